@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -57,6 +58,12 @@ type Config struct {
 	// agent.
 	MaxPendingOpens uint16
 
+	// Splicing indicates whether the backing node supports splicing. If
+	// set, the agent splices funds into one of its existing channels
+	// whenever it should allocate more funds, but no new channels can be
+	// opened.
+	Splicing bool
+
 	// TODO(roasbeef): add additional signals from fee rates and revenue of
 	// currently opened channels
 }
@@ -327,16 +334,24 @@ func mergeNodeMaps(c map[NodeID]Channel,
 
 // mergeChanState merges the Agent's set of active channels, with the set of
 // channels awaiting confirmation. This ensures that the agent doesn't go over
-// the prescribed channel limit or fund allocation limit.
+// the prescribed channel limit or fund allocation limit. Active channels with
+// a pending splice are replaced by the channel the splice results in.
 func mergeChanState(pendingChans map[NodeID]Channel,
+	pendingSplices map[lnwire.ShortChannelID]Channel,
 	activeChans channelState) []Channel {
 
 	numChans := len(pendingChans) + len(activeChans)
 	totalChans := make([]Channel, 0, numChans)
 
 	for _, activeChan := range activeChans.Channels() {
+		if _, ok := pendingSplices[activeChan.ChanID]; ok {
+			continue
+		}
 		totalChans = append(totalChans, activeChan)
 	}
+	for _, splicedChan := range pendingSplices {
+		totalChans = append(totalChans, splicedChan)
+	}
 	for _, pendingChan := range pendingChans {
 		totalChans = append(totalChans, pendingChan)
 	}
@@ -344,6 +359,37 @@ func mergeChanState(pendingChans map[NodeID]Channel,
 	return totalChans
 }
 
+// selectSpliceCandidate returns the active channel with the smallest capacity
+// that isn't part of the passed set of channels to skip. Channels with an
+// unknown funding outpoint can't be spliced, and are skipped as well.
+func (a *Agent) selectSpliceCandidate(
+	skipChans ...map[lnwire.ShortChannelID]Channel) (Channel, bool) {
+
+	var (
+		candidate Channel
+		found     bool
+	)
+
+nextChan:
+	for chanID, channel := range a.chanState {
+		if channel.ChanPoint == (wire.OutPoint{}) {
+			continue
+		}
+		for _, skip := range skipChans {
+			if _, ok := skip[chanID]; ok {
+				continue nextChan
+			}
+		}
+
+		if !found || channel.Capacity < candidate.Capacity {
+			candidate = channel
+			found = true
+		}
+	}
+
+	return candidate, found
+}
+
 // controller implements the closed-loop control system of the Agent. The
 // controller will make a decision w.r.t channel placement within the graph
 // based on: its current internal state of the set of active channels open,
@@ -376,6 +422,17 @@ func (a *Agent) controller() {
 	pendingOpens := make(map[NodeID]Channel)
 	var pendingMtx sync.Mutex
 
+	// pendingSplices tracks the channels we've requested funds to be
+	// spliced into, keyed by their current short channel ID. Each maps to
+	// the channel the splice results in, which is tracked until it has
+	// been announced.
+	pendingSplices := make(map[lnwire.ShortChannelID]Channel)
+
+	// failedSplices lists channels that we've previously attempted to
+	// splice funds into, but didn't succeed. They're retried once the
+	// balance of the wallet changes, and removed once they're closed.
+	failedSplices := make(map[lnwire.ShortChannelID]Channel)
+
 	updateBalance := func() {
 		newBalance, err := a.cfg.WalletBalance()
 		if err != nil {
@@ -409,6 +466,11 @@ func (a *Agent) controller() {
 
 				pendingMtx.Lock()
 				delete(pendingOpens, newChan.Node)
+				for chanID, c := range pendingSplices {
+					if c.ChanPoint == newChan.ChanPoint {
+						delete(pendingSplices, chanID)
+					}
+				}
 				pendingMtx.Unlock()
 
 				updateBalance()
@@ -419,9 +481,12 @@ func (a *Agent) controller() {
 					"updates: %v",
 					spew.Sdump(update.closedChans))
 
+				pendingMtx.Lock()
 				for _, closedChan := range update.closedChans {
 					delete(a.chanState, closedChan)
+					delete(failedSplices, closedChan)
 				}
+				pendingMtx.Unlock()
 
 				updateBalance()
 			}
@@ -439,6 +504,14 @@ func (a *Agent) controller() {
 
 			updateBalance()
 
+			// Splices may have failed due to a lack of funds, so
+			// we'll give the channels another try.
+			pendingMtx.Lock()
+			for chanID := range failedSplices {
+				delete(failedSplices, chanID)
+			}
+			pendingMtx.Unlock()
+
 		// The channel we tried to open previously failed for whatever
 		// reason.
 		case <-a.chanOpenFailures:
@@ -469,7 +542,9 @@ func (a *Agent) controller() {
 		// factor in our set of unconfirmed channels.
 		confirmedChans := a.chanState
 		pendingMtx.Lock()
-		totalChans := mergeChanState(pendingOpens, confirmedChans)
+		totalChans := mergeChanState(
+			pendingOpens, pendingSplices, confirmedChans,
+		)
 		pendingMtx.Unlock()
 
 		// Now that we've updated our internal state, we'll consult our
@@ -512,6 +587,19 @@ func (a *Agent) controller() {
 
 		if len(chanCandidates) == 0 {
 			log.Infof("No eligible candidates to connect to")
+
+			// As no new channels can be opened, we'll grow one of
+			// our existing channels instead. We only splice a
+			// single channel at a time.
+			pendingMtx.Lock()
+			if a.cfg.Splicing && len(pendingSplices) == 0 {
+				a.spliceIn(
+					availableFunds, pendingSplices,
+					failedSplices, &pendingMtx,
+				)
+			}
+			pendingMtx.Unlock()
+
 			continue
 		}
 
@@ -693,3 +781,53 @@ func (a *Agent) controller() {
 
 	}
 }
+
+// spliceIn splices the passed amount of funds into the smallest of our active
+// channels that neither has a pending splice, nor failed to be spliced
+// before. The splice is carried out in a new goroutine, and tracked within
+// the passed set of pending splices until the spliced channel is announced.
+//
+// NOTE: The passed mutex guarding the pending and failed splices must be held
+// when calling this method.
+func (a *Agent) spliceIn(amt btcutil.Amount,
+	pendingSplices, failedSplices map[lnwire.ShortChannelID]Channel,
+	pendingMtx *sync.Mutex) {
+
+	channel, ok := a.selectSpliceCandidate(pendingSplices, failedSplices)
+	if !ok {
+		log.Debugf("No eligible channels to splice funds into")
+		return
+	}
+
+	log.Infof("Attempting to splice %v into ChannelPoint(%v)", amt,
+		channel.ChanPoint)
+
+	// Until the controller reports the actual result of the splice, we'll
+	// assume all funds end up in the channel.
+	chanID := channel.ChanID
+	pendingSplices[chanID] = Channel{
+		Capacity: channel.Capacity + amt,
+		Node:     channel.Node,
+	}
+
+	go func() {
+		splicedChan, err := a.cfg.ChanController.SpliceIn(
+			&channel.ChanPoint, amt,
+		)
+
+		pendingMtx.Lock()
+		defer pendingMtx.Unlock()
+
+		if err != nil {
+			log.Warnf("Unable to splice %v into "+
+				"ChannelPoint(%v): %v", amt,
+				channel.ChanPoint, err)
+
+			delete(pendingSplices, chanID)
+			failedSplices[chanID] = channel
+			return
+		}
+
+		pendingSplices[chanID] = *splicedChan
+	}()
+}
@@ -101,9 +101,18 @@ type openChanIntent struct {
 	private bool
 }
 
+type spliceIntent struct {
+	chanPoint wire.OutPoint
+	amt       btcutil.Amount
+}
+
 type mockChanController struct {
 	openChanSignals chan openChanIntent
 	private         bool
+
+	spliceInSignals chan spliceIntent
+	splicedChan     Channel
+	spliceErr       error
 }
 
 func (m *mockChanController) OpenChannel(target *btcec.PublicKey,
@@ -123,7 +132,16 @@ func (m *mockChanController) CloseChannel(chanPoint *wire.OutPoint) error {
 }
 func (m *mockChanController) SpliceIn(chanPoint *wire.OutPoint,
 	amt btcutil.Amount) (*Channel, error) {
-	return nil, nil
+
+	m.spliceInSignals <- spliceIntent{
+		chanPoint: *chanPoint,
+		amt:       amt,
+	}
+
+	if m.spliceErr != nil {
+		return nil, m.spliceErr
+	}
+	return &m.splicedChan, nil
 }
 func (m *mockChanController) SpliceOut(chanPoint *wire.OutPoint,
 	amt btcutil.Amount) (*Channel, error) {
@@ -1361,3 +1379,303 @@ func TestAgentSkipPendingConns(t *testing.T) {
 		t.Fatalf("agent have attempted connection")
 	}
 }
+
+// TestAgentSpliceIn tests that the agent splices funds into its smallest
+// channel if it should allocate more funds, but no channels can be opened, and
+// that it tracks the spliced channel until it has been announced.
+func TestAgentSpliceIn(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create all the dependencies that we'll need in order to
+	// create the autopilot agent.
+	self, err := randKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	heuristic := &mockHeuristic{
+		moreChansResps: make(chan moreChansResp),
+		moreChanArgs:   make(chan moreChanArg),
+		directiveResps: make(chan []AttachmentDirective),
+	}
+
+	// We'll start out with two channels, and expect funds to be spliced
+	// into the smaller one.
+	smallChan := Channel{
+		ChanID:    randChanID(),
+		ChanPoint: wire.OutPoint{Index: 1},
+		Capacity:  btcutil.SatoshiPerBitcoin,
+	}
+	largeChan := Channel{
+		ChanID:    randChanID(),
+		ChanPoint: wire.OutPoint{Index: 2},
+		Capacity:  btcutil.SatoshiPerBitcoin * 2,
+	}
+	splicedChan := Channel{
+		ChanPoint: wire.OutPoint{Index: 3},
+		Capacity:  btcutil.SatoshiPerBitcoin * 1.5,
+	}
+	chanController := &mockChanController{
+		openChanSignals: make(chan openChanIntent),
+		spliceInSignals: make(chan spliceIntent),
+		splicedChan:     splicedChan,
+	}
+	memGraph, _, _ := newMemChanGraph()
+
+	// With the dependencies we created, we can now create the initial
+	// agent itself, allowing it to splice channels.
+	testCfg := Config{
+		Self:           self,
+		Heuristic:      heuristic,
+		ChanController: chanController,
+		WalletBalance: func() (btcutil.Amount, error) {
+			return btcutil.SatoshiPerBitcoin, nil
+		},
+		ConnectToPeer: func(*btcec.PublicKey, []net.Addr) (bool, error) {
+			return false, nil
+		},
+		DisconnectPeer: func(*btcec.PublicKey) error {
+			return nil
+		},
+		Graph:           memGraph,
+		MaxPendingOpens: 10,
+		Splicing:        true,
+	}
+	initialChans := []Channel{smallChan, largeChan}
+	agent, err := New(testCfg, initialChans)
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+
+	// To ensure the heuristic doesn't block on quitting the agent, we'll
+	// use the agent's quit chan to signal when it should also stop.
+	heuristic.quit = agent.quit
+
+	// With the autopilot agent and all its dependencies we'll star the
+	// primary controller goroutine.
+	if err := agent.Start(); err != nil {
+		t.Fatalf("unable to start agent: %v", err)
+	}
+	defer agent.Stop()
+
+	// queryHeuristic waits for the agent to query the heuristic, and
+	// returns the channels it was queried with after sending the passed
+	// response.
+	queryHeuristic := func(resp moreChansResp) []Channel {
+		t.Helper()
+
+		var chans []Channel
+		select {
+		case arg := <-heuristic.moreChanArgs:
+			chans = arg.chans
+		case <-time.After(time.Second * 10):
+			t.Fatalf("heuristic wasn't queried in time")
+		}
+
+		select {
+		case heuristic.moreChansResps <- resp:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("heuristic wasn't queried in time")
+		}
+
+		return chans
+	}
+
+	// The heuristic will ask for more funds to be allocated, but won't
+	// return any nodes to open channels to.
+	const spliceAmt = btcutil.SatoshiPerBitcoin / 2
+	queryHeuristic(moreChansResp{true, 1, spliceAmt})
+
+	select {
+	case heuristic.directiveResps <- []AttachmentDirective{}:
+	case <-time.After(time.Second * 10):
+		t.Fatalf("Select was not called but should have been")
+	}
+
+	// As a result, the agent should splice the funds into the smaller
+	// channel.
+	select {
+	case intent := <-chanController.spliceInSignals:
+		if intent.chanPoint != smallChan.ChanPoint {
+			t.Fatalf("expected splice of %v, got %v",
+				smallChan.ChanPoint, intent.chanPoint)
+		}
+		if intent.amt != spliceAmt {
+			t.Fatalf("expected splice of %v, got %v", spliceAmt,
+				intent.amt)
+		}
+	case <-time.After(time.Second * 10):
+		t.Fatalf("channel wasn't spliced in time")
+	}
+
+	// assertSpliced asserts that the heuristic is queried with the
+	// spliced channel in place of the smaller channel.
+	assertSpliced := func(chans []Channel) {
+		t.Helper()
+
+		if len(chans) != 2 {
+			t.Fatalf("expected 2 channels, got %v", len(chans))
+		}
+		for _, channel := range chans {
+			if channel.ChanPoint == smallChan.ChanPoint {
+				t.Fatalf("spliced channel still present")
+			}
+		}
+	}
+
+	// Even once the smaller channel has been closed by the splice
+	// transaction, the spliced channel should be taken into account.
+	agent.OnChannelClose(smallChan.ChanID)
+	assertSpliced(queryHeuristic(moreChansResp{false, 0, 0}))
+
+	// Finally, once the spliced channel has been announced, it should
+	// replace the pending splice.
+	splicedChan.ChanID = randChanID()
+	agent.OnChannelOpen(splicedChan)
+	chans := queryHeuristic(moreChansResp{false, 0, 0})
+	assertSpliced(chans)
+	for _, channel := range chans {
+		if channel.ChanPoint == splicedChan.ChanPoint &&
+			channel.ChanID != splicedChan.ChanID {
+
+			t.Fatalf("pending splice wasn't replaced by announced " +
+				"channel")
+		}
+	}
+}
+
+// TestAgentSpliceInFailure tests that the agent doesn't splice funds into a
+// channel it failed to splice before, until the balance of the wallet
+// changes.
+func TestAgentSpliceInFailure(t *testing.T) {
+	t.Parallel()
+
+	self, err := randKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	heuristic := &mockHeuristic{
+		moreChansResps: make(chan moreChansResp),
+		moreChanArgs:   make(chan moreChanArg),
+		directiveResps: make(chan []AttachmentDirective),
+	}
+
+	smallChan := Channel{
+		ChanID:    randChanID(),
+		ChanPoint: wire.OutPoint{Index: 1},
+		Capacity:  btcutil.SatoshiPerBitcoin,
+	}
+	largeChan := Channel{
+		ChanID:    randChanID(),
+		ChanPoint: wire.OutPoint{Index: 2},
+		Capacity:  btcutil.SatoshiPerBitcoin * 2,
+	}
+	chanController := &mockChanController{
+		openChanSignals: make(chan openChanIntent),
+		spliceInSignals: make(chan spliceIntent),
+		spliceErr:       errors.New("splice failed"),
+	}
+	memGraph, _, _ := newMemChanGraph()
+
+	testCfg := Config{
+		Self:           self,
+		Heuristic:      heuristic,
+		ChanController: chanController,
+		WalletBalance: func() (btcutil.Amount, error) {
+			return btcutil.SatoshiPerBitcoin, nil
+		},
+		ConnectToPeer: func(*btcec.PublicKey, []net.Addr) (bool, error) {
+			return false, nil
+		},
+		DisconnectPeer: func(*btcec.PublicKey) error {
+			return nil
+		},
+		Graph:           memGraph,
+		MaxPendingOpens: 10,
+		Splicing:        true,
+	}
+	agent, err := New(testCfg, []Channel{smallChan, largeChan})
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+	heuristic.quit = agent.quit
+
+	if err := agent.Start(); err != nil {
+		t.Fatalf("unable to start agent: %v", err)
+	}
+	defer agent.Stop()
+
+	// trySplice answers the next query of the heuristic by asking for
+	// more funds to be allocated without any nodes to open channels to,
+	// and returns the channel the agent attempts to splice, if any.
+	const spliceAmt = btcutil.SatoshiPerBitcoin / 2
+	trySplice := func() (wire.OutPoint, bool) {
+		t.Helper()
+
+		select {
+		case <-heuristic.moreChanArgs:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("heuristic wasn't queried in time")
+		}
+		select {
+		case heuristic.moreChansResps <- moreChansResp{
+			true, 1, spliceAmt,
+		}:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("heuristic wasn't queried in time")
+		}
+		select {
+		case heuristic.directiveResps <- []AttachmentDirective{}:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("Select was not called but should have been")
+		}
+
+		select {
+		case intent := <-chanController.spliceInSignals:
+			return intent.chanPoint, true
+		case <-time.After(time.Millisecond * 100):
+			return wire.OutPoint{}, false
+		}
+	}
+
+	// nextSplice triggers new rounds of the agent until it attempts
+	// another splice, which is skipped while the previous one is still
+	// being recorded as failed.
+	nextSplice := func(signal func()) wire.OutPoint {
+		t.Helper()
+
+		for i := 0; i < 20; i++ {
+			signal()
+			if chanPoint, ok := trySplice(); ok {
+				return chanPoint
+			}
+		}
+
+		t.Fatalf("channel wasn't spliced in time")
+		return wire.OutPoint{}
+	}
+
+	chanPoint, ok := trySplice()
+	if !ok {
+		t.Fatalf("channel wasn't spliced in time")
+	}
+	if chanPoint != smallChan.ChanPoint {
+		t.Fatalf("expected splice of %v, got %v", smallChan.ChanPoint,
+			chanPoint)
+	}
+
+	// As the splice of the smaller channel failed, the agent should
+	// splice the funds into the larger channel instead.
+	chanPoint = nextSplice(agent.OnNodeUpdates)
+	if chanPoint != largeChan.ChanPoint {
+		t.Fatalf("expected splice of %v, got %v", largeChan.ChanPoint,
+			chanPoint)
+	}
+
+	// Once the balance of the wallet changes, the agent should try the
+	// smaller channel again.
+	chanPoint = nextSplice(agent.OnBalanceChange)
+	if chanPoint != smallChan.ChanPoint {
+		t.Fatalf("expected splice of %v, got %v", smallChan.ChanPoint,
+			chanPoint)
+	}
+}
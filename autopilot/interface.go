@@ -39,6 +39,9 @@ type Channel struct {
 	// BOLT-0007.
 	ChanID lnwire.ShortChannelID
 
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
 	// Capacity is the capacity of the channel expressed in satoshis.
 	Capacity btcutil.Amount
 
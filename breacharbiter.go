@@ -87,8 +87,8 @@ type BreachConfig struct {
 	Notifier chainntnfs.ChainNotifier
 
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network, labeling it with the passed label.
+	PublishTransaction func(*wire.MsgTx, string) error
 
 	// ContractBreaches is a channel where the breachArbiter will receive
 	// notifications in the event of a contract breach being observed. A
@@ -527,7 +527,9 @@ justiceTxBroadcast:
 
 	// We'll now attempt to broadcast the transaction which finalized the
 	// channel's retribution against the cheating counter party.
-	err = b.cfg.PublishTransaction(finalTx)
+	err = b.cfg.PublishTransaction(
+		finalTx, lnwallet.JusticeTxLabel(breachInfo.chanPoint),
+	)
 	if err != nil {
 		brarLog.Errorf("unable to broadcast justice tx: %v", err)
 
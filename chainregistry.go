@@ -14,6 +14,7 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/wallet"
@@ -28,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/chainview"
 )
@@ -138,6 +140,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 
 	cc := &chainControl{}
 
+	var staticFeeRate lnwallet.SatPerKWeight
 	switch registeredChains.PrimaryChain() {
 	case bitcoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
@@ -146,9 +149,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Bitcoin.FeeRate,
 			TimeLockDelta: cfg.Bitcoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.StaticFeeEstimator{
-			FeePerKW: defaultBitcoinStaticFeePerKW,
-		}
+		staticFeeRate = defaultBitcoinStaticFeePerKW
 	case litecoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
 			MinHTLC:       cfg.Litecoin.MinHTLC,
@@ -156,13 +157,14 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Litecoin.FeeRate,
 			TimeLockDelta: cfg.Litecoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.StaticFeeEstimator{
-			FeePerKW: defaultLitecoinStaticFeePerKW,
-		}
+		staticFeeRate = defaultLitecoinStaticFeePerKW
 	default:
 		return nil, nil, fmt.Errorf("Default routing policy for "+
 			"chain %v is unknown", registeredChains.PrimaryChain())
 	}
+	cc.feeEstimator = lnwallet.StaticFeeEstimator{
+		FeePerKW: staticFeeRate,
+	}
 
 	walletConfig := &btcwallet.Config{
 		PrivatePass:    privateWalletPw,
@@ -269,7 +271,23 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		walletConfig.ChainSource = chain.NewNeutrinoClient(
 			activeNetParams.Params, svc,
 		)
+
+		// As there's no fee estimation RPC available to light
+		// clients, we'll derive live fee estimates from the fee rates
+		// paid within the most recent blocks instead. The static fee
+		// rate is used until enough fee rates are known.
+		feeEstimator := lnwallet.NewNeutrinoFeeEstimator(
+			&neutrinoBlockSource{svc: svc},
+			lnwallet.DefaultNeutrinoFeeWindow,
+			lnwallet.DefaultNeutrinoFeePollInterval, staticFeeRate,
+		)
+		if err := feeEstimator.Start(); err != nil {
+			return nil, nil, err
+		}
+		cc.feeEstimator = feeEstimator
+
 		cleanUp = func() {
+			feeEstimator.Stop()
 			svc.Stop()
 			nodeDatabase.Close()
 		}
@@ -493,6 +511,66 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			homeChainConfig.Node)
 	}
 
+	// If a fee estimation API is configured, its estimates take precedence
+	// over those of the chain backend. Until the API has been reached,
+	// we'll fall back to the static fee rate of the primary chain.
+	if cfg.FeeURL != "" {
+		ltndLog.Infof("Initializing web API fee estimator using %v",
+			cfg.FeeURL)
+
+		if err := cc.feeEstimator.Stop(); err != nil {
+			return nil, nil, err
+		}
+
+		webEstimator := lnwallet.NewWebAPIFeeEstimator(
+			cfg.FeeURL, lnwallet.DefaultWebAPIPollInterval,
+			staticFeeRate,
+		)
+		if err := webEstimator.Start(); err != nil {
+			return nil, nil, err
+		}
+		cc.feeEstimator = webEstimator
+
+		chainCleanUp := cleanUp
+		cleanUp = func() {
+			webEstimator.Stop()
+			if chainCleanUp != nil {
+				chainCleanUp()
+			}
+		}
+	}
+
+	// If a remote signer is used, it holds our seed, so we'll run a
+	// watch-only wallet exported by the remote signer. All keys are
+	// derived by the remote signer, and all signatures, including those
+	// for the inputs of the on-chain wallet, are generated by it.
+	var remoteSigner *remotesigner.RemoteSigner
+	if cfg.RemoteSigner.Active {
+		ltndLog.Infof("Using remote signer at %v",
+			cfg.RemoteSigner.RPCHost)
+
+		remoteSigner, err = remotesigner.New(cfg.RemoteSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		chainCleanUp := cleanUp
+		cleanUp = func() {
+			remoteSigner.Stop()
+			if chainCleanUp != nil {
+				chainCleanUp()
+			}
+		}
+
+		if err := importWatchOnlyWallet(
+			remoteSigner, homeChainConfig.ChainDir,
+		); err != nil {
+			return nil, nil, err
+		}
+
+		walletConfig.WatchOnly = true
+	}
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
@@ -509,9 +587,19 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		channelConstraints = defaultLtcChannelConstraints
 	}
 
-	keyRing := keychain.NewBtcWalletKeyRing(
-		wc.InternalWallet(), activeNetParams.CoinType,
-	)
+	var keyRing keychain.SecretKeyRing
+	if remoteSigner != nil {
+		cc.signer = &watchOnlySigner{
+			RemoteSigner: remoteSigner,
+			wallet:       wc,
+		}
+		cc.msgSigner = remoteSigner
+		keyRing = remoteSigner
+	} else {
+		keyRing = keychain.NewBtcWalletKeyRing(
+			wc.InternalWallet(), activeNetParams.CoinType,
+		)
+	}
 
 	// Create, and start the lnwallet, which handles the core payment
 	// channel logic, and exposes control via proxy state machines.
@@ -525,6 +613,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		ChainIO:            cc.chainIO,
 		DefaultConstraints: channelConstraints,
 		NetParams:          *activeNetParams.Params,
+		WatchOnly:          walletConfig.WatchOnly,
 	}
 	lnWallet, err := lnwallet.NewLightningWallet(walletCfg)
 	if err != nil {
@@ -543,6 +632,66 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	return cc, cleanUp, nil
 }
 
+// importWatchOnlyWallet imports the watch-only wallet exported by the remote
+// signer, unless a wallet has already been created within the chain directory.
+func importWatchOnlyWallet(remoteSigner *remotesigner.RemoteSigner,
+	chainDir string) error {
+
+	walletExists, err := btcwallet.WalletExists(
+		chainDir, activeNetParams.Params,
+	)
+	if err != nil {
+		return err
+	}
+	if walletExists {
+		return nil
+	}
+
+	walletDB, err := remoteSigner.ExportWatchOnlyWallet()
+	if err != nil {
+		return fmt.Errorf("unable to export watch-only wallet from "+
+			"remote signer: %v", err)
+	}
+
+	err = btcwallet.ImportWatchOnlyWallet(
+		chainDir, activeNetParams.Params, walletDB,
+	)
+	if err != nil {
+		return err
+	}
+
+	ltndLog.Infof("Imported watch-only wallet from remote signer")
+
+	return nil
+}
+
+// watchOnlySigner is the signer used when running with a remote signer. All
+// signatures are generated by the remote signer. To compute the input script
+// for an output of the watch-only wallet, the remote signer is told the path
+// of the wallet key the output pays to.
+type watchOnlySigner struct {
+	*remotesigner.RemoteSigner
+
+	wallet *btcwallet.BtcWallet
+}
+
+// ComputeInputScript generates a complete input script for an output of the
+// watch-only wallet using the remote signer.
+//
+// NOTE: This is part of the lnwallet.Signer interface.
+func (w *watchOnlySigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	scope, path, err := w.wallet.KeyPath(signDesc.Output.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.RemoteSigner.ComputeWalletInputScript(
+		tx, signDesc, scope, path,
+	)
+}
+
 var (
 	// bitcoinTestnetGenesis is the genesis hash of Bitcoin's testnet
 	// chain.
@@ -624,6 +773,42 @@ var (
 	}
 )
 
+// neutrinoBlockSource is an implementation of the lnwallet.FeeBlockSource
+// interface backed by a neutrino light client.
+type neutrinoBlockSource struct {
+	svc *neutrino.ChainService
+}
+
+// BestHeight returns the height of the current chain tip.
+func (n *neutrinoBlockSource) BestHeight() (uint32, error) {
+	_, height, err := n.svc.BlockHeaders.ChainTip()
+	return height, err
+}
+
+// BlockHash returns the hash of the block at the given height in the main
+// chain.
+func (n *neutrinoBlockSource) BlockHash(height uint32) (*chainhash.Hash,
+	error) {
+
+	header, err := n.svc.BlockHeaders.FetchHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	hash := header.BlockHash()
+	return &hash, nil
+}
+
+// FetchBlock fetches the full block with the given hash from the network.
+func (n *neutrinoBlockSource) FetchBlock(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	block, err := n.svc.GetBlock(*hash)
+	if err != nil {
+		return nil, err
+	}
+	return block.MsgBlock(), nil
+}
+
 // chainRegistry keeps track of the current chains
 type chainRegistry struct {
 	sync.RWMutex
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/shachain"
+)
+
+// chanDBSource is a thin wrapper around the channel database which implements
+// the chanbackup.LiveChannelSource interface.
+type chanDBSource struct {
+	db *channeldb.DB
+}
+
+// A compile-time check to ensure chanDBSource meets the
+// chanbackup.LiveChannelSource interface.
+var _ chanbackup.LiveChannelSource = (*chanDBSource)(nil)
+
+// FetchAllChannels returns all channels that haven't yet been fully closed,
+// excluding those for which we've already broadcast a commitment.
+//
+// NOTE: Part of the chanbackup.LiveChannelSource interface.
+func (c *chanDBSource) FetchAllChannels() ([]*channeldb.OpenChannel, error) {
+	dbChans, err := c.db.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	liveChans := make([]*channeldb.OpenChannel, 0, len(dbChans))
+	for _, dbChan := range dbChans {
+		if dbChan.HasChanStatus(channeldb.CommitmentBroadcasted) {
+			continue
+		}
+
+		liveChans = append(liveChans, dbChan)
+	}
+
+	return liveChans, nil
+}
+
+// FetchChannel attempts to locate a live channel identified by the passed
+// chanPoint.
+//
+// NOTE: Part of the chanbackup.LiveChannelSource interface.
+func (c *chanDBSource) FetchChannel(
+	chanPoint wire.OutPoint) (*channeldb.OpenChannel, error) {
+
+	liveChans, err := c.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, liveChan := range liveChans {
+		if liveChan.FundingOutpoint == chanPoint {
+			return liveChan, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find channel %v", chanPoint)
+}
+
+// AddrsForNode returns all known addresses for the target node public key. We
+// combine the addresses we've stored for the link node with those advertised
+// by the node within the channel graph.
+//
+// NOTE: Part of the chanbackup.LiveChannelSource interface.
+func (c *chanDBSource) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr,
+	error) {
+
+	var addrs []net.Addr
+	addrSet := make(map[string]struct{})
+	addAddrs := func(newAddrs []net.Addr) {
+		for _, addr := range newAddrs {
+			if _, ok := addrSet[addr.String()]; ok {
+				continue
+			}
+
+			addrSet[addr.String()] = struct{}{}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	linkNode, err := c.db.FetchLinkNode(nodePub)
+	switch {
+	case err == nil:
+		addAddrs(linkNode.Addresses)
+
+	case err != channeldb.ErrNodeNotFound:
+		return nil, err
+	}
+
+	graphNode, err := c.db.ChannelGraph().FetchLightningNode(nodePub)
+	switch {
+	case err == nil:
+		addAddrs(graphNode.Addresses)
+
+	case err != channeldb.ErrGraphNodeNotFound &&
+		err != channeldb.ErrGraphNodesNotFound:
+		return nil, err
+	}
+
+	return addrs, nil
+}
+
+// chanDBRestorer is an implementation of the chanbackup.ChannelRestorer
+// interface that is able to properly map a Single backup, into a
+// channeldb.ChannelShell which is required to fully restore a channel. We
+// also need the secret key ring in order to re-derive our local channel keys,
+// as the backup only stores their locators.
+type chanDBRestorer struct {
+	db *channeldb.DB
+
+	secretKeys keychain.SecretKeyRing
+
+	chainHash chainhash.Hash
+
+	// chainArb is used to watch the restored channels on-chain, so we're
+	// able to sweep our funds once the remote party force closes.
+	chainArb channelWatcher
+}
+
+// channelWatcher is the subset of the contractcourt.ChainArbitrator used by
+// the chanDBRestorer to watch the restored channels on-chain.
+type channelWatcher interface {
+	// WatchNewChannel starts watching the passed channel for on-chain
+	// events.
+	WatchNewChannel(*channeldb.OpenChannel) error
+}
+
+// A compile-time check to ensure the ChainArbitrator meets the
+// channelWatcher interface.
+var _ channelWatcher = (*contractcourt.ChainArbitrator)(nil)
+
+// A compile-time check to ensure chanDBRestorer meets the
+// chanbackup.ChannelRestorer interface.
+var _ chanbackup.ChannelRestorer = (*chanDBRestorer)(nil)
+
+// openChannelShell maps the static channel back up into an open channel
+// "shell". We say shell as this doesn't include all the information required
+// to continue to use the channel, only the minimal amount of information to
+// insert this shell channel back into the database.
+func (c *chanDBRestorer) openChannelShell(
+	backup chanbackup.Single) (*channeldb.ChannelShell, error) {
+
+	if backup.ChainHash != c.chainHash {
+		return nil, fmt.Errorf("backup for ChannelPoint(%v) is for "+
+			"chain %v, we're on %v", backup.FundingOutpoint,
+			backup.ChainHash, c.chainHash)
+	}
+
+	// First, we'll need to re-derive the public keys of our side of the
+	// channel, as the backup only stores the key locators.
+	localKeys := []*keychain.KeyDescriptor{
+		&backup.LocalChanCfg.MultiSigKey,
+		&backup.LocalChanCfg.RevocationBasePoint,
+		&backup.LocalChanCfg.PaymentBasePoint,
+		&backup.LocalChanCfg.DelayBasePoint,
+		&backup.LocalChanCfg.HtlcBasePoint,
+	}
+	for _, keyDesc := range localKeys {
+		derivedKey, err := c.secretKeys.DeriveKey(keyDesc.KeyLocator)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive key %v: %v",
+				keyDesc.KeyLocator, err)
+		}
+
+		keyDesc.PubKey = derivedKey.PubKey
+	}
+
+	// The shell won't be used to sign new states, so we'll fill in the
+	// remaining fields with placeholders that allow the channel to be
+	// serialized to disk.
+	var shaChainRoot chainhash.Hash
+	chanShell := channeldb.ChannelShell{
+		NodeAddrs: backup.Addresses,
+		Chan: &channeldb.OpenChannel{
+			ChanType:                channeldb.SingleFunder,
+			ChainHash:               backup.ChainHash,
+			IsInitiator:             backup.IsInitiator,
+			Capacity:                backup.Capacity,
+			FundingOutpoint:         backup.FundingOutpoint,
+			ShortChannelID:          backup.ShortChannelID,
+			IdentityPub:             backup.RemoteNodePub,
+			IsPending:               false,
+			LocalChanCfg:            backup.LocalChanCfg,
+			RemoteChanCfg:           backup.RemoteChanCfg,
+			RemoteCurrentRevocation: backup.RemoteNodePub,
+			RevocationStore:         shachain.NewRevocationStore(),
+			RevocationProducer: shachain.NewRevocationProducer(
+				shaChainRoot,
+			),
+			LocalCommitment: channeldb.ChannelCommitment{
+				CommitTx: wire.NewMsgTx(2),
+			},
+			RemoteCommitment: channeldb.ChannelCommitment{
+				CommitTx: wire.NewMsgTx(2),
+			},
+			Packager: channeldb.NewChannelPackager(
+				backup.ShortChannelID,
+			),
+		},
+	}
+
+	return &chanShell, nil
+}
+
+// RestoreChansFromSingles attempts to map the set of single channel backups
+// to channel shells that will be stored persistently. Once these shells have
+// been stored on disk, we'll be able to connect to the channel peer and
+// execute the data loss recovery protocol.
+//
+// NOTE: Part of the chanbackup.ChannelRestorer interface.
+func (c *chanDBRestorer) RestoreChansFromSingles(
+	backups ...chanbackup.Single) error {
+
+	channelShells := make([]*channeldb.ChannelShell, 0, len(backups))
+	for _, backup := range backups {
+		chanShell, err := c.openChannelShell(backup)
+		if err != nil {
+			return err
+		}
+
+		channelShells = append(channelShells, chanShell)
+	}
+
+	ltndLog.Infof("Inserting %v SCB channel shells into DB",
+		len(channelShells))
+
+	// Now that we have all the backups mapped into a series of Singles,
+	// we'll insert them all into the database.
+	if err := c.db.RestoreChannelShells(channelShells...); err != nil {
+		return err
+	}
+
+	// With the shells stored, we'll register each of the restored
+	// channels with the chain arbitrator, such that we'll be able to
+	// recover our funds once the remote party broadcasts their
+	// commitment. We read the channels back from disk, as some of them
+	// may have already been known to us.
+	restoredPoints := make(map[wire.OutPoint]struct{}, len(backups))
+	for _, backup := range backups {
+		restoredPoints[backup.FundingOutpoint] = struct{}{}
+	}
+	dbChans, err := c.db.FetchAllChannels()
+	if err != nil {
+		return err
+	}
+	for _, dbChan := range dbChans {
+		if _, ok := restoredPoints[dbChan.FundingOutpoint]; !ok {
+			continue
+		}
+		if !dbChan.HasChanStatus(channeldb.Restored) {
+			continue
+		}
+
+		if err := c.chainArb.WatchNewChannel(dbChan); err != nil {
+			return fmt.Errorf("unable to watch restored "+
+				"ChannelPoint(%v): %v", dbChan.FundingOutpoint,
+				err)
+		}
+	}
+
+	return nil
+}
+
+// A compile-time check to ensure server meets the chanbackup.PeerConnector
+// interface.
+var _ chanbackup.PeerConnector = (*server)(nil)
+
+// ConnectPeer attempts to connect to the target node at the set of available
+// addresses. Once this method returns with a nil error, the connector
+// should attempt to persistently connect to the target peer in the background
+// as a persistent attempt.
+//
+// NOTE: Part of the chanbackup.PeerConnector interface.
+func (s *server) ConnectPeer(nodePub *btcec.PublicKey,
+	addrs []net.Addr) error {
+
+	// If we're already connected to this peer, then we'll disconnect so
+	// the restored channel is picked up once the connection is
+	// re-established.
+	if _, err := s.FindPeer(nodePub); err == nil {
+		srvrLog.Infof("Disconnecting from peer %x to reload restored "+
+			"channels", nodePub.SerializeCompressed())
+
+		if err := s.DisconnectPeer(nodePub); err != nil {
+			return err
+		}
+	}
+
+	if len(addrs) == 0 {
+		srvrLog.Warnf("No addresses known for peer %x, unable to "+
+			"connect", nodePub.SerializeCompressed())
+		return nil
+	}
+
+	// For each of the known addresses, we'll attempt to launch a
+	// persistent connection to the (pub, addr) pair. In the event that
+	// any of them connect, all the other stale requests will be
+	// cancelled.
+	for _, addr := range addrs {
+		netAddr := &lnwire.NetAddress{
+			IdentityKey: nodePub,
+			Address:     addr,
+		}
+
+		srvrLog.Infof("Attempting to connect to %v for SCB restore "+
+			"DLP", netAddr)
+
+		// Attempt to connect to the peer using this full address. If
+		// we're unable to connect to them, then we'll try the next
+		// address in place of it.
+		if err := s.ConnectToPeer(netAddr, true); err != nil {
+			srvrLog.Errorf("unable to connect to %v to "+
+				"complete SCB restore: %v", netAddr, err)
+			continue
+		}
+	}
+
+	return nil
+}
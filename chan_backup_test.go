@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/aezeed"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/walletunlocker"
+	"golang.org/x/net/context"
+)
+
+// mockChannelWatcher records the channels it was asked to watch.
+type mockChannelWatcher struct {
+	sync.Mutex
+	watched []wire.OutPoint
+}
+
+func (m *mockChannelWatcher) WatchNewChannel(
+	channel *channeldb.OpenChannel) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.watched = append(m.watched, channel.FundingOutpoint)
+	return nil
+}
+
+// mockPeerConnector records the peers it was asked to connect to.
+type mockPeerConnector struct {
+	sync.Mutex
+	connected []*btcec.PublicKey
+}
+
+func (m *mockPeerConnector) ConnectPeer(node *btcec.PublicKey,
+	addrs []net.Addr) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.connected = append(m.connected, node)
+	return nil
+}
+
+// newTestChanBackup creates a backup of a channel with the given funding
+// outpoint index.
+func newTestChanBackup(t *testing.T, chainHash chainhash.Hash,
+	index uint32) chanbackup.Single {
+
+	remoteKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	remotePub := remoteKey.PubKey()
+
+	return chanbackup.Single{
+		Version:     chanbackup.DefaultSingleVersion,
+		IsInitiator: true,
+		ChainHash:   chainHash,
+		FundingOutpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: index,
+		},
+		ShortChannelID: lnwire.NewShortChanIDFromInt(uint64(index)),
+		RemoteNodePub:  remotePub,
+		Addresses: []net.Addr{
+			&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9735},
+		},
+		Capacity: 1000000,
+		LocalChanCfg: channeldb.ChannelConfig{
+			CsvDelay: 144,
+			MultiSigKey: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamilyMultiSig,
+					Index:  index,
+				},
+			},
+		},
+		RemoteChanCfg: channeldb.ChannelConfig{
+			CsvDelay: 144,
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+		},
+	}
+}
+
+// TestInitWalletRestoreChanBackups tests that the channel backups passed to
+// InitWallet are handed from the unlocker service to the chanDBRestorer, and
+// that backups which can't be decrypted are skipped without affecting the
+// others.
+func TestInitWalletRestoreChanBackups(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "restorechanbackups")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	netParams := &chaincfg.RegressionNetParams
+	chainHash := *netParams.GenesisHash
+
+	rootKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	keyRing := &mockSecretKeyRing{rootKey: rootKey}
+
+	// We'll back up one channel in a single backup and another one in a
+	// multi backup. A third single backup can't be decrypted and must be
+	// skipped.
+	singleBackup := newTestChanBackup(t, chainHash, 0)
+	var packedSingle bytes.Buffer
+	if err := singleBackup.PackToWriter(&packedSingle, keyRing); err != nil {
+		t.Fatalf("unable to pack single backup: %v", err)
+	}
+
+	multiBackup := chanbackup.Multi{
+		Version: chanbackup.DefaultMultiVersion,
+		StaticBackups: []chanbackup.Single{
+			newTestChanBackup(t, chainHash, 1),
+		},
+	}
+	var packedMulti bytes.Buffer
+	if err := multiBackup.PackToWriter(&packedMulti, keyRing); err != nil {
+		t.Fatalf("unable to pack multi backup: %v", err)
+	}
+
+	cipherSeed, err := aezeed.New(
+		keychain.KeyDerivationVersion, nil, time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	mnemonic, err := cipherSeed.ToMnemonic(nil)
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+
+	service := walletunlocker.New(testDir, netParams, nil)
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:     []byte("test-password"),
+		CipherSeedMnemonic: []string(mnemonic[:]),
+		ChannelBackups: &lnrpc.ChanBackupSnapshot{
+			SingleChanBackups: &lnrpc.ChannelBackups{
+				ChanBackups: []*lnrpc.ChannelBackup{
+					{ChanBackup: []byte("corrupt backup")},
+					{ChanBackup: packedSingle.Bytes()},
+				},
+			},
+			MultiChanBackup: &lnrpc.MultiChanBackup{
+				MultiChanBackup: packedMulti.Bytes(),
+			},
+		},
+	}
+	_, err = service.InitWallet(context.Background(), req)
+	if err != nil {
+		t.Fatalf("InitWallet call failed: %v", err)
+	}
+
+	var initMsg *walletunlocker.WalletInitMsg
+	select {
+	case initMsg = <-service.InitMsgs:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("init message not received")
+	}
+
+	chanDB, err := channeldb.Open(testDir)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer chanDB.Close()
+
+	chanWatcher := &mockChannelWatcher{}
+	chanRestorer := &chanDBRestorer{
+		db:         chanDB,
+		secretKeys: keyRing,
+		chainHash:  chainHash,
+		chainArb:   chanWatcher,
+	}
+	peerConnector := &mockPeerConnector{}
+
+	restoreChanBackups(
+		initMsg.ChanBackups, keyRing, chanRestorer, peerConnector,
+	)
+
+	// Both channels that could be decrypted must have been restored to
+	// disk, watched on-chain, and their peers connected to.
+	expectedBackups := []chanbackup.Single{
+		singleBackup, multiBackup.StaticBackups[0],
+	}
+	dbChans, err := chanDB.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(dbChans) != len(expectedBackups) {
+		t.Fatalf("expected %v restored channels, got %v",
+			len(expectedBackups), len(dbChans))
+	}
+	if len(chanWatcher.watched) != len(expectedBackups) {
+		t.Fatalf("expected %v watched channels, got %v",
+			len(expectedBackups), len(chanWatcher.watched))
+	}
+	if len(peerConnector.connected) != len(expectedBackups) {
+		t.Fatalf("expected %v peer connections, got %v",
+			len(expectedBackups), len(peerConnector.connected))
+	}
+
+	for i, backup := range expectedBackups {
+		var dbChan *channeldb.OpenChannel
+		for _, c := range dbChans {
+			if c.FundingOutpoint == backup.FundingOutpoint {
+				dbChan = c
+			}
+		}
+		if dbChan == nil {
+			t.Fatalf("ChannelPoint(%v) wasn't restored",
+				backup.FundingOutpoint)
+		}
+		if !dbChan.HasChanStatus(channeldb.Restored) {
+			t.Fatalf("ChannelPoint(%v) not marked as restored",
+				backup.FundingOutpoint)
+		}
+
+		if chanWatcher.watched[i] != backup.FundingOutpoint {
+			t.Fatalf("expected ChannelPoint(%v) to be watched, "+
+				"got %v", backup.FundingOutpoint,
+				chanWatcher.watched[i])
+		}
+		if !peerConnector.connected[i].IsEqual(backup.RemoteNodePub) {
+			t.Fatalf("expected connection to %x",
+				backup.RemoteNodePub.SerializeCompressed())
+		}
+	}
+}
@@ -143,9 +143,14 @@ func (c *chanSeries) FilterChannelRange(chain chainhash.Hash,
 
 	chanResp := make([]lnwire.ShortChannelID, 0, len(chansInRange))
 	for _, chanID := range chansInRange {
-		chanResp = append(
-			chanResp, lnwire.NewShortChanIDFromInt(chanID),
-		)
+		// Channels we only know by their alias are never announced,
+		// so we won't let the remote peer know of them either.
+		shortChanID := lnwire.NewShortChanIDFromInt(chanID)
+		if shortChanID.IsAlias() {
+			continue
+		}
+
+		chanResp = append(chanResp, shortChanID)
 	}
 
 	return chanResp, nil
@@ -0,0 +1,74 @@
+package chanacceptor
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ChainedAcceptor represents a conjunction of ChannelAcceptor results.
+type ChainedAcceptor struct {
+	// acceptorID is used to uniquely identify each acceptor that's added.
+	//
+	// NOTE: This MUST be used atomically.
+	acceptorID uint64
+
+	// acceptors is a map of ChannelAcceptors that will be evaluated when
+	// the ChainedAcceptor's Accept method is called.
+	acceptors    map[uint64]ChannelAcceptor
+	acceptorsMtx sync.RWMutex
+}
+
+// NewChainedAcceptor initializes a ChainedAcceptor.
+func NewChainedAcceptor() *ChainedAcceptor {
+	return &ChainedAcceptor{
+		acceptors: make(map[uint64]ChannelAcceptor),
+	}
+}
+
+// AddAcceptor adds a ChannelAcceptor to this ChainedAcceptor. The returned ID
+// must be used to remove the acceptor again.
+func (c *ChainedAcceptor) AddAcceptor(acceptor ChannelAcceptor) uint64 {
+	id := atomic.AddUint64(&c.acceptorID, 1)
+
+	c.acceptorsMtx.Lock()
+	c.acceptors[id] = acceptor
+	c.acceptorsMtx.Unlock()
+
+	return id
+}
+
+// RemoveAcceptor removes a ChannelAcceptor from this ChainedAcceptor given
+// an ID.
+func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
+	c.acceptorsMtx.Lock()
+	delete(c.acceptors, id)
+	c.acceptorsMtx.Unlock()
+}
+
+// Accept evaluates the results of all ChannelAcceptors in the acceptors map
+// and returns the first rejection, if any. With no acceptors added, every
+// channel is accepted.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) error {
+	// The acceptors are copied, so the lock isn't held while waiting for
+	// them to make a decision.
+	c.acceptorsMtx.RLock()
+	acceptors := make([]ChannelAcceptor, 0, len(c.acceptors))
+	for _, acceptor := range c.acceptors {
+		acceptors = append(acceptors, acceptor)
+	}
+	c.acceptorsMtx.RUnlock()
+
+	for _, acceptor := range acceptors {
+		if err := acceptor.Accept(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A compile-time constraint to ensure ChainedAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -0,0 +1,56 @@
+package chanacceptor
+
+import (
+	"testing"
+)
+
+// mockAcceptor is a ChannelAcceptor that returns a fixed result, and records
+// whether it was called.
+type mockAcceptor struct {
+	err    error
+	called bool
+}
+
+// Accept returns the mock's fixed result.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (m *mockAcceptor) Accept(req *ChannelAcceptRequest) error {
+	m.called = true
+	return m.err
+}
+
+// TestChainedAcceptor tests that the ChainedAcceptor only accepts a channel
+// if all of its acceptors do, and that acceptors can be removed again.
+func TestChainedAcceptor(t *testing.T) {
+	t.Parallel()
+
+	chained := NewChainedAcceptor()
+	req := &ChannelAcceptRequest{}
+
+	// Without any acceptors, every channel is accepted.
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+
+	accept := &mockAcceptor{}
+	chained.AddAcceptor(accept)
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+	if !accept.called {
+		t.Fatalf("expected acceptor to be called")
+	}
+
+	// Once an acceptor that rejects the channel is added, the channel is
+	// rejected with its error.
+	rejectErr := &RejectError{Reason: "unknown node"}
+	rejectID := chained.AddAcceptor(&mockAcceptor{err: rejectErr})
+	if err := chained.Accept(req); err != rejectErr {
+		t.Fatalf("expected %v, got %v", rejectErr, err)
+	}
+
+	chained.RemoveAcceptor(rejectID)
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package chanacceptor
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptRequest is a struct containing the requesting node's public
+// key along with the lnwire.OpenChannel message that they sent when
+// requesting an inbound channel. This information is provided to each
+// acceptor so that they can each leverage their own decision-making with
+// this information.
+type ChannelAcceptRequest struct {
+	// Node is the public key of the node requesting to open a channel.
+	Node *btcec.PublicKey
+
+	// OpenChanMsg is the actual OpenChannel protocol message that the
+	// peer sent to us.
+	OpenChanMsg *lnwire.OpenChannel
+}
+
+// RejectError is returned by a ChannelAcceptor that rejects an inbound
+// channel. The reason is sent to the peer that requested the channel, so it
+// must not contain any sensitive information.
+type RejectError struct {
+	// Reason is the human readable reason the channel was rejected for.
+	Reason string
+}
+
+// Error returns the reason the channel was rejected.
+//
+// NOTE: Part of the error interface.
+func (e *RejectError) Error() string {
+	if e.Reason == "" {
+		return "channel rejected"
+	}
+
+	return "channel rejected: " + e.Reason
+}
+
+// ChannelAcceptor is an interface that represents a predicate on the data
+// contained in ChannelAcceptRequest.
+type ChannelAcceptor interface {
+	// Accept returns nil if the channel should be accepted, and a
+	// RejectError otherwise. Implementations may block, so they must
+	// bound the time it takes to reach a decision.
+	Accept(req *ChannelAcceptRequest) error
+}
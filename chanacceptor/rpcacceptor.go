@@ -0,0 +1,149 @@
+package chanacceptor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+var (
+	// errShuttingDown is returned when the acceptor is shut down while
+	// waiting for a response.
+	errShuttingDown = errors.New("channel acceptor shutting down")
+)
+
+// RPCAcceptor represents the RPC-controlled variant of the ChannelAcceptor.
+// Each inbound channel request is sent to an RPC client, which responds
+// asynchronously with its decision.
+type RPCAcceptor struct {
+	// send is used to send a channel request to the RPC client.
+	send func(*lnrpc.ChannelAcceptRequest) error
+
+	// timeout is the amount of time we'll wait for the RPC client to
+	// respond before rejecting the channel.
+	timeout time.Duration
+
+	// responses maps the pending channel ID of each request that's
+	// awaiting a response to the channel the response is delivered on.
+	responses    map[[32]byte]chan *lnrpc.ChannelAcceptResponse
+	responsesMtx sync.Mutex
+
+	// sendMtx makes sure only a single request is sent to the client at
+	// a time.
+	sendMtx sync.Mutex
+
+	quit chan struct{}
+}
+
+// NewRPCAcceptor creates and returns an instance of the RPCAcceptor. Requests
+// are sent to the client using the send closure. Channels that aren't
+// accepted before the timeout expires, or before the quit channel is closed,
+// are rejected.
+func NewRPCAcceptor(send func(*lnrpc.ChannelAcceptRequest) error,
+	timeout time.Duration, quit chan struct{}) *RPCAcceptor {
+
+	return &RPCAcceptor{
+		send:      send,
+		timeout:   timeout,
+		responses: make(map[[32]byte]chan *lnrpc.ChannelAcceptResponse),
+		quit:      quit,
+	}
+}
+
+// Accept sends the channel request to the RPC client and waits for its
+// response. The channel is rejected if the client doesn't respond in time.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) error {
+	msg := req.OpenChanMsg
+	pendingChanID := msg.PendingChannelID
+
+	// We register for the response before sending the request, so we
+	// can't miss it. The funding manager makes sure only a single request
+	// per pending channel ID is awaiting a decision.
+	respChan := make(chan *lnrpc.ChannelAcceptResponse, 1)
+
+	r.responsesMtx.Lock()
+	r.responses[pendingChanID] = respChan
+	r.responsesMtx.Unlock()
+
+	defer func() {
+		r.responsesMtx.Lock()
+		delete(r.responses, pendingChanID)
+		r.responsesMtx.Unlock()
+	}()
+
+	rpcReq := &lnrpc.ChannelAcceptRequest{
+		NodePubkey:       req.Node.SerializeCompressed(),
+		ChainHash:        msg.ChainHash[:],
+		PendingChanId:    pendingChanID[:],
+		FundingAmt:       uint64(msg.FundingAmount),
+		PushAmt:          uint64(msg.PushAmount),
+		DustLimit:        uint64(msg.DustLimit),
+		MaxValueInFlight: uint64(msg.MaxValueInFlight),
+		ChannelReserve:   uint64(msg.ChannelReserve),
+		MinHtlc:          uint64(msg.HtlcMinimum),
+		FeePerKw:         uint64(msg.FeePerKiloWeight),
+		CsvDelay:         uint32(msg.CsvDelay),
+		MaxAcceptedHtlcs: uint32(msg.MaxAcceptedHTLCs),
+		ChannelFlags:     uint32(msg.ChannelFlags),
+	}
+
+	r.sendMtx.Lock()
+	err := r.send(rpcReq)
+	r.sendMtx.Unlock()
+	if err != nil {
+		return fmt.Errorf("unable to send channel request: %v", err)
+	}
+
+	select {
+	case resp := <-respChan:
+		if resp.Accept {
+			return nil
+		}
+		return &RejectError{Reason: resp.Error}
+
+	case <-time.After(r.timeout):
+		return &RejectError{Reason: "timed out"}
+
+	case <-r.quit:
+		return errShuttingDown
+	}
+}
+
+// HandleResponse delivers a response of the RPC client to the request it
+// answers. An error is returned if no request with the response's pending
+// channel ID is awaiting a response.
+func (r *RPCAcceptor) HandleResponse(resp *lnrpc.ChannelAcceptResponse) error {
+	var pendingChanID [32]byte
+	if len(resp.PendingChanId) != len(pendingChanID) {
+		return fmt.Errorf("invalid pending channel ID length %v",
+			len(resp.PendingChanId))
+	}
+	copy(pendingChanID[:], resp.PendingChanId)
+
+	r.responsesMtx.Lock()
+	respChan, ok := r.responses[pendingChanID]
+	if ok {
+		// The response is only delivered once, so a second response
+		// for the same request can't block us.
+		delete(r.responses, pendingChanID)
+	}
+	r.responsesMtx.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no channel request with pending channel "+
+			"ID %x", pendingChanID[:])
+	}
+
+	respChan <- resp
+
+	return nil
+}
+
+// A compile-time constraint to ensure RPCAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*RPCAcceptor)(nil)
@@ -0,0 +1,150 @@
+package chanacceptor
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// newTestRequest creates a channel request with the given pending channel ID
+// from a random node.
+func newTestRequest(t *testing.T, pendingChanID byte) *ChannelAcceptRequest {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+
+	return &ChannelAcceptRequest{
+		Node: priv.PubKey(),
+		OpenChanMsg: &lnwire.OpenChannel{
+			PendingChannelID: [32]byte{pendingChanID},
+			FundingAmount:    100000,
+			PushAmount:       1000,
+			CsvDelay:         144,
+		},
+	}
+}
+
+// TestRPCAcceptor tests that the RPCAcceptor sends each channel request to
+// the client, and accepts or rejects the channel according to its response.
+func TestRPCAcceptor(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan *lnrpc.ChannelAcceptRequest, 1)
+	send := func(req *lnrpc.ChannelAcceptRequest) error {
+		requests <- req
+		return nil
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+	acceptor := NewRPCAcceptor(send, time.Minute, quit)
+
+	// accept runs the acceptor for the request in the background, and
+	// returns the request that was sent to the client.
+	accept := func(req *ChannelAcceptRequest) (*lnrpc.ChannelAcceptRequest,
+		chan error) {
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- acceptor.Accept(req)
+		}()
+
+		select {
+		case rpcReq := <-requests:
+			return rpcReq, errChan
+		case <-time.After(5 * time.Second):
+			t.Fatalf("request not sent")
+		}
+		return nil, nil
+	}
+
+	assertResult := func(errChan chan error, expectedErr string) {
+		t.Helper()
+
+		select {
+		case err := <-errChan:
+			switch {
+			case expectedErr == "" && err != nil:
+				t.Fatalf("expected channel to be accepted, "+
+					"got %v", err)
+			case expectedErr != "" && err == nil:
+				t.Fatalf("expected channel to be rejected")
+			case expectedErr != "" && err.Error() != expectedErr:
+				t.Fatalf("expected error %v, got %v",
+					expectedErr, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no result")
+		}
+	}
+
+	// The request sent to the client should carry the details of the
+	// channel, and a positive response accepts it.
+	req := newTestRequest(t, 1)
+	rpcReq, errChan := accept(req)
+	if !bytes.Equal(rpcReq.NodePubkey, req.Node.SerializeCompressed()) {
+		t.Fatalf("expected node %x, got %x",
+			req.Node.SerializeCompressed(), rpcReq.NodePubkey)
+	}
+	if rpcReq.FundingAmt != 100000 || rpcReq.PushAmt != 1000 ||
+		rpcReq.CsvDelay != 144 {
+
+		t.Fatalf("unexpected request: %v", rpcReq)
+	}
+
+	err := acceptor.HandleResponse(&lnrpc.ChannelAcceptResponse{
+		Accept:        true,
+		PendingChanId: rpcReq.PendingChanId,
+	})
+	if err != nil {
+		t.Fatalf("unable to handle response: %v", err)
+	}
+	assertResult(errChan, "")
+
+	// The request has been answered, so another response for it can't be
+	// handled.
+	err = acceptor.HandleResponse(&lnrpc.ChannelAcceptResponse{
+		Accept:        true,
+		PendingChanId: rpcReq.PendingChanId,
+	})
+	if err == nil {
+		t.Fatalf("expected response for unknown request to fail")
+	}
+
+	// A rejection should carry the reason given by the client.
+	rpcReq, errChan = accept(newTestRequest(t, 2))
+	err = acceptor.HandleResponse(&lnrpc.ChannelAcceptResponse{
+		PendingChanId: rpcReq.PendingChanId,
+		Error:         "kyc required",
+	})
+	if err != nil {
+		t.Fatalf("unable to handle response: %v", err)
+	}
+	assertResult(errChan, "channel rejected: kyc required")
+}
+
+// TestRPCAcceptorTimeout tests that a channel is rejected if the client
+// doesn't respond in time.
+func TestRPCAcceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	send := func(req *lnrpc.ChannelAcceptRequest) error {
+		return nil
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+	acceptor := NewRPCAcceptor(send, 10*time.Millisecond, quit)
+
+	err := acceptor.Accept(newTestRequest(t, 1))
+	if _, ok := err.(*RejectError); !ok {
+		t.Fatalf("expected RejectError, got %v", err)
+	}
+}
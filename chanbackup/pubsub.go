@@ -0,0 +1,215 @@
+package chanbackup
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// Swapper is an interface that allows the chanbackup.SubSwapper to update the
+// main multi backup location once it learns of new channels or that prior
+// channels have been closed.
+type Swapper interface {
+	// UpdateAndSwap attempts to atomically update the main multi back up
+	// file location with the new fully packed multi-channel backup.
+	UpdateAndSwap(newBackup PackedMulti) error
+}
+
+// ChannelEvent houses the new channels that have been opened, and the set of
+// channel points that have been closed since the last event. The SubSwapper
+// only uses these as a trigger to re-assemble the backup, so they're
+// primarily used for logging purposes.
+type ChannelEvent struct {
+	// ClosedChans are the set of channels that have been closed since the
+	// last event.
+	ClosedChans []wire.OutPoint
+
+	// NewChans is the set of channels that have been opened since the
+	// last event.
+	NewChans []wire.OutPoint
+}
+
+// ChannelSubscription represents an intent to be notified of any updates to
+// the primary channel state.
+type ChannelSubscription struct {
+	// ChanUpdates is a read-only channel that will be sent upon once the
+	// primary channel state is updated.
+	ChanUpdates <-chan ChannelEvent
+
+	// Cancel is a closure that allows the caller to cancel their
+	// subscription and free up any resources allocated.
+	Cancel func()
+}
+
+// ChannelNotifier represents a system that allows the chanbackup.SubSwapper to
+// be notified of any changes to the primary channel state.
+type ChannelNotifier interface {
+	// SubscribeChans requests a new subscription to be notified of all
+	// channels that are opened or closed from now on.
+	SubscribeChans() (*ChannelSubscription, error)
+}
+
+// SubSwapper subscribes to new updates to the open channel state, and then
+// swaps out the on-disk channel backup state in response. This sub-system
+// will ensure that the multi chan backup file on disk will always be
+// updated with the latest channel back up state. We'll receive new
+// opened/closed channels from the ChannelNotifier, then use the Swapper to
+// update the file state on disk with the new set of open channels. This can
+// be used to implement a system that always keeps the multi-chan backup file
+// on disk in a consistent state for safety purposes.
+type SubSwapper struct {
+	started uint32
+	stopped uint32
+
+	// chanNotifier is an interface that will notify us of any channels
+	// that have been opened or closed.
+	chanNotifier ChannelNotifier
+
+	// chanSource is the source we'll use to fetch the latest set of live
+	// channels whenever we're notified of a change.
+	chanSource LiveChannelSource
+
+	// keyRing is the main key ring that will allow us to pack the new
+	// multi backup.
+	keyRing keychain.KeyRing
+
+	// swapper is used to atomically replace the on-disk backup with a
+	// freshly packed one.
+	swapper Swapper
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSubSwapper creates a new instance of the SubSwapper given the passed
+// channel source, chan notifier, key ring, and swapper.
+func NewSubSwapper(chanSource LiveChannelSource, chanNotifier ChannelNotifier,
+	keyRing keychain.KeyRing, backupSwapper Swapper) *SubSwapper {
+
+	return &SubSwapper{
+		chanNotifier: chanNotifier,
+		chanSource:   chanSource,
+		keyRing:      keyRing,
+		swapper:      backupSwapper,
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the chanbackup.SubSwapper.
+func (s *SubSwapper) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting chanbackup.SubSwapper")
+
+	// We'll subscribe to channel updates before writing out the initial
+	// backup, so we can't miss an update that happens in between.
+	chanEvents, err := s.chanNotifier.SubscribeChans()
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to chan "+
+			"events: %v", err)
+	}
+
+	// Before we enter our main loop, we'll update the on-disk state with
+	// the latest Single state, as nodes may have new advertised addresses.
+	if err := s.updateBackupFile(); err != nil {
+		chanEvents.Cancel()
+		return fmt.Errorf("unable to refresh backup file: %v", err)
+	}
+
+	s.wg.Add(1)
+	go s.backupUpdater(chanEvents)
+
+	return nil
+}
+
+// Stop signals the SubSwapper to begin a graceful shutdown.
+func (s *SubSwapper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping chanbackup.SubSwapper")
+
+	close(s.quit)
+	s.wg.Wait()
+
+	return nil
+}
+
+// updateBackupFile fetches the latest set of live channels from the channel
+// source, then packs and swaps in a new multi backup reflecting them.
+func (s *SubSwapper) updateBackupFile() error {
+	backups, err := FetchStaticChanBackups(s.chanSource)
+	if err != nil {
+		return err
+	}
+
+	// With our updated channel state obtained, we'll create a new multi
+	// from our series of singles.
+	newMulti := Multi{
+		StaticBackups: backups,
+	}
+
+	// Now that our multi has been assembled, we'll attempt to pack
+	// (encrypt+encode) the new channel state into a buffer.
+	var b bytes.Buffer
+	if err := newMulti.PackToWriter(&b, s.keyRing); err != nil {
+		return fmt.Errorf("unable to pack multi backup: %v", err)
+	}
+
+	// Finally, we'll swap out the old backup for this new one in a single
+	// atomic step.
+	if err := s.swapper.UpdateAndSwap(PackedMulti(b.Bytes())); err != nil {
+		return fmt.Errorf("unable to update multi backup: %v", err)
+	}
+
+	log.Debugf("Updated backup file, now backing up %v channels",
+		len(backups))
+
+	return nil
+}
+
+// backupUpdater is the primary goroutine of the SubSwapper which is
+// responsible for listening for changes to the channel, and updating the
+// persistent multi backup state with a new packed multi of the latest channel
+// state.
+func (s *SubSwapper) backupUpdater(chanEvents *ChannelSubscription) {
+	defer s.wg.Done()
+	defer chanEvents.Cancel()
+
+	log.Debugf("SubSwapper's backupUpdater is active!")
+
+	for {
+		select {
+		// The channel state has been modified! We'll re-assemble the
+		// backup from the latest set of live channels, and swap it in
+		// for the current one.
+		case chanUpdate, ok := <-chanEvents.ChanUpdates:
+			if !ok {
+				log.Warnf("Channel notifier has exited, " +
+					"backups will no longer be updated")
+				return
+			}
+
+			log.Infof("Updating on-disk multi SCB backup: "+
+				"num_new_chans=%v, num_closed_chans=%v",
+				len(chanUpdate.NewChans),
+				len(chanUpdate.ClosedChans))
+
+			if err := s.updateBackupFile(); err != nil {
+				log.Errorf("unable to update backup file: %v",
+					err)
+			}
+
+		// Exit at once if a quit signal is detected.
+		case <-s.quit:
+			return
+		}
+	}
+}
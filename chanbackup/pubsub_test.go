@@ -0,0 +1,264 @@
+package chanbackup
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// mockChannelSource is a mock implementation of the LiveChannelSource
+// interface backed by an in-memory map of channels.
+type mockChannelSource struct {
+	chans map[wire.OutPoint]*channeldb.OpenChannel
+
+	failQuery bool
+
+	addrs map[[33]byte][]net.Addr
+}
+
+func newMockChannelSource() *mockChannelSource {
+	return &mockChannelSource{
+		chans: make(map[wire.OutPoint]*channeldb.OpenChannel),
+		addrs: make(map[[33]byte][]net.Addr),
+	}
+}
+
+// FetchAllChannels is part of the LiveChannelSource interface.
+func (m *mockChannelSource) FetchAllChannels() ([]*channeldb.OpenChannel,
+	error) {
+
+	if m.failQuery {
+		return nil, fmt.Errorf("fail")
+	}
+
+	chans := make([]*channeldb.OpenChannel, 0, len(m.chans))
+	for _, channel := range m.chans {
+		chans = append(chans, channel)
+	}
+
+	return chans, nil
+}
+
+// FetchChannel is part of the LiveChannelSource interface.
+func (m *mockChannelSource) FetchChannel(
+	chanPoint wire.OutPoint) (*channeldb.OpenChannel, error) {
+
+	if m.failQuery {
+		return nil, fmt.Errorf("fail")
+	}
+
+	channel, ok := m.chans[chanPoint]
+	if !ok {
+		return nil, fmt.Errorf("can't find chan")
+	}
+
+	return channel, nil
+}
+
+// AddrsForNode is part of the LiveChannelSource interface.
+func (m *mockChannelSource) AddrsForNode(
+	nodePub *btcec.PublicKey) ([]net.Addr, error) {
+
+	if m.failQuery {
+		return nil, fmt.Errorf("fail")
+	}
+
+	var nodeKey [33]byte
+	copy(nodeKey[:], nodePub.SerializeCompressed())
+
+	return m.addrs[nodeKey], nil
+}
+
+// mockSwapper is a mock implementation of the Swapper interface that
+// delivers each new backup over a channel.
+type mockSwapper struct {
+	fail bool
+
+	swaps chan PackedMulti
+}
+
+func newMockSwapper() *mockSwapper {
+	return &mockSwapper{
+		swaps: make(chan PackedMulti),
+	}
+}
+
+// UpdateAndSwap is part of the Swapper interface.
+func (m *mockSwapper) UpdateAndSwap(newBackup PackedMulti) error {
+	if m.fail {
+		return fmt.Errorf("fail")
+	}
+
+	m.swaps <- newBackup
+
+	return nil
+}
+
+// mockChannelNotifier is a mock implementation of the ChannelNotifier
+// interface that allows the test to dispatch channel events directly.
+type mockChannelNotifier struct {
+	fail bool
+
+	chanEvents chan ChannelEvent
+}
+
+func newMockChannelNotifier() *mockChannelNotifier {
+	return &mockChannelNotifier{
+		chanEvents: make(chan ChannelEvent),
+	}
+}
+
+// SubscribeChans is part of the ChannelNotifier interface.
+func (m *mockChannelNotifier) SubscribeChans() (*ChannelSubscription, error) {
+	if m.fail {
+		return nil, fmt.Errorf("fail")
+	}
+
+	return &ChannelSubscription{
+		ChanUpdates: m.chanEvents,
+		Cancel: func() {
+		},
+	}, nil
+}
+
+// assertExpectedBackupSwap asserts that the next backup swapped in by the
+// SubSwapper contains exactly the set of channels currently in the source.
+func assertExpectedBackupSwap(t *testing.T, swapper *mockSwapper,
+	keyRing *mockKeyRing, chanSource *mockChannelSource) {
+
+	t.Helper()
+
+	var newPackedMulti PackedMulti
+	select {
+	case newPackedMulti = <-swapper.swaps:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swap never happened")
+	}
+
+	// If we unpack the new multi, then we should find all the old
+	// channels, and also the new channel included and any deleted channel
+	// omitted.
+	newMulti, err := newPackedMulti.Unpack(keyRing)
+	if err != nil {
+		t.Fatalf("unable to unpack multi: %v", err)
+	}
+
+	if len(newMulti.StaticBackups) != len(chanSource.chans) {
+		t.Fatalf("new backup has %v chans, expected %v",
+			len(newMulti.StaticBackups), len(chanSource.chans))
+	}
+	for _, backup := range newMulti.StaticBackups {
+		if _, ok := chanSource.chans[backup.FundingOutpoint]; !ok {
+			t.Fatalf("unexpected chan %v in backup",
+				backup.FundingOutpoint)
+		}
+	}
+}
+
+// TestSubSwapperIdempotentStartStop tests that calling the Start/Stop methods
+// multiple times is permitted.
+func TestSubSwapperIdempotentStartStop(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mockKeyRing{}
+	chanSource := newMockChannelSource()
+	chanNotifier := newMockChannelNotifier()
+	swapper := newMockSwapper()
+
+	subSwapper := NewSubSwapper(chanSource, chanNotifier, keyRing, swapper)
+
+	go func() {
+		<-swapper.swaps
+	}()
+
+	if err := subSwapper.Start(); err != nil {
+		t.Fatalf("unable to start swapper: %v", err)
+	}
+	if err := subSwapper.Start(); err != nil {
+		t.Fatalf("unable to start swapper: %v", err)
+	}
+
+	subSwapper.Stop()
+	subSwapper.Stop()
+}
+
+// TestSubSwapperUpdater tests that the SubSwapper will properly swap out
+// new/old channels within the channel set, and notify the swapper to update
+// the master multi file backup.
+func TestSubSwapperUpdater(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mockKeyRing{}
+	chanNotifier := newMockChannelNotifier()
+	swapper := newMockSwapper()
+
+	// First, we'll start out by creating a channels set for the source
+	// with 5 channels.
+	chanSource := newMockChannelSource()
+	for i := 0; i < 5; i++ {
+		channel, err := genRandomOpenChannelShell()
+		if err != nil {
+			t.Fatalf("unable to make test chan: %v", err)
+		}
+
+		chanSource.chans[channel.FundingOutpoint] = channel
+	}
+
+	// With our channel set created, we'll make a fresh sub swapper
+	// instance to begin our test.
+	subSwapper := NewSubSwapper(chanSource, chanNotifier, keyRing, swapper)
+
+	// Upon start up, the swapper should write out a backup containing
+	// the initial set of channels.
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- subSwapper.Start()
+	}()
+	assertExpectedBackupSwap(t, swapper, keyRing, chanSource)
+	if err := <-startErr; err != nil {
+		t.Fatalf("unable to start sub swapper: %v", err)
+	}
+	defer subSwapper.Stop()
+
+	// Now that the sub-swapper is active, we'll notify to add a brand new
+	// channel to the channel state.
+	newChannel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to create new chan: %v", err)
+	}
+	chanSource.chans[newChannel.FundingOutpoint] = newChannel
+
+	// With the new channel created, we'll send a new update to the main
+	// goroutine telling it about this new channel.
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		NewChans: []wire.OutPoint{newChannel.FundingOutpoint},
+	}:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read new channel")
+	}
+
+	// The swapper should now write out a backup that includes the new
+	// channel.
+	assertExpectedBackupSwap(t, swapper, keyRing, chanSource)
+
+	// We'll now trigger an update to remove an existing channel.
+	delete(chanSource.chans, newChannel.FundingOutpoint)
+	chanClose := ChannelEvent{
+		ClosedChans: []wire.OutPoint{newChannel.FundingOutpoint},
+	}
+
+	select {
+	case chanNotifier.chanEvents <- chanClose:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read closed channel")
+	}
+
+	// The new backup should no longer include the closed channel.
+	assertExpectedBackupSwap(t, swapper, keyRing, chanSource)
+}
@@ -0,0 +1,345 @@
+package chanbackup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// SingleBackupVersion denotes the version of the single static channel backup.
+// Based on this version, we know how to pack/unpack serialized versions of the
+// backup.
+type SingleBackupVersion byte
+
+const (
+	// DefaultSingleVersion is the default version of the single channel
+	// backup. The serialized version of this static channel backup is
+	// simply: version || length || SCB. Where SCB is the known format of
+	// the version.
+	DefaultSingleVersion SingleBackupVersion = 0
+)
+
+// Single is a static description of an existing channel that can be used for
+// the purposes of backing up. The fields in this struct allow a node to
+// recover the settled funds within a channel in the case of partial or
+// complete data loss. We provide the network address that we last used to
+// connect to the peer as well, in case the node stops advertising the IP on
+// the network for whatever reason.
+type Single struct {
+	// Version is the version that should be observed when attempting to
+	// pack the single backup.
+	Version SingleBackupVersion
+
+	// IsInitiator is true if we were the initiator of the channel, and
+	// false otherwise. We'll need to know this information in order to
+	// properly re-derive the state hint information.
+	IsInitiator bool
+
+	// ChainHash is a hash which represents the blockchain that this
+	// channel will be opened within. This value is typically the genesis
+	// hash. In the case that the original chain went through a contentious
+	// hard-fork, then this value will be tweaked using the unique fork
+	// point on each branch.
+	ChainHash chainhash.Hash
+
+	// FundingOutpoint is the outpoint of the final funding transaction.
+	// This value uniquely and globally identities the channel within the
+	// target blockchain as specified by the chain hash parameter.
+	FundingOutpoint wire.OutPoint
+
+	// ShortChannelID encodes the exact location in the chain in which the
+	// channel was initially confirmed. This includes: the block height,
+	// transaction index, and the output within the target transaction.
+	// If the channel was still pending when the backup was made, this
+	// will be the zero value.
+	ShortChannelID lnwire.ShortChannelID
+
+	// RemoteNodePub is the identity public key of the remote node this
+	// channel has been established with.
+	RemoteNodePub *btcec.PublicKey
+
+	// Addresses is a list of IP address in which either we were able to
+	// reach the node over in the past, OR we received an incoming
+	// authenticated connection for the stored identity public key.
+	Addresses []net.Addr
+
+	// Capacity is the size of the original channel.
+	Capacity btcutil.Amount
+
+	// LocalChanCfg is our local channel configuration. It contains all the
+	// information we need to re-derive the keys we used within the
+	// channel. Only the key locators of each key descriptor are stored,
+	// the public keys themselves are re-derived from our keychain upon
+	// restoration.
+	LocalChanCfg channeldb.ChannelConfig
+
+	// RemoteChanCfg is the remote channel configuration. We store this as
+	// well since we'll need some of their keys to re-derive things like
+	// the state hint obfuscator which will allow us to recognize the state
+	// they broadcast on chain.
+	RemoteChanCfg channeldb.ChannelConfig
+}
+
+// NewSingle creates a new static channel backup based on an existing open
+// channel. We also pass in the set of addresses that we used in the past to
+// connect to the channel peer.
+func NewSingle(channel *channeldb.OpenChannel,
+	nodeAddrs []net.Addr) Single {
+
+	// The short channel ID is only meaningful once the funding
+	// transaction has confirmed.
+	var chanID lnwire.ShortChannelID
+	if !channel.IsPending {
+		chanID = channel.ShortChanID()
+	}
+
+	return Single{
+		Version:         DefaultSingleVersion,
+		IsInitiator:     channel.IsInitiator,
+		ChainHash:       channel.ChainHash,
+		FundingOutpoint: channel.FundingOutpoint,
+		ShortChannelID:  chanID,
+		RemoteNodePub:   channel.IdentityPub,
+		Addresses:       nodeAddrs,
+		Capacity:        channel.Capacity,
+		LocalChanCfg:    channel.LocalChanCfg,
+		RemoteChanCfg:   channel.RemoteChanCfg,
+	}
+}
+
+// locatorOnly strips the public key from the passed key descriptor, leaving
+// only the information required to re-derive it from our keychain.
+func locatorOnly(desc keychain.KeyDescriptor) keychain.KeyDescriptor {
+	return keychain.KeyDescriptor{
+		KeyLocator: desc.KeyLocator,
+	}
+}
+
+// serializeChanConfig serializes the passed channel config into the target
+// writer. If locatorsOnly is true, then the public keys of each key
+// descriptor are omitted.
+func serializeChanConfig(w io.Writer, c *channeldb.ChannelConfig,
+	locatorsOnly bool) error {
+
+	keys := []keychain.KeyDescriptor{
+		c.MultiSigKey, c.RevocationBasePoint, c.PaymentBasePoint,
+		c.DelayBasePoint, c.HtlcBasePoint,
+	}
+	if locatorsOnly {
+		for i := range keys {
+			keys[i] = locatorOnly(keys[i])
+		}
+	}
+
+	err := channeldb.WriteElements(
+		w, c.DustLimit, c.ChanReserve, c.MaxPendingAmount, c.MinHTLC,
+		c.MaxAcceptedHtlcs, c.CsvDelay,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := channeldb.WriteElement(w, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeChanConfig reads a channel config serialized using
+// serializeChanConfig from the passed reader.
+func deserializeChanConfig(r io.Reader, c *channeldb.ChannelConfig) error {
+	return channeldb.ReadElements(
+		r, &c.DustLimit, &c.ChanReserve, &c.MaxPendingAmount,
+		&c.MinHTLC, &c.MaxAcceptedHtlcs, &c.CsvDelay, &c.MultiSigKey,
+		&c.RevocationBasePoint, &c.PaymentBasePoint,
+		&c.DelayBasePoint, &c.HtlcBasePoint,
+	)
+}
+
+// Serialize attempts to write out the serialized version of the target
+// StaticChannelBackup into the passed io.Writer.
+func (s *Single) Serialize(w io.Writer) error {
+	// Check to ensure that we'll only attempt to serialize a version that
+	// we're aware of.
+	switch s.Version {
+	case DefaultSingleVersion:
+	default:
+		return fmt.Errorf("unable to serialize w/ unknown "+
+			"version: %v", s.Version)
+	}
+
+	// We'll first serialize the body of the backup into a temporary
+	// buffer, as we need to know its final length before writing it out.
+	var singleBytes bytes.Buffer
+	if err := channeldb.WriteElements(
+		&singleBytes, s.IsInitiator, s.ChainHash, s.FundingOutpoint,
+		s.ShortChannelID, s.RemoteNodePub, s.Addresses, s.Capacity,
+	); err != nil {
+		return err
+	}
+
+	// Our local keys are always stored as locators only, as we're able to
+	// re-derive them from our keychain. The remote keys on the other hand
+	// must be stored in full.
+	err := serializeChanConfig(&singleBytes, &s.LocalChanCfg, true)
+	if err != nil {
+		return err
+	}
+	err = serializeChanConfig(&singleBytes, &s.RemoteChanCfg, false)
+	if err != nil {
+		return err
+	}
+
+	// With the body of the backup serialized, we'll write it out
+	// prefixed by its version and length. The length prefix ensures
+	// that a reader never consumes more than a single backup.
+	var header [3]byte
+	header[0] = byte(s.Version)
+	binary.BigEndian.PutUint16(header[1:], uint16(singleBytes.Len()))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err = w.Write(singleBytes.Bytes())
+	return err
+}
+
+// PackToWriter is similar to the Serialize method, but takes the operation a
+// step further by encrypting the raw bytes of the static channel back up. For
+// encryption we use the chacha20poly1305 AEAD cipher with a 12 byte nonce and
+// 32-byte key. The key is derived from the passed keychain.KeyRing, so the
+// backup can only be decrypted by a node that holds the same wallet seed.
+func (s *Single) PackToWriter(w io.Writer, keyRing keychain.KeyRing) error {
+	// First, we'll serialize the SCB (StaticChannelBackup) into a
+	// temporary buffer so we can store it in a temporary place before we
+	// go to encrypt the entire thing.
+	var rawBytes bytes.Buffer
+	if err := s.Serialize(&rawBytes); err != nil {
+		return err
+	}
+
+	// Finally, we'll encrypt the raw serialized SCB (using the nonce as
+	// associated data), and write out the ciphertext prepended with the
+	// nonce that we used to the passed io.Writer.
+	return encryptPayloadToWriter(rawBytes, w, keyRing)
+}
+
+// Deserialize attempts to read the raw plaintext serialized SCB from the
+// passed io.Reader. If the method is successful, then the target
+// StaticChannelBackup will be fully populated.
+func (s *Single) Deserialize(r io.Reader) error {
+	// First, we'll need to read the version of this single-back up so we
+	// can know how to unpack each of the SCB.
+	var header [3]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return err
+	}
+
+	s.Version = SingleBackupVersion(header[0])
+
+	switch s.Version {
+	case DefaultSingleVersion:
+	default:
+		return fmt.Errorf("unable to de-serialize w/ unknown "+
+			"version: %v", s.Version)
+	}
+
+	// We'll limit our reads to the stated length of the backup, so a
+	// malformed backup can't cause us to read into the next one.
+	length := binary.BigEndian.Uint16(header[1:])
+	r = io.LimitReader(r, int64(length))
+
+	err := channeldb.ReadElements(
+		r, &s.IsInitiator, &s.ChainHash, &s.FundingOutpoint,
+		&s.ShortChannelID, &s.RemoteNodePub, &s.Addresses, &s.Capacity,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := deserializeChanConfig(r, &s.LocalChanCfg); err != nil {
+		return err
+	}
+
+	return deserializeChanConfig(r, &s.RemoteChanCfg)
+}
+
+// UnpackFromReader is similar to Deserialize method, but it expects the passed
+// io.Reader to contain an encrypted SCB. Refer to the PackToWriter method for
+// details w.r.t the encryption scheme used. If we're unable to decrypt the
+// payload for whatever reason (wrong key, wrong nonce, etc), then this method
+// will return an error.
+func (s *Single) UnpackFromReader(r io.Reader, keyRing keychain.KeyRing) error {
+	plaintext, err := decryptPayloadFromReader(r, keyRing)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll pack the bytes into a reader so we can deserialize
+	// the plaintext bytes of the SCB.
+	backupReader := bytes.NewReader(plaintext)
+	return s.Deserialize(backupReader)
+}
+
+// PackStaticChanBackups accepts a set of existing open channels, and a
+// keychain.KeyRing, and returns a map of outpoints to the serialized+encrypted
+// static channel backups. The passed keyRing should be backed by the users
+// root HD seed in order to ensure full determinism.
+func PackStaticChanBackups(backups []Single,
+	keyRing keychain.KeyRing) (map[wire.OutPoint][]byte, error) {
+
+	packedBackups := make(map[wire.OutPoint][]byte)
+	for _, chanBackup := range backups {
+		chanPoint := chanBackup.FundingOutpoint
+
+		var b bytes.Buffer
+		err := chanBackup.PackToWriter(&b, keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("unable to pack chan backup "+
+				"for %v: %v", chanPoint, err)
+		}
+
+		packedBackups[chanPoint] = b.Bytes()
+	}
+
+	return packedBackups, nil
+}
+
+// PackedSingles represents a series of fully packed SCBs. This may be the
+// combination of a series of individual SCBs in order to batch their
+// unpacking.
+type PackedSingles [][]byte
+
+// Unpack attempts to decrypt the passed set of encrypted SCBs and deserialize
+// each one into a new SCB struct. The passed keyRing should be backed by the
+// same HD seed as was used to encrypt the set of backups in the first place.
+// If we're unable to decrypt any of the back ups, then we'll return an error.
+func (p PackedSingles) Unpack(keyRing keychain.KeyRing) ([]Single, error) {
+	backups := make([]Single, len(p))
+	for i, encryptedBackup := range p {
+		var backup Single
+
+		backupReader := bytes.NewReader(encryptedBackup)
+		err := backup.UnpackFromReader(backupReader, keyRing)
+		if err != nil {
+			return nil, err
+		}
+
+		backups[i] = backup
+	}
+
+	return backups, nil
+}
@@ -0,0 +1,452 @@
+package chanbackup
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	chainHash = chainhash.Hash{
+		0xb7, 0x94, 0x38, 0x5f, 0x2d, 0x1e, 0xf7, 0xab,
+		0x4d, 0x92, 0x73, 0xd1, 0x90, 0x63, 0x81, 0xb4,
+		0x4f, 0x2f, 0x6f, 0x25, 0x18, 0xa3, 0xef, 0xb9,
+		0x64, 0x49, 0x18, 0x83, 0x31, 0x98, 0x47, 0x53,
+	}
+
+	addr1, _ = net.ResolveTCPAddr("tcp", "10.0.0.2:9000")
+	addr2, _ = net.ResolveTCPAddr("tcp", "10.0.0.3:9000")
+
+	errTestKeyRing = errors.New("unable to derive key")
+)
+
+// mockKeyRing is a simple implementation of the keychain.KeyRing interface
+// that hands out keys derived deterministically from a fixed root key.
+type mockKeyRing struct {
+	fail bool
+	root [32]byte
+}
+
+// DeriveNextKey is part of the keychain.KeyRing interface.
+func (m *mockKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return m.DeriveKey(keychain.KeyLocator{Family: keyFam})
+}
+
+// DeriveKey is part of the keychain.KeyRing interface.
+func (m *mockKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	if m.fail {
+		return keychain.KeyDescriptor{}, errTestKeyRing
+	}
+
+	var b bytes.Buffer
+	b.Write(m.root[:])
+	channeldb.WriteElements(&b, uint32(keyLoc.Family), keyLoc.Index)
+	privBytes := chainhash.HashB(b.Bytes())
+
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), privBytes)
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     pub,
+	}, nil
+}
+
+func randPubKey() (*btcec.PublicKey, error) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return priv.PubKey(), nil
+}
+
+func genRandomOpenChannelShell() (*channeldb.OpenChannel, error) {
+	var testPriv [32]byte
+	if _, err := rand.Read(testPriv[:]); err != nil {
+		return nil, err
+	}
+
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), testPriv[:])
+
+	var chanPoint wire.OutPoint
+	if _, err := rand.Read(chanPoint.Hash[:]); err != nil {
+		return nil, err
+	}
+
+	chanPoint.Index = uint32(rand.Intn(math.MaxUint16))
+
+	var chanCfgs [2]channeldb.ChannelConfig
+	for i := 0; i < 2; i++ {
+		var keys [5]keychain.KeyDescriptor
+		for j := range keys {
+			keyPub, err := randPubKey()
+			if err != nil {
+				return nil, err
+			}
+			keys[j] = keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(
+						rand.Intn(math.MaxUint16),
+					),
+					Index: uint32(rand.Intn(math.MaxUint16)),
+				},
+				PubKey: keyPub,
+			}
+		}
+
+		chanCfgs[i] = channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit: btcutil.Amount(
+					rand.Int63n(math.MaxInt32),
+				),
+				ChanReserve: btcutil.Amount(
+					rand.Int63n(math.MaxInt32),
+				),
+				MaxPendingAmount: lnwire.MilliSatoshi(
+					rand.Int63n(math.MaxInt32),
+				),
+				MinHTLC: lnwire.MilliSatoshi(
+					rand.Int63n(math.MaxInt32),
+				),
+				MaxAcceptedHtlcs: uint16(
+					rand.Intn(math.MaxUint16),
+				),
+			},
+			CsvDelay:            uint16(rand.Intn(math.MaxUint16)),
+			MultiSigKey:         keys[0],
+			RevocationBasePoint: keys[1],
+			PaymentBasePoint:    keys[2],
+			DelayBasePoint:      keys[3],
+			HtlcBasePoint:       keys[4],
+		}
+	}
+
+	return &channeldb.OpenChannel{
+		ChainHash:       chainHash,
+		FundingOutpoint: chanPoint,
+		ShortChannelID: lnwire.NewShortChanIDFromInt(
+			uint64(rand.Int63()),
+		),
+		IdentityPub:   pub,
+		IsInitiator:   rand.Int63()%2 == 0,
+		Capacity:      btcutil.Amount(rand.Int63()),
+		LocalChanCfg:  chanCfgs[0],
+		RemoteChanCfg: chanCfgs[1],
+	}, nil
+}
+
+// stripLocalPubKeys returns a copy of the passed backup with the public keys
+// of each of the local key descriptors removed. This mirrors what we expect
+// to find after deserializing a backup, as only the locators are stored.
+func stripLocalPubKeys(s Single) Single {
+	cfg := &s.LocalChanCfg
+	cfg.MultiSigKey = locatorOnly(cfg.MultiSigKey)
+	cfg.RevocationBasePoint = locatorOnly(cfg.RevocationBasePoint)
+	cfg.PaymentBasePoint = locatorOnly(cfg.PaymentBasePoint)
+	cfg.DelayBasePoint = locatorOnly(cfg.DelayBasePoint)
+	cfg.HtlcBasePoint = locatorOnly(cfg.HtlcBasePoint)
+
+	return s
+}
+
+func assertSingleEqual(t *testing.T, a, b Single) {
+	t.Helper()
+
+	if a.Version != b.Version {
+		t.Fatalf("versions don't match: %v vs %v", a.Version,
+			b.Version)
+	}
+	if a.IsInitiator != b.IsInitiator {
+		t.Fatalf("initiators don't match: %v vs %v", a.IsInitiator,
+			b.IsInitiator)
+	}
+	if a.ChainHash != b.ChainHash {
+		t.Fatalf("chainhash doesn't match: %v vs %v", a.ChainHash,
+			b.ChainHash)
+	}
+	if a.FundingOutpoint != b.FundingOutpoint {
+		t.Fatalf("chan point doesn't match: %v vs %v",
+			a.FundingOutpoint, b.FundingOutpoint)
+	}
+	if a.ShortChannelID != b.ShortChannelID {
+		t.Fatalf("chan id doesn't match: %v vs %v",
+			a.ShortChannelID, b.ShortChannelID)
+	}
+	if a.Capacity != b.Capacity {
+		t.Fatalf("capacity doesn't match: %v vs %v",
+			a.Capacity, b.Capacity)
+	}
+	if !a.RemoteNodePub.IsEqual(b.RemoteNodePub) {
+		t.Fatalf("node pubs don't match %x vs %x",
+			a.RemoteNodePub.SerializeCompressed(),
+			b.RemoteNodePub.SerializeCompressed())
+	}
+	if !reflect.DeepEqual(a.LocalChanCfg, b.LocalChanCfg) {
+		t.Fatalf("local chan config doesn't match: %v vs %v",
+			spew.Sdump(a.LocalChanCfg),
+			spew.Sdump(b.LocalChanCfg))
+	}
+	if !reflect.DeepEqual(a.RemoteChanCfg, b.RemoteChanCfg) {
+		t.Fatalf("remote chan config doesn't match: %v vs %v",
+			spew.Sdump(a.RemoteChanCfg),
+			spew.Sdump(b.RemoteChanCfg))
+	}
+	if len(a.Addresses) != len(b.Addresses) {
+		t.Fatalf("expected %v addrs got %v", len(a.Addresses),
+			len(b.Addresses))
+	}
+	for i := 0; i < len(a.Addresses); i++ {
+		if a.Addresses[i].String() != b.Addresses[i].String() {
+			t.Fatalf("addr mismatch: %v vs %v",
+				a.Addresses[i], b.Addresses[i])
+		}
+	}
+}
+
+// TestSinglePackUnpack tests that we're able to unpack a previously packed
+// channel backup.
+func TestSinglePackUnpack(t *testing.T) {
+	t.Parallel()
+
+	// Given our test pub key, we'll create an open channel shell that
+	// contains all the information we need to create a static channel
+	// backup.
+	channel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen open channel: %v", err)
+	}
+
+	singleChanBackup := NewSingle(channel, []net.Addr{addr1, addr2})
+
+	keyRing := &mockKeyRing{}
+
+	versionTestCases := []struct {
+		// version is the pack/unpack version that we should use to
+		// decode/encode the final SCB.
+		version SingleBackupVersion
+
+		// valid tests us if this test case should pass or not.
+		valid bool
+	}{
+		// The default version, should pack/unpack with no problem.
+		{
+			version: DefaultSingleVersion,
+			valid:   true,
+		},
+
+		// A non-default version, atm this should result in a failure.
+		{
+			version: 99,
+			valid:   false,
+		},
+	}
+	for i, versionCase := range versionTestCases {
+		// First, we'll re-assign SCB version to what was indicated in
+		// the test case.
+		singleChanBackup.Version = versionCase.version
+
+		var b bytes.Buffer
+
+		err := singleChanBackup.PackToWriter(&b, keyRing)
+		switch {
+		// If this is a valid test case, and we failed, then we'll
+		// return an error.
+		case err != nil && versionCase.valid:
+			t.Fatalf("#%v, unable to pack single: %v", i, err)
+
+		// If this is an invalid test case, and we passed it, then
+		// we'll return an error.
+		case err == nil && !versionCase.valid:
+			t.Fatalf("#%v got nil error for invalid pack: %v",
+				i, err)
+		}
+
+		// If this is a valid test case, then we'll continue to ensure
+		// we can unpack it, and also that if we mutate the packed
+		// version, then we trigger an error.
+		if versionCase.valid {
+			var unpackedSingle Single
+			err = unpackedSingle.UnpackFromReader(&b, keyRing)
+			if err != nil {
+				t.Fatalf("#%v unable to unpack single: %v",
+					i, err)
+			}
+
+			assertSingleEqual(
+				t, stripLocalPubKeys(singleChanBackup),
+				unpackedSingle,
+			)
+
+			// If this was a valid packing attempt, then we'll test
+			// to ensure that if we mutate the version prepended to
+			// the serialization, then unpacking will fail as well.
+			var rawSingle bytes.Buffer
+			err := unpackedSingle.Serialize(&rawSingle)
+			if err != nil {
+				t.Fatalf("unable to serialize single: %v", err)
+			}
+
+			rawBytes := rawSingle.Bytes()
+			rawBytes[0] ^= 1
+
+			newReader := bytes.NewReader(rawBytes)
+			err = unpackedSingle.Deserialize(newReader)
+			if err == nil {
+				t.Fatalf("#%v unpack with unknown version "+
+					"should have failed", i)
+			}
+		}
+	}
+}
+
+// TestPackedSinglesUnpack tests that we're able to properly unpack a series of
+// packed singles.
+func TestPackedSinglesUnpack(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mockKeyRing{}
+
+	// To start, we'll create 10 new singles, and then assemble their
+	// packed forms into a slice.
+	numSingles := 10
+	packedSingles := make([][]byte, 0, numSingles)
+	unpackedSingles := make([]Single, 0, numSingles)
+	for i := 0; i < numSingles; i++ {
+		channel, err := genRandomOpenChannelShell()
+		if err != nil {
+			t.Fatalf("unable to gen channel: %v", err)
+		}
+
+		single := NewSingle(channel, nil)
+
+		var b bytes.Buffer
+		if err := single.PackToWriter(&b, keyRing); err != nil {
+			t.Fatalf("unable to pack single: %v", err)
+		}
+
+		packedSingles = append(packedSingles, b.Bytes())
+		unpackedSingles = append(unpackedSingles, single)
+	}
+
+	// With all singles packed, we'll create the grouped type and attempt
+	// to Unpack all of them in a single go.
+	freshSingles, err := PackedSingles(packedSingles).Unpack(keyRing)
+	if err != nil {
+		t.Fatalf("unable to unpack singles: %v", err)
+	}
+
+	// The set of freshly unpacked singles should exactly match the initial
+	// set of singles that we packed before.
+	for i := 0; i < len(unpackedSingles); i++ {
+		assertSingleEqual(
+			t, stripLocalPubKeys(unpackedSingles[i]),
+			freshSingles[i],
+		)
+	}
+
+	// If we mutate one of the packed singles, then the entire method
+	// should fail.
+	packedSingles[0][0] ^= 1
+	_, err = PackedSingles(packedSingles).Unpack(keyRing)
+	if err == nil {
+		t.Fatalf("unpack attempt should fail")
+	}
+}
+
+// TestSinglePackStaticChanBackups tests that we're able to batch pack a set of
+// Singles, and then unpack them obtaining the same set of unpacked singles.
+func TestSinglePackStaticChanBackups(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mockKeyRing{}
+
+	// First, we'll create a set of random singles, and along the way,
+	// create a map that will let us look up each single by its chan point.
+	numSingles := 10
+	singleMap := make(map[wire.OutPoint]Single, numSingles)
+	unpackedSingles := make([]Single, 0, numSingles)
+	for i := 0; i < numSingles; i++ {
+		channel, err := genRandomOpenChannelShell()
+		if err != nil {
+			t.Fatalf("unable to gen channel: %v", err)
+		}
+
+		single := NewSingle(channel, nil)
+
+		singleMap[channel.FundingOutpoint] = single
+		unpackedSingles = append(unpackedSingles, single)
+	}
+
+	// Now that all of our singles are created, we'll attempt to pack them
+	// all in a single batch.
+	packedSingleMap, err := PackStaticChanBackups(unpackedSingles, keyRing)
+	if err != nil {
+		t.Fatalf("unable to pack backups: %v", err)
+	}
+
+	// With our packed singles obtained, we'll ensure that each of them
+	// match their unpacked counterparts after they themselves have been
+	// unpacked.
+	for chanPoint, single := range singleMap {
+		packedSingles, ok := packedSingleMap[chanPoint]
+		if !ok {
+			t.Fatalf("unable to find single %v", chanPoint)
+		}
+
+		var freshSingle Single
+		err := freshSingle.UnpackFromReader(
+			bytes.NewReader(packedSingles), keyRing,
+		)
+		if err != nil {
+			t.Fatalf("unable to unpack single: %v", err)
+		}
+
+		assertSingleEqual(t, stripLocalPubKeys(single), freshSingle)
+	}
+
+	// If we attempt to pack again, but force the key ring to fail, then
+	// the entire method should fail.
+	_, err = PackStaticChanBackups(
+		unpackedSingles, &mockKeyRing{fail: true},
+	)
+	if err == nil {
+		t.Fatalf("pack attempt should fail")
+	}
+}
+
+// TestSingleUnconfirmedChannel tests that a backup created for a channel that
+// is still pending doesn't carry a short channel ID.
+func TestSingleUnconfirmedChannel(t *testing.T) {
+	t.Parallel()
+
+	channel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen open channel: %v", err)
+	}
+	channel.IsPending = true
+
+	// A pending channel has no meaningful short channel ID, so we expect
+	// the backup to carry the zero value instead.
+	single := NewSingle(channel, nil)
+	if single.ShortChannelID != (lnwire.ShortChannelID{}) {
+		t.Fatalf("expected zero short chan id for pending channel, "+
+			"got %v", single.ShortChannelID)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -75,13 +76,24 @@ type chanCloseCfg struct {
 	// further HTLC's should be routed through the channel.
 	unregisterChannel func(lnwire.ChannelID)
 
-	// broadcastTx broadcasts the passed transaction to the network.
-	broadcastTx func(*wire.MsgTx) error
+	// broadcastTx broadcasts the passed transaction to the network,
+	// labeling it with the passed label.
+	broadcastTx func(*wire.MsgTx, string) error
 
 	// disableChannel disables a channel, resulting in it not being able to
 	// forward payments.
 	disableChannel func(wire.OutPoint) error
 
+	// replaceable indicates whether the close transaction signals
+	// replaceability, which is only the case if both parties support
+	// re-negotiating the closing fee.
+	replaceable bool
+
+	// prevCloseInfo describes the close transaction that was previously
+	// broadcast for the channel. It is only set if we're re-negotiating
+	// the closing fee.
+	prevCloseInfo *channeldb.CoopCloseInfo
+
 	// quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	quit chan struct{}
@@ -421,6 +433,7 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 		closeTx, _, err := c.cfg.channel.CompleteCooperativeClose(
 			localSig, remoteSig, c.localDeliveryScript,
 			c.remoteDeliveryScript, remoteProposedFee,
+			c.closeOpts()...,
 		)
 		if err != nil {
 			return nil, false, err
@@ -428,15 +441,32 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 		c.closingTx = closeTx
 
 		// With the closing transaction crafted, we'll now broadcast it
-		// to the network.
+		// to the network. If we're re-negotiating the closing fee, it
+		// replaces the previous close transaction.
 		peerLog.Infof("Broadcasting cooperative close tx: %v",
 			newLogClosure(func() string {
 				return spew.Sdump(closeTx)
 			}))
-		if err := c.cfg.broadcastTx(closeTx); err != nil {
+		label := lnwallet.CoopCloseTxLabel(c.chanPoint)
+		if err := c.cfg.broadcastTx(closeTx, label); err != nil {
 			return nil, false, err
 		}
-		if c.cfg.channel.MarkCommitmentBroadcasted(); err != nil {
+
+		closeInfo := &channeldb.CoopCloseInfo{
+			CloseTx:             closeTx,
+			LocalDeliveryScript: c.localDeliveryScript,
+			BroadcastHeight:     c.negotiationHeight,
+		}
+		if c.closeReq != nil {
+			closeInfo.BumpAfterBlocks = c.closeReq.BumpAfterBlocks
+		}
+		if prev := c.cfg.prevCloseInfo; prev != nil {
+			closeInfo.NumRenegotiations = prev.NumRenegotiations + 1
+			if closeInfo.BumpAfterBlocks == 0 {
+				closeInfo.BumpAfterBlocks = prev.BumpAfterBlocks
+			}
+		}
+		if err := c.cfg.channel.MarkCoopBroadcasted(closeInfo); err != nil {
 			return nil, false, err
 		}
 
@@ -486,6 +516,7 @@ func (c *channelCloser) proposeCloseSigned(fee btcutil.Amount) (*lnwire.ClosingS
 
 	rawSig, _, _, err := c.cfg.channel.CreateCloseProposal(
 		fee, c.localDeliveryScript, c.remoteDeliveryScript,
+		c.closeOpts()...,
 	)
 	if err != nil {
 		return nil, err
@@ -515,6 +546,15 @@ func (c *channelCloser) proposeCloseSigned(fee btcutil.Amount) (*lnwire.ClosingS
 	return closeSignedMsg, nil
 }
 
+// closeOpts returns the options used to create the close transaction.
+func (c *channelCloser) closeOpts() []lnwallet.CoopCloseOption {
+	if !c.cfg.replaceable {
+		return nil
+	}
+
+	return []lnwallet.CoopCloseOption{lnwallet.ReplaceableCoopClose()}
+}
+
 // feeInAcceptableRange returns true if the passed remote fee is deemed to be
 // in an "acceptable" range to our local fee. This is an attempt at a
 // compromise and to ensure that the fee negotiation has a stopping point. We
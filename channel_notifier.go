@@ -0,0 +1,159 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chanbackup"
+)
+
+// channelNotifier is a simple fan-out notifier that dispatches channel open
+// and close events to all active subscribers. It implements the
+// chanbackup.ChannelNotifier interface, allowing the static channel backup
+// sub-system to keep the on-disk backup file up to date.
+type channelNotifier struct {
+	mu         sync.Mutex
+	clients    map[uint64]*chanEventClient
+	nextClient uint64
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// chanEventClient is a single subscriber of the channelNotifier. Events are
+// accumulated into a pending ChannelEvent and delivered by a dedicated
+// goroutine, ensuring that a slow client never blocks the notifier itself.
+type chanEventClient struct {
+	mu      sync.Mutex
+	pending *chanbackup.ChannelEvent
+
+	signal  chan struct{}
+	updates chan chanbackup.ChannelEvent
+
+	cancel chan struct{}
+}
+
+// newChannelNotifier creates a new channelNotifier with no active clients.
+func newChannelNotifier() *channelNotifier {
+	return &channelNotifier{
+		clients: make(map[uint64]*chanEventClient),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Stop signals all active clients to exit, and waits for their dispatch
+// goroutines to finish.
+func (c *channelNotifier) Stop() {
+	close(c.quit)
+	c.wg.Wait()
+}
+
+// SubscribeChans returns a new subscription that will be notified of all
+// channels opened or closed from now on.
+//
+// NOTE: Part of the chanbackup.ChannelNotifier interface.
+func (c *channelNotifier) SubscribeChans() (*chanbackup.ChannelSubscription,
+	error) {
+
+	client := &chanEventClient{
+		signal:  make(chan struct{}, 1),
+		updates: make(chan chanbackup.ChannelEvent),
+		cancel:  make(chan struct{}),
+	}
+
+	c.mu.Lock()
+	clientID := c.nextClient
+	c.nextClient++
+	c.clients[clientID] = client
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go c.deliverEvents(client)
+
+	var cancelOnce sync.Once
+	return &chanbackup.ChannelSubscription{
+		ChanUpdates: client.updates,
+		Cancel: func() {
+			cancelOnce.Do(func() {
+				c.mu.Lock()
+				delete(c.clients, clientID)
+				c.mu.Unlock()
+
+				close(client.cancel)
+			})
+		},
+	}, nil
+}
+
+// NotifyOpenChannelEvent notifies all active clients that a new channel
+// identified by the passed outpoint has been opened.
+func (c *channelNotifier) NotifyOpenChannelEvent(chanPoint wire.OutPoint) {
+	c.notify(func(event *chanbackup.ChannelEvent) {
+		event.NewChans = append(event.NewChans, chanPoint)
+	})
+}
+
+// NotifyClosedChannelEvent notifies all active clients that the channel
+// identified by the passed outpoint has been closed.
+func (c *channelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
+	c.notify(func(event *chanbackup.ChannelEvent) {
+		event.ClosedChans = append(event.ClosedChans, chanPoint)
+	})
+}
+
+// notify applies the passed update to the pending event of each active client,
+// then wakes up their dispatch goroutines.
+func (c *channelNotifier) notify(update func(*chanbackup.ChannelEvent)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, client := range c.clients {
+		client.mu.Lock()
+		if client.pending == nil {
+			client.pending = &chanbackup.ChannelEvent{}
+		}
+		update(client.pending)
+		client.mu.Unlock()
+
+		select {
+		case client.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// deliverEvents is a goroutine dedicated to a single client which delivers
+// any accumulated events. Events that arrive while the client is busy are
+// merged into a single ChannelEvent.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *channelNotifier) deliverEvents(client *chanEventClient) {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-client.signal:
+		case <-client.cancel:
+			return
+		case <-c.quit:
+			return
+		}
+
+		client.mu.Lock()
+		event := client.pending
+		client.pending = nil
+		client.mu.Unlock()
+
+		if event == nil {
+			continue
+		}
+
+		select {
+		case client.updates <- *event:
+		case <-client.cancel:
+			return
+		case <-c.quit:
+			return
+		}
+	}
+}
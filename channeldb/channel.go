@@ -55,6 +55,22 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// coopCloseInfoKey stores the cooperative close transaction that was
+	// last broadcast for the channel, along with the state needed to bump
+	// its fee.
+	coopCloseInfoKey = []byte("coop-close-info-key")
+
+	// spliceInfoKey stores the splice transaction of a channel that is
+	// waiting for it to confirm, along with the commitments spending its
+	// new funding output.
+	spliceInfoKey = []byte("splice-info-key")
+
+	// fundingTxnKey stores the funding transaction of a channel initiated
+	// by the remote party, if it has been sent to us. The funding
+	// transaction of a channel we initiated is stored within its chan info
+	// instead.
+	fundingTxnKey = []byte("funding-txn-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -99,6 +115,19 @@ var (
 	// ErrNoCommitPoint is returned when no data loss commit point is found
 	// in the database.
 	ErrNoCommitPoint = fmt.Errorf("no commit point found")
+
+	// ErrNoCoopCloseInfo is returned when no cooperative close info is
+	// found for a channel in the database.
+	ErrNoCoopCloseInfo = fmt.Errorf("no coop close info found")
+
+	// ErrNoPendingSplice is returned when no pending splice is found for
+	// a channel in the database.
+	ErrNoPendingSplice = fmt.Errorf("no pending splice found")
+
+	// ErrNoAliasShortChanID is returned when attempting to replace the
+	// alias short channel ID of a channel that doesn't use an alias.
+	ErrNoAliasShortChanID = fmt.Errorf("channel has no alias short " +
+		"channel ID")
 )
 
 // ChannelType is an enum-like type that describes one of several possible
@@ -310,6 +339,17 @@ var (
 	// TODO(halseh): actually enforce that we are not force closing such a
 	// channel.
 	LocalDataLoss ChannelStatus = 1 << 2
+
+	// Restored indicates that the channel was restored from a static
+	// channel backup. Such a channel holds none of the prior channel
+	// state, and can only be used to prompt the remote party into
+	// force closing the channel so we can recover our funds.
+	Restored ChannelStatus = 1 << 3
+
+	// PendingSplice indicates that a splice transaction spending the
+	// funding output of the channel has been signed, and the channel
+	// can't be used until it confirms.
+	PendingSplice ChannelStatus = 1 << 4
 )
 
 // String returns a human-readable representation of the ChannelStatus.
@@ -323,6 +363,10 @@ func (c ChannelStatus) String() string {
 		return "CommitmentBroadcasted"
 	case LocalDataLoss:
 		return "LocalDataLoss"
+	case Restored:
+		return "Restored"
+	case PendingSplice:
+		return "PendingSplice"
 	default:
 		return fmt.Sprintf("Unknown(%08b)", c)
 	}
@@ -487,6 +531,15 @@ func (c *OpenChannel) ChanStatus() ChannelStatus {
 	return c.chanStatus
 }
 
+// HasChanStatus returns true if the internal bitfield channel status of the
+// target channel has the specified status bit set.
+func (c *OpenChannel) HasChanStatus(status ChannelStatus) bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.chanStatus&status == status
+}
+
 // RefreshShortChanID updates the in-memory short channel ID using the latest
 // value observed on disk.
 func (c *OpenChannel) RefreshShortChanID() error {
@@ -640,6 +693,102 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// ConfirmShortChanID replaces the alias short channel ID of an open channel
+// with the passed short channel ID of its confirmed funding output. As
+// forwarding packages are indexed by the short channel ID of the channel
+// they belong to, any packages written under the alias are moved over as
+// well.
+func (c *OpenChannel) ConfirmShortChanID(
+	shortChanID lnwire.ShortChannelID) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		alias := channel.ShortChannelID
+		if !alias.IsAlias() {
+			return ErrNoAliasShortChanID
+		}
+
+		channel.ShortChannelID = shortChanID
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		fwdPkgBkt := tx.Bucket(fwdPackagesKey)
+		if fwdPkgBkt == nil {
+			return nil
+		}
+
+		aliasKey := makeLogKey(alias.ToUint64())
+		aliasBkt := fwdPkgBkt.Bucket(aliasKey[:])
+		if aliasBkt == nil {
+			return nil
+		}
+
+		sourceKey := makeLogKey(shortChanID.ToUint64())
+		sourceBkt, err := fwdPkgBkt.CreateBucketIfNotExists(sourceKey[:])
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(sourceBkt, aliasBkt); err != nil {
+			return err
+		}
+
+		return fwdPkgBkt.DeleteBucket(aliasKey[:])
+	}); err != nil {
+		return err
+	}
+
+	c.ShortChannelID = shortChanID
+	c.Packager = NewChannelPackager(shortChanID)
+
+	return nil
+}
+
+// InsertFundingTxn stores the funding transaction of a channel initiated by
+// the remote party. We only learn about it once the remote party sends it to
+// us, which allows us to watch the transaction for a double spend before it
+// confirms.
+func (c *OpenChannel) InsertFundingTxn(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, fundingTx); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(fundingTxnKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.FundingTxn = fundingTx
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -742,6 +891,427 @@ func (c *OpenChannel) MarkCommitmentBroadcasted() error {
 	return c.putChanStatus(CommitmentBroadcasted)
 }
 
+// CoopCloseInfo describes the cooperative close transaction that was last
+// broadcast for a channel, along with the state needed to bump its fee in case
+// it doesn't confirm in time.
+type CoopCloseInfo struct {
+	// CloseTx is the fully signed cooperative close transaction.
+	CloseTx *wire.MsgTx
+
+	// LocalDeliveryScript is the script that pays out our settled balance
+	// within CloseTx.
+	LocalDeliveryScript []byte
+
+	// BroadcastHeight is the height at which CloseTx was broadcast.
+	BroadcastHeight uint32
+
+	// BumpAfterBlocks is the number of blocks CloseTx may remain
+	// unconfirmed before its fee is bumped. A value of zero indicates
+	// that the default of the node should be used.
+	BumpAfterBlocks uint32
+
+	// NumRenegotiations is the number of times the closing fee has been
+	// re-negotiated with the remote party.
+	NumRenegotiations uint32
+
+	// CPFP is true if we've resorted to bumping the fee of CloseTx by
+	// spending our output in a child transaction. Once this is set, the
+	// closing fee is no longer re-negotiated, as a new close transaction
+	// would invalidate the child.
+	CPFP bool
+}
+
+// MarkCoopBroadcasted marks the channel as having its cooperative close
+// transaction broadcast, and stores the passed close info. It may be called
+// again to replace the info after the fee of the close transaction has been
+// bumped.
+func (c *OpenChannel) MarkCoopBroadcasted(info *CoopCloseInfo) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var status ChannelStatus
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		status = channel.chanStatus | CommitmentBroadcasted
+		channel.chanStatus = status
+
+		var b bytes.Buffer
+		if err := serializeCoopCloseInfo(&b, info); err != nil {
+			return err
+		}
+
+		err = chanBucket.Put(coopCloseInfoKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.chanStatus = status
+
+	return nil
+}
+
+// CoopCloseInfo retrieves the close info stored during MarkCoopBroadcasted. If
+// not found ErrNoCoopCloseInfo is returned.
+func (c *OpenChannel) CoopCloseInfo() (*CoopCloseInfo, error) {
+	var info *CoopCloseInfo
+
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoCoopCloseInfo
+		default:
+			return err
+		}
+
+		bs := chanBucket.Get(coopCloseInfoKey)
+		if bs == nil {
+			return ErrNoCoopCloseInfo
+		}
+
+		info, err = deserializeCoopCloseInfo(bytes.NewReader(bs))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func serializeCoopCloseInfo(w io.Writer, info *CoopCloseInfo) error {
+	return WriteElements(w,
+		info.CloseTx, info.LocalDeliveryScript, info.BroadcastHeight,
+		info.BumpAfterBlocks, info.NumRenegotiations, info.CPFP,
+	)
+}
+
+func deserializeCoopCloseInfo(r io.Reader) (*CoopCloseInfo, error) {
+	info := &CoopCloseInfo{}
+	err := ReadElements(r,
+		&info.CloseTx, &info.LocalDeliveryScript, &info.BroadcastHeight,
+		&info.BumpAfterBlocks, &info.NumRenegotiations, &info.CPFP,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// ChannelSplice describes a splice transaction that spends the funding
+// output of a channel, along with the commitments that become valid once it
+// confirms.
+type ChannelSplice struct {
+	// SpliceTx is the splice transaction. It is only fully signed once
+	// both parties have exchanged their signatures for the input spending
+	// the current funding output.
+	SpliceTx *wire.MsgTx
+
+	// FundingOutpoint is the new funding output created by SpliceTx.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// BroadcastHeight is the height at which SpliceTx was signed.
+	BroadcastHeight uint32
+
+	// LocalCommitment is our commitment spending the new funding output.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the remote party's commitment spending the new
+	// funding output.
+	RemoteCommitment ChannelCommitment
+}
+
+// MarkSplicePending marks the channel as having a pending splice, and stores
+// the passed splice. It may be called again to replace the splice once its
+// transaction has been fully signed.
+func (c *OpenChannel) MarkSplicePending(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var status ChannelStatus
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		status = channel.chanStatus | PendingSplice
+		channel.chanStatus = status
+
+		var b bytes.Buffer
+		if err := serializeChannelSplice(&b, splice); err != nil {
+			return err
+		}
+
+		err = chanBucket.Put(spliceInfoKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.chanStatus = status
+
+	return nil
+}
+
+// PendingSplice retrieves the splice stored during MarkSplicePending. If not
+// found ErrNoPendingSplice is returned.
+func (c *OpenChannel) PendingSplice() (*ChannelSplice, error) {
+	var splice *ChannelSplice
+
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoPendingSplice
+		default:
+			return err
+		}
+
+		bs := chanBucket.Get(spliceInfoKey)
+		if bs == nil {
+			return ErrNoPendingSplice
+		}
+
+		splice, err = deserializeChannelSplice(bytes.NewReader(bs))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// AbortSplice removes the pending splice of the channel, and clears its
+// PendingSplice status. It is to be called once the current funding output
+// has been spent by a transaction other than the splice transaction, as the
+// splice can then never confirm.
+func (c *OpenChannel) AbortSplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var status ChannelStatus
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if chanBucket.Get(spliceInfoKey) == nil {
+			return ErrNoPendingSplice
+		}
+		if err := chanBucket.Delete(spliceInfoKey); err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		status = channel.chanStatus &^ PendingSplice
+		channel.chanStatus = status
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.chanStatus = status
+
+	return nil
+}
+
+// CompleteSplice is to be called once the pending splice transaction of the
+// channel has confirmed at the location described by shortChanID. The
+// channel state is moved to the new funding outpoint, and the commitments
+// spending the new funding output replace the current ones.
+func (c *OpenChannel) CompleteSplice(shortChanID lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var channel *OpenChannel
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		bs := chanBucket.Get(spliceInfoKey)
+		if bs == nil {
+			return ErrNoPendingSplice
+		}
+		splice, err := deserializeChannelSplice(bytes.NewReader(bs))
+		if err != nil {
+			return err
+		}
+
+		// The channel is stored under its funding outpoint, so we'll
+		// copy everything we know about it over to a new bucket keyed
+		// by the outpoint created by the splice transaction.
+		chainBucket := tx.Bucket(openChannelBucket).Bucket(
+			c.IdentityPub.SerializeCompressed(),
+		).Bucket(c.ChainHash[:])
+
+		var oldKey, newKey bytes.Buffer
+		err = writeOutpoint(&oldKey, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+		err = writeOutpoint(&newKey, &splice.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		newChanBucket, err := chainBucket.CreateBucket(newKey.Bytes())
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(newChanBucket, chanBucket); err != nil {
+			return err
+		}
+		if err := newChanBucket.Delete(spliceInfoKey); err != nil {
+			return err
+		}
+
+		channel.FundingOutpoint = splice.FundingOutpoint
+		channel.ShortChannelID = shortChanID
+		channel.Capacity = splice.Capacity
+		channel.FundingBroadcastHeight = splice.BroadcastHeight
+		channel.LocalCommitment = splice.LocalCommitment
+		channel.RemoteCommitment = splice.RemoteCommitment
+		channel.chanStatus &^= PendingSplice
+		if channel.IsInitiator {
+			channel.FundingTxn = splice.SpliceTx
+		}
+
+		if err := putOpenChannel(newChanBucket, channel); err != nil {
+			return err
+		}
+
+		return chainBucket.DeleteBucket(oldKey.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.FundingOutpoint = channel.FundingOutpoint
+	c.ShortChannelID = channel.ShortChannelID
+	c.Capacity = channel.Capacity
+	c.FundingBroadcastHeight = channel.FundingBroadcastHeight
+	c.LocalCommitment = channel.LocalCommitment
+	c.RemoteCommitment = channel.RemoteCommitment
+	c.FundingTxn = channel.FundingTxn
+	c.chanStatus = channel.chanStatus
+	c.Packager = NewChannelPackager(shortChanID)
+
+	return nil
+}
+
+// copyBucket recursively copies all keys and nested buckets of src into dst.
+func copyBucket(dst, src *bolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+
+		dstChild, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return copyBucket(dstChild, src.Bucket(k))
+	})
+}
+
+func serializeChannelSplice(w io.Writer, splice *ChannelSplice) error {
+	err := WriteElements(w,
+		splice.SpliceTx, splice.FundingOutpoint, splice.Capacity,
+		splice.BroadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &splice.LocalCommitment); err != nil {
+		return err
+	}
+
+	return serializeChanCommit(w, &splice.RemoteCommitment)
+}
+
+func deserializeChannelSplice(r io.Reader) (*ChannelSplice, error) {
+	splice := &ChannelSplice{}
+	err := ReadElements(r,
+		&splice.SpliceTx, &splice.FundingOutpoint, &splice.Capacity,
+		&splice.BroadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	splice.LocalCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+	splice.RemoteCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
 func (c *OpenChannel) putChanStatus(status ChannelStatus) error {
 	if err := c.Db.Update(func(tx *bolt.Tx) error {
 		chanBucket, err := fetchChanBucket(
@@ -822,6 +1392,19 @@ func fetchOpenChannel(chanBucket *bolt.Bucket,
 		return nil, fmt.Errorf("unable to fetch chan revocations: %v", err)
 	}
 
+	// The funding transaction of a channel initiated by the remote party
+	// is only known if it has been sent to us.
+	if !channel.IsInitiator {
+		if bs := chanBucket.Get(fundingTxnKey); bs != nil {
+			r := bytes.NewReader(bs)
+			err := ReadElement(r, &channel.FundingTxn)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read funding "+
+					"txn: %v", err)
+			}
+		}
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return channel, nil
@@ -2165,8 +2748,10 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For channels that we initiated, write the funding txn. As the
+	// initiator broadcasts the funding transaction, it's the only party
+	// that's guaranteed to know it in full, even for dual funder channels.
+	if channel.IsInitiator {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2275,8 +2860,8 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For channels that we initiated, read the funding txn.
+	if channel.IsInitiator {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
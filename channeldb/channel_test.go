@@ -16,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -953,3 +954,359 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestConfirmShortChanID asserts that the alias short channel ID of a channel
+// can be replaced by its confirmed short channel ID, taking any forwarding
+// packages written under the alias along.
+func TestConfirmShortChanID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// A channel that isn't using an alias can't have it replaced.
+	if err := state.MarkAsOpen(state.ShortChannelID); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	chanOpenLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	err = state.ConfirmShortChanID(chanOpenLoc)
+	if err != ErrNoAliasShortChanID {
+		t.Fatalf("expected ErrNoAliasShortChanID, got: %v", err)
+	}
+
+	// Mark the channel open under its alias, and write a forwarding
+	// package for it.
+	alias := lnwire.NewAliasShortChanID(state.FundingOutpoint)
+	if err := state.MarkAsOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	fwdPkg := NewFwdPkg(alias, 1, nil, nil)
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		return state.Packager.AddFwdPkg(tx, fwdPkg)
+	})
+	if err != nil {
+		t.Fatalf("unable to add fwd pkg: %v", err)
+	}
+
+	if err := state.ConfirmShortChanID(chanOpenLoc); err != nil {
+		t.Fatalf("unable to confirm short chan id: %v", err)
+	}
+
+	// Both the channel read from disk and the forwarding package should
+	// now use the confirmed short channel ID.
+	channels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	if channels[0].ShortChanID() != chanOpenLoc {
+		t.Fatalf("expected short chan id %v, got %v", chanOpenLoc,
+			channels[0].ShortChanID())
+	}
+
+	fwdPkgs, err := channels[0].LoadFwdPkgs()
+	if err != nil {
+		t.Fatalf("unable to load fwd pkgs: %v", err)
+	}
+	if len(fwdPkgs) != 1 || fwdPkgs[0].Height != 1 {
+		t.Fatalf("expected the fwd pkg at height 1, got %v",
+			spew.Sdump(fwdPkgs))
+	}
+}
+
+// TestInsertFundingTxn asserts that the funding transaction of a channel
+// initiated by the remote party is only known once it has been inserted.
+func TestInsertFundingTxn(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	state.IsInitiator = false
+	state.FundingTxn = nil
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	fetchFundingTxn := func() *wire.MsgTx {
+		channels, err := cdb.FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 channel, got %v", len(channels))
+		}
+		return channels[0].FundingTxn
+	}
+
+	if fundingTx := fetchFundingTxn(); fundingTx != nil {
+		t.Fatalf("expected no funding txn, got %v",
+			spew.Sdump(fundingTx))
+	}
+
+	if err := state.InsertFundingTxn(testTx); err != nil {
+		t.Fatalf("unable to insert funding txn: %v", err)
+	}
+	if state.FundingTxn != testTx {
+		t.Fatalf("funding txn not updated in memory")
+	}
+
+	fundingTx := fetchFundingTxn()
+	if fundingTx == nil || fundingTx.TxHash() != testTx.TxHash() {
+		t.Fatalf("expected funding txn %v, got %v", spew.Sdump(testTx),
+			spew.Sdump(fundingTx))
+	}
+}
+
+// TestCoopCloseInfo asserts that the coop close info stored when marking a
+// channel as coop broadcasted can be retrieved, and that the channel is
+// reported as waiting to be closed.
+func TestCoopCloseInfo(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	if err := state.MarkAsOpen(state.ShortChannelID); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	// Initially, no close info should be found.
+	if _, err := state.CoopCloseInfo(); err != ErrNoCoopCloseInfo {
+		t.Fatalf("expected ErrNoCoopCloseInfo, got: %v", err)
+	}
+
+	info := &CoopCloseInfo{
+		CloseTx:             testTx,
+		LocalDeliveryScript: []byte{0x00, 0x14, 0x01, 0x02},
+		BroadcastHeight:     100,
+		BumpAfterBlocks:     6,
+		NumRenegotiations:   1,
+		CPFP:                true,
+	}
+	if err := state.MarkCoopBroadcasted(info); err != nil {
+		t.Fatalf("unable to mark coop broadcasted: %v", err)
+	}
+	if !state.HasChanStatus(CommitmentBroadcasted) {
+		t.Fatalf("expected channel to be marked as broadcasted")
+	}
+
+	// The channel should now be reported as waiting close, and the close
+	// info should be retrievable from the channel read from disk.
+	waitingClose, err := cdb.FetchWaitingCloseChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch waiting close channels: %v", err)
+	}
+	if len(waitingClose) != 1 {
+		t.Fatalf("expected 1 waiting close channel, got %v",
+			len(waitingClose))
+	}
+
+	dbInfo, err := waitingClose[0].CoopCloseInfo()
+	if err != nil {
+		t.Fatalf("unable to fetch coop close info: %v", err)
+	}
+	if !reflect.DeepEqual(info, dbInfo) {
+		t.Fatalf("close info mismatch: expected %v, got %v",
+			spew.Sdump(info), spew.Sdump(dbInfo))
+	}
+
+	// Once the channel is closed, the close info should be gone.
+	err = state.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: state.FundingOutpoint,
+		RemotePub: state.IdentityPub,
+		CloseType: CooperativeClose,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	if _, err := state.CoopCloseInfo(); err != ErrNoCoopCloseInfo {
+		t.Fatalf("expected ErrNoCoopCloseInfo, got: %v", err)
+	}
+}
+
+// TestChannelSplice tests that a pending splice can be stored for a channel,
+// and that completing the splice moves the channel over to the new funding
+// outpoint.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	if err := state.MarkAsOpen(state.ShortChannelID); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	// Initially, no splice should be found.
+	if _, err := state.PendingSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+
+	localCommit := state.LocalCommitment
+	localCommit.LocalBalance += 5000000
+	remoteCommit := state.RemoteCommitment
+	remoteCommit.RemoteBalance += 5000000
+	splice := &ChannelSplice{
+		SpliceTx: testTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash:  testTx.TxHash(),
+			Index: 1,
+		},
+		Capacity:         state.Capacity + 5000,
+		BroadcastHeight:  200,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}
+	if err := state.MarkSplicePending(splice); err != nil {
+		t.Fatalf("unable to mark splice pending: %v", err)
+	}
+	if !state.HasChanStatus(PendingSplice) {
+		t.Fatalf("expected channel to be marked as pending splice")
+	}
+
+	// A pending splice doesn't close the channel, so it should still be
+	// reported as open.
+	openChans, err := cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChans) != 1 {
+		t.Fatalf("expected 1 open channel, got %v", len(openChans))
+	}
+
+	dbSplice, err := openChans[0].PendingSplice()
+	if err != nil {
+		t.Fatalf("unable to fetch pending splice: %v", err)
+	}
+	if !reflect.DeepEqual(splice, dbSplice) {
+		t.Fatalf("splice mismatch: expected %v, got %v",
+			spew.Sdump(splice), spew.Sdump(dbSplice))
+	}
+
+	// Aborting the splice should remove it, and leave the channel at its
+	// current funding outpoint.
+	if err := openChans[0].AbortSplice(); err != nil {
+		t.Fatalf("unable to abort splice: %v", err)
+	}
+	if openChans[0].HasChanStatus(PendingSplice) {
+		t.Fatalf("expected pending splice status to be cleared")
+	}
+	if _, err := openChans[0].PendingSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+	if err := openChans[0].AbortSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+	openChans, err = cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if openChans[0].ChanStatus() != Default {
+		t.Fatalf("expected default status, got %v",
+			openChans[0].ChanStatus())
+	}
+
+	if err := openChans[0].MarkSplicePending(splice); err != nil {
+		t.Fatalf("unable to mark splice pending: %v", err)
+	}
+
+	// Once the splice is completed, the channel should only be found
+	// under its new funding outpoint, with the commitments of the splice.
+	shortChanID := lnwire.ShortChannelID{BlockHeight: 201, TxIndex: 3}
+	if err := openChans[0].CompleteSplice(shortChanID); err != nil {
+		t.Fatalf("unable to complete splice: %v", err)
+	}
+
+	openChans, err = cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChans) != 1 {
+		t.Fatalf("expected 1 open channel, got %v", len(openChans))
+	}
+	dbChan := openChans[0]
+
+	if dbChan.FundingOutpoint != splice.FundingOutpoint {
+		t.Fatalf("expected funding outpoint %v, got %v",
+			splice.FundingOutpoint, dbChan.FundingOutpoint)
+	}
+	if dbChan.ShortChanID() != shortChanID {
+		t.Fatalf("expected short chan id %v, got %v", shortChanID,
+			dbChan.ShortChanID())
+	}
+	if dbChan.Capacity != splice.Capacity {
+		t.Fatalf("expected capacity %v, got %v", splice.Capacity,
+			dbChan.Capacity)
+	}
+	if dbChan.ChanStatus() != Default {
+		t.Fatalf("expected default status, got %v",
+			dbChan.ChanStatus())
+	}
+	assertCommitmentEqual(t, &localCommit, &dbChan.LocalCommitment)
+	assertCommitmentEqual(t, &remoteCommit, &dbChan.RemoteCommitment)
+
+	if _, err := dbChan.PendingSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"sync"
@@ -509,9 +510,12 @@ func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 
 					// If the channel is in any other state
 					// than Default, then it means it is
-					// waiting to be closed.
+					// waiting to be closed. A pending
+					// splice doesn't close the channel, so
+					// it is disregarded.
+					status := channel.ChanStatus()
 					channelWaitingClose :=
-						channel.ChanStatus() != Default
+						status&^PendingSplice != Default
 
 					// Only include it if we requested
 					// channels with the same waitingClose
@@ -705,6 +709,79 @@ func (d *DB) PruneLinkNodes() error {
 	})
 }
 
+// ChannelShell is a shell of a channel that is meant to be used for channel
+// recovery purposes. It contains a minimal OpenChannel instance along with
+// addresses for that target node.
+type ChannelShell struct {
+	// NodeAddrs the set of addresses that this node has known to be
+	// reachable at in the past.
+	NodeAddrs []net.Addr
+
+	// Chan is a shell of an OpenChannel, it contains only the items
+	// required to restore the channel on disk.
+	Chan *OpenChannel
+}
+
+// RestoreChannelShells is a method that allows the caller to reconstruct the
+// state of an OpenChannel from the ChannelShell. We'll attempt to write the
+// new channel to disk, and create a LinkNode instance with the passed node
+// addresses. This method is idempotent, so repeated calls with the same set of
+// channel shells won't modify the database after the initial call.
+func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		for _, channelShell := range channelShells {
+			channel := channelShell.Chan
+
+			// When we make a channel, we mark that the channel has
+			// been restored, this will signal to other sub-systems
+			// to not attempt to use the channel as if it was a
+			// regular one.
+			channel.chanStatus |= Restored
+
+			// If a channel with this channel point is already
+			// known to us, then there's nothing to restore.
+			_, err := fetchChanBucket(
+				tx, channel.IdentityPub, &channel.FundingOutpoint,
+				channel.ChainHash,
+			)
+			switch {
+			case err == nil:
+				continue
+
+			case err != ErrNoChanDBExists &&
+				err != ErrNoActiveChannels &&
+				err != ErrChannelNotFound:
+				return err
+			}
+
+			// Otherwise, we'll write out the shell in full, as if
+			// it were a newly created channel.
+			channel.Db = d
+			if err := channel.fullSync(tx); err != nil {
+				return err
+			}
+
+			// Next, we'll create a new LinkNode for this peer, so
+			// we'll attempt to reconnect to it upon restart.
+			nodeInfoBucket, err := tx.CreateBucketIfNotExists(
+				nodeInfoBucket,
+			)
+			if err != nil {
+				return err
+			}
+			linkNode := d.NewLinkNode(
+				wire.MainNet, channel.IdentityPub,
+				channelShell.NodeAddrs...,
+			)
+			if err := putLinkNode(nodeInfoBucket, linkNode); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // syncVersions function is used for safe db version synchronization. It
 // applies migration functions to the current database and recovers the
 // previous state of db if at least one error/panic appeared during migration.
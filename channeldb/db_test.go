@@ -2,6 +2,7 @@ package channeldb
 
 import (
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -33,3 +34,83 @@ func TestOpenWithCreate(t *testing.T) {
 		t.Fatalf("channeldb failed to create data directory")
 	}
 }
+
+// TestRestoreChannelShells tests that we're able to insert a partially
+// populated channel into the database, and that we'll also create a link node
+// for the channel's peer.
+func TestRestoreChannelShells(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// First, we'll make our channel shell, it will only have the minimal
+	// amount of information required for us to initiate the data loss
+	// protection feature.
+	channelShell := &ChannelShell{
+		NodeAddrs: []net.Addr{testAddr},
+	}
+	channelShell.Chan, err = createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// With the channel shell constructed, we'll now insert it into the
+	// database with the restoration method.
+	if err := cdb.RestoreChannelShells(channelShell); err != nil {
+		t.Fatalf("unable to restore channel shell: %v", err)
+	}
+
+	// Now that the channel has been inserted, we'll attempt to query for
+	// it to ensure we can properly locate it via various means.
+	nodeChans, err := cdb.FetchOpenChannels(channelShell.Chan.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable find channel: %v", err)
+	}
+	if len(nodeChans) != 1 {
+		t.Fatalf("expected a single channel, instead found %v",
+			len(nodeChans))
+	}
+
+	// The channel we found should match the shell we inserted, and it
+	// should be marked as restored.
+	restoredChan := nodeChans[0]
+	if restoredChan.FundingOutpoint != channelShell.Chan.FundingOutpoint {
+		t.Fatalf("wrong channel point: expected %v, got %v",
+			channelShell.Chan.FundingOutpoint,
+			restoredChan.FundingOutpoint)
+	}
+	if !restoredChan.HasChanStatus(Restored) {
+		t.Fatalf("restored channel should have status %v, instead "+
+			"has %v", Restored, restoredChan.ChanStatus())
+	}
+
+	// Finally, we'll ensure that a link node has been created for the
+	// peer, with the addresses we passed in.
+	linkNode, err := cdb.FetchLinkNode(channelShell.Chan.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch link node: %v", err)
+	}
+	if len(linkNode.Addresses) != 1 ||
+		linkNode.Addresses[0].String() != testAddr.String() {
+
+		t.Fatalf("wrong link node addresses: expected %v, got %v",
+			channelShell.NodeAddrs, linkNode.Addresses)
+	}
+
+	// Restoring the same shell again should be a no-op.
+	if err := cdb.RestoreChannelShells(channelShell); err != nil {
+		t.Fatalf("unable to restore channel shell: %v", err)
+	}
+	nodeChans, err = cdb.FetchOpenChannels(channelShell.Chan.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable find channel: %v", err)
+	}
+	if len(nodeChans) != 1 {
+		t.Fatalf("expected a single channel, instead found %v",
+			len(nodeChans))
+	}
+}
@@ -0,0 +1,171 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// outputLeaseBucket is the name of the bucket that stores all active
+	// output leases, keyed by the outpoint of the leased output.
+	outputLeaseBucket = []byte("output-lease")
+
+	// ErrOutputLeaseNotFound is returned when an output that isn't leased
+	// is attempted to be released.
+	ErrOutputLeaseNotFound = fmt.Errorf("output lease not found")
+
+	// ErrOutputAlreadyLeased is returned when an output is attempted to be
+	// leased while it's still leased under a different lock ID.
+	ErrOutputAlreadyLeased = fmt.Errorf("output already leased")
+
+	// ErrOutputLeaseIDMismatch is returned when an output is attempted to
+	// be released using a lock ID other than the one it was leased under.
+	ErrOutputLeaseIDMismatch = fmt.Errorf("output leased under a " +
+		"different lock id")
+)
+
+// OutputLease is a lease of one of the wallet's outputs, which excludes the
+// output from coin selection until the lease is released, or expires.
+type OutputLease struct {
+	// OutPoint is the outpoint of the leased output.
+	OutPoint wire.OutPoint
+
+	// LockID is the identifier the output was leased under. The output can
+	// only be released, or have its lease extended, using the same ID.
+	LockID [32]byte
+
+	// Expiration is the time at which the lease expires.
+	Expiration time.Time
+}
+
+// Expired returns true if the lease has expired at the passed time.
+func (l *OutputLease) Expired(now time.Time) bool {
+	return !now.Before(l.Expiration)
+}
+
+// LeaseOutput stores the passed output lease. If the output is already leased
+// under the same lock ID, the expiration of the existing lease is updated. If
+// it's leased under a different lock ID and the lease hasn't expired yet,
+// ErrOutputAlreadyLeased is returned.
+func (d *DB) LeaseOutput(lease *OutputLease) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		leases, err := tx.CreateBucketIfNotExists(outputLeaseBucket)
+		if err != nil {
+			return err
+		}
+
+		var key bytes.Buffer
+		if err := writeOutpoint(&key, &lease.OutPoint); err != nil {
+			return err
+		}
+
+		if v := leases.Get(key.Bytes()); v != nil {
+			existing, err := deserializeOutputLease(
+				lease.OutPoint, v,
+			)
+			if err != nil {
+				return err
+			}
+
+			if existing.LockID != lease.LockID &&
+				!existing.Expired(time.Now()) {
+
+				return ErrOutputAlreadyLeased
+			}
+		}
+
+		var b bytes.Buffer
+		err = WriteElements(
+			&b, lease.LockID, uint64(lease.Expiration.Unix()),
+		)
+		if err != nil {
+			return err
+		}
+
+		return leases.Put(key.Bytes(), b.Bytes())
+	})
+}
+
+// ReleaseOutput removes the lease of the passed output. If the output isn't
+// leased, ErrOutputLeaseNotFound is returned, and if it's leased under a
+// different lock ID, ErrOutputLeaseIDMismatch is returned.
+func (d *DB) ReleaseOutput(lockID [32]byte, op wire.OutPoint) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		leases := tx.Bucket(outputLeaseBucket)
+		if leases == nil {
+			return ErrOutputLeaseNotFound
+		}
+
+		var key bytes.Buffer
+		if err := writeOutpoint(&key, &op); err != nil {
+			return err
+		}
+
+		v := leases.Get(key.Bytes())
+		if v == nil {
+			return ErrOutputLeaseNotFound
+		}
+		lease, err := deserializeOutputLease(op, v)
+		if err != nil {
+			return err
+		}
+		if lease.LockID != lockID {
+			return ErrOutputLeaseIDMismatch
+		}
+
+		return leases.Delete(key.Bytes())
+	})
+}
+
+// FetchOutputLeases returns all stored output leases, including those that
+// have already expired.
+func (d *DB) FetchOutputLeases() ([]*OutputLease, error) {
+	var leases []*OutputLease
+	err := d.View(func(tx *bolt.Tx) error {
+		leaseBucket := tx.Bucket(outputLeaseBucket)
+		if leaseBucket == nil {
+			return nil
+		}
+
+		return leaseBucket.ForEach(func(k, v []byte) error {
+			var op wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &op)
+			if err != nil {
+				return err
+			}
+
+			lease, err := deserializeOutputLease(op, v)
+			if err != nil {
+				return err
+			}
+			leases = append(leases, lease)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+// deserializeOutputLease decodes the stored lease of the given output.
+func deserializeOutputLease(op wire.OutPoint, v []byte) (*OutputLease, error) {
+	lease := &OutputLease{
+		OutPoint: op,
+	}
+
+	var expiration uint64
+	err := ReadElements(bytes.NewReader(v), &lease.LockID, &expiration)
+	if err != nil {
+		return nil, err
+	}
+	lease.Expiration = time.Unix(int64(expiration), 0)
+
+	return lease, nil
+}
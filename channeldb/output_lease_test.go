@@ -0,0 +1,99 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestOutputLeases tests that output leases can be stored, extended and
+// released, and that an output can't be leased under two lock IDs at once.
+func TestOutputLeases(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	op := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 3}
+	lockID := [32]byte{1}
+	otherLockID := [32]byte{2}
+
+	// Releasing an output that was never leased should fail.
+	if err := cdb.ReleaseOutput(lockID, op); err != ErrOutputLeaseNotFound {
+		t.Fatalf("expected ErrOutputLeaseNotFound, got %v", err)
+	}
+
+	lease := &OutputLease{
+		OutPoint:   op,
+		LockID:     lockID,
+		Expiration: time.Unix(time.Now().Unix()+600, 0),
+	}
+	if err := cdb.LeaseOutput(lease); err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+
+	assertLeases := func(expected ...*OutputLease) {
+		t.Helper()
+
+		leases, err := cdb.FetchOutputLeases()
+		if err != nil {
+			t.Fatalf("unable to fetch leases: %v", err)
+		}
+		if len(leases) != len(expected) {
+			t.Fatalf("expected %v leases, got %v", len(expected),
+				len(leases))
+		}
+		for i, lease := range leases {
+			if lease.OutPoint != expected[i].OutPoint ||
+				lease.LockID != expected[i].LockID ||
+				!lease.Expiration.Equal(expected[i].Expiration) {
+
+				t.Fatalf("expected lease %v, got %v",
+					expected[i], lease)
+			}
+		}
+	}
+	assertLeases(lease)
+
+	// The output can't be leased under another ID while the lease is
+	// active, but the lease can be extended using the same ID.
+	otherLease := &OutputLease{
+		OutPoint:   op,
+		LockID:     otherLockID,
+		Expiration: lease.Expiration,
+	}
+	if err := cdb.LeaseOutput(otherLease); err != ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+
+	lease.Expiration = lease.Expiration.Add(time.Hour)
+	if err := cdb.LeaseOutput(lease); err != nil {
+		t.Fatalf("unable to extend lease: %v", err)
+	}
+	assertLeases(lease)
+
+	// Only the lock ID the output was leased under can release it.
+	err = cdb.ReleaseOutput(otherLockID, op)
+	if err != ErrOutputLeaseIDMismatch {
+		t.Fatalf("expected ErrOutputLeaseIDMismatch, got %v", err)
+	}
+	if err := cdb.ReleaseOutput(lockID, op); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	assertLeases()
+
+	// Once a lease has expired, the output can be leased under a new ID.
+	lease.Expiration = time.Unix(time.Now().Unix()-1, 0)
+	if err := cdb.LeaseOutput(lease); err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if err := cdb.LeaseOutput(otherLease); err != nil {
+		t.Fatalf("unable to lease expired output: %v", err)
+	}
+	assertLeases(otherLease)
+}
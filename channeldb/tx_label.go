@@ -0,0 +1,116 @@
+package channeldb
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/coreos/bbolt"
+)
+
+const (
+	// MaxTxLabelLength is the maximum length of a transaction label in
+	// bytes.
+	MaxTxLabelLength = 500
+)
+
+var (
+	// txLabelBucket is the name of the bucket that stores the labels of
+	// on-chain transactions, keyed by txid.
+	txLabelBucket = []byte("tx-label")
+
+	// ErrTxLabelNotFound is returned when the label of a transaction that
+	// hasn't been labeled is requested.
+	ErrTxLabelNotFound = fmt.Errorf("transaction label not found")
+
+	// ErrTxLabelExists is returned when a transaction that already has a
+	// label is attempted to be labeled without overwriting the label.
+	ErrTxLabelExists = fmt.Errorf("transaction already labeled")
+
+	// ErrEmptyTxLabel is returned when a transaction is attempted to be
+	// labeled with an empty label.
+	ErrEmptyTxLabel = fmt.Errorf("transaction label must not be empty")
+
+	// ErrTxLabelTooLong is returned when a transaction is attempted to be
+	// labeled with a label exceeding MaxTxLabelLength.
+	ErrTxLabelTooLong = fmt.Errorf("transaction label exceeds %v bytes",
+		MaxTxLabelLength)
+)
+
+// PutTxLabel stores the label of the transaction with the passed txid. If the
+// transaction already has a label, it's only replaced if overwrite is true,
+// otherwise ErrTxLabelExists is returned.
+func (d *DB) PutTxLabel(txid chainhash.Hash, label string,
+	overwrite bool) error {
+
+	switch {
+	case len(label) == 0:
+		return ErrEmptyTxLabel
+	case len(label) > MaxTxLabelLength:
+		return ErrTxLabelTooLong
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		labels, err := tx.CreateBucketIfNotExists(txLabelBucket)
+		if err != nil {
+			return err
+		}
+
+		if !overwrite && labels.Get(txid[:]) != nil {
+			return ErrTxLabelExists
+		}
+
+		return labels.Put(txid[:], []byte(label))
+	})
+}
+
+// FetchTxLabel returns the label of the transaction with the passed txid. If
+// the transaction hasn't been labeled, ErrTxLabelNotFound is returned.
+func (d *DB) FetchTxLabel(txid chainhash.Hash) (string, error) {
+	var label string
+	err := d.View(func(tx *bolt.Tx) error {
+		labels := tx.Bucket(txLabelBucket)
+		if labels == nil {
+			return ErrTxLabelNotFound
+		}
+
+		v := labels.Get(txid[:])
+		if v == nil {
+			return ErrTxLabelNotFound
+		}
+		label = string(v)
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return label, nil
+}
+
+// FetchTxLabels returns the labels of all labeled transactions, indexed by
+// txid.
+func (d *DB) FetchTxLabels() (map[chainhash.Hash]string, error) {
+	txLabels := make(map[chainhash.Hash]string)
+	err := d.View(func(tx *bolt.Tx) error {
+		labels := tx.Bucket(txLabelBucket)
+		if labels == nil {
+			return nil
+		}
+
+		return labels.ForEach(func(k, v []byte) error {
+			txid, err := chainhash.NewHash(k)
+			if err != nil {
+				return err
+			}
+			txLabels[*txid] = string(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txLabels, nil
+}
@@ -0,0 +1,78 @@
+package channeldb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestTxLabels tests that transaction labels can be stored, fetched and
+// overwritten, and that invalid labels are rejected.
+func TestTxLabels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	txid1 := chainhash.Hash{1}
+	txid2 := chainhash.Hash{2}
+
+	// Before anything has been labeled, no labels should be found.
+	if _, err := cdb.FetchTxLabel(txid1); err != ErrTxLabelNotFound {
+		t.Fatalf("expected ErrTxLabelNotFound, got %v", err)
+	}
+	labels, err := cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("expected no labels, got %v", labels)
+	}
+
+	// Empty labels and labels that are too long should be rejected.
+	if err := cdb.PutTxLabel(txid1, "", false); err != ErrEmptyTxLabel {
+		t.Fatalf("expected ErrEmptyTxLabel, got %v", err)
+	}
+	longLabel := strings.Repeat("a", MaxTxLabelLength+1)
+	err = cdb.PutTxLabel(txid1, longLabel, false)
+	if err != ErrTxLabelTooLong {
+		t.Fatalf("expected ErrTxLabelTooLong, got %v", err)
+	}
+
+	if err := cdb.PutTxLabel(txid1, "open:1", false); err != nil {
+		t.Fatalf("unable to label tx: %v", err)
+	}
+	if err := cdb.PutTxLabel(txid2, "sweep", false); err != nil {
+		t.Fatalf("unable to label tx: %v", err)
+	}
+
+	// An existing label should only be replaced if overwrite is set.
+	if err := cdb.PutTxLabel(txid1, "rent", false); err != ErrTxLabelExists {
+		t.Fatalf("expected ErrTxLabelExists, got %v", err)
+	}
+	label, err := cdb.FetchTxLabel(txid1)
+	if err != nil {
+		t.Fatalf("unable to fetch label: %v", err)
+	}
+	if label != "open:1" {
+		t.Fatalf("expected label open:1, got %v", label)
+	}
+
+	if err := cdb.PutTxLabel(txid1, "rent", true); err != nil {
+		t.Fatalf("unable to overwrite label: %v", err)
+	}
+
+	labels, err = cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 2 || labels[txid1] != "rent" ||
+		labels[txid2] != "sweep" {
+
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+}
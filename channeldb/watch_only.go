@@ -0,0 +1,564 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// watchOnlyAccountBucket is the name of the top-level bucket that
+	// stores the watch-only accounts. Each account has its own sub-bucket,
+	// keyed by the account name, which holds the account info as well as
+	// the account's outputs and transactions.
+	watchOnlyAccountBucket = []byte("watch-only-account")
+
+	// watchOnlyInfoKey is the key within an account bucket that stores the
+	// serialized account.
+	watchOnlyInfoKey = []byte("info")
+
+	// watchOnlyUtxoBucket is the name of the sub-bucket within an account
+	// bucket that stores the outputs paying to the account, keyed by
+	// outpoint.
+	watchOnlyUtxoBucket = []byte("utxo")
+
+	// watchOnlyTxBucket is the name of the sub-bucket within an account
+	// bucket that stores the transactions relevant to the account, keyed
+	// by txid.
+	watchOnlyTxBucket = []byte("tx")
+
+	// ErrWatchOnlyAccountExists is returned when a watch-only account is
+	// attempted to be created under a name that's already taken.
+	ErrWatchOnlyAccountExists = fmt.Errorf("watch-only account already " +
+		"exists")
+
+	// ErrWatchOnlyAccountNotFound is returned when a watch-only account
+	// that doesn't exist is requested.
+	ErrWatchOnlyAccountNotFound = fmt.Errorf("watch-only account not found")
+)
+
+// WatchOnlyAccount is an account of which only the extended public key is
+// known. Its outputs are tracked, but can't be spent by the node.
+type WatchOnlyAccount struct {
+	// Name is the unique name of the account.
+	Name string
+
+	// ExtendedKey is the serialized BIP32 extended public key the
+	// account's addresses are derived from.
+	ExtendedKey string
+
+	// AddrType is the type of the addresses derived for this account,
+	// expressed as an lnwallet.AddressType.
+	AddrType uint8
+
+	// MasterFingerprint is the fingerprint of the master key the extended
+	// key was derived from. Along with DerivationPath, it allows signers
+	// to locate the keys of the account's outputs.
+	MasterFingerprint uint32
+
+	// DerivationPath is the path of the extended key from the master key.
+	DerivationPath []uint32
+
+	// BirthdayHeight is the height from which the chain is scanned for
+	// transactions of the account.
+	BirthdayHeight uint32
+
+	// NextExternalIndex is the index of the next receive address to hand
+	// out.
+	NextExternalIndex uint32
+
+	// NextInternalIndex is the index of the next change address to hand
+	// out.
+	NextInternalIndex uint32
+
+	// SyncedHeight is the height of the last block that was scanned for
+	// transactions of the account.
+	SyncedHeight uint32
+
+	// RecentBlockHashes are the hashes of the most recently scanned blocks
+	// in ascending order, the last one being the block at SyncedHeight.
+	// They allow finding the fork point of a reorg.
+	RecentBlockHashes []chainhash.Hash
+}
+
+// WatchOnlyUtxo is an output paying to one of the addresses of a watch-only
+// account.
+type WatchOnlyUtxo struct {
+	// OutPoint is the outpoint of the output.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// PkScript is the output script of the output.
+	PkScript []byte
+
+	// Branch is the BIP32 branch of the output's key: 0 for receive
+	// addresses, 1 for change addresses.
+	Branch uint32
+
+	// Index is the BIP32 index of the output's key within its branch.
+	Index uint32
+
+	// Height is the height of the block the output confirmed in.
+	Height uint32
+
+	// SpentHeight is the height of the block the output was spent in, or
+	// zero if it's unspent.
+	SpentHeight uint32
+}
+
+// WatchOnlyTx is a confirmed transaction that either pays to, or spends from
+// a watch-only account.
+type WatchOnlyTx struct {
+	// Tx is the transaction itself.
+	Tx *wire.MsgTx
+
+	// BlockHash is the hash of the block the transaction confirmed in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the transaction confirmed in.
+	BlockHeight uint32
+
+	// Timestamp is the unix timestamp of the block the transaction
+	// confirmed in.
+	Timestamp int64
+
+	// Value is the net value of the transaction from the PoV of the
+	// account.
+	Value btcutil.Amount
+
+	// TotalFees is the fee paid by the transaction. It's only known if all
+	// of its inputs spend outputs of the account, and zero otherwise.
+	TotalFees btcutil.Amount
+}
+
+// CreateWatchOnlyAccount stores a new watch-only account. If an account with
+// the same name already exists, ErrWatchOnlyAccountExists is returned.
+func (d *DB) CreateWatchOnlyAccount(account *WatchOnlyAccount) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		accounts, err := tx.CreateBucketIfNotExists(
+			watchOnlyAccountBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		if accounts.Bucket([]byte(account.Name)) != nil {
+			return ErrWatchOnlyAccountExists
+		}
+		accountBucket, err := accounts.CreateBucket(
+			[]byte(account.Name),
+		)
+		if err != nil {
+			return err
+		}
+		_, err = accountBucket.CreateBucket(watchOnlyUtxoBucket)
+		if err != nil {
+			return err
+		}
+		_, err = accountBucket.CreateBucket(watchOnlyTxBucket)
+		if err != nil {
+			return err
+		}
+
+		return putWatchOnlyAccount(accountBucket, account)
+	})
+}
+
+// UpdateWatchOnlyAccount replaces the stored info of an existing watch-only
+// account, such as its address indexes.
+func (d *DB) UpdateWatchOnlyAccount(account *WatchOnlyAccount) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		accountBucket, err := fetchWatchOnlyAccountBucket(
+			tx, account.Name,
+		)
+		if err != nil {
+			return err
+		}
+
+		return putWatchOnlyAccount(accountBucket, account)
+	})
+}
+
+// FetchWatchOnlyAccounts returns all watch-only accounts.
+func (d *DB) FetchWatchOnlyAccounts() ([]*WatchOnlyAccount, error) {
+	var accounts []*WatchOnlyAccount
+	err := d.View(func(tx *bolt.Tx) error {
+		accountsBucket := tx.Bucket(watchOnlyAccountBucket)
+		if accountsBucket == nil {
+			return nil
+		}
+
+		return accountsBucket.ForEach(func(k, _ []byte) error {
+			accountBucket := accountsBucket.Bucket(k)
+			if accountBucket == nil {
+				return nil
+			}
+
+			account, err := deserializeWatchOnlyAccount(
+				bytes.NewReader(accountBucket.Get(
+					watchOnlyInfoKey,
+				)),
+			)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, account)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// AddWatchOnlyBlock atomically stores the outputs and transactions of a watch-
+// only account that were found in a block, along with the updated account
+// info. Outputs that are already stored, e.g. because they were spent within
+// the block, are replaced.
+func (d *DB) AddWatchOnlyBlock(account *WatchOnlyAccount,
+	utxos []*WatchOnlyUtxo, txns []*WatchOnlyTx) error {
+
+	return d.Update(func(tx *bolt.Tx) error {
+		accountBucket, err := fetchWatchOnlyAccountBucket(
+			tx, account.Name,
+		)
+		if err != nil {
+			return err
+		}
+
+		utxoBucket := accountBucket.Bucket(watchOnlyUtxoBucket)
+		for _, utxo := range utxos {
+			var key bytes.Buffer
+			if err := writeOutpoint(&key, &utxo.OutPoint); err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			if err := serializeWatchOnlyUtxo(&b, utxo); err != nil {
+				return err
+			}
+			if err := utxoBucket.Put(key.Bytes(), b.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		txBucket := accountBucket.Bucket(watchOnlyTxBucket)
+		for _, txn := range txns {
+			txid := txn.Tx.TxHash()
+
+			var b bytes.Buffer
+			if err := serializeWatchOnlyTx(&b, txn); err != nil {
+				return err
+			}
+			if err := txBucket.Put(txid[:], b.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return putWatchOnlyAccount(accountBucket, account)
+	})
+}
+
+// RewindWatchOnlyAccount removes all outputs and transactions of a watch-only
+// account that confirmed above the passed height, and marks the outputs that
+// were spent above it as unspent again. This is used to undo the blocks that
+// were disconnected by a reorg. The passed account info, which should reflect
+// the new synced height, is stored along with it.
+func (d *DB) RewindWatchOnlyAccount(account *WatchOnlyAccount,
+	height uint32) error {
+
+	return d.Update(func(tx *bolt.Tx) error {
+		accountBucket, err := fetchWatchOnlyAccountBucket(
+			tx, account.Name,
+		)
+		if err != nil {
+			return err
+		}
+
+		// We can't modify a bucket while iterating over it, so we'll
+		// collect the changes first.
+		var (
+			utxoBucket   = accountBucket.Bucket(watchOnlyUtxoBucket)
+			staleUtxos   [][]byte
+			unspentUtxos = make(map[string][]byte)
+		)
+		err = utxoBucket.ForEach(func(k, v []byte) error {
+			utxo, err := deserializeWatchOnlyUtxo(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case utxo.Height > height:
+				staleUtxos = append(staleUtxos, k)
+
+			case utxo.SpentHeight > height:
+				utxo.SpentHeight = 0
+
+				var b bytes.Buffer
+				err := serializeWatchOnlyUtxo(&b, utxo)
+				if err != nil {
+					return err
+				}
+				unspentUtxos[string(k)] = b.Bytes()
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range staleUtxos {
+			if err := utxoBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		for k, v := range unspentUtxos {
+			if err := utxoBucket.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		txBucket := accountBucket.Bucket(watchOnlyTxBucket)
+		var staleTxns [][]byte
+		err = txBucket.ForEach(func(k, v []byte) error {
+			txn, err := deserializeWatchOnlyTx(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			if txn.BlockHeight > height {
+				staleTxns = append(staleTxns, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range staleTxns {
+			if err := txBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return putWatchOnlyAccount(accountBucket, account)
+	})
+}
+
+// FetchWatchOnlyUtxos returns all outputs of the watch-only account with the
+// passed name, including those that have been spent.
+func (d *DB) FetchWatchOnlyUtxos(name string) ([]*WatchOnlyUtxo, error) {
+	var utxos []*WatchOnlyUtxo
+	err := d.View(func(tx *bolt.Tx) error {
+		accountBucket, err := fetchWatchOnlyAccountBucket(tx, name)
+		if err != nil {
+			return err
+		}
+
+		utxoBucket := accountBucket.Bucket(watchOnlyUtxoBucket)
+		return utxoBucket.ForEach(func(_, v []byte) error {
+			utxo, err := deserializeWatchOnlyUtxo(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			utxos = append(utxos, utxo)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utxos, nil
+}
+
+// FetchWatchOnlyTxns returns all transactions relevant to the watch-only
+// account with the passed name.
+func (d *DB) FetchWatchOnlyTxns(name string) ([]*WatchOnlyTx, error) {
+	var txns []*WatchOnlyTx
+	err := d.View(func(tx *bolt.Tx) error {
+		accountBucket, err := fetchWatchOnlyAccountBucket(tx, name)
+		if err != nil {
+			return err
+		}
+
+		txBucket := accountBucket.Bucket(watchOnlyTxBucket)
+		return txBucket.ForEach(func(_, v []byte) error {
+			txn, err := deserializeWatchOnlyTx(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			txns = append(txns, txn)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txns, nil
+}
+
+// fetchWatchOnlyAccountBucket returns the bucket of the watch-only account
+// with the passed name.
+func fetchWatchOnlyAccountBucket(tx *bolt.Tx, name string) (*bolt.Bucket,
+	error) {
+
+	accounts := tx.Bucket(watchOnlyAccountBucket)
+	if accounts == nil {
+		return nil, ErrWatchOnlyAccountNotFound
+	}
+	accountBucket := accounts.Bucket([]byte(name))
+	if accountBucket == nil {
+		return nil, ErrWatchOnlyAccountNotFound
+	}
+
+	return accountBucket, nil
+}
+
+// putWatchOnlyAccount stores the account info within the account's bucket.
+func putWatchOnlyAccount(accountBucket *bolt.Bucket,
+	account *WatchOnlyAccount) error {
+
+	var b bytes.Buffer
+	if err := serializeWatchOnlyAccount(&b, account); err != nil {
+		return err
+	}
+
+	return accountBucket.Put(watchOnlyInfoKey, b.Bytes())
+}
+
+func serializeWatchOnlyAccount(w io.Writer, a *WatchOnlyAccount) error {
+	err := WriteElements(
+		w, []byte(a.Name), []byte(a.ExtendedKey), uint16(a.AddrType),
+		a.MasterFingerprint, uint32(len(a.DerivationPath)),
+	)
+	if err != nil {
+		return err
+	}
+	for _, index := range a.DerivationPath {
+		if err := WriteElement(w, index); err != nil {
+			return err
+		}
+	}
+
+	err = WriteElements(
+		w, a.BirthdayHeight, a.NextExternalIndex, a.NextInternalIndex,
+		a.SyncedHeight, uint32(len(a.RecentBlockHashes)),
+	)
+	if err != nil {
+		return err
+	}
+	for _, hash := range a.RecentBlockHashes {
+		if err := WriteElement(w, hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeWatchOnlyAccount(r io.Reader) (*WatchOnlyAccount, error) {
+	var (
+		a                  WatchOnlyAccount
+		name, extendedKey  []byte
+		addrType           uint16
+		derivationPathSize uint32
+	)
+	err := ReadElements(
+		r, &name, &extendedKey, &addrType, &a.MasterFingerprint,
+		&derivationPathSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	a.Name = string(name)
+	a.ExtendedKey = string(extendedKey)
+	a.AddrType = uint8(addrType)
+
+	for i := uint32(0); i < derivationPathSize; i++ {
+		var index uint32
+		if err := ReadElement(r, &index); err != nil {
+			return nil, err
+		}
+		a.DerivationPath = append(a.DerivationPath, index)
+	}
+
+	var numBlockHashes uint32
+	err = ReadElements(
+		r, &a.BirthdayHeight, &a.NextExternalIndex,
+		&a.NextInternalIndex, &a.SyncedHeight, &numBlockHashes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numBlockHashes; i++ {
+		var hash chainhash.Hash
+		if err := ReadElement(r, &hash); err != nil {
+			return nil, err
+		}
+		a.RecentBlockHashes = append(a.RecentBlockHashes, hash)
+	}
+
+	return &a, nil
+}
+
+func serializeWatchOnlyUtxo(w io.Writer, u *WatchOnlyUtxo) error {
+	return WriteElements(
+		w, u.OutPoint, u.Value, u.PkScript, u.Branch, u.Index, u.Height,
+		u.SpentHeight,
+	)
+}
+
+func deserializeWatchOnlyUtxo(r io.Reader) (*WatchOnlyUtxo, error) {
+	var u WatchOnlyUtxo
+	err := ReadElements(
+		r, &u.OutPoint, &u.Value, &u.PkScript, &u.Branch, &u.Index,
+		&u.Height, &u.SpentHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func serializeWatchOnlyTx(w io.Writer, t *WatchOnlyTx) error {
+	return WriteElements(
+		w, t.Tx, t.BlockHash, t.BlockHeight, uint64(t.Timestamp),
+		t.Value, t.TotalFees,
+	)
+}
+
+func deserializeWatchOnlyTx(r io.Reader) (*WatchOnlyTx, error) {
+	var (
+		t         WatchOnlyTx
+		timestamp uint64
+	)
+	err := ReadElements(
+		r, &t.Tx, &t.BlockHash, &t.BlockHeight, &timestamp, &t.Value,
+		&t.TotalFees,
+	)
+	if err != nil {
+		return nil, err
+	}
+	t.Timestamp = int64(timestamp)
+
+	return &t, nil
+}
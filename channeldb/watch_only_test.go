@@ -0,0 +1,159 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestWatchOnlyAccounts tests that watch-only accounts along with their
+// outputs and transactions can be stored, and that they're correctly rewound
+// to a lower height.
+func TestWatchOnlyAccounts(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	account := &WatchOnlyAccount{
+		Name:              "cold",
+		ExtendedKey:       "xpub",
+		AddrType:          1,
+		MasterFingerprint: 0xdeadbeef,
+		DerivationPath:    []uint32{0x80000054, 0x80000000, 0x80000000},
+		BirthdayHeight:    100,
+	}
+
+	// Accounts that don't exist can't be updated.
+	err = cdb.UpdateWatchOnlyAccount(account)
+	if err != ErrWatchOnlyAccountNotFound {
+		t.Fatalf("expected ErrWatchOnlyAccountNotFound, got %v", err)
+	}
+
+	if err := cdb.CreateWatchOnlyAccount(account); err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	err = cdb.CreateWatchOnlyAccount(account)
+	if err != ErrWatchOnlyAccountExists {
+		t.Fatalf("expected ErrWatchOnlyAccountExists, got %v", err)
+	}
+
+	assertAccount := func(expected *WatchOnlyAccount) {
+		t.Helper()
+
+		accounts, err := cdb.FetchWatchOnlyAccounts()
+		if err != nil {
+			t.Fatalf("unable to fetch accounts: %v", err)
+		}
+		if len(accounts) != 1 {
+			t.Fatalf("expected 1 account, got %v", len(accounts))
+		}
+		if !reflect.DeepEqual(accounts[0], expected) {
+			t.Fatalf("expected account %v, got %v", expected,
+				accounts[0])
+		}
+	}
+	assertAccount(account)
+
+	// Add two blocks, the second of which spends the output received in
+	// the first.
+	utxo := &WatchOnlyUtxo{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1},
+		Value:    50000,
+		PkScript: []byte{0x00, 0x14},
+		Branch:   0,
+		Index:    3,
+		Height:   101,
+	}
+	txn := &WatchOnlyTx{
+		Tx:          testTx,
+		BlockHash:   chainhash.Hash{2},
+		BlockHeight: 101,
+		Timestamp:   1500000000,
+		Value:       50000,
+	}
+	account.SyncedHeight = 101
+	account.NextExternalIndex = 4
+	account.RecentBlockHashes = []chainhash.Hash{{2}}
+	err = cdb.AddWatchOnlyBlock(
+		account, []*WatchOnlyUtxo{utxo}, []*WatchOnlyTx{txn},
+	)
+	if err != nil {
+		t.Fatalf("unable to add block: %v", err)
+	}
+	assertAccount(account)
+
+	spentUtxo := *utxo
+	spentUtxo.SpentHeight = 102
+	account.SyncedHeight = 102
+	account.RecentBlockHashes = append(
+		account.RecentBlockHashes, chainhash.Hash{3},
+	)
+	err = cdb.AddWatchOnlyBlock(account, []*WatchOnlyUtxo{&spentUtxo}, nil)
+	if err != nil {
+		t.Fatalf("unable to add block: %v", err)
+	}
+	assertAccount(account)
+
+	assertUtxos := func(expected ...*WatchOnlyUtxo) {
+		t.Helper()
+
+		utxos, err := cdb.FetchWatchOnlyUtxos(account.Name)
+		if err != nil {
+			t.Fatalf("unable to fetch utxos: %v", err)
+		}
+		if len(utxos) != len(expected) {
+			t.Fatalf("expected %v utxos, got %v", len(expected),
+				len(utxos))
+		}
+		for i, utxo := range utxos {
+			if !reflect.DeepEqual(utxo, expected[i]) {
+				t.Fatalf("expected utxo %v, got %v",
+					expected[i], utxo)
+			}
+		}
+	}
+	assertUtxos(&spentUtxo)
+
+	txns, err := cdb.FetchWatchOnlyTxns(account.Name)
+	if err != nil {
+		t.Fatalf("unable to fetch txns: %v", err)
+	}
+	if len(txns) != 1 || txns[0].Tx.TxHash() != testTx.TxHash() ||
+		txns[0].BlockHeight != txn.BlockHeight ||
+		txns[0].Timestamp != txn.Timestamp ||
+		txns[0].Value != txn.Value {
+
+		t.Fatalf("unexpected txns: %v", txns)
+	}
+
+	// Rewinding to the first block should mark the output as unspent
+	// again, while rewinding below it should remove everything.
+	account.SyncedHeight = 101
+	account.RecentBlockHashes = account.RecentBlockHashes[:1]
+	if err := cdb.RewindWatchOnlyAccount(account, 101); err != nil {
+		t.Fatalf("unable to rewind account: %v", err)
+	}
+	assertAccount(account)
+	assertUtxos(utxo)
+
+	account.SyncedHeight = 100
+	account.RecentBlockHashes = nil
+	if err := cdb.RewindWatchOnlyAccount(account, 100); err != nil {
+		t.Fatalf("unable to rewind account: %v", err)
+	}
+	assertUtxos()
+
+	txns, err = cdb.FetchWatchOnlyTxns(account.Name)
+	if err != nil {
+		t.Fatalf("unable to fetch txns: %v", err)
+	}
+	if len(txns) != 0 {
+		t.Fatalf("expected no txns, got %v", len(txns))
+	}
+}
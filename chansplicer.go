@@ -0,0 +1,555 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrChanAlreadySplicing is returned when a splice of a channel is
+	// attempted while another splice of the channel is being negotiated.
+	ErrChanAlreadySplicing = fmt.Errorf("channel splice already initiated")
+)
+
+// spliceState represents all the possible states the channel splicer state
+// machine can be in. Each message advances the state machine to the next
+// state, until it reaches the spliceFinished state.
+type spliceState uint8
+
+const (
+	// spliceIdle is the initial starting state. If a state machine
+	// receives a message while in this state, then it is the responder to
+	// a splice initiated by the remote party.
+	spliceIdle spliceState = iota
+
+	// spliceInitSent is the state of the initiator once it has sent the
+	// SpliceInit message. It awaits the SpliceAccept message of the
+	// responder.
+	spliceInitSent
+
+	// spliceAcceptSent is the state of the responder once it has sent the
+	// SpliceAccept message. It awaits the SpliceSigned message of the
+	// initiator.
+	spliceAcceptSent
+
+	// spliceSignedSent is the state of the initiator once it has sent the
+	// SpliceSigned message. At this point the splice has been persisted,
+	// and the initiator awaits the final SpliceComplete message.
+	spliceSignedSent
+
+	// spliceFinished is the final state of the state machine. The splice
+	// transaction has been completed and broadcast, and the channel waits
+	// for it to confirm.
+	spliceFinished
+)
+
+// spliceChanReq is a local request to splice funds into or out of a channel.
+type spliceChanReq struct {
+	// chanPoint is the funding outpoint of the channel to be spliced.
+	chanPoint wire.OutPoint
+
+	// amt is the amount to splice into or out of the channel.
+	amt btcutil.Amount
+
+	// deliveryScript is the script the funds spliced out of the channel
+	// are sent to. If nil, amt is spliced into the channel using coins of
+	// the wallet instead.
+	deliveryScript []byte
+
+	// feePerKw is the fee rate of the splice transaction.
+	feePerKw lnwallet.SatPerKWeight
+
+	// minConfs is the minimum number of confirmations of the coins the
+	// wallet selects to splice funds into the channel.
+	minConfs int32
+
+	// splice receives the pending splice of the channel once the splice
+	// transaction has been broadcast.
+	splice chan *channeldb.ChannelSplice
+
+	// err receives any error that aborted the splice.
+	err chan error
+}
+
+// chanSpliceCfg holds all the items that a channelSplicer requires to carry
+// out its duties.
+type chanSpliceCfg struct {
+	// channel is the channel that is spliced.
+	channel *lnwallet.LightningChannel
+
+	// wallet is used to fund, verify and sign the inputs of the splice
+	// transaction.
+	wallet *lnwallet.LightningWallet
+
+	// maxChanSize is the largest capacity the channel may be spliced to.
+	maxChanSize btcutil.Amount
+
+	// unregisterChannel removes the link of the channel from the switch,
+	// such that no further updates are made to the channel while it's
+	// being spliced.
+	unregisterChannel func(lnwire.ChannelID)
+
+	// broadcastTx broadcasts the passed transaction to the network,
+	// labeling it with the passed label.
+	broadcastTx func(*wire.MsgTx, string) error
+
+	// watchSplice is called once the splice has been persisted, in order
+	// to wait for the splice transaction to confirm.
+	watchSplice func(*channeldb.OpenChannel)
+}
+
+// channelSplicer is a state machine that handles the negotiation of a splice
+// of a channel. The splice transaction spends the current funding output of
+// the channel along with the inputs of the initiator, and creates a new
+// funding output along with the outputs of the initiator. Both parties
+// exchange signatures for their new commitments before signing the splice
+// transaction, after which the channel waits for the splice transaction to
+// confirm.
+//
+// The link of the channel is removed from the switch before the new
+// commitments are created, and is only restored once the splice transaction
+// has confirmed, or the splice is aborted before it's persisted. In between,
+// the channel doesn't forward any HTLCs, as neither party could update the
+// commitments spending the current and the new funding output in lockstep.
+type channelSplicer struct {
+	// state is the current state of the state machine.
+	state spliceState
+
+	// cfg holds the configuration for this channelSplicer instance.
+	cfg chanSpliceCfg
+
+	// chanPoint is the full channel point of the target channel.
+	chanPoint wire.OutPoint
+
+	// cid is the full channel ID of the target channel.
+	cid lnwire.ChannelID
+
+	// broadcastHeight is the height the splice was negotiated at.
+	broadcastHeight uint32
+
+	// spliceReq is the initial splice request. This will only be
+	// populated if we're the initiator of the splice.
+	spliceReq *spliceChanReq
+
+	// inputs, prevOutputs and outputs are the inputs, the outputs spent
+	// by them, and the outputs the initiator adds to the splice
+	// transaction. prevOutputHeights are the heights the prevOutputs were
+	// confirmed at.
+	inputs            []*wire.TxIn
+	prevOutputs       []*wire.TxOut
+	prevOutputHeights []uint32
+	outputs           []*wire.TxOut
+
+	// splice is the new state of the channel once the splice transaction
+	// confirms.
+	splice *channeldb.ChannelSplice
+
+	// localFundingSig is our signature for the input of the splice
+	// transaction spending the current funding output.
+	localFundingSig lnwire.Sig
+
+	// unregistered is true once the link of the channel has been removed
+	// from the switch.
+	unregistered bool
+}
+
+// newChannelSplicer creates a new instance of the channel splicer. The final
+// argument should only be populated iff we're the initiator of the splice.
+func newChannelSplicer(cfg chanSpliceCfg, broadcastHeight uint32,
+	spliceReq *spliceChanReq) *channelSplicer {
+
+	chanPoint := cfg.channel.ChannelPoint()
+	return &channelSplicer{
+		state:           spliceIdle,
+		cfg:             cfg,
+		chanPoint:       *chanPoint,
+		cid:             lnwire.NewChanIDFromOutPoint(chanPoint),
+		broadcastHeight: broadcastHeight,
+		spliceReq:       spliceReq,
+	}
+}
+
+// SpliceRequest returns the original splice request that prompted the
+// creation of the state machine.
+//
+// NOTE: This will only return a non-nil pointer if we were the initiator of
+// the splice.
+func (s *channelSplicer) SpliceRequest() *spliceChanReq {
+	return s.spliceReq
+}
+
+// Splice returns the negotiated splice of the channel, or nil if the splice
+// hasn't been negotiated yet.
+func (s *channelSplicer) Splice() *channeldb.ChannelSplice {
+	return s.splice
+}
+
+// Persisted returns true once the splice has been persisted, as the remote
+// party may broadcast the splice transaction from this point on.
+func (s *channelSplicer) Persisted() bool {
+	return s.state == spliceSignedSent || s.state == spliceFinished
+}
+
+// Paused returns true if the link of the channel has been removed from the
+// switch, but the splice was aborted before it was persisted. In this case
+// the channel should resume its normal operation.
+func (s *channelSplicer) Paused() bool {
+	return s.unregistered && !s.Persisted()
+}
+
+// Abort releases any coins the wallet selected to splice funds into the
+// channel. It should be called if the splice fails before it has been
+// persisted.
+func (s *channelSplicer) Abort() {
+	if s.spliceReq == nil || s.Persisted() {
+		return
+	}
+
+	s.cfg.wallet.ReleaseSpliceInputs(s.inputs)
+}
+
+// InitSplice is the first method that's to be called by the initiator of the
+// splice. It selects the coins to splice into, or creates the output
+// receiving the funds spliced out of the channel, and returns the SpliceInit
+// message to send to the remote party.
+func (s *channelSplicer) InitSplice() (*lnwire.SpliceInit, error) {
+	if s.state != spliceIdle {
+		return nil, ErrChanAlreadySplicing
+	}
+
+	req := s.spliceReq
+	capacity := s.cfg.channel.Capacity
+	if req.deliveryScript == nil {
+		contribution, err := s.cfg.wallet.FundSplice(
+			req.amt, req.feePerKw, req.minConfs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		s.inputs = contribution.Inputs
+		s.prevOutputs = contribution.PrevOutputs
+		s.prevOutputHeights = contribution.PrevOutputHeights
+		s.outputs = contribution.ChangeOutputs
+		capacity += req.amt
+	} else {
+		s.outputs = []*wire.TxOut{{
+			Value:    int64(req.amt),
+			PkScript: req.deliveryScript,
+		}}
+		capacity -= req.amt + lnwallet.SpliceTxFee(
+			req.feePerKw, s.outputs,
+		)
+	}
+
+	if err := s.newSplice(capacity, true); err != nil {
+		return nil, err
+	}
+
+	peerLog.Infof("ChannelPoint(%v): initiating splice to capacity %v",
+		s.chanPoint, capacity)
+
+	msg := &lnwire.SpliceInit{
+		ChanID:   s.cid,
+		Capacity: capacity,
+	}
+	for i, txIn := range s.inputs {
+		prevOut := s.prevOutputs[i]
+		msg.Inputs = append(msg.Inputs, lnwire.FundingInput{
+			OutPoint: txIn.PreviousOutPoint,
+			Value:    btcutil.Amount(prevOut.Value),
+			PkScript: prevOut.PkScript,
+			Height:   s.prevOutputHeights[i],
+		})
+	}
+	for _, txOut := range s.outputs {
+		msg.Outputs = append(msg.Outputs, lnwire.FundingOutput{
+			Value:    btcutil.Amount(txOut.Value),
+			PkScript: txOut.PkScript,
+		})
+	}
+
+	s.state = spliceInitSent
+
+	return msg, nil
+}
+
+// newSplice removes the link of the channel from the switch, then creates the
+// splice transaction and the new state of the channel.
+func (s *channelSplicer) newSplice(capacity btcutil.Amount,
+	localInitiated bool) error {
+
+	if capacity > s.cfg.maxChanSize {
+		return fmt.Errorf("splice capacity of %v exceeds maximum "+
+			"channel size of %v", capacity, s.cfg.maxChanSize)
+	}
+
+	// Before creating the new commitments, we'll make sure no further
+	// updates are made to the channel.
+	s.cfg.unregisterChannel(s.cid)
+	s.unregistered = true
+
+	spliceTx, err := s.cfg.channel.NewSpliceTx(
+		capacity, s.inputs, s.outputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.splice, err = s.cfg.channel.NewSplice(
+		spliceTx, localInitiated, s.broadcastHeight,
+	)
+	return err
+}
+
+// ProcessSpliceMsg attempts to process the next message of the splice
+// negotiation. It returns the messages to send to the remote party, and a
+// bool indicating if the splice negotiation has completed. If the second
+// value is true, then the channelSplicer can be garbage collected.
+func (s *channelSplicer) ProcessSpliceMsg(msg lnwire.Message) (
+	[]lnwire.Message, bool, error) {
+
+	switch s.state {
+
+	// If we're idle, then the remote party initiates a splice of the
+	// channel. We'll verify its inputs, and reply with our signature for
+	// its new commitment.
+	case spliceIdle:
+		spliceInit, ok := msg.(*lnwire.SpliceInit)
+		if !ok {
+			return nil, false, fmt.Errorf("expected "+
+				"lnwire.SpliceInit, instead have %v",
+				spew.Sdump(msg))
+		}
+
+		for _, input := range spliceInit.Inputs {
+			outPoint := input.OutPoint
+			s.inputs = append(
+				s.inputs, wire.NewTxIn(&outPoint, nil, nil),
+			)
+			s.prevOutputs = append(s.prevOutputs, &wire.TxOut{
+				Value:    int64(input.Value),
+				PkScript: input.PkScript,
+			})
+			s.prevOutputHeights = append(
+				s.prevOutputHeights, input.Height,
+			)
+		}
+		for _, output := range spliceInit.Outputs {
+			s.outputs = append(s.outputs, &wire.TxOut{
+				Value:    int64(output.Value),
+				PkScript: output.PkScript,
+			})
+		}
+
+		capacityDelta := spliceInit.Capacity - s.cfg.channel.Capacity
+		err := s.cfg.wallet.VerifySpliceInputs(
+			s.inputs, s.prevOutputs, s.prevOutputHeights,
+			s.outputs, capacityDelta,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if err := s.newSplice(spliceInit.Capacity, false); err != nil {
+			return nil, false, err
+		}
+
+		commitSig, err := s.cfg.channel.SignSpliceCommitment(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+
+		peerLog.Infof("ChannelPoint(%v): accepting splice to "+
+			"capacity %v", s.chanPoint, spliceInit.Capacity)
+
+		s.state = spliceAcceptSent
+
+		return []lnwire.Message{&lnwire.SpliceAccept{
+			ChanID:    s.cid,
+			CommitSig: commitSig,
+		}}, false, nil
+
+	// If we initiated the splice, the remote party accepts it by sending
+	// its signature for our new commitment. In return, we'll send it our
+	// signature for its new commitment, along with all our signatures for
+	// the splice transaction.
+	case spliceInitSent:
+		spliceAccept, ok := msg.(*lnwire.SpliceAccept)
+		if !ok {
+			return nil, false, fmt.Errorf("expected "+
+				"lnwire.SpliceAccept, instead have %v",
+				spew.Sdump(msg))
+		}
+
+		channel := s.cfg.channel
+		err := channel.ReceiveSpliceCommitment(
+			s.splice, spliceAccept.CommitSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		commitSig, err := channel.SignSpliceCommitment(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+		s.localFundingSig, err = channel.SignSpliceFunding(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+
+		spliceSigned := &lnwire.SpliceSigned{
+			ChanID:     s.cid,
+			CommitSig:  commitSig,
+			FundingSig: s.localFundingSig,
+		}
+		if len(s.inputs) != 0 {
+			witnesses, err := s.cfg.wallet.SignSpliceInputs(
+				s.splice.SpliceTx, s.inputs,
+			)
+			if err != nil {
+				return nil, false, err
+			}
+			err = lnwallet.AddSpliceWitnesses(
+				s.splice.SpliceTx, s.inputs, s.prevOutputs,
+				witnesses,
+			)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, witness := range witnesses {
+				spliceSigned.Witnesses = append(
+					spliceSigned.Witnesses,
+					lnwire.InputWitness(witness),
+				)
+			}
+		}
+
+		// Once the remote party receives our signatures, it's able to
+		// broadcast the splice transaction, so we'll persist the
+		// splice before sending them.
+		err = channel.State().MarkSplicePending(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+
+		s.state = spliceSignedSent
+
+		return []lnwire.Message{spliceSigned}, false, nil
+
+	// If we're the responder, the initiator sends us its signatures,
+	// which allow us to complete the splice transaction and broadcast it.
+	case spliceAcceptSent:
+		spliceSigned, ok := msg.(*lnwire.SpliceSigned)
+		if !ok {
+			return nil, false, fmt.Errorf("expected "+
+				"lnwire.SpliceSigned, instead have %v",
+				spew.Sdump(msg))
+		}
+
+		channel := s.cfg.channel
+		err := channel.ReceiveSpliceCommitment(
+			s.splice, spliceSigned.CommitSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		witnesses := make(
+			[]wire.TxWitness, 0, len(spliceSigned.Witnesses),
+		)
+		for _, witness := range spliceSigned.Witnesses {
+			witnesses = append(witnesses, wire.TxWitness(witness))
+		}
+		err = lnwallet.AddSpliceWitnesses(
+			s.splice.SpliceTx, s.inputs, s.prevOutputs, witnesses,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		s.localFundingSig, err = channel.SignSpliceFunding(s.splice)
+		if err != nil {
+			return nil, false, err
+		}
+		err = channel.CompleteSpliceFunding(
+			s.splice, s.localFundingSig, spliceSigned.FundingSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if err := s.finalizeSplice(); err != nil {
+			return nil, false, err
+		}
+
+		return []lnwire.Message{&lnwire.SpliceComplete{
+			ChanID:     s.cid,
+			FundingSig: s.localFundingSig,
+		}}, true, nil
+
+	// If we initiated the splice, the remote party's final message
+	// carries its signature for the current funding output, which
+	// completes the splice transaction.
+	case spliceSignedSent:
+		spliceComplete, ok := msg.(*lnwire.SpliceComplete)
+		if !ok {
+			return nil, false, fmt.Errorf("expected "+
+				"lnwire.SpliceComplete, instead have %v",
+				spew.Sdump(msg))
+		}
+
+		err := s.cfg.channel.CompleteSpliceFunding(
+			s.splice, s.localFundingSig, spliceComplete.FundingSig,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if err := s.finalizeSplice(); err != nil {
+			return nil, false, err
+		}
+
+		return nil, true, nil
+
+	// Otherwise, we're in an unknown state, and can't proceed.
+	default:
+		return nil, false, ErrInvalidState
+	}
+}
+
+// finalizeSplice persists the fully signed splice transaction, broadcasts it,
+// and hands the channel over to wait for its confirmation.
+func (s *channelSplicer) finalizeSplice() error {
+	chanState := s.cfg.channel.State()
+	if err := chanState.MarkSplicePending(s.splice); err != nil {
+		return err
+	}
+
+	s.state = spliceFinished
+
+	spliceTx := s.splice.SpliceTx
+	peerLog.Infof("ChannelPoint(%v): broadcasting splice tx: %v",
+		s.chanPoint, newLogClosure(func() string {
+			return spew.Sdump(spliceTx)
+		}),
+	)
+
+	err := s.cfg.broadcastTx(spliceTx, lnwallet.SpliceTxLabel(s.chanPoint))
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		peerLog.Errorf("ChannelPoint(%v): unable to broadcast splice "+
+			"tx: %v", s.chanPoint, err)
+	}
+
+	s.cfg.watchSplice(chanState)
+
+	return nil
+}
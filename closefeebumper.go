@@ -0,0 +1,662 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// coopCloseBumpConfTarget is the confirmation target used to estimate the fee
+// rate of automatic fee bumps of cooperative close transactions.
+const coopCloseBumpConfTarget = 3
+
+var (
+	// ErrNoCoopClose is returned when a fee bump is requested for a
+	// channel that doesn't have a cooperative close transaction waiting
+	// for confirmation.
+	ErrNoCoopClose = errors.New("no cooperative close transaction " +
+		"waiting for confirmation")
+
+	// ErrCloseRenegotiationPending is returned when a fee bump is
+	// requested for a channel whose closing fee is already being
+	// re-negotiated.
+	ErrCloseRenegotiationPending = errors.New("closing fee " +
+		"re-negotiation already in progress")
+)
+
+// CloseFeeBumperConfig houses the resources the closeFeeBumper needs to
+// rebroadcast cooperative close transactions and bump their fees.
+type CloseFeeBumperConfig struct {
+	// ChainIO is used to query the best block height.
+	ChainIO lnwallet.BlockChainIO
+
+	// Notifier provides the block epochs that drive the fee bumping, and
+	// is used to notify callers of manual fee bumps of the confirmation
+	// of the close transaction.
+	Notifier chainntnfs.ChainNotifier
+
+	// FetchWaitingCloseChannels returns all channels whose closing
+	// transaction hasn't confirmed yet.
+	FetchWaitingCloseChannels func() ([]*channeldb.OpenChannel, error)
+
+	// Estimator is used to determine the fee rate of automatic fee bumps.
+	Estimator lnwallet.FeeEstimator
+
+	// PublishTransaction broadcasts a transaction to the network, labeling
+	// it with the passed label.
+	PublishTransaction func(*wire.MsgTx, string) error
+
+	// RenegotiateClose hands the close request to the peer of the passed
+	// channel, which will then re-negotiate the closing fee. An error is
+	// returned if the peer isn't online.
+	RenegotiateClose func(*channeldb.OpenChannel,
+		*htlcswitch.ChanClose) error
+
+	// SweepInput offers an input to the sweeper, which will sweep it in
+	// time to confirm before the given deadline.
+	SweepInput func(sweep.Input, uint32) (chan sweep.Result, error)
+
+	// SweepInputAtFeeRate offers an input to the sweeper, which will
+	// sweep it at the given fee rate.
+	SweepInputAtFeeRate func(sweep.Input,
+		lnwallet.SatPerKWeight) (chan sweep.Result, error)
+
+	// DefaultBumpAfterBlocks is the number of blocks after which the fee
+	// of an unconfirmed close transaction is bumped, unless a different
+	// number was requested for the close.
+	DefaultBumpAfterBlocks uint32
+
+	// BumpConfTarget is the confirmation target used to estimate the fee
+	// rate of automatic fee bumps.
+	BumpConfTarget uint32
+}
+
+// closeFeeBumper makes sure that our cooperative close transactions confirm.
+// Close transactions are rebroadcast every block, and if they haven't
+// confirmed after a configured number of blocks, their fee is bumped. If the
+// remote peer is online, we'll re-negotiate the closing fee with it. Otherwise,
+// we'll fall back to child-pays-for-parent, spending our output of the close
+// transaction to a child paying a higher fee.
+//
+// NOTE: The closing fee can only be re-negotiated if the close transaction
+// signals replaceability, which requires both peers to signal support for
+// it. Otherwise, we'll always use CPFP.
+type closeFeeBumper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *CloseFeeBumperConfig
+
+	// renegotiating maps the channels whose closing fee is being
+	// re-negotiated to the height at which the re-negotiation was
+	// started.
+	renegotiating map[wire.OutPoint]uint32
+
+	// cpfpOffered tracks the channels whose output of the close
+	// transaction has been offered to the sweeper.
+	cpfpOffered map[wire.OutPoint]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+	sync.Mutex
+}
+
+// newCloseFeeBumper creates a new instance of the closeFeeBumper given the
+// passed config.
+func newCloseFeeBumper(cfg *CloseFeeBumperConfig) *closeFeeBumper {
+	return &closeFeeBumper{
+		cfg:           cfg,
+		renegotiating: make(map[wire.OutPoint]uint32),
+		cpfpOffered:   make(map[wire.OutPoint]struct{}),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start rebroadcasts all pending cooperative close transactions, and launches
+// the goroutine that bumps their fees.
+func (b *closeFeeBumper) Start() error {
+	if !atomic.CompareAndSwapUint32(&b.started, 0, 1) {
+		return nil
+	}
+
+	peerLog.Tracef("Starting close fee bumper")
+
+	blockEpochs, err := b.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	_, bestHeight, err := b.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		blockEpochs.Cancel()
+		return err
+	}
+
+	// Before waiting for new blocks, we'll rebroadcast the close txes
+	// that we're still waiting on, and resume any CPFP fee bumps.
+	b.processCloses(uint32(bestHeight))
+
+	b.wg.Add(1)
+	go b.bumper(blockEpochs)
+
+	return nil
+}
+
+// Stop signals the closeFeeBumper to exit, and waits for its goroutines to
+// finish.
+func (b *closeFeeBumper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&b.stopped, 0, 1) {
+		return nil
+	}
+
+	peerLog.Infof("Close fee bumper shutting down")
+
+	close(b.quit)
+	b.wg.Wait()
+
+	return nil
+}
+
+// bumper processes the pending cooperative closes on every new block.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *closeFeeBumper) bumper(blockEpochs *chainntnfs.BlockEpochEvent) {
+	defer b.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			b.processCloses(uint32(epoch.Height))
+
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// bumpAfterBlocks returns the number of blocks the described close
+// transaction may remain unconfirmed before its fee is bumped.
+func (b *closeFeeBumper) bumpAfterBlocks(
+	info *channeldb.CoopCloseInfo) uint32 {
+
+	if info.BumpAfterBlocks != 0 {
+		return info.BumpAfterBlocks
+	}
+
+	return b.cfg.DefaultBumpAfterBlocks
+}
+
+// bumpHeight returns the height at which the fee of the described close
+// transaction is bumped if it hasn't confirmed by then.
+func (b *closeFeeBumper) bumpHeight(info *channeldb.CoopCloseInfo) uint32 {
+	return info.BroadcastHeight + b.bumpAfterBlocks(info)
+}
+
+// processCloses rebroadcasts all cooperative close transactions that haven't
+// confirmed yet, and bumps the fees of those that are due at the given
+// height.
+func (b *closeFeeBumper) processCloses(height uint32) {
+	channels, err := b.cfg.FetchWaitingCloseChannels()
+	if err != nil {
+		peerLog.Errorf("Unable to fetch channels waiting close: %v",
+			err)
+		return
+	}
+
+	for _, channel := range channels {
+		// Channels that were force closed don't have any coop close
+		// info, we'll leave those to the chain arbitrator.
+		info, err := channel.CoopCloseInfo()
+		switch {
+		case err == channeldb.ErrNoCoopCloseInfo:
+			continue
+
+		case err != nil:
+			peerLog.Errorf("Unable to fetch coop close info for "+
+				"ChannelPoint(%v): %v", channel.FundingOutpoint,
+				err)
+			continue
+		}
+
+		b.processClose(channel, info, height)
+	}
+}
+
+// processClose rebroadcasts the close transaction of the given channel, and
+// bumps its fee if it is due.
+func (b *closeFeeBumper) processClose(channel *channeldb.OpenChannel,
+	info *channeldb.CoopCloseInfo, height uint32) {
+
+	b.Lock()
+	defer b.Unlock()
+
+	chanPoint := channel.FundingOutpoint
+
+	closeTxid := info.CloseTx.TxHash()
+	err := b.cfg.PublishTransaction(
+		info.CloseTx, lnwallet.CoopCloseTxLabel(chanPoint),
+	)
+	if err != nil {
+		peerLog.Debugf("Unable to rebroadcast close tx %v of "+
+			"ChannelPoint(%v): %v", closeTxid, chanPoint, err)
+	}
+
+	// If we've already resorted to CPFP, the sweeper takes care of the
+	// fee. We only need to make sure it is aware of our output, which
+	// won't be the case right after a restart.
+	if info.CPFP {
+		err := b.offerCloseOutput(chanPoint, info, 0, height)
+		if err != nil {
+			peerLog.Errorf("Unable to offer close output of "+
+				"ChannelPoint(%v) to sweeper: %v", chanPoint,
+				err)
+		}
+		return
+	}
+
+	if height < b.bumpHeight(info) {
+		return
+	}
+
+	// If a re-negotiation is in progress, we'll give the peer until the
+	// next bump height to finish it. If it hasn't by then, we'll stop
+	// relying on the peer and bump the fee ourselves.
+	cpfpOnly := false
+	if startHeight, ok := b.renegotiating[chanPoint]; ok {
+		if height < startHeight+b.bumpAfterBlocks(info) {
+			return
+		}
+
+		peerLog.Warnf("Closing fee re-negotiation for "+
+			"ChannelPoint(%v) didn't finish, falling back to CPFP",
+			chanPoint)
+
+		delete(b.renegotiating, chanPoint)
+		cpfpOnly = true
+	}
+
+	feeRate, err := b.cfg.Estimator.EstimateFeePerKW(b.cfg.BumpConfTarget)
+	if err != nil {
+		peerLog.Errorf("Unable to estimate fee rate for bumping close "+
+			"tx of ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+	if feeRate < lnwallet.FeePerKwFloor {
+		feeRate = lnwallet.FeePerKwFloor
+	}
+
+	peerLog.Infof("Close tx %v of ChannelPoint(%v) unconfirmed since "+
+		"height %v, bumping fee to %v sat/kw", closeTxid, chanPoint,
+		info.BroadcastHeight, int64(feeRate))
+
+	err = b.bumpFee(channel, info, feeRate, height, cpfpOnly, nil, nil)
+	if err != nil {
+		peerLog.Errorf("Unable to bump fee of close tx %v of "+
+			"ChannelPoint(%v): %v", closeTxid, chanPoint, err)
+	}
+}
+
+// BumpFee bumps the fee of the cooperative close transaction of the given
+// channel to the passed fee rate, regardless of how long it has been
+// unconfirmed. The returned channels deliver the status of the close, just
+// like the ones returned for the initial close request.
+func (b *closeFeeBumper) BumpFee(chanPoint wire.OutPoint,
+	feeRate lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
+	chan error) {
+
+	updates := make(chan *lnrpc.CloseStatusUpdate, 2)
+	errChan := make(chan error, 1)
+
+	channel, info, err := b.fetchCoopClose(chanPoint)
+	if err != nil {
+		errChan <- err
+		return updates, errChan
+	}
+
+	_, bestHeight, err := b.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		errChan <- err
+		return updates, errChan
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	if _, ok := b.renegotiating[chanPoint]; ok {
+		errChan <- ErrCloseRenegotiationPending
+		return updates, errChan
+	}
+
+	err = b.bumpFee(
+		channel, info, feeRate, uint32(bestHeight), false, updates,
+		errChan,
+	)
+	if err != nil {
+		errChan <- err
+	}
+
+	return updates, errChan
+}
+
+// fetchCoopClose returns the channel with the given channel point, along with
+// the info of its cooperative close transaction.
+func (b *closeFeeBumper) fetchCoopClose(chanPoint wire.OutPoint) (
+	*channeldb.OpenChannel, *channeldb.CoopCloseInfo, error) {
+
+	channels, err := b.cfg.FetchWaitingCloseChannels()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.FundingOutpoint != chanPoint {
+			continue
+		}
+
+		info, err := channel.CoopCloseInfo()
+		switch {
+		case err == channeldb.ErrNoCoopCloseInfo:
+			return nil, nil, ErrNoCoopClose
+
+		case err != nil:
+			return nil, nil, err
+		}
+
+		return channel, info, nil
+	}
+
+	return nil, nil, ErrNoCoopClose
+}
+
+// bumpFee bumps the fee of the close transaction of the given channel to the
+// passed fee rate. If the peer is online, the close transaction is
+// replaceable, and the closing fee can still be raised, the fee is
+// re-negotiated with the peer. Otherwise, our output of the
+// close transaction is swept by a child transaction paying the fee rate. If
+// updates and errChan are set, the status of the close is delivered on them.
+//
+// NOTE: The caller MUST hold the closeFeeBumper's lock.
+func (b *closeFeeBumper) bumpFee(channel *channeldb.OpenChannel,
+	info *channeldb.CoopCloseInfo, feeRate lnwallet.SatPerKWeight,
+	height uint32, cpfpOnly bool, updates chan *lnrpc.CloseStatusUpdate,
+	errChan chan error) error {
+
+	chanPoint := channel.FundingOutpoint
+
+	// The closing fee may not exceed the fee of the commitment
+	// transaction, so we'll only re-negotiate if that leaves room for an
+	// increase.
+	newFee := feeRate.FeeForWeight(lnwallet.CommitWeight)
+	if newFee > channel.LocalCommitment.CommitFee {
+		newFee = channel.LocalCommitment.CommitFee
+	}
+	oldFee := closeTxFee(channel.Capacity, info.CloseTx)
+
+	replaceable := lnwallet.IsReplaceableCoopClose(info.CloseTx)
+	if !cpfpOnly && !info.CPFP && replaceable && newFee > oldFee {
+		req := &htlcswitch.ChanClose{
+			CloseType:      htlcswitch.CloseRegular,
+			ChanPoint:      &chanPoint,
+			TargetFeePerKw: feeRate,
+			Updates:        make(chan *lnrpc.CloseStatusUpdate, 2),
+			Err:            make(chan error, 1),
+		}
+		err := b.cfg.RenegotiateClose(channel, req)
+		if err == nil {
+			b.renegotiating[chanPoint] = height
+
+			b.wg.Add(1)
+			go b.waitForRenegotiation(chanPoint, req, updates, errChan)
+
+			return nil
+		}
+
+		peerLog.Infof("Unable to re-negotiate closing fee of "+
+			"ChannelPoint(%v), falling back to CPFP: %v",
+			chanPoint, err)
+	}
+
+	// From here on, we'll bump the fee using CPFP. We persist this, as we
+	// can no longer re-negotiate the closing fee without invalidating the
+	// child.
+	if !info.CPFP {
+		info.CPFP = true
+		if err := channel.MarkCoopBroadcasted(info); err != nil {
+			return err
+		}
+	}
+
+	// Automatic fee bumps let the sweeper aim for confirmation within the
+	// configured target, while manual ones use the requested fee rate.
+	var cpfpFeeRate lnwallet.SatPerKWeight
+	if updates != nil {
+		cpfpFeeRate = feeRate
+	}
+	err := b.offerCloseOutput(chanPoint, info, cpfpFeeRate, height)
+	if err != nil {
+		return err
+	}
+
+	if updates == nil {
+		return nil
+	}
+
+	closeTxid := info.CloseTx.TxHash()
+	updates <- &lnrpc.CloseStatusUpdate{
+		Update: &lnrpc.CloseStatusUpdate_ClosePending{
+			ClosePending: &lnrpc.PendingUpdate{
+				Txid: closeTxid[:],
+			},
+		},
+	}
+
+	go waitForChanToClose(info.BroadcastHeight, b.cfg.Notifier, errChan,
+		&chanPoint, &closeTxid, info.CloseTx.TxOut[0].PkScript, func() {
+			updates <- &lnrpc.CloseStatusUpdate{
+				Update: &lnrpc.CloseStatusUpdate_ChanClose{
+					ChanClose: &lnrpc.ChannelCloseUpdate{
+						ClosingTxid: closeTxid[:],
+						Success:     true,
+					},
+				},
+			}
+		})
+
+	return nil
+}
+
+// waitForRenegotiation waits for the re-negotiation of the closing fee of the
+// given channel to finish, forwarding its status to updates and errChan if
+// set.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *closeFeeBumper) waitForRenegotiation(chanPoint wire.OutPoint,
+	req *htlcswitch.ChanClose, updates chan *lnrpc.CloseStatusUpdate,
+	errChan chan error) {
+
+	defer b.wg.Done()
+
+	done := func() {
+		b.Lock()
+		delete(b.renegotiating, chanPoint)
+		b.Unlock()
+	}
+
+	for {
+		select {
+		case update := <-req.Updates:
+			if updates != nil {
+				updates <- update
+			}
+
+			switch update.Update.(type) {
+			// Once the new close tx has been broadcast, the
+			// re-negotiation is done. We'll keep forwarding
+			// updates to the caller of a manual fee bump until the
+			// close confirms.
+			case *lnrpc.CloseStatusUpdate_ClosePending:
+				done()
+				if updates == nil {
+					return
+				}
+
+			case *lnrpc.CloseStatusUpdate_ChanClose:
+				return
+			}
+
+		case err := <-req.Err:
+			done()
+
+			peerLog.Errorf("Unable to re-negotiate closing fee of "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+
+			if errChan != nil {
+				errChan <- err
+			}
+			return
+
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// offerCloseOutput offers our output of the close transaction to the sweeper,
+// such that the child transaction sweeping it bumps the fee of the close
+// transaction. If feeRate is zero, the sweeper will aim for the child to
+// confirm within the configured target.
+//
+// NOTE: The caller MUST hold the closeFeeBumper's lock.
+func (b *closeFeeBumper) offerCloseOutput(chanPoint wire.OutPoint,
+	info *channeldb.CoopCloseInfo, feeRate lnwallet.SatPerKWeight,
+	height uint32) error {
+
+	// Without an explicit fee rate, there's nothing to do if the sweeper
+	// is already aware of our output.
+	if _, ok := b.cpfpOffered[chanPoint]; ok && feeRate == 0 {
+		return nil
+	}
+
+	closeTxid := info.CloseTx.TxHash()
+	outputIndex := -1
+	for i, txOut := range info.CloseTx.TxOut {
+		if bytes.Equal(txOut.PkScript, info.LocalDeliveryScript) {
+			outputIndex = i
+			break
+		}
+	}
+	if outputIndex == -1 {
+		return fmt.Errorf("close tx %v has no output paying to us",
+			closeTxid)
+	}
+
+	// We can only sweep outputs paying to a key of our wallet.
+	if !txscript.IsPayToWitnessPubKeyHash(info.LocalDeliveryScript) {
+		return fmt.Errorf("close tx %v doesn't pay to a p2wkh output",
+			closeTxid)
+	}
+
+	input := sweep.MakeBaseInput(
+		&wire.OutPoint{
+			Hash:  closeTxid,
+			Index: uint32(outputIndex),
+		},
+		lnwallet.WitnessKeyHash,
+		&lnwallet.SignDescriptor{
+			Output:   info.CloseTx.TxOut[outputIndex],
+			HashType: txscript.SigHashAll,
+		},
+		info.BroadcastHeight,
+	)
+
+	var (
+		resultChan chan sweep.Result
+		err        error
+	)
+	if feeRate != 0 {
+		resultChan, err = b.cfg.SweepInputAtFeeRate(&input, feeRate)
+	} else {
+		resultChan, err = b.cfg.SweepInput(
+			&input, height+b.cfg.BumpConfTarget,
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	peerLog.Infof("Bumping fee of close tx %v of ChannelPoint(%v) "+
+		"using CPFP", closeTxid, chanPoint)
+
+	b.cpfpOffered[chanPoint] = struct{}{}
+
+	b.wg.Add(1)
+	go b.waitForSweep(chanPoint, closeTxid, resultChan)
+
+	return nil
+}
+
+// waitForSweep waits for our output of the close transaction to be swept.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *closeFeeBumper) waitForSweep(chanPoint wire.OutPoint,
+	closeTxid chainhash.Hash, resultChan chan sweep.Result) {
+
+	defer b.wg.Done()
+
+	select {
+	case result := <-resultChan:
+		switch result.Err {
+		case nil:
+			peerLog.Infof("Output of close tx %v of "+
+				"ChannelPoint(%v) swept by %v", closeTxid,
+				chanPoint, result.Tx.TxHash())
+
+		case sweep.ErrRemoteSpend:
+			peerLog.Warnf("Output of close tx %v of "+
+				"ChannelPoint(%v) spent by a third party tx",
+				closeTxid, chanPoint)
+
+		// If the sweep failed, we'll offer the output again on the
+		// next block.
+		default:
+			peerLog.Errorf("Unable to sweep output of close tx %v "+
+				"of ChannelPoint(%v): %v", closeTxid, chanPoint,
+				result.Err)
+
+			b.Lock()
+			delete(b.cpfpOffered, chanPoint)
+			b.Unlock()
+		}
+
+	case <-b.quit:
+	}
+}
+
+// closeTxFee returns the fee paid by the close transaction of a channel with
+// the given capacity.
+func closeTxFee(capacity btcutil.Amount, closeTx *wire.MsgTx) btcutil.Amount {
+	fee := capacity
+	for _, txOut := range closeTx.TxOut {
+		fee -= btcutil.Amount(txOut.Value)
+	}
+
+	return fee
+}
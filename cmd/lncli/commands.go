@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -108,7 +109,17 @@ var newAddressCommand = cli.Command{
 	Description: `
 	Generate a wallet new address. Address-types has to be one of:
 	    - p2wkh:  Pay to witness key hash
-	    - np2wkh: Pay to nested witness key hash`,
+	    - np2wkh: Pay to nested witness key hash
+
+	If --account is set, the address is derived from the watch-only
+	account with that name instead, and the address-type is omitted.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) the watch-only account to derive " +
+				"the address from",
+		},
+	},
 	Action: actionDecorator(newAddress),
 }
 
@@ -127,13 +138,19 @@ func newAddress(ctx *cli.Context) error {
 	case "np2wkh":
 		addrType = lnrpc.NewAddressRequest_NESTED_PUBKEY_HASH
 	default:
-		return fmt.Errorf("invalid address type %v, support address type "+
-			"are: p2wkh and np2wkh", stringAddrType)
+		// Watch-only accounts use their own address type, so none
+		// needs to be given.
+		if !ctx.IsSet("account") {
+			return fmt.Errorf("invalid address type %v, support "+
+				"address type are: p2wkh and np2wkh",
+				stringAddrType)
+		}
 	}
 
 	ctxb := context.Background()
 	addr, err := client.NewAddress(ctxb, &lnrpc.NewAddressRequest{
-		Type: addrType,
+		Type:    addrType,
+		Account: ctx.String("account"),
 	})
 	if err != nil {
 		return err
@@ -154,6 +171,10 @@ var sendCoinsCommand = cli.Command{
 	Fees used when sending the transaction can be specified via the --conf_target, or
 	--sat_per_byte optional flags.
 
+	If --sweepall is set, all confirmed wallet outputs that aren't locked are
+	sent to the address, and the amount is omitted. The amount that remains
+	after paying the fee is returned.
+
 	Positional arguments and flags can be used interchangeably but not at the same time!
 	`,
 	Flags: []cli.Flag{
@@ -178,6 +199,18 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the format " +
+				"txid:output_index of a wallet output to " +
+				"spend, can be specified multiple times to " +
+				"spend exactly the given outputs",
+		},
+		cli.BoolFlag{
+			Name: "sweepall",
+			Usage: "(optional) send all confirmed wallet " +
+				"outputs, the amount must not be set",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -211,6 +244,11 @@ func sendCoins(ctx *cli.Context) error {
 	}
 
 	switch {
+	case ctx.Bool("sweepall"):
+		if ctx.IsSet("amt") || args.Present() {
+			return fmt.Errorf("amount can't be set when sweeping " +
+				"all coins")
+		}
 	case ctx.IsSet("amt"):
 		amt = ctx.Int64("amt")
 	case args.Present():
@@ -223,6 +261,11 @@ func sendCoins(ctx *cli.Context) error {
 		return fmt.Errorf("unable to decode amount: %v", err)
 	}
 
+	outpoints, err := parseOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -232,6 +275,8 @@ func sendCoins(ctx *cli.Context) error {
 		Amount:     amt,
 		TargetConf: int32(ctx.Int64("conf_target")),
 		SatPerByte: ctx.Int64("sat_per_byte"),
+		Outpoints:  outpoints,
+		SendAll:    ctx.Bool("sweepall"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -266,6 +311,13 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
 				"used when crafting the transaction",
 		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the format " +
+				"txid:output_index of a wallet output to " +
+				"spend, can be specified multiple times to " +
+				"spend exactly the given outputs",
+		},
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -283,6 +335,11 @@ func sendMany(ctx *cli.Context) error {
 			"set, but not both")
 	}
 
+	outpoints, err := parseOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -291,6 +348,7 @@ func sendMany(ctx *cli.Context) error {
 		AddrToAmount: amountToAddr,
 		TargetConf:   int32(ctx.Int64("conf_target")),
 		SatPerByte:   ctx.Int64("sat_per_byte"),
+		Outpoints:    outpoints,
 	})
 	if err != nil {
 		return err
@@ -407,8 +465,19 @@ var openChannelCommand = cli.Command{
 	amount to the remote node as part of the channel opening. Once the channel is open,
 	a channelPoint (txid:vout) of the funding output is returned.
 
+	Channels above 16777215 satoshis can only be opened if both nodes signal
+	support for large channels, and the amount doesn't exceed the maxchansize
+	of the local node.
+
 	One can manually set the fee to be used for the funding transaction via either
-	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	the --conf_target or --sat_per_byte arguments. This is optional.
+
+	If the --psbt flag is set, the channel is funded from an external wallet
+	rather than the internal one. Once the peer has accepted the channel, the
+	address and amount of the funding output are printed. A transaction paying
+	exactly that amount to the address must then be pasted, either as a
+	base64 encoded finalized PSBT or as a hex encoded raw transaction. It may
+	only spend confirmed segwit outputs.`,
 	ArgsUsage: "node-key local-amt push-amt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -420,11 +489,11 @@ var openChannelCommand = cli.Command{
 			Name:  "connect",
 			Usage: "(optional) the host:port of the target node",
 		},
-		cli.IntFlag{
+		cli.Int64Flag{
 			Name:  "local_amt",
 			Usage: "the number of satoshis the wallet should commit to the channel",
 		},
-		cli.IntFlag{
+		cli.Int64Flag{
 			Name: "push_amt",
 			Usage: "the number of satoshis to give the remote side " +
 				"as part of the initial commitment state, " +
@@ -476,6 +545,20 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.BoolFlag{
+			Name: "psbt",
+			Usage: "(optional) fund the channel with a transaction " +
+				"crafted by an external wallet, provided as " +
+				"a PSBT or raw transaction",
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the format " +
+				"txid:output_index of a wallet output to " +
+				"spend, can be specified multiple times to " +
+				"fund the channel with exactly the given " +
+				"outputs",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -547,7 +630,7 @@ func openChannel(ctx *cli.Context) error {
 
 	switch {
 	case ctx.IsSet("local_amt"):
-		req.LocalFundingAmount = int64(ctx.Int("local_amt"))
+		req.LocalFundingAmount = ctx.Int64("local_amt")
 	case args.Present():
 		req.LocalFundingAmount, err = strconv.ParseInt(args.First(), 10, 64)
 		if err != nil {
@@ -559,7 +642,7 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	if ctx.IsSet("push_amt") {
-		req.PushSat = int64(ctx.Int("push_amt"))
+		req.PushSat = ctx.Int64("push_amt")
 	} else if args.Present() {
 		req.PushSat, err = strconv.ParseInt(args.First(), 10, 64)
 		if err != nil {
@@ -568,6 +651,12 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.PsbtFunding = ctx.Bool("psbt")
+
+	req.Outpoints, err = parseOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
 
 	stream, err := client.OpenChannel(ctxb, req)
 	if err != nil {
@@ -583,6 +672,12 @@ func openChannel(ctx *cli.Context) error {
 		}
 
 		switch update := resp.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			err := finalizePsbtFunding(ctxb, client, update.PsbtFund)
+			if err != nil {
+				return err
+			}
+
 		case *lnrpc.OpenStatusUpdate_ChanPending:
 			txid, err := chainhash.NewHash(update.ChanPending.Txid)
 			if err != nil {
@@ -636,6 +731,209 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+// psbtMagic is the magic byte sequence that every serialized PSBT starts with.
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// finalizePsbtFunding prints the funding output of a channel that is funded
+// from an external wallet, and then prompts the user for the transaction
+// paying to it. The transaction is handed to the daemon, which resumes the
+// funding flow with the remote peer.
+func finalizePsbtFunding(ctxb context.Context, client lnrpc.LightningClient,
+	psbtFund *lnrpc.ReadyForPsbtFunding) error {
+
+	printJSON(struct {
+		FundingAddress string `json:"funding_address"`
+		FundingAmount  int64  `json:"funding_amount"`
+		PendingChanID  string `json:"pending_chan_id"`
+	}{
+		FundingAddress: psbtFund.FundingAddress,
+		FundingAmount:  psbtFund.FundingAmount,
+		PendingChanID:  hex.EncodeToString(psbtFund.PendingChanId),
+	})
+
+	fmt.Printf("\nCraft a transaction that pays exactly %v satoshis to "+
+		"%v, spending only confirmed segwit outputs.\n",
+		psbtFund.FundingAmount, psbtFund.FundingAddress)
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Print("Enter the base64 encoded finalized PSBT or the hex " +
+			"encoded raw transaction: ")
+
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		input = strings.TrimSpace(input)
+
+		req := &lnrpc.FinalizeFundingRequest{
+			PendingChanId: psbtFund.PendingChanId,
+		}
+		rawTx, hexErr := hex.DecodeString(input)
+		psbt, base64Err := base64.StdEncoding.DecodeString(input)
+		switch {
+		case hexErr == nil && bytes.HasPrefix(rawTx, psbtMagic):
+			req.SignedPsbt = rawTx
+
+		case hexErr == nil:
+			req.FinalRawTx = rawTx
+
+		case base64Err == nil:
+			req.SignedPsbt = psbt
+
+		default:
+			fmt.Println("Unable to decode transaction, it must be " +
+				"either hex or base64 encoded.")
+			continue
+		}
+
+		// If the transaction is rejected, the reservation is left
+		// intact, so the user is able to try again.
+		if _, err := client.FinalizeFunding(ctxb, req); err != nil {
+			fmt.Printf("Unable to finalize funding: %v\n", err)
+			continue
+		}
+
+		return nil
+	}
+}
+
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open several channels to existing peers with a single " +
+		"transaction.",
+	Description: `
+	Attempt to open a channel to each of the given peers, all of which are
+	funded by a single transaction. The funding transaction is only
+	broadcast once every peer has accepted and signed its channel. If
+	opening any of the channels fails, none of them is opened.
+
+	The channels are specified as a JSON array, e.g.:
+
+	    '[{"node_pubkey": "<hex>", "local_funding_amount": 500000,
+	       "push_sat": 0, "private": false}]'
+
+	Each channel may additionally set min_htlc_msat and remote_csv_delay.
+
+	One can manually set the fee to be used for the funding transaction via either
+	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the format " +
+				"txid:output_index of a wallet output to " +
+				"spend, can be specified multiple times to " +
+				"fund the channels with exactly the given " +
+				"outputs",
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+// batchChannel is the JSON representation of a single channel passed to the
+// batchopenchannel command.
+type batchChannel struct {
+	NodePubkey         string `json:"node_pubkey"`
+	LocalFundingAmount int64  `json:"local_funding_amount"`
+	PushSat            int64  `json:"push_sat"`
+	Private            bool   `json:"private"`
+	MinHtlcMsat        int64  `json:"min_htlc_msat"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay"`
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "batchopenchannel")
+	}
+
+	var channels []batchChannel
+	err := json.Unmarshal([]byte(ctx.Args().First()), &channels)
+	if err != nil {
+		return fmt.Errorf("unable to parse channels: %v", err)
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	outpoints, err := parseOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.BatchOpenChannelRequest{
+		Channels:   make([]*lnrpc.BatchOpenChannel, len(channels)),
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+		MinConfs:   int32(ctx.Uint64("min_confs")),
+		Outpoints:  outpoints,
+	}
+	for i, channel := range channels {
+		nodePubHex, err := hex.DecodeString(channel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key "+
+				"of channel %v: %v", i, err)
+		}
+
+		req.Channels[i] = &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubHex,
+			LocalFundingAmount: channel.LocalFundingAmount,
+			PushSat:            channel.PushSat,
+			Private:            channel.Private,
+			MinHtlcMsat:        channel.MinHtlcMsat,
+			RemoteCsvDelay:     channel.RemoteCsvDelay,
+		}
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	pendingChans := make([]string, len(resp.PendingChannels))
+	for i, pendingChan := range resp.PendingChannels {
+		txid, err := chainhash.NewHash(pendingChan.Txid)
+		if err != nil {
+			return err
+		}
+		pendingChans[i] = fmt.Sprintf("%v:%v", txid,
+			pendingChan.OutputIndex)
+	}
+
+	printJSON(struct {
+		ChannelPoints []string `json:"channel_points"`
+	}{
+		ChannelPoints: pendingChans,
+	})
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -655,6 +953,16 @@ var closeChannelCommand = cli.Command{
 	--sat_per_byte arguments. This will be the starting value used during
 	fee negotiation. This is optional.
 
+	If a cooperative closing transaction hasn't confirmed after a number of
+	blocks, which can be set via the --bump_after_blocks argument, its fee
+	is bumped automatically. The fee of a closing transaction that has
+	already been broadcast can also be bumped manually by passing --bump_fee
+	along with either --conf_target or --sat_per_byte. If the peer is
+	online and the closing transaction signals replaceability, which is the
+	case if both nodes support it, the closing fee is re-negotiated.
+	Otherwise, our output of the closing transaction is spent by a child
+	paying a higher fee (CPFP).
+
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
 	The format for a channel_point is 'funding_txid:output_index'.`,
@@ -695,6 +1003,17 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Uint64Flag{
+			Name: "bump_after_blocks",
+			Usage: "(optional) the number of blocks after which " +
+				"the fee of the cooperative closing " +
+				"transaction is bumped if it hasn't confirmed",
+		},
+		cli.BoolFlag{
+			Name: "bump_fee",
+			Usage: "bump the fee of the already broadcast " +
+				"cooperative closing transaction",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -716,10 +1035,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		BumpAfterBlocks: uint32(ctx.Uint64("bump_after_blocks")),
+		BumpFee:         ctx.Bool("bump_fee"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1145,7 +1466,51 @@ var createCommand = cli.Command{
 	was provided by the user. This should be written down as it can be used
 	to potentially recover all on-chain funds, and most off-chain funds as
 	well.
+
+	If --stateless_init is set, lnd generates the seed itself and never
+	shows it. Instead, the seed is encrypted with the optional mnemonic
+	passphrase and written to the file given by --seed_file on lnd's host.
+	The admin macaroon of the new wallet is printed, or written to the file
+	given by --save_to.
+
+	When restoring a wallet from an existing mnemonic, a static channel
+	backup can be passed along with one of the --single_backup,
+	--multi_backup or --multi_file flags. Once lnd has started, it will
+	connect to each of the channel peers in the backup to close the
+	channels and recover their settled funds.
 	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "stateless_init",
+			Usage: "let lnd generate the seed and write it " +
+				"encrypted to --seed_file instead of " +
+				"displaying it",
+		},
+		cli.StringFlag{
+			Name: "seed_file",
+			Usage: "the path on lnd's host the encrypted seed is " +
+				"written to when using --stateless_init",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "the file the admin macaroon is written to " +
+				"when using --stateless_init",
+		},
+		cli.StringFlag{
+			Name: "single_backup",
+			Usage: "a hex encoded single channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name: "multi_backup",
+			Usage: "a hex encoded multi-channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name:  "multi_file",
+			Usage: "the path to a multi-channel back up file",
+		},
+	},
 	Action: actionDecorator(create),
 }
 
@@ -1181,6 +1546,17 @@ func create(ctx *cli.Context) error {
 	client, cleanUp := getWalletUnlockerClient(ctx)
 	defer cleanUp()
 
+	// Channels can only be restored along with an existing seed, so we
+	// parse any backups before prompting for anything.
+	chanBackups, err := parseChanBackupSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	if chanBackups != nil && ctx.Bool("stateless_init") {
+		return fmt.Errorf("channel backups can't be restored with " +
+			"stateless_init")
+	}
+
 	// First, we'll prompt the user for their passphrase twice to ensure
 	// both attempts match up properly.
 	fmt.Printf("Input wallet password: ")
@@ -1202,6 +1578,10 @@ func create(ctx *cli.Context) error {
 		return fmt.Errorf("passwords don't match")
 	}
 
+	if ctx.Bool("stateless_init") {
+		return createStateless(ctx, client, pw1)
+	}
+
 	// Next, we'll see if the user has 24-word mnemonic they want to use to
 	// derive a seed within the wallet.
 	var (
@@ -1242,6 +1622,10 @@ mnemonicCheck:
 		aezeedPass         []byte
 		recoveryWindow     int32
 	)
+	if !hasMnemonic && chanBackups != nil {
+		return fmt.Errorf("channel backups can only be restored with " +
+			"an existing cipher seed mnemonic")
+	}
 	if hasMnemonic {
 		// We'll now prompt the user to enter in their 24-word
 		// mnemonic.
@@ -1387,6 +1771,7 @@ mnemonicCheck:
 		CipherSeedMnemonic: cipherSeedMnemonic,
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
+		ChannelBackups:     chanBackups,
 	}
 	if _, err := client.InitWallet(ctxb, req); err != nil {
 		return err
@@ -1397,6 +1782,68 @@ mnemonicCheck:
 	return nil
 }
 
+// createStateless initializes the wallet with a seed that's generated by lnd
+// and only written to a file in its encrypted form, so it never shows up in
+// the terminal.
+func createStateless(ctx *cli.Context, client lnrpc.WalletUnlockerClient,
+	password []byte) error {
+
+	seedFile := ctx.String("seed_file")
+	if seedFile == "" {
+		return fmt.Errorf("seed_file must be set with stateless_init")
+	}
+
+	fmt.Printf("Input an optional passphrase to encrypt the seed with " +
+		"(or press enter to proceed without a cipher seed " +
+		"passphrase): ")
+	aezeedPass1, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if len(aezeedPass1) != 0 {
+		fmt.Printf("Confirm cipher seed passphrase: ")
+		aezeedPass2, err := terminal.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+
+		if !bytes.Equal(aezeedPass1, aezeedPass2) {
+			return fmt.Errorf("cipher seed pass phrases don't match")
+		}
+	}
+
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:    password,
+		AezeedPassphrase:  aezeedPass1,
+		StatelessInit:     true,
+		EncryptedSeedFile: seedFile,
+	}
+	resp, err := client.InitWallet(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\nlnd successfully initialized, the encrypted seed was "+
+		"written to %v\n", seedFile)
+
+	if saveTo := ctx.String("save_to"); saveTo != "" {
+		err := ioutil.WriteFile(saveTo, resp.AdminMacaroon, 0600)
+		if err != nil {
+			return fmt.Errorf("unable to write admin macaroon: %v",
+				err)
+		}
+		fmt.Printf("Admin macaroon written to %v\n", saveTo)
+		return nil
+	}
+
+	fmt.Printf("Admin macaroon: %x\n", resp.AdminMacaroon)
+
+	return nil
+}
+
 var unlockCommand = cli.Command{
 	Name:     "unlock",
 	Category: "Startup",
@@ -1406,6 +1853,10 @@ var unlockCommand = cli.Command{
 	start up. This command MUST be run after booting up lnd before it's
 	able to carry out its duties. An exception is if a user is running with
 	--noseedbackup, then a default passphrase will be used.
+
+	If the wallet was created with --stateless_init, then --stateless_init
+	must also be set when unlocking it, so that lnd doesn't write any
+	macaroon files to disk.
 	`,
 	Flags: []cli.Flag{
 		cli.IntFlag{
@@ -1416,6 +1867,11 @@ var unlockCommand = cli.Command{
 				"maximum number of consecutive, unused " +
 				"addresses ever generated by the wallet.",
 		},
+		cli.BoolFlag{
+			Name: "stateless_init",
+			Usage: "don't write any macaroon files to disk, as " +
+				"the wallet was created with --stateless_init",
+		},
 	},
 	Action: actionDecorator(unlock),
 }
@@ -1452,6 +1908,7 @@ func unlock(ctx *cli.Context) error {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: pw,
 		RecoveryWindow: recoveryWindow,
+		StatelessInit:  ctx.Bool("stateless_init"),
 	}
 	_, err = client.UnlockWallet(ctxb, req)
 	if err != nil {
@@ -2837,11 +3294,32 @@ func decodePayReq(ctx *cli.Context) error {
 }
 
 var listChainTxnsCommand = cli.Command{
-	Name:        "listchaintxns",
-	Category:    "On-chain",
-	Usage:       "List transactions from the wallet.",
-	Description: "List all transactions an address of the wallet was involved in.",
-	Action:      actionDecorator(listChainTxns),
+	Name:     "listchaintxns",
+	Category: "On-chain",
+	Usage:    "List transactions from the wallet.",
+	Description: `
+	List all transactions an address of the wallet was involved in, along
+	with their labels.
+
+	The transactions can be limited to those confirmed within a range of
+	block heights. Unconfirmed transactions are only listed if no end
+	height, or an end height of -1, is given.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_height",
+			Usage: "the height from which to list transactions, " +
+				"inclusive",
+		},
+		cli.Int64Flag{
+			Name: "end_height",
+			Usage: "the height until which to list transactions, " +
+				"inclusive; -1 to include unconfirmed " +
+				"transactions",
+			Value: -1,
+		},
+	},
+	Action: actionDecorator(listChainTxns),
 }
 
 func listChainTxns(ctx *cli.Context) error {
@@ -2849,7 +3327,11 @@ func listChainTxns(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.GetTransactions(ctxb, &lnrpc.GetTransactionsRequest{})
+	req := &lnrpc.GetTransactionsRequest{
+		StartHeight: int32(ctx.Int64("start_height")),
+		EndHeight:   int32(ctx.Int64("end_height")),
+	}
+	resp, err := client.GetTransactions(ctxb, req)
 
 	if err != nil {
 		return err
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var spliceCommand = cli.Command{
+	Name:     "splice",
+	Category: "Channels",
+	Usage:    "Splice funds into or out of an existing channel.",
+	Description: `
+	Splicing resizes an existing channel without closing it. The new
+	funding transaction spends the current funding output, and the channel
+	continues to operate under a new channel point once it confirms. The
+	peer must signal support for splicing, and the channel must not have
+	any HTLCs in flight.`,
+	Subcommands: []cli.Command{
+		spliceInCommand,
+		spliceOutCommand,
+	},
+}
+
+// spliceFlags are the flags shared by the splice in and splice out commands.
+var spliceFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "funding_txid",
+		Usage: "the txid of the channel's funding transaction",
+	},
+	cli.IntFlag{
+		Name: "output_index",
+		Usage: "the output index for the funding output of the " +
+			"funding transaction",
+	},
+	cli.Int64Flag{
+		Name:  "amt",
+		Usage: "the number of satoshis to splice",
+	},
+	cli.Int64Flag{
+		Name: "conf_target",
+		Usage: "(optional) the number of blocks that the " +
+			"transaction *should* confirm in, will be " +
+			"used for fee estimation",
+	},
+	cli.Int64Flag{
+		Name: "sat_per_byte",
+		Usage: "(optional) a manual fee expressed in " +
+			"sat/byte that should be used when crafting " +
+			"the transaction",
+	},
+}
+
+var spliceInCommand = cli.Command{
+	Name:  "in",
+	Usage: "Add funds of the wallet to an existing channel.",
+	Description: `
+	Add --amt satoshis from the wallet to our balance of the channel with
+	the given channel point. The fee of the splice transaction is paid by
+	the wallet as well.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of " +
+				"confirmations each one of your outputs used " +
+				"for the splice transaction must satisfy",
+			Value: 1,
+		},
+	}, spliceFlags...),
+	Action: actionDecorator(spliceIn),
+}
+
+func spliceIn(ctx *cli.Context) error {
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "in")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint: channelPoint,
+		SpliceInAmt:  ctx.Int64("amt"),
+		MinConfs:     int32(ctx.Uint64("min_confs")),
+	}
+
+	return spliceChannel(ctx, req)
+}
+
+var spliceOutCommand = cli.Command{
+	Name:  "out",
+	Usage: "Remove funds from an existing channel.",
+	Description: `
+	Remove --amt satoshis from our balance of the channel with the given
+	channel point, and send them to --addr, or a new address of the wallet
+	if no address is given. The fee of the splice transaction is paid from
+	our channel balance as well.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "(optional) the address to send the spliced " +
+				"out funds to",
+		},
+	}, spliceFlags...),
+	Action: actionDecorator(spliceOut),
+}
+
+func spliceOut(ctx *cli.Context) error {
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "out")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint: channelPoint,
+		SpliceOutAmt: ctx.Int64("amt"),
+		Addr:         ctx.String("addr"),
+	}
+
+	return spliceChannel(ctx, req)
+}
+
+// spliceChannel sets the fee related parameters of the passed request and
+// sends it to lnd, printing the new channel point of the spliced channel.
+func spliceChannel(ctx *cli.Context, req *lnrpc.SpliceChannelRequest) error {
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should " +
+			"be set, but not both")
+	}
+	req.TargetConf = int32(ctx.Int64("conf_target"))
+	req.SatPerByte = ctx.Int64("sat_per_byte")
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SpliceChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	newChanPoint := resp.NewChannelPoint
+	txid, err := chainhash.NewHash(newChanPoint.GetFundingTxidBytes())
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		ChannelPoint string `json:"channel_point"`
+	}{
+		ChannelPoint: fmt.Sprintf("%v:%v", txid,
+			newChanPoint.OutputIndex),
+	})
+	return nil
+}
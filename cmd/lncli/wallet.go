@@ -0,0 +1,511 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var walletCommand = cli.Command{
+	Name:     "wallet",
+	Category: "Wallet",
+	Usage:    "Interact with the wallet.",
+	Subcommands: []cli.Command{
+		bumpFeeCommand,
+		listUnspentCommand,
+		leaseOutputCommand,
+		releaseOutputCommand,
+		labelTxCommand,
+		importAccountCommand,
+		listAccountsCommand,
+		fundPsbtCommand,
+	},
+}
+
+// parseOutPoint parses an outpoint of the format txid:output_index.
+func parseOutPoint(s string) (*lnrpc.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("expected outpoint of format " +
+			"txid:output_index")
+	}
+
+	outputIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
+	}
+
+	return &lnrpc.OutPoint{
+		TxidStr:     parts[0],
+		OutputIndex: uint32(outputIndex),
+	}, nil
+}
+
+var bumpFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	Usage:     "Bumps the fee of an unconfirmed transaction.",
+	ArgsUsage: "outpoint",
+	Description: `
+	Speeds up the confirmation of an unconfirmed transaction by spending one
+	of its outputs in a child transaction paying a higher fee
+	(child-pays-for-parent). The outpoint, of the format txid:output_index,
+	must refer to a p2wkh output that is controlled by the wallet, for
+	instance the change output of a funding transaction.
+
+	The fee of the child transaction is specified via either the
+	--conf_target or the --sat_per_byte flag. The child transaction is
+	rebroadcast, and its fee bumped as the confirmation target approaches,
+	until it confirms.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the child " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_byte",
+			Usage: "a manual fee expressed in sat/byte that " +
+				"the child transaction should pay",
+		},
+	},
+	Action: actionDecorator(bumpFee),
+}
+
+func bumpFee(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "bumpfee")
+	}
+
+	outpoint, err := parseOutPoint(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.BumpFeeRequest{
+		Outpoint:   outpoint,
+		TargetConf: uint32(ctx.Uint64("conf_target")),
+		SatPerByte: uint32(ctx.Uint64("sat_per_byte")),
+	}
+	resp, err := client.BumpFee(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseOutPoints parses a list of outpoints of the format txid:output_index.
+func parseOutPoints(ss []string) ([]*lnrpc.OutPoint, error) {
+	outpoints := make([]*lnrpc.OutPoint, 0, len(ss))
+	for _, s := range ss {
+		outpoint, err := parseOutPoint(s)
+		if err != nil {
+			return nil, err
+		}
+		outpoints = append(outpoints, outpoint)
+	}
+
+	return outpoints, nil
+}
+
+var listUnspentCommand = cli.Command{
+	Name:  "listunspent",
+	Usage: "List the unspent outputs of the wallet.",
+	Description: `
+	Lists the unspent witness outputs of the wallet that are available for
+	spending, along with their number of confirmations. Outputs that are
+	leased are not included.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "min_confs",
+			Usage: "the minimum number of confirmations of the " +
+				"listed outputs",
+			Value: 1,
+		},
+		cli.Int64Flag{
+			Name: "max_confs",
+			Usage: "the maximum number of confirmations of the " +
+				"listed outputs, 0 means no limit",
+		},
+	},
+	Action: actionDecorator(listUnspent),
+}
+
+func listUnspent(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListUnspentRequest{
+		MinConfs: int32(ctx.Int64("min_confs")),
+		MaxConfs: int32(ctx.Int64("max_confs")),
+	}
+	resp, err := client.ListUnspent(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var leaseOutputCommand = cli.Command{
+	Name:      "leaseoutput",
+	Usage:     "Lease an output of the wallet.",
+	ArgsUsage: "lock_id outpoint",
+	Description: `
+	Locks an output of the wallet, of the format txid:output_index, under
+	the given hex encoded 32-byte lock ID. A leased output won't be used by
+	coin selection until it's released using the same lock ID, or until the
+	lease expires. Leasing an output again under the same lock ID extends
+	the lease.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "the number of seconds the lease lasts, if " +
+				"unset the wallet's default is used",
+		},
+	},
+	Action: actionDecorator(leaseOutput),
+}
+
+// parseLockID parses a hex encoded output lease ID.
+func parseLockID(s string) ([]byte, error) {
+	lockID, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lock id: %v", err)
+	}
+	if len(lockID) != 32 {
+		return nil, errors.New("lock id must be 32 bytes")
+	}
+
+	return lockID, nil
+}
+
+func leaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "leaseoutput")
+	}
+
+	lockID, err := parseLockID(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	outpoint, err := parseOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.LeaseOutputRequest{
+		Id:                lockID,
+		Outpoint:          outpoint,
+		ExpirationSeconds: ctx.Uint64("expiry"),
+	}
+	resp, err := client.LeaseOutput(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Usage:     "Release a leased output of the wallet.",
+	ArgsUsage: "lock_id outpoint",
+	Description: `
+	Releases an output of the wallet, of the format txid:output_index, that
+	was leased under the given hex encoded 32-byte lock ID, making it
+	available for coin selection again.
+	`,
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "releaseoutput")
+	}
+
+	lockID, err := parseLockID(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	outpoint, err := parseOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ReleaseOutputRequest{
+		Id:       lockID,
+		Outpoint: outpoint,
+	}
+	resp, err := client.ReleaseOutput(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var labelTxCommand = cli.Command{
+	Name:      "labeltx",
+	Usage:     "Set the label of a wallet transaction.",
+	ArgsUsage: "txid label",
+	Description: `
+	Sets the label of a transaction relevant to the wallet. If the
+	transaction already has a label, for instance one set by the node when
+	it created the transaction, the --overwrite flag must be set to replace
+	it. Labels are shown by listchaintxns.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "overwrite",
+			Usage: "replace the existing label of the transaction",
+		},
+	},
+	Action: actionDecorator(labelTx),
+}
+
+func labelTx(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "labeltx")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.LabelTransactionRequest{
+		TxidStr:   ctx.Args().Get(0),
+		Label:     ctx.Args().Get(1),
+		Overwrite: ctx.Bool("overwrite"),
+	}
+	resp, err := client.LabelTransaction(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importAccountCommand = cli.Command{
+	Name:      "importaccount",
+	Usage:     "Import an extended public key as a watch-only account.",
+	ArgsUsage: "name extended-public-key",
+	Description: `
+	Imports the BIP32 extended public key of an account, such as the
+	account of a cold storage wallet, as a watch-only account. The account's
+	receive and change addresses are derived from the branches 0 and 1 of
+	the key. The chain is scanned for the account's transactions starting at
+	the birthday height, after which its balance is shown by walletbalance,
+	and its transactions by listchaintxns.
+
+	The master key fingerprint and derivation path of the key are included
+	in the PSBTs created by fundpsbt, so that a hardware wallet can locate
+	the keys needed to sign them.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "address_type",
+			Value: "p2wkh",
+			Usage: "the type of the account's addresses, either " +
+				"p2wkh or np2wkh",
+		},
+		cli.StringFlag{
+			Name: "master_key_fingerprint",
+			Usage: "the hex-encoded 4-byte fingerprint of the " +
+				"master key the account was derived from",
+		},
+		cli.StringFlag{
+			Name: "derivation_path",
+			Usage: "the derivation path of the account key, " +
+				"e.g. m/84'/0'/0'",
+		},
+		cli.Uint64Flag{
+			Name: "birthday_height",
+			Usage: "the height from which to scan the chain for " +
+				"the account's transactions",
+		},
+	},
+	Action: actionDecorator(importAccount),
+}
+
+func importAccount(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "importaccount")
+	}
+
+	var addrType lnrpc.NewAddressRequest_AddressType
+	switch ctx.String("address_type") {
+	case "p2wkh":
+		addrType = lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH
+	case "np2wkh":
+		addrType = lnrpc.NewAddressRequest_NESTED_PUBKEY_HASH
+	default:
+		return fmt.Errorf("invalid address type %v, supported "+
+			"address types are: p2wkh and np2wkh",
+			ctx.String("address_type"))
+	}
+
+	fingerprint, err := hex.DecodeString(
+		ctx.String("master_key_fingerprint"),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode master key fingerprint: "+
+			"%v", err)
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ImportAccountRequest{
+		Name:                 ctx.Args().Get(0),
+		ExtendedPublicKey:    ctx.Args().Get(1),
+		AddressType:          addrType,
+		MasterKeyFingerprint: fingerprint,
+		DerivationPath:       ctx.String("derivation_path"),
+		BirthdayHeight:       uint32(ctx.Uint64("birthday_height")),
+	}
+	resp, err := client.ImportAccount(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAccountsCommand = cli.Command{
+	Name:   "listaccounts",
+	Usage:  "List the watch-only accounts.",
+	Action: actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAccounts(ctxb, &lnrpc.ListAccountsRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fundPsbtCommand = cli.Command{
+	Name:      "fundpsbt",
+	Usage:     "Create an unsigned PSBT spending from a watch-only account.",
+	ArgsUsage: "send-json-string",
+	Description: `
+	Creates an unsigned PSBT that spends from the given watch-only account,
+	paying to the outputs of the JSON string, which has the same format as
+	the one of sendmany: '{"ExampleAddr": NumCoinsInSatoshis}'. Change is
+	sent to a new change address of the account. The base64 encoded PSBT
+	can be signed by the wallet holding the account's private keys.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "the name of the watch-only account to spend " +
+				"from",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction should confirm in, will be used " +
+				"for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Int64Flag{
+			Name:  "min_confs",
+			Value: 1,
+			Usage: "the minimum number of confirmations of the " +
+				"spent outputs",
+		},
+	},
+	Action: actionDecorator(fundPsbt),
+}
+
+func fundPsbt(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || !ctx.IsSet("account") {
+		return cli.ShowCommandHelp(ctx, "fundpsbt")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should " +
+			"be set, but not both")
+	}
+
+	var amountToAddr map[string]int64
+	jsonMap := ctx.Args().First()
+	if err := json.Unmarshal([]byte(jsonMap), &amountToAddr); err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.FundPsbtRequest{
+		Account:      ctx.String("account"),
+		AddrToAmount: amountToAddr,
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerByte:   ctx.Int64("sat_per_byte"),
+		MinConfs:     int32(ctx.Int64("min_confs")),
+	}
+	resp, err := client.FundPsbt(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
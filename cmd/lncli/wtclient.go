@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var wtclientCommand = cli.Command{
+	Name:     "wtclient",
+	Category: "Watchtower",
+	Usage:    "Interact with the watchtower client.",
+	Subcommands: []cli.Command{
+		addTowerCommand,
+		removeTowerCommand,
+		listTowersCommand,
+		towerClientStatsCommand,
+	},
+}
+
+var addTowerCommand = cli.Command{
+	Name:      "add",
+	Usage:     "Register a watchtower to use for future sessions/backups.",
+	ArgsUsage: "pubkey@address",
+	Description: `
+	If the watchtower has already been registered, then this command serves
+	as a way of updating the watchtower with new addresses it is reachable
+	over.`,
+	Action: actionDecorator(addTower),
+}
+
+func addTower(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "add")
+	}
+
+	parts := strings.Split(ctx.Args().First(), "@")
+	if len(parts) != 2 {
+		return errors.New("expected tower of format pubkey@address")
+	}
+	pubKey, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+	address := parts[1]
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.AddTowerRequest{
+		Pubkey:  pubKey,
+		Address: address,
+	}
+	resp, err := client.AddTower(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeTowerCommand = cli.Command{
+	Name: "remove",
+	Usage: "Remove a watchtower to prevent its use for future " +
+		"sessions/backups.",
+	ArgsUsage: "pubkey | pubkey@address",
+	Description: `
+	An optional address can be provided to remove, indicating that the
+	watchtower is no longer reachable at this address. If an address isn't
+	provided, then the watchtower will no longer be used for future sessions
+	and backups.`,
+	Action: actionDecorator(removeTower),
+}
+
+func removeTower(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "remove")
+	}
+
+	// The command can have only one argument, but it can be interpreted in
+	// either of the following formats:
+	//
+	//   pubkey or pubkey@address
+	//
+	// The hex-encoded public key of the watchtower is always expected to be
+	// the first element/argument.
+	parts := strings.Split(ctx.Args().First(), "@")
+	if len(parts) > 2 {
+		return errors.New("expected tower of format pubkey@address")
+	}
+
+	pubKey, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+
+	var address string
+	if len(parts) == 2 {
+		address = parts[1]
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.RemoveTowerRequest{
+		Pubkey:  pubKey,
+		Address: address,
+	}
+	resp, err := client.RemoveTower(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listTowersCommand = cli.Command{
+	Name:  "towers",
+	Usage: "Display information about all registered watchtowers.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "include_sessions",
+			Usage: "include sessions with the watchtower in the " +
+				"response",
+		},
+	},
+	Action: actionDecorator(listTowers),
+}
+
+func listTowers(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() > 0 || ctx.NumFlags() > 1 {
+		return cli.ShowCommandHelp(ctx, "towers")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListTowersRequest{
+		IncludeSessions: ctx.Bool("include_sessions"),
+	}
+	resp, err := client.ListTowers(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var towerClientStatsCommand = cli.Command{
+	Name:   "stats",
+	Usage:  "Display the session stats of the watchtower client.",
+	Action: actionDecorator(towerClientStats),
+}
+
+func towerClientStats(ctx *cli.Context) error {
+	if ctx.NArg() > 0 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "stats")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.TowerClientStatsRequest{}
+	resp, err := client.TowerClientStats(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
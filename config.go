@@ -5,6 +5,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -19,14 +20,19 @@ import (
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/watchtower"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
 )
 
 const (
@@ -53,6 +59,10 @@ const (
 	defaultMaxLogFiles         = 3
 	defaultMaxLogFileSize      = 10
 
+	defaultSignerMacFilename       = "signer.macaroon"
+	defaultWalletKitMacFilename    = "walletkit.macaroon"
+	defaultRemoteSignerMacFilename = "remotesigner.macaroon"
+
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
 	defaultTorDNSPort              = 53
@@ -62,12 +72,28 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	// defaultCoopCloseBumpBlocks is the default number of blocks a
+	// cooperative close transaction may remain unconfirmed before we
+	// attempt to bump its fee.
+	defaultCoopCloseBumpBlocks = 36
+
+	// defaultAcceptorTimeout is the default time we'll wait for a
+	// ChannelAcceptor RPC client to decide on an inbound channel.
+	defaultAcceptorTimeout = 15 * time.Second
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
 
 	defaultAlias = ""
 	defaultColor = "#3399FF"
+
+	defaultTowerSubDirname = "watchtower"
+
+	// defaultWtClientSweepFeeRate is the default fee rate, in sat/byte,
+	// that the watchtower client will request towers use when sweeping a
+	// breached channel.
+	defaultWtClientSweepFeeRate = 10
 )
 
 var (
@@ -148,6 +174,99 @@ type autoPilotConfig struct {
 	MinConfs       int32   `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
 }
 
+type dualFundingConfig struct {
+	Active           bool     `long:"active" description:"Signal support for dual funder channels, and contribute funds to channels opened by peers that allow it."`
+	MaxContribution  int64    `long:"maxcontribution" description:"The maximum amount in satoshis we contribute to a dual funder channel opened by a peer. We never contribute more than the peer itself."`
+	PeerContribution []string `long:"peercontribution" description:"Overrides the maximum contribution for a single peer, specified as <pubkey>:<amount in satoshis>. Use an amount of zero to never contribute to channels of the peer. Can be specified multiple times."`
+
+	// peerContributions is the parsed version of PeerContribution.
+	peerContributions map[[33]byte]btcutil.Amount
+}
+
+// contribution returns the maximum amount we contribute to a dual funder
+// channel opened by the given peer.
+func (d *dualFundingConfig) contribution(peer *btcec.PublicKey) btcutil.Amount {
+	var peerKey [33]byte
+	copy(peerKey[:], peer.SerializeCompressed())
+	if amt, ok := d.peerContributions[peerKey]; ok {
+		return amt
+	}
+
+	return btcutil.Amount(d.MaxContribution)
+}
+
+// parsePeerContributions parses the per peer contribution overrides, each of
+// which has the form <pubkey>:<amount in satoshis>.
+func parsePeerContributions(
+	overrides []string) (map[[33]byte]btcutil.Amount, error) {
+
+	contributions := make(map[[33]byte]btcutil.Amount, len(overrides))
+	for _, override := range overrides {
+		parts := strings.Split(override, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid peer contribution %q, "+
+				"expected <pubkey>:<amount>", override)
+		}
+
+		pubKeyBytes, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pubkey in peer "+
+				"contribution %q: %v", override, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid pubkey in peer "+
+				"contribution %q: %v", override, err)
+		}
+
+		amt, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || amt < 0 {
+			return nil, fmt.Errorf("invalid amount in peer "+
+				"contribution %q", override)
+		}
+
+		var peerKey [33]byte
+		copy(peerKey[:], pubKey.SerializeCompressed())
+		contributions[peerKey] = btcutil.Amount(amt)
+	}
+
+	return contributions, nil
+}
+
+// parseZeroConfPeers parses the hex encoded public keys of the peers on the
+// zero-conf allow list.
+func parseZeroConfPeers(pubKeys []string) (map[[33]byte]struct{}, error) {
+	peers := make(map[[33]byte]struct{}, len(pubKeys))
+	for _, pubKeyStr := range pubKeys {
+		pubKeyBytes, err := hex.DecodeString(pubKeyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %q: %v",
+				pubKeyStr, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %q: %v",
+				pubKeyStr, err)
+		}
+
+		var peerKey [33]byte
+		copy(peerKey[:], pubKey.SerializeCompressed())
+		peers[peerKey] = struct{}{}
+	}
+
+	return peers, nil
+}
+
+// isZeroConfPeer returns whether the given peer is on the zero-conf allow
+// list.
+func (c *config) isZeroConfPeer(peer *btcec.PublicKey) bool {
+	var peerKey [33]byte
+	copy(peerKey[:], peer.SerializeCompressed())
+	_, ok := c.zeroConfPeers[peerKey]
+
+	return ok
+}
+
 type torConfig struct {
 	Active          bool   `long:"active" description:"Allow outbound and inbound connections to be routed through Tor"`
 	SOCKS           string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
@@ -177,10 +296,15 @@ type config struct {
 	AdminMacPath   string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
 	ReadMacPath    string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
 	InvoiceMacPath string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	BackupFilePath string `long:"backupfilepath" description:"The target location of the channel backup file"`
 	LogDir         string `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
 	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
+	SignerMacPath       string `long:"signermacaroonpath" description:"Path to write the signer macaroon, which grants access to the Signer service, if it doesn't exist"`
+	WalletKitMacPath    string `long:"walletkitmacaroonpath" description:"Path to write the wallet kit macaroon, which grants access to the WalletKit service, if it doesn't exist"`
+	RemoteSignerMacPath string `long:"remotesignermacaroonpath" description:"Path to write the remote signer macaroon, which grants access to the RemoteSigner service, if it doesn't exist and --remotesigner.serve is set"`
+
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
 	// command line library can access them.
@@ -207,6 +331,10 @@ type config struct {
 	UnsafeReplay       bool `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
 	MaxPendingChannels int  `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
 
+	FeeURL string `long:"feeurl" description:"Optional URL of a fee estimation API returning fee rates in sat/kvB by confirmation target. If set, its estimates are used instead of those of the chain backend."`
+
+	CoopCloseBumpBlocks uint32 `long:"coopclosebumpblocks" description:"The number of blocks a cooperative close transaction may remain unconfirmed before its fee is bumped, by re-negotiating the closing fee with the peer if it is online, or using CPFP otherwise."`
+
 	Bitcoin      *chainConfig    `group:"Bitcoin" namespace:"bitcoin"`
 	BtcdMode     *btcdConfig     `group:"btcd" namespace:"btcd"`
 	BitcoindMode *bitcoindConfig `group:"bitcoind" namespace:"bitcoind"`
@@ -218,6 +346,8 @@ type config struct {
 
 	Autopilot *autoPilotConfig `group:"Autopilot" namespace:"autopilot"`
 
+	DualFunding *dualFundingConfig `group:"dualfunding" namespace:"dualfunding"`
+
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
@@ -226,18 +356,38 @@ type config struct {
 
 	NoSeedBackup bool `long:"noseedbackup" description:"If true, NO SEED WILL BE EXPOSED AND THE WALLET WILL BE ENCRYPTED USING THE DEFAULT PASSPHRASE -- EVER. THIS FLAG IS ONLY FOR TESTING AND IS BEING DEPRECATED."`
 
+	WalletUnlockPasswordFile string `long:"wallet-unlock-password-file" description:"The full path to a file that contains the password for unlocking the wallet. If set, the wallet is unlocked automatically at startup instead of waiting for the UnlockWallet RPC. Trailing newlines are ignored."`
+
 	TrickleDelay        int           `long:"trickledelay" description:"Time in milliseconds between each release of announcements to the network"`
 	InactiveChanTimeout time.Duration `long:"inactivechantimeout" description:"If a channel has been inactive for the set time, send a ChannelUpdate disabling it."`
 
 	Alias       string `long:"alias" description:"The node alias. Used as a moniker by peers and intelligence services"`
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
+	MaxChanSize int64  `long:"maxchansize" description:"The largest channel size (in satoshis) that we should accept or open. Defaults to the 2^24 satoshi limit of BOLT 2, which may only be exceeded if largechannels is set"`
+
+	LargeChannels bool `long:"largechannels" description:"Signal support for channels above the 2^24 satoshi limit of BOLT 2. Such channels are only opened and accepted if the peer signals support for them as well"`
+
+	Splicing bool `long:"splicing" description:"Signal support for splicing funds into and out of existing channels. Splices are only initiated and accepted if the peer signals support for them as well"`
+
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex encoded public key of a peer whose channels with us are usable before their funding transaction confirms. Only allow peers you fully trust, as a double spend of the funding transaction results in the loss of any funds received over the channel. Can be specified multiple times."`
+
+	// zeroConfPeers is the parsed version of ZeroConfPeers.
+	zeroConfPeers map[[33]byte]struct{}
+
+	AcceptorTimeout time.Duration `long:"acceptortimeout" description:"Time after which an inbound channel is rejected if a connected ChannelAcceptor RPC client hasn't decided on it yet."`
 
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
+
+	Watchtower *watchtower.Conf `group:"watchtower" namespace:"watchtower"`
+
+	WtClient *wtclient.Conf `group:"wtclient" namespace:"wtclient"`
+
+	RemoteSigner *remotesigner.Conf `group:"remotesigner" namespace:"remotesigner"`
 }
 
 // loadConfig initializes and parses the config using a config file and command
@@ -291,25 +441,39 @@ func loadConfig() (*config, error) {
 			Dir:     defaultLitecoindDir,
 			RPCHost: defaultRPCHost,
 		},
-		MaxPendingChannels: defaultMaxPendingChannels,
-		NoSeedBackup:       defaultNoSeedBackup,
+		MaxPendingChannels:  defaultMaxPendingChannels,
+		CoopCloseBumpBlocks: defaultCoopCloseBumpBlocks,
+		NoSeedBackup:        defaultNoSeedBackup,
 		Autopilot: &autoPilotConfig{
 			MaxChannels:    5,
 			Allocation:     0.6,
 			MinChannelSize: int64(minChanFundingSize),
 			MaxChannelSize: int64(maxFundingAmount),
 		},
+		DualFunding:         &dualFundingConfig{},
 		TrickleDelay:        defaultTrickleDelay,
 		InactiveChanTimeout: defaultInactiveChanTimeout,
 		Alias:               defaultAlias,
 		Color:               defaultColor,
 		MinChanSize:         int64(minChanFundingSize),
+		AcceptorTimeout:     defaultAcceptorTimeout,
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
 			Control: defaultTorControl,
 		},
 		net: &tor.ClearNet{},
+		Watchtower: &watchtower.Conf{
+			ReadTimeout:  watchtower.DefaultReadTimeout,
+			WriteTimeout: watchtower.DefaultWriteTimeout,
+		},
+		WtClient: &wtclient.Conf{
+			SweepFeeRate: defaultWtClientSweepFeeRate,
+			MaxUpdates:   wtclient.DefaultMaxUpdates,
+		},
+		RemoteSigner: &remotesigner.Conf{
+			Timeout: remotesigner.DefaultTimeout,
+		},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -400,12 +564,26 @@ func loadConfig() (*config, error) {
 	cfg.AdminMacPath = cleanAndExpandPath(cfg.AdminMacPath)
 	cfg.ReadMacPath = cleanAndExpandPath(cfg.ReadMacPath)
 	cfg.InvoiceMacPath = cleanAndExpandPath(cfg.InvoiceMacPath)
+	cfg.SignerMacPath = cleanAndExpandPath(cfg.SignerMacPath)
+	cfg.WalletKitMacPath = cleanAndExpandPath(cfg.WalletKitMacPath)
+	cfg.RemoteSignerMacPath = cleanAndExpandPath(cfg.RemoteSignerMacPath)
+	cfg.BackupFilePath = cleanAndExpandPath(cfg.BackupFilePath)
+	cfg.WalletUnlockPasswordFile = cleanAndExpandPath(
+		cfg.WalletUnlockPasswordFile,
+	)
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.BtcdMode.Dir = cleanAndExpandPath(cfg.BtcdMode.Dir)
 	cfg.LtcdMode.Dir = cleanAndExpandPath(cfg.LtcdMode.Dir)
 	cfg.BitcoindMode.Dir = cleanAndExpandPath(cfg.BitcoindMode.Dir)
 	cfg.LitecoindMode.Dir = cleanAndExpandPath(cfg.LitecoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
+	cfg.Watchtower.TowerDir = cleanAndExpandPath(cfg.Watchtower.TowerDir)
+	cfg.RemoteSigner.MacaroonPath = cleanAndExpandPath(
+		cfg.RemoteSigner.MacaroonPath,
+	)
+	cfg.RemoteSigner.TLSCertPath = cleanAndExpandPath(
+		cfg.RemoteSigner.TLSCertPath,
+	)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -440,14 +618,39 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
-	// Ensure that the specified values for the min and max channel size
-	// don't are within the bounds of the normal chan size constraints.
+	// Ensure that the specified minimum channel size is within the bounds
+	// of the normal chan size constraints. The maximum is validated once
+	// the active chain is known.
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
 		cfg.Autopilot.MinChannelSize = int64(minChanFundingSize)
 	}
-	if cfg.Autopilot.MaxChannelSize > int64(maxFundingAmount) {
-		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
+
+	// Validate the dual funding parameters, and parse the per peer
+	// contribution overrides.
+	if cfg.DualFunding.MaxContribution < 0 {
+		str := "%s: dualfunding.maxcontribution must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	peerContributions, err := parsePeerContributions(
+		cfg.DualFunding.PeerContribution,
+	)
+	if err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	cfg.DualFunding.peerContributions = peerContributions
+
+	// Parse the peers whose channels we don't wait for confirmations of.
+	zeroConfPeers, err := parseZeroConfPeers(cfg.ZeroConfPeers)
+	if err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
 	}
+	cfg.zeroConfPeers = zeroConfPeers
 
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
@@ -763,13 +966,40 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Unless specified, the largest channel we accept or open is the
+	// soft-limit of the active chain, which may only be exceeded if we
+	// signal support for large channels.
+	if cfg.MaxChanSize == 0 {
+		cfg.MaxChanSize = int64(maxFundingAmount)
+	}
+	switch {
+	case cfg.MaxChanSize < cfg.MinChanSize:
+		str := "%s: maxchansize must be at least minchansize"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.MaxChanSize > int64(btcutil.MaxSatoshi):
+		str := "%s: maxchansize must not exceed %v"
+		err := fmt.Errorf(str, funcName, btcutil.MaxSatoshi)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.MaxChanSize > int64(maxFundingAmount) && !cfg.LargeChannels:
+		str := "%s: maxchansize above %v requires largechannels to " +
+			"be set"
+		err := fmt.Errorf(str, funcName, maxFundingAmount)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Ensure that the specified values for the min and max channel size
 	// don't are within the bounds of the normal chan size constraints.
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
 		cfg.Autopilot.MinChannelSize = int64(minChanFundingSize)
 	}
-	if cfg.Autopilot.MaxChannelSize > int64(maxFundingAmount) {
-		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
+	if cfg.Autopilot.MaxChannelSize > cfg.MaxChanSize {
+		cfg.Autopilot.MaxChannelSize = cfg.MaxChanSize
 	}
 
 	// Validate profile port number.
@@ -810,6 +1040,37 @@ func loadConfig() (*config, error) {
 			networkDir, defaultInvoiceMacFilename,
 		)
 	}
+	if cfg.SignerMacPath == "" {
+		cfg.SignerMacPath = filepath.Join(
+			networkDir, defaultSignerMacFilename,
+		)
+	}
+	if cfg.WalletKitMacPath == "" {
+		cfg.WalletKitMacPath = filepath.Join(
+			networkDir, defaultWalletKitMacFilename,
+		)
+	}
+	if cfg.RemoteSignerMacPath == "" {
+		cfg.RemoteSignerMacPath = filepath.Join(
+			networkDir, defaultRemoteSignerMacFilename,
+		)
+	}
+
+	// Similarly, if a custom back up file path wasn't specified, then
+	// we'll update the file location to match our set network directory.
+	if cfg.BackupFilePath == "" {
+		cfg.BackupFilePath = filepath.Join(
+			networkDir, chanbackup.DefaultBackupFileName,
+		)
+	}
+
+	// If a custom watchtower directory wasn't specified, we'll store the
+	// tower's database within the data directory.
+	if cfg.Watchtower.TowerDir == "" {
+		cfg.Watchtower.TowerDir = filepath.Join(
+			cfg.DataDir, defaultTowerSubDirname,
+		)
+	}
 
 	// Append the network type to the log directory so it is "namespaced"
 	// per network in the same fashion as the data directory.
@@ -934,6 +1195,102 @@ func loadConfig() (*config, error) {
 		}
 	}
 
+	// If the watchtower is active, ensure it has at least one address to
+	// accept clients on, adding the default port to each listener if
+	// needed.
+	if cfg.Watchtower.Active {
+		if len(cfg.Watchtower.RawListeners) == 0 {
+			addr := fmt.Sprintf(":%d", watchtower.DefaultPeerPort)
+			cfg.Watchtower.RawListeners = append(
+				cfg.Watchtower.RawListeners, addr,
+			)
+		}
+
+		cfg.Watchtower.Listeners, err = lncfg.NormalizeAddresses(
+			cfg.Watchtower.RawListeners,
+			strconv.Itoa(watchtower.DefaultPeerPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, towerListener := range cfg.Watchtower.Listeners {
+			if lncfg.IsUnix(towerListener) {
+				err := fmt.Errorf("unix socket addresses "+
+					"cannot be used for the watchtower "+
+					"listener: %s", towerListener)
+				return nil, err
+			}
+		}
+	}
+
+	// If the watchtower client is active, ensure its policy is sane and
+	// parse the towers it should back up to.
+	if cfg.WtClient.Active {
+		if cfg.WtClient.SweepFeeRate == 0 {
+			return nil, errors.New("wtclient.sweepfeerate must be " +
+				"positive")
+		}
+		if cfg.WtClient.MaxUpdates == 0 {
+			return nil, errors.New("wtclient.maxupdates must be " +
+				"positive")
+		}
+
+		for _, tower := range cfg.WtClient.Towers {
+			towerAddr, err := parseTowerAddr(
+				tower, cfg.net.ResolveTCPAddr,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid wtclient.towers "+
+					"%q: %v", tower, err)
+			}
+
+			cfg.WtClient.TowerAddrs = append(
+				cfg.WtClient.TowerAddrs, towerAddr,
+			)
+		}
+	}
+
+	// A node can either use a remote signer or act as one, and the signer
+	// service must be protected by a macaroon as it hands out private
+	// keys.
+	switch {
+	case cfg.RemoteSigner.Active && cfg.RemoteSigner.Serve:
+		return nil, errors.New("remotesigner.active and " +
+			"remotesigner.serve can't both be set")
+
+	case cfg.RemoteSigner.Active:
+		if cfg.RemoteSigner.RPCHost == "" ||
+			cfg.RemoteSigner.MacaroonPath == "" ||
+			cfg.RemoteSigner.TLSCertPath == "" {
+
+			return nil, errors.New("remotesigner.rpchost, " +
+				"remotesigner.macaroonpath and " +
+				"remotesigner.tlscertpath must be set to use " +
+				"a remote signer")
+		}
+		if cfg.RemoteSigner.Timeout <= 0 {
+			return nil, errors.New("remotesigner.timeout must be " +
+				"positive")
+		}
+
+	case cfg.RemoteSigner.Serve && cfg.NoMacaroons:
+		return nil, errors.New("remotesigner.serve can't be used " +
+			"with no-macaroons")
+	}
+
+	if cfg.AcceptorTimeout <= 0 {
+		return nil, errors.New("acceptortimeout must be positive")
+	}
+
+	// The default password used with --noseedbackup can't be overridden
+	// by a password file.
+	if cfg.NoSeedBackup && cfg.WalletUnlockPasswordFile != "" {
+		return nil, errors.New("wallet-unlock-password-file can't be " +
+			"used with noseedbackup")
+	}
+
 	// Finally, ensure that we are only listening on localhost if Tor
 	// inbound support is enabled.
 	if cfg.Tor.V2 || cfg.Tor.V3 {
@@ -958,6 +1315,40 @@ func loadConfig() (*config, error) {
 	return &cfg, nil
 }
 
+// parseTowerAddr parses a watchtower address of the form pubkey@host:port. If
+// no port is specified, the default watchtower port is used. The resolver is
+// used to resolve the tower's host.
+func parseTowerAddr(tower string, resolver func(string,
+	string) (*net.TCPAddr, error)) (*lnwire.NetAddress, error) {
+
+	parts := strings.Split(tower, "@")
+	if len(parts) != 2 {
+		return nil, errors.New("tower must be of the form " +
+			"pubkey@host:port")
+	}
+
+	pubKeyBytes, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := lncfg.ParseAddressString(
+		parts[1], strconv.Itoa(watchtower.DefaultPeerPort), resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address:     addr,
+	}, nil
+}
+
 // cleanAndExpandPath expands environment variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 // This function is taken from https://github.com/btcsuite/btcd
@@ -13,6 +13,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // ErrChainArbExiting signals that the chain arbitrator is shutting down.
@@ -65,10 +66,11 @@ type ChainArbitratorConfig struct {
 	// NOTE: This SHOULD return a p2wkh script.
 	NewSweepAddr func() ([]byte, error)
 
-	// PublishTx reliably broadcasts a transaction to the network. Once
-	// this function exits without an error, then they transaction MUST
-	// continually be rebroadcast if needed.
-	PublishTx func(*wire.MsgTx) error
+	// PublishTx reliably broadcasts a transaction to the network,
+	// labeling it with the passed label. Once this function exits without
+	// an error, then they transaction MUST continually be rebroadcast if
+	// needed.
+	PublishTx func(*wire.MsgTx, string) error
 
 	// DeliverResolutionMsg is a function that will append an outgoing
 	// message to the "out box" for a ChannelLink. This is used to cancel
@@ -127,9 +129,18 @@ type ChainArbitratorConfig struct {
 	// ChainIO allows us to query the state of the current main chain.
 	ChainIO lnwallet.BlockChainIO
 
+	// Sweeper allows resolvers to sweep their final outputs back into the
+	// wallet, batched with any other outputs that are being swept.
+	Sweeper *sweep.UtxoSweeper
+
 	// DisableChannel disables a channel, resulting in it not being able to
 	// forward payments.
 	DisableChannel func(wire.OutPoint) error
+
+	// NotifyClosedChannel is a function closure that will be called once
+	// a channel has been marked as closed within the database, either
+	// pending or fully resolved.
+	NotifyClosedChannel func(wire.OutPoint)
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -247,10 +258,16 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return chanMachine.ForceClose()
 		},
 		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
-		MarkChannelClosed:         channel.CloseChannel,
-		IsPendingClose:            false,
-		ChainArbitratorConfig:     c.cfg,
-		ChainEvents:               chanEvents,
+		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
+			if err := channel.CloseChannel(summary); err != nil {
+				return err
+			}
+			c.cfg.NotifyClosedChannel(summary.ChanPoint)
+			return nil
+		},
+		IsPendingClose:        false,
+		ChainArbitratorConfig: c.cfg,
+		ChainEvents:           chanEvents,
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -355,6 +372,7 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				notifyClosedChannel: c.cfg.NotifyClosedChannel,
 			},
 		)
 		if err != nil {
@@ -645,6 +663,21 @@ func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.Msg
 	return closeTx, nil
 }
 
+// GetChannelArbitrator safely returns the channel arbitrator for a given
+// channel outpoint.
+func (c *ChainArbitrator) GetChannelArbitrator(chanPoint wire.OutPoint) (
+	*ChannelArbitrator, error) {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unable to find arbitrator")
+	}
+
+	return arbitrator, nil
+}
+
 // WatchNewChannel sends the ChainArbitrator a message to create a
 // ChannelArbitrator tasked with watching over a new channel. Once a new
 // channel has finished its final funding flow, it should be registered with
@@ -675,6 +708,7 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			notifyClosedChannel: c.cfg.NotifyClosedChannel,
 		},
 	)
 	if err != nil {
@@ -703,6 +737,44 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 	return chainWatcher.Start()
 }
 
+// SpliceContract is called once the splice transaction of a channel has
+// confirmed, and the channel has moved to its new funding output. The
+// arbitrator and chain watcher of the spent funding output are torn down, and
+// new ones are created to watch over the channel at its new funding output.
+func (c *ChainArbitrator) SpliceContract(oldChanPoint wire.OutPoint,
+	newChan *channeldb.OpenChannel) error {
+
+	log.Infof("ChannelPoint(%v) spliced into ChannelPoint(%v)",
+		oldChanPoint, newChan.FundingOutpoint)
+
+	c.Lock()
+	channelArb, ok := c.activeChannels[oldChanPoint]
+	delete(c.activeChannels, oldChanPoint)
+
+	chainWatcher, watcherOk := c.activeWatchers[oldChanPoint]
+	delete(c.activeWatchers, oldChanPoint)
+	c.Unlock()
+
+	if ok {
+		if err := channelArb.Stop(); err != nil {
+			return err
+		}
+
+		// As the arbitrator never acted on the spent funding output,
+		// its log doesn't hold any state worth keeping around.
+		if err := channelArb.log.WipeHistory(); err != nil {
+			return err
+		}
+	}
+	if watcherOk {
+		if err := chainWatcher.Stop(); err != nil {
+			return err
+		}
+	}
+
+	return c.WatchNewChannel(newChan)
+}
+
 // SubscribeChannelEvents returns a new active subscription for the set of
 // possible on-chain events for a particular channel. The struct can be used by
 // callers to be notified whenever an event that changes the state of the
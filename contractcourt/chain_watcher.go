@@ -1,9 +1,11 @@
 package contractcourt
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -16,6 +18,16 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
+const (
+	// minCommitPointPollTimeout is the minimum time we'll wait before
+	// polling the database for a channel's commitpoint.
+	minCommitPointPollTimeout = 1 * time.Second
+
+	// maxCommitPointPollTimeout is the maximum time we'll wait before
+	// polling the database for a channel's commitpoint.
+	maxCommitPointPollTimeout = 10 * time.Minute
+)
+
 // LocalUnilateralCloseInfo encapsulates all the informnation we need to act
 // on a local force close that gets confirmed.
 type LocalUnilateralCloseInfo struct {
@@ -93,6 +105,10 @@ type chainWatcherConfig struct {
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
+
+	// notifyClosedChannel is called once the channel has been marked as
+	// pending closed within the database due to a breach.
+	notifyClosedChannel func(wire.OutPoint)
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -113,6 +129,9 @@ type chainWatcher struct {
 	// the current state number on the commitment transactions.
 	stateHintObfuscator [lnwallet.StateHintSize]byte
 
+	// fundingPkScript is the script of the funding output of the channel.
+	fundingPkScript []byte
+
 	// All the fields below are protected by this mutex.
 	sync.Mutex
 
@@ -191,6 +210,8 @@ func (c *chainWatcher) Start() error {
 		return err
 	}
 
+	c.fundingPkScript = pkScript
+
 	spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
 		fundingOut, pkScript, heightHint,
 	)
@@ -284,6 +305,20 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// prior revoked state...!!!
 		commitTxBroadcast := commitSpend.SpendingTx
 
+		// A splice transaction is the only spend that pays to the
+		// funding script once again. In that case the channel lives
+		// on, and the funding manager will move it over to its new
+		// funding output, so there's nothing for us to do.
+		for _, txOut := range commitTxBroadcast.TxOut {
+			if bytes.Equal(txOut.PkScript, c.fundingPkScript) {
+				log.Infof("ChannelPoint(%v) spliced by "+
+					"txid=%v",
+					c.cfg.chanState.FundingOutpoint,
+					commitSpend.SpenderTxHash)
+				return
+			}
+		}
+
 		localCommit, remoteCommit, err := c.cfg.chanState.LatestCommitments()
 		if err != nil {
 			log.Errorf("Unable to fetch channel state for "+
@@ -354,13 +389,22 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// If this channel was restored from a static channel
+		// backup, then we don't know any of its commitment
+		// states, so any commitment broadcast by the remote
+		// party must be handled through the data loss recovery
+		// path below.
+		isRestoredChan := c.cfg.chanState.HasChanStatus(
+			channeldb.Restored,
+		)
+
 		switch {
 		// If state number spending transaction matches the
 		// current latest state, then they've initiated a
 		// unilateral close. So we'll trigger the unilateral
 		// close signal so subscribers can clean up the state
 		// as necessary.
-		case broadcastStateNum == remoteStateNum:
+		case broadcastStateNum == remoteStateNum && !isRestoredChan:
 			err := c.dispatchRemoteForceClose(
 				commitSpend, *remoteCommit,
 				c.cfg.chanState.RemoteCurrentRevocation,
@@ -378,7 +422,7 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// has a fail crash _after_ accepting the new state,
 		// but _before_ sending their signature to us.
 		case broadcastStateNum == remoteStateNum+1 &&
-			remoteChainTip != nil:
+			remoteChainTip != nil && !isRestoredChan:
 
 			err := c.dispatchRemoteForceClose(
 				commitSpend, remoteChainTip.Commitment,
@@ -393,8 +437,9 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// This is the case that somehow the commitment broadcast is
 		// actually greater than even one beyond our best known state
 		// number. This should ONLY happen in case we experienced some
-		// sort of data loss.
-		case broadcastStateNum > remoteStateNum+1:
+		// sort of data loss, or if the channel was restored from a
+		// static channel backup.
+		case broadcastStateNum > remoteStateNum+1 || isRestoredChan:
 			log.Warnf("Remote node broadcast state #%v, "+
 				"which is more than 1 beyond best known "+
 				"state #%v!!! Attempting recovery...",
@@ -402,15 +447,11 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 
 			// If we are lucky, the remote peer sent us the correct
 			// commitment point during channel sync, such that we
-			// can sweep our funds.
-			// TODO(halseth): must handle the case where we haven't
-			// yet processed the chan sync message.
-			commitPoint, err := c.cfg.chanState.DataLossCommitPoint()
-			if err != nil {
-				log.Errorf("Unable to retrieve commitment "+
-					"point for channel(%v) with lost "+
-					"state: %v",
-					c.cfg.chanState.FundingOutpoint, err)
+			// can sweep our funds. If we don't have it yet, then
+			// we'll wait until the peer sends it to us the next
+			// time we connect.
+			commitPoint := c.waitForDataLossCommitPoint()
+			if commitPoint == nil {
 				return
 			}
 
@@ -463,6 +504,36 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
+// waitForDataLossCommitPoint blocks until the commitment point sent by the
+// remote party during channel sync is available within the database, polling
+// with an exponential backoff. The point is stored once we reconnect to the
+// peer, so we may have detected their commitment broadcast before we have it.
+// A nil point is returned if the chainWatcher is signalled to exit.
+func (c *chainWatcher) waitForDataLossCommitPoint() *btcec.PublicKey {
+	backoff := minCommitPointPollTimeout
+	for {
+		commitPoint, err := c.cfg.chanState.DataLossCommitPoint()
+		if err == nil {
+			return commitPoint
+		}
+
+		log.Errorf("Unable to retrieve commitment point for "+
+			"channel(%v) with lost state: %v. Retrying in %v.",
+			c.cfg.chanState.FundingOutpoint, err, backoff)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+			if backoff > maxCommitPointPollTimeout {
+				backoff = maxCommitPointPollTimeout
+			}
+
+		case <-c.quit:
+			return nil
+		}
+	}
+}
+
 // toSelfAmount takes a transaction and returns the sum of all outputs that pay
 // to a script that the wallet controls. If no outputs pay to us, then we
 // return zero. This is possible as our output may have been trimmed due to
@@ -756,5 +827,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 	log.Infof("Breached channel=%v marked pending-closed",
 		c.cfg.chanState.FundingOutpoint)
 
+	c.cfg.notifyClosedChannel(c.cfg.chanState.FundingOutpoint)
+
 	return nil
 }
@@ -213,3 +213,95 @@ func TestChainWatcherRemoteUnilateralClosePendingCommit(t *testing.T) {
 		t.Fatalf("unable to find alice's commit resolution")
 	}
 }
+
+// TestChainWatcherDataLossProtect tests that the chain watcher is able to
+// recover our funds in the case that the remote party broadcasts a commitment
+// from a state we don't know of, as long as they've sent us their commitment
+// point. The point is only made available after the broadcast has been
+// detected, to ensure the chain watcher waits for it.
+func TestChainWatcherDataLossProtect(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create two channels which already have established a
+	// commitment contract between themselves.
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// With the channels created, we'll now create a chain watcher instance
+	// which will be watching for any closes of Alice's channel.
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceChannel.State(),
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// We'll now simulate Bob broadcasting a commitment for a state far
+	// beyond what Alice knows of, by re-encoding the state hint of his
+	// current commitment.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx.Copy()
+	obfuscator := aliceChainWatcher.stateHintObfuscator
+	if err := lnwallet.SetStateNumHint(bobCommit, 5, obfuscator); err != nil {
+		t.Fatalf("unable to set state hint: %v", err)
+	}
+	bobTxHash := bobCommit.TxHash()
+	bobSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+	aliceNotifier.spendChan <- bobSpend
+
+	// As Alice doesn't yet know the commitment point of Bob's broadcast
+	// state, she shouldn't be able to dispatch the close.
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+		t.Fatalf("unilateral close dispatched without commit point")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// We'll now have Alice receive Bob's commitment point, as she would
+	// during channel reestablishment.
+	bobSecret, err := bobChannel.State().RevocationProducer.AtIndex(0)
+	if err != nil {
+		t.Fatalf("unable to derive commit secret: %v", err)
+	}
+	bobCommitPoint := lnwallet.ComputeCommitmentPoint(bobSecret[:])
+	if err := aliceChannel.State().MarkDataLoss(bobCommitPoint); err != nil {
+		t.Fatalf("unable to mark data loss: %v", err)
+	}
+
+	// Alice should now be able to dispatch the close, having located her
+	// output on Bob's commitment.
+	var uniClose *lnwallet.UnilateralCloseSummary
+	select {
+	case uniClose = <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive unilateral close event")
+	}
+
+	if uniClose.CommitResolution == nil {
+		t.Fatalf("unable to find alice's commit resolution")
+	}
+	aliceBalance := aliceChannel.State().LocalCommitment.LocalBalance
+	if uniClose.CommitResolution.SelfOutputSignDesc.Output.Value !=
+		int64(aliceBalance.ToSatoshis()) {
+
+		t.Fatalf("expected output value %v, got %v",
+			aliceBalance.ToSatoshis(),
+			uniClose.CommitResolution.SelfOutputSignDesc.Output.Value)
+	}
+}
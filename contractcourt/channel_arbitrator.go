@@ -194,6 +194,10 @@ type ChannelArbitrator struct {
 	// be able to signal them for shutdown in the case that we shutdown.
 	activeResolvers []ContractResolver
 
+	// activeResolversLock prevents simultaneous read and write to the
+	// resolvers slice.
+	activeResolversLock sync.RWMutex
+
 	// resolutionSignal is a channel that will be sent upon by contract
 	// resolvers once their contract has been fully resolved. With each
 	// send, we'll check to see if the contract is fully resolved.
@@ -336,7 +340,10 @@ func (c *ChannelArbitrator) Start() error {
 		log.Infof("ChannelArbitrator(%v): relaunching %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(unresolvedContracts))
 
+		c.activeResolversLock.Lock()
 		c.activeResolvers = unresolvedContracts
+		c.activeResolversLock.Unlock()
+
 		for _, contract := range unresolvedContracts {
 			c.wg.Add(1)
 			go c.resolveContract(contract)
@@ -362,9 +369,11 @@ func (c *ChannelArbitrator) Stop() error {
 		go c.cfg.ChainEvents.Cancel()
 	}
 
+	c.activeResolversLock.RLock()
 	for _, activeResolver := range c.activeResolvers {
 		activeResolver.Stop()
 	}
+	c.activeResolversLock.RUnlock()
 
 	close(c.quit)
 	c.wg.Wait()
@@ -372,6 +381,25 @@ func (c *ChannelArbitrator) Stop() error {
 	return nil
 }
 
+// Report returns a summary of the contracts this arbitrator is currently
+// resolving that are able to report on their progress.
+func (c *ChannelArbitrator) Report() []*ContractReport {
+	c.activeResolversLock.RLock()
+	defer c.activeResolversLock.RUnlock()
+
+	var reports []*ContractReport
+	for _, resolver := range c.activeResolvers {
+		r, ok := resolver.(reportingContractResolver)
+		if !ok {
+			continue
+		}
+
+		reports = append(reports, r.report())
+	}
+
+	return reports
+}
+
 // transitionTrigger is an enum that denotes exactly *why* a state transition
 // was initiated. This is useful as depending on the initial trigger, we may
 // skip certain states as those actions are expected to have already taken
@@ -562,7 +590,8 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 
 		// At this point, we'll now broadcast the commitment
 		// transaction itself.
-		if err := c.cfg.PublishTx(closeTx); err != nil {
+		label := lnwallet.ForceCloseTxLabel(c.cfg.ChanPoint)
+		if err := c.cfg.PublishTx(closeTx, label); err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to broadcast "+
 				"close tx: %v", c.cfg.ChanPoint, err)
 			if err != lnwallet.ErrDoubleSpend {
@@ -695,7 +724,10 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 
 		// Finally, we'll launch all the required contract resolvers.
 		// Once they're all resolved, we're no longer needed.
+		c.activeResolversLock.Lock()
 		c.activeResolvers = htlcResolvers
+		c.activeResolversLock.Unlock()
+
 		for _, contract := range htlcResolvers {
 			c.wg.Add(1)
 			go c.resolveContract(contract)
@@ -7,13 +7,15 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sync"
 
-	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -65,6 +67,33 @@ type ContractResolver interface {
 	Stop()
 }
 
+// ContractReport provides a summary of a commitment tx output that is in the
+// process of being swept back to our wallet.
+type ContractReport struct {
+	// Outpoint is the final output that will be swept back to the wallet.
+	Outpoint wire.OutPoint
+
+	// Amount is the final value that will be swept in back to the wallet.
+	Amount btcutil.Amount
+
+	// LimboBalance is the total number of frozen coins within this
+	// contract.
+	LimboBalance btcutil.Amount
+
+	// RecoveredBalance is the total value that has been successfully swept
+	// back to the user's wallet.
+	RecoveredBalance btcutil.Amount
+}
+
+// reportingContractResolver is a ContractResolver that also exposes a report
+// on the resolution state of the contract.
+type reportingContractResolver interface {
+	ContractResolver
+
+	// report returns a summary of the current state of the contract.
+	report() *ContractReport
+}
+
 // ResolverKit is meant to be used as a mix-in struct to be embedded within a
 // given ContractResolver implementation. It contains all the items that a
 // resolver requires to carry out its duties.
@@ -392,11 +421,9 @@ type htlcSuccessResolver struct {
 	// payHash is the payment hash of the original HTLC extended to us.
 	payHash [32]byte
 
-	// sweepTx will be non-nil if we've already crafted a transaction to
-	// sweep a direct HTLC output. This is only a concern if we're sweeping
-	// from the commitment transaction of the remote party.
-	//
-	// TODO(roasbeef): send off to utxobundler
+	// sweepTx will be non-nil once the sweeper has swept a direct HTLC
+	// output. This is only a concern if we're sweeping from the
+	// commitment transaction of the remote party.
 	sweepTx *wire.MsgTx
 
 	ResolverKit
@@ -424,10 +451,8 @@ func (h *htlcSuccessResolver) ResolverKey() []byte {
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
 // preimage to. If the HTLC is on the commitment of the remote party, then
-// we'll simply sweep it directly. Otherwise, we'll hand this off to the utxo
-// nursery to do its duty.
-//
-// TODO(roasbeef): create multi to batch
+// we'll hand it to the sweeper to sweep it directly. Otherwise, we'll hand
+// this off to the utxo nursery to do its duty.
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
@@ -439,91 +464,53 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
-		// If we don't already have the sweep transaction constructed,
-		// we'll do so and broadcast it.
+		// If we don't already have the sweep transaction, we'll hand
+		// the output to the sweeper and wait for it to be swept.
 		if h.sweepTx == nil {
-			log.Infof("%T(%x): crafting sweep tx for "+
-				"incoming+remote htlc confirmed", h,
-				h.payHash[:])
+			log.Infof("%T(%x): offering incoming+remote htlc to "+
+				"sweeper", h, h.payHash[:])
 
 			// In this case, we can sweep it directly from the
-			// commitment output. We'll first grab a fresh address
-			// from the wallet to sweep the output.
-			addr, err := h.NewSweepAddr()
+			// commitment output using the preimage.
+			input := sweep.MakeHtlcSucceedInput(
+				&h.htlcResolution.ClaimOutpoint,
+				&h.htlcResolution.SweepSignDesc,
+				h.htlcResolution.Preimage[:],
+				h.broadcastHeight,
+			)
+			resultChan, err := h.Sweeper.SweepInput(&input, 0)
 			if err != nil {
 				return nil, err
 			}
 
-			// With our address obtained, we'll query for an
-			// estimate to be confirmed at ease.
-			//
-			// TODO(roasbeef): signal up if fee would be too large
-			// to sweep singly, need to batch
-			feePerKw, err := h.FeeEstimator.EstimateFeePerKW(6)
-			if err != nil {
-				return nil, err
-			}
+			select {
+			case result, ok := <-resultChan:
+				if !ok {
+					return nil, fmt.Errorf("quitting")
+				}
+				if result.Err != nil {
+					log.Errorf("%T(%x): unable to sweep htlc: "+
+						"%v", h, h.payHash[:], result.Err)
+					return nil, result.Err
+				}
 
-			log.Debugf("%T(%x): using %v sat/kw to sweep htlc"+
-				"incoming+remote htlc confirmed", h,
-				h.payHash[:], int64(feePerKw))
-
-			// Using a weight estimator, we'll compute the total
-			// fee required, and from that the value we'll end up
-			// with.
-			totalWeight := (&lnwallet.TxWeightEstimator{}).
-				AddWitnessInput(lnwallet.OfferedHtlcSuccessWitnessSize).
-				AddP2WKHOutput().Weight()
-			totalFees := feePerKw.FeeForWeight(int64(totalWeight))
-			sweepAmt := h.htlcResolution.SweepSignDesc.Output.Value -
-				int64(totalFees)
-
-			// With the fee computation finished, we'll now
-			// construct the sweep transaction.
-			htlcPoint := h.htlcResolution.ClaimOutpoint
-			h.sweepTx = wire.NewMsgTx(2)
-			h.sweepTx.AddTxIn(&wire.TxIn{
-				PreviousOutPoint: htlcPoint,
-			})
-			h.sweepTx.AddTxOut(&wire.TxOut{
-				PkScript: addr,
-				Value:    sweepAmt,
-			})
-
-			// With the transaction fully assembled, we can now
-			// generate a valid witness for the transaction.
-			h.htlcResolution.SweepSignDesc.SigHashes = txscript.NewTxSigHashes(
-				h.sweepTx,
-			)
-			h.sweepTx.TxIn[0].Witness, err = lnwallet.SenderHtlcSpendRedeem(
-				h.Signer, &h.htlcResolution.SweepSignDesc, h.sweepTx,
-				h.htlcResolution.Preimage[:],
-			)
-			if err != nil {
-				return nil, err
+				h.sweepTx = result.Tx
+
+			case <-h.Quit:
+				return nil, fmt.Errorf("quitting")
 			}
 
-			log.Infof("%T(%x): crafted sweep tx=%v", h,
-				h.payHash[:], spew.Sdump(h.sweepTx))
+			log.Infof("%T(%x): htlc swept by tx=%v", h,
+				h.payHash[:], h.sweepTx.TxHash())
 
 			// With the sweep transaction confirmed, we'll now
 			// Checkpoint our state.
 			if err := h.Checkpoint(h); err != nil {
 				log.Errorf("unable to Checkpoint: %v", err)
 			}
-
-			// Finally, we'll broadcast the sweep transaction to
-			// the network.
-			//
-			// TODO(roasbeef): validate first?
-			if err := h.PublishTx(h.sweepTx); err != nil {
-				log.Infof("%T(%x): unable to publish tx: %v",
-					h, h.payHash[:], err)
-				return nil, err
-			}
 		}
 
-		// With the sweep transaction broadcast, we'll wait for its
+		// With the sweep transaction known, we'll wait for its
 		// confirmation.
 		sweepTXID := h.sweepTx.TxHash()
 		sweepScript := h.sweepTx.TxOut[0].PkScript
@@ -560,7 +547,11 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// the claiming process.
 	//
 	// TODO(roasbeef): after changing sighashes send to tx bundler
-	if err := h.PublishTx(h.htlcResolution.SignedSuccessTx); err != nil {
+	err := h.PublishTx(
+		h.htlcResolution.SignedSuccessTx,
+		lnwallet.HtlcSuccessTxLabel(h.ChanPoint),
+	)
+	if err != nil {
 		return nil, err
 	}
 
@@ -1198,6 +1189,10 @@ type commitSweepResolver struct {
 	// source wallet.
 	sweepTx *wire.MsgTx
 
+	// reportLock prevents concurrent access to the resolved flag while
+	// a report is being generated.
+	reportLock sync.Mutex
+
 	ResolverKit
 }
 
@@ -1253,64 +1248,49 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
 
 	switch {
-	// If the sweep transaction isn't already generated, and the remote
-	// party broadcast the commitment transaction then we'll create it now.
+	// If the sweep transaction isn't already known, and the remote party
+	// broadcast the commitment transaction then we'll have the sweeper
+	// sweep the output now.
 	case c.sweepTx == nil && !isLocalCommitTx:
 		// Now that the commitment transaction has confirmed, we'll
-		// craft a transaction to sweep this output into the wallet.
-		signDesc := c.commitResolution.SelfOutputSignDesc
-
-		// First, we'll estimate the total weight so we can compute
-		// fees properly. We'll use a lax estimate, as this output is
-		// in no immediate danger.
-		feePerKw, err := c.FeeEstimator.EstimateFeePerKW(6)
+		// hand the output to the sweeper, which will sweep it into the
+		// wallet. This output is in no immediate danger, so we leave
+		// the confirmation target up to the sweeper.
+		input := sweep.MakeBaseInput(
+			&c.commitResolution.SelfOutPoint,
+			lnwallet.CommitmentNoDelay,
+			&c.commitResolution.SelfOutputSignDesc,
+			c.broadcastHeight,
+		)
+		resultChan, err := c.Sweeper.SweepInput(&input, 0)
 		if err != nil {
+			log.Errorf("%T(%v): unable to sweep input: %v",
+				c, c.chanPoint, err)
 			return nil, err
 		}
 
-		log.Debugf("%T(%v): using %v sat/kw for sweep tx", c,
-			c.chanPoint, int64(feePerKw))
+		log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
 
-		totalWeight := (&lnwallet.TxWeightEstimator{}).
-			AddP2WKHInput().
-			AddP2WKHOutput().Weight()
-		totalFees := feePerKw.FeeForWeight(int64(totalWeight))
-		sweepAmt := signDesc.Output.Value - int64(totalFees)
-
-		c.sweepTx = wire.NewMsgTx(2)
-		c.sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: c.commitResolution.SelfOutPoint,
-		})
-		sweepAddr, err := c.NewSweepAddr()
-		if err != nil {
-			return nil, err
-		}
-		c.sweepTx.AddTxOut(&wire.TxOut{
-			PkScript: sweepAddr,
-			Value:    sweepAmt,
-		})
-
-		// With the transaction fully assembled, we can now generate a
-		// valid witness for the transaction.
-		signDesc.SigHashes = txscript.NewTxSigHashes(c.sweepTx)
-		c.sweepTx.TxIn[0].Witness, err = lnwallet.CommitSpendNoDelay(
-			c.Signer, &signDesc, c.sweepTx,
-		)
-		if err != nil {
-			return nil, err
-		}
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+			if result.Err != nil {
+				log.Errorf("%T(%v): unable to sweep commit "+
+					"output: %v", c, c.chanPoint, result.Err)
+				return nil, result.Err
+			}
 
-		log.Infof("%T(%v): sweeping commit output with tx=%v", c,
-			c.chanPoint, spew.Sdump(c.sweepTx))
+			c.sweepTx = result.Tx
 
-		// Finally, we'll broadcast the sweep transaction to the
-		// network.
-		if err := c.PublishTx(c.sweepTx); err != nil {
-			log.Errorf("%T(%v): unable to publish sweep tx: %v",
-				c, c.chanPoint, err)
-			return nil, err
+		case <-c.Quit:
+			return nil, fmt.Errorf("quitting")
 		}
 
+		log.Infof("%T(%v): commit output swept by txid=%v", c,
+			c.chanPoint, c.sweepTx.TxHash())
+
 		// With the sweep transaction confirmed, we'll now Checkpoint
 		// our state.
 		if err := c.Checkpoint(c); err != nil {
@@ -1386,7 +1366,10 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	// Once the transaction has received a sufficient number of
 	// confirmations, we'll mark ourselves as fully resolved and exit.
+	c.reportLock.Lock()
 	c.resolved = true
+	c.reportLock.Unlock()
+
 	return nil, c.Checkpoint(c)
 }
 
@@ -1398,6 +1381,30 @@ func (c *commitSweepResolver) Stop() {
 	close(c.Quit)
 }
 
+// report returns a summary of the commitment output being swept. The output
+// remains in limbo until the sweep transaction has confirmed.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (c *commitSweepResolver) report() *ContractReport {
+	c.reportLock.Lock()
+	defer c.reportLock.Unlock()
+
+	amt := btcutil.Amount(
+		c.commitResolution.SelfOutputSignDesc.Output.Value,
+	)
+	report := &ContractReport{
+		Outpoint: c.commitResolution.SelfOutPoint,
+		Amount:   amt,
+	}
+	if c.resolved {
+		report.RecoveredBalance = amt
+	} else {
+		report.LimboBalance = amt
+	}
+
+	return report
+}
+
 // IsResolved returns true if the stored state in the resolve is fully
 // resolved. In this case the target output can be forgotten.
 //
@@ -1489,5 +1496,5 @@ func (c *commitSweepResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure commitSweepResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*commitSweepResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*commitSweepResolver)(nil)
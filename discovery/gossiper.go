@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -51,9 +52,42 @@ type networkMsg struct {
 
 	isRemote bool
 
+	// optionalFields holds the fields of a local announcement that aren't
+	// part of the wire message itself.
+	optionalFields optionalMsgFields
+
 	err chan error
 }
 
+// optionalMsgFields is the set of fields that can be passed along with a local
+// announcement, as they can't be derived from the announcement itself.
+type optionalMsgFields struct {
+	capacity     btcutil.Amount
+	channelPoint *wire.OutPoint
+}
+
+// OptionalMsgField is a functional option that sets one of the optional fields
+// of a local announcement.
+type OptionalMsgField func(*optionalMsgFields)
+
+// ChannelCapacity sets the capacity of the channel of a local
+// ChannelAnnouncement.
+func ChannelCapacity(capacity btcutil.Amount) OptionalMsgField {
+	return func(f *optionalMsgFields) {
+		f.capacity = capacity
+	}
+}
+
+// ChannelPoint sets the funding outpoint of the channel of a local
+// ChannelAnnouncement. Along with ChannelCapacity, this allows the channel to
+// be added to the graph before its short channel ID can be located on chain,
+// as is the case for channels using an alias short channel ID.
+func ChannelPoint(op wire.OutPoint) OptionalMsgField {
+	return func(f *optionalMsgFields) {
+		f.channelPoint = &op
+	}
+}
+
 // chanPolicyUpdateRequest is a request that is sent to the server when a caller
 // wishes to update the channel policy (fees e.g.) for a particular set of
 // channels. New ChannelUpdate messages will be crafted to be sent out during
@@ -507,7 +541,7 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 // entire channel announcement and update messages will be re-constructed and
 // broadcast to the rest of the network.
 func (d *AuthenticatedGossiper) ProcessLocalAnnouncement(msg lnwire.Message,
-	source *btcec.PublicKey) chan error {
+	source *btcec.PublicKey, optionalFields ...OptionalMsgField) chan error {
 
 	nMsg := &networkMsg{
 		msg:      msg,
@@ -515,6 +549,9 @@ func (d *AuthenticatedGossiper) ProcessLocalAnnouncement(msg lnwire.Message,
 		source:   source,
 		err:      make(chan error, 1),
 	}
+	for _, optionalField := range optionalFields {
+		optionalField(&nMsg.optionalFields)
+	}
 
 	select {
 	case d.networkMsgs <- nMsg:
@@ -1667,6 +1704,17 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// An alias short channel ID is only known to the parties of a
+		// channel, so it must never be announced by a remote node.
+		if nMsg.isRemote && msg.ShortChannelID.IsAlias() {
+			err := fmt.Errorf("Ignoring ChannelAnnouncement for "+
+				"alias short_chan_id=%v", msg.ShortChannelID)
+			log.Errorf(err.Error())
+
+			nMsg.err <- err
+			return nil
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
@@ -1743,6 +1791,10 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			Features:         featureBuf.Bytes(),
 			ExtraOpaqueData:  msg.ExtraOpaqueData,
 		}
+		if nMsg.optionalFields.channelPoint != nil {
+			edge.ChannelPoint = *nMsg.optionalFields.channelPoint
+			edge.Capacity = nMsg.optionalFields.capacity
+		}
 
 		// We will add the edge to the channel router. If the nodes
 		// present in this channel are not present in the database, a
@@ -1887,7 +1939,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
-		if nMsg.isRemote && isPremature(msg.ShortChannelID, 0) {
+		// Updates for channels using an alias short channel ID are
+		// exempt, as their inclusionary block doesn't exist.
+		if nMsg.isRemote && !msg.ShortChannelID.IsAlias() &&
+			isPremature(msg.ShortChannelID, 0) {
+
 			log.Infof("Update announcement for "+
 				"short_chan_id(%v), is premature: advertises "+
 				"height %v, only height %v is known",
@@ -2290,6 +2290,57 @@ func TestExtraDataNodeAnnouncementValidation(t *testing.T) {
 	}
 }
 
+// TestAliasChannelAnnouncement tests that channel announcements using an alias
+// short channel ID are rejected from remote peers, and that local ones carry
+// the channel point and capacity of the channel to the router.
+func TestAliasChannelAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	remotePeer := &mockPeer{nodeKeyPriv1.PubKey(), nil, nil}
+
+	ca, err := createRemoteChannelAnnouncement(lnwire.AliasStartHeight)
+	if err != nil {
+		t.Fatalf("can't create channel announcement: %v", err)
+	}
+
+	select {
+	case err = <-ctx.gossiper.ProcessRemoteAnnouncement(ca, remotePeer):
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not process remote announcement")
+	}
+	if err == nil {
+		t.Fatalf("remote alias announcement should be rejected")
+	}
+
+	chanPoint := wire.OutPoint{Index: 1}
+	select {
+	case err = <-ctx.gossiper.ProcessLocalAnnouncement(
+		ca, nodeKeyPriv1.PubKey(), ChannelPoint(chanPoint),
+		ChannelCapacity(1000),
+	):
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not process local announcement")
+	}
+	if err != nil {
+		t.Fatalf("unable to process announcement: %v", err)
+	}
+
+	info, ok := ctx.router.infos[ca.ShortChannelID.ToUint64()]
+	if !ok {
+		t.Fatalf("alias edge not added to router")
+	}
+	if info.ChannelPoint != chanPoint || info.Capacity != 1000 {
+		t.Fatalf("expected channel point %v and capacity 1000, got "+
+			"%v and %v", chanPoint, info.ChannelPoint, info.Capacity)
+	}
+}
+
 // mockPeer implements the lnpeer.Peer interface and is used to test the
 // gossiper's interaction with peers.
 type mockPeer struct {
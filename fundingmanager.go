@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
@@ -19,7 +20,9 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnpeer"
@@ -52,6 +55,11 @@ const (
 	// the channel. 288 blocks is ~48 hrs
 	maxWaitNumBlocksFundingConf = 288
 
+	// maxWaitNumBlocksSpliceConf is the maximum number of blocks to wait
+	// for a splice transaction to be confirmed before force closing the
+	// channel to recover its funds. 2016 blocks is ~2 weeks.
+	maxWaitNumBlocksSpliceConf = 2016
+
 	// minChanFundingSize is the smallest channel that we'll allow to be
 	// created over the RPC interface.
 	minChanFundingSize = btcutil.Amount(20000)
@@ -76,9 +84,9 @@ var (
 	// while implementations are battle tested in the real world.
 	//
 	// At the moment, this value depends on which chain is active. It is set
-	// to the value under the Bitcoin chain as default.
-	//
-	// TODO(roasbeef): add command line param to modify
+	// to the value under the Bitcoin chain as default. Larger channels are
+	// only permitted with peers that signal support for them, up to the
+	// configured maxchansize.
 	maxFundingAmount = maxBtcFundingAmount
 
 	// ErrFundingManagerShuttingDown is an error returned when attempting to
@@ -112,6 +120,26 @@ type reservationWithCtx struct {
 
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
+
+	// batchSigned is non-nil if the channel is funded by a transaction
+	// shared with other channels of a batch. It's closed once the remote
+	// peer has signed our commitment transaction, after which the funding
+	// flow is paused until the batch is either completed or cancelled.
+	batchSigned chan struct{}
+
+	// dualFundOffered is true if we initiated the channel, and allowed the
+	// responder to contribute funds to it.
+	dualFundOffered bool
+
+	// remoteFunding is the contribution the responder of a dual funder
+	// channel we initiated sent us. It's processed along with their
+	// AcceptChannel message.
+	remoteFunding *lnwire.FundingContribution
+
+	// remoteInputSigs are the signatures for the inputs the responder of
+	// a dual funder channel we initiated contributed to the funding
+	// transaction.
+	remoteInputSigs []*lnwallet.InputScript
 }
 
 // isLocked checks the reservation's timestamp to determine whether it is locked.
@@ -158,6 +186,14 @@ type fundingOpenMsg struct {
 	peer lnpeer.Peer
 }
 
+// chanAcceptDecisionMsg carries the decision of the channel acceptor on an
+// inbound channel request back to the reservationCoordinator, which resumes
+// the funding workflow if the channel was accepted.
+type chanAcceptDecisionMsg struct {
+	openMsg *fundingOpenMsg
+	err     error
+}
+
 // fundingAcceptMsg couples an lnwire.AcceptChannel message with the peer who
 // sent the message. This allows the funding manager to queue a response
 // directly to the peer, progressing the funding workflow.
@@ -182,6 +218,22 @@ type fundingSignedMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingContributionMsg couples an lnwire.FundingContribution message with
+// the peer who sent the message. This allows the funding manager to add the
+// peer's inputs to the funding transaction of a dual funder channel.
+type fundingContributionMsg struct {
+	msg  *lnwire.FundingContribution
+	peer lnpeer.Peer
+}
+
+// fundingInputSigsMsg couples an lnwire.FundingInputSigs message with the peer
+// who sent the message. This allows the funding manager to complete the
+// funding transaction of a dual funder channel.
+type fundingInputSigsMsg struct {
+	msg  *lnwire.FundingInputSigs
+	peer lnpeer.Peer
+}
+
 // fundingLockedMsg couples an lnwire.FundingLocked message with the peer who
 // sent the message. This allows the funding manager to finalize the funding
 // process and announce the existence of the new channel.
@@ -190,6 +242,41 @@ type fundingLockedMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingTxMsg couples an lnwire.FundingTx message with the peer who sent the
+// message. This allows the funding manager to watch the funding transaction
+// of an inbound zero-conf channel for a double spend.
+type fundingTxMsg struct {
+	msg  *lnwire.FundingTx
+	peer lnpeer.Peer
+}
+
+// externalFundingTxMsg carries the signed funding transaction of a pending
+// channel that is funded by an external wallet. It allows the funding workflow
+// of that channel to resume.
+type externalFundingTxMsg struct {
+	pendingChanID [32]byte
+	fundingTx     *wire.MsgTx
+	batch         bool
+	err           chan error
+}
+
+// completeBatchMsg requests that the funding flows of a batch of channels,
+// which have all been signed by their remote peers, are completed by
+// broadcasting their shared funding transaction.
+type completeBatchMsg struct {
+	pendingChanIDs [][32]byte
+	fundingTx      *wire.MsgTx
+	err            chan error
+}
+
+// cancelBatchMsg requests that the funding flows of a batch of channels are
+// cancelled.
+type cancelBatchMsg struct {
+	pendingChanIDs [][32]byte
+	reason         error
+	done           chan struct{}
+}
+
 // fundingErrorMsg couples an lnwire.Error message with the peer who sent the
 // message. This allows the funding manager to properly process the error.
 type fundingErrorMsg struct {
@@ -228,8 +315,8 @@ type fundingConfig struct {
 	Wallet *lnwallet.LightningWallet
 
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network, labeling it with the passed label.
+	PublishTransaction func(*wire.MsgTx, string) error
 
 	// FeeEstimator calculates appropriate fee rates based on historical
 	// transaction information.
@@ -256,7 +343,8 @@ type fundingConfig struct {
 	// SendAnnouncement is used by the FundingManager to send
 	// announcement messages to the Gossiper to possibly broadcast
 	// to the greater network.
-	SendAnnouncement func(msg lnwire.Message) chan error
+	SendAnnouncement func(msg lnwire.Message,
+		optionalFields ...discovery.OptionalMsgField) chan error
 
 	// NotifyWhenOnline allows the FundingManager to register with a
 	// subsystem that will notify it when the peer comes online. This is
@@ -315,11 +403,31 @@ type fundingConfig struct {
 	// node we're establishing a channel with for reconnection purposes.
 	WatchNewChannel func(*channeldb.OpenChannel, *btcec.PublicKey) error
 
+	// NotifyClosedChannel is called once a pending channel has been
+	// removed from the database because its funding flow was canceled.
+	NotifyClosedChannel func(wire.OutPoint)
+
 	// ReportShortChanID allows the funding manager to report the newly
 	// discovered short channel ID of a formerly pending channel to outside
 	// sub-systems.
 	ReportShortChanID func(wire.OutPoint) error
 
+	// ConfirmAliasShortChanID is called once the funding transaction of a
+	// channel that has been used under its alias short channel ID
+	// confirms. It runs the passed closure, which persists the confirmed
+	// short channel ID, while no HTLCs are forwarded over the channel, and
+	// then moves the channel's link over to it.
+	ConfirmAliasShortChanID func(wire.OutPoint, func() error) error
+
+	// SpliceContract is called once the splice transaction of a channel
+	// has confirmed, and the channel has moved from the passed funding
+	// outpoint to its new funding output.
+	SpliceContract func(wire.OutPoint, *channeldb.OpenChannel) error
+
+	// ForceCloseContract force closes the channel with the passed funding
+	// outpoint by broadcasting our latest commitment transaction.
+	ForceCloseContract func(wire.OutPoint) error
+
 	// ZombieSweeperInterval is the periodic time interval in which the
 	// zombie sweeper is run.
 	ZombieSweeperInterval time.Duration
@@ -333,6 +441,37 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// OpenChannelPredicate is a predicate on the lnwire.OpenChannel message
+	// and on the requesting node's public key that returns whether we
+	// accept the inbound channel. The reason of a rejection is sent to the
+	// peer.
+	OpenChannelPredicate chanacceptor.ChannelAcceptor
+
+	// MaxChanSize returns the largest channel we accept or open with the
+	// peer with the given public key. Channels may only exceed the
+	// soft-limit of maxFundingAmount if both we and the peer signaled
+	// support for large channels.
+	MaxChanSize func(*btcec.PublicKey) btcutil.Amount
+
+	// SupportsDualFunding returns whether both we and the peer with the
+	// given public key signaled support for dual funder channels.
+	SupportsDualFunding func(*btcec.PublicKey) bool
+
+	// DualFundingContribution returns the maximum amount we're willing to
+	// add to a dual funder channel that the peer with the given public key
+	// initiates. If zero is returned, we won't contribute any funds.
+	DualFundingContribution func(*btcec.PublicKey) btcutil.Amount
+
+	// ZeroConfPeer returns whether channels with the peer with the given
+	// public key are used right away, rather than after their funding
+	// transaction confirms.
+	ZeroConfPeer func(*btcec.PublicKey) bool
+
+	// RemoveLink is called when the funding transaction of a channel that
+	// was used before it confirmed has been double spent. It removes the
+	// link of the channel, such that it's no longer used to forward HTLCs.
+	RemoveLink func(lnwire.ChannelID)
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -373,10 +512,19 @@ type fundingManager struct {
 	// signed by both parties.
 	signedReservations map[lnwire.ChannelID][32]byte
 
-	// resMtx guards both of the maps above to ensure that all access is
+	// batchSignatures holds the commitment signatures of the remote
+	// peers for channels of a batch, until the batch is completed.
+	batchSignatures map[[32]byte]lnwire.Sig
+
+	// resMtx guards all of the maps above to ensure that all access is
 	// goroutine safe.
 	resMtx sync.RWMutex
 
+	// pendingAcceptRequests maps the pending channel ID of each inbound
+	// channel that awaits the decision of the channel acceptor to the peer
+	// that requested it. It's only accessed by the reservationCoordinator.
+	pendingAcceptRequests map[[32]byte]serializedPubKey
+
 	// fundingMsgs is a channel which receives wrapped wire messages
 	// related to funding workflow from outside peers.
 	fundingMsgs chan interface{}
@@ -402,6 +550,12 @@ type fundingManager struct {
 	handleFundingLockedMtx      sync.RWMutex
 	handleFundingLockedBarriers map[lnwire.ChannelID]struct{}
 
+	// fundingTxSignals is a map from the channel ID of an inbound zero-conf
+	// channel to a signal that is closed once we've learned about the
+	// channel's funding transaction, or once it has confirmed.
+	fundingTxMtx     sync.Mutex
+	fundingTxSignals map[lnwire.ChannelID]chan struct{}
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -450,11 +604,14 @@ func newFundingManager(cfg fundingConfig) (*fundingManager, error) {
 		chanIDKey:                   cfg.TempChanIDSeed,
 		activeReservations:          make(map[serializedPubKey]pendingChannels),
 		signedReservations:          make(map[lnwire.ChannelID][32]byte),
+		batchSignatures:             make(map[[32]byte]lnwire.Sig),
+		pendingAcceptRequests:       make(map[[32]byte]serializedPubKey),
 		newChanBarriers:             make(map[lnwire.ChannelID]chan struct{}),
 		fundingMsgs:                 make(chan interface{}, msgBufferSize),
 		fundingRequests:             make(chan *initFundingMsg, msgBufferSize),
 		localDiscoverySignals:       make(map[lnwire.ChannelID]chan struct{}),
 		handleFundingLockedBarriers: make(map[lnwire.ChannelID]struct{}),
+		fundingTxSignals:            make(map[lnwire.ChannelID]chan struct{}),
 		queries:                     make(chan interface{}, 1),
 		quit:                        make(chan struct{}),
 	}, nil
@@ -500,10 +657,11 @@ func (f *fundingManager) Start() error {
 		// already broadcast this transaction. Otherwise, we simply log
 		// the error as there isn't anything we can currently do to
 		// recover.
-		if channel.ChanType == channeldb.SingleFunder &&
-			channel.IsInitiator {
-
-			err := f.cfg.PublishTransaction(channel.FundingTxn)
+		if channel.IsInitiator {
+			err := f.cfg.PublishTransaction(
+				channel.FundingTxn,
+				lnwallet.FundingTxLabel(channel.FundingOutpoint),
+			)
 			if err != nil && err != lnwallet.ErrDoubleSpend {
 				fndgLog.Warnf("unable to rebroadcast funding "+
 					"txn: %v", err)
@@ -538,6 +696,8 @@ func (f *fundingManager) Start() error {
 				if err := ch.CloseChannel(closeInfo); err != nil {
 					fndgLog.Errorf("Failed closing channel "+
 						"%v: %v", ch.FundingOutpoint, err)
+				} else {
+					f.cfg.NotifyClosedChannel(ch.FundingOutpoint)
 				}
 
 			case <-f.quit:
@@ -585,6 +745,14 @@ func (f *fundingManager) Start() error {
 	}
 
 	for _, channel := range openChannels {
+		// Resume waiting for the confirmation of any splice
+		// transaction we negotiated before going down.
+		if channel.HasChanStatus(channeldb.PendingSplice) {
+			f.rebroadcastSplice(channel)
+			f.WatchSplice(channel)
+			continue
+		}
+
 		channelState, shortChanID, err := f.getChannelOpeningState(
 			&channel.FundingOutpoint)
 		if err == ErrChannelNotFound {
@@ -844,6 +1012,11 @@ func (f *fundingManager) failFundingFlow(peer lnpeer.Peer, tempChanID [32]byte,
 	case lnwallet.ReservationError:
 		msg = lnwire.ErrorData(e.Error())
 
+	// The channel was rejected by a channel acceptor, which gave us a
+	// reason to pass on.
+	case *chanacceptor.RejectError:
+		msg = lnwire.ErrorData(e.Error())
+
 	// Send the status code.
 	case lnwire.ErrorCode:
 		msg = lnwire.ErrorData{byte(e)}
@@ -882,17 +1055,31 @@ func (f *fundingManager) reservationCoordinator() {
 			switch fmsg := msg.(type) {
 			case *fundingOpenMsg:
 				f.handleFundingOpen(fmsg)
+			case *chanAcceptDecisionMsg:
+				f.handleChanAcceptDecision(fmsg)
 			case *fundingAcceptMsg:
 				f.handleFundingAccept(fmsg)
 			case *fundingCreatedMsg:
 				f.handleFundingCreated(fmsg)
 			case *fundingSignedMsg:
 				f.handleFundingSigned(fmsg)
+			case *fundingContributionMsg:
+				f.handleFundingContribution(fmsg)
+			case *fundingInputSigsMsg:
+				f.handleFundingInputSigs(fmsg)
+			case *fundingTxMsg:
+				f.handleFundingTx(fmsg)
 			case *fundingLockedMsg:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg)
 			case *fundingErrorMsg:
 				f.handleErrorMsg(fmsg)
+			case *externalFundingTxMsg:
+				f.handleExternalFundingTx(fmsg)
+			case *completeBatchMsg:
+				f.handleCompleteBatch(fmsg)
+			case *cancelBatchMsg:
+				f.handleCancelBatch(fmsg)
 			}
 		case req := <-f.fundingRequests:
 			f.handleInitFundingMsg(req)
@@ -967,12 +1154,19 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	amt := msg.FundingAmount
 
 	// We count the number of pending channels for this peer. This is the
-	// sum of the active reservations and the channels pending open in the
+	// sum of the active reservations, the requests awaiting the decision
+	// of the channel acceptor and the channels pending open in the
 	// database.
 	f.resMtx.RLock()
 	numPending := len(f.activeReservations[peerIDKey])
 	f.resMtx.RUnlock()
 
+	for _, peerKey := range f.pendingAcceptRequests {
+		if peerKey == peerIDKey {
+			numPending++
+		}
+	}
+
 	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
 	if err != nil {
 		f.failFundingFlow(
@@ -1013,8 +1207,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	// We'll reject any request to create a channel that's above the
-	// current soft-limit for channel size.
-	if msg.FundingAmount > maxFundingAmount {
+	// largest channel size we permit with this peer.
+	maxChanSize := f.maxChanSize(peerPubKey)
+	if msg.FundingAmount > maxChanSize {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
@@ -1032,43 +1227,152 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Without a channel acceptor, we're ready to create the reservation.
+	if f.cfg.OpenChannelPredicate == nil {
+		f.handleAcceptedFundingOpen(fmsg)
+		return
+	}
+
+	// Once the static checks have passed, we'll let the channel acceptor
+	// decide whether we want this channel at all. Its decisions are
+	// matched to the requests by their pending channel ID, so only a
+	// single request per ID may await a decision.
+	if _, ok := f.pendingAcceptRequests[msg.PendingChannelID]; ok {
+		f.failFundingFlow(
+			fmsg.peer, msg.PendingChannelID,
+			&chanacceptor.RejectError{
+				Reason: "duplicate pending channel ID",
+			},
+		)
+		return
+	}
+	f.pendingAcceptRequests[msg.PendingChannelID] = peerIDKey
+
+	// The acceptor may take a while to reach a decision, so we'll wait
+	// for it in a goroutine rather than stall the funding workflows of all
+	// other channels. The decision is handed back to us through the
+	// fundingMsgs queue.
+	f.wg.Add(1)
+	go f.awaitChanAcceptDecision(fmsg)
+}
+
+// awaitChanAcceptDecision asks the channel acceptor whether the inbound
+// channel should be accepted, and dispatches its decision to the
+// reservationCoordinator.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) awaitChanAcceptDecision(fmsg *fundingOpenMsg) {
+	defer f.wg.Done()
+
+	err := f.cfg.OpenChannelPredicate.Accept(
+		&chanacceptor.ChannelAcceptRequest{
+			Node:        fmsg.peer.IdentityKey(),
+			OpenChanMsg: fmsg.msg,
+		},
+	)
+
+	select {
+	case f.fundingMsgs <- &chanAcceptDecisionMsg{fmsg, err}:
+	case <-f.quit:
+	}
+}
+
+// handleChanAcceptDecision resumes the funding workflow of an inbound channel
+// once the channel acceptor has made its decision, or fails it if the channel
+// was rejected.
+func (f *fundingManager) handleChanAcceptDecision(dmsg *chanAcceptDecisionMsg) {
+	fmsg := dmsg.openMsg
+	peerPubKey := fmsg.peer.IdentityKey()
+	pendingChanID := fmsg.msg.PendingChannelID
+	delete(f.pendingAcceptRequests, pendingChanID)
+
+	if dmsg.err != nil {
+		fndgLog.Infof("Rejecting pendingChan(%x) from peer(%x): %v",
+			pendingChanID, peerPubKey.SerializeCompressed(),
+			dmsg.err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, dmsg.err)
+		return
+	}
+
+	f.handleAcceptedFundingOpen(fmsg)
+}
+
+// handleAcceptedFundingOpen creates the reservation of an inbound channel that
+// has passed all checks, and responds to the peer with an accept channel
+// message.
+func (f *fundingManager) handleAcceptedFundingOpen(fmsg *fundingOpenMsg) {
+	peerPubKey := fmsg.peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	msg := fmsg.msg
+	amt := msg.FundingAmount
+
+	var err error
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
 		fmsg.peer.IdentityKey().SerializeCompressed())
 
+	// If the initiator allows us to add funds to the channel, we'll
+	// consult our policy to determine how much we're willing to
+	// contribute. A failure to reserve our share of the funds isn't
+	// fatal, in that case we simply fall back to a single funder channel.
+	chainHash := chainhash.Hash(msg.ChainHash)
+	var reservation *lnwallet.ChannelReservation
+	ourAmt := f.dualFundingContribution(peerPubKey, msg)
+	if ourAmt > 0 {
+		reservation, err = f.initDualFundingReservation(
+			fmsg.peer, msg, ourAmt,
+		)
+		if err != nil {
+			fndgLog.Warnf("Unable to contribute %v to "+
+				"pendingChan(%x), proceeding as single "+
+				"funder: %v", ourAmt, msg.PendingChannelID, err)
+			ourAmt = 0
+		}
+	}
+
 	// Attempt to initialize a reservation within the wallet. If the wallet
 	// has insufficient resources to create the channel, then the
 	// reservation attempt may be rejected. Note that since we're on the
 	// responding side of a single funder workflow, we don't commit any
 	// funds to the channel ourselves.
-	chainHash := chainhash.Hash(msg.ChainHash)
-	req := &lnwallet.InitFundingReserveMsg{
-		ChainHash:       &chainHash,
-		NodeID:          fmsg.peer.IdentityKey(),
-		NodeAddr:        fmsg.peer.Address(),
-		FundingAmount:   0,
-		Capacity:        amt,
-		CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
-		FundingFeePerKw: 0,
-		PushMSat:        msg.PushAmount,
-		Flags:           msg.ChannelFlags,
-		MinConfs:        1,
-	}
+	if reservation == nil {
+		commitFeePerKw := lnwallet.SatPerKWeight(msg.FeePerKiloWeight)
+		req := &lnwallet.InitFundingReserveMsg{
+			ChainHash:       &chainHash,
+			NodeID:          fmsg.peer.IdentityKey(),
+			NodeAddr:        fmsg.peer.Address(),
+			FundingAmount:   0,
+			Capacity:        amt,
+			CommitFeePerKw:  commitFeePerKw,
+			FundingFeePerKw: 0,
+			PushMSat:        msg.PushAmount,
+			Flags:           msg.ChannelFlags,
+			MinConfs:        1,
+		}
 
-	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
-	if err != nil {
-		fndgLog.Errorf("Unable to initialize reservation: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+		reservation, err = f.cfg.Wallet.InitChannelReservation(req)
+		if err != nil {
+			fndgLog.Errorf("Unable to initialize reservation: %v",
+				err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
 	}
 
+	// From here on, all channel parameters are derived from the total
+	// capacity of the channel, which includes our own contribution in
+	// case of a dual funder channel.
+	capacity := amt + ourAmt
+
 	// As we're the responder, we get to specify the number of
 	// confirmations that we require before both of us consider the channel
 	// open. We'll use out mapping to derive the proper number of
 	// confirmations based on the amount of the channel, and also if any
 	// funds are being pushed to us.
-	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	numConfsReq := f.cfg.NumRequiredConfs(capacity, msg.PushAmount)
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1084,14 +1388,14 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	fndgLog.Infof("Requiring %v confirmations for pendingChan(%x): "+
-		"amt=%v, push_amt=%v", numConfsReq, fmsg.msg.PendingChannelID,
-		amt, msg.PushAmount)
+		"amt=%v, our_amt=%v, push_amt=%v", numConfsReq,
+		fmsg.msg.PendingChannelID, amt, ourAmt, msg.PushAmount)
 
 	// Generate our required constraints for the remote party.
-	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
-	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, msg.DustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(amt)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(amt)
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, msg.DustLimit)
+	maxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
 	minHtlc := f.cfg.DefaultRoutingPolicy.MinHTLC
 
 	// Once the reservation has been created successfully, we add it to
@@ -1103,7 +1407,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 	resCtx := &reservationWithCtx{
 		reservation:    reservation,
-		chanAmt:        amt,
+		chanAmt:        capacity,
 		remoteCsvDelay: remoteCsvDelay,
 		remoteMinHtlc:  minHtlc,
 		err:            make(chan error, 1),
@@ -1159,8 +1463,24 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
 	// With the initiator's contribution recorded, respond with our
-	// contribution in the next message of the workflow.
+	// contribution in the next message of the workflow. If we're adding
+	// funds to the channel, the inputs we spend are sent first, so the
+	// initiator is able to build the funding transaction once it receives
+	// our AcceptChannel message.
 	ourContribution := reservation.OurContribution()
+	if ourAmt > 0 {
+		fundingContribution := newFundingContributionMsg(
+			msg.PendingChannelID, ourAmt, ourContribution,
+		)
+		err := fmsg.peer.SendMessage(false, fundingContribution)
+		if err != nil {
+			fndgLog.Errorf("unable to send funding contribution "+
+				"to peer: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+	}
+
 	fundingAccept := lnwire.AcceptChannel{
 		PendingChannelID:     msg.PendingChannelID,
 		DustLimit:            ourContribution.DustLimit,
@@ -1184,6 +1504,273 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 }
 
+// maxChanSize returns the largest channel we accept or open with the peer with
+// the given public key.
+func (f *fundingManager) maxChanSize(peerKey *btcec.PublicKey) btcutil.Amount {
+	if f.cfg.MaxChanSize == nil {
+		return maxFundingAmount
+	}
+
+	return f.cfg.MaxChanSize(peerKey)
+}
+
+// dualFundingContribution returns the amount we'll add to the channel the
+// remote peer proposes with the passed OpenChannel message. Zero is returned
+// if the initiator doesn't allow us to contribute, or our policy doesn't
+// permit it.
+func (f *fundingManager) dualFundingContribution(peerKey *btcec.PublicKey,
+	msg *lnwire.OpenChannel) btcutil.Amount {
+
+	if msg.ChannelFlags&lnwire.FFDualFund == 0 {
+		return 0
+	}
+	if f.cfg.SupportsDualFunding == nil ||
+		f.cfg.DualFundingContribution == nil ||
+		!f.cfg.SupportsDualFunding(peerKey) {
+
+		return 0
+	}
+
+	// We'll never contribute more than the initiator, and the total
+	// capacity must stay within the largest channel size we permit with
+	// this peer.
+	ourAmt := f.cfg.DualFundingContribution(peerKey)
+	if ourAmt > msg.FundingAmount {
+		ourAmt = msg.FundingAmount
+	}
+	maxChanSize := f.maxChanSize(peerKey)
+	if ourAmt > maxChanSize-msg.FundingAmount {
+		ourAmt = maxChanSize - msg.FundingAmount
+	}
+	if ourAmt < 0 {
+		return 0
+	}
+
+	return ourAmt
+}
+
+// initDualFundingReservation attempts to reserve the passed amount of our
+// funds for the dual funder channel proposed by the OpenChannel message.
+func (f *fundingManager) initDualFundingReservation(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel,
+	ourAmt btcutil.Amount) (*lnwallet.ChannelReservation, error) {
+
+	// We only pay the fees for our own inputs and change outputs, so
+	// we'll use a fee rate that gets the funding transaction confirmed
+	// within a reasonable amount of time.
+	fundingFeePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		return nil, err
+	}
+
+	chainHash := chainhash.Hash(msg.ChainHash)
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:       &chainHash,
+		NodeID:          peer.IdentityKey(),
+		NodeAddr:        peer.Address(),
+		FundingAmount:   ourAmt,
+		Capacity:        msg.FundingAmount + ourAmt,
+		CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
+		FundingFeePerKw: fundingFeePerKw,
+		PushMSat:        msg.PushAmount,
+		Flags:           msg.ChannelFlags,
+		MinConfs:        1,
+	}
+
+	return f.cfg.Wallet.InitChannelReservation(req)
+}
+
+// newFundingContributionMsg creates the FundingContribution message that
+// advertises the inputs and change outputs of the passed contribution to the
+// remote peer.
+func newFundingContributionMsg(pendingChanID [32]byte, amt btcutil.Amount,
+	contribution *lnwallet.ChannelContribution) *lnwire.FundingContribution {
+
+	msg := &lnwire.FundingContribution{
+		PendingChannelID: pendingChanID,
+		FundingAmount:    amt,
+	}
+	for i, txIn := range contribution.Inputs {
+		prevOut := contribution.PrevOutputs[i]
+		msg.Inputs = append(msg.Inputs, lnwire.FundingInput{
+			OutPoint: txIn.PreviousOutPoint,
+			Value:    btcutil.Amount(prevOut.Value),
+			PkScript: prevOut.PkScript,
+			Height:   contribution.PrevOutputHeights[i],
+		})
+	}
+	for _, txOut := range contribution.ChangeOutputs {
+		msg.ChangeOutputs = append(
+			msg.ChangeOutputs, lnwire.FundingOutput{
+				Value:    btcutil.Amount(txOut.Value),
+				PkScript: txOut.PkScript,
+			},
+		)
+	}
+
+	return msg
+}
+
+// parseFundingContribution extracts the inputs, the outputs spent by them
+// along with their heights, and the change outputs from the passed
+// FundingContribution message into a ChannelContribution.
+func parseFundingContribution(
+	msg *lnwire.FundingContribution) *lnwallet.ChannelContribution {
+
+	contribution := &lnwallet.ChannelContribution{
+		FundingAmount: msg.FundingAmount,
+	}
+	for _, input := range msg.Inputs {
+		contribution.Inputs = append(
+			contribution.Inputs,
+			wire.NewTxIn(&input.OutPoint, nil, nil),
+		)
+		contribution.PrevOutputs = append(
+			contribution.PrevOutputs,
+			wire.NewTxOut(int64(input.Value), input.PkScript),
+		)
+		contribution.PrevOutputHeights = append(
+			contribution.PrevOutputHeights, input.Height,
+		)
+	}
+	for _, output := range msg.ChangeOutputs {
+		contribution.ChangeOutputs = append(
+			contribution.ChangeOutputs,
+			wire.NewTxOut(int64(output.Value), output.PkScript),
+		)
+	}
+
+	return contribution
+}
+
+// processFundingContribution sends a message to the fundingManager allowing it
+// to add the inputs contributed by the remote peer to the funding transaction
+// of a dual funder channel.
+func (f *fundingManager) processFundingContribution(
+	msg *lnwire.FundingContribution, peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingContributionMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingContribution processes the inputs and change outputs the
+// remote peer adds to the funding transaction of a dual funder channel. If
+// we're the initiator, the contribution is recorded and processed along with
+// the AcceptChannel message that follows it. Otherwise, the initiator's
+// contribution completes the funding transaction, which allows us to sign our
+// inputs once we receive the FundingCreated message.
+func (f *fundingManager) handleFundingContribution(
+	fmsg *fundingContributionMsg) {
+
+	msg := fmsg.msg
+	pendingChanID := msg.PendingChannelID
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+
+	// Update the timestamp once the fundingContributionMsg has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	fndgLog.Infof("Recv'd funding contribution of %v with %v inputs for "+
+		"pendingID(%x)", msg.FundingAmount, len(msg.Inputs),
+		pendingChanID[:])
+
+	if resCtx.dualFundOffered {
+		if resCtx.remoteFunding != nil || msg.FundingAmount <= 0 {
+			err := fmt.Errorf("invalid funding contribution for "+
+				"pendingID(%x)", pendingChanID[:])
+			fndgLog.Errorf(err.Error())
+			f.failFundingFlow(fmsg.peer, pendingChanID, err)
+			return
+		}
+
+		resCtx.remoteFunding = msg
+		return
+	}
+
+	contribution := parseFundingContribution(msg)
+	err = resCtx.reservation.ProcessDualFundingInputs(
+		contribution.Inputs, contribution.PrevOutputs,
+		contribution.PrevOutputHeights, contribution.ChangeOutputs,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to process funding inputs from %v: %v",
+			peerKey, err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+}
+
+// processFundingInputSigs sends a message to the fundingManager allowing it
+// to record the signatures for the inputs the remote peer contributed to the
+// funding transaction of a dual funder channel.
+func (f *fundingManager) processFundingInputSigs(msg *lnwire.FundingInputSigs,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingInputSigsMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingInputSigs records the signatures for the inputs the responder
+// of a dual funder channel we initiated contributed to the funding
+// transaction. They're verified once the FundingSigned message arrives, and
+// complete the funding transaction.
+func (f *fundingManager) handleFundingInputSigs(fmsg *fundingInputSigsMsg) {
+	f.resMtx.RLock()
+	pendingChanID, ok := f.signedReservations[fmsg.msg.ChanID]
+	f.resMtx.RUnlock()
+	if !ok {
+		err := fmt.Errorf("Unable to find signed reservation for "+
+			"chan_id=%x", fmsg.msg.ChanID)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, fmsg.msg.ChanID, err)
+		return
+	}
+
+	peerKey := fmsg.peer.IdentityKey()
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Unable to find reservation (peer_id:%v, "+
+			"chan_id:%x)", peerKey, pendingChanID[:])
+		f.failFundingFlow(fmsg.peer, fmsg.msg.ChanID, err)
+		return
+	}
+
+	// Update the timestamp once the fundingInputSigsMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	if !resCtx.dualFundOffered || resCtx.remoteInputSigs != nil {
+		err := fmt.Errorf("unexpected funding input signatures for "+
+			"chan_id=%x", fmsg.msg.ChanID)
+		fndgLog.Errorf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	resCtx.remoteInputSigs = make(
+		[]*lnwallet.InputScript, 0, len(fmsg.msg.Witnesses),
+	)
+	for _, witness := range fmsg.msg.Witnesses {
+		resCtx.remoteInputSigs = append(
+			resCtx.remoteInputSigs, &lnwallet.InputScript{
+				Witness: witness,
+			},
+		)
+	}
+}
+
 // processFundingAccept sends a message to the fundingManager allowing it to
 // continue the second phase of a funding workflow with the target peer.
 func (f *fundingManager) processFundingAccept(msg *lnwire.AcceptChannel,
@@ -1216,6 +1803,23 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 
 	fndgLog.Infof("Recv'd fundingResponse for pendingID(%x)", pendingChanID[:])
 
+	// If the responder contributes funds to the channel, we'll make sure
+	// the total capacity stays within the largest channel size we permit
+	// with this peer. The constraints below are then derived from the
+	// capacity of the dual funder channel.
+	if resCtx.remoteFunding != nil {
+		remoteAmt := resCtx.remoteFunding.FundingAmount
+		if resCtx.chanAmt+remoteAmt > f.maxChanSize(peerKey) {
+			f.failFundingFlow(
+				fmsg.peer, msg.PendingChannelID,
+				lnwire.ErrChanTooLarge,
+			)
+			return
+		}
+
+		resCtx.chanAmt += remoteAmt
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -1269,6 +1873,15 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
+	if remoteFunding := resCtx.remoteFunding; remoteFunding != nil {
+		contribution := parseFundingContribution(remoteFunding)
+		remoteContribution.FundingAmount = contribution.FundingAmount
+		remoteContribution.Inputs = contribution.Inputs
+		remoteContribution.PrevOutputs = contribution.PrevOutputs
+		remoteContribution.PrevOutputHeights =
+			contribution.PrevOutputHeights
+		remoteContribution.ChangeOutputs = contribution.ChangeOutputs
+	}
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
@@ -1282,21 +1895,178 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
-	// Now that we have their contribution, we can extract, then send over
-	// both the funding out point and our signature for their version of
-	// the commitment transaction to the remote peer.
-	outPoint := resCtx.reservation.FundingOutpoint()
-	_, sig := resCtx.reservation.OurSignatures()
+	// If the funding transaction is provided by an external wallet, we'll
+	// need to pause the funding flow at this point, as the funding
+	// transaction can only be crafted now that the funding output is
+	// known. The caller is notified of the output it should pay to, and
+	// the flow resumes once the signed transaction is handed to us.
+	fundingOutput := resCtx.reservation.ExternalFundingOutput()
+	if fundingOutput != nil {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			fundingOutput.PkScript, &f.cfg.Wallet.Cfg.NetParams,
+		)
+		if err != nil || len(addrs) != 1 {
+			err := fmt.Errorf("unable to derive funding "+
+				"address: %v", err)
+			fndgLog.Errorf(err.Error())
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
 
-	// A new channel has almost finished the funding process. In order to
-	// properly synchronize with the writeHandler goroutine, we add a new
-	// channel to the barriers map which will be closed once the channel is
-	// fully open.
-	f.barrierMtx.Lock()
-	channelID := lnwire.NewChanIDFromOutPoint(outPoint)
-	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
-	f.newChanBarriers[channelID] = make(chan struct{})
-	f.barrierMtx.Unlock()
+		fndgLog.Infof("Waiting for external funding tx paying %v to "+
+			"%v for pendingID(%x)", btcutil.Amount(fundingOutput.Value),
+			addrs[0], pendingChanID[:])
+
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+				PsbtFund: &lnrpc.ReadyForPsbtFunding{
+					FundingAddress: addrs[0].String(),
+					FundingAmount:  fundingOutput.Value,
+					PendingChanId:  pendingChanID[:],
+				},
+			},
+		}
+
+		select {
+		case resCtx.updates <- upd:
+		case <-f.quit:
+		}
+		return
+	}
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// ProcessExternalFundingTx hands the signed funding transaction of a pending
+// channel that is funded by an external wallet to the funding manager. If the
+// transaction is valid, the funding workflow with the remote peer resumes.
+func (f *fundingManager) ProcessExternalFundingTx(pendingChanID [32]byte,
+	fundingTx *wire.MsgTx) error {
+
+	errChan := make(chan error, 1)
+	select {
+	case f.fundingMsgs <- &externalFundingTxMsg{
+		pendingChanID: pendingChanID,
+		fundingTx:     fundingTx,
+		err:           errChan,
+	}:
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+}
+
+// ProcessBatchFundingTx hands the shared funding transaction of a batch to one
+// of the pending channels of that batch. The transaction must have been
+// created by the wallet via CreateBatchFundingTx, so only its funding output
+// is verified.
+func (f *fundingManager) ProcessBatchFundingTx(pendingChanID [32]byte,
+	fundingTx *wire.MsgTx) error {
+
+	errChan := make(chan error, 1)
+	select {
+	case f.fundingMsgs <- &externalFundingTxMsg{
+		pendingChanID: pendingChanID,
+		fundingTx:     fundingTx,
+		batch:         true,
+		err:           errChan,
+	}:
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+}
+
+// handleExternalFundingTx verifies the funding transaction provided for an
+// externally funded reservation. If it's valid, we'll send the FundingCreated
+// message to the remote peer. Otherwise, the reservation is left untouched,
+// such that the caller is able to retry with a corrected transaction.
+func (f *fundingManager) handleExternalFundingTx(fmsg *externalFundingTxMsg) {
+	pendingChanID := fmsg.pendingChanID
+
+	// As the caller only knows about the pending channel ID, we'll need
+	// to find the peer the reservation belongs to.
+	resCtx := f.findReservationCtx(pendingChanID)
+	if resCtx == nil {
+		fmsg.err <- fmt.Errorf("unable to find reservation for "+
+			"pendingID(%x)", pendingChanID[:])
+		return
+	}
+
+	if resCtx.reservation.ExternalFundingOutput() == nil {
+		fmsg.err <- fmt.Errorf("pendingID(%x) isn't awaiting an "+
+			"external funding tx", pendingChanID[:])
+		return
+	}
+
+	batched := resCtx.batchSigned != nil
+	switch {
+	case fmsg.batch && !batched:
+		fmsg.err <- fmt.Errorf("pendingID(%x) isn't part of a batch",
+			pendingChanID[:])
+		return
+
+	case !fmsg.batch && batched:
+		fmsg.err <- fmt.Errorf("pendingID(%x) is part of a batch, its "+
+			"funding tx is created by the wallet", pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the funding transaction has been handled.
+	defer resCtx.updateTimestamp()
+
+	var err error
+	if fmsg.batch {
+		err = resCtx.reservation.ProcessBatchFundingTx(fmsg.fundingTx)
+	} else {
+		err = resCtx.reservation.ProcessExternalFundingTx(fmsg.fundingTx)
+	}
+	if err != nil {
+		fndgLog.Errorf("Unable to process external funding tx for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		fmsg.err <- err
+		return
+	}
+
+	fndgLog.Infof("Received external funding tx %v for pendingID(%x)",
+		fmsg.fundingTx.TxHash(), pendingChanID[:])
+
+	fmsg.err <- nil
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// sendFundingCreated sends the FundingCreated message to the remote peer, once
+// the funding transaction of a reservation we initiated is known. The message
+// carries both the funding outpoint and our signature for their version of
+// the commitment transaction.
+func (f *fundingManager) sendFundingCreated(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
+
+	outPoint := resCtx.reservation.FundingOutpoint()
+	_, sig := resCtx.reservation.OurSignatures()
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	f.barrierMtx.Lock()
+	channelID := lnwire.NewChanIDFromOutPoint(outPoint)
+	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
 
 	// The next message that advances the funding flow will reference the
 	// channel via its permanent channel ID, so we'll set up this mapping
@@ -1309,19 +2079,38 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Infof("Generated ChannelPoint(%v) for pendingID(%x)", outPoint,
 		pendingChanID[:])
 
+	// If the responder contributed funds to the channel, they still need
+	// our inputs in order to reconstruct the funding transaction, so
+	// we'll send them over before our commitment signature.
+	if resCtx.remoteFunding != nil {
+		ourAmt := resCtx.chanAmt - resCtx.remoteFunding.FundingAmount
+		ourContribution := resCtx.reservation.OurContribution()
+		fundingContribution := newFundingContributionMsg(
+			pendingChanID, ourAmt, ourContribution,
+		)
+		err := resCtx.peer.SendMessage(false, fundingContribution)
+		if err != nil {
+			fndgLog.Errorf("Unable to send funding contribution: "+
+				"%v", err)
+			f.failFundingFlow(resCtx.peer, pendingChanID, err)
+			return
+		}
+	}
+
 	fundingCreated := &lnwire.FundingCreated{
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
 	}
+	var err error
 	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
 	if err != nil {
 		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
 		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
+	if err := resCtx.peer.SendMessage(false, fundingCreated); err != nil {
 		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
 		return
 	}
 }
@@ -1381,29 +2170,6 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// from the set of active reservations.
 	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
 
-	// If something goes wrong before the funding transaction is confirmed,
-	// we use this convenience method to delete the pending OpenChannel
-	// from the database.
-	deleteFromDatabase := func() {
-		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChanPoint:               completeChan.FundingOutpoint,
-			ChainHash:               completeChan.ChainHash,
-			RemotePub:               completeChan.IdentityPub,
-			CloseType:               channeldb.FundingCanceled,
-			Capacity:                completeChan.Capacity,
-			SettledBalance:          localBalance,
-			RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
-			RemoteNextRevocation:    completeChan.RemoteNextRevocation,
-			LocalChanConfig:         completeChan.LocalChanCfg,
-		}
-
-		if err := completeChan.CloseChannel(closeInfo); err != nil {
-			fndgLog.Errorf("Failed closing channel %v: %v",
-				completeChan.FundingOutpoint, err)
-		}
-	}
-
 	// A new channel has almost finished the funding process. In order to
 	// properly synchronize with the writeHandler goroutine, we add a new
 	// channel to the barriers map which will be closed once the channel is
@@ -1419,15 +2185,35 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 
 	// With their signature for our version of the commitment transaction
 	// verified, we can now send over our signature to the remote peer.
-	_, sig := resCtx.reservation.OurSignatures()
+	inputScripts, sig := resCtx.reservation.OurSignatures()
 	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
 	if err != nil {
 		fndgLog.Errorf("unable to parse signature: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
+	// If we contributed inputs to the funding transaction, the initiator
+	// needs our signatures for them before it's able to broadcast it.
+	if len(inputScripts) != 0 {
+		inputSigs := &lnwire.FundingInputSigs{
+			ChanID: channelID,
+		}
+		for _, inputScript := range inputScripts {
+			inputSigs.Witnesses = append(
+				inputSigs.Witnesses, inputScript.Witness,
+			)
+		}
+		if err := fmsg.peer.SendMessage(false, inputSigs); err != nil {
+			fndgLog.Errorf("unable to send FundingInputSigs "+
+				"message: %v", err)
+			f.failFundingFlow(fmsg.peer, pendingChanID, err)
+			f.deletePendingChannel(completeChan)
+			return
+		}
+	}
+
 	fundingSigned := &lnwire.FundingSigned{
 		ChanID:    channelID,
 		CommitSig: ourCommitSig,
@@ -1435,7 +2221,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
 		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
@@ -1488,7 +2274,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 				"(%v) to confirm", completeChan.FundingOutpoint)
 			fndgLog.Warnf(err.Error())
 			f.failFundingFlow(fmsg.peer, pendingChanID, err)
-			deleteFromDatabase()
+			f.deletePendingChannel(completeChan)
 			return
 		case <-f.quit:
 			// The fundingManager is shutting down, will resume
@@ -1515,6 +2301,34 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	}()
 }
 
+// deletePendingChannel removes a pending channel whose funding transaction
+// will never confirm from the database. This is used if something goes wrong
+// before the funding transaction is confirmed.
+func (f *fundingManager) deletePendingChannel(
+	completeChan *channeldb.OpenChannel) {
+
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	if err := completeChan.CloseChannel(closeInfo); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+		return
+	}
+
+	f.cfg.NotifyClosedChannel(completeChan.FundingOutpoint)
+}
+
 // processFundingSigned sends a single funding sign complete message along with
 // the source peer to the funding manager.
 func (f *fundingManager) processFundingSigned(msg *lnwire.FundingSigned,
@@ -1558,21 +2372,26 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
-	// Create an entry in the local discovery map so we can ensure that we
-	// process the channel confirmation fully before we receive a funding
-	// locked message.
-	fundingPoint := resCtx.reservation.FundingOutpoint()
-	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
-	f.localDiscoveryMtx.Lock()
-	f.localDiscoverySignals[permChanID] = make(chan struct{})
-	f.localDiscoveryMtx.Unlock()
+	// If the channel is part of a batch, the shared funding transaction
+	// can only be broadcast once every channel of the batch has been
+	// signed. We'll hold on to the signature until the batch is either
+	// completed or cancelled, and keep the reservation from being pruned
+	// in the meantime.
+	if resCtx.batchSigned != nil {
+		fndgLog.Infof("Holding FundingSigned for batched pendingID(%x)",
+			pendingChanID[:])
 
-	// The remote peer has responded with a signature for our commitment
-	// transaction. We'll verify the signature for validity, then commit
-	// the state to disk as we can now open the channel.
-	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
-	completeChan, err := resCtx.reservation.CompleteReservation(
-		nil, commitSig,
+		f.resMtx.Lock()
+		f.batchSignatures[pendingChanID] = fmsg.msg.CommitSig
+		f.resMtx.Unlock()
+
+		resCtx.lock()
+		close(resCtx.batchSigned)
+		return
+	}
+
+	completeChan, err := f.completeReservation(
+		resCtx, pendingChanID, fmsg.msg.CommitSig,
 	)
 	if err != nil {
 		fndgLog.Errorf("Unable to complete reservation sign "+
@@ -1581,16 +2400,14 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
-	// The channel is now marked IsPending in the database, and we can
-	// delete it from our set of active reservations.
-	f.deleteReservationCtx(peerKey, pendingChanID)
-
 	// Broadcast the finalized funding transaction to the network.
 	fundingTx := completeChan.FundingTxn
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-	err = f.cfg.PublishTransaction(fundingTx)
+	err = f.cfg.PublishTransaction(
+		fundingTx, lnwallet.FundingTxLabel(completeChan.FundingOutpoint),
+	)
 	if err != nil {
 		fndgLog.Errorf("unable to broadcast funding "+
 			"txn: %v", err)
@@ -1601,6 +2418,57 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// delete from the DB?
 	}
 
+	f.launchPendingChannel(
+		fmsg.peer, pendingChanID, completeChan, resCtx.updates,
+	)
+}
+
+// completeReservation verifies the remote peer's signature for our version of
+// the commitment transaction, and persists the channel as pending. Once it
+// returns, the funding transaction may be broadcast.
+func (f *fundingManager) completeReservation(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, commitSig lnwire.Sig) (*channeldb.OpenChannel,
+	error) {
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[permChanID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	// The remote peer has responded with a signature for our commitment
+	// transaction. We'll verify the signature for validity, then commit
+	// the state to disk as we can now open the channel. In case of a dual
+	// funder channel, the signatures for the remote peer's inputs are
+	// verified as well.
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		resCtx.remoteInputSigs, commitSig.ToSignatureBytes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The channel is now marked IsPending in the database, and we can
+	// delete it from our set of active reservations.
+	f.deleteReservationCtx(resCtx.peer.IdentityKey(), pendingChanID)
+
+	return completeChan, nil
+}
+
+// launchPendingChannel hands a pending channel, whose funding transaction has
+// been broadcast, to the chain arbitrator, and waits for the funding
+// transaction to confirm in order to finish the funding flow. The caller is
+// notified about the progress via the passed updates channel.
+func (f *fundingManager) launchPendingChannel(peer lnpeer.Peer,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel,
+	updates chan *lnrpc.OpenStatusUpdate) {
+
+	peerKey := peer.IdentityKey()
+	fundingPoint := &completeChan.FundingOutpoint
+
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
 	// watch for any on-chin actions before the channel has fully
@@ -1628,7 +2496,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	}
 
 	select {
-	case resCtx.updates <- upd:
+	case updates <- upd:
 	case <-f.quit:
 		return
 	}
@@ -1680,7 +2548,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		defer lnChannel.Stop()
 
 		err = f.sendFundingLocked(
-			fmsg.peer, completeChan, lnChannel, shortChanID,
+			peer, completeChan, lnChannel, shortChanID,
 		)
 		if err != nil {
 			fndgLog.Errorf("failed sending fundingLocked: %v", err)
@@ -1714,7 +2582,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		}
 
 		select {
-		case resCtx.updates <- upd:
+		case updates <- upd:
 		case <-f.quit:
 			return
 		}
@@ -1728,6 +2596,150 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	}()
 }
 
+// CompleteBatch finishes the funding flows of a batch of channels that share
+// the passed funding transaction, which must have been signed by every remote
+// peer of the batch. All channels are persisted as pending, and the funding
+// transaction is broadcast. If this fails for any of the channels, none of
+// them is kept and the transaction isn't broadcast, so the caller should
+// cancel the batch via CancelBatch.
+func (f *fundingManager) CompleteBatch(pendingChanIDs [][32]byte,
+	fundingTx *wire.MsgTx) error {
+
+	errChan := make(chan error, 1)
+	select {
+	case f.fundingMsgs <- &completeBatchMsg{
+		pendingChanIDs: pendingChanIDs,
+		fundingTx:      fundingTx,
+		err:            errChan,
+	}:
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+}
+
+// handleCompleteBatch completes the reservations of all channels of a batch,
+// and broadcasts their shared funding transaction.
+func (f *fundingManager) handleCompleteBatch(msg *completeBatchMsg) {
+	resCtxs := make([]*reservationWithCtx, len(msg.pendingChanIDs))
+	sigs := make([]lnwire.Sig, len(msg.pendingChanIDs))
+	for i, pendingChanID := range msg.pendingChanIDs {
+		resCtx := f.findReservationCtx(pendingChanID)
+
+		f.resMtx.RLock()
+		sig, ok := f.batchSignatures[pendingChanID]
+		f.resMtx.RUnlock()
+
+		if resCtx == nil || !ok {
+			msg.err <- fmt.Errorf("pendingID(%x) hasn't been "+
+				"signed by the remote peer", pendingChanID[:])
+			return
+		}
+		resCtxs[i] = resCtx
+		sigs[i] = sig
+	}
+
+	// Now that we know every channel has been signed, we'll verify the
+	// signatures and persist the channels. If any of them turns out to
+	// be invalid, the channels persisted so far are removed again, as
+	// the funding transaction won't be broadcast.
+	completed := make([]*channeldb.OpenChannel, 0, len(resCtxs))
+	abandon := func() {
+		for _, completeChan := range completed {
+			f.deletePendingChannel(completeChan)
+		}
+	}
+	for i, resCtx := range resCtxs {
+		pendingChanID := msg.pendingChanIDs[i]
+		completeChan, err := f.completeReservation(
+			resCtx, pendingChanID, sigs[i],
+		)
+		if err != nil {
+			abandon()
+			msg.err <- fmt.Errorf("unable to complete "+
+				"pendingID(%x): %v", pendingChanID[:], err)
+			return
+		}
+		completed = append(completed, completeChan)
+	}
+
+	fndgLog.Infof("Broadcasting batch funding tx for %v channels: %v",
+		len(completed), spew.Sdump(msg.fundingTx))
+
+	chanPoints := make([]wire.OutPoint, 0, len(completed))
+	for _, completeChan := range completed {
+		chanPoints = append(chanPoints, completeChan.FundingOutpoint)
+	}
+	label := lnwallet.FundingTxLabel(chanPoints...)
+	if err := f.cfg.PublishTransaction(msg.fundingTx, label); err != nil {
+		fndgLog.Errorf("unable to broadcast batch funding txn: %v", err)
+		abandon()
+		msg.err <- err
+		return
+	}
+
+	f.resMtx.Lock()
+	for _, pendingChanID := range msg.pendingChanIDs {
+		delete(f.batchSignatures, pendingChanID)
+	}
+	f.resMtx.Unlock()
+
+	msg.err <- nil
+
+	for i, completeChan := range completed {
+		f.launchPendingChannel(
+			resCtxs[i].peer, msg.pendingChanIDs[i], completeChan,
+			resCtxs[i].updates,
+		)
+	}
+}
+
+// CancelBatch cancels the funding flows of all channels of a batch that are
+// still pending, and notifies their remote peers about the passed reason.
+func (f *fundingManager) CancelBatch(pendingChanIDs [][32]byte, reason error) {
+	done := make(chan struct{})
+	select {
+	case f.fundingMsgs <- &cancelBatchMsg{
+		pendingChanIDs: pendingChanIDs,
+		reason:         reason,
+		done:           done,
+	}:
+	case <-f.quit:
+		return
+	}
+
+	select {
+	case <-done:
+	case <-f.quit:
+	}
+}
+
+// handleCancelBatch fails the funding flow of each channel of a batch that
+// still has an active reservation.
+func (f *fundingManager) handleCancelBatch(msg *cancelBatchMsg) {
+	defer close(msg.done)
+
+	for _, pendingChanID := range msg.pendingChanIDs {
+		f.resMtx.Lock()
+		delete(f.batchSignatures, pendingChanID)
+		f.resMtx.Unlock()
+
+		// The reservation may already be gone, if its funding flow
+		// failed on its own.
+		resCtx := f.findReservationCtx(pendingChanID)
+		if resCtx == nil {
+			continue
+		}
+		f.failFundingFlow(resCtx.peer, pendingChanID, msg.reason)
+	}
+}
+
 // waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation that
 // will cancel the wait for confirmation if we are not the channel initiator and
 // the maxWaitNumBlocksFundingConf has passed from bestHeight.
@@ -1836,6 +2848,23 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 
 	defer close(confChan)
 
+	// Channels with peers on our zero-conf allow list are used right away
+	// under an alias short channel ID, which is replaced by the real one
+	// once the funding transaction confirms.
+	if f.cfg.ZeroConfPeer != nil &&
+		f.cfg.ZeroConfPeer(completeChan.IdentityPub) {
+
+		alias := lnwire.NewAliasShortChanID(completeChan.FundingOutpoint)
+
+		fndgLog.Infof("ChannelPoint(%v) with zero-conf peer %x is now "+
+			"active under alias short_chan_id=%v",
+			completeChan.FundingOutpoint,
+			completeChan.IdentityPub.SerializeCompressed(), alias)
+
+		f.markChannelOpen(completeChan, alias, confChan)
+		return
+	}
+
 	// Register with the ChainNotifier for a notification once the funding
 	// transaction reaches `numConfs` confirmations.
 	txid := completeChan.FundingOutpoint.Hash
@@ -1899,6 +2928,20 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 		TxPosition:  uint16(fundingPoint.Index),
 	}
 
+	f.markChannelOpen(completeChan, shortChanID, confChan)
+}
+
+// markChannelOpen marks the pending channel as open under the given short
+// channel ID, both within the database and the switch. The short channel ID
+// is then passed to confChan, and the peer is allowed to process the
+// fundingLocked message of the remote party.
+func (f *fundingManager) markChannelOpen(completeChan *channeldb.OpenChannel,
+	shortChanID lnwire.ShortChannelID,
+	confChan chan<- *lnwire.ShortChannelID) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
 	// Now that the channel has been fully confirmed, we'll mark it as open
 	// within the database.
 	if err := completeChan.MarkAsOpen(shortChanID); err != nil {
@@ -1918,8 +2961,9 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	// TODO(halseth): make the two db transactions (MarkChannelAsOpen and
 	// saveChannelOpeningState) atomic by doing them in the same transaction.
 	// Needed to be properly fault-tolerant.
-	err = f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
-		&shortChanID)
+	err := f.saveChannelOpeningState(
+		&completeChan.FundingOutpoint, markedOpen, &shortChanID,
+	)
 	if err != nil {
 		fndgLog.Errorf("error setting channel state to markedOpen: %v",
 			err)
@@ -2008,6 +3052,21 @@ func (f *fundingManager) sendFundingLocked(peer lnpeer.Peer,
 		return fmt.Errorf("unable to create next revocation: %v", err)
 	}
 	fundingLockedMsg := lnwire.NewFundingLocked(chanID, nextRevocation)
+	msgs := []lnwire.Message{fundingLockedMsg}
+
+	// The remote party of a zero-conf channel we initiated will use the
+	// channel before the funding transaction confirms, so we'll send it
+	// the transaction first to allow it to watch for a double spend. The
+	// message has an odd type, so peers that don't know it ignore it.
+	if completeChan.IsInitiator && shortChanID.IsAlias() &&
+		completeChan.FundingTxn != nil {
+
+		fundingTxMsg := &lnwire.FundingTx{
+			ChanID: chanID,
+			Tx:     completeChan.FundingTxn,
+		}
+		msgs = []lnwire.Message{fundingTxMsg, fundingLockedMsg}
+	}
 
 	// If the peer has disconnected before we reach this point, we will need
 	// to wait for him to come back online before sending the fundingLocked
@@ -2024,7 +3083,7 @@ func (f *fundingManager) sendFundingLocked(peer lnpeer.Peer,
 		fndgLog.Debugf("Sending FundingLocked for ChannelID(%v) to "+
 			"peer %x", chanID, peerKey.SerializeCompressed())
 
-		if err := peer.SendMessage(false, fundingLockedMsg); err == nil {
+		if err := peer.SendMessage(false, msgs...); err == nil {
 			// Sending succeeded, we can break out and continue the
 			// funding flow.
 			break
@@ -2090,9 +3149,19 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 			"announcement: %v", err)
 	}
 
+	// The router can't locate a channel on chain by its alias, so we'll
+	// pass along the funding outpoint and capacity of such a channel.
+	var optionalFields []discovery.OptionalMsgField
+	if shortChanID.IsAlias() {
+		optionalFields = append(optionalFields,
+			discovery.ChannelPoint(completeChan.FundingOutpoint),
+			discovery.ChannelCapacity(completeChan.Capacity),
+		)
+	}
+
 	// Send ChannelAnnouncement and ChannelUpdate to the gossiper to add
 	// to the Router's topology.
-	errChan := f.cfg.SendAnnouncement(ann.chanAnn)
+	errChan := f.cfg.SendAnnouncement(ann.chanAnn, optionalFields...)
 	select {
 	case err := <-errChan:
 		if err != nil {
@@ -2149,6 +3218,16 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	shortChanID *lnwire.ShortChannelID) error {
 
+	// A channel that is used under its alias must be moved over to its
+	// real short channel ID before it can be announced.
+	if shortChanID.IsAlias() {
+		var err error
+		shortChanID, err = f.confirmAliasChannel(completeChan)
+		if err != nil {
+			return err
+		}
+	}
+
 	// If this channel is meant to be announced to the greater network,
 	// wait until the funding tx has reached 6 confirmations before
 	// announcing it.
@@ -2245,24 +3324,662 @@ func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	return nil
 }
 
-// processFundingLocked sends a message to the fundingManager allowing it to
-// finish the funding workflow.
-func (f *fundingManager) processFundingLocked(msg *lnwire.FundingLocked,
-	peer lnpeer.Peer) {
+// confirmAliasChannel waits for the funding transaction of a channel that is
+// used under its alias short channel ID to confirm. The channel is then moved
+// over to its real short channel ID within the database, the switch and the
+// router graph, and the real short channel ID is returned. If the funding
+// transaction is double spent instead, the channel is marked borked and its
+// link removed, so that it's no longer used to forward HTLCs.
+func (f *fundingManager) confirmAliasChannel(
+	completeChan *channeldb.OpenChannel) (*lnwire.ShortChannelID, error) {
 
-	select {
-	case f.fundingMsgs <- &fundingLockedMsg{msg, peer}:
-	case <-f.quit:
-		return
+	fundingPoint := completeChan.FundingOutpoint
+	txid := fundingPoint.Hash
+	fundingScript, err := makeFundingScript(completeChan)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create funding script for "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, fundingScript, 1, completeChan.FundingBroadcastHeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for confirmation "+
+			"of ChannelPoint(%v): %v", fundingPoint, err)
 	}
-}
 
-// handleFundingLocked finalizes the channel funding process and enables the
-// channel to enter normal operating mode.
-func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
-	defer f.wg.Done()
-	fndgLog.Debugf("Received FundingLocked for ChannelID(%v) from "+
-		"peer %x", fmsg.msg.ChanID,
+	// A double spend of the funding transaction can only be detected by
+	// watching its inputs, so we'll need to know the transaction in full.
+	// The funding transaction of a channel initiated by the remote party
+	// is sent to us along with FundingLocked, so we may need to wait for
+	// it.
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	fundingTxns := make(chan *wire.MsgTx, 1)
+	if completeChan.FundingTxn != nil {
+		fundingTxns <- completeChan.FundingTxn
+	} else {
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+
+			channel, err := f.waitForFundingTx(chanID)
+			if err != nil || channel.FundingTxn == nil {
+				return
+			}
+			fundingTxns <- channel.FundingTxn
+		}()
+	}
+
+	fndgLog.Infof("Waiting for funding tx (%v) of zero-conf "+
+		"ChannelPoint(%v) to confirm", txid, fundingPoint)
+
+	doubleSpends := make(chan *chainhash.Hash, 1)
+	var confDetails *chainntnfs.TxConfirmation
+	for confDetails == nil {
+		select {
+		case fundingTx := <-fundingTxns:
+			completeChan.FundingTxn = fundingTx
+			stopWatching, err := f.watchFundingInputs(
+				completeChan, doubleSpends,
+			)
+			if err != nil {
+				return nil, err
+			}
+			defer stopWatching()
+
+		case details, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return nil, fmt.Errorf("ChainNotifier shutting "+
+					"down, cannot confirm ChannelPoint(%v)",
+					fundingPoint)
+			}
+			confDetails = details
+
+		case spenderHash := <-doubleSpends:
+			fndgLog.Errorf("Funding tx of zero-conf "+
+				"ChannelPoint(%v) double spent by %v, "+
+				"removing link", fundingPoint, spenderHash)
+
+			if err := completeChan.MarkBorked(); err != nil {
+				return nil, fmt.Errorf("unable to mark "+
+					"ChannelPoint(%v) borked: %v",
+					fundingPoint, err)
+			}
+
+			// The channel has never been announced, so removing
+			// its link is all that's needed to stop using it.
+			f.cfg.RemoveLink(chanID)
+
+			return nil, fmt.Errorf("funding tx of ChannelPoint(%v) "+
+				"double spent", fundingPoint)
+
+		case <-f.quit:
+			return nil, ErrFundingManagerShuttingDown
+		}
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(fundingPoint.Index),
+	}
+
+	// The switch must not forward HTLCs over the channel while its short
+	// channel ID changes on disk, so we'll have it persist the confirmed
+	// short channel ID while holding its link index, and move the link
+	// over to it right after. If we went down after updating the database
+	// the last time around, the channel will already use its real short
+	// channel ID.
+	err = f.cfg.ConfirmAliasShortChanID(fundingPoint, func() error {
+		if !completeChan.ShortChanID().IsAlias() {
+			return nil
+		}
+		return completeChan.ConfirmShortChanID(shortChanID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to confirm short chan id of "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	// The funding transaction is no longer needed to detect a double
+	// spend, so nobody should wait for it anymore.
+	f.signalFundingTx(chanID)
+
+	fndgLog.Infof("Zero-conf ChannelPoint(%v) confirmed, "+
+		"short_chan_id=%v", fundingPoint, shortChanID)
+
+	// With the link updated, the channel can replace its alias within the
+	// router graph.
+	if err := f.addToRouterGraph(completeChan, &shortChanID); err != nil {
+		return nil, fmt.Errorf("failed adding to router graph: %v",
+			err)
+	}
+
+	return &shortChanID, nil
+}
+
+// watchFundingInputs registers for the spends of the inputs of the funding
+// transaction of the passed channel. If any of them is spent by a transaction
+// other than the funding transaction, the hash of the spending transaction is
+// sent over doubleSpends. The returned closure stops watching the inputs.
+func (f *fundingManager) watchFundingInputs(
+	completeChan *channeldb.OpenChannel,
+	doubleSpends chan<- *chainhash.Hash) (func(), error) {
+
+	fundingTxid := completeChan.FundingOutpoint.Hash
+	done := make(chan struct{})
+
+	var spendNtfns []*chainntnfs.SpendEvent
+	stopWatching := func() {
+		close(done)
+		for _, spendNtfn := range spendNtfns {
+			spendNtfn.Cancel()
+		}
+	}
+
+	for _, txIn := range completeChan.FundingTxn.TxIn {
+		// Light clients need the script of the spent output, which
+		// we'll only know for our own inputs.
+		var pkScript []byte
+		prevOut, err := f.cfg.Wallet.FetchInputInfo(
+			&txIn.PreviousOutPoint,
+		)
+		if err == nil {
+			pkScript = prevOut.PkScript
+		}
+
+		spendNtfn, err := f.cfg.Notifier.RegisterSpendNtfn(
+			&txIn.PreviousOutPoint, pkScript,
+			completeChan.FundingBroadcastHeight,
+		)
+		if err != nil {
+			stopWatching()
+			return nil, fmt.Errorf("unable to register for spend "+
+				"of funding input %v: %v",
+				txIn.PreviousOutPoint, err)
+		}
+		spendNtfns = append(spendNtfns, spendNtfn)
+
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+
+			select {
+			case spend, ok := <-spendNtfn.Spend:
+				if !ok || *spend.SpenderTxHash == fundingTxid {
+					return
+				}
+
+				select {
+				case doubleSpends <- spend.SpenderTxHash:
+				default:
+				}
+
+			case <-done:
+			case <-f.quit:
+			}
+		}()
+	}
+
+	return stopWatching, nil
+}
+
+// rebroadcastSplice rebroadcasts the splice transaction of the passed
+// channel, if we've already obtained all of its signatures.
+func (f *fundingManager) rebroadcastSplice(channel *channeldb.OpenChannel) {
+	splice, err := channel.PendingSplice()
+	if err != nil {
+		fndgLog.Errorf("Unable to fetch splice of ChannelPoint(%v): %v",
+			channel.FundingOutpoint, err)
+		return
+	}
+
+	for _, txIn := range splice.SpliceTx.TxIn {
+		if len(txIn.Witness) == 0 {
+			return
+		}
+	}
+
+	err = f.cfg.PublishTransaction(
+		splice.SpliceTx, lnwallet.SpliceTxLabel(channel.FundingOutpoint),
+	)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		fndgLog.Warnf("Unable to rebroadcast splice txn: %v", err)
+	}
+}
+
+// WatchSplice waits for the pending splice transaction of the passed channel
+// to confirm. Once it does, the channel is moved over to its new funding
+// output, handed back to the peer, and announced with its new short channel
+// ID.
+//
+// NOTE: Until then, the channel has no link in the switch. Updating it would
+// require both parties to sign for the commitments spending the current and
+// the new funding output, which isn't part of the splice protocol, so the
+// channel can't forward any HTLCs while its splice is pending.
+func (f *fundingManager) WatchSplice(channel *channeldb.OpenChannel) {
+	f.wg.Add(1)
+	go f.waitForSpliceConfirmation(channel)
+}
+
+// waitForSpliceConfirmation is the goroutine launched by WatchSplice.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) waitForSpliceConfirmation(
+	channel *channeldb.OpenChannel) {
+
+	defer f.wg.Done()
+
+	oldChanPoint := channel.FundingOutpoint
+	splice, err := channel.PendingSplice()
+	if err != nil {
+		fndgLog.Errorf("Unable to fetch splice of ChannelPoint(%v): %v",
+			oldChanPoint, err)
+		return
+	}
+
+	txid := splice.FundingOutpoint.Hash
+	fundingOutput := splice.SpliceTx.TxOut[splice.FundingOutpoint.Index]
+	fundingScript := fundingOutput.PkScript
+	numConfs := uint32(channel.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
+	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
+		&txid, fundingScript, numConfs, splice.BroadcastHeight,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to register for confirmation of splice "+
+			"of ChannelPoint(%v): %v", oldChanPoint, err)
+		return
+	}
+
+	// The new funding output pays to the same script as the current one,
+	// so we'll use it to watch for any transaction other than the splice
+	// transaction spending the current funding output.
+	spendNtfn, err := f.cfg.Notifier.RegisterSpendNtfn(
+		&oldChanPoint, fundingScript, channel.FundingBroadcastHeight,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to register for spend of "+
+			"ChannelPoint(%v): %v", oldChanPoint, err)
+		return
+	}
+	defer spendNtfn.Cancel()
+
+	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		fndgLog.Errorf("unable to register for epoch notification: %v",
+			err)
+		return
+	}
+	defer epochClient.Cancel()
+
+	fndgLog.Infof("Waiting for splice tx (%v) of ChannelPoint(%v) to "+
+		"reach %v confirmations", txid, oldChanPoint, numConfs)
+
+	confDetails := f.waitForSpliceTx(
+		channel, splice, confNtfn, spendNtfn, epochClient,
+	)
+	if confDetails == nil {
+		return
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(splice.FundingOutpoint.Index),
+	}
+
+	// With the splice transaction confirmed, we'll move the channel over
+	// to its new funding output, and have it watched there. Like the
+	// funding transaction, we assume the splice transaction won't be
+	// reorged out once it reached the required number of confirmations.
+	if err := channel.CompleteSplice(shortChanID); err != nil {
+		fndgLog.Errorf("Unable to complete splice of "+
+			"ChannelPoint(%v): %v", oldChanPoint, err)
+		return
+	}
+
+	fndgLog.Infof("ChannelPoint(%v) spliced into ChannelPoint(%v), "+
+		"short_chan_id=%v", oldChanPoint, channel.FundingOutpoint,
+		shortChanID)
+
+	err = f.cfg.SpliceContract(oldChanPoint, channel)
+	if err != nil {
+		fndgLog.Errorf("Unable to watch spliced ChannelPoint(%v): %v",
+			channel.FundingOutpoint, err)
+		return
+	}
+
+	// Both parties already consider the channel open, so we can skip
+	// right ahead to announcing the channel under its new short channel
+	// ID. Saving the opening state ensures we'll resume the announcement
+	// upon restart.
+	err = f.saveChannelOpeningState(
+		&channel.FundingOutpoint, fundingLockedSent, &shortChanID,
+	)
+	if err != nil {
+		fndgLog.Errorf("error setting channel state to "+
+			"fundingLockedSent: %v", err)
+		return
+	}
+
+	// Once the peer is online, we'll hand the channel back to it so a
+	// link is created for the channel at its new funding output.
+	peerChan := make(chan lnpeer.Peer, 1)
+	f.cfg.NotifyWhenOnline(channel.IdentityPub, peerChan)
+
+	var peer lnpeer.Peer
+	select {
+	case peer = <-peerChan:
+	case <-f.quit:
+		return
+	}
+	if err := peer.AddNewChannel(channel, f.quit); err != nil {
+		fndgLog.Errorf("Unable to add spliced ChannelPoint(%v) to "+
+			"peer: %v", channel.FundingOutpoint, err)
+	}
+
+	if err := f.addToRouterGraph(channel, &shortChanID); err != nil {
+		fndgLog.Errorf("failed adding to router graph: %v", err)
+		return
+	}
+	if err := f.annAfterSixConfs(channel, &shortChanID); err != nil {
+		fndgLog.Errorf("error sending channel announcements: %v", err)
+	}
+}
+
+// waitForSpliceTx waits for the splice transaction of the passed channel to
+// reach the number of confirmations of confNtfn, and returns the details of
+// its confirmation. If the splice is aborted, or the funding manager is
+// shutting down, nil is returned.
+//
+// The splice is aborted once the current funding output of the channel is
+// spent by any other transaction, as the splice transaction can then never
+// confirm. Should the splice transaction not confirm within
+// maxWaitNumBlocksSpliceConf blocks, we'll force close the channel to recover
+// its funds, which in turn aborts the splice once the commitment transaction
+// confirms.
+func (f *fundingManager) waitForSpliceTx(channel *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice, confNtfn *chainntnfs.ConfirmationEvent,
+	spendNtfn *chainntnfs.SpendEvent,
+	epochClient *chainntnfs.BlockEpochEvent) *chainntnfs.TxConfirmation {
+
+	chanPoint := channel.FundingOutpoint
+	spliceTxid := splice.FundingOutpoint.Hash
+	maxHeight := splice.BroadcastHeight + maxWaitNumBlocksSpliceConf
+	forceClosed := false
+
+	for {
+		select {
+		case confDetails, ok := <-confNtfn.Confirmed:
+			if !ok {
+				fndgLog.Warnf("ChainNotifier shutting down, "+
+					"cannot complete splice of "+
+					"ChannelPoint(%v)", chanPoint)
+				return nil
+			}
+
+			// The block confirming the splice transaction might
+			// have been disconnected right after the notification
+			// was dispatched. As the channel can't be moved back
+			// to its current funding output, we'll make sure the
+			// block is still part of the main chain. Otherwise
+			// we'll wait for the splice transaction to confirm
+			// again.
+			chainIO := f.cfg.Wallet.Cfg.ChainIO
+			blockHash, err := chainIO.GetBlockHash(
+				int64(confDetails.BlockHeight),
+			)
+			if err != nil {
+				fndgLog.Errorf("Unable to fetch block hash at "+
+					"height %v: %v",
+					confDetails.BlockHeight, err)
+				return nil
+			}
+			if *blockHash != *confDetails.BlockHash {
+				fndgLog.Warnf("Splice tx (%v) of "+
+					"ChannelPoint(%v) was reorged out of "+
+					"the chain, waiting for it to confirm "+
+					"again", spliceTxid, chanPoint)
+				continue
+			}
+
+			return confDetails
+
+		case reorgDepth, ok := <-confNtfn.NegativeConf:
+			if !ok {
+				return nil
+			}
+
+			fndgLog.Warnf("Splice tx (%v) of ChannelPoint(%v) was "+
+				"reorged out of the chain by %v blocks, "+
+				"waiting for it to confirm again", spliceTxid,
+				chanPoint, reorgDepth)
+
+		case spend, ok := <-spendNtfn.Spend:
+			if !ok {
+				return nil
+			}
+
+			// If it's the splice transaction itself that spent
+			// the funding output, we just need to wait for it to
+			// reach the required number of confirmations.
+			if *spend.SpenderTxHash == spliceTxid {
+				continue
+			}
+
+			f.abortSplice(channel, splice, spend.SpenderTxHash)
+			return nil
+
+		case epoch, ok := <-epochClient.Epochs:
+			if !ok {
+				fndgLog.Warnf("Epoch client shutting down")
+				return nil
+			}
+
+			if forceClosed || uint32(epoch.Height) < maxHeight {
+				continue
+			}
+
+			fndgLog.Warnf("Waited for %v blocks without seeing "+
+				"splice tx (%v) confirmed, force closing "+
+				"ChannelPoint(%v)", maxWaitNumBlocksSpliceConf,
+				spliceTxid, chanPoint)
+
+			err := f.cfg.ForceCloseContract(chanPoint)
+			if err != nil {
+				fndgLog.Errorf("Unable to force close "+
+					"ChannelPoint(%v): %v", chanPoint, err)
+				continue
+			}
+			forceClosed = true
+
+		case <-f.quit:
+			return nil
+		}
+	}
+}
+
+// abortSplice gives up on the pending splice of the passed channel after its
+// funding output was spent by the transaction with the passed hash. The chain
+// arbitrator still watches the funding output, and will resolve the channel,
+// so all that's left for us is to forget about the splice and release any of
+// our coins it spent.
+func (f *fundingManager) abortSplice(channel *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice, spenderTxid *chainhash.Hash) {
+
+	fndgLog.Warnf("ChannelPoint(%v) spent by txid=%v, aborting splice",
+		channel.FundingOutpoint, spenderTxid)
+
+	// Releasing inputs that belong to the remote party is a no-op.
+	var inputs []*wire.TxIn
+	for _, txIn := range splice.SpliceTx.TxIn {
+		if txIn.PreviousOutPoint != channel.FundingOutpoint {
+			inputs = append(inputs, txIn)
+		}
+	}
+	f.cfg.Wallet.ReleaseSpliceInputs(inputs)
+
+	err := channel.AbortSplice()
+	switch err {
+	// The chain arbitrator may already have closed the channel, in which
+	// case there's no splice left to remove.
+	case nil, channeldb.ErrNoChanDBExists, channeldb.ErrNoActiveChannels,
+		channeldb.ErrChannelNotFound:
+
+	default:
+		fndgLog.Errorf("Unable to abort splice of ChannelPoint(%v): %v",
+			channel.FundingOutpoint, err)
+	}
+}
+
+// processFundingTx sends a message to the fundingManager allowing it to watch
+// the funding transaction of an inbound zero-conf channel for a double spend.
+func (f *fundingManager) processFundingTx(msg *lnwire.FundingTx,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingTxMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingTx stores the funding transaction of an inbound zero-conf
+// channel as sent by the remote party, and signals anybody waiting for it.
+func (f *fundingManager) handleFundingTx(fmsg *fundingTxMsg) {
+	chanID := fmsg.msg.ChanID
+	peerKey := fmsg.peer.IdentityKey()
+
+	fndgLog.Debugf("Received FundingTx for ChannelID(%v) from peer %x",
+		chanID, peerKey.SerializeCompressed())
+
+	channel, err := f.cfg.FindChannel(chanID)
+	if err != nil {
+		fndgLog.Errorf("Unable to locate ChannelID(%v), cannot store "+
+			"funding tx", chanID)
+		return
+	}
+
+	// Only the initiator of the channel knows the funding transaction
+	// upfront, so it's the only one to send it.
+	if channel.IsInitiator || !channel.IdentityPub.IsEqual(peerKey) {
+		fndgLog.Errorf("Peer %x is not the initiator of ChannelID(%v), "+
+			"ignoring FundingTx", peerKey.SerializeCompressed(),
+			chanID)
+		return
+	}
+	if channel.FundingTxn != nil {
+		fndgLog.Infof("Received duplicate FundingTx for "+
+			"ChannelID(%v), ignoring.", chanID)
+		return
+	}
+
+	// The txid commits to the inputs and outputs of the transaction, so a
+	// matching txid is all we need to make sure it's the funding
+	// transaction of the channel.
+	fundingTx := fmsg.msg.Tx
+	if fundingTx.TxHash() != channel.FundingOutpoint.Hash {
+		fndgLog.Errorf("FundingTx %v doesn't match funding outpoint "+
+			"%v of ChannelID(%v)", fundingTx.TxHash(),
+			channel.FundingOutpoint, chanID)
+		return
+	}
+
+	if err := channel.InsertFundingTxn(fundingTx); err != nil {
+		fndgLog.Errorf("Unable to store funding tx of ChannelID(%v): "+
+			"%v", chanID, err)
+		return
+	}
+
+	f.signalFundingTx(chanID)
+}
+
+// awaitsFundingTx returns whether the passed channel is an inbound zero-conf
+// channel whose funding transaction has neither been sent to us nor
+// confirmed yet.
+func awaitsFundingTx(channel *channeldb.OpenChannel) bool {
+	return !channel.IsInitiator && channel.ShortChanID().IsAlias() &&
+		channel.FundingTxn == nil
+}
+
+// fundingTxSignal returns a channel that is closed once the funding
+// transaction of the target inbound zero-conf channel has been sent to us or
+// has confirmed. To not miss the signal, it must be obtained before reading
+// the channel from disk.
+func (f *fundingManager) fundingTxSignal(
+	chanID lnwire.ChannelID) chan struct{} {
+
+	f.fundingTxMtx.Lock()
+	defer f.fundingTxMtx.Unlock()
+
+	signal, ok := f.fundingTxSignals[chanID]
+	if !ok {
+		signal = make(chan struct{})
+		f.fundingTxSignals[chanID] = signal
+	}
+
+	return signal
+}
+
+// signalFundingTx closes the funding tx signal of the target channel, if
+// anybody is waiting for it. It must be called after the channel has been
+// updated on disk.
+func (f *fundingManager) signalFundingTx(chanID lnwire.ChannelID) {
+	f.fundingTxMtx.Lock()
+	defer f.fundingTxMtx.Unlock()
+
+	if signal, ok := f.fundingTxSignals[chanID]; ok {
+		close(signal)
+		delete(f.fundingTxSignals, chanID)
+	}
+}
+
+// waitForFundingTx blocks until the funding transaction of the target inbound
+// zero-conf channel has been sent to us or has confirmed. The channel is then
+// returned as read from disk.
+func (f *fundingManager) waitForFundingTx(
+	chanID lnwire.ChannelID) (*channeldb.OpenChannel, error) {
+
+	for {
+		fundingTxKnown := f.fundingTxSignal(chanID)
+
+		channel, err := f.cfg.FindChannel(chanID)
+		if err != nil {
+			return nil, err
+		}
+		if !awaitsFundingTx(channel) {
+			return channel, nil
+		}
+
+		select {
+		case <-fundingTxKnown:
+		case <-f.quit:
+			return nil, ErrFundingManagerShuttingDown
+		}
+	}
+}
+
+// processFundingLocked sends a message to the fundingManager allowing it to
+// finish the funding workflow.
+func (f *fundingManager) processFundingLocked(msg *lnwire.FundingLocked,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingLockedMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingLocked finalizes the channel funding process and enables the
+// channel to enter normal operating mode.
+func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
+	defer f.wg.Done()
+	fndgLog.Debugf("Received FundingLocked for ChannelID(%v) from "+
+		"peer %x", fmsg.msg.ChanID,
 		fmsg.peer.IdentityKey().SerializeCompressed())
 
 	// If we are currently in the process of handling a funding locked
@@ -2330,6 +4047,24 @@ func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
 		return
 	}
 
+	// An inbound zero-conf channel is used before its funding transaction
+	// confirms, so we must be able to detect a double spend of the
+	// transaction in the meantime. The remote party sends it along with
+	// FundingLocked, and we'll wait for it before accepting the remote
+	// party's next commitment point, which keeps the channel from
+	// forwarding HTLCs until then.
+	if awaitsFundingTx(channel) {
+		fndgLog.Infof("Waiting for funding tx of zero-conf "+
+			"ChannelID(%v)", chanID)
+
+		channel, err = f.waitForFundingTx(chanID)
+		if err != nil {
+			fndgLog.Errorf("Unable to wait for funding tx of "+
+				"ChannelID(%v): %v", chanID, err)
+			return
+		}
+	}
+
 	// The funding locked message contains the next commitment point we'll
 	// need to create the next commitment state for the remote party. So
 	// we'll insert that into the channel now before passing it along to
@@ -2633,6 +4368,16 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		localAmt, msg.pushAmt, capacity, msg.chainHash,
 		peerKey.SerializeCompressed(), ourDustLimit, msg.minConfs)
 
+	// Channels above the soft-limit for channel size can only be opened
+	// if the peer signaled support for large channels, so we'll bail out
+	// early rather than have the peer reject the channel.
+	if maxChanSize := f.maxChanSize(peerKey); capacity > maxChanSize {
+		msg.err <- fmt.Errorf("funding amount is too large, the max "+
+			"channel size with peer %x is: %v",
+			peerKey.SerializeCompressed(), maxChanSize)
+		return
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -2665,6 +4410,8 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		FundingInputs:   msg.fundingInputs,
+		ExternalFunding: msg.externalFunding,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2692,6 +4439,21 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		minHtlc = f.cfg.DefaultRoutingPolicy.MinHTLC
 	}
 
+	// We'll allow the remote peer to contribute funds to the channel if
+	// we both support dual funder channels. As the funding transaction
+	// must not be malleable, this is only possible if we're funding the
+	// channel from native segwit outputs of our own wallet.
+	ourContribution := reservation.OurContribution()
+	dualFund := f.cfg.SupportsDualFunding != nil &&
+		f.cfg.SupportsDualFunding(peerKey) && !msg.externalFunding &&
+		msg.batchSigned == nil &&
+		len(ourContribution.PrevOutputs) == len(ourContribution.Inputs)
+	for _, prevOut := range ourContribution.PrevOutputs {
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			dualFund = false
+		}
+	}
+
 	// If a pending channel map for this peer isn't already created, then
 	// we create one, ultimately allowing us to track this pending
 	// reservation within the target peer.
@@ -2702,13 +4464,15 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 
 	resCtx := &reservationWithCtx{
-		chanAmt:        capacity,
-		remoteCsvDelay: remoteCsvDelay,
-		remoteMinHtlc:  minHtlc,
-		reservation:    reservation,
-		peer:           msg.peer,
-		updates:        msg.updates,
-		err:            msg.err,
+		chanAmt:         capacity,
+		remoteCsvDelay:  remoteCsvDelay,
+		remoteMinHtlc:   minHtlc,
+		reservation:     reservation,
+		peer:            msg.peer,
+		updates:         msg.updates,
+		err:             msg.err,
+		batchSigned:     msg.batchSigned,
+		dualFundOffered: dualFund,
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
@@ -2716,10 +4480,6 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	// Update the timestamp once the initFundingMsg has been handled.
 	defer resCtx.updateTimestamp()
 
-	// Once the reservation has been created, and indexed, queue a funding
-	// request to the remote peer, kicking off the funding workflow.
-	ourContribution := reservation.OurContribution()
-
 	// Finally, we'll use the current value of the channels and our default
 	// policy to determine of required commitment constraints for the
 	// remote party.
@@ -2750,6 +4510,12 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
 		ChannelFlags:         channelFlags,
 	}
+	if dualFund {
+		fundingOpen.ChannelFlags |= lnwire.FFDualFund
+	}
+
+	// Once the reservation has been created, and indexed, queue a funding
+	// request to the remote peer, kicking off the funding workflow.
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
 			err)
@@ -2959,6 +4725,24 @@ func (f *fundingManager) getReservationCtx(peerKey *btcec.PublicKey,
 	return resCtx, nil
 }
 
+// findReservationCtx returns the reservation context for a particular pending
+// channel ID, regardless of the peer it belongs to. If there's no such
+// reservation, nil is returned.
+func (f *fundingManager) findReservationCtx(
+	pendingChanID [32]byte) *reservationWithCtx {
+
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	for _, pendingChans := range f.activeReservations {
+		if resCtx, ok := pendingChans[pendingChanID]; ok {
+			return resCtx
+		}
+	}
+
+	return nil
+}
+
 // IsPendingChannel returns a boolean indicating whether the channel identified
 // by the pendingChanID and given peer is pending, meaning it is in the process
 // of being funded. After the funding transaction has been confirmed, the
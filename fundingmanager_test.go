@@ -17,11 +17,14 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnpeer"
@@ -95,6 +98,9 @@ type mockNotifier struct {
 	oneConfChannel chan *chainntnfs.TxConfirmation
 	sixConfChannel chan *chainntnfs.TxConfirmation
 	epochChan      chan *chainntnfs.BlockEpoch
+
+	// spendChannel, if set, is returned for all spend notifications.
+	spendChannel chan *chainntnfs.SpendDetail
 }
 
 func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
@@ -128,8 +134,13 @@ func (m *mockNotifier) Stop() error {
 
 func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 	heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	spendChan := m.spendChannel
+	if spendChan == nil {
+		spendChan = make(chan *chainntnfs.SpendDetail)
+	}
 	return &chainntnfs.SpendEvent{
-		Spend:  make(chan *chainntnfs.SpendDetail),
+		Spend:  spendChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -164,8 +175,13 @@ func (n *testNode) PubKey() [33]byte {
 	return newSerializedKey(n.addr.IdentityKey)
 }
 
-func (n *testNode) SendMessage(_ bool, msg ...lnwire.Message) error {
-	return n.sendMessage(msg[0])
+func (n *testNode) SendMessage(_ bool, msgs ...lnwire.Message) error {
+	for _, msg := range msgs {
+		if err := n.sendMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (n *testNode) WipeChannel(_ *wire.OutPoint) error {
@@ -280,7 +296,9 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		SignMessage: func(pubKey *btcec.PublicKey, msg []byte) (*btcec.Signature, error) {
 			return testSig, nil
 		},
-		SendAnnouncement: func(msg lnwire.Message) chan error {
+		SendAnnouncement: func(msg lnwire.Message,
+			_ ...discovery.OptionalMsgField) chan error {
+
 			errChan := make(chan error, 1)
 			select {
 			case sentAnnouncements <- msg:
@@ -342,10 +360,17 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		WatchNewChannel: func(*channeldb.OpenChannel, *btcec.PublicKey) error {
 			return nil
 		},
+		NotifyClosedChannel: func(wire.OutPoint) {},
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		ConfirmAliasShortChanID: func(_ wire.OutPoint,
+			persist func() error) error {
+
+			return persist()
+		},
+		RemoveLink: func(lnwire.ChannelID) {},
+		PublishTransaction: func(txn *wire.MsgTx, _ string) error {
 			publTxChan <- txn
 			return nil
 		},
@@ -404,7 +429,9 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			msg []byte) (*btcec.Signature, error) {
 			return testSig, nil
 		},
-		SendAnnouncement: func(msg lnwire.Message) chan error {
+		SendAnnouncement: func(msg lnwire.Message,
+			_ ...discovery.OptionalMsgField) chan error {
+
 			errChan := make(chan error, 1)
 			select {
 			case aliceAnnounceChan <- msg:
@@ -430,7 +457,7 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			FeeRate:       1000,
 			TimeLockDelta: 10,
 		},
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		PublishTransaction: func(txn *wire.MsgTx, _ string) error {
 			publishChan <- txn
 			return nil
 		},
@@ -670,14 +697,22 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
 		sentMsg, ok = msg.(*lnwire.FundingCreated)
 	case "FundingSigned":
 		sentMsg, ok = msg.(*lnwire.FundingSigned)
+	case "FundingTx":
+		sentMsg, ok = msg.(*lnwire.FundingTx)
 	case "FundingLocked":
 		sentMsg, ok = msg.(*lnwire.FundingLocked)
+	case "FundingContribution":
+		sentMsg, ok = msg.(*lnwire.FundingContribution)
+	case "FundingInputSigs":
+		sentMsg, ok = msg.(*lnwire.FundingInputSigs)
 	case "Error":
 		sentMsg, ok = msg.(*lnwire.Error)
 	default:
@@ -1134,7 +1169,9 @@ func TestFundingManagerRestartBehavior(t *testing.T) {
 
 	// Intentionally make the channel announcements fail
 	alice.fundingMgr.cfg.SendAnnouncement =
-		func(msg lnwire.Message) chan error {
+		func(msg lnwire.Message,
+			_ ...discovery.OptionalMsgField) chan error {
+
 			errChan := make(chan error, 1)
 			errChan <- fmt.Errorf("intentional error in " +
 				"SendAnnouncement")
@@ -2429,3 +2466,1345 @@ func TestFundingManagerMaxPendingChannels(t *testing.T) {
 		t, bob.msgChan, "AcceptChannel",
 	).(*lnwire.AcceptChannel)
 }
+
+// TestFundingManagerLargeChannels tests that channels above the soft-limit for
+// channel size are only opened and accepted if both nodes permit them.
+func TestFundingManagerLargeChannels(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	largeChanSize := func(*btcec.PublicKey) btcutil.Amount {
+		return 2 * maxFundingAmount
+	}
+
+	initFunding := func() *openChanReq {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: maxFundingAmount + 1,
+			pushAmt:         lnwire.NewMSatFromSatoshis(0),
+			private:         false,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		return initReq
+	}
+
+	// As long as Alice doesn't permit large channels with Bob, the
+	// workflow should fail before any message is sent.
+	initReq := initFunding()
+	select {
+	case <-initReq.err:
+	case msg := <-alice.msgChan:
+		t.Fatalf("expected funding workflow to fail, instead "+
+			"alice sent %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow did not fail")
+	}
+
+	// Once she does, she'll send the OpenChannel message, which Bob
+	// should reject as he only accepts channels up to the soft-limit.
+	alice.fundingMgr.cfg.MaxChanSize = largeChanSize
+	initFunding()
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	assertErrorSent(t, bob.msgChan)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+
+	// If Bob permits large channels as well, he should accept the
+	// channel.
+	bob.fundingMgr.cfg.MaxChanSize = largeChanSize
+	initFunding()
+	openChannelReq = assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+	assertNumPendingReservations(t, bob, alicePubKey, 1)
+}
+
+// mockAcceptRequest is a channel request awaiting the decision of the test.
+type mockAcceptRequest struct {
+	req      *chanacceptor.ChannelAcceptRequest
+	decision chan error
+}
+
+// mockChanAcceptor is a channel acceptor that hands each request to the test,
+// and blocks until the test has made its decision.
+type mockChanAcceptor struct {
+	requests chan *mockAcceptRequest
+}
+
+// Accept blocks until the test decides on the channel request.
+//
+// NOTE: Part of the chanacceptor.ChannelAcceptor interface.
+func (m *mockChanAcceptor) Accept(
+	req *chanacceptor.ChannelAcceptRequest) error {
+
+	decision := make(chan error, 1)
+	m.requests <- &mockAcceptRequest{req, decision}
+	return <-decision
+}
+
+// assertAcceptRequest asserts that the channel acceptor was asked to decide on
+// the channel with the passed pending channel ID.
+func assertAcceptRequest(t *testing.T, acceptor *mockChanAcceptor,
+	pendingChanID [32]byte) *mockAcceptRequest {
+
+	select {
+	case req := <-acceptor.requests:
+		if req.req.OpenChanMsg.PendingChannelID != pendingChanID {
+			t.Fatalf("expected request for pendingChan(%x), got "+
+				"%x", pendingChanID,
+				req.req.OpenChanMsg.PendingChannelID)
+		}
+		return req
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel acceptor not consulted")
+	}
+
+	return nil
+}
+
+// TestFundingManagerChannelAcceptor tests that the funding manager keeps
+// processing other funding messages while the channel acceptor decides on an
+// inbound channel, and that requests awaiting a decision count towards the
+// maximum number of pending channels.
+func TestFundingManagerChannelAcceptor(t *testing.T) {
+	const maxPending = 2
+
+	alice, bob := setupFundingManagers(t, maxPending)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	acceptor := &mockChanAcceptor{
+		requests: make(chan *mockAcceptRequest),
+	}
+	bob.fundingMgr.cfg.OpenChannelPredicate = acceptor
+
+	initFunding := func() *lnwire.OpenChannel {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			pushAmt:         lnwire.NewMSatFromSatoshis(0),
+			private:         false,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		return assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+	}
+
+	// Bob shouldn't respond to the first channel until the acceptor has
+	// made its decision.
+	firstOpen := initFunding()
+	bob.fundingMgr.processFundingOpen(firstOpen, alice)
+	firstReq := assertAcceptRequest(
+		t, acceptor, firstOpen.PendingChannelID,
+	)
+	assertErrorNotSent(t, bob.msgChan)
+
+	// A second request with the same pending channel ID must be rejected
+	// right away, without consulting the acceptor.
+	bob.fundingMgr.processFundingOpen(firstOpen, alice)
+	assertErrorSent(t, bob.msgChan)
+
+	// In the meantime, Bob should still handle other channels, so the
+	// acceptor is asked about the second channel as well.
+	secondOpen := initFunding()
+	bob.fundingMgr.processFundingOpen(secondOpen, alice)
+	secondReq := assertAcceptRequest(
+		t, acceptor, secondOpen.PendingChannelID,
+	)
+
+	// Both requests count as pending channels, so the third channel is
+	// rejected before reaching the acceptor.
+	thirdOpen := initFunding()
+	bob.fundingMgr.processFundingOpen(thirdOpen, alice)
+	assertErrorSent(t, bob.msgChan)
+
+	select {
+	case req := <-acceptor.requests:
+		t.Fatalf("acceptor consulted for pendingChan(%x)",
+			req.req.OpenChanMsg.PendingChannelID)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the acceptor rejects the second channel, Bob should fail its
+	// funding flow.
+	secondReq.decision <- &chanacceptor.RejectError{Reason: "no"}
+	assertErrorSent(t, bob.msgChan)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+
+	// Accepting the first channel resumes its funding flow.
+	firstReq.decision <- nil
+	accept := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if accept.PendingChannelID != firstOpen.PendingChannelID {
+		t.Fatalf("expected AcceptChannel for pendingChan(%x), got %x",
+			firstOpen.PendingChannelID, accept.PendingChannelID)
+	}
+	assertNumPendingReservations(t, bob, alicePubKey, 1)
+}
+
+// assertShortChanID checks that the node's channel with the funding outpoint
+// uses the expected short channel ID.
+func assertShortChanID(t *testing.T, node *testNode,
+	fundingOutPoint *wire.OutPoint, expected lnwire.ShortChannelID) {
+
+	channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	for _, channel := range channels {
+		if channel.FundingOutpoint != *fundingOutPoint {
+			continue
+		}
+		if channel.ShortChanID() != expected {
+			t.Fatalf("expected short chan id %v, got %v",
+				expected, channel.ShortChanID())
+		}
+		return
+	}
+
+	t.Fatalf("channel %v not found", fundingOutPoint)
+}
+
+// openZeroConfChannel opens a zero-conf channel from Alice to Bob, and runs
+// the funding flow up until both nodes use the channel under its alias. Alice,
+// as the initiator, must send the funding transaction along with FundingLocked,
+// and Bob must not use the channel before receiving it.
+func openZeroConfChannel(t *testing.T, alice, bob *testNode) *wire.OutPoint {
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		true)
+
+	// As they allow each other to open zero-conf channels, both nodes
+	// should consider the channel open before the funding transaction has
+	// confirmed.
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingTxAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingTx",
+	).(*lnwire.FundingTx)
+	if fundingTxAlice.Tx.TxHash() != fundingOutPoint.Hash {
+		t.Fatalf("expected funding tx %v, got %v",
+			fundingOutPoint.Hash, fundingTxAlice.Tx.TxHash())
+	}
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+	assertChannelAnnouncements(t, alice, bob)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+
+	select {
+	case c := <-alice.newChannels:
+		close(c.err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send new channel to peer")
+	}
+
+	// Bob can't detect a double spend of the funding transaction without
+	// knowing it, so the channel must not be used until then. A
+	// transaction not matching the funding outpoint doesn't count.
+	wrongTx := fundingTxAlice.Tx.Copy()
+	wrongTx.LockTime++
+	bob.fundingMgr.processFundingTx(&lnwire.FundingTx{
+		ChanID: fundingTxAlice.ChanID,
+		Tx:     wrongTx,
+	}, alice)
+
+	select {
+	case <-bob.newChannels:
+		t.Fatalf("bob used channel before receiving funding tx")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	bob.fundingMgr.processFundingTx(fundingTxAlice, alice)
+
+	select {
+	case c := <-bob.newChannels:
+		close(c.err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("bob did not send new channel to peer")
+	}
+
+	return fundingOutPoint
+}
+
+// TestFundingManagerZeroConf tests that a channel between peers that allow
+// each other to open zero-conf channels is used under its alias before its
+// funding transaction confirms, and that it's moved over to its real short
+// channel ID once the transaction confirms. If the funding transaction is
+// double spent instead, both nodes must stop using the channel.
+func TestFundingManagerZeroConf(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	zeroConfPeer := func(*btcec.PublicKey) bool {
+		return true
+	}
+	removedLinks := make(chan lnwire.ChannelID, 2)
+	removeLink := func(chanID lnwire.ChannelID) {
+		removedLinks <- chanID
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.fundingMgr.cfg.ZeroConfPeer = zeroConfPeer
+		node.fundingMgr.cfg.RemoveLink = removeLink
+		node.mockNotifier.spendChannel = make(
+			chan *chainntnfs.SpendDetail,
+		)
+	}
+
+	fundingOutPoint := openZeroConfChannel(t, alice, bob)
+
+	// Until the funding transaction confirms, the channel is used under
+	// its alias.
+	alias := lnwire.NewAliasShortChanID(*fundingOutPoint)
+	assertShortChanID(t, alice, fundingOutPoint, alias)
+	assertShortChanID(t, bob, fundingOutPoint, alias)
+
+	// Once it confirms, both nodes should move the channel over to its
+	// real short channel ID, and add it to the router graph under that
+	// ID.
+	confDetails := &chainntnfs.TxConfirmation{
+		BlockHeight: 100,
+		TxIndex:     1,
+	}
+	alice.mockNotifier.oneConfChannel <- confDetails
+	bob.mockNotifier.oneConfChannel <- confDetails
+
+	assertChannelAnnouncements(t, alice, bob)
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(fundingOutPoint.Index),
+	}
+	assertShortChanID(t, alice, fundingOutPoint, shortChanID)
+	assertShortChanID(t, bob, fundingOutPoint, shortChanID)
+
+	// From here on the channel is announced like any other.
+	alice.mockNotifier.sixConfChannel <- &chainntnfs.TxConfirmation{}
+	bob.mockNotifier.sixConfChannel <- &chainntnfs.TxConfirmation{}
+
+	assertAnnouncementSignatures(t, alice, bob)
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+
+	// The funding transaction of a second channel is double spent before
+	// it confirms. Both nodes watch the inputs of the transaction, so
+	// they should notice.
+	fundingOutPoint = openZeroConfChannel(t, alice, bob)
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+
+	spenderHash := chainhash.Hash{1}
+	for _, node := range []*testNode{alice, bob} {
+		select {
+		case node.mockNotifier.spendChannel <- &chainntnfs.SpendDetail{
+			SpenderTxHash: &spenderHash,
+		}:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("funding inputs not watched")
+		}
+	}
+
+	// The link of the channel should be removed on both sides, and the
+	// channel marked borked so it isn't used again after a restart.
+	for i := 0; i < 2; i++ {
+		select {
+		case removed := <-removedLinks:
+			if removed != chanID {
+				t.Fatalf("expected link %v to be removed, "+
+					"got %v", chanID, removed)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("link of double spent channel not removed")
+		}
+	}
+	for _, node := range []*testNode{alice, bob} {
+		channel, err := node.fundingMgr.cfg.FindChannel(chanID)
+		if err != nil {
+			t.Fatalf("unable to find channel: %v", err)
+		}
+		if !channel.HasChanStatus(channeldb.Borked) {
+			t.Fatalf("expected channel to be borked, got status %v",
+				channel.ChanStatus())
+		}
+	}
+}
+
+// externalUtxoChainIO is a mock BlockChainIO that knows about a single
+// confirmed output, which is spent by an externally crafted funding
+// transaction.
+type externalUtxoChainIO struct {
+	mockChainIO
+
+	outPoint wire.OutPoint
+	output   *wire.TxOut
+}
+
+func (e *externalUtxoChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
+	heightHint uint32) (*wire.TxOut, error) {
+
+	if *op != e.outPoint {
+		return nil, fmt.Errorf("output %v not found", op)
+	}
+	return e.output, nil
+}
+
+// TestFundingManagerPsbtFunding tests that the funding flow of a channel
+// funded by an external wallet pauses until the funding transaction is
+// provided, and that only a valid funding transaction allows it to resume.
+func TestFundingManagerPsbtFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	const localAmt = 500000
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate, 1)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localAmt,
+		externalFunding: true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-errChan:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	// Rather than sending FundingCreated, Alice should now request the
+	// funding transaction from the caller.
+	var update *lnrpc.OpenStatusUpdate
+	select {
+	case update = <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_PsbtFund")
+	}
+	psbtFund, ok := update.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+	if !ok {
+		t.Fatalf("expected OpenStatusUpdate_PsbtFund, got %T",
+			update.Update)
+	}
+	if psbtFund.PsbtFund.FundingAmount != localAmt {
+		t.Fatalf("expected funding amount %v, got %v", localAmt,
+			psbtFund.PsbtFund.FundingAmount)
+	}
+	assertErrorNotSent(t, alice.msgChan)
+
+	fundingAddr, err := btcutil.DecodeAddress(
+		psbtFund.PsbtFund.FundingAddress, activeNetParams.Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to decode funding address: %v", err)
+	}
+	fundingScript, err := txscript.PayToAddrScript(fundingAddr)
+	if err != nil {
+		t.Fatalf("unable to create funding script: %v", err)
+	}
+
+	var pendingChanID [32]byte
+	copy(pendingChanID[:], psbtFund.PsbtFund.PendingChanId)
+
+	// We'll now craft the funding transaction, which spends a p2wkh output
+	// the wallet doesn't know about.
+	extPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	extPkScript, err := txscript.NewScriptBuilder().AddOp(
+		txscript.OP_0,
+	).AddData(btcutil.Hash160(
+		extPriv.PubKey().SerializeCompressed(),
+	)).Script()
+	if err != nil {
+		t.Fatalf("unable to create p2wkh script: %v", err)
+	}
+	chainIO := &externalUtxoChainIO{
+		outPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2},
+		output: &wire.TxOut{
+			Value:    localAmt + 10000,
+			PkScript: extPkScript,
+		},
+	}
+	alice.fundingMgr.cfg.Wallet.Cfg.ChainIO = chainIO
+
+	createFundingTx := func(amt int64) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: chainIO.outPoint})
+		tx.AddTxOut(&wire.TxOut{Value: amt, PkScript: fundingScript})
+
+		witness, err := txscript.WitnessSignature(
+			tx, txscript.NewTxSigHashes(tx), 0,
+			chainIO.output.Value, extPkScript,
+			txscript.SigHashAll, extPriv, true,
+		)
+		if err != nil {
+			t.Fatalf("unable to sign funding tx: %v", err)
+		}
+		tx.TxIn[0].Witness = witness
+
+		return tx
+	}
+
+	// A transaction that doesn't pay the full channel capacity to the
+	// funding output must be rejected, leaving the reservation intact.
+	err = alice.fundingMgr.ProcessExternalFundingTx(
+		pendingChanID, createFundingTx(localAmt-1),
+	)
+	if err == nil {
+		t.Fatalf("expected funding tx with wrong amount to be rejected")
+	}
+
+	// The same goes for a transaction with an invalid signature.
+	invalidTx := createFundingTx(localAmt)
+	invalidTx.TxIn[0].Witness[0][10] ^= 0x01
+	err = alice.fundingMgr.ProcessExternalFundingTx(
+		pendingChanID, invalidTx,
+	)
+	if err == nil {
+		t.Fatalf("expected funding tx with invalid signature to be " +
+			"rejected")
+	}
+	assertErrorNotSent(t, alice.msgChan)
+	assertNumPendingReservations(t, alice, bobPubKey, 1)
+
+	// Once the valid funding transaction is provided, Alice should resume
+	// the funding flow.
+	fundingTx := createFundingTx(localAmt)
+	err = alice.fundingMgr.ProcessExternalFundingTx(pendingChanID, fundingTx)
+	if err != nil {
+		t.Fatalf("unable to process funding tx: %v", err)
+	}
+
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	if fundingCreated.FundingPoint.Hash != fundingTx.TxHash() {
+		t.Fatalf("expected funding txid %v, got %v",
+			fundingTx.TxHash(), fundingCreated.FundingPoint.Hash)
+	}
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	// Finally, Alice should broadcast the externally crafted funding
+	// transaction.
+	select {
+	case publ := <-alice.publTxChan:
+		if publ.TxHash() != fundingTx.TxHash() {
+			t.Fatalf("expected funding tx %v to be published, "+
+				"got %v", fundingTx.TxHash(), publ.TxHash())
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+}
+
+// startBatchFunding initiates the funding flow of a batched channel from
+// Alice to Bob, and runs it until Alice requests the funding transaction. The
+// pending channel ID and the funding output are returned.
+func startBatchFunding(t *testing.T, alice, bob *testNode,
+	req *openChanReq) ([32]byte, *wire.TxOut) {
+
+	alice.fundingMgr.initFundingWorkflow(bob, req)
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-req.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	var update *lnrpc.OpenStatusUpdate
+	select {
+	case update = <-req.updates:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_PsbtFund")
+	}
+	psbtFund, ok := update.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+	if !ok {
+		t.Fatalf("expected OpenStatusUpdate_PsbtFund, got %T",
+			update.Update)
+	}
+
+	fundingAddr, err := btcutil.DecodeAddress(
+		psbtFund.PsbtFund.FundingAddress, activeNetParams.Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to decode funding address: %v", err)
+	}
+	fundingScript, err := txscript.PayToAddrScript(fundingAddr)
+	if err != nil {
+		t.Fatalf("unable to create funding script: %v", err)
+	}
+
+	var pendingChanID [32]byte
+	copy(pendingChanID[:], psbtFund.PsbtFund.PendingChanId)
+
+	return pendingChanID, &wire.TxOut{
+		Value:    psbtFund.PsbtFund.FundingAmount,
+		PkScript: fundingScript,
+	}
+}
+
+// TestFundingManagerBatchFunding tests that the funding transaction of a
+// batched channel is only broadcast once the batch is completed, and that
+// cancelling a batch fails the funding flow.
+func TestFundingManagerBatchFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	const localAmt = 500000
+
+	newReq := func() *openChanReq {
+		return &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localAmt,
+			externalFunding: true,
+			batchSigned:     make(chan struct{}),
+			updates:         make(chan *lnrpc.OpenStatusUpdate, 2),
+			err:             make(chan error, 1),
+		}
+	}
+
+	// We'll start out by cancelling a batch before the funding transaction
+	// is known. Both the reservation and the flow with Bob should fail.
+	req := newReq()
+	pendingChanID, _ := startBatchFunding(t, alice, bob, req)
+
+	cancelErr := fmt.Errorf("batch cancelled")
+	alice.fundingMgr.CancelBatch([][32]byte{pendingChanID}, cancelErr)
+
+	select {
+	case err := <-req.err:
+		if err != cancelErr {
+			t.Fatalf("expected error %v, got %v", cancelErr, err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding flow wasn't failed")
+	}
+	errMsg := assertFundingMsgSent(t, alice.msgChan, "Error").(*lnwire.Error)
+	bob.fundingMgr.processFundingError(errMsg, alice.privKey.PubKey())
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	// Now we'll run a batch to completion.
+	req = newReq()
+	pendingChanID, fundingOutput := startBatchFunding(t, alice, bob, req)
+
+	// The batch funding transaction is created by the wallet, so there's
+	// no need for it to spend an output the chain backend knows about.
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	fundingTx.AddTxOut(fundingOutput)
+
+	// The shared transaction can't be used to fund a channel that isn't
+	// part of a batch.
+	err := alice.fundingMgr.ProcessExternalFundingTx(
+		pendingChanID, fundingTx,
+	)
+	if err == nil {
+		t.Fatalf("expected batched channel to reject external " +
+			"funding tx")
+	}
+
+	err = alice.fundingMgr.ProcessBatchFundingTx(pendingChanID, fundingTx)
+	if err != nil {
+		t.Fatalf("unable to process batch funding tx: %v", err)
+	}
+
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	// Alice should signal that Bob signed, but hold back the funding
+	// transaction until the batch is completed.
+	select {
+	case <-req.batchSigned:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not signal that bob signed")
+	}
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx published before batch was completed")
+	case <-time.After(time.Millisecond * 300):
+	}
+
+	err = alice.fundingMgr.CompleteBatch(
+		[][32]byte{pendingChanID}, fundingTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to complete batch: %v", err)
+	}
+
+	select {
+	case publ := <-alice.publTxChan:
+		if publ.TxHash() != fundingTx.TxHash() {
+			t.Fatalf("expected funding tx %v to be published, "+
+				"got %v", fundingTx.TxHash(), publ.TxHash())
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	select {
+	case update := <-req.updates:
+		_, ok := update.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			t.Fatalf("expected OpenStatusUpdate_ChanPending, "+
+				"got %T", update.Update)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// dualFundingWallet is a mock WalletController that owns a single confirmed
+// p2wkh output of the mock signer's key, which it contributes to dual funder
+// channels.
+type dualFundingWallet struct {
+	*mockWalletController
+
+	utxo *lnwallet.Utxo
+}
+
+func (w *dualFundingWallet) ListUnspentWitness(int32) ([]*lnwallet.Utxo,
+	error) {
+
+	return []*lnwallet.Utxo{w.utxo}, nil
+}
+
+func (w *dualFundingWallet) FetchInputInfo(
+	op *wire.OutPoint) (*wire.TxOut, error) {
+
+	if *op != w.utxo.OutPoint {
+		return nil, lnwallet.ErrNotMine
+	}
+
+	return wire.NewTxOut(int64(w.utxo.Value), w.utxo.PkScript), nil
+}
+
+// dualFundingChainIO is a mock BlockChainIO that knows about the outputs of
+// the dualFundingWallets. Like a light client, it only finds an output if the
+// height hint isn't above the height the output was confirmed at. A hint of
+// zero is rejected as well, as it would make a light client scan the entire
+// chain.
+type dualFundingChainIO struct {
+	mockChainIO
+
+	utxos map[wire.OutPoint]*lnwallet.Utxo
+}
+
+func (d *dualFundingChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
+	heightHint uint32) (*wire.TxOut, error) {
+
+	utxo, ok := d.utxos[*op]
+	if !ok {
+		return nil, fmt.Errorf("output %v not found", op)
+	}
+
+	confHeight := uint32(fundingBroadcastHeight - utxo.Confirmations + 1)
+	if heightHint == 0 || heightHint > confHeight {
+		return nil, fmt.Errorf("output %v not found from height %v",
+			op, heightHint)
+	}
+
+	return wire.NewTxOut(int64(utxo.Value), utxo.PkScript), nil
+}
+
+// enableDualFunding gives both nodes a confirmed output to contribute to dual
+// funder channels, and makes them signal support for dual funding.
+func enableDualFunding(t *testing.T, alice, bob *testNode) {
+	// Both mock signers sign with Alice's key, so that's the key the
+	// outputs must pay to.
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(alicePubKey.SerializeCompressed())).
+		Script()
+	if err != nil {
+		t.Fatalf("unable to create pkscript: %v", err)
+	}
+
+	chainIO := &dualFundingChainIO{
+		utxos: make(map[wire.OutPoint]*lnwallet.Utxo),
+	}
+	for i, node := range []*testNode{alice, bob} {
+		utxo := &lnwallet.Utxo{
+			AddressType:   lnwallet.WitnessPubKey,
+			Value:         btcutil.SatoshiPerBitcoin,
+			Confirmations: int64(6 + i),
+			PkScript:      pkScript,
+			OutPoint: wire.OutPoint{
+				Hash: chainhash.Hash{byte(i + 1)},
+			},
+		}
+		chainIO.utxos[utxo.OutPoint] = utxo
+
+		lnw := node.fundingMgr.cfg.Wallet
+		mockWallet := lnw.WalletController.(*mockWalletController)
+		wc := &dualFundingWallet{
+			mockWalletController: mockWallet,
+			utxo:                 utxo,
+		}
+		lnw.WalletController = wc
+		lnw.Cfg.WalletController = wc
+		lnw.Cfg.ChainIO = chainIO
+
+		node.fundingMgr.cfg.SupportsDualFunding = func(
+			*btcec.PublicKey) bool {
+
+			return true
+		}
+	}
+}
+
+// TestFundingManagerDualFunding tests that the responder of a channel adds
+// funds to it if the initiator allows it, up to the amount it's willing to
+// contribute to channels of that peer, and that the initiator rejects a
+// contribution spending an output it can't find.
+func TestFundingManagerDualFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	enableDualFunding(t, alice, bob)
+
+	// Bob contributes up to bobAmt to channels opened by Alice, and
+	// nothing to channels opened by anyone else.
+	const bobAmt = 300000
+	bob.fundingMgr.cfg.DualFundingContribution = func(
+		peerKey *btcec.PublicKey) btcutil.Amount {
+
+		if peerKey.IsEqual(alicePubKey) {
+			return bobAmt
+		}
+		return 0
+	}
+
+	// Bob's contribution is bounded by the per peer cap, the initiator's
+	// amount and the largest channel size, and requires the initiator to
+	// allow it.
+	contributionTests := []struct {
+		peerKey     *btcec.PublicKey
+		amt         btcutil.Amount
+		flags       lnwire.FundingFlag
+		expectedAmt btcutil.Amount
+	}{
+		{alicePubKey, 500000, lnwire.FFDualFund, bobAmt},
+		{alicePubKey, 200000, lnwire.FFDualFund, 200000},
+		{alicePubKey, maxFundingAmount - 100000, lnwire.FFDualFund,
+			100000},
+		{alicePubKey, 500000, 0, 0},
+		{bobPubKey, 500000, lnwire.FFDualFund, 0},
+	}
+	for i, test := range contributionTests {
+		amt := bob.fundingMgr.dualFundingContribution(
+			test.peerKey, &lnwire.OpenChannel{
+				FundingAmount: test.amt,
+				ChannelFlags:  test.flags,
+			},
+		)
+		if amt != test.expectedAmt {
+			t.Fatalf("test %v: expected contribution of %v, got %v",
+				i, test.expectedAmt, amt)
+		}
+	}
+
+	// Alice opens a channel, which allows Bob to contribute.
+	const aliceAmt = 500000
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: aliceAmt,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if openChannelReq.ChannelFlags&lnwire.FFDualFund == 0 {
+		t.Fatalf("alice didn't allow bob to contribute")
+	}
+
+	// Bob sends his contribution right before accepting the channel.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	bobContribution := assertFundingMsgSent(
+		t, bob.msgChan, "FundingContribution",
+	).(*lnwire.FundingContribution)
+	if bobContribution.FundingAmount != bobAmt {
+		t.Fatalf("expected bob to contribute %v, got %v", bobAmt,
+			bobContribution.FundingAmount)
+	}
+	acceptChannel := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	// Alice responds with her own inputs, followed by her signature for
+	// Bob's commitment.
+	alice.fundingMgr.processFundingContribution(bobContribution, bob)
+	alice.fundingMgr.processFundingAccept(acceptChannel, bob)
+	aliceContribution := assertFundingMsgSent(
+		t, alice.msgChan, "FundingContribution",
+	).(*lnwire.FundingContribution)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	// Bob is now able to build the funding transaction, and sends the
+	// signatures for his inputs along with his commitment signature.
+	bob.fundingMgr.processFundingContribution(aliceContribution, alice)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	inputSigs := assertFundingMsgSent(
+		t, bob.msgChan, "FundingInputSigs",
+	).(*lnwire.FundingInputSigs)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingInputSigs(inputSigs, bob)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case update := <-updateChan:
+		_, ok := update.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			t.Fatalf("expected OpenStatusUpdate_ChanPending, "+
+				"got %T", update.Update)
+		}
+	case err := <-initReq.err:
+		t.Fatalf("unable to open channel: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	// The published funding transaction must spend the outputs of both
+	// Alice and Bob, and fund the channel with their combined amount.
+	var fundingTx *wire.MsgTx
+	select {
+	case fundingTx = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	if len(fundingTx.TxIn) != 2 {
+		t.Fatalf("expected 2 funding inputs, got %v",
+			len(fundingTx.TxIn))
+	}
+	fundingOutput := fundingTx.TxOut[fundingCreated.FundingPoint.Index]
+	if fundingOutput.Value != aliceAmt+bobAmt {
+		t.Fatalf("expected channel capacity of %v, got %v",
+			aliceAmt+bobAmt, fundingOutput.Value)
+	}
+
+	for _, node := range []*testNode{alice, bob} {
+		channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+			FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 pending channel, got %v",
+				len(channels))
+		}
+		if channels[0].ChanType != channeldb.DualFunder {
+			t.Fatalf("expected dual funder channel, got %v",
+				channels[0].ChanType)
+		}
+		if channels[0].Capacity != aliceAmt+bobAmt {
+			t.Fatalf("expected capacity of %v, got %v",
+				aliceAmt+bobAmt, channels[0].Capacity)
+		}
+	}
+	assertErrorNotSent(t, alice.msgChan)
+	assertErrorNotSent(t, bob.msgChan)
+
+	// Finally, we'll open a second channel between fresh nodes, in which
+	// Bob claims his output confirmed later than it did. Alice can't find
+	// the output starting at that height, so she must reject his
+	// contribution and fail the funding flow.
+	alice, bob = setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	enableDualFunding(t, alice, bob)
+	bob.fundingMgr.cfg.DualFundingContribution = func(
+		*btcec.PublicKey) btcutil.Amount {
+
+		return bobAmt
+	}
+
+	initReq = &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: aliceAmt,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             make(chan error, 1),
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+	openChannelReq = assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	bobContribution = assertFundingMsgSent(
+		t, bob.msgChan, "FundingContribution",
+	).(*lnwire.FundingContribution)
+	acceptChannel = assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	bobContribution.Inputs[0].Height++
+	alice.fundingMgr.processFundingContribution(bobContribution, bob)
+	alice.fundingMgr.processFundingAccept(acceptChannel, bob)
+	assertErrorSent(t, alice.msgChan)
+
+	select {
+	case <-initReq.err:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding flow did not fail")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// spliceChainIO is a mock BlockChainIO that reports the same block hash for
+// every height of the main chain.
+type spliceChainIO struct {
+	mockChainIO
+
+	blockHash chainhash.Hash
+}
+
+func (s *spliceChainIO) GetBlockHash(int64) (*chainhash.Hash, error) {
+	return &s.blockHash, nil
+}
+
+// openAnnouncedChannel runs through the entire funding flow of a public
+// channel from Alice to Bob, up until the channel has been announced. Returns
+// the funding outpoint.
+func openAnnouncedChannel(t *testing.T, alice, bob *testNode) *wire.OutPoint {
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openChannel(t, alice, bob, 500000, 0, 1, updateChan,
+		true)
+
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+	bob.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+	assertChannelAnnouncements(t, alice, bob)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	alice.mockNotifier.sixConfChannel <- &chainntnfs.TxConfirmation{}
+	bob.mockNotifier.sixConfChannel <- &chainntnfs.TxConfirmation{}
+	assertAnnouncementSignatures(t, alice, bob)
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+
+	return fundingOutPoint
+}
+
+// TestFundingManagerSplice tests that a channel with a pending splice is only
+// handed back to the peer once the splice transaction has confirmed in a block
+// of the main chain, and that the splice is aborted once the funding output
+// is spent by a conflicting transaction. Should the splice transaction not
+// confirm in time, the channel must be force closed.
+func TestFundingManagerSplice(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	fundingOutPoint := openAnnouncedChannel(t, alice, bob)
+
+	splicedChans := make(chan wire.OutPoint, 1)
+	alice.fundingMgr.cfg.SpliceContract = func(oldChanPoint wire.OutPoint,
+		_ *channeldb.OpenChannel) error {
+
+		splicedChans <- oldChanPoint
+		return nil
+	}
+	forceClosedChans := make(chan wire.OutPoint, 1)
+	alice.fundingMgr.cfg.ForceCloseContract = func(
+		chanPoint wire.OutPoint) error {
+
+		forceClosedChans <- chanPoint
+		return nil
+	}
+	chainIO := &spliceChainIO{blockHash: chainhash.Hash{1}}
+	alice.fundingMgr.cfg.Wallet.Cfg.ChainIO = chainIO
+	alice.mockNotifier.spendChannel = make(
+		chan *chainntnfs.SpendDetail, 1,
+	)
+
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	channel, err := alice.fundingMgr.cfg.FindChannel(chanID)
+	if err != nil {
+		t.Fatalf("unable to find channel: %v", err)
+	}
+
+	// markSplicePending marks the channel as having a pending splice that
+	// adds funds to it.
+	markSplicePending := func() *channeldb.ChannelSplice {
+		t.Helper()
+
+		fundingScript, err := makeFundingScript(channel)
+		if err != nil {
+			t.Fatalf("unable to create funding script: %v", err)
+		}
+
+		capacity := channel.Capacity + 100000
+		spliceTx := wire.NewMsgTx(2)
+		spliceTx.AddTxIn(
+			wire.NewTxIn(&channel.FundingOutpoint, nil, nil),
+		)
+		spliceTx.AddTxOut(wire.NewTxOut(int64(capacity), fundingScript))
+
+		splice := &channeldb.ChannelSplice{
+			SpliceTx: spliceTx,
+			FundingOutpoint: wire.OutPoint{
+				Hash: spliceTx.TxHash(),
+			},
+			Capacity:         capacity,
+			BroadcastHeight:  fundingBroadcastHeight,
+			LocalCommitment:  channel.LocalCommitment,
+			RemoteCommitment: channel.RemoteCommitment,
+		}
+		if err := channel.MarkSplicePending(splice); err != nil {
+			t.Fatalf("unable to mark splice pending: %v", err)
+		}
+
+		return splice
+	}
+
+	// assertNotResumed asserts that the channel isn't handed back to the
+	// peer, nor moved to a new funding output.
+	assertNotResumed := func() {
+		t.Helper()
+
+		select {
+		case <-alice.newChannels:
+			t.Fatalf("channel handed back to peer")
+		case <-splicedChans:
+			t.Fatalf("channel moved to new funding output")
+		case <-time.After(300 * time.Millisecond):
+		}
+	}
+
+	// assertSpliceAborted asserts that the pending splice of the channel
+	// is removed.
+	assertSpliceAborted := func() {
+		t.Helper()
+
+		for i := 0; i < 50; i++ {
+			_, err := channel.PendingSplice()
+			if err == channeldb.ErrNoPendingSplice {
+				assertNotResumed()
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		t.Fatalf("splice wasn't aborted")
+	}
+
+	// conflictingSpend is a spend of the funding output by a transaction
+	// other than the splice transaction, such as a commitment.
+	conflictingSpend := func() *chainntnfs.SpendDetail {
+		return &chainntnfs.SpendDetail{
+			SpentOutPoint:  &channel.FundingOutpoint,
+			SpenderTxHash:  &chainhash.Hash{3},
+			SpendingTx:     wire.NewMsgTx(2),
+			SpendingHeight: fundingBroadcastHeight + 1,
+		}
+	}
+
+	splice := markSplicePending()
+	alice.fundingMgr.WatchSplice(channel)
+
+	// While the splice is pending, the channel has no link, so it must
+	// not be handed back to the peer. A confirmation in a block that has
+	// since been disconnected must not complete the splice either.
+	assertNotResumed()
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		BlockHash:   &chainhash.Hash{2},
+		BlockHeight: fundingBroadcastHeight + 1,
+		TxIndex:     1,
+	}
+	assertNotResumed()
+	if _, err := channel.PendingSplice(); err != nil {
+		t.Fatalf("unable to fetch pending splice: %v", err)
+	}
+
+	// Once the splice transaction confirms within the main chain, the
+	// channel should be moved to its new funding output, and handed back
+	// to the peer.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		BlockHash:   &chainIO.blockHash,
+		BlockHeight: fundingBroadcastHeight + 2,
+		TxIndex:     1,
+	}
+	select {
+	case oldChanPoint := <-splicedChans:
+		if oldChanPoint != *fundingOutPoint {
+			t.Fatalf("expected splice of %v, got %v",
+				fundingOutPoint, oldChanPoint)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel wasn't spliced")
+	}
+	select {
+	case c := <-alice.newChannels:
+		if c.channel.FundingOutpoint != splice.FundingOutpoint {
+			t.Fatalf("expected channel at %v, got %v",
+				splice.FundingOutpoint,
+				c.channel.FundingOutpoint)
+		}
+		close(c.err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel wasn't handed back to peer")
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: fundingBroadcastHeight + 2,
+		TxIndex:     1,
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-alice.announceChan:
+			ann, ok := msg.(*lnwire.ChannelAnnouncement)
+			if ok && ann.ShortChannelID != shortChanID {
+				t.Fatalf("expected short chan id %v, got %v",
+					shortChanID, ann.ShortChannelID)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("node did not send announcement: %v", i)
+		}
+	}
+
+	// A new splice should be aborted once a conflicting transaction spends
+	// the funding output, while the spend of the splice transaction itself
+	// must be ignored.
+	splice = markSplicePending()
+	alice.fundingMgr.WatchSplice(channel)
+
+	spliceTxid := splice.SpliceTx.TxHash()
+	alice.mockNotifier.spendChannel <- &chainntnfs.SpendDetail{
+		SpentOutPoint:  &channel.FundingOutpoint,
+		SpenderTxHash:  &spliceTxid,
+		SpendingTx:     splice.SpliceTx,
+		SpendingHeight: fundingBroadcastHeight + 3,
+	}
+	assertNotResumed()
+	if _, err := channel.PendingSplice(); err != nil {
+		t.Fatalf("unable to fetch pending splice: %v", err)
+	}
+
+	alice.mockNotifier.spendChannel <- conflictingSpend()
+	assertSpliceAborted()
+
+	// Finally, if the splice transaction doesn't confirm in time, the
+	// channel should be force closed, which aborts the splice once the
+	// commitment transaction spends the funding output.
+	splice = markSplicePending()
+	alice.fundingMgr.WatchSplice(channel)
+
+	maxHeight := splice.BroadcastHeight + maxWaitNumBlocksSpliceConf
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: int32(maxHeight) - 1,
+	}
+	select {
+	case <-forceClosedChans:
+		t.Fatalf("channel force closed before timeout")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: int32(maxHeight),
+	}
+	select {
+	case chanPoint := <-forceClosedChans:
+		if chanPoint != channel.FundingOutpoint {
+			t.Fatalf("expected force close of %v, got %v",
+				channel.FundingOutpoint, chanPoint)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("channel wasn't force closed")
+	}
+
+	alice.mockNotifier.spendChannel <- conflictingSpend()
+	assertSpliceAborted()
+}
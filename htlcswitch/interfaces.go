@@ -4,6 +4,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -131,3 +132,12 @@ type ForwardingLog interface {
 	// visualizations, etc.
 	AddForwardingEvents([]channeldb.ForwardingEvent) error
 }
+
+// TowerClient is the primary interface used by the link to back up revoked
+// states with the client's watchtowers.
+type TowerClient interface {
+	// BackupState queues a request to back up a particular revoked
+	// state. The justice kit is signed and persisted asynchronously, such
+	// that the caller is not blocked while the backup is constructed.
+	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution) error
+}
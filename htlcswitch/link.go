@@ -226,6 +226,11 @@ type ChannelLinkConfig struct {
 	// fee rate. A random timeout will be selected between these values.
 	MinFeeUpdateTimeout time.Duration
 	MaxFeeUpdateTimeout time.Duration
+
+	// TowerClient is an optional engine that manages the backup of each
+	// revoked state to the client's watchtowers. If nil, no backups will
+	// be made.
+	TowerClient TowerClient
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -552,7 +557,25 @@ func (l *channelLink) syncChanStates() error {
 			fundingLockedMsg := lnwire.NewFundingLocked(
 				l.ChanID(), nextRevocation,
 			)
-			err = l.cfg.Peer.SendMessage(false, fundingLockedMsg)
+			msgs := []lnwire.Message{fundingLockedMsg}
+
+			// The remote party of a zero-conf channel we initiated
+			// won't use it before it has the funding transaction,
+			// so we'll re-send that as well.
+			chanState := l.channel.State()
+			if chanState.IsInitiator && l.ShortChanID().IsAlias() &&
+				chanState.FundingTxn != nil {
+
+				fundingTxMsg := &lnwire.FundingTx{
+					ChanID: l.ChanID(),
+					Tx:     chanState.FundingTxn,
+				}
+				msgs = []lnwire.Message{
+					fundingTxMsg, fundingLockedMsg,
+				}
+			}
+
+			err = l.cfg.Peer.SendMessage(false, msgs...)
 			if err != nil {
 				return fmt.Errorf("unable to re-send "+
 					"FundingLocked: %v", err)
@@ -1547,6 +1570,12 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			return
 		}
 
+		// The remote party's prior commitment has now been revoked, so
+		// hand it off to our watchtowers if a tower client is active.
+		if l.cfg.TowerClient != nil {
+			l.backupRevokedState()
+		}
+
 		l.processRemoteSettleFails(fwdPkg, settleFails)
 		needUpdate := l.processRemoteAdds(fwdPkg, adds)
 
@@ -1592,6 +1621,42 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 
 }
 
+// backupRevokedState constructs the breach retribution for the remote
+// commitment that was just revoked, and hands it off to the tower client. The
+// client signs and persists the backup in the background, so the revocation
+// isn't held up. A failure to back up the state is logged, but does not fail
+// the link, as the breach arbiter continues to protect the channel while we
+// are online.
+func (l *channelLink) backupRevokedState() {
+	chanState := l.channel.State()
+	revokedHeight := chanState.RemoteCommitment.CommitHeight - 1
+
+	revokedCommit, err := chanState.FindPreviousState(revokedHeight)
+	if err != nil {
+		log.Errorf("ChannelPoint(%v): unable to fetch revoked state "+
+			"%d: %v", l.channel.ChannelPoint(), revokedHeight, err)
+		return
+	}
+
+	breachInfo, err := lnwallet.NewBreachRetribution(
+		chanState, revokedHeight, revokedCommit.CommitTx, 0,
+	)
+	if err != nil {
+		log.Errorf("ChannelPoint(%v): unable to create breach "+
+			"retribution for state %d: %v",
+			l.channel.ChannelPoint(), revokedHeight, err)
+		return
+	}
+
+	chanID := l.ChanID()
+	err = l.cfg.TowerClient.BackupState(&chanID, breachInfo)
+	if err != nil {
+		log.Errorf("ChannelPoint(%v): unable to queue backup of "+
+			"state %d: %v", l.channel.ChannelPoint(),
+			revokedHeight, err)
+	}
+}
+
 // ackDownStreamPackets is responsible for removing htlcs from a link's mailbox
 // for packets delivered from server, and cleaning up any circuits closed by
 // signing a previous commitment txn. This method ensures that the circuits are
@@ -1761,6 +1826,7 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	l.infof("Updating to short_chan_id=%v for chan_id=%v", sid, chanID)
 
 	l.Lock()
+	prevSid := l.shortChanID
 	l.shortChanID = sid
 	l.Unlock()
 
@@ -1776,9 +1842,12 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. A link that
+	// was using an alias is already doing so.
+	if prevSid == sourceHop {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
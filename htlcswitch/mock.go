@@ -585,6 +585,10 @@ type mockChannelLink struct {
 
 	eligible bool
 
+	// confirmedShortChanID is the short channel ID a link using an alias
+	// loads once its funding transaction confirms.
+	confirmedShortChanID lnwire.ShortChannelID
+
 	htlcID uint64
 }
 
@@ -673,6 +677,11 @@ func (f *mockChannelLink) EligibleToForward() bool                      { return
 func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
+	if f.shortChanID.IsAlias() &&
+		f.confirmedShortChanID != (lnwire.ShortChannelID{}) {
+
+		f.shortChanID = f.confirmedShortChanID
+	}
 	return f.shortChanID, nil
 }
 
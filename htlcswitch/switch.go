@@ -117,6 +117,11 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// BumpAfterBlocks is the number of blocks the cooperative closure
+	// transaction may remain unconfirmed before its fee is bumped. A value
+	// of zero indicates that the default of the node should be used.
+	BumpAfterBlocks uint32
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan *lnrpc.CloseStatusUpdate
@@ -244,6 +249,13 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// aliasIndex maps the short channel ID of a link that was forwarding
+	// under an alias short channel ID before its funding transaction
+	// confirmed, to that alias. The alias remains in the forwarding index
+	// for as long as the link is active, as HTLCs may still be sent to
+	// it.
+	aliasIndex map[lnwire.ShortChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -303,6 +315,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		aliasIndex:        make(map[lnwire.ShortChannelID]lnwire.ShortChannelID),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		pendingPayments:   make(map[uint64]*pendingPayment),
@@ -1295,6 +1308,22 @@ func (s *Switch) closeCircuit(pkt *htlcPacket) (*PaymentCircuit, error) {
 	// Otherwise, this is packet was received from the remote party.  Use
 	// circuit map to find the incoming link to receive the settle/fail.
 	circuit, err := s.circuits.CloseCircuit(pkt.outKey())
+
+	// If the outgoing link was using an alias short chan ID when the HTLC
+	// was forwarded, then the circuit is still keyed by that alias.
+	if err == ErrUnknownCircuit {
+		s.indexMtx.RLock()
+		alias, ok := s.aliasIndex[pkt.outgoingChanID]
+		s.indexMtx.RUnlock()
+
+		if ok {
+			circuit, err = s.circuits.CloseCircuit(CircuitKey{
+				ChanID: alias,
+				HtlcID: pkt.outgoingHTLCID,
+			})
+		}
+	}
+
 	switch err {
 
 	// Open circuit successfully closed.
@@ -1412,22 +1441,24 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then targetFeePerKw should be the ideal fee-per-kw that will be used as a
+// starting point for close negotiation, and bumpAfterBlocks the number of
+// blocks after which the fee of an unconfirmed closing transaction is bumped.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
-	chan error) {
+	targetFeePerKw lnwallet.SatPerKWeight,
+	bumpAfterBlocks uint32) (chan *lnrpc.CloseStatusUpdate, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
 	updateChan := make(chan *lnrpc.CloseStatusUpdate, 2)
 	errChan := make(chan error, 1)
 
 	command := &ChanClose{
-		CloseType:      closeType,
-		ChanPoint:      chanPoint,
-		Updates:        updateChan,
-		TargetFeePerKw: targetFeePerKw,
-		Err:            errChan,
+		CloseType:       closeType,
+		ChanPoint:       chanPoint,
+		Updates:         updateChan,
+		TargetFeePerKw:  targetFeePerKw,
+		BumpAfterBlocks: bumpAfterBlocks,
+		Err:             errChan,
 	}
 
 	select {
@@ -2028,6 +2059,10 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if alias, ok := s.aliasIndex[link.ShortChanID()]; ok {
+		delete(s.forwardingIndex, alias)
+		delete(s.aliasIndex, link.ShortChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2093,6 +2128,64 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// ConfirmAliasShortChanID moves a channel that has been used under its alias
+// short chan ID over to its confirmed short chan ID. The passed closure is
+// expected to persist the confirmed short chan ID, after which the channel's
+// link loads it from disk. Both happen with the link index locked, so no
+// packets are forwarded to the link while its short chan ID changes.
+func (s *Switch) ConfirmAliasShortChanID(chanID lnwire.ChannelID,
+	persist func() error) error {
+
+	s.indexMtx.Lock()
+	defer s.indexMtx.Unlock()
+
+	if err := persist(); err != nil {
+		return err
+	}
+
+	// If the channel has no link with us at the moment, it will use its
+	// confirmed short chan ID once it's added.
+	link, ok := s.linkIndex[chanID]
+	if !ok || !link.ShortChanID().IsAlias() {
+		return nil
+	}
+
+	return s.confirmAliasLink(link)
+}
+
+// confirmAliasLink loads the confirmed short chan ID of a live link that has
+// been forwarding under its alias. The link becomes reachable by its new short
+// chan ID, while the alias stays in the forwarding index until the link is
+// removed.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) confirmAliasLink(link ChannelLink) error {
+	chanID := link.ChanID()
+	alias := link.ShortChanID()
+
+	shortChanID, err := link.UpdateShortChanID()
+	if err != nil {
+		return err
+	}
+
+	// The short chan ID on disk may not have been updated yet, in which
+	// case there's nothing to do.
+	if shortChanID == alias {
+		return nil
+	}
+
+	log.Infof("Replaced alias short_chan_id for ChannelLink(%v): "+
+		"alias=%v, new=%v", chanID, alias, shortChanID)
+
+	s.forwardingIndex[shortChanID] = link
+	s.aliasIndex[shortChanID] = alias
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID)
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, shortChanID)
+
+	return nil
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
@@ -82,6 +82,113 @@ func TestSwitchAddDuplicateLink(t *testing.T) {
 	}
 }
 
+// TestSwitchAliasShortChanID tests that a link using an alias short channel
+// ID is live right away, and that it can be reached by both its alias and its
+// confirmed short channel ID once the latter has been persisted.
+func TestSwitchAliasShortChanID(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, _ := genIDs()
+
+	alias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartHeight,
+		TxIndex:     1,
+	}
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, alias, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	assertLink := func(sid lnwire.ShortChannelID, exists bool) {
+		t.Helper()
+
+		s.indexMtx.RLock()
+		_, err := s.getLinkByShortID(sid)
+		s.indexMtx.RUnlock()
+
+		switch {
+		case exists && err != nil:
+			t.Fatalf("unable to find link by %v: %v", sid, err)
+		case !exists && err == nil:
+			t.Fatalf("link by %v should have been removed", sid)
+		}
+	}
+	assertLink(alias, true)
+
+	// If the confirmed short channel ID can't be persisted, the link
+	// should keep using its alias only.
+	aliceChannelLink.confirmedShortChanID = aliceChanID
+	persistErr := fmt.Errorf("unable to persist")
+	err = s.ConfirmAliasShortChanID(chanID1, func() error {
+		return persistErr
+	})
+	if err != persistErr {
+		t.Fatalf("expected persist error, got: %v", err)
+	}
+	assertLink(alias, true)
+	assertLink(aliceChanID, false)
+
+	// Once it's persisted, the link should be reachable by both short
+	// channel IDs. The link index must be locked while the short channel
+	// ID is persisted, such that nothing is forwarded to the link in the
+	// meantime.
+	err = s.ConfirmAliasShortChanID(chanID1, func() error {
+		locked := make(chan struct{})
+		go func() {
+			s.indexMtx.RLock()
+			s.indexMtx.RUnlock()
+			close(locked)
+		}()
+
+		select {
+		case <-locked:
+			t.Fatalf("link index not locked while persisting")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to confirm alice short_chan_id: %v", err)
+	}
+	assertLink(alias, true)
+	assertLink(aliceChanID, true)
+
+	// A channel without a link only needs its short channel ID persisted.
+	var persisted bool
+	err = s.ConfirmAliasShortChanID(chanID2, func() error {
+		persisted = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to confirm short_chan_id: %v", err)
+	}
+	if !persisted {
+		t.Fatalf("short_chan_id of channel without link not persisted")
+	}
+
+	// Removing the link should clear both short channel IDs.
+	s.RemoveLink(chanID1)
+	assertLink(alias, false)
+	assertLink(aliceChanID, false)
+}
+
 // TestSwitchSendPending checks the inability of htlc switch to forward adds
 // over pending links, and the UpdateShortChanID makes a pending link live.
 func TestSwitchSendPending(t *testing.T) {
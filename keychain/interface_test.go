@@ -28,6 +28,8 @@ var versionZeroKeyFamilies = []KeyFamily{
 	KeyFamilyDelayBase,
 	KeyFamilyRevocationRoot,
 	KeyFamilyNodeKey,
+	KeyFamilyTowerSession,
+	KeyFamilyStaticBackup,
 }
 
 var (
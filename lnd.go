@@ -34,20 +34,27 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcwallet/wallet"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	flags "github.com/jessevdk/go-flags"
 
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/walletunlocker"
+	"github.com/lightningnetwork/lnd/watchtower"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 )
 
 const (
@@ -201,12 +208,16 @@ func lndMain() error {
 		birthday        = time.Now()
 		recoveryWindow  uint32
 		unlockedWallet  *wallet.Wallet
+		macResponseChan chan []byte
+		statelessInit   bool
+		chansToRestore  walletunlocker.ChannelsToRecover
 	)
 
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
-	// for wallet encryption.
-	if !cfg.NoSeedBackup {
+	// for wallet encryption. The same goes for a node using a remote
+	// signer, as its watch-only wallet holds no private keys to encrypt.
+	if !cfg.NoSeedBackup && !cfg.RemoteSigner.Active {
 		walletInitParams, err := waitForWalletPassword(
 			cfg.RPCListeners, cfg.RESTListeners, serverOpts,
 			proxyOpts, tlsConf,
@@ -220,6 +231,9 @@ func lndMain() error {
 		birthday = walletInitParams.Birthday
 		recoveryWindow = walletInitParams.RecoveryWindow
 		unlockedWallet = walletInitParams.Wallet
+		macResponseChan = walletInitParams.MacResponseChan
+		statelessInit = walletInitParams.StatelessInit
+		chansToRestore = walletInitParams.ChansToRestore
 
 		if recoveryWindow > 0 {
 			ltndLog.Infof("Wallet recovery mode enabled with "+
@@ -247,20 +261,32 @@ func lndMain() error {
 			return err
 		}
 
-		// Create macaroon files for lncli to use if they don't exist.
-		if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
-			!fileExists(cfg.InvoiceMacPath) {
+		// If the wallet was initialized statelessly, we won't write
+		// any macaroon files to disk, so the only macaroon is the admin
+		// macaroon returned to the caller of InitWallet.
+		if !statelessInit {
+			err = genMacaroonFiles(ctx, macaroonService)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
-			err = genMacaroons(
-				ctx, macaroonService, cfg.AdminMacPath,
-				cfg.ReadMacPath, cfg.InvoiceMacPath,
+	// If the wallet was initialized statelessly, the caller of InitWallet
+	// is still waiting for the admin macaroon of the new wallet.
+	if macResponseChan != nil {
+		var adminMac []byte
+		if !cfg.NoMacaroons {
+			adminMac, err = bakeMacaroon(
+				ctx, macaroonService, adminPermissions(),
 			)
 			if err != nil {
-				ltndLog.Errorf("unable to create macaroon "+
-					"files: %v", err)
+				ltndLog.Errorf("unable to create admin "+
+					"macaroon: %v", err)
 				return err
 			}
 		}
+		macResponseChan <- adminMac
 	}
 
 	// With the information parsed from the configuration, create valid
@@ -302,10 +328,103 @@ func lndMain() error {
 			"is proxying over Tor as well", cfg.Tor.StreamIsolation)
 	}
 
+	// If the watchtower is enabled, we'll open its database and set up the
+	// tower, which will be started once the chain backend is synced. The
+	// tower accepts clients using the node's identity key.
+	var tower *watchtower.Standalone
+	if cfg.Watchtower.Active {
+		towerDBDir := filepath.Join(
+			cfg.Watchtower.TowerDir,
+			registeredChains.PrimaryChain().String(),
+			normalizeNetwork(activeNetParams.Name),
+		)
+
+		towerDB, err := wtdb.OpenTowerDB(towerDBDir)
+		if err != nil {
+			ltndLog.Errorf("unable to open watchtower db: %v", err)
+			return err
+		}
+		defer towerDB.Close()
+
+		tower, err = watchtower.New(&watchtower.Config{
+			BlockFetcher:   activeChainControl.chainIO,
+			DB:             towerDB,
+			EpochRegistrar: activeChainControl.chainNotifier,
+			SpendRegistrar: activeChainControl.chainNotifier,
+			PublishTx:      activeChainControl.wallet.PublishTransaction,
+			NodePrivKey:    idPrivKey,
+			ListenAddrs:    cfg.Watchtower.Listeners,
+			ReadTimeout:    cfg.Watchtower.ReadTimeout,
+			WriteTimeout:   cfg.Watchtower.WriteTimeout,
+		})
+		if err != nil {
+			ltndLog.Errorf("unable to create watchtower: %v", err)
+			return err
+		}
+	}
+
+	// If the watchtower client is enabled, we'll open its database, which
+	// lives alongside the channel database, and set up the client so that
+	// our links can back up each revoked state.
+	var towerClient wtclient.Client
+	if cfg.WtClient.Active {
+		wtClientDB, err := wtdb.OpenClientDB(graphDir)
+		if err != nil {
+			ltndLog.Errorf("unable to open watchtower client db: %v",
+				err)
+			return err
+		}
+		defer wtClientDB.Close()
+
+		sweepFeeRate := lnwallet.SatPerKVByte(
+			cfg.WtClient.SweepFeeRate * 1000,
+		).FeePerKWeight()
+
+		lnWallet := activeChainControl.wallet
+		towerClient, err = wtclient.New(&wtclient.Config{
+			Signer: activeChainControl.signer,
+			NewAddress: func() ([]byte, error) {
+				addr, err := lnWallet.NewAddress(
+					lnwallet.WitnessPubKey, false,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return txscript.PayToAddrScript(addr)
+			},
+			SecretKeyRing: lnWallet,
+			Dial:          cfg.net.Dial,
+			AuthDial:      wtclient.AuthDial,
+			DB:            wtClientDB,
+			Policy: wtdb.SessionPolicy{
+				BlobVersion:  blob.MaxVersion,
+				MaxUpdates:   cfg.WtClient.MaxUpdates,
+				SweepFeeRate: sweepFeeRate,
+			},
+		})
+		if err != nil {
+			ltndLog.Errorf("unable to create watchtower client: %v",
+				err)
+			return err
+		}
+
+		// Register any towers provided in the config, such that they
+		// are loaded once the client is started.
+		for _, towerAddr := range cfg.WtClient.TowerAddrs {
+			if err := towerClient.AddTower(towerAddr); err != nil {
+				ltndLog.Errorf("unable to add watchtower %v: %v",
+					towerAddr, err)
+				return err
+			}
+		}
+	}
+
 	// Set up the core server which will listen for incoming peer
 	// connections.
 	server, err := newServer(
 		cfg.Listeners, chanDB, activeChainControl, idPrivKey,
+		towerClient,
 	)
 	if err != nil {
 		srvrLog.Errorf("unable to create server: %v\n", err)
@@ -332,6 +451,36 @@ func lndMain() error {
 
 	grpcServer := grpc.NewServer(serverOpts...)
 	lnrpc.RegisterLightningServer(grpcServer, rpcServer)
+	lnrpc.RegisterSignerServer(
+		grpcServer, newSignerRPCServer(activeChainControl),
+	)
+	lnrpc.RegisterWalletKitServer(
+		grpcServer, newWalletKitRPCServer(activeChainControl),
+	)
+
+	// If we're acting as the remote signer of a watch-only node, then
+	// we'll also serve signing and key derivation requests from our
+	// wallet.
+	if cfg.RemoteSigner.Serve {
+		rpcsLog.Infof("Serving remote signer requests")
+
+		// The watch-only node's wallet is exported from our wallet,
+		// which is a btcwallet when acting as a remote signer.
+		walletController := activeChainControl.wallet.WalletController
+		wc, ok := walletController.(*btcwallet.BtcWallet)
+		if !ok {
+			return fmt.Errorf("remote signer requires btcwallet")
+		}
+
+		lnrpc.RegisterRemoteSignerServer(
+			grpcServer, remotesigner.NewServer(
+				activeChainControl.signer,
+				activeChainControl.msgSigner,
+				activeChainControl.wallet, wc.InternalWallet(),
+				publicWalletPw,
+			),
+		)
+	}
 
 	// Next, Start the gRPC server listening for HTTP/2 connections.
 	for _, listener := range cfg.RPCListeners {
@@ -412,6 +561,17 @@ func lndMain() error {
 			bestHeight)
 	}
 
+	// The tower client must be started before the server brings up any
+	// links, as the links will begin handing it revoked states.
+	if towerClient != nil {
+		if err := towerClient.Start(); err != nil {
+			ltndLog.Errorf("unable to start watchtower client: %v",
+				err)
+			return err
+		}
+		defer towerClient.Stop()
+	}
+
 	// With all the relevant chains initialized, we can finally start the
 	// server itself.
 	if err := server.Start(); err != nil {
@@ -420,10 +580,34 @@ func lndMain() error {
 	}
 	defer server.Stop()
 
+	// Now that the server has started, we'll restore any channels that
+	// were passed in along with the seed when the wallet was created.
+	// This connects to each channel peer, so the channels are closed and
+	// their funds swept back into our wallet.
+	chanRestorer := &chanDBRestorer{
+		db:         server.chanDB,
+		secretKeys: server.cc.wallet,
+		chainHash:  *activeNetParams.GenesisHash,
+		chainArb:   server.chainArb,
+	}
+	restoreChanBackups(
+		chansToRestore, server.cc.wallet, chanRestorer, server,
+	)
+
+	// Now that the chain backend is synced, start the watchtower so that it
+	// can begin accepting clients and monitoring for breaches.
+	if tower != nil {
+		if err := tower.Start(); err != nil {
+			ltndLog.Errorf("unable to start watchtower: %v", err)
+			return err
+		}
+		defer tower.Stop()
+	}
+
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll initialize a fresh instance of it and start it.
 	if cfg.Autopilot.Active {
-		pilot, err := initAutoPilot(server, cfg.Autopilot)
+		pilot, err := initAutoPilot(server, cfg.Autopilot, cfg.Splicing)
 		if err != nil {
 			ltndLog.Errorf("unable to create autopilot agent: %v",
 				err)
@@ -645,14 +829,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	admBytes, err := admMacaroon.M().MarshalBinary()
+	admBytes, err := bakeMacaroon(ctx, svc, adminPermissions())
 	if err != nil {
 		return err
 	}
@@ -663,6 +840,138 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// adminPermissions returns the permissions granted by the admin macaroon.
+func adminPermissions() []bakery.Op {
+	perms := make([]bakery.Op, 0, len(readPermissions)+len(writePermissions))
+	perms = append(perms, readPermissions...)
+	return append(perms, writePermissions...)
+}
+
+// genMacaroonFiles creates the macaroon files for lncli and each of the
+// sub-services, unless they already exist.
+func genMacaroonFiles(ctx context.Context, svc *macaroons.Service) error {
+	// Create macaroon files for lncli to use if they don't exist.
+	if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
+		!fileExists(cfg.InvoiceMacPath) {
+
+		err := genMacaroons(
+			ctx, svc, cfg.AdminMacPath, cfg.ReadMacPath,
+			cfg.InvoiceMacPath,
+		)
+		if err != nil {
+			ltndLog.Errorf("unable to create macaroon files: %v",
+				err)
+			return err
+		}
+	}
+
+	// Each of the sub-services gets its own macaroon, which only grants
+	// access to that service. If we act as a remote signer, then we'll
+	// also need the macaroon that the watch-only node authenticates with.
+	serviceMacs := map[string][]bakery.Op{
+		cfg.SignerMacPath:    signerPermissions,
+		cfg.WalletKitMacPath: walletKitPermissions,
+	}
+	if cfg.RemoteSigner.Serve {
+		serviceMacs[cfg.RemoteSignerMacPath] = remoteSignerPermissions
+	}
+	for macFile, perms := range serviceMacs {
+		if fileExists(macFile) {
+			continue
+		}
+
+		err := genServiceMacaroon(ctx, svc, macFile, perms)
+		if err != nil {
+			ltndLog.Errorf("unable to create macaroon %v: %v",
+				macFile, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bakeMacaroon creates a new macaroon that grants the passed permissions and
+// returns it in its serialized form.
+func bakeMacaroon(ctx context.Context, svc *macaroons.Service,
+	perms []bakery.Op) ([]byte, error) {
+
+	mac, err := svc.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, perms...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M().MarshalBinary()
+}
+
+// genServiceMacaroon generates a macaroon that grants the passed permissions,
+// and writes it to the passed file.
+func genServiceMacaroon(ctx context.Context, svc *macaroons.Service,
+	macFile string, perms []bakery.Op) error {
+
+	macBytes, err := bakeMacaroon(ctx, svc, perms)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(macFile, macBytes, 0600)
+}
+
+// restoreChanBackups attempts to restore the passed set of channel backups,
+// which are decrypted using the keys of our wallet. Backups that can't be
+// decrypted or restored are logged and skipped, so a single bad backup can
+// neither prevent the remaining channels from being restored nor keep the
+// daemon from starting.
+func restoreChanBackups(chansToRestore walletunlocker.ChannelsToRecover,
+	keyRing keychain.KeyRing, restorer chanbackup.ChannelRestorer,
+	peerConnector chanbackup.PeerConnector) {
+
+	var chanBackups []chanbackup.Single
+	if len(chansToRestore.PackedSingleChanBackups) != 0 {
+		ltndLog.Infof("Restoring %v single channel backups",
+			len(chansToRestore.PackedSingleChanBackups))
+	}
+	for i, packedSingle := range chansToRestore.PackedSingleChanBackups {
+		singles, err := chanbackup.PackedSingles{packedSingle}.Unpack(
+			keyRing,
+		)
+		if err != nil {
+			ltndLog.Errorf("Unable to unpack single channel backup "+
+				"#%v, skipping: %v", i, err)
+			continue
+		}
+
+		chanBackups = append(chanBackups, singles...)
+	}
+
+	if len(chansToRestore.PackedMultiChanBackup) != 0 {
+		ltndLog.Infof("Restoring multi channel backup")
+
+		packedMulti := chansToRestore.PackedMultiChanBackup
+		multi, err := packedMulti.Unpack(keyRing)
+		if err != nil {
+			ltndLog.Errorf("Unable to unpack multi channel backup, "+
+				"skipping: %v", err)
+		} else {
+			chanBackups = append(chanBackups, multi.StaticBackups...)
+		}
+	}
+
+	// Each channel is restored on its own, such that a failure to restore
+	// one of them doesn't affect the others.
+	for _, backup := range chanBackups {
+		err := chanbackup.Recover(
+			[]chanbackup.Single{backup}, restorer, peerConnector,
+		)
+		if err != nil {
+			ltndLog.Errorf("Unable to restore ChannelPoint(%v), "+
+				"skipping: %v", backup.FundingOutpoint, err)
+		}
+	}
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -683,6 +992,20 @@ type WalletUnlockParams struct {
 	// later when lnd actually uses it). Because unlocking involves scrypt
 	// which is resource intensive, we want to avoid doing it twice.
 	Wallet *wallet.Wallet
+
+	// MacResponseChan is set if the wallet was initialized with
+	// stateless_init. The admin macaroon of the new wallet must be sent
+	// over it, as the InitWallet call only returns once it receives it.
+	MacResponseChan chan []byte
+
+	// StatelessInit is true if the wallet was initialized with
+	// stateless_init. No macaroon files are written to disk in that case,
+	// so the admin macaroon returned by InitWallet is the only one.
+	StatelessInit bool
+
+	// ChansToRestore is a set of static channel backups that should be
+	// restored once the main server instance has started.
+	ChansToRestore walletunlocker.ChannelsToRecover
 }
 
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
@@ -712,6 +1035,39 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, macaroonFiles,
 	)
+
+	// If a password file is configured, we try to unlock the wallet with
+	// it right away. Only if the wallet doesn't exist yet do we start the
+	// unlocker RPC servers, so the wallet can be created.
+	if cfg.WalletUnlockPasswordFile != "" {
+		password, err := walletunlocker.ReadPasswordFile(
+			cfg.WalletUnlockPasswordFile,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		unlockedWallet, err := pwService.LoadAndUnlock(password, 0)
+		switch {
+		case err == walletunlocker.ErrWalletNotFound:
+			ltndLog.Infof("Wallet not found, waiting for it to " +
+				"be created before it can be unlocked with " +
+				"the password file")
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to unlock wallet "+
+				"with password file: %v", err)
+
+		default:
+			ltndLog.Infof("Wallet unlocked with password file")
+
+			return &WalletUnlockParams{
+				Password: password,
+				Wallet:   unlockedWallet,
+			}, nil
+		}
+	}
+
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
 	// Use a WaitGroup so we can be sure the instructions on how to input the
@@ -841,6 +1197,12 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 			Birthday:       birthday,
 			RecoveryWindow: recoveryWindow,
 			Wallet:         newWallet,
+			ChansToRestore: initMsg.ChanBackups,
+		}
+		if initMsg.StatelessInit {
+			macChan := pwService.MacResponseChan
+			walletInitParams.MacResponseChan = macChan
+			walletInitParams.StatelessInit = true
 		}
 
 		return walletInitParams, nil
@@ -852,6 +1214,7 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 			Password:       unlockMsg.Passphrase,
 			RecoveryWindow: unlockMsg.RecoveryWindow,
 			Wallet:         unlockMsg.Wallet,
+			StatelessInit:  unlockMsg.StatelessInit,
 		}
 		return walletInitParams, nil
 
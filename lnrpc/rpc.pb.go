@@ -27,6 +27,25 @@ It has these top-level messages:
 	LightningAddress
 	SendManyRequest
 	SendManyResponse
+	OutPoint
+	Utxo
+	ListUnspentRequest
+	ListUnspentResponse
+	LeaseOutputRequest
+	LeaseOutputResponse
+	ReleaseOutputRequest
+	ReleaseOutputResponse
+	LabelTransactionRequest
+	LabelTransactionResponse
+	Account
+	ImportAccountRequest
+	ImportAccountResponse
+	ListAccountsRequest
+	ListAccountsResponse
+	FundPsbtRequest
+	FundPsbtResponse
+	BumpFeeRequest
+	BumpFeeResponse
 	SendCoinsRequest
 	SendCoinsResponse
 	NewAddressRequest
@@ -57,13 +76,24 @@ It has these top-level messages:
 	CloseChannelRequest
 	CloseStatusUpdate
 	PendingUpdate
+	ChannelAcceptRequest
+	ChannelAcceptResponse
 	OpenChannelRequest
+	BatchOpenChannel
+	BatchOpenChannelRequest
+	BatchOpenChannelResponse
+	SpliceChannelRequest
+	SpliceChannelResponse
+	ReadyForPsbtFunding
 	OpenStatusUpdate
+	FinalizeFundingRequest
+	FinalizeFundingResponse
 	PendingHTLC
 	PendingChannelsRequest
 	PendingChannelsResponse
 	WalletBalanceRequest
 	WalletBalanceResponse
+	AccountBalance
 	ChannelBalanceRequest
 	ChannelBalanceResponse
 	QueryRoutesRequest
@@ -115,6 +145,45 @@ It has these top-level messages:
 	ForwardingHistoryRequest
 	ForwardingEvent
 	ForwardingHistoryResponse
+	AddTowerRequest
+	AddTowerResponse
+	RemoveTowerRequest
+	RemoveTowerResponse
+	ListTowersRequest
+	TowerSession
+	Tower
+	ListTowersResponse
+	TowerClientStatsRequest
+	TowerClientStatsResponse
+	ExportChannelBackupRequest
+	ChannelBackup
+	MultiChanBackup
+	ChannelBackups
+	ChanBackupSnapshot
+	VerifyChanBackupResponse
+	RestoreChanBackupRequest
+	RestoreBackupResponse
+	KeyLocator
+	KeyDescriptor
+	KeyReq
+	TxOut
+	SignDescriptor
+	WalletKeyPath
+	SignReq
+	SignResp
+	InputScript
+	InputScriptResp
+	KeySignMessageRequest
+	KeySignMessageResponse
+	ExportWatchOnlyWalletRequest
+	ExportWatchOnlyWalletResponse
+	DerivePrivKeyResponse
+	SharedKeyRequest
+	SharedKeyResponse
+	PublishTxRequest
+	PublishTxResponse
+	EstimateFeeRequest
+	EstimateFeeResponse
 */
 package lnrpc
 
@@ -159,7 +228,7 @@ func (x NewAddressRequest_AddressType) String() string {
 	return proto.EnumName(NewAddressRequest_AddressType_name, int32(x))
 }
 func (NewAddressRequest_AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{21, 0}
+	return fileDescriptor0, []int{40, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -194,7 +263,7 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{35, 0}
+	return fileDescriptor0, []int{54, 0}
 }
 
 type GenSeedRequest struct {
@@ -282,6 +351,24 @@ type InitWalletRequest struct {
 	// window of zero indicates that no addresses should be recovered, such after
 	// the first initialization of the wallet.
 	RecoveryWindow int32 `protobuf:"varint,4,opt,name=recovery_window,json=recoveryWindow" json:"recovery_window,omitempty"`
+	// *
+	// stateless_init instructs the daemon to generate a new aezeed cipher seed
+	// itself instead of using cipher_seed_mnemonic. The mnemonic is never
+	// exposed, instead the seed is enciphered with aezeed_passphrase and written
+	// to encrypted_seed_file. The admin macaroon created for the new wallet is
+	// returned in the response.
+	StatelessInit bool `protobuf:"varint,5,opt,name=stateless_init,json=statelessInit" json:"stateless_init,omitempty"`
+	// *
+	// encrypted_seed_file is the path the enciphered seed is written to when
+	// stateless_init is set. The file must not exist yet.
+	EncryptedSeedFile string `protobuf:"bytes,6,opt,name=encrypted_seed_file,json=encryptedSeedFile" json:"encrypted_seed_file,omitempty"`
+	// *
+	// channel_backups is an optional argument that allows clients to recover the
+	// settled funds within a set of channels when restoring a wallet from an
+	// existing seed. The backups are decrypted with keys derived from the seed.
+	// Once the wallet is unlocked, the daemon connects to each channel peer so
+	// the channels get closed and their funds swept back into the wallet.
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,7,opt,name=channel_backups,json=channelBackups" json:"channel_backups,omitempty"`
 }
 
 func (m *InitWalletRequest) Reset()                    { *m = InitWalletRequest{} }
@@ -317,7 +404,32 @@ func (m *InitWalletRequest) GetRecoveryWindow() int32 {
 	return 0
 }
 
+func (m *InitWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
+func (m *InitWalletRequest) GetEncryptedSeedFile() string {
+	if m != nil {
+		return m.EncryptedSeedFile
+	}
+	return ""
+}
+
+func (m *InitWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
+	if m != nil {
+		return m.ChannelBackups
+	}
+	return nil
+}
+
 type InitWalletResponse struct {
+	// *
+	// admin_macaroon is the admin macaroon of the new wallet. It's only set when
+	// the wallet was initialized with stateless_init.
+	AdminMacaroon []byte `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 }
 
 func (m *InitWalletResponse) Reset()                    { *m = InitWalletResponse{} }
@@ -325,6 +437,13 @@ func (m *InitWalletResponse) String() string            { return proto.CompactTe
 func (*InitWalletResponse) ProtoMessage()               {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
+func (m *InitWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type UnlockWalletRequest struct {
 	// *
 	// wallet_password should be the current valid passphrase for the daemon. This
@@ -338,6 +457,10 @@ type UnlockWalletRequest struct {
 	// window of zero indicates that no addresses should be recovered, such after
 	// the first initialization of the wallet.
 	RecoveryWindow int32 `protobuf:"varint,2,opt,name=recovery_window,json=recoveryWindow" json:"recovery_window,omitempty"`
+	// *
+	// stateless_init should be set if the wallet was initialized with
+	// stateless_init. If set, no macaroon files are written to disk.
+	StatelessInit bool `protobuf:"varint,3,opt,name=stateless_init,json=statelessInit" json:"stateless_init,omitempty"`
 }
 
 func (m *UnlockWalletRequest) Reset()                    { *m = UnlockWalletRequest{} }
@@ -359,6 +482,13 @@ func (m *UnlockWalletRequest) GetRecoveryWindow() int32 {
 	return 0
 }
 
+func (m *UnlockWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type UnlockWalletResponse struct {
 }
 
@@ -422,6 +552,10 @@ type Transaction struct {
 	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees" json:"total_fees,omitempty"`
 	// / Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses" json:"dest_addresses,omitempty"`
+	// / The label of this transaction, if any
+	Label string `protobuf:"bytes,9,opt,name=label" json:"label,omitempty"`
+	// / The name of the watch-only account of this transaction, if any
+	Account string `protobuf:"bytes,10,opt,name=account" json:"account,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
@@ -485,7 +619,29 @@ func (m *Transaction) GetDestAddresses() []string {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Transaction) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
+	// / The height from which to list transactions, inclusive.
+	StartHeight int32 `protobuf:"varint,1,opt,name=start_height" json:"start_height,omitempty"`
+	// *
+	// The height until which to list transactions, inclusive. If set to -1, or
+	// not set at all, transactions up to the chain tip as well as unconfirmed
+	// transactions are listed. As the unset value can't be told apart from an
+	// explicit 0, an end height of 0 is treated the same as -1.
+	EndHeight int32 `protobuf:"varint,2,opt,name=end_height" json:"end_height,omitempty"`
 }
 
 func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
@@ -493,6 +649,20 @@ func (m *GetTransactionsRequest) String() string            { return proto.Compa
 func (*GetTransactionsRequest) ProtoMessage()               {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
+func (m *GetTransactionsRequest) GetStartHeight() int32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetEndHeight() int32 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 type TransactionDetails struct {
 	// / The list of transactions relevant to the wallet.
 	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
@@ -928,6 +1098,11 @@ type SendManyRequest struct {
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// *
+	// An optional set of wallet outputs to spend. If set, exactly these outputs
+	// are spent, and any remainder is sent to a change address. Otherwise, the
+	// inputs are selected automatically.
+	Outpoints []*OutPoint `protobuf:"bytes,6,rep,name=outpoints" json:"outpoints,omitempty"`
 }
 
 func (m *SendManyRequest) Reset()                    { *m = SendManyRequest{} }
@@ -956,6 +1131,13 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
 type SendManyResponse struct {
 	// / The id of the transaction
 	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
@@ -973,5140 +1155,7869 @@ func (m *SendManyResponse) GetTxid() string {
 	return ""
 }
 
-type SendCoinsRequest struct {
-	// / The address to send coins to
-	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
-	// / The amount in satoshis to send
-	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	// / The target number of blocks that this transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+type OutPoint struct {
+	// / Raw bytes representing the transaction id.
+	TxidBytes []byte `protobuf:"bytes,1,opt,name=txid_bytes,proto3" json:"txid_bytes,omitempty"`
+	// / Reversed, hex-encoded string representing the transaction id.
+	TxidStr string `protobuf:"bytes,2,opt,name=txid_str" json:"txid_str,omitempty"`
+	// / The index of the output on the transaction.
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index" json:"output_index,omitempty"`
 }
 
-func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
-func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendCoinsRequest) ProtoMessage()               {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *OutPoint) Reset()                    { *m = OutPoint{} }
+func (m *OutPoint) String() string            { return proto.CompactTextString(m) }
+func (*OutPoint) ProtoMessage()               {}
+func (*OutPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
-func (m *SendCoinsRequest) GetAddr() string {
+func (m *OutPoint) GetTxidBytes() []byte {
 	if m != nil {
-		return m.Addr
+		return m.TxidBytes
 	}
-	return ""
+	return nil
 }
 
-func (m *SendCoinsRequest) GetAmount() int64 {
+func (m *OutPoint) GetTxidStr() string {
 	if m != nil {
-		return m.Amount
+		return m.TxidStr
 	}
-	return 0
+	return ""
 }
 
-func (m *SendCoinsRequest) GetTargetConf() int32 {
+func (m *OutPoint) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.TargetConf
+		return m.OutputIndex
 	}
 	return 0
 }
 
-func (m *SendCoinsRequest) GetSatPerByte() int64 {
+type Utxo struct {
+	// / The type of address the output pays to.
+	Type NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,json=address_type,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+	// / The address the output pays to.
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	// / The value of the output in satoshis.
+	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat" json:"amount_sat,omitempty"`
+	// / The hex-encoded pkScript of the output.
+	PkScript string `protobuf:"bytes,4,opt,name=pk_script" json:"pk_script,omitempty"`
+	// / The outpoint of the output.
+	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The number of confirmations of the output.
+	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations" json:"confirmations,omitempty"`
+}
+
+func (m *Utxo) Reset()                    { *m = Utxo{} }
+func (m *Utxo) String() string            { return proto.CompactTextString(m) }
+func (*Utxo) ProtoMessage()               {}
+func (*Utxo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Utxo) GetType() NewAddressRequest_AddressType {
 	if m != nil {
-		return m.SatPerByte
+		return m.Type
 	}
-	return 0
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
 }
 
-type SendCoinsResponse struct {
-	// / The transaction ID of the transaction
-	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+func (m *Utxo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
-func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendCoinsResponse) ProtoMessage()               {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (m *Utxo) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
 
-func (m *SendCoinsResponse) GetTxid() string {
+func (m *Utxo) GetPkScript() string {
 	if m != nil {
-		return m.Txid
+		return m.PkScript
 	}
 	return ""
 }
 
-// *
-// `AddressType` has to be one of:
-//
-// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
-// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
-type NewAddressRequest struct {
-	// / The address type
-	Type NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+func (m *Utxo) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
 }
 
-func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
-func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewAddressRequest) ProtoMessage()               {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
-
-func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
+func (m *Utxo) GetConfirmations() int64 {
 	if m != nil {
-		return m.Type
+		return m.Confirmations
 	}
-	return NewAddressRequest_WITNESS_PUBKEY_HASH
+	return 0
 }
 
-type NewAddressResponse struct {
-	// / The newly generated wallet address
-	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+type ListUnspentRequest struct {
+	// / The minimum number of confirmations an output must have.
+	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / The maximum number of confirmations an output may have. If zero, no maximum is applied.
+	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs" json:"max_confs,omitempty"`
 }
 
-func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
-func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
-func (*NewAddressResponse) ProtoMessage()               {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *ListUnspentRequest) Reset()                    { *m = ListUnspentRequest{} }
+func (m *ListUnspentRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListUnspentRequest) ProtoMessage()               {}
+func (*ListUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
-func (m *NewAddressResponse) GetAddress() string {
+func (m *ListUnspentRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.Address
+		return m.MinConfs
 	}
-	return ""
-}
-
-type SignMessageRequest struct {
-	// / The message to be signed
-	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	return 0
 }
 
-func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
-func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
-func (*SignMessageRequest) ProtoMessage()               {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
-
-func (m *SignMessageRequest) GetMsg() []byte {
+func (m *ListUnspentRequest) GetMaxConfs() int32 {
 	if m != nil {
-		return m.Msg
+		return m.MaxConfs
 	}
-	return nil
+	return 0
 }
 
-type SignMessageResponse struct {
-	// / The signature for the given message
-	Signature string `protobuf:"bytes,1,opt,name=signature" json:"signature,omitempty"`
+type ListUnspentResponse struct {
+	// / The unspent outputs of the wallet that are available for spending.
+	Utxos []*Utxo `protobuf:"bytes,1,rep,name=utxos" json:"utxos,omitempty"`
 }
 
-func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
-func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignMessageResponse) ProtoMessage()               {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *ListUnspentResponse) Reset()                    { *m = ListUnspentResponse{} }
+func (m *ListUnspentResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListUnspentResponse) ProtoMessage()               {}
+func (*ListUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
-func (m *SignMessageResponse) GetSignature() string {
+func (m *ListUnspentResponse) GetUtxos() []*Utxo {
 	if m != nil {
-		return m.Signature
+		return m.Utxos
 	}
-	return ""
+	return nil
 }
 
-type VerifyMessageRequest struct {
-	// / The message over which the signature is to be verified
-	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// / The signature to be verified over the given message
-	Signature string `protobuf:"bytes,2,opt,name=signature" json:"signature,omitempty"`
+type LeaseOutputRequest struct {
+	// / The 32-byte identifier the output is leased under.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The wallet output to lease.
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The duration of the lease in seconds. If zero, a default of 10 minutes is used.
+	ExpirationSeconds uint64 `protobuf:"varint,3,opt,name=expiration_seconds" json:"expiration_seconds,omitempty"`
 }
 
-func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
-func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
-func (*VerifyMessageRequest) ProtoMessage()               {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *LeaseOutputRequest) Reset()                    { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()               {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
-func (m *VerifyMessageRequest) GetMsg() []byte {
+func (m *LeaseOutputRequest) GetId() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Id
 	}
 	return nil
 }
 
-func (m *VerifyMessageRequest) GetSignature() string {
+func (m *LeaseOutputRequest) GetOutpoint() *OutPoint {
 	if m != nil {
-		return m.Signature
+		return m.Outpoint
 	}
-	return ""
-}
-
-type VerifyMessageResponse struct {
-	// / Whether the signature was valid over the given message
-	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
-	// / The pubkey recovered from the signature
-	Pubkey string `protobuf:"bytes,2,opt,name=pubkey" json:"pubkey,omitempty"`
+	return nil
 }
 
-func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
-func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
-func (*VerifyMessageResponse) ProtoMessage()               {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
-
-func (m *VerifyMessageResponse) GetValid() bool {
+func (m *LeaseOutputRequest) GetExpirationSeconds() uint64 {
 	if m != nil {
-		return m.Valid
+		return m.ExpirationSeconds
 	}
-	return false
+	return 0
 }
 
-func (m *VerifyMessageResponse) GetPubkey() string {
+type LeaseOutputResponse struct {
+	// / The unix timestamp at which the lease expires.
+	Expiration uint64 `protobuf:"varint,1,opt,name=expiration" json:"expiration,omitempty"`
+}
+
+func (m *LeaseOutputResponse) Reset()                    { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()               {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
 	if m != nil {
-		return m.Pubkey
+		return m.Expiration
 	}
-	return ""
+	return 0
 }
 
-type ConnectPeerRequest struct {
-	// / Lightning address of the peer, in the format `<pubkey>@host`
-	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
-	// * If set, the daemon will attempt to persistently connect to the target
-	// peer.  Otherwise, the call will be synchronous.
-	Perm bool `protobuf:"varint,2,opt,name=perm" json:"perm,omitempty"`
+type ReleaseOutputRequest struct {
+	// / The identifier the output was leased under.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The leased wallet output to release.
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
 }
 
-func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
-func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
-func (*ConnectPeerRequest) ProtoMessage()               {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (m *ReleaseOutputRequest) Reset()                    { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()               {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
-func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
+func (m *ReleaseOutputRequest) GetId() []byte {
 	if m != nil {
-		return m.Addr
+		return m.Id
 	}
 	return nil
 }
 
-func (m *ConnectPeerRequest) GetPerm() bool {
+func (m *ReleaseOutputRequest) GetOutpoint() *OutPoint {
 	if m != nil {
-		return m.Perm
+		return m.Outpoint
 	}
-	return false
+	return nil
 }
 
-type ConnectPeerResponse struct {
+type ReleaseOutputResponse struct {
 }
 
-func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
-func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
-func (*ConnectPeerResponse) ProtoMessage()               {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *ReleaseOutputResponse) Reset()                    { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()               {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
-type DisconnectPeerRequest struct {
-	// / The pubkey of the node to disconnect from
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+type LabelTransactionRequest struct {
+	// / The hex-encoded id of the transaction to label.
+	TxidStr string `protobuf:"bytes,1,opt,name=txid_str" json:"txid_str,omitempty"`
+	// / The label of the transaction.
+	Label string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+	// / Whether to replace the existing label of the transaction, if any.
+	Overwrite bool `protobuf:"varint,3,opt,name=overwrite" json:"overwrite,omitempty"`
 }
 
-func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
-func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
-func (*DisconnectPeerRequest) ProtoMessage()               {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *LabelTransactionRequest) Reset()                    { *m = LabelTransactionRequest{} }
+func (m *LabelTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*LabelTransactionRequest) ProtoMessage()               {}
+func (*LabelTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
-func (m *DisconnectPeerRequest) GetPubKey() string {
+func (m *LabelTransactionRequest) GetTxidStr() string {
 	if m != nil {
-		return m.PubKey
+		return m.TxidStr
 	}
 	return ""
 }
 
-type DisconnectPeerResponse struct {
+func (m *LabelTransactionRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
 }
 
-func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
-func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
-func (*DisconnectPeerResponse) ProtoMessage()               {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *LabelTransactionRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
 
-type HTLC struct {
-	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
-	Amount           int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	HashLock         []byte `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
-	ExpirationHeight uint32 `protobuf:"varint,4,opt,name=expiration_height" json:"expiration_height,omitempty"`
+type LabelTransactionResponse struct {
 }
 
-func (m *HTLC) Reset()                    { *m = HTLC{} }
-func (m *HTLC) String() string            { return proto.CompactTextString(m) }
-func (*HTLC) ProtoMessage()               {}
-func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (m *LabelTransactionResponse) Reset()                    { *m = LabelTransactionResponse{} }
+func (m *LabelTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*LabelTransactionResponse) ProtoMessage()               {}
+func (*LabelTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
-func (m *HTLC) GetIncoming() bool {
+type Account struct {
+	// / The name of the account.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// / The BIP32 extended public key of the account.
+	ExtendedPublicKey string `protobuf:"bytes,2,opt,name=extended_public_key" json:"extended_public_key,omitempty"`
+	// / The type of the account's addresses.
+	AddressType NewAddressRequest_AddressType `protobuf:"varint,3,opt,name=address_type,enum=lnrpc.NewAddressRequest_AddressType" json:"address_type,omitempty"`
+	// / The 4-byte fingerprint of the master key the account was derived from.
+	MasterKeyFingerprint []byte `protobuf:"bytes,4,opt,name=master_key_fingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	// / The derivation path of the account key, e.g. m/84'/0'/0'.
+	DerivationPath string `protobuf:"bytes,5,opt,name=derivation_path" json:"derivation_path,omitempty"`
+	// / The height from which the chain is scanned for the account.
+	BirthdayHeight uint32 `protobuf:"varint,6,opt,name=birthday_height" json:"birthday_height,omitempty"`
+	// / The height up to which the chain has been scanned for the account.
+	SyncedHeight uint32 `protobuf:"varint,7,opt,name=synced_height" json:"synced_height,omitempty"`
+	// / The number of receive addresses handed out or used so far.
+	ExternalKeyCount uint32 `protobuf:"varint,8,opt,name=external_key_count" json:"external_key_count,omitempty"`
+	// / The number of change addresses handed out or used so far.
+	InternalKeyCount uint32 `protobuf:"varint,9,opt,name=internal_key_count" json:"internal_key_count,omitempty"`
+	// / The confirmed balance of the account in satoshis.
+	ConfirmedBalance int64 `protobuf:"varint,10,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
+}
+
+func (m *Account) Reset()                    { *m = Account{} }
+func (m *Account) String() string            { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()               {}
+func (*Account) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *Account) GetName() string {
 	if m != nil {
-		return m.Incoming
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *HTLC) GetAmount() int64 {
+func (m *Account) GetExtendedPublicKey() string {
 	if m != nil {
-		return m.Amount
+		return m.ExtendedPublicKey
 	}
-	return 0
+	return ""
 }
 
-func (m *HTLC) GetHashLock() []byte {
+func (m *Account) GetAddressType() NewAddressRequest_AddressType {
 	if m != nil {
-		return m.HashLock
+		return m.AddressType
 	}
-	return nil
-}
-
-func (m *HTLC) GetExpirationHeight() uint32 {
-	if m != nil {
-		return m.ExpirationHeight
-	}
-	return 0
-}
-
-type Channel struct {
-	// / Whether this channel is active or not
-	Active bool `protobuf:"varint,1,opt,name=active" json:"active,omitempty"`
-	// / The identity pubkey of the remote node
-	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey" json:"remote_pubkey,omitempty"`
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point" json:"channel_point,omitempty"`
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id" json:"chan_id,omitempty"`
-	// / The total amount of funds held in this channel
-	Capacity int64 `protobuf:"varint,5,opt,name=capacity" json:"capacity,omitempty"`
-	// / This node's current balance in this channel
-	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance" json:"local_balance,omitempty"`
-	// / The counterparty's current balance in this channel
-	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance" json:"remote_balance,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of commitment
-	// transactions. The fee amount is persisted with the channel in order to
-	// allow the fee amount to be removed and recalculated with each channel state
-	// update, including updates that happen after a system restart.
-	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will pay
-	// at all times, for both the funding transaction and commitment transaction.
-	// This value can later be updated once the channel is open.
-	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
-	// / The unsettled balance in this channel
-	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance" json:"unsettled_balance,omitempty"`
-	// *
-	// The total number of satoshis we've sent within this channel.
-	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent" json:"total_satoshis_sent,omitempty"`
-	// *
-	// The total number of satoshis we've received within this channel.
-	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received" json:"total_satoshis_received,omitempty"`
-	// *
-	// The total number of updates conducted within this channel.
-	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates" json:"num_updates,omitempty"`
-	// *
-	// The list of active, uncleared HTLCs currently pending within the channel.
-	PendingHtlcs []*HTLC `protobuf:"bytes,15,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
-	// *
-	// The CSV delay expressed in relative blocks. If the channel is force
-	// closed, we'll need to wait for this many blocks before we can regain our
-	// funds.
-	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay" json:"csv_delay,omitempty"`
-	// / Whether this channel is advertised to the network or not
-	Private bool `protobuf:"varint,17,opt,name=private" json:"private,omitempty"`
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
 }
 
-func (m *Channel) Reset()                    { *m = Channel{} }
-func (m *Channel) String() string            { return proto.CompactTextString(m) }
-func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
-
-func (m *Channel) GetActive() bool {
+func (m *Account) GetMasterKeyFingerprint() []byte {
 	if m != nil {
-		return m.Active
+		return m.MasterKeyFingerprint
 	}
-	return false
+	return nil
 }
 
-func (m *Channel) GetRemotePubkey() string {
+func (m *Account) GetDerivationPath() string {
 	if m != nil {
-		return m.RemotePubkey
+		return m.DerivationPath
 	}
 	return ""
 }
 
-func (m *Channel) GetChannelPoint() string {
+func (m *Account) GetBirthdayHeight() uint32 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.BirthdayHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *Channel) GetChanId() uint64 {
+func (m *Account) GetSyncedHeight() uint32 {
 	if m != nil {
-		return m.ChanId
+		return m.SyncedHeight
 	}
 	return 0
 }
 
-func (m *Channel) GetCapacity() int64 {
+func (m *Account) GetExternalKeyCount() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.ExternalKeyCount
 	}
 	return 0
 }
 
-func (m *Channel) GetLocalBalance() int64 {
+func (m *Account) GetInternalKeyCount() uint32 {
 	if m != nil {
-		return m.LocalBalance
+		return m.InternalKeyCount
 	}
 	return 0
 }
 
-func (m *Channel) GetRemoteBalance() int64 {
+func (m *Account) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.RemoteBalance
+		return m.ConfirmedBalance
 	}
 	return 0
 }
 
-func (m *Channel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
-	}
-	return 0
+type ImportAccountRequest struct {
+	// / The name to import the account under.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// / The BIP32 extended public key of the account, e.g. an xpub or tpub.
+	ExtendedPublicKey string `protobuf:"bytes,2,opt,name=extended_public_key" json:"extended_public_key,omitempty"`
+	// / The type of the account's addresses.
+	AddressType NewAddressRequest_AddressType `protobuf:"varint,3,opt,name=address_type,enum=lnrpc.NewAddressRequest_AddressType" json:"address_type,omitempty"`
+	// *
+	// The 4-byte fingerprint of the master key the account was derived from. If
+	// neither the fingerprint nor the derivation path are set, the account key
+	// is treated as the master key within PSBTs.
+	MasterKeyFingerprint []byte `protobuf:"bytes,4,opt,name=master_key_fingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	// / The derivation path of the account key, e.g. m/84'/0'/0'.
+	DerivationPath string `protobuf:"bytes,5,opt,name=derivation_path" json:"derivation_path,omitempty"`
+	// / The height from which to scan the chain for the account's transactions.
+	BirthdayHeight uint32 `protobuf:"varint,6,opt,name=birthday_height" json:"birthday_height,omitempty"`
 }
 
-func (m *Channel) GetCommitWeight() int64 {
+func (m *ImportAccountRequest) Reset()                    { *m = ImportAccountRequest{} }
+func (m *ImportAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportAccountRequest) ProtoMessage()               {}
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ImportAccountRequest) GetName() string {
 	if m != nil {
-		return m.CommitWeight
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Channel) GetFeePerKw() int64 {
+func (m *ImportAccountRequest) GetExtendedPublicKey() string {
 	if m != nil {
-		return m.FeePerKw
+		return m.ExtendedPublicKey
 	}
-	return 0
+	return ""
 }
 
-func (m *Channel) GetUnsettledBalance() int64 {
+func (m *ImportAccountRequest) GetAddressType() NewAddressRequest_AddressType {
 	if m != nil {
-		return m.UnsettledBalance
+		return m.AddressType
 	}
-	return 0
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
 }
 
-func (m *Channel) GetTotalSatoshisSent() int64 {
+func (m *ImportAccountRequest) GetMasterKeyFingerprint() []byte {
 	if m != nil {
-		return m.TotalSatoshisSent
+		return m.MasterKeyFingerprint
 	}
-	return 0
+	return nil
 }
 
-func (m *Channel) GetTotalSatoshisReceived() int64 {
+func (m *ImportAccountRequest) GetDerivationPath() string {
 	if m != nil {
-		return m.TotalSatoshisReceived
+		return m.DerivationPath
 	}
-	return 0
+	return ""
 }
 
-func (m *Channel) GetNumUpdates() uint64 {
+func (m *ImportAccountRequest) GetBirthdayHeight() uint32 {
 	if m != nil {
-		return m.NumUpdates
+		return m.BirthdayHeight
 	}
 	return 0
 }
 
-func (m *Channel) GetPendingHtlcs() []*HTLC {
+type ImportAccountResponse struct {
+	// / The imported account.
+	Account *Account `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+}
+
+func (m *ImportAccountResponse) Reset()                    { *m = ImportAccountResponse{} }
+func (m *ImportAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImportAccountResponse) ProtoMessage()               {}
+func (*ImportAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ImportAccountResponse) GetAccount() *Account {
 	if m != nil {
-		return m.PendingHtlcs
+		return m.Account
 	}
 	return nil
 }
 
-func (m *Channel) GetCsvDelay() uint32 {
-	if m != nil {
-		return m.CsvDelay
-	}
-	return 0
+type ListAccountsRequest struct {
 }
 
-func (m *Channel) GetPrivate() bool {
+func (m *ListAccountsRequest) Reset()                    { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()               {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type ListAccountsResponse struct {
+	// / The watch-only accounts.
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+}
+
+func (m *ListAccountsResponse) Reset()                    { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()               {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
 	if m != nil {
-		return m.Private
+		return m.Accounts
 	}
-	return false
+	return nil
 }
 
-type ListChannelsRequest struct {
-	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly" json:"active_only,omitempty"`
-	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly" json:"inactive_only,omitempty"`
-	PublicOnly   bool `protobuf:"varint,3,opt,name=public_only,json=publicOnly" json:"public_only,omitempty"`
-	PrivateOnly  bool `protobuf:"varint,4,opt,name=private_only,json=privateOnly" json:"private_only,omitempty"`
+type FundPsbtRequest struct {
+	// / The name of the watch-only account to spend from.
+	Account string `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+	// / The map from addresses to amounts to pay to them.
+	AddrToAmount map[string]int64 `protobuf:"bytes,2,rep,name=addr_to_amount" json:"addr_to_amount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// / The target number of blocks that the transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that the transaction should pay.
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations the spent outputs must have.
+	MinConfs int32 `protobuf:"varint,5,opt,name=min_confs" json:"min_confs,omitempty"`
 }
 
-func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
-func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListChannelsRequest) ProtoMessage()               {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (m *FundPsbtRequest) Reset()                    { *m = FundPsbtRequest{} }
+func (m *FundPsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*FundPsbtRequest) ProtoMessage()               {}
+func (*FundPsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
-func (m *ListChannelsRequest) GetActiveOnly() bool {
+func (m *FundPsbtRequest) GetAccount() string {
 	if m != nil {
-		return m.ActiveOnly
+		return m.Account
 	}
-	return false
+	return ""
 }
 
-func (m *ListChannelsRequest) GetInactiveOnly() bool {
+func (m *FundPsbtRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
-		return m.InactiveOnly
+		return m.AddrToAmount
 	}
-	return false
+	return nil
 }
 
-func (m *ListChannelsRequest) GetPublicOnly() bool {
+func (m *FundPsbtRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.PublicOnly
+		return m.TargetConf
 	}
-	return false
+	return 0
 }
 
-func (m *ListChannelsRequest) GetPrivateOnly() bool {
+func (m *FundPsbtRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.PrivateOnly
+		return m.SatPerByte
 	}
-	return false
+	return 0
 }
 
-type ListChannelsResponse struct {
-	// / The list of active channels
-	Channels []*Channel `protobuf:"bytes,11,rep,name=channels" json:"channels,omitempty"`
+func (m *FundPsbtRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
 }
 
-func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
-func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListChannelsResponse) ProtoMessage()               {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+type FundPsbtResponse struct {
+	// / The serialized unsigned PSBT.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	// / The index of the change output, or -1 if there's none.
+	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index" json:"change_output_index,omitempty"`
+}
 
-func (m *ListChannelsResponse) GetChannels() []*Channel {
+func (m *FundPsbtResponse) Reset()                    { *m = FundPsbtResponse{} }
+func (m *FundPsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*FundPsbtResponse) ProtoMessage()               {}
+func (*FundPsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *FundPsbtResponse) GetFundedPsbt() []byte {
 	if m != nil {
-		return m.Channels
+		return m.FundedPsbt
 	}
 	return nil
 }
 
-type ChannelCloseSummary struct {
-	// / The outpoint (txid:index) of the funding transaction.
-	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
-	// /  The unique channel ID for the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
-	// / The hash of the genesis block that this channel resides within.
-	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash" json:"chain_hash,omitempty"`
-	// / The txid of the transaction which ultimately closed this channel.
-	ClosingTxHash string `protobuf:"bytes,4,opt,name=closing_tx_hash" json:"closing_tx_hash,omitempty"`
-	// / Public key of the remote peer that we formerly had a channel with.
-	RemotePubkey string `protobuf:"bytes,5,opt,name=remote_pubkey" json:"remote_pubkey,omitempty"`
-	// / Total capacity of the channel.
-	Capacity int64 `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
-	// / Height at which the funding transaction was spent.
-	CloseHeight uint32 `protobuf:"varint,7,opt,name=close_height" json:"close_height,omitempty"`
-	// / Settled balance at the time of channel closure
-	SettledBalance int64 `protobuf:"varint,8,opt,name=settled_balance" json:"settled_balance,omitempty"`
-	// / The sum of all the time-locked outputs at the time of channel closure
-	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance" json:"time_locked_balance,omitempty"`
-	// / Details on how the channel was closed.
-	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+func (m *FundPsbtResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
+	}
+	return 0
 }
 
-func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
-func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
-func (*ChannelCloseSummary) ProtoMessage()               {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+type BumpFeeRequest struct {
+	// / The wallet controlled output of the transaction to bump the fee of.
+	Outpoint *OutPoint `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The target number of blocks that the child transaction should be confirmed by.
+	TargetConf uint32 `protobuf:"varint,2,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that the child transaction should pay.
+	SatPerByte uint32 `protobuf:"varint,3,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+}
 
-func (m *ChannelCloseSummary) GetChannelPoint() string {
+func (m *BumpFeeRequest) Reset()                    { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()               {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *BumpFeeRequest) GetOutpoint() *OutPoint {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Outpoint
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelCloseSummary) GetChanId() uint64 {
+func (m *BumpFeeRequest) GetTargetConf() uint32 {
 	if m != nil {
-		return m.ChanId
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *ChannelCloseSummary) GetChainHash() string {
+func (m *BumpFeeRequest) GetSatPerByte() uint32 {
 	if m != nil {
-		return m.ChainHash
+		return m.SatPerByte
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelCloseSummary) GetClosingTxHash() string {
-	if m != nil {
-		return m.ClosingTxHash
-	}
-	return ""
+type BumpFeeResponse struct {
 }
 
-func (m *ChannelCloseSummary) GetRemotePubkey() string {
+func (m *BumpFeeResponse) Reset()                    { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()               {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+type SendCoinsRequest struct {
+	// / The address to send coins to
+	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	// / The amount in satoshis to send
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	// / The target number of blocks that this transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// *
+	// An optional set of wallet outputs to spend. If set, exactly these outputs
+	// are spent, and any remainder is sent to a change address. Otherwise, the
+	// inputs are selected automatically.
+	Outpoints []*OutPoint `protobuf:"bytes,6,rep,name=outpoints" json:"outpoints,omitempty"`
+	// *
+	// If set, all confirmed wallet outputs that aren't locked are sent to the
+	// address, minus the fee. The amount and outpoints must not be set.
+	SendAll bool `protobuf:"varint,7,opt,name=send_all" json:"send_all,omitempty"`
+}
+
+func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
+func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendCoinsRequest) ProtoMessage()               {}
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *SendCoinsRequest) GetAddr() string {
 	if m != nil {
-		return m.RemotePubkey
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *ChannelCloseSummary) GetCapacity() int64 {
+func (m *SendCoinsRequest) GetAmount() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *ChannelCloseSummary) GetCloseHeight() uint32 {
+func (m *SendCoinsRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.CloseHeight
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *ChannelCloseSummary) GetSettledBalance() int64 {
+func (m *SendCoinsRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.SettledBalance
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *ChannelCloseSummary) GetTimeLockedBalance() int64 {
+func (m *SendCoinsRequest) GetOutpoints() []*OutPoint {
 	if m != nil {
-		return m.TimeLockedBalance
+		return m.Outpoints
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
+func (m *SendCoinsRequest) GetSendAll() bool {
 	if m != nil {
-		return m.CloseType
+		return m.SendAll
 	}
-	return ChannelCloseSummary_COOPERATIVE_CLOSE
+	return false
 }
 
-type ClosedChannelsRequest struct {
-	Cooperative     bool `protobuf:"varint,1,opt,name=cooperative" json:"cooperative,omitempty"`
-	LocalForce      bool `protobuf:"varint,2,opt,name=local_force,json=localForce" json:"local_force,omitempty"`
-	RemoteForce     bool `protobuf:"varint,3,opt,name=remote_force,json=remoteForce" json:"remote_force,omitempty"`
-	Breach          bool `protobuf:"varint,4,opt,name=breach" json:"breach,omitempty"`
-	FundingCanceled bool `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled" json:"funding_canceled,omitempty"`
-	Abandoned       bool `protobuf:"varint,6,opt,name=abandoned" json:"abandoned,omitempty"`
+type SendCoinsResponse struct {
+	// / The transaction ID of the transaction
+	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+	// / The amount sent to the address in satoshis
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
 }
 
-func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
-func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ClosedChannelsRequest) ProtoMessage()               {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
+func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendCoinsResponse) ProtoMessage()               {}
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
-func (m *ClosedChannelsRequest) GetCooperative() bool {
+func (m *SendCoinsResponse) GetTxid() string {
 	if m != nil {
-		return m.Cooperative
+		return m.Txid
 	}
-	return false
+	return ""
 }
 
-func (m *ClosedChannelsRequest) GetLocalForce() bool {
+func (m *SendCoinsResponse) GetAmount() int64 {
 	if m != nil {
-		return m.LocalForce
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetRemoteForce() bool {
-	if m != nil {
-		return m.RemoteForce
-	}
-	return false
+// *
+// `AddressType` has to be one of:
+//
+// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
+// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
+type NewAddressRequest struct {
+	// / The address type
+	Type NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+	// *
+	// The name of the watch-only account to derive the address from. If set,
+	// the address type of the account is used.
+	Account string `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
 }
 
-func (m *ClosedChannelsRequest) GetBreach() bool {
+func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
+func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewAddressRequest) ProtoMessage()               {}
+func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
 	if m != nil {
-		return m.Breach
+		return m.Type
 	}
-	return false
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
 }
 
-func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
+func (m *NewAddressRequest) GetAccount() string {
 	if m != nil {
-		return m.FundingCanceled
+		return m.Account
 	}
-	return false
+	return ""
 }
 
-func (m *ClosedChannelsRequest) GetAbandoned() bool {
+type NewAddressResponse struct {
+	// / The newly generated wallet address
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
+func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewAddressResponse) ProtoMessage()               {}
+func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
-		return m.Abandoned
+		return m.Address
 	}
-	return false
+	return ""
 }
 
-type ClosedChannelsResponse struct {
-	Channels []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+type SignMessageRequest struct {
+	// / The message to be signed
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
-func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ClosedChannelsResponse) ProtoMessage()               {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageRequest) ProtoMessage()               {}
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
-func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
+func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
-		return m.Channels
+		return m.Msg
 	}
 	return nil
 }
 
-type Peer struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
-	// / Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
-	// / Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
-	// / Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
-	// / Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
-	// / A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
-	// / Ping time to this peer
-	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+type SignMessageResponse struct {
+	// / The signature for the given message
+	Signature string `protobuf:"bytes,1,opt,name=signature" json:"signature,omitempty"`
 }
 
-func (m *Peer) Reset()                    { *m = Peer{} }
-func (m *Peer) String() string            { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageResponse) ProtoMessage()               {}
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
-func (m *Peer) GetPubKey() string {
+func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
-		return m.PubKey
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *Peer) GetAddress() string {
+type VerifyMessageRequest struct {
+	// / The message over which the signature is to be verified
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// / The signature to be verified over the given message
+	Signature string `protobuf:"bytes,2,opt,name=signature" json:"signature,omitempty"`
+}
+
+func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
+func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyMessageRequest) ProtoMessage()               {}
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
-		return m.Address
+		return m.Msg
 	}
-	return ""
+	return nil
 }
 
-func (m *Peer) GetBytesSent() uint64 {
+func (m *VerifyMessageRequest) GetSignature() string {
 	if m != nil {
-		return m.BytesSent
+		return m.Signature
 	}
-	return 0
+	return ""
 }
 
-func (m *Peer) GetBytesRecv() uint64 {
+type VerifyMessageResponse struct {
+	// / Whether the signature was valid over the given message
+	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
+	// / The pubkey recovered from the signature
+	Pubkey string `protobuf:"bytes,2,opt,name=pubkey" json:"pubkey,omitempty"`
+}
+
+func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
+func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyMessageResponse) ProtoMessage()               {}
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
-		return m.BytesRecv
+		return m.Valid
 	}
-	return 0
+	return false
 }
 
-func (m *Peer) GetSatSent() int64 {
+func (m *VerifyMessageResponse) GetPubkey() string {
 	if m != nil {
-		return m.SatSent
+		return m.Pubkey
 	}
-	return 0
+	return ""
 }
 
-func (m *Peer) GetSatRecv() int64 {
+type ConnectPeerRequest struct {
+	// / Lightning address of the peer, in the format `<pubkey>@host`
+	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	// * If set, the daemon will attempt to persistently connect to the target
+	// peer.  Otherwise, the call will be synchronous.
+	Perm bool `protobuf:"varint,2,opt,name=perm" json:"perm,omitempty"`
+}
+
+func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
+func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConnectPeerRequest) ProtoMessage()               {}
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
-		return m.SatRecv
+		return m.Addr
 	}
-	return 0
+	return nil
 }
 
-func (m *Peer) GetInbound() bool {
+func (m *ConnectPeerRequest) GetPerm() bool {
 	if m != nil {
-		return m.Inbound
+		return m.Perm
 	}
 	return false
 }
 
-func (m *Peer) GetPingTime() int64 {
+type ConnectPeerResponse struct {
+}
+
+func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
+func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConnectPeerResponse) ProtoMessage()               {}
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+type DisconnectPeerRequest struct {
+	// / The pubkey of the node to disconnect from
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+}
+
+func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()               {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
-		return m.PingTime
+		return m.PubKey
 	}
-	return 0
+	return ""
 }
 
-type ListPeersRequest struct {
+type DisconnectPeerResponse struct {
 }
 
-func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
-func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersRequest) ProtoMessage()               {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
+func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
+func (*DisconnectPeerResponse) ProtoMessage()               {}
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
-type ListPeersResponse struct {
-	// / The list of currently connected peers
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+type HTLC struct {
+	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
+	Amount           int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	HashLock         []byte `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
+	ExpirationHeight uint32 `protobuf:"varint,4,opt,name=expiration_height" json:"expiration_height,omitempty"`
 }
 
-func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
-func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersResponse) ProtoMessage()               {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (m *HTLC) Reset()                    { *m = HTLC{} }
+func (m *HTLC) String() string            { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()               {}
+func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
-func (m *ListPeersResponse) GetPeers() []*Peer {
+func (m *HTLC) GetIncoming() bool {
 	if m != nil {
-		return m.Peers
+		return m.Incoming
 	}
-	return nil
+	return false
 }
 
-type GetInfoRequest struct {
+func (m *HTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()               {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
-
-type GetInfoResponse struct {
-	// / The identity pubkey of the current node.
-	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
-	// / If applicable, the alias of the current node, e.g. "bob"
-	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
-	// / Number of pending channels
-	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
-	// / Number of active channels
-	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
-	// / Number of peers
-	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
-	// / The node's current view of the height of the best block
-	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
-	// / The node's current view of the hash of the best block
-	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
-	// / Whether the wallet's view is synced to the main chain
-	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
-	// / Whether the current node is connected to testnet
-	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
-	// / A list of active chains the node is connected to
-	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
-	// / The URIs of the current node.
-	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
-	// / Timestamp of the block best known to the wallet
-	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
-	// / The version of the LND software that the node is running.
-	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+func (m *HTLC) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
 }
 
-func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
-func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoResponse) ProtoMessage()               {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (m *HTLC) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
 
-func (m *GetInfoResponse) GetIdentityPubkey() string {
+type Channel struct {
+	// / Whether this channel is active or not
+	Active bool `protobuf:"varint,1,opt,name=active" json:"active,omitempty"`
+	// / The identity pubkey of the remote node
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey" json:"remote_pubkey,omitempty"`
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point" json:"channel_point,omitempty"`
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The total amount of funds held in this channel
+	Capacity int64 `protobuf:"varint,5,opt,name=capacity" json:"capacity,omitempty"`
+	// / This node's current balance in this channel
+	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance" json:"local_balance,omitempty"`
+	// / The counterparty's current balance in this channel
+	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance" json:"remote_balance,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of commitment
+	// transactions. The fee amount is persisted with the channel in order to
+	// allow the fee amount to be removed and recalculated with each channel state
+	// update, including updates that happen after a system restart.
+	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will pay
+	// at all times, for both the funding transaction and commitment transaction.
+	// This value can later be updated once the channel is open.
+	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// / The unsettled balance in this channel
+	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance" json:"unsettled_balance,omitempty"`
+	// *
+	// The total number of satoshis we've sent within this channel.
+	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent" json:"total_satoshis_sent,omitempty"`
+	// *
+	// The total number of satoshis we've received within this channel.
+	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received" json:"total_satoshis_received,omitempty"`
+	// *
+	// The total number of updates conducted within this channel.
+	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates" json:"num_updates,omitempty"`
+	// *
+	// The list of active, uncleared HTLCs currently pending within the channel.
+	PendingHtlcs []*HTLC `protobuf:"bytes,15,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
+	// *
+	// The CSV delay expressed in relative blocks. If the channel is force
+	// closed, we'll need to wait for this many blocks before we can regain our
+	// funds.
+	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / Whether this channel is advertised to the network or not
+	Private bool `protobuf:"varint,17,opt,name=private" json:"private,omitempty"`
+}
+
+func (m *Channel) Reset()                    { *m = Channel{} }
+func (m *Channel) String() string            { return proto.CompactTextString(m) }
+func (*Channel) ProtoMessage()               {}
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *Channel) GetActive() bool {
 	if m != nil {
-		return m.IdentityPubkey
+		return m.Active
 	}
-	return ""
+	return false
 }
 
-func (m *GetInfoResponse) GetAlias() string {
+func (m *Channel) GetRemotePubkey() string {
 	if m != nil {
-		return m.Alias
+		return m.RemotePubkey
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+func (m *Channel) GetChannelPoint() string {
 	if m != nil {
-		return m.NumPendingChannels
+		return m.ChannelPoint
 	}
-	return 0
+	return ""
 }
 
-func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+func (m *Channel) GetChanId() uint64 {
 	if m != nil {
-		return m.NumActiveChannels
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumPeers() uint32 {
+func (m *Channel) GetCapacity() int64 {
 	if m != nil {
-		return m.NumPeers
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHeight() uint32 {
+func (m *Channel) GetLocalBalance() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.LocalBalance
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHash() string {
+func (m *Channel) GetRemoteBalance() int64 {
 	if m != nil {
-		return m.BlockHash
+		return m.RemoteBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *GetInfoResponse) GetSyncedToChain() bool {
+func (m *Channel) GetCommitFee() int64 {
 	if m != nil {
-		return m.SyncedToChain
+		return m.CommitFee
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetTestnet() bool {
+func (m *Channel) GetCommitWeight() int64 {
 	if m != nil {
-		return m.Testnet
+		return m.CommitWeight
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetChains() []string {
+func (m *Channel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.Chains
+		return m.FeePerKw
 	}
-	return nil
+	return 0
 }
 
-func (m *GetInfoResponse) GetUris() []string {
+func (m *Channel) GetUnsettledBalance() int64 {
 	if m != nil {
-		return m.Uris
+		return m.UnsettledBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+func (m *Channel) GetTotalSatoshisSent() int64 {
 	if m != nil {
-		return m.BestHeaderTimestamp
+		return m.TotalSatoshisSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetVersion() string {
+func (m *Channel) GetTotalSatoshisReceived() int64 {
 	if m != nil {
-		return m.Version
+		return m.TotalSatoshisReceived
 	}
-	return ""
+	return 0
 }
 
-type ConfirmationUpdate struct {
-	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
-	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+func (m *Channel) GetNumUpdates() uint64 {
+	if m != nil {
+		return m.NumUpdates
+	}
+	return 0
 }
 
-func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()               {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
-
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
+func (m *Channel) GetPendingHtlcs() []*HTLC {
 	if m != nil {
-		return m.BlockSha
+		return m.PendingHtlcs
 	}
 	return nil
 }
 
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+func (m *Channel) GetCsvDelay() uint32 {
 	if m != nil {
-		return m.BlockHeight
+		return m.CsvDelay
 	}
 	return 0
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+func (m *Channel) GetPrivate() bool {
 	if m != nil {
-		return m.NumConfsLeft
+		return m.Private
 	}
-	return 0
+	return false
 }
 
-type ChannelOpenUpdate struct {
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+type ListChannelsRequest struct {
+	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly" json:"active_only,omitempty"`
+	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly" json:"inactive_only,omitempty"`
+	PublicOnly   bool `protobuf:"varint,3,opt,name=public_only,json=publicOnly" json:"public_only,omitempty"`
+	PrivateOnly  bool `protobuf:"varint,4,opt,name=private_only,json=privateOnly" json:"private_only,omitempty"`
 }
 
-func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()               {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
+func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListChannelsRequest) ProtoMessage()               {}
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
-		return m.ChannelPoint
+		return m.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
+func (m *ListChannelsRequest) GetInactiveOnly() bool {
+	if m != nil {
+		return m.InactiveOnly
+	}
+	return false
 }
 
-func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()               {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
-
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+func (m *ListChannelsRequest) GetPublicOnly() bool {
 	if m != nil {
-		return m.ClosingTxid
+		return m.PublicOnly
 	}
-	return nil
+	return false
 }
 
-func (m *ChannelCloseUpdate) GetSuccess() bool {
+func (m *ListChannelsRequest) GetPrivateOnly() bool {
 	if m != nil {
-		return m.Success
+		return m.PrivateOnly
 	}
 	return false
 }
 
-type CloseChannelRequest struct {
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
-	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
-	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+type ListChannelsResponse struct {
+	// / The list of active channels
+	Channels []*Channel `protobuf:"bytes,11,rep,name=channels" json:"channels,omitempty"`
 }
 
-func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
-func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseChannelRequest) ProtoMessage()               {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
-
-func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
+func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListChannelsResponse) ProtoMessage()               {}
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *ListChannelsResponse) GetChannels() []*Channel {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Channels
 	}
 	return nil
 }
 
-func (m *CloseChannelRequest) GetForce() bool {
+type ChannelCloseSummary struct {
+	// / The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// /  The unique channel ID for the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The hash of the genesis block that this channel resides within.
+	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash" json:"chain_hash,omitempty"`
+	// / The txid of the transaction which ultimately closed this channel.
+	ClosingTxHash string `protobuf:"bytes,4,opt,name=closing_tx_hash" json:"closing_tx_hash,omitempty"`
+	// / Public key of the remote peer that we formerly had a channel with.
+	RemotePubkey string `protobuf:"bytes,5,opt,name=remote_pubkey" json:"remote_pubkey,omitempty"`
+	// / Total capacity of the channel.
+	Capacity int64 `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
+	// / Height at which the funding transaction was spent.
+	CloseHeight uint32 `protobuf:"varint,7,opt,name=close_height" json:"close_height,omitempty"`
+	// / Settled balance at the time of channel closure
+	SettledBalance int64 `protobuf:"varint,8,opt,name=settled_balance" json:"settled_balance,omitempty"`
+	// / The sum of all the time-locked outputs at the time of channel closure
+	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance" json:"time_locked_balance,omitempty"`
+	// / Details on how the channel was closed.
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+}
+
+func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
+func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCloseSummary) ProtoMessage()               {}
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
-		return m.Force
+		return m.ChannelPoint
 	}
-	return false
+	return ""
 }
 
-func (m *CloseChannelRequest) GetTargetConf() int32 {
+func (m *ChannelCloseSummary) GetChanId() uint64 {
 	if m != nil {
-		return m.TargetConf
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *CloseChannelRequest) GetSatPerByte() int64 {
+func (m *ChannelCloseSummary) GetChainHash() string {
 	if m != nil {
-		return m.SatPerByte
+		return m.ChainHash
 	}
-	return 0
+	return ""
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*CloseStatusUpdate_ClosePending
-	//	*CloseStatusUpdate_Confirmation
-	//	*CloseStatusUpdate_ChanClose
-	Update isCloseStatusUpdate_Update `protobuf_oneof:"update"`
+func (m *ChannelCloseSummary) GetClosingTxHash() string {
+	if m != nil {
+		return m.ClosingTxHash
+	}
+	return ""
 }
 
-func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
-func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
-func (*CloseStatusUpdate) ProtoMessage()               {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
-
-type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
-
-type CloseStatusUpdate_ClosePending struct {
-	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,oneof"`
+func (m *ChannelCloseSummary) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
 }
-type CloseStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
+
+func (m *ChannelCloseSummary) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
-type CloseStatusUpdate_ChanClose struct {
-	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,oneof"`
+
+func (m *ChannelCloseSummary) GetCloseHeight() uint32 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
 }
 
-func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
-func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
-func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update()    {}
+func (m *ChannelCloseSummary) GetSettledBalance() int64 {
+	if m != nil {
+		return m.SettledBalance
+	}
+	return 0
+}
 
-func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
+func (m *ChannelCloseSummary) GetTimeLockedBalance() int64 {
 	if m != nil {
-		return m.Update
+		return m.TimeLockedBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
-		return x.ClosePending
+func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
+	if m != nil {
+		return m.CloseType
 	}
-	return nil
+	return ChannelCloseSummary_COOPERATIVE_CLOSE
 }
 
-func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
-		return x.Confirmation
+type ClosedChannelsRequest struct {
+	Cooperative     bool `protobuf:"varint,1,opt,name=cooperative" json:"cooperative,omitempty"`
+	LocalForce      bool `protobuf:"varint,2,opt,name=local_force,json=localForce" json:"local_force,omitempty"`
+	RemoteForce     bool `protobuf:"varint,3,opt,name=remote_force,json=remoteForce" json:"remote_force,omitempty"`
+	Breach          bool `protobuf:"varint,4,opt,name=breach" json:"breach,omitempty"`
+	FundingCanceled bool `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled" json:"funding_canceled,omitempty"`
+	Abandoned       bool `protobuf:"varint,6,opt,name=abandoned" json:"abandoned,omitempty"`
+}
+
+func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
+func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ClosedChannelsRequest) ProtoMessage()               {}
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ClosedChannelsRequest) GetCooperative() bool {
+	if m != nil {
+		return m.Cooperative
 	}
-	return nil
+	return false
 }
 
-func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
-		return x.ChanClose
+func (m *ClosedChannelsRequest) GetLocalForce() bool {
+	if m != nil {
+		return m.LocalForce
 	}
-	return nil
+	return false
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
-		(*CloseStatusUpdate_ClosePending)(nil),
-		(*CloseStatusUpdate_Confirmation)(nil),
-		(*CloseStatusUpdate_ChanClose)(nil),
+func (m *ClosedChannelsRequest) GetRemoteForce() bool {
+	if m != nil {
+		return m.RemoteForce
 	}
+	return false
 }
 
-func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClosePending); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_ChanClose:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanClose); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+func (m *ClosedChannelsRequest) GetBreach() bool {
+	if m != nil {
+		return m.Breach
 	}
-	return nil
+	return false
 }
 
-func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CloseStatusUpdate)
-	switch tag {
-	case 1: // update.close_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ClosePending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_close
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelCloseUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ChanClose{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
+	if m != nil {
+		return m.FundingCanceled
 	}
+	return false
 }
 
-func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		s := proto.Size(x.ClosePending)
-		n += proto.SizeVarint(1<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_ChanClose:
-		s := proto.Size(x.ChanClose)
-		n += proto.SizeVarint(3<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *ClosedChannelsRequest) GetAbandoned() bool {
+	if m != nil {
+		return m.Abandoned
 	}
-	return n
+	return false
 }
 
-type PendingUpdate struct {
-	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index" json:"output_index,omitempty"`
+type ClosedChannelsResponse struct {
+	Channels []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
 }
 
-func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
-func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
-func (*PendingUpdate) ProtoMessage()               {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
+func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ClosedChannelsResponse) ProtoMessage()               {}
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
-func (m *PendingUpdate) GetTxid() []byte {
+func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	if m != nil {
-		return m.Txid
+		return m.Channels
 	}
 	return nil
 }
 
-func (m *PendingUpdate) GetOutputIndex() uint32 {
-	if m != nil {
-		return m.OutputIndex
-	}
-	return 0
-}
-
-type OpenChannelRequest struct {
-	// / The pubkey of the node to open a channel with
-	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
-	// / The hex encoded pubkey of the node to open a channel with
-	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
-	// / The number of satoshis the wallet should commit to the channel
-	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
-	// / The number of satoshis to push to the remote side as part of the initial commitment state
-	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
-	// / Whether this channel should be private, not announced to the greater network.
-	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
-	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
-	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
-	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
-	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
-	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
+type Peer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	// / Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
+	// / Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
+	// / Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
+	// / Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
+	// / A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
+	// / Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
 }
 
-func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
-func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*OpenChannelRequest) ProtoMessage()               {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (m *Peer) Reset()                    { *m = Peer{} }
+func (m *Peer) String() string            { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()               {}
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
-func (m *OpenChannelRequest) GetNodePubkey() []byte {
+func (m *Peer) GetPubKey() string {
 	if m != nil {
-		return m.NodePubkey
+		return m.PubKey
 	}
-	return nil
+	return ""
 }
 
-func (m *OpenChannelRequest) GetNodePubkeyString() string {
+func (m *Peer) GetAddress() string {
 	if m != nil {
-		return m.NodePubkeyString
+		return m.Address
 	}
 	return ""
 }
 
-func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+func (m *Peer) GetBytesSent() uint64 {
 	if m != nil {
-		return m.LocalFundingAmount
+		return m.BytesSent
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPushSat() int64 {
+func (m *Peer) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.PushSat
+		return m.BytesRecv
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetTargetConf() int32 {
+func (m *Peer) GetSatSent() int64 {
 	if m != nil {
-		return m.TargetConf
+		return m.SatSent
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSatPerByte() int64 {
+func (m *Peer) GetSatRecv() int64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.SatRecv
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPrivate() bool {
+func (m *Peer) GetInbound() bool {
 	if m != nil {
-		return m.Private
+		return m.Inbound
 	}
 	return false
 }
 
-func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+func (m *Peer) GetPingTime() int64 {
 	if m != nil {
-		return m.MinHtlcMsat
+		return m.PingTime
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
-	if m != nil {
-		return m.RemoteCsvDelay
-	}
-	return 0
+type ListPeersRequest struct {
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
+func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersRequest) ProtoMessage()               {}
+func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+type ListPeersResponse struct {
+	// / The list of currently connected peers
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
+func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersResponse) ProtoMessage()               {}
+func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *ListPeersResponse) GetPeers() []*Peer {
 	if m != nil {
-		return m.MinConfs
+		return m.Peers
 	}
-	return 0
+	return nil
 }
 
-type OpenStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_Confirmation
-	//	*OpenStatusUpdate_ChanOpen
-	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+type GetInfoRequest struct {
 }
 
-func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
-func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
-func (*OpenStatusUpdate) ProtoMessage()               {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()               {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
-type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
+type GetInfoResponse struct {
+	// / The identity pubkey of the current node.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
+	// / If applicable, the alias of the current node, e.g. "bob"
+	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
+	// / Number of pending channels
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
+	// / Number of active channels
+	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
+	// / Number of peers
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
+	// / The node's current view of the height of the best block
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
+	// / The node's current view of the hash of the best block
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
+	// / Whether the wallet's view is synced to the main chain
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
+	// / Whether the current node is connected to testnet
+	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
+	// / A list of active chains the node is connected to
+	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
+	// / The URIs of the current node.
+	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
+	// / Timestamp of the block best known to the wallet
+	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
+	// / The version of the LND software that the node is running.
+	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+}
 
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
+func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()               {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *GetInfoResponse) GetIdentityPubkey() string {
+	if m != nil {
+		return m.IdentityPubkey
+	}
+	return ""
 }
-type OpenStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
+
+func (m *GetInfoResponse) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
 }
-type OpenStatusUpdate_ChanOpen struct {
-	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,oneof"`
+
+func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+	if m != nil {
+		return m.NumPendingChannels
+	}
+	return 0
 }
 
-func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update()  {}
-func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
-func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update()     {}
+func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+	if m != nil {
+		return m.NumActiveChannels
+	}
+	return 0
+}
 
-func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+func (m *GetInfoResponse) GetNumPeers() uint32 {
 	if m != nil {
-		return m.Update
+		return m.NumPeers
 	}
-	return nil
+	return 0
 }
 
-func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
-		return x.ChanPending
+func (m *GetInfoResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
-		return x.Confirmation
+func (m *GetInfoResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
-		return x.ChanOpen
+func (m *GetInfoResponse) GetSyncedToChain() bool {
+	if m != nil {
+		return m.SyncedToChain
 	}
-	return nil
+	return false
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
-		(*OpenStatusUpdate_ChanPending)(nil),
-		(*OpenStatusUpdate_Confirmation)(nil),
-		(*OpenStatusUpdate_ChanOpen)(nil),
+func (m *GetInfoResponse) GetTestnet() bool {
+	if m != nil {
+		return m.Testnet
 	}
+	return false
 }
 
-func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPending); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_ChanOpen:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanOpen); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+func (m *GetInfoResponse) GetChains() []string {
+	if m != nil {
+		return m.Chains
 	}
 	return nil
 }
 
-func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*OpenStatusUpdate)
-	switch tag {
-	case 1: // update.chan_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanPending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_open
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelOpenUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanOpen{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		s := proto.Size(x.ChanPending)
-		n += proto.SizeVarint(1<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_ChanOpen:
-		s := proto.Size(x.ChanOpen)
-		n += proto.SizeVarint(3<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type PendingHTLC struct {
-	// / The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
-	// / The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	// / The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint" json:"outpoint,omitempty"`
-	// / The next block height at which we can spend the current stage
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
-	// *
-	// The number of blocks remaining until the current stage can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
-	// / Indicates whether the htlc is in its first or second stage of recovery
-	Stage uint32 `protobuf:"varint,6,opt,name=stage" json:"stage,omitempty"`
-}
-
-func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()               {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
-
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *GetInfoResponse) GetUris() []string {
 	if m != nil {
-		return m.Incoming
+		return m.Uris
 	}
-	return false
+	return nil
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
 	if m != nil {
-		return m.Amount
+		return m.BestHeaderTimestamp
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *GetInfoResponse) GetVersion() string {
 	if m != nil {
-		return m.Outpoint
+		return m.Version
 	}
 	return ""
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
+type ConfirmationUpdate struct {
+	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+}
+
+func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()               {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
 	if m != nil {
-		return m.MaturityHeight
+		return m.BlockSha
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
 	if m != nil {
-		return m.Stage
+		return m.NumConfsLeft
 	}
 	return 0
 }
 
-type PendingChannelsRequest struct {
-}
-
-func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()               {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
-
-type PendingChannelsResponse struct {
-	// / The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance" json:"total_limbo_balance,omitempty"`
-	// / Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels" json:"pending_open_channels,omitempty"`
-	// / Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels" json:"pending_closing_channels,omitempty"`
-	// / Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels" json:"pending_force_closing_channels,omitempty"`
-	// / Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels" json:"waiting_close_channels,omitempty"`
+type ChannelOpenUpdate struct {
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
 }
 
-func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()               {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
-
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
-	if m != nil {
-		return m.TotalLimboBalance
-	}
-	return 0
-}
+func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()               {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.PendingOpenChannels
+		return m.ChannelPoint
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
-	if m != nil {
-		return m.PendingClosingChannels
-	}
-	return nil
+type ChannelCloseUpdate struct {
+	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
 }
 
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()               {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
-		return m.PendingForceClosingChannels
+		return m.ClosingTxid
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+func (m *ChannelCloseUpdate) GetSuccess() bool {
 	if m != nil {
-		return m.WaitingCloseChannels
+		return m.Success
 	}
-	return nil
+	return false
 }
 
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
-	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
-	Capacity      int64  `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
-	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance" json:"local_balance,omitempty"`
-	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance" json:"remote_balance,omitempty"`
+type CloseChannelRequest struct {
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
+	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
+	// / The target number of blocks that the closure transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / The number of blocks the cooperative closure transaction may remain unconfirmed before its fee is bumped. If not set, the default of the node is used.
+	BumpAfterBlocks uint32 `protobuf:"varint,5,opt,name=bump_after_blocks,json=bumpAfterBlocks" json:"bump_after_blocks,omitempty"`
+	// *
+	// If true, the fee of the already broadcast cooperative closure transaction
+	// is bumped to the fee rate determined by target_conf or sat_per_byte. The
+	// closing fee is re-negotiated if the peer is online and the closure
+	// transaction signals replaceability, otherwise our output of the closure
+	// transaction is spent by a child paying a higher fee (CPFP).
+	BumpFee bool `protobuf:"varint,6,opt,name=bump_fee,json=bumpFee" json:"bump_fee,omitempty"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 0}
-}
+func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()               {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.RemoteNodePub
+		return m.ChannelPoint
 	}
-	return ""
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+func (m *CloseChannelRequest) GetForce() bool {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Force
 	}
-	return ""
+	return false
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+func (m *CloseChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Capacity
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.LocalBalance
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+func (m *CloseChannelRequest) GetBumpAfterBlocks() uint32 {
 	if m != nil {
-		return m.RemoteBalance
+		return m.BumpAfterBlocks
 	}
 	return 0
 }
 
-type PendingChannelsResponse_PendingOpenChannel struct {
-	// / The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height" json:"confirmation_height,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of
-	// commitment transactions. The fee amount is persisted with the channel
-	// in order to allow the fee amount to be removed and recalculated with
-	// each channel state update, including updates that happen after a system
-	// restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will
-	// pay at all times, for both the funding transaction and commitment
-	// transaction. This value can later be updated once the channel is open.
-	FeePerKw int64 `protobuf:"varint,6,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+func (m *CloseChannelRequest) GetBumpFee() bool {
+	if m != nil {
+		return m.BumpFee
+	}
+	return false
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
+type CloseStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*CloseStatusUpdate_ClosePending
+	//	*CloseStatusUpdate_Confirmation
+	//	*CloseStatusUpdate_ChanClose
+	Update isCloseStatusUpdate_Update `protobuf_oneof:"update"`
 }
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 1}
+
+func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
+func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
+func (*CloseStatusUpdate) ProtoMessage()               {}
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
+
+type CloseStatusUpdate_ClosePending struct {
+	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,oneof"`
+}
+type CloseStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
+}
+type CloseStatusUpdate_ChanClose struct {
+	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,oneof"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
+func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
+func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update()    {}
+
+func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
 	if m != nil {
-		return m.Channel
+		return m.Update
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
-	if m != nil {
-		return m.ConfirmationHeight
+func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
+		return x.ClosePending
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
+func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
+		return x.Confirmation
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
-	if m != nil {
-		return m.CommitWeight
+func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
+		return x.ChanClose
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
-	if m != nil {
-		return m.FeePerKw
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
+		(*CloseStatusUpdate_ClosePending)(nil),
+		(*CloseStatusUpdate_Confirmation)(nil),
+		(*CloseStatusUpdate_ChanClose)(nil),
 	}
-	return 0
 }
 
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	// / The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The balance in satoshis encumbered in this channel
-	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
+func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosePending); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_ChanClose:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanClose); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
+func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CloseStatusUpdate)
+	switch tag {
+	case 1: // update.close_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ClosePending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_close
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ChanClose{msg}
+		return true, err
+	default:
+		return false, nil
+	}
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
+
+func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		s := proto.Size(x.ClosePending)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_ChanClose:
+		s := proto.Size(x.ChanClose)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
 }
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 2}
+
+type PendingUpdate struct {
+	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index" json:"output_index,omitempty"`
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()               {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
-		return m.Channel
+		return m.Txid
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+func (m *PendingUpdate) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.LimboBalance
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type PendingChannelsResponse_ClosedChannel struct {
-	// / The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
+type ChannelAcceptRequest struct {
+	// / The pubkey of the node that wishes to open an inbound channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hash of the genesis block that the proposed channel resides in.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The pending channel id.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The funding amount in satoshis that initiator wishes to use in the channel.
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt" json:"funding_amt,omitempty"`
+	// / The push amount of the proposed channel in millisatoshis.
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt" json:"push_amt,omitempty"`
+	// / The dust limit of the initiator's commitment tx.
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit" json:"dust_limit,omitempty"`
+	// / The maximum amount of coins in millisatoshis that can be pending in this channel.
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight" json:"max_value_in_flight,omitempty"`
+	// / The minimum amount of satoshis the initiator requires us to have at all times.
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve" json:"channel_reserve,omitempty"`
+	// / The smallest HTLC in millisatoshis that the initiator will accept.
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	// / The initial fee rate that the initiator suggests for both commitment transactions.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// *
+	// The number of blocks to use for the relative time lock in the pay-to-self
+	// output of both commitment transactions.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The total number of incoming HTLC's that the initiator will accept.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// *
+	// A bit-field which the initiator uses to specify proposed channel
+	// behavior.
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags" json:"channel_flags,omitempty"`
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 3}
-}
+func (m *ChannelAcceptRequest) Reset()                    { *m = ChannelAcceptRequest{} }
+func (m *ChannelAcceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptRequest) ProtoMessage()               {}
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *ChannelAcceptRequest) GetNodePubkey() []byte {
 	if m != nil {
-		return m.Channel
+		return m.NodePubkey
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+func (m *ChannelAcceptRequest) GetChainHash() []byte {
 	if m != nil {
-		return m.ClosingTxid
+		return m.ChainHash
 	}
-	return ""
+	return nil
 }
 
-type PendingChannelsResponse_ForceClosedChannel struct {
-	// / The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
-	// / The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
-	// / The height at which funds can be sweeped into the wallet
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
-	//
-	// Remaining # of blocks until the commitment output can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
-	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance" json:"recovered_balance,omitempty"`
-	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
+func (m *ChannelAcceptRequest) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
+func (m *ChannelAcceptRequest) GetFundingAmt() uint64 {
+	if m != nil {
+		return m.FundingAmt
+	}
+	return 0
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
+
+func (m *ChannelAcceptRequest) GetPushAmt() uint64 {
+	if m != nil {
+		return m.PushAmt
+	}
+	return 0
 }
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 4}
+
+func (m *ChannelAcceptRequest) GetDustLimit() uint64 {
+	if m != nil {
+		return m.DustLimit
+	}
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
 	if m != nil {
-		return m.Channel
+		return m.MaxValueInFlight
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+func (m *ChannelAcceptRequest) GetChannelReserve() uint64 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.ChannelReserve
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+func (m *ChannelAcceptRequest) GetMinHtlc() uint64 {
 	if m != nil {
-		return m.LimboBalance
+		return m.MinHtlc
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+func (m *ChannelAcceptRequest) GetFeePerKw() uint64 {
 	if m != nil {
-		return m.MaturityHeight
+		return m.FeePerKw
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+func (m *ChannelAcceptRequest) GetCsvDelay() uint32 {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.CsvDelay
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+func (m *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
 	if m != nil {
-		return m.RecoveredBalance
+		return m.MaxAcceptedHtlcs
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
 	if m != nil {
-		return m.PendingHtlcs
+		return m.ChannelFlags
 	}
-	return nil
+	return 0
 }
 
-type WalletBalanceRequest struct {
+type ChannelAcceptResponse struct {
+	// / Whether or not the client accepts the channel.
+	Accept bool `protobuf:"varint,1,opt,name=accept" json:"accept,omitempty"`
+	// / The pending channel id to which this response applies.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// An optional reason for rejecting the channel, which is sent to the peer
+	// that requested it. It must not contain any sensitive information.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (m *ChannelAcceptResponse) Reset()                    { *m = ChannelAcceptResponse{} }
+func (m *ChannelAcceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptResponse) ProtoMessage()               {}
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
-}
-
-func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
-
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *ChannelAcceptResponse) GetAccept() bool {
 	if m != nil {
-		return m.TotalBalance
+		return m.Accept
 	}
-	return 0
+	return false
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.PendingChanId
 	}
-	return 0
+	return nil
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+func (m *ChannelAcceptResponse) GetError() string {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type ChannelBalanceRequest struct {
+type OpenChannelRequest struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hex encoded pubkey of the node to open a channel with
+	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
+	// *
+	// If set, the funding transaction won't be funded by the internal wallet.
+	// Instead, the funding flow is paused once the funding output is known, and a
+	// psbt_fund update is sent that contains the address and amount the funding
+	// transaction must pay to. The flow resumes once the signed transaction is
+	// handed over using the FinalizeFunding call.
+	PsbtFunding bool `protobuf:"varint,12,opt,name=psbt_funding" json:"psbt_funding,omitempty"`
+	// *
+	// An optional set of wallet outputs to fund the channel with. If set, all of
+	// these outputs are spent by the funding transaction, and any remainder is
+	// sent to a change address. Otherwise, coin selection is performed
+	// automatically.
+	Outpoints []*OutPoint `protobuf:"bytes,13,rep,name=outpoints" json:"outpoints,omitempty"`
 }
 
-func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
+func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenChannelRequest) ProtoMessage()               {}
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
-type ChannelBalanceResponse struct {
-	// / Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance" json:"balance,omitempty"`
-	// / Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance int64 `protobuf:"varint,2,opt,name=pending_open_balance" json:"pending_open_balance,omitempty"`
+func (m *OpenChannelRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
 }
 
-func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (m *OpenChannelRequest) GetNodePubkeyString() string {
+	if m != nil {
+		return m.NodePubkeyString
+	}
+	return ""
+}
 
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
 	if m != nil {
-		return m.Balance
+		return m.LocalFundingAmount
 	}
 	return 0
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *OpenChannelRequest) GetPushSat() int64 {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.PushSat
 	}
 	return 0
 }
 
-type QueryRoutesRequest struct {
-	// / The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
-	// / The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt" json:"amt,omitempty"`
-	// / The max number of routes to return.
-	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes" json:"num_routes,omitempty"`
-	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta" json:"final_cltv_delta,omitempty"`
-	// *
-	// The maximum number of satoshis that will be paid as a fee of the payment.
-	// This value can be represented either as a percentage of the amount being
-	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	// send the payment.
-	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+func (m *OpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
 }
 
-func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
-
-func (m *QueryRoutesRequest) GetPubKey() string {
+func (m *OpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.SatPerByte
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *OpenChannelRequest) GetPrivate() bool {
 	if m != nil {
-		return m.Amt
+		return m.Private
 	}
-	return 0
+	return false
 }
 
-func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
 	if m != nil {
-		return m.NumRoutes
+		return m.MinHtlcMsat
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
 	if m != nil {
-		return m.FinalCltvDelta
+		return m.RemoteCsvDelay
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+func (m *OpenChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.FeeLimit
+		return m.MinConfs
 	}
-	return nil
+	return 0
 }
 
-type QueryRoutesResponse struct {
-	Routes []*Route `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty"`
+func (m *OpenChannelRequest) GetPsbtFunding() bool {
+	if m != nil {
+		return m.PsbtFunding
+	}
+	return false
 }
 
-func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
-
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *OpenChannelRequest) GetOutpoints() []*OutPoint {
 	if m != nil {
-		return m.Routes
+		return m.Outpoints
 	}
 	return nil
 }
 
-type Hop struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward" json:"amt_to_forward,omitempty"`
-	Fee              int64  `protobuf:"varint,4,opt,name=fee" json:"fee,omitempty"`
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat" json:"fee_msat,omitempty"`
+type BatchOpenChannel struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
 }
 
-func (m *Hop) Reset()                    { *m = Hop{} }
-func (m *Hop) String() string            { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
-
-func (m *Hop) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
-	}
-	return 0
-}
+func (m *BatchOpenChannel) Reset()                    { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()               {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
-func (m *Hop) GetChanCapacity() int64 {
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
 	if m != nil {
-		return m.ChanCapacity
+		return m.NodePubkey
 	}
-	return 0
+	return nil
 }
 
-func (m *Hop) GetAmtToForward() int64 {
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
 	if m != nil {
-		return m.AmtToForward
+		return m.LocalFundingAmount
 	}
 	return 0
 }
 
-func (m *Hop) GetFee() int64 {
+func (m *BatchOpenChannel) GetPushSat() int64 {
 	if m != nil {
-		return m.Fee
+		return m.PushSat
 	}
 	return 0
 }
 
-func (m *Hop) GetExpiry() uint32 {
+func (m *BatchOpenChannel) GetPrivate() bool {
 	if m != nil {
-		return m.Expiry
+		return m.Private
 	}
-	return 0
+	return false
 }
 
-func (m *Hop) GetAmtToForwardMsat() int64 {
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
 	if m != nil {
-		return m.AmtToForwardMsat
+		return m.MinHtlcMsat
 	}
 	return 0
 }
 
-func (m *Hop) GetFeeMsat() int64 {
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
 	if m != nil {
-		return m.FeeMsat
+		return m.RemoteCsvDelay
 	}
 	return 0
 }
 
-// *
-// A path through the channel graph which runs over one or more channels in
-// succession. This struct carries all the information required to craft the
-// Sphinx onion packet, and send the payment along the first hop in the path. A
-// route is only selected as valid if all the channels have sufficient capacity to
-// carry the initial payment amount after fees are accounted for.
-type Route struct {
-	// *
-	// The cumulative (final) time lock across the entire route.  This is the CLTV
-	// value that should be extended to the first hop in the route. All other hops
-	// will decrement the time-lock as advertised, leaving enough time for all
-	// hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock" json:"total_time_lock,omitempty"`
-	// *
-	// The sum of the fees paid at each hop within the final route.  In the case
-	// of a one-hop payment, this value will be zero as we don't need to pay a fee
-	// it ourself.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees" json:"total_fees,omitempty"`
-	// *
-	// The total amount of funds required to complete a payment over this route.
-	// This value includes the cumulative fees at each hop. As a result, the HTLC
-	// extended to the first-hop in the route will need to have at least this many
-	// satoshis, otherwise the route will fail at an intermediate node due to an
-	// insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt" json:"total_amt,omitempty"`
-	// *
-	// Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops" json:"hops,omitempty"`
-	// *
-	// The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat" json:"total_fees_msat,omitempty"`
+type BatchOpenChannelRequest struct {
+	// / The channels to open, each with a different peer.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,4,opt,name=min_confs" json:"min_confs,omitempty"`
 	// *
-	// The total amount in millisatoshis.
-	TotalAmtMsat int64 `protobuf:"varint,6,opt,name=total_amt_msat" json:"total_amt_msat,omitempty"`
+	// An optional set of wallet outputs to fund the channels with. If set, all of
+	// these outputs are spent by the funding transaction, and any remainder is
+	// sent to a change address. Otherwise, coin selection is performed
+	// automatically.
+	Outpoints []*OutPoint `protobuf:"bytes,5,rep,name=outpoints" json:"outpoints,omitempty"`
 }
 
-func (m *Route) Reset()                    { *m = Route{} }
-func (m *Route) String() string            { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (m *BatchOpenChannelRequest) Reset()                    { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()               {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
-func (m *Route) GetTotalTimeLock() uint32 {
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
 	if m != nil {
-		return m.TotalTimeLock
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *Route) GetTotalFees() int64 {
+func (m *BatchOpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.TotalFees
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmt() int64 {
+func (m *BatchOpenChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.TotalAmt
+		return m.MinConfs
 	}
 	return 0
 }
 
-func (m *Route) GetHops() []*Hop {
+func (m *BatchOpenChannelRequest) GetOutpoints() []*OutPoint {
 	if m != nil {
-		return m.Hops
+		return m.Outpoints
 	}
 	return nil
 }
 
-func (m *Route) GetTotalFeesMsat() int64 {
+type BatchOpenChannelResponse struct {
+	// / The pending channels of the batch, in the order they were requested.
+	PendingChannels []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels" json:"pending_channels,omitempty"`
+}
+
+func (m *BatchOpenChannelResponse) Reset()                    { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()               {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.PendingChannels
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+type SpliceChannelRequest struct {
+	// / The outpoint (txid:index) of the funding transaction of the channel to splice.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The number of satoshis the wallet should splice into the channel.
+	SpliceInAmt int64 `protobuf:"varint,2,opt,name=splice_in_amt" json:"splice_in_amt,omitempty"`
+	// / The number of satoshis to splice out of the channel. Only one of splice_in_amt and splice_out_amt may be set.
+	SpliceOutAmt int64 `protobuf:"varint,3,opt,name=splice_out_amt" json:"splice_out_amt,omitempty"`
+	// / The address the funds spliced out of the channel are sent to. If not set, a new wallet address is used.
+	Addr string `protobuf:"bytes,4,opt,name=addr" json:"addr,omitempty"`
+	// / The target number of blocks that the splice transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,5,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the splice transaction.
+	SatPerByte int64 `protobuf:"varint,6,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs spliced into the channel must satisfy.
+	MinConfs int32 `protobuf:"varint,7,opt,name=min_confs" json:"min_confs,omitempty"`
+}
+
+func (m *SpliceChannelRequest) Reset()                    { *m = SpliceChannelRequest{} }
+func (m *SpliceChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SpliceChannelRequest) ProtoMessage()               {}
+func (*SpliceChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *SpliceChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-type NodeInfoRequest struct {
-	// / The 33-byte hex-encoded compressed public of the target node
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
-}
-
-func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
-func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
-
-func (m *NodeInfoRequest) GetPubKey() string {
+func (m *SpliceChannelRequest) GetSpliceInAmt() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.SpliceInAmt
 	}
-	return ""
-}
-
-type NodeInfo struct {
-	// *
-	// An individual vertex/node within the channel graph. A node is
-	// connected to other nodes by one or more channel edges emanating from it. As
-	// the graph is directed, a node will also have an incoming edge attached to
-	// it for each outgoing edge.
-	Node          *LightningNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
-	NumChannels   uint32         `protobuf:"varint,2,opt,name=num_channels" json:"num_channels,omitempty"`
-	TotalCapacity int64          `protobuf:"varint,3,opt,name=total_capacity" json:"total_capacity,omitempty"`
+	return 0
 }
 
-func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
-func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
-
-func (m *NodeInfo) GetNode() *LightningNode {
+func (m *SpliceChannelRequest) GetSpliceOutAmt() int64 {
 	if m != nil {
-		return m.Node
+		return m.SpliceOutAmt
 	}
-	return nil
+	return 0
 }
 
-func (m *NodeInfo) GetNumChannels() uint32 {
+func (m *SpliceChannelRequest) GetAddr() string {
 	if m != nil {
-		return m.NumChannels
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *NodeInfo) GetTotalCapacity() int64 {
+func (m *SpliceChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.TotalCapacity
+		return m.TargetConf
 	}
 	return 0
 }
 
-// *
-// An individual vertex/node within the channel graph. A node is
-// connected to other nodes by one or more channel edges emanating from it. As the
-// graph is directed, a node will also have an incoming edge attached to it for
-// each outgoing edge.
-type LightningNode struct {
-	LastUpdate uint32         `protobuf:"varint,1,opt,name=last_update" json:"last_update,omitempty"`
-	PubKey     string         `protobuf:"bytes,2,opt,name=pub_key" json:"pub_key,omitempty"`
-	Alias      string         `protobuf:"bytes,3,opt,name=alias" json:"alias,omitempty"`
-	Addresses  []*NodeAddress `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
-	Color      string         `protobuf:"bytes,5,opt,name=color" json:"color,omitempty"`
-}
-
-func (m *LightningNode) Reset()                    { *m = LightningNode{} }
-func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
-func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
-
-func (m *LightningNode) GetLastUpdate() uint32 {
+func (m *SpliceChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.LastUpdate
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *LightningNode) GetPubKey() string {
+func (m *SpliceChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.PubKey
+		return m.MinConfs
 	}
-	return ""
+	return 0
 }
 
-func (m *LightningNode) GetAlias() string {
-	if m != nil {
-		return m.Alias
-	}
-	return ""
+type SpliceChannelResponse struct {
+	// / The new channel point of the channel once the splice transaction confirms.
+	NewChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=new_channel_point" json:"new_channel_point,omitempty"`
 }
 
-func (m *LightningNode) GetAddresses() []*NodeAddress {
-	if m != nil {
-		return m.Addresses
-	}
-	return nil
-}
+func (m *SpliceChannelResponse) Reset()                    { *m = SpliceChannelResponse{} }
+func (m *SpliceChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SpliceChannelResponse) ProtoMessage()               {}
+func (*SpliceChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
-func (m *LightningNode) GetColor() string {
+func (m *SpliceChannelResponse) GetNewChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.Color
+		return m.NewChannelPoint
 	}
-	return ""
+	return nil
 }
 
-type NodeAddress struct {
-	Network string `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
-	Addr    string `protobuf:"bytes,2,opt,name=addr" json:"addr,omitempty"`
+type ReadyForPsbtFunding struct {
+	// / The P2WSH address of the channel funding output.
+	FundingAddress string `protobuf:"bytes,1,opt,name=funding_address" json:"funding_address,omitempty"`
+	// / The exact amount in satoshis that must be paid to the funding output.
+	FundingAmount int64 `protobuf:"varint,2,opt,name=funding_amount" json:"funding_amount,omitempty"`
+	// / The pending channel ID to pass to FinalizeFunding.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 }
 
-func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
-func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
-func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
-
-func (m *NodeAddress) GetNetwork() string {
-	if m != nil {
-		return m.Network
-	}
-	return ""
-}
+func (m *ReadyForPsbtFunding) Reset()                    { *m = ReadyForPsbtFunding{} }
+func (m *ReadyForPsbtFunding) String() string            { return proto.CompactTextString(m) }
+func (*ReadyForPsbtFunding) ProtoMessage()               {}
+func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
-func (m *NodeAddress) GetAddr() string {
+func (m *ReadyForPsbtFunding) GetFundingAddress() string {
 	if m != nil {
-		return m.Addr
+		return m.FundingAddress
 	}
 	return ""
 }
 
-type RoutingPolicy struct {
-	TimeLockDelta    uint32 `protobuf:"varint,1,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
-	MinHtlc          int64  `protobuf:"varint,2,opt,name=min_htlc" json:"min_htlc,omitempty"`
-	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat" json:"fee_rate_milli_msat,omitempty"`
-	Disabled         bool   `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
-}
-
-func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
-func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
-func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
-
-func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
+func (m *ReadyForPsbtFunding) GetFundingAmount() int64 {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.FundingAmount
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetMinHtlc() int64 {
+func (m *ReadyForPsbtFunding) GetPendingChanId() []byte {
 	if m != nil {
-		return m.MinHtlc
+		return m.PendingChanId
 	}
-	return 0
+	return nil
 }
 
-func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
-	if m != nil {
-		return m.FeeBaseMsat
-	}
-	return 0
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_Confirmation
+	//	*OpenStatusUpdate_ChanOpen
+	//	*OpenStatusUpdate_PsbtFund
+	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
 }
 
-func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
-	if m != nil {
-		return m.FeeRateMilliMsat
-	}
-	return 0
-}
+func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
+func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
+func (*OpenStatusUpdate) ProtoMessage()               {}
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
-func (m *RoutingPolicy) GetDisabled() bool {
-	if m != nil {
-		return m.Disabled
-	}
-	return false
-}
+type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
 
-// *
-// A fully authenticated channel along with all its unique attributes.
-// Once an authenticated channel announcement has been processed on the network,
-// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
-// stored. The other portions relevant to routing policy of a channel are stored
-// within a ChannelEdgePolicy for each direction of the channel.
-type ChannelEdge struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChannelId   uint64         `protobuf:"varint,1,opt,name=channel_id" json:"channel_id,omitempty"`
-	ChanPoint   string         `protobuf:"bytes,2,opt,name=chan_point" json:"chan_point,omitempty"`
-	LastUpdate  uint32         `protobuf:"varint,3,opt,name=last_update" json:"last_update,omitempty"`
-	Node1Pub    string         `protobuf:"bytes,4,opt,name=node1_pub" json:"node1_pub,omitempty"`
-	Node2Pub    string         `protobuf:"bytes,5,opt,name=node2_pub" json:"node2_pub,omitempty"`
-	Capacity    int64          `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
-	Node1Policy *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy" json:"node1_policy,omitempty"`
-	Node2Policy *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy" json:"node2_policy,omitempty"`
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
 }
-
-func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
-func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
-func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
-
-func (m *ChannelEdge) GetChannelId() uint64 {
-	if m != nil {
-		return m.ChannelId
-	}
-	return 0
+type OpenStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
 }
-
-func (m *ChannelEdge) GetChanPoint() string {
-	if m != nil {
-		return m.ChanPoint
-	}
-	return ""
+type OpenStatusUpdate_ChanOpen struct {
+	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,oneof"`
 }
-
-func (m *ChannelEdge) GetLastUpdate() uint32 {
-	if m != nil {
-		return m.LastUpdate
-	}
-	return 0
+type OpenStatusUpdate_PsbtFund struct {
+	PsbtFund *ReadyForPsbtFunding `protobuf:"bytes,4,opt,name=psbt_fund,oneof"`
 }
 
-func (m *ChannelEdge) GetNode1Pub() string {
-	if m != nil {
-		return m.Node1Pub
-	}
-	return ""
-}
+func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update()  {}
+func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
+func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update()     {}
+func (*OpenStatusUpdate_PsbtFund) isOpenStatusUpdate_Update()     {}
 
-func (m *ChannelEdge) GetNode2Pub() string {
+func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
 	if m != nil {
-		return m.Node2Pub
+		return m.Update
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelEdge) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
+func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
+		return x.ChanPending
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
-	if m != nil {
-		return m.Node1Policy
+func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
+		return x.Confirmation
 	}
 	return nil
 }
 
-func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
-	if m != nil {
-		return m.Node2Policy
+func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
+		return x.ChanOpen
 	}
 	return nil
 }
 
-type ChannelGraphRequest struct {
-}
-
-func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
-func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
-
-// / Returns a new instance of the directed channel graph.
-type ChannelGraph struct {
-	// / The list of `LightningNode`s in this channel graph
-	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
-	// / The list of `ChannelEdge`s in this channel graph
-	Edges []*ChannelEdge `protobuf:"bytes,2,rep,name=edges" json:"edges,omitempty"`
-}
-
-func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
-func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
-func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
-
-func (m *ChannelGraph) GetNodes() []*LightningNode {
-	if m != nil {
-		return m.Nodes
+func (m *OpenStatusUpdate) GetPsbtFund() *ReadyForPsbtFunding {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_PsbtFund); ok {
+		return x.PsbtFund
 	}
 	return nil
 }
 
-func (m *ChannelGraph) GetEdges() []*ChannelEdge {
-	if m != nil {
-		return m.Edges
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
+		(*OpenStatusUpdate_ChanPending)(nil),
+		(*OpenStatusUpdate_Confirmation)(nil),
+		(*OpenStatusUpdate_ChanOpen)(nil),
+		(*OpenStatusUpdate_PsbtFund)(nil),
 	}
-	return nil
 }
 
-type ChanInfoRequest struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPending); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_ChanOpen:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanOpen); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_PsbtFund:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFund); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
 }
 
-func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
-func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
-
-func (m *ChanInfoRequest) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
+func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OpenStatusUpdate)
+	switch tag {
+	case 1: // update.chan_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanPending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelOpenUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanOpen{msg}
+		return true, err
+	case 4: // update.psbt_fund
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReadyForPsbtFunding)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_PsbtFund{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return 0
 }
 
-type NetworkInfoRequest struct {
+func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		s := proto.Size(x.ChanPending)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_ChanOpen:
+		s := proto.Size(x.ChanOpen)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_PsbtFund:
+		s := proto.Size(x.PsbtFund)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
 }
 
-func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
-func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
-
-type NetworkInfo struct {
-	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree" json:"max_out_degree,omitempty"`
-	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes" json:"num_nodes,omitempty"`
-	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size" json:"max_channel_size,omitempty"`
+type FinalizeFundingRequest struct {
+	// / The pending channel ID of the channel, as returned in the psbt_fund update.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// A finalized PSBT that pays to the funding output. Exactly one of
+	// signed_psbt and final_raw_tx must be set.
+	SignedPsbt []byte `protobuf:"bytes,2,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// / The fully signed raw funding transaction.
+	FinalRawTx []byte `protobuf:"bytes,3,opt,name=final_raw_tx,proto3" json:"final_raw_tx,omitempty"`
 }
 
-func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (m *FinalizeFundingRequest) Reset()                    { *m = FinalizeFundingRequest{} }
+func (m *FinalizeFundingRequest) String() string            { return proto.CompactTextString(m) }
+func (*FinalizeFundingRequest) ProtoMessage()               {}
+func (*FinalizeFundingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
-func (m *NetworkInfo) GetGraphDiameter() uint32 {
+func (m *FinalizeFundingRequest) GetPendingChanId() []byte {
 	if m != nil {
-		return m.GraphDiameter
+		return m.PendingChanId
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkInfo) GetAvgOutDegree() float64 {
+func (m *FinalizeFundingRequest) GetSignedPsbt() []byte {
 	if m != nil {
-		return m.AvgOutDegree
+		return m.SignedPsbt
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+func (m *FinalizeFundingRequest) GetFinalRawTx() []byte {
 	if m != nil {
-		return m.MaxOutDegree
+		return m.FinalRawTx
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkInfo) GetNumNodes() uint32 {
+type FinalizeFundingResponse struct {
+	// / The txid of the funding transaction.
+	FundingTxid string `protobuf:"bytes,1,opt,name=funding_txid" json:"funding_txid,omitempty"`
+}
+
+func (m *FinalizeFundingResponse) Reset()                    { *m = FinalizeFundingResponse{} }
+func (m *FinalizeFundingResponse) String() string            { return proto.CompactTextString(m) }
+func (*FinalizeFundingResponse) ProtoMessage()               {}
+func (*FinalizeFundingResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *FinalizeFundingResponse) GetFundingTxid() string {
 	if m != nil {
-		return m.NumNodes
+		return m.FundingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetNumChannels() uint32 {
+type PendingHTLC struct {
+	// / The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
+	// / The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	// / The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The next block height at which we can spend the current stage
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	// *
+	// The number of blocks remaining until the current stage can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / Indicates whether the htlc is in its first or second stage of recovery
+	Stage uint32 `protobuf:"varint,6,opt,name=stage" json:"stage,omitempty"`
+}
+
+func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()               {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
-		return m.NumChannels
+		return m.Incoming
 	}
-	return 0
+	return false
 }
 
-func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+func (m *PendingHTLC) GetAmount() int64 {
 	if m != nil {
-		return m.TotalNetworkCapacity
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetAvgChannelSize() float64 {
+func (m *PendingHTLC) GetOutpoint() string {
 	if m != nil {
-		return m.AvgChannelSize
+		return m.Outpoint
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetMinChannelSize() int64 {
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.MinChannelSize
+		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxChannelSize() int64 {
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.MaxChannelSize
+		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-type StopRequest struct {
+func (m *PendingHTLC) GetStage() uint32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
 }
 
-func (m *StopRequest) Reset()                    { *m = StopRequest{} }
-func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
-func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
-
-type StopResponse struct {
+type PendingChannelsRequest struct {
 }
 
-func (m *StopResponse) Reset()                    { *m = StopResponse{} }
-func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
-func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()               {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
-type GraphTopologySubscription struct {
+type PendingChannelsResponse struct {
+	// / The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance" json:"total_limbo_balance,omitempty"`
+	// / Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels" json:"pending_open_channels,omitempty"`
+	// / Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels" json:"pending_closing_channels,omitempty"`
+	// / Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels" json:"pending_force_closing_channels,omitempty"`
+	// / Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels" json:"waiting_close_channels,omitempty"`
 }
 
-func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
-func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
-func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()               {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
-type GraphTopologyUpdate struct {
-	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
-	ChannelUpdates []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates" json:"channel_updates,omitempty"`
-	ClosedChans    []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans" json:"closed_chans,omitempty"`
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
 }
 
-func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
-func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
-func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
-
-func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
 	if m != nil {
-		return m.NodeUpdates
+		return m.PendingOpenChannels
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
 	if m != nil {
-		return m.ChannelUpdates
+		return m.PendingClosingChannels
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
 	if m != nil {
-		return m.ClosedChans
+		return m.PendingForceClosingChannels
 	}
 	return nil
 }
 
-type NodeUpdate struct {
-	Addresses      []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
-	IdentityKey    string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey" json:"identity_key,omitempty"`
-	GlobalFeatures []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
-	Alias          string   `protobuf:"bytes,4,opt,name=alias" json:"alias,omitempty"`
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+	if m != nil {
+		return m.WaitingCloseChannels
+	}
+	return nil
 }
 
-func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
-func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
-func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
+	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
+	Capacity      int64  `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
+	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance" json:"local_balance,omitempty"`
+	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance" json:"remote_balance,omitempty"`
+}
 
-func (m *NodeUpdate) GetAddresses() []string {
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{82, 0}
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
 	if m != nil {
-		return m.Addresses
+		return m.RemoteNodePub
 	}
-	return nil
+	return ""
 }
 
-func (m *NodeUpdate) GetIdentityKey() string {
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
 	if m != nil {
-		return m.IdentityKey
+		return m.ChannelPoint
 	}
 	return ""
 }
 
-func (m *NodeUpdate) GetGlobalFeatures() []byte {
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
 	if m != nil {
-		return m.GlobalFeatures
+		return m.Capacity
 	}
-	return nil
+	return 0
 }
 
-func (m *NodeUpdate) GetAlias() string {
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
 	if m != nil {
-		return m.Alias
+		return m.LocalBalance
 	}
-	return ""
+	return 0
 }
 
-type ChannelEdgeUpdate struct {
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return 0
+}
+
+type PendingChannelsResponse_PendingOpenChannel struct {
+	// / The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The height at which this channel will be confirmed
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height" json:"confirmation_height,omitempty"`
 	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId          uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
-	ChanPoint       *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
-	Capacity        int64          `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
-	RoutingPolicy   *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy" json:"routing_policy,omitempty"`
-	AdvertisingNode string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode" json:"advertising_node,omitempty"`
-	ConnectingNode  string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode" json:"connecting_node,omitempty"`
+	// The amount calculated to be paid in fees for the current set of
+	// commitment transactions. The fee amount is persisted with the channel
+	// in order to allow the fee amount to be removed and recalculated with
+	// each channel state update, including updates that happen after a system
+	// restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will
+	// pay at all times, for both the funding transaction and commitment
+	// transaction. This value can later be updated once the channel is open.
+	FeePerKw int64 `protobuf:"varint,6,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
 }
 
-func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
-func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{82, 1}
+}
 
-func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.ChanId
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
 	if m != nil {
-		return m.ChanPoint
+		return m.ConfirmationHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.CommitFee
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
 	if m != nil {
-		return m.RoutingPolicy
+		return m.CommitWeight
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.AdvertisingNode
+		return m.FeePerKw
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	// / The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The balance in satoshis encumbered in this channel
+	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
+	// / The transaction id of the cooperative closing transaction, if any
+	ClosingTxid string `protobuf:"bytes,3,opt,name=closing_txid" json:"closing_txid,omitempty"`
+	// / The height at which the cooperative closing transaction was broadcast
+	BroadcastHeight uint32 `protobuf:"varint,4,opt,name=broadcast_height" json:"broadcast_height,omitempty"`
+	// / The height at which the fee of the cooperative closing transaction is bumped if it hasn't confirmed by then
+	FeeBumpHeight uint32 `protobuf:"varint,5,opt,name=fee_bump_height" json:"fee_bump_height,omitempty"`
+	// / The number of times the closing fee has been re-negotiated with the peer
+	NumFeeRenegotiations uint32 `protobuf:"varint,6,opt,name=num_fee_renegotiations" json:"num_fee_renegotiations,omitempty"`
+	// / Whether the fee of the closing transaction is being bumped by spending our output in a child transaction (CPFP)
+	Cpfp bool `protobuf:"varint,7,opt,name=cpfp" json:"cpfp,omitempty"`
+}
+
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{82, 2}
+}
+
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.ConnectingNode
+		return m.Channel
 	}
-	return ""
+	return nil
 }
 
-type ClosedChannelUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId       uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
-	Capacity     int64         `protobuf:"varint,2,opt,name=capacity" json:"capacity,omitempty"`
-	ClosedHeight uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight" json:"closed_height,omitempty"`
-	ChanPoint    *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+	if m != nil {
+		return m.LimboBalance
+	}
+	return 0
 }
 
-func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
-func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetClosingTxid() string {
+	if m != nil {
+		return m.ClosingTxid
+	}
+	return ""
+}
 
-func (m *ClosedChannelUpdate) GetChanId() uint64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetBroadcastHeight() uint32 {
 	if m != nil {
-		return m.ChanId
+		return m.BroadcastHeight
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetCapacity() int64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetFeeBumpHeight() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.FeeBumpHeight
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetNumFeeRenegotiations() uint32 {
 	if m != nil {
-		return m.ClosedHeight
+		return m.NumFeeRenegotiations
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetCpfp() bool {
 	if m != nil {
-		return m.ChanPoint
+		return m.Cpfp
 	}
-	return nil
+	return false
 }
 
-type HopHint struct {
-	// / The public key of the node at the start of the channel.
-	NodeId string `protobuf:"bytes,1,opt,name=node_id" json:"node_id,omitempty"`
-	// / The unique identifier of the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
-	// / The base fee of the channel denominated in millisatoshis.
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
-	// *
-	// The fee rate of the channel for sending one satoshi across it denominated in
-	// millionths of a satoshi.
-	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths" json:"fee_proportional_millionths,omitempty"`
-	// / The time-lock delta of the channel.
-	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta" json:"cltv_expiry_delta,omitempty"`
+type PendingChannelsResponse_ClosedChannel struct {
+	// / The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
 }
 
-func (m *HopHint) Reset()                    { *m = HopHint{} }
-func (m *HopHint) String() string            { return proto.CompactTextString(m) }
-func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{82, 3}
+}
 
-func (m *HopHint) GetNodeId() string {
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.NodeId
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+	if m != nil {
+		return m.ClosingTxid
 	}
 	return ""
 }
 
-func (m *HopHint) GetChanId() uint64 {
+type PendingChannelsResponse_ForceClosedChannel struct {
+	// / The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
+	// / The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
+	// / The height at which funds can be sweeped into the wallet
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	//
+	// Remaining # of blocks until the commitment output can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / The total value of funds successfully recovered from this channel
+	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance" json:"recovered_balance,omitempty"`
+	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{82, 4}
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.ChanId
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *HopHint) GetFeeBaseMsat() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.FeeBaseMsat
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.FeeProportionalMillionths
+		return m.LimboBalance
 	}
 	return 0
 }
 
-func (m *HopHint) GetCltvExpiryDelta() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.CltvExpiryDelta
+		return m.MaturityHeight
 	}
 	return 0
 }
 
-type RouteHint struct {
-	// *
-	// A list of hop hints that when chained together can assist in reaching a
-	// specific destination.
-	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints" json:"hop_hints,omitempty"`
-}
-
-func (m *RouteHint) Reset()                    { *m = RouteHint{} }
-func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
-func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
-
-func (m *RouteHint) GetHopHints() []*HopHint {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.HopHints
+		return m.BlocksTilMaturity
 	}
-	return nil
-}
-
-type Invoice struct {
-	// *
-	// An optional memo to attach along with the invoice. Used for record keeping
-	// purposes for the invoice's creator, and will also be set in the description
-	// field of the encoded payment request if the description_hash field is not
-	// being used.
-	Memo string `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
-	// / An optional cryptographic receipt of payment
-	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
-	// *
-	// The hex-encoded preimage (32 byte) which will allow settling an incoming
-	// HTLC payable to this preimage
-	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
-	// / The hash of the preimage
-	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// / The value of this invoice in satoshis
-	Value int64 `protobuf:"varint,5,opt,name=value" json:"value,omitempty"`
-	// / Whether this invoice has been fulfilled
-	Settled bool `protobuf:"varint,6,opt,name=settled" json:"settled,omitempty"`
-	// / When this invoice was created
-	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date" json:"creation_date,omitempty"`
-	// / When this invoice was settled
-	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date" json:"settle_date,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request" json:"payment_request,omitempty"`
-	// *
-	// Hash (SHA-256) of a description of the payment. Used if the description of
-	// payment (memo) is too long to naturally fit within the description field
-	// of an encoded payment request.
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
-	Expiry int64 `protobuf:"varint,11,opt,name=expiry" json:"expiry,omitempty"`
-	// / Fallback on-chain address.
-	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
-	// / Delta to use for the time-lock of the CLTV extended to the final hop.
-	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
-	// *
-	// Route hints that can each be individually used to assist in reaching the
-	// invoice's destination.
-	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints" json:"route_hints,omitempty"`
-	// / Whether this invoice should include routing hints for private channels.
-	Private bool `protobuf:"varint,15,opt,name=private" json:"private,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
-	// *
-	// The "settle" index of this invoice. Each newly settled invoice will
-	// increment this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all
-	// settled invoices with an settle_index greater than this one.
-	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index" json:"settle_index,omitempty"`
-	// / Deprecated, use amt_paid_sat or amt_paid_msat.
-	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid" json:"amt_paid,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in satoshis. This will ONLY
-	// be set if this invoice has been settled. We provide this field as if the
-	// invoice was created with a zero value, then we need to record what amount
-	// was ultimately accepted. Additionally, it's possible that the sender paid
-	// MORE that was specified in the original invoice. So we'll record that here
-	// as well.
-	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat" json:"amt_paid_sat,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in millisatoshis. This will
-	// ONLY be set if this invoice has been settled. We provide this field as if
-	// the invoice was created with a zero value, then we need to record what
-	// amount was ultimately accepted. Additionally, it's possible that the sender
-	// paid MORE that was specified in the original invoice. So we'll record that
-	// here as well.
-	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat" json:"amt_paid_msat,omitempty"`
+	return 0
 }
 
-func (m *Invoice) Reset()                    { *m = Invoice{} }
-func (m *Invoice) String() string            { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
-
-func (m *Invoice) GetMemo() string {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
 	if m != nil {
-		return m.Memo
+		return m.RecoveredBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Invoice) GetReceipt() []byte {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
 	if m != nil {
-		return m.Receipt
+		return m.PendingHtlcs
 	}
 	return nil
 }
 
-func (m *Invoice) GetRPreimage() []byte {
-	if m != nil {
-		return m.RPreimage
-	}
-	return nil
+type WalletBalanceRequest struct {
 }
 
-func (m *Invoice) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
+func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()               {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+	// *
+	// The confirmed balances of the watch-only accounts, which aren't included
+	// in the balances above.
+	AccountBalances []*AccountBalance `protobuf:"bytes,4,rep,name=account_balances" json:"account_balances,omitempty"`
 }
 
-func (m *Invoice) GetValue() int64 {
+func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()               {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
-		return m.Value
+		return m.TotalBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettled() bool {
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.Settled
+		return m.ConfirmedBalance
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetCreationDate() int64 {
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.CreationDate
+		return m.UnconfirmedBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleDate() int64 {
+func (m *WalletBalanceResponse) GetAccountBalances() []*AccountBalance {
 	if m != nil {
-		return m.SettleDate
+		return m.AccountBalances
 	}
-	return 0
+	return nil
 }
 
-func (m *Invoice) GetPaymentRequest() string {
-	if m != nil {
-		return m.PaymentRequest
-	}
-	return ""
+type AccountBalance struct {
+	// / The name of the watch-only account.
+	Account string `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+	// / The confirmed balance of the account in satoshis.
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
 }
 
-func (m *Invoice) GetDescriptionHash() []byte {
+func (m *AccountBalance) Reset()                    { *m = AccountBalance{} }
+func (m *AccountBalance) String() string            { return proto.CompactTextString(m) }
+func (*AccountBalance) ProtoMessage()               {}
+func (*AccountBalance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *AccountBalance) GetAccount() string {
 	if m != nil {
-		return m.DescriptionHash
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-func (m *Invoice) GetExpiry() int64 {
+func (m *AccountBalance) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.ConfirmedBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetFallbackAddr() string {
-	if m != nil {
-		return m.FallbackAddr
-	}
-	return ""
+type ChannelBalanceRequest struct {
 }
 
-func (m *Invoice) GetCltvExpiry() uint64 {
+func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()               {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+type ChannelBalanceResponse struct {
+	// / Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance" json:"balance,omitempty"`
+	// / Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance int64 `protobuf:"varint,2,opt,name=pending_open_balance" json:"pending_open_balance,omitempty"`
+}
+
+func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()               {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.Balance
 	}
 	return 0
 }
 
-func (m *Invoice) GetRouteHints() []*RouteHint {
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
 	if m != nil {
-		return m.RouteHints
+		return m.PendingOpenBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetPrivate() bool {
+type QueryRoutesRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt" json:"amt,omitempty"`
+	// / The max number of routes to return.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta" json:"final_cltv_delta,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+}
+
+func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()               {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
-		return m.Private
+		return m.PubKey
 	}
-	return false
+	return ""
 }
 
-func (m *Invoice) GetAddIndex() uint64 {
+func (m *QueryRoutesRequest) GetAmt() int64 {
 	if m != nil {
-		return m.AddIndex
+		return m.Amt
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleIndex() uint64 {
+func (m *QueryRoutesRequest) GetNumRoutes() int32 {
 	if m != nil {
-		return m.SettleIndex
+		return m.NumRoutes
 	}
 	return 0
 }
 
-func (m *Invoice) GetAmtPaid() int64 {
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
 	if m != nil {
-		return m.AmtPaid
+		return m.FinalCltvDelta
 	}
 	return 0
 }
 
-func (m *Invoice) GetAmtPaidSat() int64 {
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
 	if m != nil {
-		return m.AmtPaidSat
+		return m.FeeLimit
 	}
-	return 0
+	return nil
 }
 
-func (m *Invoice) GetAmtPaidMsat() int64 {
+type QueryRoutesResponse struct {
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty"`
+}
+
+func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()               {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
-		return m.AmtPaidMsat
+		return m.Routes
 	}
-	return 0
+	return nil
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request" json:"payment_request,omitempty"`
+type Hop struct {
 	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
-}
-
-func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
-
-func (m *AddInvoiceResponse) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward" json:"amt_to_forward,omitempty"`
+	Fee              int64  `protobuf:"varint,4,opt,name=fee" json:"fee,omitempty"`
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat" json:"fee_msat,omitempty"`
 }
 
-func (m *AddInvoiceResponse) GetPaymentRequest() string {
-	if m != nil {
-		return m.PaymentRequest
-	}
-	return ""
-}
+func (m *Hop) Reset()                    { *m = Hop{} }
+func (m *Hop) String() string            { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()               {}
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
-func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+func (m *Hop) GetChanId() uint64 {
 	if m != nil {
-		return m.AddIndex
+		return m.ChanId
 	}
 	return 0
 }
 
-type PaymentHash struct {
-	// *
-	// The hex-encoded payment hash of the invoice to be looked up. The passed
-	// payment hash must be exactly 32 bytes, otherwise an error is returned.
-	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str" json:"r_hash_str,omitempty"`
-	// / The payment hash of the invoice to be looked up.
-	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-}
-
-func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
-func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
-func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
-
-func (m *PaymentHash) GetRHashStr() string {
+func (m *Hop) GetChanCapacity() int64 {
 	if m != nil {
-		return m.RHashStr
+		return m.ChanCapacity
 	}
-	return ""
+	return 0
 }
 
-func (m *PaymentHash) GetRHash() []byte {
+func (m *Hop) GetAmtToForward() int64 {
 	if m != nil {
-		return m.RHash
+		return m.AmtToForward
 	}
-	return nil
-}
-
-type ListInvoiceRequest struct {
-	// / If set, only unsettled invoices will be returned in the response.
-	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only" json:"pending_only,omitempty"`
-	// *
-	// The index of an invoice that will be used as either the start or end of a
-	// query to determine which invoices should be returned in the response.
-	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset" json:"index_offset,omitempty"`
-	// / The max number of invoices to return in the response to this query.
-	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices" json:"num_max_invoices,omitempty"`
-	// *
-	// If set, the invoices returned will result from seeking backwards from the
-	// specified index offset. This can be used to paginate backwards.
-	Reversed bool `protobuf:"varint,6,opt,name=reversed" json:"reversed,omitempty"`
+	return 0
 }
 
-func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
-func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
-
-func (m *ListInvoiceRequest) GetPendingOnly() bool {
+func (m *Hop) GetFee() int64 {
 	if m != nil {
-		return m.PendingOnly
+		return m.Fee
 	}
-	return false
+	return 0
 }
 
-func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+func (m *Hop) GetExpiry() uint32 {
 	if m != nil {
-		return m.IndexOffset
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+func (m *Hop) GetAmtToForwardMsat() int64 {
 	if m != nil {
-		return m.NumMaxInvoices
+		return m.AmtToForwardMsat
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetReversed() bool {
+func (m *Hop) GetFeeMsat() int64 {
 	if m != nil {
-		return m.Reversed
+		return m.FeeMsat
 	}
-	return false
+	return 0
 }
 
-type ListInvoiceResponse struct {
+// *
+// A path through the channel graph which runs over one or more channels in
+// succession. This struct carries all the information required to craft the
+// Sphinx onion packet, and send the payment along the first hop in the path. A
+// route is only selected as valid if all the channels have sufficient capacity to
+// carry the initial payment amount after fees are accounted for.
+type Route struct {
 	// *
-	// A list of invoices from the time slice of the time series specified in the
-	// request.
-	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+	// The cumulative (final) time lock across the entire route.  This is the CLTV
+	// value that should be extended to the first hop in the route. All other hops
+	// will decrement the time-lock as advertised, leaving enough time for all
+	// hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock" json:"total_time_lock,omitempty"`
 	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek further, pagination style.
-	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// The sum of the fees paid at each hop within the final route.  In the case
+	// of a one-hop payment, this value will be zero as we don't need to pay a fee
+	// it ourself.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees" json:"total_fees,omitempty"`
 	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek backwards, pagination style.
-	FirstIndexOffset uint64 `protobuf:"varint,3,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
+	// The total amount of funds required to complete a payment over this route.
+	// This value includes the cumulative fees at each hop. As a result, the HTLC
+	// extended to the first-hop in the route will need to have at least this many
+	// satoshis, otherwise the route will fail at an intermediate node due to an
+	// insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt" json:"total_amt,omitempty"`
+	// *
+	// Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops" json:"hops,omitempty"`
+	// *
+	// The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat" json:"total_fees_msat,omitempty"`
+	// *
+	// The total amount in millisatoshis.
+	TotalAmtMsat int64 `protobuf:"varint,6,opt,name=total_amt_msat" json:"total_amt_msat,omitempty"`
 }
 
-func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
-func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (m *Route) Reset()                    { *m = Route{} }
+func (m *Route) String() string            { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()               {}
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
-func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
-		return m.Invoices
+		return m.TotalTimeLock
 	}
-	return nil
+	return 0
 }
 
-func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+func (m *Route) GetTotalFees() int64 {
 	if m != nil {
-		return m.LastIndexOffset
+		return m.TotalFees
 	}
 	return 0
 }
 
-func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+func (m *Route) GetTotalAmt() int64 {
 	if m != nil {
-		return m.FirstIndexOffset
+		return m.TotalAmt
 	}
 	return 0
 }
 
-type InvoiceSubscription struct {
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all added indexes with an add_index greater than this
-	// value. This allows callers to catch up on any events they missed while they
-	// weren't connected to the streaming RPC.
-	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index" json:"add_index,omitempty"`
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all settled indexes with an settle_index greater than
-	// this value. This allows callers to catch up on any events they missed while
-	// they weren't connected to the streaming RPC.
-	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index" json:"settle_index,omitempty"`
+func (m *Route) GetHops() []*Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
 }
 
-func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
-func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
-func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
-
-func (m *InvoiceSubscription) GetAddIndex() uint64 {
+func (m *Route) GetTotalFeesMsat() int64 {
 	if m != nil {
-		return m.AddIndex
+		return m.TotalFeesMsat
 	}
 	return 0
 }
 
-func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+func (m *Route) GetTotalAmtMsat() int64 {
 	if m != nil {
-		return m.SettleIndex
+		return m.TotalAmtMsat
 	}
 	return 0
 }
 
-type Payment struct {
-	// / The payment hash
-	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash" json:"payment_hash,omitempty"`
-	// / Deprecated, use value_sat or value_msat.
-	Value int64 `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
-	// / The date of this payment
-	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date" json:"creation_date,omitempty"`
-	// / The path this payment took
-	Path []string `protobuf:"bytes,4,rep,name=path" json:"path,omitempty"`
-	// / The fee paid for this payment in satoshis
-	Fee int64 `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
-	// / The payment preimage
-	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage" json:"payment_preimage,omitempty"`
-	// / The value of the payment in satoshis
-	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat" json:"value_sat,omitempty"`
-	// / The value of the payment in milli-satoshis
-	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat" json:"value_msat,omitempty"`
+type NodeInfoRequest struct {
+	// / The 33-byte hex-encoded compressed public of the target node
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
 }
 
-func (m *Payment) Reset()                    { *m = Payment{} }
-func (m *Payment) String() string            { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
+func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfoRequest) ProtoMessage()               {}
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
-func (m *Payment) GetPaymentHash() string {
+func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
-		return m.PaymentHash
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *Payment) GetValue() int64 {
+type NodeInfo struct {
+	// *
+	// An individual vertex/node within the channel graph. A node is
+	// connected to other nodes by one or more channel edges emanating from it. As
+	// the graph is directed, a node will also have an incoming edge attached to
+	// it for each outgoing edge.
+	Node          *LightningNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	NumChannels   uint32         `protobuf:"varint,2,opt,name=num_channels" json:"num_channels,omitempty"`
+	TotalCapacity int64          `protobuf:"varint,3,opt,name=total_capacity" json:"total_capacity,omitempty"`
+}
+
+func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
+func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()               {}
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
-		return m.Value
+		return m.Node
 	}
-	return 0
+	return nil
 }
 
-func (m *Payment) GetCreationDate() int64 {
+func (m *NodeInfo) GetNumChannels() uint32 {
 	if m != nil {
-		return m.CreationDate
+		return m.NumChannels
 	}
 	return 0
 }
 
-func (m *Payment) GetPath() []string {
-	if m != nil {
-		return m.Path
-	}
-	return nil
-}
-
-func (m *Payment) GetFee() int64 {
+func (m *NodeInfo) GetTotalCapacity() int64 {
 	if m != nil {
-		return m.Fee
+		return m.TotalCapacity
 	}
 	return 0
 }
 
-func (m *Payment) GetPaymentPreimage() string {
-	if m != nil {
-		return m.PaymentPreimage
-	}
-	return ""
+// *
+// An individual vertex/node within the channel graph. A node is
+// connected to other nodes by one or more channel edges emanating from it. As the
+// graph is directed, a node will also have an incoming edge attached to it for
+// each outgoing edge.
+type LightningNode struct {
+	LastUpdate uint32         `protobuf:"varint,1,opt,name=last_update" json:"last_update,omitempty"`
+	PubKey     string         `protobuf:"bytes,2,opt,name=pub_key" json:"pub_key,omitempty"`
+	Alias      string         `protobuf:"bytes,3,opt,name=alias" json:"alias,omitempty"`
+	Addresses  []*NodeAddress `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
+	Color      string         `protobuf:"bytes,5,opt,name=color" json:"color,omitempty"`
 }
 
-func (m *Payment) GetValueSat() int64 {
+func (m *LightningNode) Reset()                    { *m = LightningNode{} }
+func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
+func (*LightningNode) ProtoMessage()               {}
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.ValueSat
+		return m.LastUpdate
 	}
 	return 0
 }
 
-func (m *Payment) GetValueMsat() int64 {
+func (m *LightningNode) GetPubKey() string {
 	if m != nil {
-		return m.ValueMsat
+		return m.PubKey
 	}
-	return 0
-}
-
-type ListPaymentsRequest struct {
-}
-
-func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
-func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
-
-type ListPaymentsResponse struct {
-	// / The list of payments
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+	return ""
 }
 
-func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
-func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
-
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *LightningNode) GetAlias() string {
 	if m != nil {
-		return m.Payments
+		return m.Alias
 	}
-	return nil
-}
-
-type DeleteAllPaymentsRequest struct {
-}
-
-func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
-func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
-
-type DeleteAllPaymentsResponse struct {
-}
-
-func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
-func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
-
-type AbandonChannelRequest struct {
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+	return ""
 }
 
-func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
-func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*AbandonChannelRequest) ProtoMessage()               {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
-
-func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *LightningNode) GetAddresses() []*NodeAddress {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Addresses
 	}
 	return nil
 }
 
-type AbandonChannelResponse struct {
+func (m *LightningNode) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
 }
 
-func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
-func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
-func (*AbandonChannelResponse) ProtoMessage()               {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
-
-type DebugLevelRequest struct {
-	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
-	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec" json:"level_spec,omitempty"`
+type NodeAddress struct {
+	Network string `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+	Addr    string `protobuf:"bytes,2,opt,name=addr" json:"addr,omitempty"`
 }
 
-func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
-func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
-func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
+func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
+func (*NodeAddress) ProtoMessage()               {}
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
-func (m *DebugLevelRequest) GetShow() bool {
+func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
-		return m.Show
+		return m.Network
 	}
-	return false
+	return ""
 }
 
-func (m *DebugLevelRequest) GetLevelSpec() string {
+func (m *NodeAddress) GetAddr() string {
 	if m != nil {
-		return m.LevelSpec
+		return m.Addr
 	}
 	return ""
 }
 
-type DebugLevelResponse struct {
-	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems" json:"sub_systems,omitempty"`
+type RoutingPolicy struct {
+	TimeLockDelta    uint32 `protobuf:"varint,1,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+	MinHtlc          int64  `protobuf:"varint,2,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat" json:"fee_rate_milli_msat,omitempty"`
+	Disabled         bool   `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
 }
 
-func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
-func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
-func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()               {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
-func (m *DebugLevelResponse) GetSubSystems() string {
+func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.SubSystems
+		return m.TimeLockDelta
 	}
-	return ""
-}
-
-type PayReqString struct {
-	// / The payment request string to be decoded
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq" json:"pay_req,omitempty"`
+	return 0
 }
 
-func (m *PayReqString) Reset()                    { *m = PayReqString{} }
-func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
-func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
-
-func (m *PayReqString) GetPayReq() string {
+func (m *RoutingPolicy) GetMinHtlc() int64 {
 	if m != nil {
-		return m.PayReq
+		return m.MinHtlc
 	}
-	return ""
+	return 0
 }
 
-type PayReq struct {
-	Destination     string       `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
-	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash" json:"payment_hash,omitempty"`
-	NumSatoshis     int64        `protobuf:"varint,3,opt,name=num_satoshis" json:"num_satoshis,omitempty"`
-	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
-	Expiry          int64        `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
-	Description     string       `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
-	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash" json:"description_hash,omitempty"`
-	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
-	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
-	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints" json:"route_hints,omitempty"`
+func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
 }
 
-func (m *PayReq) Reset()                    { *m = PayReq{} }
-func (m *PayReq) String() string            { return proto.CompactTextString(m) }
-func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
-
-func (m *PayReq) GetDestination() string {
+func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
 	if m != nil {
-		return m.Destination
+		return m.FeeRateMilliMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetPaymentHash() string {
+func (m *RoutingPolicy) GetDisabled() bool {
 	if m != nil {
-		return m.PaymentHash
+		return m.Disabled
 	}
-	return ""
+	return false
 }
 
-func (m *PayReq) GetNumSatoshis() int64 {
+// *
+// A fully authenticated channel along with all its unique attributes.
+// Once an authenticated channel announcement has been processed on the network,
+// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
+// stored. The other portions relevant to routing policy of a channel are stored
+// within a ChannelEdgePolicy for each direction of the channel.
+type ChannelEdge struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChannelId   uint64         `protobuf:"varint,1,opt,name=channel_id" json:"channel_id,omitempty"`
+	ChanPoint   string         `protobuf:"bytes,2,opt,name=chan_point" json:"chan_point,omitempty"`
+	LastUpdate  uint32         `protobuf:"varint,3,opt,name=last_update" json:"last_update,omitempty"`
+	Node1Pub    string         `protobuf:"bytes,4,opt,name=node1_pub" json:"node1_pub,omitempty"`
+	Node2Pub    string         `protobuf:"bytes,5,opt,name=node2_pub" json:"node2_pub,omitempty"`
+	Capacity    int64          `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
+	Node1Policy *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy" json:"node1_policy,omitempty"`
+	Node2Policy *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy" json:"node2_policy,omitempty"`
+}
+
+func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
+func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEdge) ProtoMessage()               {}
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
-		return m.NumSatoshis
+		return m.ChannelId
 	}
 	return 0
 }
 
-func (m *PayReq) GetTimestamp() int64 {
+func (m *ChannelEdge) GetChanPoint() string {
 	if m != nil {
-		return m.Timestamp
+		return m.ChanPoint
 	}
-	return 0
+	return ""
 }
 
-func (m *PayReq) GetExpiry() int64 {
+func (m *ChannelEdge) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.Expiry
+		return m.LastUpdate
 	}
 	return 0
 }
 
-func (m *PayReq) GetDescription() string {
+func (m *ChannelEdge) GetNode1Pub() string {
 	if m != nil {
-		return m.Description
+		return m.Node1Pub
 	}
 	return ""
 }
 
-func (m *PayReq) GetDescriptionHash() string {
+func (m *ChannelEdge) GetNode2Pub() string {
 	if m != nil {
-		return m.DescriptionHash
+		return m.Node2Pub
 	}
 	return ""
 }
 
-func (m *PayReq) GetFallbackAddr() string {
+func (m *ChannelEdge) GetCapacity() int64 {
 	if m != nil {
-		return m.FallbackAddr
+		return m.Capacity
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetCltvExpiry() int64 {
+func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
 	if m != nil {
-		return m.CltvExpiry
+		return m.Node1Policy
 	}
-	return 0
+	return nil
 }
 
-func (m *PayReq) GetRouteHints() []*RouteHint {
+func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
 	if m != nil {
-		return m.RouteHints
+		return m.Node2Policy
 	}
 	return nil
 }
 
-type FeeReportRequest struct {
+type ChannelGraphRequest struct {
 }
 
-func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
+func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelGraphRequest) ProtoMessage()               {}
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
-type ChannelFeeReport struct {
-	// / The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point" json:"chan_point,omitempty"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
-	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil" json:"fee_per_mil,omitempty"`
-	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
+// / Returns a new instance of the directed channel graph.
+type ChannelGraph struct {
+	// / The list of `LightningNode`s in this channel graph
+	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	// / The list of `ChannelEdge`s in this channel graph
+	Edges []*ChannelEdge `protobuf:"bytes,2,rep,name=edges" json:"edges,omitempty"`
 }
 
-func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
+func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
+func (*ChannelGraph) ProtoMessage()               {}
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
-		return m.ChanPoint
+		return m.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+func (m *ChannelGraph) GetEdges() []*ChannelEdge {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.Edges
+	}
+	return nil
+}
+
+type ChanInfoRequest struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+}
+
+func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
+func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChanInfoRequest) ProtoMessage()               {}
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *ChanInfoRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
+type NetworkInfoRequest struct {
+}
+
+func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
+func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfoRequest) ProtoMessage()               {}
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+type NetworkInfo struct {
+	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree" json:"max_out_degree,omitempty"`
+	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes" json:"num_nodes,omitempty"`
+	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size" json:"max_channel_size,omitempty"`
+}
+
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
-		return m.FeePerMil
+		return m.GraphDiameter
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *NetworkInfo) GetAvgOutDegree() float64 {
 	if m != nil {
-		return m.FeeRate
+		return m.AvgOutDegree
 	}
 	return 0
 }
 
-type FeeReportResponse struct {
-	// / An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees" json:"channel_fees,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum" json:"day_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum" json:"week_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum uint64 `protobuf:"varint,4,opt,name=month_fee_sum" json:"month_fee_sum,omitempty"`
+func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+	if m != nil {
+		return m.MaxOutDegree
+	}
+	return 0
 }
 
-func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (m *NetworkInfo) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
 
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+func (m *NetworkInfo) GetNumChannels() uint32 {
 	if m != nil {
-		return m.ChannelFees
+		return m.NumChannels
 	}
-	return nil
+	return 0
 }
 
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
 	if m != nil {
-		return m.DayFeeSum
+		return m.TotalNetworkCapacity
 	}
 	return 0
 }
 
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+func (m *NetworkInfo) GetAvgChannelSize() float64 {
 	if m != nil {
-		return m.WeekFeeSum
+		return m.AvgChannelSize
 	}
 	return 0
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+func (m *NetworkInfo) GetMinChannelSize() int64 {
 	if m != nil {
-		return m.MonthFeeSum
+		return m.MinChannelSize
 	}
 	return 0
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
-	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
-	// / The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+func (m *NetworkInfo) GetMaxChannelSize() int64 {
+	if m != nil {
+		return m.MaxChannelSize
+	}
+	return 0
 }
 
-func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+type StopRequest struct {
+}
 
-type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
+func (m *StopRequest) Reset()                    { *m = StopRequest{} }
+func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()               {}
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,oneof"`
+type StopResponse struct {
 }
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,oneof"`
+
+func (m *StopResponse) Reset()                    { *m = StopResponse{} }
+func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()               {}
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+type GraphTopologySubscription struct {
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope()    {}
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
+func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
+func (*GraphTopologySubscription) ProtoMessage()               {}
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+type GraphTopologyUpdate struct {
+	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
+	ChannelUpdates []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates" json:"channel_updates,omitempty"`
+	ClosedChans    []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans" json:"closed_chans,omitempty"`
+}
+
+func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
+func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
+func (*GraphTopologyUpdate) ProtoMessage()               {}
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
-		return m.Scope
+		return m.NodeUpdates
 	}
 	return nil
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
+func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+	if m != nil {
+		return m.ChannelUpdates
 	}
-	return false
+	return nil
 }
 
-func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
-		return x.ChanPoint
+func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+	if m != nil {
+		return m.ClosedChans
 	}
 	return nil
 }
 
-func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
-	if m != nil {
-		return m.BaseFeeMsat
-	}
-	return 0
+type NodeUpdate struct {
+	Addresses      []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	IdentityKey    string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey" json:"identity_key,omitempty"`
+	GlobalFeatures []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
+	Alias          string   `protobuf:"bytes,4,opt,name=alias" json:"alias,omitempty"`
 }
 
-func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
+func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*NodeUpdate) ProtoMessage()               {}
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
-		return m.FeeRate
+		return m.Addresses
 	}
-	return 0
+	return nil
 }
 
-func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+func (m *NodeUpdate) GetIdentityKey() string {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.IdentityKey
 	}
-	return 0
+	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
-		(*PolicyUpdateRequest_Global)(nil),
-		(*PolicyUpdateRequest_ChanPoint)(nil),
+func (m *NodeUpdate) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
 	}
+	return nil
 }
 
-func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		t := uint64(0)
-		if x.Global {
-			t = 1
-		}
-		b.EncodeVarint(1<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *PolicyUpdateRequest_ChanPoint:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPoint); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
+func (m *NodeUpdate) GetAlias() string {
+	if m != nil {
+		return m.Alias
 	}
-	return nil
+	return ""
 }
 
-func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*PolicyUpdateRequest)
-	switch tag {
-	case 1: // scope.global
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Scope = &PolicyUpdateRequest_Global{x != 0}
-		return true, err
-	case 2: // scope.chan_point
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
-		return true, err
-	default:
-		return false, nil
-	}
+type ChannelEdgeUpdate struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId          uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+	ChanPoint       *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
+	Capacity        int64          `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
+	RoutingPolicy   *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy" json:"routing_policy,omitempty"`
+	AdvertisingNode string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode" json:"advertising_node,omitempty"`
+	ConnectingNode  string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode" json:"connecting_node,omitempty"`
 }
 
-func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		n += proto.SizeVarint(1<<3 | proto.WireVarint)
-		n += 1
-	case *PolicyUpdateRequest_ChanPoint:
-		s := proto.Size(x.ChanPoint)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
+func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
+func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEdgeUpdate) ProtoMessage()               {}
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
-type PolicyUpdateResponse struct {
+func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
 
-func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
-
-type ForwardingHistoryRequest struct {
-	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
-	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time" json:"end_time,omitempty"`
-	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
-	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset" json:"index_offset,omitempty"`
-	// / The max number of events to return in the response to this query.
-	NumMaxEvents uint32 `protobuf:"varint,4,opt,name=num_max_events" json:"num_max_events,omitempty"`
+func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
-func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
-
-func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+func (m *ChannelEdgeUpdate) GetCapacity() int64 {
 	if m != nil {
-		return m.StartTime
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
 	if m != nil {
-		return m.EndTime
+		return m.RoutingPolicy
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
 	if m != nil {
-		return m.IndexOffset
+		return m.AdvertisingNode
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+func (m *ChannelEdgeUpdate) GetConnectingNode() string {
 	if m != nil {
-		return m.NumMaxEvents
+		return m.ConnectingNode
 	}
-	return 0
+	return ""
 }
 
-type ForwardingEvent struct {
-	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	// / The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
-	// / The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
-	// / The total amount of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
-	// / The total amount of the outgoign HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
-	// / The total fee that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+type ClosedChannelUpdate struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId       uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+	Capacity     int64         `protobuf:"varint,2,opt,name=capacity" json:"capacity,omitempty"`
+	ClosedHeight uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight" json:"closed_height,omitempty"`
+	ChanPoint    *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
 }
 
-func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
+func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ClosedChannelUpdate) ProtoMessage()               {}
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
+func (m *ClosedChannelUpdate) GetChanId() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
+func (m *ClosedChannelUpdate) GetCapacity() int64 {
 	if m != nil {
-		return m.ChanIdIn
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
+func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
 	if m != nil {
-		return m.ChanIdOut
+		return m.ClosedHeight
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
+func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
 	if m != nil {
-		return m.AmtIn
+		return m.ChanPoint
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+type HopHint struct {
+	// / The public key of the node at the start of the channel.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id" json:"node_id,omitempty"`
+	// / The unique identifier of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The base fee of the channel denominated in millisatoshis.
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
+	// *
+	// The fee rate of the channel for sending one satoshi across it denominated in
+	// millionths of a satoshi.
+	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths" json:"fee_proportional_millionths,omitempty"`
+	// / The time-lock delta of the channel.
+	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta" json:"cltv_expiry_delta,omitempty"`
+}
+
+func (m *HopHint) Reset()                    { *m = HopHint{} }
+func (m *HopHint) String() string            { return proto.CompactTextString(m) }
+func (*HopHint) ProtoMessage()               {}
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+func (m *HopHint) GetNodeId() string {
 	if m != nil {
-		return m.AmtOut
+		return m.NodeId
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingEvent) GetFee() uint64 {
+func (m *HopHint) GetChanId() uint64 {
 	if m != nil {
-		return m.Fee
+		return m.ChanId
 	}
 	return 0
 }
 
-type ForwardingHistoryResponse struct {
-	// / A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
-	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+func (m *HopHint) GetFeeBaseMsat() uint32 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
 }
 
-func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
-
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+func (m *HopHint) GetFeeProportionalMillionths() uint32 {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.FeeProportionalMillionths
 	}
-	return nil
+	return 0
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+func (m *HopHint) GetCltvExpiryDelta() uint32 {
 	if m != nil {
-		return m.LastOffsetIndex
+		return m.CltvExpiryDelta
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
-	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
-	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
-	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
-	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
-	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
-	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
-	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
-	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
-	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
-	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
-	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
-	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
-	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
-	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
-	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
-	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
-	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
-	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
-	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
-	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
-	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
-	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
-	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
-	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
-	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
-	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
-	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
-	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
-	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
-	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
-	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
-	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
-	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
-	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
-	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
-	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
-	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
-	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
-	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
-	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
-	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
-	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
-	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
-	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
-	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
-	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
-	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
-	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
-	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
-	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
-	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
-	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
-	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
-	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
-	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
-	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
-	proto.RegisterType((*Route)(nil), "lnrpc.Route")
-	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
-	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
-	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
-	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
-	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
-	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
-	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
-	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
-	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
-	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
-	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
-	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
-	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
-	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
-	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
-	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
-	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
-	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
-	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
-	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
-	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
-	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
-	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
-	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
-	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
-	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
-	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
-	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
-	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
-	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
-	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
-	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
-	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
-	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
-	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
-	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
-	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
-	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
-	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
-	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
-	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
-	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
-	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
-	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
-	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
-	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
-	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+type RouteHint struct {
+	// *
+	// A list of hop hints that when chained together can assist in reaching a
+	// specific destination.
+	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints" json:"hop_hints,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *RouteHint) Reset()                    { *m = RouteHint{} }
+func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
+func (*RouteHint) ProtoMessage()               {}
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
 
-// Client API for WalletUnlocker service
+func (m *RouteHint) GetHopHints() []*HopHint {
+	if m != nil {
+		return m.HopHints
+	}
+	return nil
+}
 
-type WalletUnlockerClient interface {
+type Invoice struct {
 	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+	// An optional memo to attach along with the invoice. Used for record keeping
+	// purposes for the invoice's creator, and will also be set in the description
+	// field of the encoded payment request if the description_hash field is not
+	// being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
+	// / An optional cryptographic receipt of payment
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// The hex-encoded preimage (32 byte) which will allow settling an incoming
+	// HTLC payable to this preimage
+	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
+	// / The hash of the preimage
+	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// / The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,5,opt,name=value" json:"value,omitempty"`
+	// / Whether this invoice has been fulfilled
+	Settled bool `protobuf:"varint,6,opt,name=settled" json:"settled,omitempty"`
+	// / When this invoice was created
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date" json:"creation_date,omitempty"`
+	// / When this invoice was settled
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date" json:"settle_date,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request" json:"payment_request,omitempty"`
+	// *
+	// Hash (SHA-256) of a description of the payment. Used if the description of
+	// payment (memo) is too long to naturally fit within the description field
+	// of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry" json:"expiry,omitempty"`
+	// / Fallback on-chain address.
+	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
+	// / Delta to use for the time-lock of the CLTV extended to the final hop.
+	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
+	// *
+	// Route hints that can each be individually used to assist in reaching the
+	// invoice's destination.
+	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints" json:"route_hints,omitempty"`
+	// / Whether this invoice should include routing hints for private channels.
+	Private bool `protobuf:"varint,15,opt,name=private" json:"private,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
+	// *
+	// The "settle" index of this invoice. Each newly settled invoice will
+	// increment this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all
+	// settled invoices with an settle_index greater than this one.
+	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index" json:"settle_index,omitempty"`
+	// / Deprecated, use amt_paid_sat or amt_paid_msat.
+	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid" json:"amt_paid,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in satoshis. This will ONLY
+	// be set if this invoice has been settled. We provide this field as if the
+	// invoice was created with a zero value, then we need to record what amount
+	// was ultimately accepted. Additionally, it's possible that the sender paid
+	// MORE that was specified in the original invoice. So we'll record that here
+	// as well.
+	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat" json:"amt_paid_sat,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in millisatoshis. This will
+	// ONLY be set if this invoice has been settled. We provide this field as if
+	// the invoice was created with a zero value, then we need to record what
+	// amount was ultimately accepted. Additionally, it's possible that the sender
+	// paid MORE that was specified in the original invoice. So we'll record that
+	// here as well.
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat" json:"amt_paid_msat,omitempty"`
 }
 
-type walletUnlockerClient struct {
-	cc *grpc.ClientConn
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *Invoice) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
-	return &walletUnlockerClient{cc}
+func (m *Invoice) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
 }
 
-func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
-	out := new(GenSeedResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetRPreimage() []byte {
+	if m != nil {
+		return m.RPreimage
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
-	out := new(InitWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
-	out := new(UnlockWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetSettled() bool {
+	if m != nil {
+		return m.Settled
 	}
-	return out, nil
+	return false
 }
 
-// Server API for WalletUnlocker service
+func (m *Invoice) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
 
-type WalletUnlockerServer interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
-	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+func (m *Invoice) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
+	}
+	return 0
 }
 
-func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
-	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
+func (m *Invoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
 }
 
-func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Invoice) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
 	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
+	return nil
+}
+
+func (m *Invoice) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
+	return 0
+}
+
+func (m *Invoice) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+	return ""
+}
+
+func (m *Invoice) GetCltvExpiry() uint64 {
+	if m != nil {
+		return m.CltvExpiry
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Invoice) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
 	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
+	return nil
+}
+
+func (m *Invoice) GetPrivate() bool {
+	if m != nil {
+		return m.Private
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
+	return false
+}
+
+func (m *Invoice) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+	return 0
+}
+
+func (m *Invoice) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Invoice) GetAmtPaid() int64 {
+	if m != nil {
+		return m.AmtPaid
 	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
+	return 0
+}
+
+func (m *Invoice) GetAmtPaidSat() int64 {
+	if m != nil {
+		return m.AmtPaidSat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
+	return 0
+}
+
+func (m *Invoice) GetAmtPaidMsat() int64 {
+	if m != nil {
+		return m.AmtPaidMsat
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	return 0
+}
+
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request" json:"payment_request,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
+}
+
+func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()               {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+func (m *AddInvoiceResponse) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AddInvoiceResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
 	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
+	return ""
+}
+
+func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+	return 0
+}
+
+type PaymentHash struct {
+	// *
+	// The hex-encoded payment hash of the invoice to be looked up. The passed
+	// payment hash must be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str" json:"r_hash_str,omitempty"`
+	// / The payment hash of the invoice to be looked up.
+	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+}
+
+func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
+func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
+func (*PaymentHash) ProtoMessage()               {}
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+func (m *PaymentHash) GetRHashStr() string {
+	if m != nil {
+		return m.RHashStr
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	return ""
+}
+
+func (m *PaymentHash) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lnrpc.WalletUnlocker",
-	HandlerType: (*WalletUnlockerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenSeed",
-			Handler:    _WalletUnlocker_GenSeed_Handler,
-		},
-		{
-			MethodName: "InitWallet",
-			Handler:    _WalletUnlocker_InitWallet_Handler,
-		},
-		{
-			MethodName: "UnlockWallet",
-			Handler:    _WalletUnlocker_UnlockWallet_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _WalletUnlocker_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "rpc.proto",
+type ListInvoiceRequest struct {
+	// / If set, only unsettled invoices will be returned in the response.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only" json:"pending_only,omitempty"`
+	// *
+	// The index of an invoice that will be used as either the start or end of a
+	// query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset" json:"index_offset,omitempty"`
+	// / The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices" json:"num_max_invoices,omitempty"`
+	// *
+	// If set, the invoices returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed bool `protobuf:"varint,6,opt,name=reversed" json:"reversed,omitempty"`
 }
 
-// Client API for Lightning service
+func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
+func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListInvoiceRequest) ProtoMessage()               {}
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
 
-type LightningClient interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in satoshis.
-	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or sat_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
-	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
-	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
-	// call is meant to be consumed by clients to the REST proxy. As with all
-	// other sync calls, all byte slices are intended to be populated as hex
-	// encoded strings.
-	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
-	// * lncli: `openchannel`
-	// OpenChannel attempts to open a singly funded channel specified in the
-	// request to a remote peer. Users are able to specify a target number of
-	// blocks that the funding transaction should be confirmed in, or a manual fee
-	// rate to us for the funding transaction. If neither are specified, then a
-	// lax block confirmation target is used.
-	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
-	// * lncli: `closechannel`
-	// CloseChannel attempts to close an active channel identified by its channel
-	// outpoint (ChannelPoint). The actions of this method can additionally be
-	// augmented to attempt a force close after a timeout period in the case of an
-	// inactive peer. If a non-force close (cooperative closure) is requested,
-	// then the user can specify either a target number of blocks until the
-	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
-	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
-	// * lncli: `abandonchannel`
-	// AbandonChannel removes all channel state from the database except for a
-	// close summary. This method can be used to get rid of permanently unusable
-	// channels due to bugs fixed in newer versions of lnd. Only available
-	// when in debug builds of lnd.
-	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
-	// * lncli: `sendpayment`
-	// SendPayment dispatches a bi-directional streaming RPC for sending payments
-	// through the Lightning Network. A single RPC invocation creates a persistent
-	// bi-directional stream allowing clients to rapidly send payments through the
-	// Lightning Network with a single persistent connection.
-	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
-	// *
-	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
-	// This RPC is intended to be consumed by clients of the REST proxy.
-	// Additionally, this RPC expects the destination's public key and the payment
-	// hash (if any) to be encoded as hex strings.
-	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `sendtoroute`
-	// SendToRoute is a bi-directional streaming RPC for sending payment through
-	// the Lightning Network. This method differs from SendPayment in that it
-	// allows users to specify a full route manually. This can be used for things
-	// like rebalancing, and atomic swaps.
-	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
-	// *
-	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
-	// until the payment either fails or succeeds.
-	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `addinvoice`
-	// AddInvoice attempts to add a new invoice to the invoice database. Any
-	// duplicated invoices are rejected, therefore all invoices *must* have a
-	// unique payment preimage.
-	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
-	// * lncli: `listinvoices`
-	// ListInvoices returns a list of all the invoices currently stored within the
-	// database. Any active debug invoices are ignored. It has full support for
-	// paginated responses, allowing users to query for specific invoices through
-	// their add_index. This can be done by using either the first_index_offset or
-	// last_index_offset fields included in the response as the index_offset of the
-	// next request. The reversed flag is set by default in order to paginate
-	// backwards. If you wish to paginate forwards, you must explicitly set the
-	// flag to false. If none of the parameters are specified, then the last 100
-	// invoices will be returned.
-	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
-	// * lncli: `lookupinvoice`
-	// LookupInvoice attempts to look up an invoice according to its payment hash.
-	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
-	// returned.
-	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
-	// *
-	// SubscribeInvoices returns a uni-directional stream (server -> client) for
-	// notifying the client of newly added/settled invoices. The caller can
-	// optionally specify the add_index and/or the settle_index. If the add_index
-	// is specified, then we'll first start by sending add invoice events for all
-	// invoices with an add_index greater than the specified value.  If the
-	// settle_index is specified, the next, we'll send out all settle events for
-	// invoices with a settle_index greater than the specified value.  One or both
-	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
-	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
-	// * lncli: `decodepayreq`
-	// DecodePayReq takes an encoded payment request string and attempts to decode
-	// it, returning a full description of the conditions encoded within the
-	// payment request.
-	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
-	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
-	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
-	// *
-	// DeleteAllPayments deletes all outgoing payments from DB.
-	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
-	// * lncli: `describegraph`
-	// DescribeGraph returns a description of the latest graph state from the
-	// point of view of the node. The graph information is partitioned into two
-	// components: all the nodes/vertexes, and all the edges that connect the
-	// vertexes themselves.  As this is a directed graph, the edges also contain
-	// the node directional specific routing policy which includes: the time lock
-	// delta, fee information, etc.
-	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
-	// * lncli: `getchaninfo`
-	// GetChanInfo returns the latest authenticated network announcement for the
-	// given channel identified by its channel ID: an 8-byte integer which
-	// uniquely identifies the location of transaction's funding output within the
-	// blockchain.
-	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
-	// * lncli: `getnodeinfo`
-	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
-	// channel information for the specified node identified by its public key.
-	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
-	// * lncli: `queryroutes`
-	// QueryRoutes attempts to query the daemon's Channel Router for a possible
-	// route to a target destination capable of carrying a specific amount of
-	// satoshis. The retuned route contains the full details required to craft and
-	// send an HTLC, also including the necessary information that should be
-	// present within the Sphinx packet encapsulated within the HTLC.
-	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
-	// * lncli: `getnetworkinfo`
-	// GetNetworkInfo returns some basic stats about the known channel graph from
-	// the point of view of the node.
-	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
-	// * lncli: `stop`
-	// StopDaemon will send a shutdown request to the interrupt handler, triggering
-	// a graceful shutdown of the daemon.
-	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
-	// *
-	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
-	// receive notifications upon any changes to the channel graph topology from
-	// the point of view of the responding node. Events notified include: new
-	// nodes coming online, nodes updating their authenticated attributes, new
-	// channels being advertised, updates in the routing policy for a directional
-	// channel edge, and when channels are closed on-chain.
-	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
-	// * lncli: `debuglevel`
-	// DebugLevel allows a caller to programmatically set the logging verbosity of
-	// lnd. The logging can be targeted according to a coarse daemon-wide logging
-	// level, or in a granular fashion to specify the logging for a target
-	// sub-system.
-	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
-	// * lncli: `feereport`
-	// FeeReport allows the caller to obtain a report detailing the current fee
-	// schedule enforced by the node globally for each channel.
-	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
-	// * lncli: `updatechanpolicy`
-	// UpdateChannelPolicy allows the caller to update the fee schedule and
-	// channel policies for all channels globally, or a particular channel.
-	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
-	// * lncli: `fwdinghistory`
-	// ForwardingHistory allows the caller to query the htlcswitch for a record of
-	// all HTLC's forwarded within the target time range, and integer offset
-	// within that time range. If no time-range is specified, then the first chunk
-	// of the past 24 hrs of forwarding history are returned.
-	//
-	// A list of forwarding events are returned. The size of each forwarding event
-	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
-	// As a result each message can only contain 50k entries.  Each response has
-	// the index offset of the last entry. The index offset can be provided to the
-	// request to allow the caller to skip a series of records.
-	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+func (m *ListInvoiceRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
 }
 
-type lightningClient struct {
-	cc *grpc.ClientConn
+func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
 }
 
-func NewLightningClient(cc *grpc.ClientConn) LightningClient {
-	return &lightningClient{cc}
+func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+	if m != nil {
+		return m.NumMaxInvoices
+	}
+	return 0
 }
 
-func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
-	out := new(WalletBalanceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
-	out := new(ChannelBalanceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListInvoiceResponse struct {
+	// *
+	// A list of invoices from the time slice of the time series specified in the
+	// request.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek backwards, pagination style.
+	FirstIndexOffset uint64 `protobuf:"varint,3,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
 }
 
-func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
-	out := new(TransactionDetails)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
+func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListInvoiceResponse) ProtoMessage()               {}
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+	if m != nil {
+		return m.Invoices
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
-	out := new(SendCoinsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[0], c.cc, "/lnrpc.Lightning/SubscribeTransactions", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeTransactionsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeTransactionsClient interface {
-	Recv() (*Transaction, error)
-	grpc.ClientStream
+type InvoiceSubscription struct {
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all added indexes with an add_index greater than this
+	// value. This allows callers to catch up on any events they missed while they
+	// weren't connected to the streaming RPC.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index" json:"add_index,omitempty"`
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all settled indexes with an settle_index greater than
+	// this value. This allows callers to catch up on any events they missed while
+	// they weren't connected to the streaming RPC.
+	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index" json:"settle_index,omitempty"`
 }
 
-type lightningSubscribeTransactionsClient struct {
-	grpc.ClientStream
-}
+func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
+func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceSubscription) ProtoMessage()               {}
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
-func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
-	m := new(Transaction)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *InvoiceSubscription) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
 	}
-	return m, nil
+	return 0
 }
 
-func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
-	out := new(SendManyResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
-	out := new(NewAddressResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Payment struct {
+	// / The payment hash
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash" json:"payment_hash,omitempty"`
+	// / Deprecated, use value_sat or value_msat.
+	Value int64 `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
+	// / The date of this payment
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date" json:"creation_date,omitempty"`
+	// / The path this payment took
+	Path []string `protobuf:"bytes,4,rep,name=path" json:"path,omitempty"`
+	// / The fee paid for this payment in satoshis
+	Fee int64 `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
+	// / The payment preimage
+	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage" json:"payment_preimage,omitempty"`
+	// / The value of the payment in satoshis
+	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat" json:"value_sat,omitempty"`
+	// / The value of the payment in milli-satoshis
+	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat" json:"value_msat,omitempty"`
 }
 
-func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
-	out := new(SignMessageResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) Reset()                    { *m = Payment{} }
+func (m *Payment) String() string            { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()               {}
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *Payment) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
-	out := new(VerifyMessageResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
-	out := new(ConnectPeerResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
-	out := new(DisconnectPeerResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetPath() []string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
-	out := new(ListPeersResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetPaymentPreimage() string {
+	if m != nil {
+		return m.PaymentPreimage
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
-	out := new(PendingChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetValueSat() int64 {
+	if m != nil {
+		return m.ValueSat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
-	out := new(ListChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetValueMsat() int64 {
+	if m != nil {
+		return m.ValueMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
-	out := new(ClosedChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListPaymentsRequest struct {
 }
 
-func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
-	out := new(ChannelPoint)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()               {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+type ListPaymentsResponse struct {
+	// / The list of payments
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
 }
 
-func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningOpenChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()               {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return x, nil
+	return nil
 }
 
-type Lightning_OpenChannelClient interface {
-	Recv() (*OpenStatusUpdate, error)
-	grpc.ClientStream
+type DeleteAllPaymentsRequest struct {
 }
 
-type lightningOpenChannelClient struct {
-	grpc.ClientStream
-}
+func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
+func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
 
-func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
-	m := new(OpenStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type DeleteAllPaymentsResponse struct {
 }
 
-func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningCloseChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
+func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
+func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
-type Lightning_CloseChannelClient interface {
-	Recv() (*CloseStatusUpdate, error)
-	grpc.ClientStream
+type AbandonChannelRequest struct {
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
 }
 
-type lightningCloseChannelClient struct {
-	grpc.ClientStream
-}
+func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
+func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbandonChannelRequest) ProtoMessage()               {}
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
 
-func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
-	m := new(CloseStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
-	out := new(AbandonChannelResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AbandonChannelResponse struct {
 }
 
-func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSendPaymentClient{stream}
-	return x, nil
-}
+func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
+func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbandonChannelResponse) ProtoMessage()               {}
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
 
-type Lightning_SendPaymentClient interface {
-	Send(*SendRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+type DebugLevelRequest struct {
+	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec" json:"level_spec,omitempty"`
 }
 
-type lightningSendPaymentClient struct {
-	grpc.ClientStream
-}
+func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
+func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
+func (*DebugLevelRequest) ProtoMessage()               {}
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
 
-func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *DebugLevelRequest) GetShow() bool {
+	if m != nil {
+		return m.Show
+	}
+	return false
 }
 
-func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DebugLevelRequest) GetLevelSpec() string {
+	if m != nil {
+		return m.LevelSpec
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DebugLevelResponse struct {
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems" json:"sub_systems,omitempty"`
 }
 
-func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
-	if err != nil {
-		return nil, err
+func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
+func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
+func (*DebugLevelResponse) ProtoMessage()               {}
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *DebugLevelResponse) GetSubSystems() string {
+	if m != nil {
+		return m.SubSystems
 	}
-	x := &lightningSendToRouteClient{stream}
-	return x, nil
+	return ""
 }
 
-type Lightning_SendToRouteClient interface {
-	Send(*SendToRouteRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+type PayReqString struct {
+	// / The payment request string to be decoded
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq" json:"pay_req,omitempty"`
 }
 
-type lightningSendToRouteClient struct {
-	grpc.ClientStream
-}
+func (m *PayReqString) Reset()                    { *m = PayReqString{} }
+func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
+func (*PayReqString) ProtoMessage()               {}
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
 
-func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *PayReqString) GetPayReq() string {
+	if m != nil {
+		return m.PayReq
+	}
+	return ""
 }
 
-func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type PayReq struct {
+	Destination     string       `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
+	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash" json:"payment_hash,omitempty"`
+	NumSatoshis     int64        `protobuf:"varint,3,opt,name=num_satoshis" json:"num_satoshis,omitempty"`
+	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Expiry          int64        `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+	Description     string       `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash" json:"description_hash,omitempty"`
+	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
+	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
+	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints" json:"route_hints,omitempty"`
 }
 
-func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) Reset()                    { *m = PayReq{} }
+func (m *PayReq) String() string            { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()               {}
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *PayReq) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
-	out := new(AddInvoiceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
-	out := new(ListInvoiceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) GetNumSatoshis() int64 {
+	if m != nil {
+		return m.NumSatoshis
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
-	out := new(Invoice)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeInvoicesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *PayReq) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeInvoicesClient interface {
-	Recv() (*Invoice, error)
-	grpc.ClientStream
+func (m *PayReq) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-type lightningSubscribeInvoicesClient struct {
-	grpc.ClientStream
+func (m *PayReq) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
 }
 
-func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
-	m := new(Invoice)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PayReq) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
-	out := new(PayReq)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) GetCltvExpiry() int64 {
+	if m != nil {
+		return m.CltvExpiry
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
-	out := new(ListPaymentsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayReq) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
-	out := new(DeleteAllPaymentsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type FeeReportRequest struct {
 }
 
-func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
-	out := new(ChannelGraph)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()               {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+type ChannelFeeReport struct {
+	// / The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point" json:"chan_point,omitempty"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
+	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil" json:"fee_per_mil,omitempty"`
+	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
 }
 
-func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
-	out := new(ChannelEdge)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()               {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *ChannelFeeReport) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
-	out := new(NodeInfo)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
-	out := new(QueryRoutesResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
+	if m != nil {
+		return m.FeePerMil
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
-	out := new(NetworkInfo)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelFeeReport) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
-	out := new(StopResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type FeeReportResponse struct {
+	// / An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees" json:"channel_fees,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum" json:"day_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum" json:"week_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum uint64 `protobuf:"varint,4,opt,name=month_fee_sum" json:"month_fee_sum,omitempty"`
+}
+
+func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()               {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+	if m != nil {
+		return m.ChannelFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+	if m != nil {
+		return m.DayFeeSum
 	}
-	x := &lightningSubscribeChannelGraphClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+	if m != nil {
+		return m.WeekFeeSum
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+	if m != nil {
+		return m.MonthFeeSum
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeChannelGraphClient interface {
-	Recv() (*GraphTopologyUpdate, error)
-	grpc.ClientStream
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
+	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
+	// / The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
 }
 
-type lightningSubscribeChannelGraphClient struct {
-	grpc.ClientStream
+func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()               {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{132} }
+
+type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
+
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,oneof"`
+}
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,oneof"`
 }
 
-func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
-	m := new(GraphTopologyUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope()    {}
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+	if m != nil {
+		return m.Scope
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
-	out := new(DebugLevelResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
-	out := new(FeeReportResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
+		return x.ChanPoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
-	out := new(PolicyUpdateResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
-	out := new(ForwardingHistoryResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return out, nil
+	return 0
 }
 
-// Server API for Lightning service
+func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+	if m != nil {
+		return m.TimeLockDelta
+	}
+	return 0
+}
 
-type LightningServer interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in satoshis.
-	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or sat_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
-	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
+		(*PolicyUpdateRequest_Global)(nil),
+		(*PolicyUpdateRequest_ChanPoint)(nil),
+	}
+}
+
+func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		t := uint64(0)
+		if x.Global {
+			t = 1
+		}
+		b.EncodeVarint(1<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *PolicyUpdateRequest_ChanPoint:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPoint); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PolicyUpdateRequest)
+	switch tag {
+	case 1: // scope.global
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Scope = &PolicyUpdateRequest_Global{x != 0}
+		return true, err
+	case 2: // scope.chan_point
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		n += proto.SizeVarint(1<<3 | proto.WireVarint)
+		n += 1
+	case *PolicyUpdateRequest_ChanPoint:
+		s := proto.Size(x.ChanPoint)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type PolicyUpdateResponse struct {
+}
+
+func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()               {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{133} }
+
+type ForwardingHistoryRequest struct {
+	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
+	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time" json:"end_time,omitempty"`
+	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset" json:"index_offset,omitempty"`
+	// / The max number of events to return in the response to this query.
+	NumMaxEvents uint32 `protobuf:"varint,4,opt,name=num_max_events" json:"num_max_events,omitempty"`
+}
+
+func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
+func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingHistoryRequest) ProtoMessage()               {}
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+	if m != nil {
+		return m.NumMaxEvents
+	}
+	return 0
+}
+
+type ForwardingEvent struct {
+	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// / The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
+	// / The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
+	// / The total amount of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
+	// / The total amount of the outgoign HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
+	// / The total fee that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+}
+
+func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()               {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *ForwardingEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
+	if m != nil {
+		return m.ChanIdIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
+	if m != nil {
+		return m.ChanIdOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtIn() uint64 {
+	if m != nil {
+		return m.AmtIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtOut() uint64 {
+	if m != nil {
+		return m.AmtOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type ForwardingHistoryResponse struct {
+	// / A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
+	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+}
+
+func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()               {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+	if m != nil {
+		return m.ForwardingEvents
+	}
+	return nil
+}
+
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
+
+type AddTowerRequest struct {
+	// / The identifying public key of the watchtower to add.
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / A network address the watchtower is reachable over.
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *AddTowerRequest) Reset()                    { *m = AddTowerRequest{} }
+func (m *AddTowerRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddTowerRequest) ProtoMessage()               {}
+func (*AddTowerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{137} }
+
+func (m *AddTowerRequest) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *AddTowerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type AddTowerResponse struct {
+}
+
+func (m *AddTowerResponse) Reset()                    { *m = AddTowerResponse{} }
+func (m *AddTowerResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddTowerResponse) ProtoMessage()               {}
+func (*AddTowerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+type RemoveTowerRequest struct {
+	// / The identifying public key of the watchtower to remove.
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// If set, then the record for this address will be removed, indicating that
+	// it is stale. Otherwise, the watchtower will no longer be used for future
+	// session negotiations and backups.
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *RemoveTowerRequest) Reset()                    { *m = RemoveTowerRequest{} }
+func (m *RemoveTowerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveTowerRequest) ProtoMessage()               {}
+func (*RemoveTowerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+func (m *RemoveTowerRequest) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *RemoveTowerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RemoveTowerResponse struct {
+}
+
+func (m *RemoveTowerResponse) Reset()                    { *m = RemoveTowerResponse{} }
+func (m *RemoveTowerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemoveTowerResponse) ProtoMessage()               {}
+func (*RemoveTowerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+type ListTowersRequest struct {
+	// / Whether we should include sessions with the watchtower in the response.
+	IncludeSessions bool `protobuf:"varint,1,opt,name=include_sessions" json:"include_sessions,omitempty"`
+}
+
+func (m *ListTowersRequest) Reset()                    { *m = ListTowersRequest{} }
+func (m *ListTowersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTowersRequest) ProtoMessage()               {}
+func (*ListTowersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+func (m *ListTowersRequest) GetIncludeSessions() bool {
+	if m != nil {
+		return m.IncludeSessions
+	}
+	return false
+}
+
+type TowerSession struct {
+	// / The total number of successful backups that have been made to the watchtower session.
+	NumBackups uint32 `protobuf:"varint,1,opt,name=num_backups" json:"num_backups,omitempty"`
+	// / The total number of backups in the session that are currently pending to be acknowledged by the watchtower.
+	NumPendingBackups uint32 `protobuf:"varint,2,opt,name=num_pending_backups" json:"num_pending_backups,omitempty"`
+	// / The maximum number of backups allowed by the watchtower session.
+	MaxBackups uint32 `protobuf:"varint,3,opt,name=max_backups" json:"max_backups,omitempty"`
+	// / The fee rate, in satoshis per byte, that will be used by the watchtower for the justice transaction in the event of a channel breach.
+	SweepSatPerByte uint64 `protobuf:"varint,4,opt,name=sweep_sat_per_byte" json:"sweep_sat_per_byte,omitempty"`
+	// / Whether the session can still be used for new backups.
+	Active bool `protobuf:"varint,5,opt,name=active" json:"active,omitempty"`
+}
+
+func (m *TowerSession) Reset()                    { *m = TowerSession{} }
+func (m *TowerSession) String() string            { return proto.CompactTextString(m) }
+func (*TowerSession) ProtoMessage()               {}
+func (*TowerSession) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+func (m *TowerSession) GetNumBackups() uint32 {
+	if m != nil {
+		return m.NumBackups
+	}
+	return 0
+}
+
+func (m *TowerSession) GetNumPendingBackups() uint32 {
+	if m != nil {
+		return m.NumPendingBackups
+	}
+	return 0
+}
+
+func (m *TowerSession) GetMaxBackups() uint32 {
+	if m != nil {
+		return m.MaxBackups
+	}
+	return 0
+}
+
+func (m *TowerSession) GetSweepSatPerByte() uint64 {
+	if m != nil {
+		return m.SweepSatPerByte
+	}
+	return 0
+}
+
+func (m *TowerSession) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type Tower struct {
+	// / The identifying public key of the watchtower.
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / The list of addresses the watchtower is reachable over.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
+	// / The number of sessions that have been negotiated with the watchtower.
+	NumSessions uint32 `protobuf:"varint,3,opt,name=num_sessions" json:"num_sessions,omitempty"`
+	// / The list of sessions that have been negotiated with the watchtower.
+	Sessions []*TowerSession `protobuf:"bytes,4,rep,name=sessions" json:"sessions,omitempty"`
+}
+
+func (m *Tower) Reset()                    { *m = Tower{} }
+func (m *Tower) String() string            { return proto.CompactTextString(m) }
+func (*Tower) ProtoMessage()               {}
+func (*Tower) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+func (m *Tower) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Tower) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *Tower) GetNumSessions() uint32 {
+	if m != nil {
+		return m.NumSessions
+	}
+	return 0
+}
+
+func (m *Tower) GetSessions() []*TowerSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type ListTowersResponse struct {
+	// / The list of watchtowers available for new backups.
+	Towers []*Tower `protobuf:"bytes,1,rep,name=towers" json:"towers,omitempty"`
+}
+
+func (m *ListTowersResponse) Reset()                    { *m = ListTowersResponse{} }
+func (m *ListTowersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTowersResponse) ProtoMessage()               {}
+func (*ListTowersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+func (m *ListTowersResponse) GetTowers() []*Tower {
+	if m != nil {
+		return m.Towers
+	}
+	return nil
+}
+
+type TowerClientStatsRequest struct {
+}
+
+func (m *TowerClientStatsRequest) Reset()                    { *m = TowerClientStatsRequest{} }
+func (m *TowerClientStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*TowerClientStatsRequest) ProtoMessage()               {}
+func (*TowerClientStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+type TowerClientStatsResponse struct {
+	// / The total number of backups requested by the client's channels.
+	NumBackupsReceived uint64 `protobuf:"varint,1,opt,name=num_backups_received" json:"num_backups_received,omitempty"`
+	// / The total number of backups acknowledged by the client's watchtowers.
+	NumBackupsAccepted uint64 `protobuf:"varint,2,opt,name=num_backups_accepted" json:"num_backups_accepted,omitempty"`
+	// / The total number of backups that could not be made, either because they did not satisfy the session policy, or because no watchtowers were registered.
+	NumBackupsIneligible uint64 `protobuf:"varint,3,opt,name=num_backups_ineligible" json:"num_backups_ineligible,omitempty"`
+	// / The total number of new sessions made to watchtowers.
+	NumSessionsAcquired uint64 `protobuf:"varint,4,opt,name=num_sessions_acquired" json:"num_sessions_acquired,omitempty"`
+	// / The total number of watchtower sessions that have been exhausted.
+	NumSessionsExhausted uint64 `protobuf:"varint,5,opt,name=num_sessions_exhausted" json:"num_sessions_exhausted,omitempty"`
+}
+
+func (m *TowerClientStatsResponse) Reset()                    { *m = TowerClientStatsResponse{} }
+func (m *TowerClientStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*TowerClientStatsResponse) ProtoMessage()               {}
+func (*TowerClientStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+func (m *TowerClientStatsResponse) GetNumBackupsReceived() uint64 {
+	if m != nil {
+		return m.NumBackupsReceived
+	}
+	return 0
+}
+
+func (m *TowerClientStatsResponse) GetNumBackupsAccepted() uint64 {
+	if m != nil {
+		return m.NumBackupsAccepted
+	}
+	return 0
+}
+
+func (m *TowerClientStatsResponse) GetNumBackupsIneligible() uint64 {
+	if m != nil {
+		return m.NumBackupsIneligible
+	}
+	return 0
+}
+
+func (m *TowerClientStatsResponse) GetNumSessionsAcquired() uint64 {
+	if m != nil {
+		return m.NumSessionsAcquired
+	}
+	return 0
+}
+
+func (m *TowerClientStatsResponse) GetNumSessionsExhausted() uint64 {
+	if m != nil {
+		return m.NumSessionsExhausted
+	}
+	return 0
+}
+
+type ExportChannelBackupRequest struct {
+	// / The target channel point to obtain a back up for. If unset, backups for all open channels are returned.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point" json:"chan_point,omitempty"`
+}
+
+func (m *ExportChannelBackupRequest) Reset()                    { *m = ExportChannelBackupRequest{} }
+func (m *ExportChannelBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportChannelBackupRequest) ProtoMessage()               {}
+func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *ExportChannelBackupRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type ChannelBackup struct {
+	// / Identifies the channel that this backup belongs to.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point" json:"chan_point,omitempty"`
+	// / Is an encrypted single-chan backup. This can be passed to RestoreChannelBackups, or the WalletUnlocker Init and Unlock methods in order to trigger the recovery protocol.
+	ChanBackup []byte `protobuf:"bytes,2,opt,name=chan_backup,proto3" json:"chan_backup,omitempty"`
+}
+
+func (m *ChannelBackup) Reset()                    { *m = ChannelBackup{} }
+func (m *ChannelBackup) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBackup) ProtoMessage()               {}
+func (*ChannelBackup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *ChannelBackup) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelBackup) GetChanBackup() []byte {
+	if m != nil {
+		return m.ChanBackup
+	}
+	return nil
+}
+
+type MultiChanBackup struct {
+	// / Is the set of all channels that are included in this multi-channel backup.
+	ChanPoints []*ChannelPoint `protobuf:"bytes,1,rep,name=chan_points" json:"chan_points,omitempty"`
+	// / A single encrypted blob containing all the static channel backups of the channel listed above. This can be stored as a single file or blob, and safely be replaced with any prior/future versions.
+	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
+}
+
+func (m *MultiChanBackup) Reset()                    { *m = MultiChanBackup{} }
+func (m *MultiChanBackup) String() string            { return proto.CompactTextString(m) }
+func (*MultiChanBackup) ProtoMessage()               {}
+func (*MultiChanBackup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+func (m *MultiChanBackup) GetChanPoints() []*ChannelPoint {
+	if m != nil {
+		return m.ChanPoints
+	}
+	return nil
+}
+
+func (m *MultiChanBackup) GetMultiChanBackup() []byte {
+	if m != nil {
+		return m.MultiChanBackup
+	}
+	return nil
+}
+
+type ChannelBackups struct {
+	// / A set of single-chan static channel backups.
+	ChanBackups []*ChannelBackup `protobuf:"bytes,1,rep,name=chan_backups" json:"chan_backups,omitempty"`
+}
+
+func (m *ChannelBackups) Reset()                    { *m = ChannelBackups{} }
+func (m *ChannelBackups) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBackups) ProtoMessage()               {}
+func (*ChannelBackups) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{150} }
+
+func (m *ChannelBackups) GetChanBackups() []*ChannelBackup {
+	if m != nil {
+		return m.ChanBackups
+	}
+	return nil
+}
+
+type ChanBackupSnapshot struct {
+	// / The set of new channels that have been added since the last channel backup snapshot was requested.
+	SingleChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=single_chan_backups" json:"single_chan_backups,omitempty"`
+	// / A multi-channel backup that covers all open channels currently known to lnd.
+	MultiChanBackup *MultiChanBackup `protobuf:"bytes,2,opt,name=multi_chan_backup" json:"multi_chan_backup,omitempty"`
+}
+
+func (m *ChanBackupSnapshot) Reset()                    { *m = ChanBackupSnapshot{} }
+func (m *ChanBackupSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*ChanBackupSnapshot) ProtoMessage()               {}
+func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+func (m *ChanBackupSnapshot) GetSingleChanBackups() *ChannelBackups {
+	if m != nil {
+		return m.SingleChanBackups
+	}
+	return nil
+}
+
+func (m *ChanBackupSnapshot) GetMultiChanBackup() *MultiChanBackup {
+	if m != nil {
+		return m.MultiChanBackup
+	}
+	return nil
+}
+
+type VerifyChanBackupResponse struct {
+}
+
+func (m *VerifyChanBackupResponse) Reset()                    { *m = VerifyChanBackupResponse{} }
+func (m *VerifyChanBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyChanBackupResponse) ProtoMessage()               {}
+func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+type RestoreChanBackupRequest struct {
+	// Types that are valid to be assigned to Backup:
+	//	*RestoreChanBackupRequest_ChanBackups
+	//	*RestoreChanBackupRequest_MultiChanBackup
+	Backup isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
+}
+
+func (m *RestoreChanBackupRequest) Reset()                    { *m = RestoreChanBackupRequest{} }
+func (m *RestoreChanBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreChanBackupRequest) ProtoMessage()               {}
+func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+type isRestoreChanBackupRequest_Backup interface{ isRestoreChanBackupRequest_Backup() }
+
+type RestoreChanBackupRequest_ChanBackups struct {
+	ChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=chan_backups,oneof"`
+}
+type RestoreChanBackupRequest_MultiChanBackup struct {
+	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3,oneof"`
+}
+
+func (*RestoreChanBackupRequest_ChanBackups) isRestoreChanBackupRequest_Backup()     {}
+func (*RestoreChanBackupRequest_MultiChanBackup) isRestoreChanBackupRequest_Backup() {}
+
+func (m *RestoreChanBackupRequest) GetBackup() isRestoreChanBackupRequest_Backup {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *RestoreChanBackupRequest) GetChanBackups() *ChannelBackups {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_ChanBackups); ok {
+		return x.ChanBackups
+	}
+	return nil
+}
+
+func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_MultiChanBackup); ok {
+		return x.MultiChanBackup
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RestoreChanBackupRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RestoreChanBackupRequest_OneofMarshaler, _RestoreChanBackupRequest_OneofUnmarshaler, _RestoreChanBackupRequest_OneofSizer, []interface{}{
+		(*RestoreChanBackupRequest_ChanBackups)(nil),
+		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
+	}
+}
+
+func _RestoreChanBackupRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanBackups); err != nil {
+			return err
+		}
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.MultiChanBackup)
+	case nil:
+	default:
+		return fmt.Errorf("RestoreChanBackupRequest.Backup has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _RestoreChanBackupRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RestoreChanBackupRequest)
+	switch tag {
+	case 1: // backup.chan_backups
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelBackups)
+		err := b.DecodeMessage(msg)
+		m.Backup = &RestoreChanBackupRequest_ChanBackups{msg}
+		return true, err
+	case 2: // backup.multi_chan_backup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Backup = &RestoreChanBackupRequest_MultiChanBackup{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _RestoreChanBackupRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		s := proto.Size(x.ChanBackups)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.MultiChanBackup)))
+		n += len(x.MultiChanBackup)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type RestoreBackupResponse struct {
+}
+
+func (m *RestoreBackupResponse) Reset()                    { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()               {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+type KeyLocator struct {
+	// / The family of key being identified.
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family" json:"key_family,omitempty"`
+	// / The precise index of the key being identified.
+	KeyIndex uint32 `protobuf:"varint,2,opt,name=key_index" json:"key_index,omitempty"`
+}
+
+func (m *KeyLocator) Reset()                    { *m = KeyLocator{} }
+func (m *KeyLocator) String() string            { return proto.CompactTextString(m) }
+func (*KeyLocator) ProtoMessage()               {}
+func (*KeyLocator) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+func (m *KeyLocator) GetKeyFamily() uint32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+func (m *KeyLocator) GetKeyIndex() uint32 {
+	if m != nil {
+		return m.KeyIndex
+	}
+	return 0
+}
+
+type KeyDescriptor struct {
+	// / The raw bytes of the compressed public key, which may be left empty if a key locator is set.
+	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,proto3" json:"raw_key_bytes,omitempty"`
+	// / The key locator that identifies which key to use.
+	KeyLoc *KeyLocator `protobuf:"bytes,2,opt,name=key_loc" json:"key_loc,omitempty"`
+}
+
+func (m *KeyDescriptor) Reset()                    { *m = KeyDescriptor{} }
+func (m *KeyDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*KeyDescriptor) ProtoMessage()               {}
+func (*KeyDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+func (m *KeyDescriptor) GetRawKeyBytes() []byte {
+	if m != nil {
+		return m.RawKeyBytes
+	}
+	return nil
+}
+
+func (m *KeyDescriptor) GetKeyLoc() *KeyLocator {
+	if m != nil {
+		return m.KeyLoc
+	}
+	return nil
+}
+
+type KeyReq struct {
+	// / The family of key to derive the next unused key of.
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family" json:"key_family,omitempty"`
+}
+
+func (m *KeyReq) Reset()                    { *m = KeyReq{} }
+func (m *KeyReq) String() string            { return proto.CompactTextString(m) }
+func (*KeyReq) ProtoMessage()               {}
+func (*KeyReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *KeyReq) GetKeyFamily() uint32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+type TxOut struct {
+	// / The value of the output being spent.
+	Value int64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+	// / The script of the output being spent.
+	PkScript []byte `protobuf:"bytes,2,opt,name=pk_script,proto3" json:"pk_script,omitempty"`
+}
+
+func (m *TxOut) Reset()                    { *m = TxOut{} }
+func (m *TxOut) String() string            { return proto.CompactTextString(m) }
+func (*TxOut) ProtoMessage()               {}
+func (*TxOut) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *TxOut) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TxOut) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
+type SignDescriptor struct {
+	// / The key descriptor of the key to sign with.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,1,opt,name=key_desc" json:"key_desc,omitempty"`
+	// / A scalar that the private key is tweaked with by addition before signing, in order to produce signatures for a derived key.
+	SingleTweak []byte `protobuf:"bytes,2,opt,name=single_tweak,proto3" json:"single_tweak,omitempty"`
+	// / A private key that the private key is tweaked with as for revocation keys, in order to sign for them.
+	DoubleTweak []byte `protobuf:"bytes,3,opt,name=double_tweak,proto3" json:"double_tweak,omitempty"`
+	// / The full script of the output being spent, for p2wsh outputs.
+	WitnessScript []byte `protobuf:"bytes,4,opt,name=witness_script,proto3" json:"witness_script,omitempty"`
+	// / The output being spent.
+	Output *TxOut `protobuf:"bytes,5,opt,name=output" json:"output,omitempty"`
+	// / The sighash type to use when generating the signature.
+	Sighash uint32 `protobuf:"varint,6,opt,name=sighash" json:"sighash,omitempty"`
+	// / The index of the input being signed within the transaction.
+	InputIndex int32 `protobuf:"varint,7,opt,name=input_index" json:"input_index,omitempty"`
+	// / The derivation path of the key that controls the wallet output being spent. It's set by watch-only nodes when computing input scripts.
+	WalletKeyPath *WalletKeyPath `protobuf:"bytes,8,opt,name=wallet_key_path" json:"wallet_key_path,omitempty"`
+}
+
+func (m *SignDescriptor) Reset()                    { *m = SignDescriptor{} }
+func (m *SignDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*SignDescriptor) ProtoMessage()               {}
+func (*SignDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *SignDescriptor) GetKeyDesc() *KeyDescriptor {
+	if m != nil {
+		return m.KeyDesc
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetSingleTweak() []byte {
+	if m != nil {
+		return m.SingleTweak
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetDoubleTweak() []byte {
+	if m != nil {
+		return m.DoubleTweak
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetWitnessScript() []byte {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetOutput() *TxOut {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *SignDescriptor) GetSighash() uint32 {
+	if m != nil {
+		return m.Sighash
+	}
+	return 0
+}
+
+func (m *SignDescriptor) GetInputIndex() int32 {
+	if m != nil {
+		return m.InputIndex
+	}
+	return 0
+}
+
+func (m *SignDescriptor) GetWalletKeyPath() *WalletKeyPath {
+	if m != nil {
+		return m.WalletKeyPath
+	}
+	return nil
+}
+
+type WalletKeyPath struct {
+	// / The purpose of the key scope of the wallet account.
+	Purpose uint32 `protobuf:"varint,1,opt,name=purpose" json:"purpose,omitempty"`
+	// / The coin type of the key scope of the wallet account.
+	CoinType uint32 `protobuf:"varint,2,opt,name=coin_type" json:"coin_type,omitempty"`
+	// / The number of the wallet account.
+	Account uint32 `protobuf:"varint,3,opt,name=account" json:"account,omitempty"`
+	// / The branch of the key, which is 0 for receive and 1 for change addresses.
+	Branch uint32 `protobuf:"varint,4,opt,name=branch" json:"branch,omitempty"`
+	// / The index of the key within its branch.
+	Index uint32 `protobuf:"varint,5,opt,name=index" json:"index,omitempty"`
+}
+
+func (m *WalletKeyPath) Reset()                    { *m = WalletKeyPath{} }
+func (m *WalletKeyPath) String() string            { return proto.CompactTextString(m) }
+func (*WalletKeyPath) ProtoMessage()               {}
+func (*WalletKeyPath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+func (m *WalletKeyPath) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetCoinType() uint32 {
+	if m != nil {
+		return m.CoinType
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetBranch() uint32 {
+	if m != nil {
+		return m.Branch
+	}
+	return 0
+}
+
+func (m *WalletKeyPath) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type SignReq struct {
+	// / The serialized transaction to sign.
+	RawTxBytes []byte `protobuf:"bytes,1,opt,name=raw_tx_bytes,proto3" json:"raw_tx_bytes,omitempty"`
+	// / A sign descriptor for each input that should be signed.
+	SignDescs []*SignDescriptor `protobuf:"bytes,2,rep,name=sign_descs" json:"sign_descs,omitempty"`
+}
+
+func (m *SignReq) Reset()                    { *m = SignReq{} }
+func (m *SignReq) String() string            { return proto.CompactTextString(m) }
+func (*SignReq) ProtoMessage()               {}
+func (*SignReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{161} }
+
+func (m *SignReq) GetRawTxBytes() []byte {
+	if m != nil {
+		return m.RawTxBytes
+	}
+	return nil
+}
+
+func (m *SignReq) GetSignDescs() []*SignDescriptor {
+	if m != nil {
+		return m.SignDescs
+	}
+	return nil
+}
+
+type SignResp struct {
+	// / The signatures of the inputs, in the order of the sign descriptors.
+	RawSigs [][]byte `protobuf:"bytes,1,rep,name=raw_sigs,proto3" json:"raw_sigs,omitempty"`
+}
+
+func (m *SignResp) Reset()                    { *m = SignResp{} }
+func (m *SignResp) String() string            { return proto.CompactTextString(m) }
+func (*SignResp) ProtoMessage()               {}
+func (*SignResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{162} }
+
+func (m *SignResp) GetRawSigs() [][]byte {
+	if m != nil {
+		return m.RawSigs
+	}
+	return nil
+}
+
+type InputScript struct {
+	// / The witness of the input.
+	Witness [][]byte `protobuf:"bytes,1,rep,name=witness,proto3" json:"witness,omitempty"`
+	// / The sigScript of the input, which is only set for np2wkh outputs.
+	SigScript []byte `protobuf:"bytes,2,opt,name=sig_script,proto3" json:"sig_script,omitempty"`
+}
+
+func (m *InputScript) Reset()                    { *m = InputScript{} }
+func (m *InputScript) String() string            { return proto.CompactTextString(m) }
+func (*InputScript) ProtoMessage()               {}
+func (*InputScript) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+func (m *InputScript) GetWitness() [][]byte {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+func (m *InputScript) GetSigScript() []byte {
+	if m != nil {
+		return m.SigScript
+	}
+	return nil
+}
+
+type InputScriptResp struct {
+	// / The input scripts, in the order of the sign descriptors.
+	InputScripts []*InputScript `protobuf:"bytes,1,rep,name=input_scripts" json:"input_scripts,omitempty"`
+}
+
+func (m *InputScriptResp) Reset()                    { *m = InputScriptResp{} }
+func (m *InputScriptResp) String() string            { return proto.CompactTextString(m) }
+func (*InputScriptResp) ProtoMessage()               {}
+func (*InputScriptResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+func (m *InputScriptResp) GetInputScripts() []*InputScript {
+	if m != nil {
+		return m.InputScripts
+	}
+	return nil
+}
+
+type KeySignMessageRequest struct {
+	// / The compressed public key of the key to sign with.
+	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,proto3" json:"raw_key_bytes,omitempty"`
+	// / The message to sign.
+	Msg []byte `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *KeySignMessageRequest) Reset()                    { *m = KeySignMessageRequest{} }
+func (m *KeySignMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*KeySignMessageRequest) ProtoMessage()               {}
+func (*KeySignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+func (m *KeySignMessageRequest) GetRawKeyBytes() []byte {
+	if m != nil {
+		return m.RawKeyBytes
+	}
+	return nil
+}
+
+func (m *KeySignMessageRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+type KeySignMessageResponse struct {
+	// / The DER encoded signature.
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *KeySignMessageResponse) Reset()                    { *m = KeySignMessageResponse{} }
+func (m *KeySignMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*KeySignMessageResponse) ProtoMessage()               {}
+func (*KeySignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{166} }
+
+func (m *KeySignMessageResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type ExportWatchOnlyWalletRequest struct {
+}
+
+func (m *ExportWatchOnlyWalletRequest) Reset()                    { *m = ExportWatchOnlyWalletRequest{} }
+func (m *ExportWatchOnlyWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletRequest) ProtoMessage()               {}
+func (*ExportWatchOnlyWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+type ExportWatchOnlyWalletResponse struct {
+	// / The watch-only copy of the signer's wallet database.
+	WalletDb []byte `protobuf:"bytes,1,opt,name=wallet_db,proto3" json:"wallet_db,omitempty"`
+}
+
+func (m *ExportWatchOnlyWalletResponse) Reset()         { *m = ExportWatchOnlyWalletResponse{} }
+func (m *ExportWatchOnlyWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletResponse) ProtoMessage()    {}
+func (*ExportWatchOnlyWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{168}
+}
+
+func (m *ExportWatchOnlyWalletResponse) GetWalletDb() []byte {
+	if m != nil {
+		return m.WalletDb
+	}
+	return nil
+}
+
+type DerivePrivKeyResponse struct {
+	// / The raw bytes of the private key.
+	RawPrivKey []byte `protobuf:"bytes,1,opt,name=raw_priv_key,proto3" json:"raw_priv_key,omitempty"`
+}
+
+func (m *DerivePrivKeyResponse) Reset()                    { *m = DerivePrivKeyResponse{} }
+func (m *DerivePrivKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*DerivePrivKeyResponse) ProtoMessage()               {}
+func (*DerivePrivKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+func (m *DerivePrivKeyResponse) GetRawPrivKey() []byte {
+	if m != nil {
+		return m.RawPrivKey
+	}
+	return nil
+}
+
+type SharedKeyRequest struct {
+	// / The key descriptor of our private key. DeriveSharedKey uses the node key if this is unset.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,1,opt,name=key_desc" json:"key_desc,omitempty"`
+	// / The compressed public key to perform the ECDH operation with.
+	EphemeralPubkey []byte `protobuf:"bytes,2,opt,name=ephemeral_pubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+}
+
+func (m *SharedKeyRequest) Reset()                    { *m = SharedKeyRequest{} }
+func (m *SharedKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SharedKeyRequest) ProtoMessage()               {}
+func (*SharedKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *SharedKeyRequest) GetKeyDesc() *KeyDescriptor {
+	if m != nil {
+		return m.KeyDesc
+	}
+	return nil
+}
+
+func (m *SharedKeyRequest) GetEphemeralPubkey() []byte {
+	if m != nil {
+		return m.EphemeralPubkey
+	}
+	return nil
+}
+
+type SharedKeyResponse struct {
+	// / The SHA-256 of the compressed shared point.
+	SharedKey []byte `protobuf:"bytes,1,opt,name=shared_key,proto3" json:"shared_key,omitempty"`
+}
+
+func (m *SharedKeyResponse) Reset()                    { *m = SharedKeyResponse{} }
+func (m *SharedKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*SharedKeyResponse) ProtoMessage()               {}
+func (*SharedKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
+
+func (m *SharedKeyResponse) GetSharedKey() []byte {
+	if m != nil {
+		return m.SharedKey
+	}
+	return nil
+}
+
+type PublishTxRequest struct {
+	// / The serialized, fully signed transaction to broadcast.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,proto3" json:"raw_tx,omitempty"`
+}
+
+func (m *PublishTxRequest) Reset()                    { *m = PublishTxRequest{} }
+func (m *PublishTxRequest) String() string            { return proto.CompactTextString(m) }
+func (*PublishTxRequest) ProtoMessage()               {}
+func (*PublishTxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+func (m *PublishTxRequest) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+type PublishTxResponse struct {
+}
+
+func (m *PublishTxResponse) Reset()                    { *m = PublishTxResponse{} }
+func (m *PublishTxResponse) String() string            { return proto.CompactTextString(m) }
+func (*PublishTxResponse) ProtoMessage()               {}
+func (*PublishTxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+type EstimateFeeRequest struct {
+	// / The number of blocks the transaction should confirm within.
+	ConfTarget int32 `protobuf:"varint,1,opt,name=conf_target" json:"conf_target,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()                    { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()               {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
+
+func (m *EstimateFeeRequest) GetConfTarget() int32 {
+	if m != nil {
+		return m.ConfTarget
+	}
+	return 0
+}
+
+type EstimateFeeResponse struct {
+	// / The estimated fee rate in sat/kw.
+	SatPerKw int64 `protobuf:"varint,1,opt,name=sat_per_kw" json:"sat_per_kw,omitempty"`
+}
+
+func (m *EstimateFeeResponse) Reset()                    { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()               {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{175} }
+
+func (m *EstimateFeeResponse) GetSatPerKw() int64 {
+	if m != nil {
+		return m.SatPerKw
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
+	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
+	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
+	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
+	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
+	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
+	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
+	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
+	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
+	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
+	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
+	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
+	proto.RegisterType((*OutPoint)(nil), "lnrpc.OutPoint")
+	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
+	proto.RegisterType((*ListUnspentRequest)(nil), "lnrpc.ListUnspentRequest")
+	proto.RegisterType((*ListUnspentResponse)(nil), "lnrpc.ListUnspentResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "lnrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "lnrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "lnrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "lnrpc.ReleaseOutputResponse")
+	proto.RegisterType((*LabelTransactionRequest)(nil), "lnrpc.LabelTransactionRequest")
+	proto.RegisterType((*LabelTransactionResponse)(nil), "lnrpc.LabelTransactionResponse")
+	proto.RegisterType((*Account)(nil), "lnrpc.Account")
+	proto.RegisterType((*ImportAccountRequest)(nil), "lnrpc.ImportAccountRequest")
+	proto.RegisterType((*ImportAccountResponse)(nil), "lnrpc.ImportAccountResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "lnrpc.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "lnrpc.ListAccountsResponse")
+	proto.RegisterType((*FundPsbtRequest)(nil), "lnrpc.FundPsbtRequest")
+	proto.RegisterType((*FundPsbtResponse)(nil), "lnrpc.FundPsbtResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "lnrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "lnrpc.BumpFeeResponse")
+	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
+	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
+	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
+	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
+	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
+	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
+	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
+	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
+	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
+	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
+	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
+	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
+	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
+	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
+	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
+	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
+	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
+	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
+	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
+	proto.RegisterType((*SpliceChannelRequest)(nil), "lnrpc.SpliceChannelRequest")
+	proto.RegisterType((*SpliceChannelResponse)(nil), "lnrpc.SpliceChannelResponse")
+	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
+	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*FinalizeFundingRequest)(nil), "lnrpc.FinalizeFundingRequest")
+	proto.RegisterType((*FinalizeFundingResponse)(nil), "lnrpc.FinalizeFundingResponse")
+	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
+	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
+	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
+	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
+	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
+	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
+	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
+	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
+	proto.RegisterType((*AccountBalance)(nil), "lnrpc.AccountBalance")
+	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
+	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
+	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
+	proto.RegisterType((*Route)(nil), "lnrpc.Route")
+	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
+	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
+	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
+	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
+	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
+	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
+	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
+	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
+	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
+	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
+	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
+	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
+	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
+	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
+	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
+	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
+	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
+	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
+	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
+	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
+	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
+	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
+	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
+	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
+	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
+	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
+	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
+	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
+	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
+	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
+	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
+	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
+	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
+	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
+	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
+	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
+	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
+	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
+	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
+	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*AddTowerRequest)(nil), "lnrpc.AddTowerRequest")
+	proto.RegisterType((*AddTowerResponse)(nil), "lnrpc.AddTowerResponse")
+	proto.RegisterType((*RemoveTowerRequest)(nil), "lnrpc.RemoveTowerRequest")
+	proto.RegisterType((*RemoveTowerResponse)(nil), "lnrpc.RemoveTowerResponse")
+	proto.RegisterType((*ListTowersRequest)(nil), "lnrpc.ListTowersRequest")
+	proto.RegisterType((*TowerSession)(nil), "lnrpc.TowerSession")
+	proto.RegisterType((*Tower)(nil), "lnrpc.Tower")
+	proto.RegisterType((*ListTowersResponse)(nil), "lnrpc.ListTowersResponse")
+	proto.RegisterType((*TowerClientStatsRequest)(nil), "lnrpc.TowerClientStatsRequest")
+	proto.RegisterType((*TowerClientStatsResponse)(nil), "lnrpc.TowerClientStatsResponse")
+	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
+	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
+	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
+	proto.RegisterType((*ChannelBackups)(nil), "lnrpc.ChannelBackups")
+	proto.RegisterType((*ChanBackupSnapshot)(nil), "lnrpc.ChanBackupSnapshot")
+	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*RestoreChanBackupRequest)(nil), "lnrpc.RestoreChanBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
+	proto.RegisterType((*KeyLocator)(nil), "lnrpc.KeyLocator")
+	proto.RegisterType((*KeyDescriptor)(nil), "lnrpc.KeyDescriptor")
+	proto.RegisterType((*KeyReq)(nil), "lnrpc.KeyReq")
+	proto.RegisterType((*TxOut)(nil), "lnrpc.TxOut")
+	proto.RegisterType((*SignDescriptor)(nil), "lnrpc.SignDescriptor")
+	proto.RegisterType((*WalletKeyPath)(nil), "lnrpc.WalletKeyPath")
+	proto.RegisterType((*SignReq)(nil), "lnrpc.SignReq")
+	proto.RegisterType((*SignResp)(nil), "lnrpc.SignResp")
+	proto.RegisterType((*InputScript)(nil), "lnrpc.InputScript")
+	proto.RegisterType((*InputScriptResp)(nil), "lnrpc.InputScriptResp")
+	proto.RegisterType((*KeySignMessageRequest)(nil), "lnrpc.KeySignMessageRequest")
+	proto.RegisterType((*KeySignMessageResponse)(nil), "lnrpc.KeySignMessageResponse")
+	proto.RegisterType((*ExportWatchOnlyWalletRequest)(nil), "lnrpc.ExportWatchOnlyWalletRequest")
+	proto.RegisterType((*ExportWatchOnlyWalletResponse)(nil), "lnrpc.ExportWatchOnlyWalletResponse")
+	proto.RegisterType((*DerivePrivKeyResponse)(nil), "lnrpc.DerivePrivKeyResponse")
+	proto.RegisterType((*SharedKeyRequest)(nil), "lnrpc.SharedKeyRequest")
+	proto.RegisterType((*SharedKeyResponse)(nil), "lnrpc.SharedKeyResponse")
+	proto.RegisterType((*PublishTxRequest)(nil), "lnrpc.PublishTxRequest")
+	proto.RegisterType((*PublishTxResponse)(nil), "lnrpc.PublishTxResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "lnrpc.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "lnrpc.EstimateFeeResponse")
+	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
+	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for WalletUnlocker service
+
+type WalletUnlockerClient interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+}
+
+type walletUnlockerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
+	return &walletUnlockerClient{cc}
+}
+
+func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
+	out := new(GenSeedResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
+	out := new(InitWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WalletUnlocker service
+
+type WalletUnlockerServer interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+}
+
+func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
+	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
+}
+
+func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.WalletUnlocker",
+	HandlerType: (*WalletUnlockerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenSeed",
+			Handler:    _WalletUnlocker_GenSeed_Handler,
+		},
+		{
+			MethodName: "InitWallet",
+			Handler:    _WalletUnlocker_InitWallet_Handler,
+		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _WalletUnlocker_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _WalletUnlocker_ChangePassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// Client API for Lightning service
+
+type LightningClient interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in satoshis.
+	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet, along with their labels. The transactions can be
+	// filtered by the height of the block they confirmed in.
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or sat_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target. If send_all is set, all confirmed unlocked wallet outputs are
+	// swept to the address, and the amount sent is what remains after the fee.
+	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over.
+	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
+	// * lncli: `wallet bumpfee`
+	// BumpFee speeds up the confirmation of an unconfirmed transaction by
+	// spending one of its outputs that is controlled by the wallet in a child
+	// transaction paying a higher fee (CPFP). Exactly one of target_conf or
+	// sat_per_byte must be set. The child transaction is rebroadcast, and its fee
+	// bumped as the confirmation target approaches, until it confirms. Only p2wkh
+	// outputs are supported.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// * lncli: `wallet listunspent`
+	// ListUnspent returns the unspent witness outputs of the wallet that are
+	// available for spending, which excludes outputs that are leased or reserved
+	// for a pending channel. The outputs can be used as explicit inputs to
+	// SendCoins, SendMany and OpenChannel.
+	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+	// * lncli: `wallet leaseoutput`
+	// LeaseOutput locks a wallet output under the given identifier, excluding it
+	// from coin selection until it's released, or the lease expires. Leases are
+	// persisted across restarts. Leasing an output again under the same
+	// identifier extends the lease.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	// * lncli: `wallet releaseoutput`
+	// ReleaseOutput releases a leased output, making it available for coin
+	// selection again. The identifier must match the one the output was leased
+	// under.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	// * lncli: `wallet labeltx`
+	// LabelTransaction sets the label of a transaction relevant to the wallet.
+	// The node labels the transactions it creates itself, e.g. with
+	// "open:<chanpoint>", "close:<chanpoint>" or "justice:<chanpoint>". Existing
+	// labels are only replaced if overwrite is set.
+	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
+	// * lncli: `wallet importaccount`
+	// ImportAccount imports the BIP32 extended public key of an account, such as
+	// the account of a cold storage wallet, as a watch-only account. The chain is
+	// scanned for the account's transactions, which are listed by
+	// GetTransactions, while its balance is reported by WalletBalance. The node
+	// can hand out addresses of the account, but can't spend from it. Instead,
+	// FundPsbt creates unsigned PSBTs that spend from the account.
+	ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error)
+	// * lncli: `wallet listaccounts`
+	// ListAccounts returns all watch-only accounts along with their balances.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// * lncli: `wallet fundpsbt`
+	// FundPsbt creates an unsigned PSBT (BIP 174) that pays to the given outputs
+	// and spends from a watch-only account. Its inputs are annotated with the
+	// outputs they spend and the BIP32 derivation of their keys, so that the PSBT
+	// can be signed by the wallet holding the account's private keys. Change is
+	// sent to a new change address of the account.
+	FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// call is meant to be consumed by clients to the REST proxy. As with all
+	// other sync calls, all byte slices are intended to be populated as hex
+	// encoded strings.
+	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
+	// * lncli: `openchannel`
+	// OpenChannel attempts to open a singly funded channel specified in the
+	// request to a remote peer. Users are able to specify a target number of
+	// blocks that the funding transaction should be confirmed in, or a manual fee
+	// rate to us for the funding transaction. If neither are specified, then a
+	// lax block confirmation target is used.
+	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// *
+	// FinalizeFunding hands the signed funding transaction of a pending channel
+	// that was opened with psbt_funding set to the daemon. The transaction can be
+	// provided either as a finalized PSBT, or as a raw transaction. It must pay
+	// the exact funding amount to the funding address, and may only spend
+	// confirmed segwit outputs. Once the transaction has been verified, the
+	// funding flow with the remote peer resumes. The daemon broadcasts the
+	// transaction after the peer has signed our commitment transaction.
+	FinalizeFunding(ctx context.Context, in *FinalizeFundingRequest, opts ...grpc.CallOption) (*FinalizeFundingResponse, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel opens several channels with different peers, which are
+	// all funded by a single transaction created by the wallet. The funding flows
+	// run in parallel, and the funding transaction is only broadcast once every
+	// peer has signed our commitment transaction. If any of the flows fails, all
+	// channels of the batch are cancelled, and no transaction is broadcast.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
+	// * lncli: `splice`
+	// SpliceChannel splices funds into or out of an active channel, without
+	// closing it. The splice transaction spends the current funding output of
+	// the channel, and creates a new funding output of the increased or
+	// decreased capacity. Funds spliced into the channel are taken from the
+	// wallet, while funds spliced out of the channel are sent to an address. In
+	// either case, only our balance changes, and we pay the fee of the splice
+	// transaction. The channel is unusable until the splice transaction confirms,
+	// after which it continues under its new channel point.
+	SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection. If the client doesn't
+	// respond in time, the channel is rejected.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// * lncli: `closechannel`
+	// CloseChannel attempts to close an active channel identified by its channel
+	// outpoint (ChannelPoint). The actions of this method can additionally be
+	// augmented to attempt a force close after a timeout period in the case of an
+	// inactive peer. If a non-force close (cooperative closure) is requested,
+	// then the user can specify either a target number of blocks until the
+	// closure transaction is confirmed, or a manual fee rate. If neither are
+	// specified, then a default lax, block confirmation target is used.
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
+	// * lncli: `abandonchannel`
+	// AbandonChannel removes all channel state from the database except for a
+	// close summary. This method can be used to get rid of permanently unusable
+	// channels due to bugs fixed in newer versions of lnd. Only available
+	// when in debug builds of lnd.
+	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// * lncli: `sendpayment`
+	// SendPayment dispatches a bi-directional streaming RPC for sending payments
+	// through the Lightning Network. A single RPC invocation creates a persistent
+	// bi-directional stream allowing clients to rapidly send payments through the
+	// Lightning Network with a single persistent connection.
+	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
+	// *
+	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
+	// This RPC is intended to be consumed by clients of the REST proxy.
+	// Additionally, this RPC expects the destination's public key and the payment
+	// hash (if any) to be encoded as hex strings.
+	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `sendtoroute`
+	// SendToRoute is a bi-directional streaming RPC for sending payment through
+	// the Lightning Network. This method differs from SendPayment in that it
+	// allows users to specify a full route manually. This can be used for things
+	// like rebalancing, and atomic swaps.
+	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
+	// *
+	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
+	// until the payment either fails or succeeds.
+	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `addinvoice`
+	// AddInvoice attempts to add a new invoice to the invoice database. Any
+	// duplicated invoices are rejected, therefore all invoices *must* have a
+	// unique payment preimage.
+	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// * lncli: `listinvoices`
+	// ListInvoices returns a list of all the invoices currently stored within the
+	// database. Any active debug invoices are ignored. It has full support for
+	// paginated responses, allowing users to query for specific invoices through
+	// their add_index. This can be done by using either the first_index_offset or
+	// last_index_offset fields included in the response as the index_offset of the
+	// next request. The reversed flag is set by default in order to paginate
+	// backwards. If you wish to paginate forwards, you must explicitly set the
+	// flag to false. If none of the parameters are specified, then the last 100
+	// invoices will be returned.
+	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
+	// * lncli: `lookupinvoice`
+	// LookupInvoice attempts to look up an invoice according to its payment hash.
+	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
+	// returned.
+	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// *
+	// SubscribeInvoices returns a uni-directional stream (server -> client) for
+	// notifying the client of newly added/settled invoices. The caller can
+	// optionally specify the add_index and/or the settle_index. If the add_index
+	// is specified, then we'll first start by sending add invoice events for all
+	// invoices with an add_index greater than the specified value.  If the
+	// settle_index is specified, the next, we'll send out all settle events for
+	// invoices with a settle_index greater than the specified value.  One or both
+	// of these fields can be set. If no fields are set, then we'll only send out
+	// the latest add/settle events.
+	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
+	// * lncli: `decodepayreq`
+	// DecodePayReq takes an encoded payment request string and attempts to decode
+	// it, returning a full description of the conditions encoded within the
+	// payment request.
+	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
+	// * lncli: `listpayments`
+	// ListPayments returns a list of all outgoing payments.
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// *
+	// DeleteAllPayments deletes all outgoing payments from DB.
+	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `describegraph`
+	// DescribeGraph returns a description of the latest graph state from the
+	// point of view of the node. The graph information is partitioned into two
+	// components: all the nodes/vertexes, and all the edges that connect the
+	// vertexes themselves.  As this is a directed graph, the edges also contain
+	// the node directional specific routing policy which includes: the time lock
+	// delta, fee information, etc.
+	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
+	// * lncli: `getchaninfo`
+	// GetChanInfo returns the latest authenticated network announcement for the
+	// given channel identified by its channel ID: an 8-byte integer which
+	// uniquely identifies the location of transaction's funding output within the
+	// blockchain.
+	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
+	// * lncli: `getnodeinfo`
+	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
+	// channel information for the specified node identified by its public key.
+	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
+	// * lncli: `queryroutes`
+	// QueryRoutes attempts to query the daemon's Channel Router for a possible
+	// route to a target destination capable of carrying a specific amount of
+	// satoshis. The retuned route contains the full details required to craft and
+	// send an HTLC, also including the necessary information that should be
+	// present within the Sphinx packet encapsulated within the HTLC.
+	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// * lncli: `getnetworkinfo`
+	// GetNetworkInfo returns some basic stats about the known channel graph from
+	// the point of view of the node.
+	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	// * lncli: `stop`
+	// StopDaemon will send a shutdown request to the interrupt handler, triggering
+	// a graceful shutdown of the daemon.
+	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// *
+	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
+	// receive notifications upon any changes to the channel graph topology from
+	// the point of view of the responding node. Events notified include: new
+	// nodes coming online, nodes updating their authenticated attributes, new
+	// channels being advertised, updates in the routing policy for a directional
+	// channel edge, and when channels are closed on-chain.
+	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
+	// * lncli: `debuglevel`
+	// DebugLevel allows a caller to programmatically set the logging verbosity of
+	// lnd. The logging can be targeted according to a coarse daemon-wide logging
+	// level, or in a granular fashion to specify the logging for a target
+	// sub-system.
+	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// * lncli: `feereport`
+	// FeeReport allows the caller to obtain a report detailing the current fee
+	// schedule enforced by the node globally for each channel.
+	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// * lncli: `updatechanpolicy`
+	// UpdateChannelPolicy allows the caller to update the fee schedule and
+	// channel policies for all channels globally, or a particular channel.
+	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `fwdinghistory`
+	// ForwardingHistory allows the caller to query the htlcswitch for a record of
+	// all HTLC's forwarded within the target time range, and integer offset
+	// within that time range. If no time-range is specified, then the first chunk
+	// of the past 24 hrs of forwarding history are returned.
+	//
+	// A list of forwarding events are returned. The size of each forwarding event
+	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
+	// As a result each message can only contain 50k entries.  Each response has
+	// the index offset of the last entry. The index offset can be provided to the
+	// request to allow the caller to skip a series of records.
+	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `wtclient add`
+	// AddTower adds a new watchtower reachable at the given address and considers
+	// it for new sessions. If the watchtower already exists, then any new
+	// addresses included will be considered when dialing it for session
+	// negotiations and backups.
+	AddTower(ctx context.Context, in *AddTowerRequest, opts ...grpc.CallOption) (*AddTowerResponse, error)
+	// * lncli: `wtclient remove`
+	// RemoveTower removes a watchtower from being considered for future session
+	// negotiations and from being used for any subsequent backups until it's
+	// added again. If an address is provided, then this RPC only serves as a way
+	// of removing the address from the watchtower instead.
+	RemoveTower(ctx context.Context, in *RemoveTowerRequest, opts ...grpc.CallOption) (*RemoveTowerResponse, error)
+	// * lncli: `wtclient towers`
+	// ListTowers returns the list of watchtowers registered with the client.
+	ListTowers(ctx context.Context, in *ListTowersRequest, opts ...grpc.CallOption) (*ListTowersResponse, error)
+	// * lncli: `wtclient stats`
+	// TowerClientStats returns the in-memory statistics of the watchtower client
+	// since startup.
+	TowerClientStats(ctx context.Context, in *TowerClientStatsRequest, opts ...grpc.CallOption) (*TowerClientStatsResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel backup
+	// for the target channel identified by its channel point. If no channel
+	// point is specified, then a backup for each open channel is returned, along
+	// with a single multi-channel backup that covers all of them. The backups are
+	// encrypted with a key generated from the aezeed seed of the user. The
+	// returned backups can either be restored using the RestoreChannelBackups
+	// method once lnd is running, or via the InitWallet and UnlockWallet methods
+	// from the WalletUnlocker service.
+	ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error)
+	// * lncli: `verifychanbackup`
+	// VerifyChanBackup allows a caller to verify the integrity of a channel
+	// backup snapshot. This method will accept either a packed Single or a
+	// packed Multi. Specifying both will result in an error.
+	VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then
+	// the new channel will be shown under listchannels, as well as pending
+	// channels.
+	RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+}
+
+type lightningClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLightningClient(cc *grpc.ClientConn) LightningClient {
+	return &lightningClient{cc}
+}
+
+func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
+	out := new(WalletBalanceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
+	out := new(ChannelBalanceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
+	out := new(TransactionDetails)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
+	out := new(SendCoinsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[0], c.cc, "/lnrpc.Lightning/SubscribeTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
+	out := new(SendManyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BumpFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
+	out := new(ListUnspentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListUnspent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LeaseOutput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ReleaseOutput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
+	out := new(LabelTransactionResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LabelTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error) {
+	out := new(ImportAccountResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ImportAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListAccounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
+	out := new(FundPsbtResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FundPsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
+	out := new(NewAddressResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
+	out := new(ConnectPeerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	out := new(DisconnectPeerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	out := new(ListPeersResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
+	out := new(PendingChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
+	out := new(ClosedChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
+	out := new(ChannelPoint)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningOpenChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_OpenChannelClient interface {
+	Recv() (*OpenStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningOpenChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
+	m := new(OpenStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) FinalizeFunding(ctx context.Context, in *FinalizeFundingRequest, opts ...grpc.CallOption) (*FinalizeFundingResponse, error) {
+	out := new(FinalizeFundingResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FinalizeFunding", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error) {
+	out := new(SpliceChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SpliceChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningChannelAcceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningChannelAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningCloseChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_CloseChannelClient interface {
+	Recv() (*CloseStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningCloseChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
+	m := new(CloseStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
+	out := new(AbandonChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendPaymentClient{stream}
+	return x, nil
+}
+
+type Lightning_SendPaymentClient interface {
+	Send(*SendRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendToRouteClient{stream}
+	return x, nil
+}
+
+type Lightning_SendToRouteClient interface {
+	Send(*SendToRouteRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendToRouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
+	out := new(AddInvoiceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
+	out := new(ListInvoiceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeInvoicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeInvoicesClient interface {
+	Recv() (*Invoice, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeInvoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
+	m := new(Invoice)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
+	out := new(PayReq)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
+	out := new(DeleteAllPaymentsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
+	out := new(ChannelGraph)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
+	out := new(ChannelEdge)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	out := new(NetworkInfo)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[7], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelGraphClient interface {
+	Recv() (*GraphTopologyUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
+	m := new(GraphTopologyUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
+	out := new(DebugLevelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
+	out := new(FeeReportResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
+	out := new(PolicyUpdateResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
+	out := new(ForwardingHistoryResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AddTower(ctx context.Context, in *AddTowerRequest, opts ...grpc.CallOption) (*AddTowerResponse, error) {
+	out := new(AddTowerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddTower", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RemoveTower(ctx context.Context, in *RemoveTowerRequest, opts ...grpc.CallOption) (*RemoveTowerResponse, error) {
+	out := new(RemoveTowerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/RemoveTower", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListTowers(ctx context.Context, in *ListTowersRequest, opts ...grpc.CallOption) (*ListTowersResponse, error) {
+	out := new(ListTowersResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListTowers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) TowerClientStats(ctx context.Context, in *TowerClientStatsRequest, opts ...grpc.CallOption) (*TowerClientStatsResponse, error) {
+	out := new(TowerClientStatsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/TowerClientStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error) {
+	out := new(ChanBackupSnapshot)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error) {
+	out := new(VerifyChanBackupResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyChanBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/RestoreChannelBackups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Lightning service
+
+type LightningServer interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in satoshis.
+	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet, along with their labels. The transactions can be
+	// filtered by the height of the block they confirmed in.
+	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or sat_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target. If send_all is set, all confirmed unlocked wallet outputs are
+	// swept to the address, and the amount sent is what remains after the fee.
+	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over.
+	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
+	// * lncli: `wallet bumpfee`
+	// BumpFee speeds up the confirmation of an unconfirmed transaction by
+	// spending one of its outputs that is controlled by the wallet in a child
+	// transaction paying a higher fee (CPFP). Exactly one of target_conf or
+	// sat_per_byte must be set. The child transaction is rebroadcast, and its fee
+	// bumped as the confirmation target approaches, until it confirms. Only p2wkh
+	// outputs are supported.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// * lncli: `wallet listunspent`
+	// ListUnspent returns the unspent witness outputs of the wallet that are
+	// available for spending, which excludes outputs that are leased or reserved
+	// for a pending channel. The outputs can be used as explicit inputs to
+	// SendCoins, SendMany and OpenChannel.
+	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+	// * lncli: `wallet leaseoutput`
+	// LeaseOutput locks a wallet output under the given identifier, excluding it
+	// from coin selection until it's released, or the lease expires. Leases are
+	// persisted across restarts. Leasing an output again under the same
+	// identifier extends the lease.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	// * lncli: `wallet releaseoutput`
+	// ReleaseOutput releases a leased output, making it available for coin
+	// selection again. The identifier must match the one the output was leased
+	// under.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	// * lncli: `wallet labeltx`
+	// LabelTransaction sets the label of a transaction relevant to the wallet.
+	// The node labels the transactions it creates itself, e.g. with
+	// "open:<chanpoint>", "close:<chanpoint>" or "justice:<chanpoint>". Existing
+	// labels are only replaced if overwrite is set.
+	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
+	// * lncli: `wallet importaccount`
+	// ImportAccount imports the BIP32 extended public key of an account, such as
+	// the account of a cold storage wallet, as a watch-only account. The chain is
+	// scanned for the account's transactions, which are listed by
+	// GetTransactions, while its balance is reported by WalletBalance. The node
+	// can hand out addresses of the account, but can't spend from it. Instead,
+	// FundPsbt creates unsigned PSBTs that spend from the account.
+	ImportAccount(context.Context, *ImportAccountRequest) (*ImportAccountResponse, error)
+	// * lncli: `wallet listaccounts`
+	// ListAccounts returns all watch-only accounts along with their balances.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// * lncli: `wallet fundpsbt`
+	// FundPsbt creates an unsigned PSBT (BIP 174) that pays to the given outputs
+	// and spends from a watch-only account. Its inputs are annotated with the
+	// outputs they spend and the BIP32 derivation of their keys, so that the PSBT
+	// can be signed by the wallet holding the account's private keys. Change is
+	// sent to a new change address of the account.
+	FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
 	// encoded strings.
@@ -6118,6 +9029,40 @@ type LightningServer interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// *
+	// FinalizeFunding hands the signed funding transaction of a pending channel
+	// that was opened with psbt_funding set to the daemon. The transaction can be
+	// provided either as a finalized PSBT, or as a raw transaction. It must pay
+	// the exact funding amount to the funding address, and may only spend
+	// confirmed segwit outputs. Once the transaction has been verified, the
+	// funding flow with the remote peer resumes. The daemon broadcasts the
+	// transaction after the peer has signed our commitment transaction.
+	FinalizeFunding(context.Context, *FinalizeFundingRequest) (*FinalizeFundingResponse, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel opens several channels with different peers, which are
+	// all funded by a single transaction created by the wallet. The funding flows
+	// run in parallel, and the funding transaction is only broadcast once every
+	// peer has signed our commitment transaction. If any of the flows fails, all
+	// channels of the batch are cancelled, and no transaction is broadcast.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
+	// * lncli: `splice`
+	// SpliceChannel splices funds into or out of an active channel, without
+	// closing it. The splice transaction spends the current funding output of
+	// the channel, and creates a new funding output of the increased or
+	// decreased capacity. Funds spliced into the channel are taken from the
+	// wallet, while funds spliced out of the channel are sent to an address. In
+	// either case, only our balance changes, and we pay the fee of the splice
+	// transaction. The channel is unusable until the splice transaction confirms,
+	// after which it continues under its new channel point.
+	SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection. If the client doesn't
+	// respond in time, the channel is rejected.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -6265,1388 +9210,2745 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `wtclient add`
+	// AddTower adds a new watchtower reachable at the given address and considers
+	// it for new sessions. If the watchtower already exists, then any new
+	// addresses included will be considered when dialing it for session
+	// negotiations and backups.
+	AddTower(context.Context, *AddTowerRequest) (*AddTowerResponse, error)
+	// * lncli: `wtclient remove`
+	// RemoveTower removes a watchtower from being considered for future session
+	// negotiations and from being used for any subsequent backups until it's
+	// added again. If an address is provided, then this RPC only serves as a way
+	// of removing the address from the watchtower instead.
+	RemoveTower(context.Context, *RemoveTowerRequest) (*RemoveTowerResponse, error)
+	// * lncli: `wtclient towers`
+	// ListTowers returns the list of watchtowers registered with the client.
+	ListTowers(context.Context, *ListTowersRequest) (*ListTowersResponse, error)
+	// * lncli: `wtclient stats`
+	// TowerClientStats returns the in-memory statistics of the watchtower client
+	// since startup.
+	TowerClientStats(context.Context, *TowerClientStatsRequest) (*TowerClientStatsResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel backup
+	// for the target channel identified by its channel point. If no channel
+	// point is specified, then a backup for each open channel is returned, along
+	// with a single multi-channel backup that covers all of them. The backups are
+	// encrypted with a key generated from the aezeed seed of the user. The
+	// returned backups can either be restored using the RestoreChannelBackups
+	// method once lnd is running, or via the InitWallet and UnlockWallet methods
+	// from the WalletUnlocker service.
+	ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChanBackupSnapshot, error)
+	// * lncli: `verifychanbackup`
+	// VerifyChanBackup allows a caller to verify the integrity of a channel
+	// backup snapshot. This method will accept either a packed Single or a
+	// packed Multi. Specifying both will result in an error.
+	VerifyChanBackup(context.Context, *ChanBackupSnapshot) (*VerifyChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then
+	// the new channel will be shown under listchannels, as well as pending
+	// channels.
+	RestoreChannelBackups(context.Context, *RestoreChanBackupRequest) (*RestoreBackupResponse, error)
+}
+
+func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
+	s.RegisterService(&_Lightning_serviceDesc, srv)
+}
+
+func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).WalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/WalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
+}
+
+type Lightning_SubscribeTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LabelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LabelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LabelTransaction(ctx, req.(*LabelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ImportAccount(ctx, req.(*ImportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundPsbt(ctx, req.(*FundPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).NewAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/NewAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ClosedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).OpenChannelSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OpenChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
+}
+
+type Lightning_OpenChannelServer interface {
+	Send(*OpenStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningOpenChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_FinalizeFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FinalizeFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FinalizeFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FinalizeFunding(ctx, req.(*FinalizeFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SpliceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceChannel(ctx, req.(*SpliceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
+}
+
+type Lightning_CloseChannelServer interface {
+	Send(*CloseStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningCloseChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AbandonChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AbandonChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
+}
+
+type Lightning_SendPaymentServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
+	m := new(SendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendPaymentSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
+}
+
+type Lightning_SendToRouteServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendToRouteRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendToRouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
+	m := new(SendToRouteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendToRouteSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Invoice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LookupInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LookupInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
-	s.RegisterService(&_Lightning_serviceDesc, srv)
+func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvoiceSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
 }
 
-func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WalletBalanceRequest)
+type Lightning_SubscribeInvoicesServer interface {
+	Send(*Invoice) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeInvoicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayReqString)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).WalletBalance(ctx, in)
+		return srv.(LightningServer).DecodePayReq(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/WalletBalance",
+		FullMethod: "/lnrpc.Lightning/DecodePayReq",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelBalanceRequest)
+func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ChannelBalance(ctx, in)
+		return srv.(LightningServer).ListPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+		FullMethod: "/lnrpc.Lightning/ListPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetTransactions(ctx, in)
+		return srv.(LightningServer).DeleteAllPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetTransactions",
+		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendCoinsRequest)
+func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendCoins(ctx, in)
+		return srv.(LightningServer).DescribeGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendCoins",
+		FullMethod: "/lnrpc.Lightning/DescribeGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTransactionsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
-}
-
-type Lightning_SubscribeTransactionsServer interface {
-	Send(*Transaction) error
-	grpc.ServerStream
-}
-
-type lightningSubscribeTransactionsServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(LightningServer).GetChanInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetChanInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendManyRequest)
+func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendMany(ctx, in)
+		return srv.(LightningServer).GetNodeInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendMany",
+		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAddressRequest)
+func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).NewAddress(ctx, in)
+		return srv.(LightningServer).QueryRoutes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/NewAddress",
+		FullMethod: "/lnrpc.Lightning/QueryRoutes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessageRequest)
+func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SignMessage(ctx, in)
+		return srv.(LightningServer).GetNetworkInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SignMessage",
+		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyMessageRequest)
+func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).VerifyMessage(ctx, in)
+		return srv.(LightningServer).StopDaemon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+		FullMethod: "/lnrpc.Lightning/StopDaemon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectPeerRequest)
+func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GraphTopologySubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
+}
+
+type Lightning_SubscribeChannelGraphServer interface {
+	Send(*GraphTopologyUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ConnectPeer(ctx, in)
+		return srv.(LightningServer).DebugLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+		FullMethod: "/lnrpc.Lightning/DebugLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisconnectPeerRequest)
+func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DisconnectPeer(ctx, in)
+		return srv.(LightningServer).FeeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+		FullMethod: "/lnrpc.Lightning/FeeReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPeersRequest)
+func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPeers(ctx, in)
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPeers",
+		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetInfo(ctx, in)
+		return srv.(LightningServer).ForwardingHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetInfo",
+		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PendingChannelsRequest)
+func _Lightning_AddTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTowerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).PendingChannels(ctx, in)
+		return srv.(LightningServer).AddTower(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/PendingChannels",
+		FullMethod: "/lnrpc.Lightning/AddTower",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+		return srv.(LightningServer).AddTower(ctx, req.(*AddTowerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChannelsRequest)
+func _Lightning_RemoveTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTowerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListChannels(ctx, in)
+		return srv.(LightningServer).RemoveTower(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListChannels",
+		FullMethod: "/lnrpc.Lightning/RemoveTower",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+		return srv.(LightningServer).RemoveTower(ctx, req.(*RemoveTowerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClosedChannelsRequest)
+func _Lightning_ListTowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTowersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ClosedChannels(ctx, in)
+		return srv.(LightningServer).ListTowers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+		FullMethod: "/lnrpc.Lightning/ListTowers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+		return srv.(LightningServer).ListTowers(ctx, req.(*ListTowersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenChannelRequest)
+func _Lightning_TowerClientStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TowerClientStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).OpenChannelSync(ctx, in)
+		return srv.(LightningServer).TowerClientStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
+		FullMethod: "/lnrpc.Lightning/TowerClientStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
+		return srv.(LightningServer).TowerClientStats(ctx, req.(*TowerClientStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(OpenChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChannelBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
-}
-
-type Lightning_OpenChannelServer interface {
-	Send(*OpenStatusUpdate) error
-	grpc.ServerStream
-}
-
-type lightningOpenChannelServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(LightningServer).ExportChannelBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ExportChannelBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ExportChannelBackup(ctx, req.(*ExportChannelBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
+func _Lightning_VerifyChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyChanBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyChanBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyChanBackup(ctx, req.(*ChanBackupSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CloseChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Lightning_RestoreChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChanBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
+	if interceptor == nil {
+		return srv.(LightningServer).RestoreChannelBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/RestoreChannelBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).RestoreChannelBackups(ctx, req.(*RestoreChanBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Lightning_CloseChannelServer interface {
-	Send(*CloseStatusUpdate) error
-	grpc.ServerStream
+var _Lightning_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.Lightning",
+	HandlerType: (*LightningServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WalletBalance",
+			Handler:    _Lightning_WalletBalance_Handler,
+		},
+		{
+			MethodName: "ChannelBalance",
+			Handler:    _Lightning_ChannelBalance_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Lightning_GetTransactions_Handler,
+		},
+		{
+			MethodName: "SendCoins",
+			Handler:    _Lightning_SendCoins_Handler,
+		},
+		{
+			MethodName: "SendMany",
+			Handler:    _Lightning_SendMany_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _Lightning_BumpFee_Handler,
+		},
+		{
+			MethodName: "ListUnspent",
+			Handler:    _Lightning_ListUnspent_Handler,
+		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _Lightning_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _Lightning_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "LabelTransaction",
+			Handler:    _Lightning_LabelTransaction_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _Lightning_ImportAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Lightning_ListAccounts_Handler,
+		},
+		{
+			MethodName: "FundPsbt",
+			Handler:    _Lightning_FundPsbt_Handler,
+		},
+		{
+			MethodName: "NewAddress",
+			Handler:    _Lightning_NewAddress_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _Lightning_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Lightning_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "ConnectPeer",
+			Handler:    _Lightning_ConnectPeer_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _Lightning_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Lightning_ListPeers_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _Lightning_GetInfo_Handler,
+		},
+		{
+			MethodName: "PendingChannels",
+			Handler:    _Lightning_PendingChannels_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _Lightning_ListChannels_Handler,
+		},
+		{
+			MethodName: "ClosedChannels",
+			Handler:    _Lightning_ClosedChannels_Handler,
+		},
+		{
+			MethodName: "OpenChannelSync",
+			Handler:    _Lightning_OpenChannelSync_Handler,
+		},
+		{
+			MethodName: "FinalizeFunding",
+			Handler:    _Lightning_FinalizeFunding_Handler,
+		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
+		{
+			MethodName: "SpliceChannel",
+			Handler:    _Lightning_SpliceChannel_Handler,
+		},
+		{
+			MethodName: "AbandonChannel",
+			Handler:    _Lightning_AbandonChannel_Handler,
+		},
+		{
+			MethodName: "SendPaymentSync",
+			Handler:    _Lightning_SendPaymentSync_Handler,
+		},
+		{
+			MethodName: "SendToRouteSync",
+			Handler:    _Lightning_SendToRouteSync_Handler,
+		},
+		{
+			MethodName: "AddInvoice",
+			Handler:    _Lightning_AddInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _Lightning_ListInvoices_Handler,
+		},
+		{
+			MethodName: "LookupInvoice",
+			Handler:    _Lightning_LookupInvoice_Handler,
+		},
+		{
+			MethodName: "DecodePayReq",
+			Handler:    _Lightning_DecodePayReq_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _Lightning_ListPayments_Handler,
+		},
+		{
+			MethodName: "DeleteAllPayments",
+			Handler:    _Lightning_DeleteAllPayments_Handler,
+		},
+		{
+			MethodName: "DescribeGraph",
+			Handler:    _Lightning_DescribeGraph_Handler,
+		},
+		{
+			MethodName: "GetChanInfo",
+			Handler:    _Lightning_GetChanInfo_Handler,
+		},
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _Lightning_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "QueryRoutes",
+			Handler:    _Lightning_QueryRoutes_Handler,
+		},
+		{
+			MethodName: "GetNetworkInfo",
+			Handler:    _Lightning_GetNetworkInfo_Handler,
+		},
+		{
+			MethodName: "StopDaemon",
+			Handler:    _Lightning_StopDaemon_Handler,
+		},
+		{
+			MethodName: "DebugLevel",
+			Handler:    _Lightning_DebugLevel_Handler,
+		},
+		{
+			MethodName: "FeeReport",
+			Handler:    _Lightning_FeeReport_Handler,
+		},
+		{
+			MethodName: "UpdateChannelPolicy",
+			Handler:    _Lightning_UpdateChannelPolicy_Handler,
+		},
+		{
+			MethodName: "ForwardingHistory",
+			Handler:    _Lightning_ForwardingHistory_Handler,
+		},
+		{
+			MethodName: "AddTower",
+			Handler:    _Lightning_AddTower_Handler,
+		},
+		{
+			MethodName: "RemoveTower",
+			Handler:    _Lightning_RemoveTower_Handler,
+		},
+		{
+			MethodName: "ListTowers",
+			Handler:    _Lightning_ListTowers_Handler,
+		},
+		{
+			MethodName: "TowerClientStats",
+			Handler:    _Lightning_TowerClientStats_Handler,
+		},
+		{
+			MethodName: "ExportChannelBackup",
+			Handler:    _Lightning_ExportChannelBackup_Handler,
+		},
+		{
+			MethodName: "VerifyChanBackup",
+			Handler:    _Lightning_VerifyChanBackup_Handler,
+		},
+		{
+			MethodName: "RestoreChannelBackups",
+			Handler:    _Lightning_RestoreChannelBackups_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransactions",
+			Handler:       _Lightning_SubscribeTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "OpenChannel",
+			Handler:       _Lightning_OpenChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ChannelAcceptor",
+			Handler:       _Lightning_ChannelAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CloseChannel",
+			Handler:       _Lightning_CloseChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SendPayment",
+			Handler:       _Lightning_SendPayment_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SendToRoute",
+			Handler:       _Lightning_SendToRoute_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeInvoices",
+			Handler:       _Lightning_SubscribeInvoices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChannelGraph",
+			Handler:       _Lightning_SubscribeChannelGraph_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
 }
 
-type lightningCloseChannelServer struct {
-	grpc.ServerStream
+// Client API for RemoteSigner service
+
+type RemoteSignerClient interface {
+	// *
+	// SignOutputRaw generates a signature for each of the passed sign
+	// descriptors, which describe the inputs of the passed transaction. The
+	// returned signatures don't include a sighash flag.
+	SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete witness and sigScript for each of
+	// the passed sign descriptors, whose outputs must be p2wkh or np2wkh outputs
+	// controlled by the signer's wallet. If a sign descriptor includes the wallet
+	// key path of its output, the signer derives the addresses up to that key
+	// first, as the watch-only node may have handed out addresses the signer
+	// hasn't derived yet.
+	ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error)
+	// *
+	// ExportWatchOnlyWallet returns a copy of the signer's on-chain wallet with
+	// all private key material removed. It only holds the extended public keys of
+	// the wallet's accounts, from which the watch-only node derives its
+	// addresses, along with the wallet's transactions. The public data of the
+	// copy is encrypted with the default public passphrase. A watch-only node
+	// imports it as its wallet on its first start.
+	ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error)
+	// *
+	// SignMessage signs the double SHA-256 digest of the passed message with the
+	// private key that corresponds to the given public key.
+	SignMessage(ctx context.Context, in *KeySignMessageRequest, opts ...grpc.CallOption) (*KeySignMessageResponse, error)
+	// *
+	// DerivePrivKey returns the private key described by the passed key
+	// descriptor. This is used for the few keys that must be held in memory by
+	// the watch-only node. Only keys of the node key, revocation root and
+	// watchtower session key families are returned, any other family is
+	// rejected.
+	DerivePrivKey(ctx context.Context, in *KeyDescriptor, opts ...grpc.CallOption) (*DerivePrivKeyResponse, error)
+	// *
+	// DeriveNextKey derives the next unused key within the passed key family.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// DeriveKey derives the public key at the passed key locator.
+	DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// ScalarMult computes the SHA-256 of the ECDH shared point between the key
+	// described by the passed key descriptor and the given public key.
+	ScalarMult(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
 }
 
-func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbandonChannelRequest)
-	if err := dec(in); err != nil {
+func (c *remoteSignerClient) SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error) {
+	out := new(SignResp)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/SignOutputRaw", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LightningServer).AbandonChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AbandonChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
+	return out, nil
 }
 
-type Lightning_SendPaymentServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendRequest, error)
-	grpc.ServerStream
+func (c *remoteSignerClient) ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error) {
+	out := new(InputScriptResp)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/ComputeInputScript", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type lightningSendPaymentServer struct {
-	grpc.ServerStream
+func (c *remoteSignerClient) ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error) {
+	out := new(ExportWatchOnlyWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/ExportWatchOnlyWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *remoteSignerClient) SignMessage(ctx context.Context, in *KeySignMessageRequest, opts ...grpc.CallOption) (*KeySignMessageResponse, error) {
+	out := new(KeySignMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/SignMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
-	m := new(SendRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *remoteSignerClient) DerivePrivKey(ctx context.Context, in *KeyDescriptor, opts ...grpc.CallOption) (*DerivePrivKeyResponse, error) {
+	out := new(DerivePrivKeyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/DerivePrivKey", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRequest)
-	if err := dec(in); err != nil {
+func (c *remoteSignerClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/DeriveNextKey", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LightningServer).SendPaymentSync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
+func (c *remoteSignerClient) DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/DeriveKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type Lightning_SendToRouteServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendToRouteRequest, error)
-	grpc.ServerStream
+func (c *remoteSignerClient) ScalarMult(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error) {
+	out := new(SharedKeyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.RemoteSigner/ScalarMult", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type lightningSendToRouteServer struct {
-	grpc.ServerStream
-}
+// Server API for RemoteSigner service
 
-func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
+type RemoteSignerServer interface {
+	// *
+	// SignOutputRaw generates a signature for each of the passed sign
+	// descriptors, which describe the inputs of the passed transaction. The
+	// returned signatures don't include a sighash flag.
+	SignOutputRaw(context.Context, *SignReq) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete witness and sigScript for each of
+	// the passed sign descriptors, whose outputs must be p2wkh or np2wkh outputs
+	// controlled by the signer's wallet. If a sign descriptor includes the wallet
+	// key path of its output, the signer derives the addresses up to that key
+	// first, as the watch-only node may have handed out addresses the signer
+	// hasn't derived yet.
+	ComputeInputScript(context.Context, *SignReq) (*InputScriptResp, error)
+	// *
+	// ExportWatchOnlyWallet returns a copy of the signer's on-chain wallet with
+	// all private key material removed. It only holds the extended public keys of
+	// the wallet's accounts, from which the watch-only node derives its
+	// addresses, along with the wallet's transactions. The public data of the
+	// copy is encrypted with the default public passphrase. A watch-only node
+	// imports it as its wallet on its first start.
+	ExportWatchOnlyWallet(context.Context, *ExportWatchOnlyWalletRequest) (*ExportWatchOnlyWalletResponse, error)
+	// *
+	// SignMessage signs the double SHA-256 digest of the passed message with the
+	// private key that corresponds to the given public key.
+	SignMessage(context.Context, *KeySignMessageRequest) (*KeySignMessageResponse, error)
+	// *
+	// DerivePrivKey returns the private key described by the passed key
+	// descriptor. This is used for the few keys that must be held in memory by
+	// the watch-only node. Only keys of the node key, revocation root and
+	// watchtower session key families are returned, any other family is
+	// rejected.
+	DerivePrivKey(context.Context, *KeyDescriptor) (*DerivePrivKeyResponse, error)
+	// *
+	// DeriveNextKey derives the next unused key within the passed key family.
+	DeriveNextKey(context.Context, *KeyReq) (*KeyDescriptor, error)
+	// *
+	// DeriveKey derives the public key at the passed key locator.
+	DeriveKey(context.Context, *KeyLocator) (*KeyDescriptor, error)
+	// *
+	// ScalarMult computes the SHA-256 of the ECDH shared point between the key
+	// described by the passed key descriptor and the given public key.
+	ScalarMult(context.Context, *SharedKeyRequest) (*SharedKeyResponse, error)
 }
 
-func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
-	m := new(SendToRouteRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
 }
 
-func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendToRouteRequest)
+func _RemoteSigner_SignOutputRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendToRouteSync(ctx, in)
+		return srv.(RemoteSignerServer).SignOutputRaw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
+		FullMethod: "/lnrpc.RemoteSigner/SignOutputRaw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
+		return srv.(RemoteSignerServer).SignOutputRaw(ctx, req.(*SignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Invoice)
+func _RemoteSigner_ComputeInputScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).AddInvoice(ctx, in)
+		return srv.(RemoteSignerServer).ComputeInputScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AddInvoice",
+		FullMethod: "/lnrpc.RemoteSigner/ComputeInputScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
+		return srv.(RemoteSignerServer).ComputeInputScript(ctx, req.(*SignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInvoiceRequest)
+func _RemoteSigner_ExportWatchOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWatchOnlyWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListInvoices(ctx, in)
+		return srv.(RemoteSignerServer).ExportWatchOnlyWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListInvoices",
+		FullMethod: "/lnrpc.RemoteSigner/ExportWatchOnlyWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
+		return srv.(RemoteSignerServer).ExportWatchOnlyWallet(ctx, req.(*ExportWatchOnlyWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentHash)
+func _RemoteSigner_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeySignMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).LookupInvoice(ctx, in)
+		return srv.(RemoteSignerServer).SignMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/LookupInvoice",
+		FullMethod: "/lnrpc.RemoteSigner/SignMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
+		return srv.(RemoteSignerServer).SignMessage(ctx, req.(*KeySignMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InvoiceSubscription)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
-}
-
-type Lightning_SubscribeInvoicesServer interface {
-	Send(*Invoice) error
-	grpc.ServerStream
-}
-
-type lightningSubscribeInvoicesServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayReqString)
+func _RemoteSigner_DerivePrivKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyDescriptor)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DecodePayReq(ctx, in)
+		return srv.(RemoteSignerServer).DerivePrivKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DecodePayReq",
+		FullMethod: "/lnrpc.RemoteSigner/DerivePrivKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
+		return srv.(RemoteSignerServer).DerivePrivKey(ctx, req.(*KeyDescriptor))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPaymentsRequest)
+func _RemoteSigner_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPayments(ctx, in)
+		return srv.(RemoteSignerServer).DeriveNextKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPayments",
+		FullMethod: "/lnrpc.RemoteSigner/DeriveNextKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+		return srv.(RemoteSignerServer).DeriveNextKey(ctx, req.(*KeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllPaymentsRequest)
+func _RemoteSigner_DeriveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyLocator)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DeleteAllPayments(ctx, in)
+		return srv.(RemoteSignerServer).DeriveKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
+		FullMethod: "/lnrpc.RemoteSigner/DeriveKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
+		return srv.(RemoteSignerServer).DeriveKey(ctx, req.(*KeyLocator))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelGraphRequest)
+func _RemoteSigner_ScalarMult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DescribeGraph(ctx, in)
+		return srv.(RemoteSignerServer).ScalarMult(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DescribeGraph",
+		FullMethod: "/lnrpc.RemoteSigner/ScalarMult",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
+		return srv.(RemoteSignerServer).ScalarMult(ctx, req.(*SharedKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChanInfoRequest)
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignOutputRaw",
+			Handler:    _RemoteSigner_SignOutputRaw_Handler,
+		},
+		{
+			MethodName: "ComputeInputScript",
+			Handler:    _RemoteSigner_ComputeInputScript_Handler,
+		},
+		{
+			MethodName: "ExportWatchOnlyWallet",
+			Handler:    _RemoteSigner_ExportWatchOnlyWallet_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _RemoteSigner_SignMessage_Handler,
+		},
+		{
+			MethodName: "DerivePrivKey",
+			Handler:    _RemoteSigner_DerivePrivKey_Handler,
+		},
+		{
+			MethodName: "DeriveNextKey",
+			Handler:    _RemoteSigner_DeriveNextKey_Handler,
+		},
+		{
+			MethodName: "DeriveKey",
+			Handler:    _RemoteSigner_DeriveKey_Handler,
+		},
+		{
+			MethodName: "ScalarMult",
+			Handler:    _RemoteSigner_ScalarMult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// Client API for Signer service
+
+type SignerClient interface {
+	// *
+	// SignOutputRaw generates a signature for each of the passed sign
+	// descriptors, which describe the inputs of the passed transaction. The
+	// returned signatures don't include a sighash flag.
+	//
+	// The keys to sign with can be specified by either their public key or key
+	// locator, and are optionally tweaked as described by the sign descriptor.
+	SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete witness and sigScript for each of
+	// the passed sign descriptors, whose outputs must be p2wkh or np2wkh outputs
+	// controlled by the wallet. The transaction can then be broadcast once all
+	// of its inputs have been signed.
+	ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error)
+	// *
+	// DeriveSharedKey computes the SHA-256 of the ECDH shared point between the
+	// node key, or the key described by the optional key descriptor, and the
+	// passed ephemeral public key.
+	DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) SignOutputRaw(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*SignResp, error) {
+	out := new(SignResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/SignOutputRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error) {
+	out := new(InputScriptResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/ComputeInputScript", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error) {
+	out := new(SharedKeyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Signer/DeriveSharedKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Signer service
+
+type SignerServer interface {
+	// *
+	// SignOutputRaw generates a signature for each of the passed sign
+	// descriptors, which describe the inputs of the passed transaction. The
+	// returned signatures don't include a sighash flag.
+	//
+	// The keys to sign with can be specified by either their public key or key
+	// locator, and are optionally tweaked as described by the sign descriptor.
+	SignOutputRaw(context.Context, *SignReq) (*SignResp, error)
+	// *
+	// ComputeInputScript generates a complete witness and sigScript for each of
+	// the passed sign descriptors, whose outputs must be p2wkh or np2wkh outputs
+	// controlled by the wallet. The transaction can then be broadcast once all
+	// of its inputs have been signed.
+	ComputeInputScript(context.Context, *SignReq) (*InputScriptResp, error)
+	// *
+	// DeriveSharedKey computes the SHA-256 of the ECDH shared point between the
+	// node key, or the key described by the optional key descriptor, and the
+	// passed ephemeral public key.
+	DeriveSharedKey(context.Context, *SharedKeyRequest) (*SharedKeyResponse, error)
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_SignOutputRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetChanInfo(ctx, in)
+		return srv.(SignerServer).SignOutputRaw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetChanInfo",
+		FullMethod: "/lnrpc.Signer/SignOutputRaw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
+		return srv.(SignerServer).SignOutputRaw(ctx, req.(*SignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeInfoRequest)
+func _Signer_ComputeInputScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetNodeInfo(ctx, in)
+		return srv.(SignerServer).ComputeInputScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
+		FullMethod: "/lnrpc.Signer/ComputeInputScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
+		return srv.(SignerServer).ComputeInputScript(ctx, req.(*SignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRoutesRequest)
+func _Signer_DeriveSharedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).QueryRoutes(ctx, in)
+		return srv.(SignerServer).DeriveSharedKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/QueryRoutes",
+		FullMethod: "/lnrpc.Signer/DeriveSharedKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
+		return srv.(SignerServer).DeriveSharedKey(ctx, req.(*SharedKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkInfoRequest)
-	if err := dec(in); err != nil {
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignOutputRaw",
+			Handler:    _Signer_SignOutputRaw_Handler,
+		},
+		{
+			MethodName: "ComputeInputScript",
+			Handler:    _Signer_ComputeInputScript_Handler,
+		},
+		{
+			MethodName: "DeriveSharedKey",
+			Handler:    _Signer_DeriveSharedKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// Client API for WalletKit service
+
+type WalletKitClient interface {
+	// *
+	// DeriveNextKey derives the next unused key within the passed key family.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// DeriveKey derives the public key at the passed key locator.
+	DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error)
+	// *
+	// PublishTransaction broadcasts the passed fully signed transaction to the
+	// network.
+	PublishTransaction(ctx context.Context, in *PublishTxRequest, opts ...grpc.CallOption) (*PublishTxResponse, error)
+	// *
+	// EstimateFee returns the fee rate the wallet's fee estimator recommends for
+	// a transaction to confirm within the passed number of blocks.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+}
+
+type walletKitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
+	return &walletKitClient{cc}
+}
+
+func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletKit/DeriveNextKey", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LightningServer).GetNetworkInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
-	if err := dec(in); err != nil {
+func (c *walletKitClient) DeriveKey(ctx context.Context, in *KeyLocator, opts ...grpc.CallOption) (*KeyDescriptor, error) {
+	out := new(KeyDescriptor)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletKit/DeriveKey", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LightningServer).StopDaemon(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/StopDaemon",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GraphTopologySubscription)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *walletKitClient) PublishTransaction(ctx context.Context, in *PublishTxRequest, opts ...grpc.CallOption) (*PublishTxResponse, error) {
+	out := new(PublishTxResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletKit/PublishTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
+	return out, nil
 }
 
-type Lightning_SubscribeChannelGraphServer interface {
-	Send(*GraphTopologyUpdate) error
-	grpc.ServerStream
+func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletKit/EstimateFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type lightningSubscribeChannelGraphServer struct {
-	grpc.ServerStream
+// Server API for WalletKit service
+
+type WalletKitServer interface {
+	// *
+	// DeriveNextKey derives the next unused key within the passed key family.
+	DeriveNextKey(context.Context, *KeyReq) (*KeyDescriptor, error)
+	// *
+	// DeriveKey derives the public key at the passed key locator.
+	DeriveKey(context.Context, *KeyLocator) (*KeyDescriptor, error)
+	// *
+	// PublishTransaction broadcasts the passed fully signed transaction to the
+	// network.
+	PublishTransaction(context.Context, *PublishTxRequest) (*PublishTxResponse, error)
+	// *
+	// EstimateFee returns the fee rate the wallet's fee estimator recommends for
+	// a transaction to confirm within the passed number of blocks.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 }
 
-func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
-	return x.ServerStream.SendMsg(m)
+func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
+	s.RegisterService(&_WalletKit_serviceDesc, srv)
 }
 
-func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func _WalletKit_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DebugLevel(ctx, in)
+		return srv.(WalletKitServer).DeriveNextKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DebugLevel",
+		FullMethod: "/lnrpc.WalletKit/DeriveNextKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(WalletKitServer).DeriveNextKey(ctx, req.(*KeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeeReportRequest)
+func _WalletKit_DeriveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyLocator)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).FeeReport(ctx, in)
+		return srv.(WalletKitServer).DeriveKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/FeeReport",
+		FullMethod: "/lnrpc.WalletKit/DeriveKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
+		return srv.(WalletKitServer).DeriveKey(ctx, req.(*KeyLocator))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyUpdateRequest)
+func _WalletKit_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
+		return srv.(WalletKitServer).PublishTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+		FullMethod: "/lnrpc.WalletKit/PublishTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+		return srv.(WalletKitServer).PublishTransaction(ctx, req.(*PublishTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForwardingHistoryRequest)
+func _WalletKit_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ForwardingHistory(ctx, in)
+		return srv.(WalletKitServer).EstimateFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
+		FullMethod: "/lnrpc.WalletKit/EstimateFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+		return srv.(WalletKitServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Lightning_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lnrpc.Lightning",
-	HandlerType: (*LightningServer)(nil),
+var _WalletKit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.WalletKit",
+	HandlerType: (*WalletKitServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "WalletBalance",
-			Handler:    _Lightning_WalletBalance_Handler,
-		},
-		{
-			MethodName: "ChannelBalance",
-			Handler:    _Lightning_ChannelBalance_Handler,
-		},
-		{
-			MethodName: "GetTransactions",
-			Handler:    _Lightning_GetTransactions_Handler,
-		},
-		{
-			MethodName: "SendCoins",
-			Handler:    _Lightning_SendCoins_Handler,
-		},
-		{
-			MethodName: "SendMany",
-			Handler:    _Lightning_SendMany_Handler,
-		},
-		{
-			MethodName: "NewAddress",
-			Handler:    _Lightning_NewAddress_Handler,
-		},
-		{
-			MethodName: "SignMessage",
-			Handler:    _Lightning_SignMessage_Handler,
-		},
-		{
-			MethodName: "VerifyMessage",
-			Handler:    _Lightning_VerifyMessage_Handler,
-		},
-		{
-			MethodName: "ConnectPeer",
-			Handler:    _Lightning_ConnectPeer_Handler,
-		},
-		{
-			MethodName: "DisconnectPeer",
-			Handler:    _Lightning_DisconnectPeer_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _Lightning_ListPeers_Handler,
-		},
-		{
-			MethodName: "GetInfo",
-			Handler:    _Lightning_GetInfo_Handler,
-		},
-		{
-			MethodName: "PendingChannels",
-			Handler:    _Lightning_PendingChannels_Handler,
-		},
-		{
-			MethodName: "ListChannels",
-			Handler:    _Lightning_ListChannels_Handler,
-		},
-		{
-			MethodName: "ClosedChannels",
-			Handler:    _Lightning_ClosedChannels_Handler,
-		},
-		{
-			MethodName: "OpenChannelSync",
-			Handler:    _Lightning_OpenChannelSync_Handler,
-		},
-		{
-			MethodName: "AbandonChannel",
-			Handler:    _Lightning_AbandonChannel_Handler,
-		},
-		{
-			MethodName: "SendPaymentSync",
-			Handler:    _Lightning_SendPaymentSync_Handler,
-		},
-		{
-			MethodName: "SendToRouteSync",
-			Handler:    _Lightning_SendToRouteSync_Handler,
-		},
-		{
-			MethodName: "AddInvoice",
-			Handler:    _Lightning_AddInvoice_Handler,
-		},
-		{
-			MethodName: "ListInvoices",
-			Handler:    _Lightning_ListInvoices_Handler,
-		},
-		{
-			MethodName: "LookupInvoice",
-			Handler:    _Lightning_LookupInvoice_Handler,
-		},
-		{
-			MethodName: "DecodePayReq",
-			Handler:    _Lightning_DecodePayReq_Handler,
-		},
-		{
-			MethodName: "ListPayments",
-			Handler:    _Lightning_ListPayments_Handler,
-		},
-		{
-			MethodName: "DeleteAllPayments",
-			Handler:    _Lightning_DeleteAllPayments_Handler,
-		},
-		{
-			MethodName: "DescribeGraph",
-			Handler:    _Lightning_DescribeGraph_Handler,
-		},
-		{
-			MethodName: "GetChanInfo",
-			Handler:    _Lightning_GetChanInfo_Handler,
-		},
-		{
-			MethodName: "GetNodeInfo",
-			Handler:    _Lightning_GetNodeInfo_Handler,
-		},
-		{
-			MethodName: "QueryRoutes",
-			Handler:    _Lightning_QueryRoutes_Handler,
-		},
-		{
-			MethodName: "GetNetworkInfo",
-			Handler:    _Lightning_GetNetworkInfo_Handler,
-		},
-		{
-			MethodName: "StopDaemon",
-			Handler:    _Lightning_StopDaemon_Handler,
-		},
-		{
-			MethodName: "DebugLevel",
-			Handler:    _Lightning_DebugLevel_Handler,
-		},
-		{
-			MethodName: "FeeReport",
-			Handler:    _Lightning_FeeReport_Handler,
-		},
-		{
-			MethodName: "UpdateChannelPolicy",
-			Handler:    _Lightning_UpdateChannelPolicy_Handler,
-		},
-		{
-			MethodName: "ForwardingHistory",
-			Handler:    _Lightning_ForwardingHistory_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "SubscribeTransactions",
-			Handler:       _Lightning_SubscribeTransactions_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "OpenChannel",
-			Handler:       _Lightning_OpenChannel_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "CloseChannel",
-			Handler:       _Lightning_CloseChannel_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SendPayment",
-			Handler:       _Lightning_SendPayment_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
+			MethodName: "DeriveNextKey",
+			Handler:    _WalletKit_DeriveNextKey_Handler,
 		},
 		{
-			StreamName:    "SendToRoute",
-			Handler:       _Lightning_SendToRoute_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
+			MethodName: "DeriveKey",
+			Handler:    _WalletKit_DeriveKey_Handler,
 		},
 		{
-			StreamName:    "SubscribeInvoices",
-			Handler:       _Lightning_SubscribeInvoices_Handler,
-			ServerStreams: true,
+			MethodName: "PublishTransaction",
+			Handler:    _WalletKit_PublishTransaction_Handler,
 		},
 		{
-			StreamName:    "SubscribeChannelGraph",
-			Handler:       _Lightning_SubscribeChannelGraph_Handler,
-			ServerStreams: true,
+			MethodName: "EstimateFee",
+			Handler:    _WalletKit_EstimateFee_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xdf, 0x6f, 0x24, 0xd9,
-	0x55, 0xff, 0x54, 0xbb, 0xdb, 0x76, 0x9f, 0x6e, 0xdb, 0xed, 0xeb, 0x5f, 0x3d, 0xbd, 0xb3, 0xb3,
-	0xb3, 0x95, 0xd5, 0xce, 0xc4, 0xdf, 0xfd, 0xce, 0xcc, 0x3a, 0xc9, 0x6a, 0xb3, 0x0b, 0x09, 0x1e,
-	0xdb, 0x33, 0x9e, 0xc4, 0x3b, 0xe3, 0x94, 0x67, 0xb3, 0x24, 0x01, 0x75, 0xca, 0xdd, 0xd7, 0xed,
-	0xca, 0x74, 0x57, 0x75, 0xaa, 0xaa, 0xed, 0xed, 0x2c, 0x23, 0x01, 0x41, 0x3c, 0x20, 0x22, 0x84,
-	0x40, 0x42, 0x41, 0x42, 0x88, 0xc0, 0x43, 0xf8, 0x03, 0xc8, 0x0b, 0xf0, 0xc6, 0x0b, 0x48, 0x88,
-	0x87, 0x7d, 0x8a, 0x90, 0x78, 0x81, 0x17, 0x40, 0xbc, 0x20, 0xf1, 0x08, 0x42, 0xe7, 0xdc, 0x1f,
-	0x75, 0x6f, 0x55, 0x79, 0x3c, 0xf9, 0xc5, 0x5b, 0xdf, 0xcf, 0x3d, 0x75, 0x7f, 0x9e, 0x5f, 0xf7,
-	0xdc, 0x73, 0x1b, 0xea, 0xf1, 0xb8, 0x77, 0x7b, 0x1c, 0x47, 0x69, 0xc4, 0x6a, 0xc3, 0x30, 0x1e,
-	0xf7, 0x3a, 0xd7, 0x06, 0x51, 0x34, 0x18, 0xf2, 0x3b, 0xfe, 0x38, 0xb8, 0xe3, 0x87, 0x61, 0x94,
-	0xfa, 0x69, 0x10, 0x85, 0x89, 0x20, 0x72, 0xbf, 0x0e, 0x8b, 0x0f, 0x78, 0x78, 0xc4, 0x79, 0xdf,
-	0xe3, 0xdf, 0x9c, 0xf0, 0x24, 0x65, 0xff, 0x0f, 0x96, 0x7d, 0xfe, 0x2d, 0xce, 0xfb, 0xdd, 0xb1,
-	0x9f, 0x24, 0xe3, 0xd3, 0xd8, 0x4f, 0x78, 0xdb, 0xb9, 0xe1, 0xdc, 0x6a, 0x7a, 0x2d, 0x51, 0x71,
-	0xa8, 0x71, 0xf6, 0x2a, 0x34, 0x13, 0x24, 0xe5, 0x61, 0x1a, 0x47, 0xe3, 0x69, 0xbb, 0x42, 0x74,
-	0x0d, 0xc4, 0xf6, 0x04, 0xe4, 0x0e, 0x61, 0x49, 0xf7, 0x90, 0x8c, 0xa3, 0x30, 0xe1, 0xec, 0x2e,
-	0xac, 0xf6, 0x82, 0xf1, 0x29, 0x8f, 0xbb, 0xf4, 0xf1, 0x28, 0xe4, 0xa3, 0x28, 0x0c, 0x7a, 0x6d,
-	0xe7, 0xc6, 0xcc, 0xad, 0xba, 0xc7, 0x44, 0x1d, 0x7e, 0xf1, 0x9e, 0xac, 0x61, 0x37, 0x61, 0x89,
-	0x87, 0x02, 0xe7, 0x7d, 0xfa, 0x4a, 0x76, 0xb5, 0x98, 0xc1, 0xf8, 0x81, 0xfb, 0x37, 0x0e, 0x2c,
-	0x3f, 0x0c, 0x83, 0xf4, 0x03, 0x7f, 0x38, 0xe4, 0xa9, 0x9a, 0xd3, 0x4d, 0x58, 0x3a, 0x27, 0x80,
-	0xe6, 0x74, 0x1e, 0xc5, 0x7d, 0x39, 0xa3, 0x45, 0x01, 0x1f, 0x4a, 0xf4, 0xc2, 0x91, 0x55, 0x2e,
-	0x1c, 0x59, 0xe9, 0x72, 0xcd, 0x5c, 0xb0, 0x5c, 0x37, 0x61, 0x29, 0xe6, 0xbd, 0xe8, 0x8c, 0xc7,
-	0xd3, 0xee, 0x79, 0x10, 0xf6, 0xa3, 0xf3, 0x76, 0xf5, 0x86, 0x73, 0xab, 0xe6, 0x2d, 0x2a, 0xf8,
-	0x03, 0x42, 0xdd, 0x55, 0x60, 0xe6, 0x2c, 0xc4, 0xba, 0xb9, 0x03, 0x58, 0x79, 0x3f, 0x1c, 0x46,
-	0xbd, 0xa7, 0x3f, 0xe6, 0xec, 0x4a, 0xba, 0xaf, 0x94, 0x76, 0xbf, 0x0e, 0xab, 0x76, 0x47, 0x72,
-	0x00, 0x1c, 0xd6, 0x76, 0x4e, 0xfd, 0x70, 0xc0, 0x55, 0x93, 0x6a, 0x08, 0x9f, 0x84, 0x56, 0x6f,
-	0x12, 0xc7, 0x3c, 0x2c, 0x8c, 0x61, 0x49, 0xe2, 0x7a, 0x10, 0xaf, 0x42, 0x33, 0xe4, 0xe7, 0x19,
-	0x99, 0x64, 0x99, 0x90, 0x9f, 0x2b, 0x12, 0xb7, 0x0d, 0xeb, 0xf9, 0x6e, 0xe4, 0x00, 0xbe, 0x5b,
-	0x81, 0xc6, 0x93, 0xd8, 0x0f, 0x13, 0xbf, 0x87, 0x5c, 0xcc, 0xda, 0x30, 0x97, 0x7e, 0xd8, 0x3d,
-	0xf5, 0x93, 0x53, 0xea, 0xae, 0xee, 0xa9, 0x22, 0x5b, 0x87, 0x59, 0x7f, 0x14, 0x4d, 0xc2, 0x94,
-	0x3a, 0x98, 0xf1, 0x64, 0x89, 0xbd, 0x01, 0xcb, 0xe1, 0x64, 0xd4, 0xed, 0x45, 0xe1, 0x49, 0x10,
-	0x8f, 0x84, 0x2c, 0xd0, 0x7e, 0xd5, 0xbc, 0x62, 0x05, 0xbb, 0x0e, 0x70, 0x8c, 0xeb, 0x20, 0xba,
-	0xa8, 0x52, 0x17, 0x06, 0xc2, 0x5c, 0x68, 0xca, 0x12, 0x0f, 0x06, 0xa7, 0x69, 0xbb, 0x46, 0x0d,
-	0x59, 0x18, 0xb6, 0x91, 0x06, 0x23, 0xde, 0x4d, 0x52, 0x7f, 0x34, 0x6e, 0xcf, 0xd2, 0x68, 0x0c,
-	0x84, 0xea, 0xa3, 0xd4, 0x1f, 0x76, 0x4f, 0x38, 0x4f, 0xda, 0x73, 0xb2, 0x5e, 0x23, 0xec, 0x75,
-	0x58, 0xec, 0xf3, 0x24, 0xed, 0xfa, 0xfd, 0x7e, 0xcc, 0x93, 0x84, 0x27, 0xed, 0x79, 0xe2, 0xc6,
-	0x1c, 0x8a, 0xab, 0xf6, 0x80, 0xa7, 0xc6, 0xea, 0x24, 0x72, 0x77, 0xdc, 0x03, 0x60, 0x06, 0xbc,
-	0xcb, 0x53, 0x3f, 0x18, 0x26, 0xec, 0x2d, 0x68, 0xa6, 0x06, 0x31, 0x49, 0x5f, 0x63, 0x8b, 0xdd,
-	0x26, 0xb5, 0x71, 0xdb, 0xf8, 0xc0, 0xb3, 0xe8, 0xdc, 0x07, 0x30, 0x7f, 0x9f, 0xf3, 0x83, 0x60,
-	0x14, 0xa4, 0x6c, 0x1d, 0x6a, 0x27, 0xc1, 0x87, 0x5c, 0x6c, 0xf6, 0xcc, 0xfe, 0x15, 0x4f, 0x14,
-	0x59, 0x07, 0xe6, 0xc6, 0x3c, 0xee, 0x71, 0xb5, 0xfc, 0xfb, 0x57, 0x3c, 0x05, 0xdc, 0x9b, 0x83,
-	0xda, 0x10, 0x3f, 0x76, 0xbf, 0x5f, 0x81, 0xc6, 0x11, 0x0f, 0x35, 0x13, 0x31, 0xa8, 0xe2, 0x94,
-	0x24, 0xe3, 0xd0, 0x6f, 0xf6, 0x0a, 0x34, 0x68, 0x9a, 0x49, 0x1a, 0x07, 0xe1, 0x80, 0x1a, 0xab,
-	0x7b, 0x80, 0xd0, 0x11, 0x21, 0xac, 0x05, 0x33, 0xfe, 0x28, 0xa5, 0x1d, 0x9c, 0xf1, 0xf0, 0x27,
-	0x32, 0xd8, 0xd8, 0x9f, 0x8e, 0x90, 0x17, 0xf5, 0xae, 0x35, 0xbd, 0x86, 0xc4, 0xf6, 0x71, 0xdb,
-	0x6e, 0xc3, 0x8a, 0x49, 0xa2, 0x5a, 0xaf, 0x51, 0xeb, 0xcb, 0x06, 0xa5, 0xec, 0xe4, 0x26, 0x2c,
-	0x29, 0xfa, 0x58, 0x0c, 0x96, 0xf6, 0xb1, 0xee, 0x2d, 0x4a, 0x58, 0x4d, 0xe1, 0x16, 0xb4, 0x4e,
-	0x82, 0xd0, 0x1f, 0x76, 0x7b, 0xc3, 0xf4, 0xac, 0xdb, 0xe7, 0xc3, 0xd4, 0xa7, 0x1d, 0xad, 0x79,
-	0x8b, 0x84, 0xef, 0x0c, 0xd3, 0xb3, 0x5d, 0x44, 0xd9, 0x1b, 0x50, 0x3f, 0xe1, 0xbc, 0x4b, 0x2b,
-	0xd1, 0x9e, 0xbf, 0xe1, 0xdc, 0x6a, 0x6c, 0x2d, 0xc9, 0xa5, 0x57, 0xab, 0xeb, 0xcd, 0x9f, 0xc8,
-	0x5f, 0xee, 0xef, 0x3b, 0xd0, 0x14, 0x4b, 0x25, 0x55, 0xe8, 0x6b, 0xb0, 0xa0, 0x46, 0xc4, 0xe3,
-	0x38, 0x8a, 0x25, 0xfb, 0xdb, 0x20, 0xdb, 0x84, 0x96, 0x02, 0xc6, 0x31, 0x0f, 0x46, 0xfe, 0x80,
-	0x4b, 0x79, 0x2b, 0xe0, 0x6c, 0x2b, 0x6b, 0x31, 0x8e, 0x26, 0xa9, 0x50, 0x62, 0x8d, 0xad, 0xa6,
-	0x1c, 0x94, 0x87, 0x98, 0x67, 0x93, 0xb8, 0xdf, 0x71, 0x80, 0xe1, 0xb0, 0x9e, 0x44, 0xa2, 0x5a,
-	0xae, 0x42, 0x7e, 0x07, 0x9c, 0x17, 0xde, 0x81, 0xca, 0x45, 0x3b, 0xf0, 0x1a, 0xcc, 0x52, 0x97,
-	0x28, 0xab, 0x33, 0x85, 0x61, 0xc9, 0x3a, 0xf7, 0x7b, 0x0e, 0x34, 0x51, 0x73, 0x84, 0x7c, 0x78,
-	0x18, 0x05, 0x61, 0xca, 0xee, 0x02, 0x3b, 0x99, 0x84, 0xfd, 0x20, 0x1c, 0x74, 0xd3, 0x0f, 0x83,
-	0x7e, 0xf7, 0x78, 0x8a, 0x4d, 0xd0, 0x78, 0xf6, 0xaf, 0x78, 0x25, 0x75, 0xec, 0x0d, 0x68, 0x59,
-	0x68, 0x92, 0xc6, 0x62, 0x54, 0xfb, 0x57, 0xbc, 0x42, 0x0d, 0xca, 0x7f, 0x34, 0x49, 0xc7, 0x93,
-	0xb4, 0x1b, 0x84, 0x7d, 0xfe, 0x21, 0xad, 0xd9, 0x82, 0x67, 0x61, 0xf7, 0x16, 0xa1, 0x69, 0x7e,
-	0xe7, 0x7e, 0x0e, 0x5a, 0x07, 0xa8, 0x18, 0xc2, 0x20, 0x1c, 0x6c, 0x0b, 0xe9, 0x45, 0x6d, 0x35,
-	0x9e, 0x1c, 0x3f, 0xe5, 0x53, 0xb9, 0x8f, 0xb2, 0x84, 0x22, 0x71, 0x1a, 0x25, 0xa9, 0x5c, 0x17,
-	0xfa, 0xed, 0xfe, 0xb3, 0x03, 0x4b, 0xb8, 0xe8, 0xef, 0xf9, 0xe1, 0x54, 0xad, 0xf8, 0x01, 0x34,
-	0xb1, 0xa9, 0x27, 0xd1, 0xb6, 0xd0, 0x79, 0x42, 0x96, 0x6f, 0xc9, 0x45, 0xca, 0x51, 0xdf, 0x36,
-	0x49, 0xd1, 0x4c, 0x4f, 0x3d, 0xeb, 0x6b, 0x14, 0xba, 0xd4, 0x8f, 0x07, 0x3c, 0x25, 0x6d, 0x28,
-	0xb5, 0x23, 0x08, 0x68, 0x27, 0x0a, 0x4f, 0xd8, 0x0d, 0x68, 0x26, 0x7e, 0xda, 0x1d, 0xf3, 0x98,
-	0x56, 0x8d, 0x04, 0x67, 0xc6, 0x83, 0xc4, 0x4f, 0x0f, 0x79, 0x7c, 0x6f, 0x9a, 0xf2, 0xce, 0xe7,
-	0x61, 0xb9, 0xd0, 0x0b, 0xca, 0x6a, 0x36, 0x45, 0xfc, 0xc9, 0x56, 0xa1, 0x76, 0xe6, 0x0f, 0x27,
-	0x5c, 0x2a, 0x69, 0x51, 0x78, 0xa7, 0xf2, 0xb6, 0xe3, 0xbe, 0x0e, 0xad, 0x6c, 0xd8, 0x92, 0xe9,
-	0x19, 0x54, 0x71, 0x05, 0x65, 0x03, 0xf4, 0xdb, 0xfd, 0x35, 0x47, 0x10, 0xee, 0x44, 0x81, 0x56,
-	0x78, 0x48, 0x88, 0x7a, 0x51, 0x11, 0xe2, 0xef, 0x0b, 0x0d, 0xc2, 0x4f, 0x3e, 0x59, 0xf7, 0x26,
-	0x2c, 0x1b, 0x43, 0x78, 0xce, 0x60, 0xbf, 0xe3, 0xc0, 0xf2, 0x23, 0x7e, 0x2e, 0x77, 0x5d, 0x8d,
-	0xf6, 0x6d, 0xa8, 0xa6, 0xd3, 0xb1, 0x70, 0xb2, 0x16, 0xb7, 0x5e, 0x93, 0x9b, 0x56, 0xa0, 0xbb,
-	0x2d, 0x8b, 0x4f, 0xa6, 0x63, 0xee, 0xd1, 0x17, 0xee, 0xe7, 0xa0, 0x61, 0x80, 0x6c, 0x03, 0x56,
-	0x3e, 0x78, 0xf8, 0xe4, 0xd1, 0xde, 0xd1, 0x51, 0xf7, 0xf0, 0xfd, 0x7b, 0x5f, 0xdc, 0xfb, 0x4a,
-	0x77, 0x7f, 0xfb, 0x68, 0xbf, 0x75, 0x85, 0xad, 0x03, 0x7b, 0xb4, 0x77, 0xf4, 0x64, 0x6f, 0xd7,
-	0xc2, 0x1d, 0xf7, 0x36, 0x30, 0xb3, 0x1b, 0x39, 0xf2, 0x36, 0xcc, 0x49, 0xab, 0xa2, 0x8c, 0xaa,
-	0x2c, 0xba, 0xaf, 0x03, 0x3b, 0x0a, 0x06, 0xe1, 0x7b, 0x3c, 0x49, 0xfc, 0x81, 0x16, 0xf7, 0x16,
-	0xcc, 0x8c, 0x92, 0x81, 0x94, 0x72, 0xfc, 0xe9, 0x7e, 0x0a, 0x56, 0x2c, 0x3a, 0xd9, 0xf0, 0x35,
-	0xa8, 0x27, 0xc1, 0x20, 0xf4, 0xd3, 0x49, 0xcc, 0x65, 0xd3, 0x19, 0xe0, 0xde, 0x87, 0xd5, 0x2f,
-	0xf3, 0x38, 0x38, 0x99, 0x5e, 0xd6, 0xbc, 0xdd, 0x4e, 0x25, 0xdf, 0xce, 0x1e, 0xac, 0xe5, 0xda,
-	0x91, 0xdd, 0x0b, 0x66, 0x93, 0x5b, 0x32, 0xef, 0x89, 0x82, 0x21, 0x7a, 0x15, 0x53, 0xf4, 0xdc,
-	0xf7, 0x81, 0xed, 0x44, 0x61, 0xc8, 0x7b, 0xe9, 0x21, 0xe7, 0x71, 0xe6, 0x1d, 0x67, 0x9c, 0xd5,
-	0xd8, 0xda, 0x90, 0x7b, 0x95, 0x97, 0x67, 0xc9, 0x72, 0x0c, 0xaa, 0x63, 0x1e, 0x8f, 0xa8, 0xe1,
-	0x79, 0x8f, 0x7e, 0xbb, 0x6b, 0xb0, 0x62, 0x35, 0x2b, 0x1d, 0x9b, 0x37, 0x61, 0x6d, 0x37, 0x48,
-	0x7a, 0xc5, 0x0e, 0xdb, 0x30, 0x37, 0x9e, 0x1c, 0x77, 0x33, 0xb9, 0x51, 0x45, 0xb4, 0xf7, 0xf9,
-	0x4f, 0x64, 0x63, 0xbf, 0xe9, 0x40, 0x75, 0xff, 0xc9, 0xc1, 0x0e, 0xeb, 0xc0, 0x7c, 0x10, 0xf6,
-	0xa2, 0x11, 0xaa, 0x56, 0x31, 0x69, 0x5d, 0xbe, 0x50, 0x1e, 0xae, 0x41, 0x9d, 0x34, 0x32, 0xba,
-	0x30, 0xd2, 0x91, 0xcd, 0x00, 0x74, 0x9f, 0xf8, 0x87, 0xe3, 0x20, 0x26, 0xff, 0x48, 0x79, 0x3d,
-	0x55, 0xd2, 0x7a, 0xc5, 0x0a, 0xf7, 0x7f, 0xaa, 0x30, 0x27, 0xf5, 0x31, 0xf5, 0xd7, 0x4b, 0x83,
-	0x33, 0x2e, 0x47, 0x22, 0x4b, 0x68, 0xc9, 0x62, 0x3e, 0x8a, 0x52, 0xde, 0xb5, 0xb6, 0xc1, 0x06,
-	0x91, 0xaa, 0x27, 0x1a, 0xea, 0x8e, 0x51, 0xb3, 0xd3, 0xc8, 0xea, 0x9e, 0x0d, 0xe2, 0x62, 0x21,
-	0xd0, 0x0d, 0xfa, 0x34, 0xa6, 0xaa, 0xa7, 0x8a, 0xb8, 0x12, 0x3d, 0x7f, 0xec, 0xf7, 0x82, 0x74,
-	0x2a, 0x05, 0x58, 0x97, 0xb1, 0xed, 0x61, 0xd4, 0xf3, 0x87, 0xdd, 0x63, 0x7f, 0xe8, 0x87, 0x3d,
-	0x2e, 0x7d, 0x34, 0x1b, 0x44, 0x37, 0x4c, 0x0e, 0x49, 0x91, 0x09, 0x57, 0x2d, 0x87, 0xa2, 0x3b,
-	0xd7, 0x8b, 0x46, 0xa3, 0x20, 0x45, 0xef, 0x8d, 0x2c, 0xfb, 0x8c, 0x67, 0x20, 0x34, 0x13, 0x51,
-	0x3a, 0x17, 0xab, 0x57, 0x17, 0xbd, 0x59, 0x20, 0xb6, 0x82, 0xee, 0x01, 0x2a, 0x9d, 0xa7, 0xe7,
-	0x6d, 0x10, 0xad, 0x64, 0x08, 0xee, 0xc3, 0x24, 0x4c, 0x78, 0x9a, 0x0e, 0x79, 0x5f, 0x0f, 0xa8,
-	0x41, 0x64, 0xc5, 0x0a, 0x76, 0x17, 0x56, 0x84, 0x43, 0x99, 0xf8, 0x69, 0x94, 0x9c, 0x06, 0x49,
-	0x37, 0x41, 0xd7, 0xac, 0x49, 0xf4, 0x65, 0x55, 0xec, 0x6d, 0xd8, 0xc8, 0xc1, 0x31, 0xef, 0xf1,
-	0xe0, 0x8c, 0xf7, 0xdb, 0x0b, 0xf4, 0xd5, 0x45, 0xd5, 0xec, 0x06, 0x34, 0xd0, 0x8f, 0x9e, 0x8c,
-	0xfb, 0x3e, 0xda, 0xda, 0x45, 0xda, 0x07, 0x13, 0x62, 0x6f, 0xc2, 0xc2, 0x98, 0x0b, 0x83, 0x78,
-	0x9a, 0x0e, 0x7b, 0x49, 0x7b, 0x89, 0xac, 0x55, 0x43, 0x0a, 0x13, 0x72, 0xae, 0x67, 0x53, 0x20,
-	0x53, 0xf6, 0x12, 0x72, 0xa8, 0xfc, 0x69, 0xbb, 0x45, 0xec, 0x96, 0x01, 0x24, 0x23, 0x71, 0x70,
-	0xe6, 0xa7, 0xbc, 0xbd, 0x4c, 0xbc, 0xa5, 0x8a, 0xee, 0x1f, 0x3b, 0xb0, 0x72, 0x10, 0x24, 0xa9,
-	0x64, 0x42, 0xad, 0x72, 0x5f, 0x81, 0x86, 0x60, 0xbf, 0x6e, 0x14, 0x0e, 0xa7, 0x92, 0x23, 0x41,
-	0x40, 0x8f, 0xc3, 0xe1, 0x94, 0x7d, 0x02, 0x16, 0x82, 0xd0, 0x24, 0x11, 0x32, 0xdc, 0x54, 0x20,
-	0x11, 0xbd, 0x02, 0x8d, 0xf1, 0xe4, 0x78, 0x18, 0xf4, 0x04, 0xc9, 0x8c, 0x68, 0x45, 0x40, 0x44,
-	0x80, 0x8e, 0x90, 0x18, 0x89, 0xa0, 0xa8, 0x12, 0x45, 0x43, 0x62, 0x48, 0xe2, 0xde, 0x83, 0x55,
-	0x7b, 0x80, 0x52, 0x59, 0x6d, 0xc2, 0xbc, 0xe4, 0xed, 0xa4, 0xdd, 0xa0, 0xf5, 0x59, 0x94, 0xeb,
-	0x23, 0x49, 0x3d, 0x5d, 0xef, 0xfe, 0xa0, 0x0a, 0x2b, 0x12, 0xdd, 0x19, 0x46, 0x09, 0x3f, 0x9a,
-	0x8c, 0x46, 0x7e, 0x5c, 0x22, 0x34, 0xce, 0x25, 0x42, 0x53, 0xb1, 0x85, 0x06, 0x59, 0xf9, 0xd4,
-	0x0f, 0x42, 0xe1, 0xc5, 0x09, 0x89, 0x33, 0x10, 0x76, 0x0b, 0x96, 0x7a, 0xc3, 0x28, 0x11, 0x9e,
-	0x8d, 0x79, 0x44, 0xca, 0xc3, 0x45, 0x21, 0xaf, 0x95, 0x09, 0xb9, 0x29, 0xa4, 0xb3, 0x39, 0x21,
-	0x75, 0xa1, 0x89, 0x8d, 0x72, 0xa5, 0x73, 0xe6, 0x84, 0xa7, 0x65, 0x62, 0x38, 0x9e, 0xbc, 0x48,
-	0x08, 0xf9, 0x5b, 0x2a, 0x13, 0x08, 0x3c, 0x81, 0xa1, 0x4e, 0x33, 0xa8, 0xeb, 0x52, 0x20, 0x8a,
-	0x55, 0xec, 0x3e, 0x80, 0xe8, 0x8b, 0x4c, 0x35, 0x90, 0xa9, 0x7e, 0xdd, 0xde, 0x11, 0x73, 0xed,
-	0x6f, 0x63, 0x61, 0x12, 0x73, 0x32, 0xd6, 0xc6, 0x97, 0xee, 0x6f, 0x39, 0xd0, 0x30, 0xea, 0xd8,
-	0x1a, 0x2c, 0xef, 0x3c, 0x7e, 0x7c, 0xb8, 0xe7, 0x6d, 0x3f, 0x79, 0xf8, 0xe5, 0xbd, 0xee, 0xce,
-	0xc1, 0xe3, 0xa3, 0xbd, 0xd6, 0x15, 0x84, 0x0f, 0x1e, 0xef, 0x6c, 0x1f, 0x74, 0xef, 0x3f, 0xf6,
-	0x76, 0x14, 0xec, 0xa0, 0x21, 0xf7, 0xf6, 0xde, 0x7b, 0xfc, 0x64, 0xcf, 0xc2, 0x2b, 0xac, 0x05,
-	0xcd, 0x7b, 0xde, 0xde, 0xf6, 0xce, 0xbe, 0x44, 0x66, 0xd8, 0x2a, 0xb4, 0xee, 0xbf, 0xff, 0x68,
-	0xf7, 0xe1, 0xa3, 0x07, 0xdd, 0x9d, 0xed, 0x47, 0x3b, 0x7b, 0x07, 0x7b, 0xbb, 0xad, 0x2a, 0x5b,
-	0x80, 0xfa, 0xf6, 0xbd, 0xed, 0x47, 0xbb, 0x8f, 0x1f, 0xed, 0xed, 0xb6, 0x6a, 0xee, 0x3f, 0x39,
-	0xb0, 0x46, 0xa3, 0xee, 0xe7, 0x05, 0xe4, 0x06, 0x34, 0x7a, 0x51, 0x34, 0xe6, 0xa8, 0xcf, 0xb5,
-	0xca, 0x36, 0x21, 0x64, 0x7e, 0xa1, 0x20, 0x4f, 0xa2, 0xb8, 0xc7, 0xa5, 0x7c, 0x00, 0x41, 0xf7,
-	0x11, 0x41, 0xe6, 0x97, 0xdb, 0x2b, 0x28, 0x84, 0x78, 0x34, 0x04, 0x26, 0x48, 0xd6, 0x61, 0xf6,
-	0x38, 0xe6, 0x7e, 0xef, 0x54, 0x4a, 0x86, 0x2c, 0xb1, 0x4f, 0x66, 0x4e, 0x78, 0x0f, 0x57, 0x7f,
-	0xc8, 0xfb, 0xc4, 0x31, 0xf3, 0xde, 0x92, 0xc4, 0x77, 0x24, 0x8c, 0x9a, 0xc1, 0x3f, 0xf6, 0xc3,
-	0x7e, 0x14, 0xf2, 0x3e, 0x31, 0xcd, 0xbc, 0x97, 0x01, 0xee, 0x21, 0xac, 0xe7, 0xe7, 0x27, 0xe5,
-	0xeb, 0x2d, 0x43, 0xbe, 0x84, 0xb7, 0xdc, 0xb9, 0x78, 0x37, 0x0d, 0x59, 0xfb, 0x37, 0x07, 0xaa,
-	0x68, 0x6c, 0x2f, 0x36, 0xcc, 0xa6, 0xff, 0x34, 0x63, 0xf9, 0x4f, 0x14, 0x4e, 0xc0, 0x53, 0x86,
-	0x50, 0xbf, 0xc2, 0x44, 0x19, 0x48, 0x56, 0x1f, 0xf3, 0xde, 0x19, 0xcd, 0x58, 0xd7, 0x23, 0x82,
-	0x02, 0x82, 0xae, 0x28, 0x7d, 0x2d, 0x05, 0x44, 0x95, 0x55, 0x1d, 0x7d, 0x39, 0x97, 0xd5, 0xd1,
-	0x77, 0x6d, 0x98, 0x0b, 0xc2, 0xe3, 0x68, 0x12, 0xf6, 0x49, 0x20, 0xe6, 0x3d, 0x55, 0xc4, 0xe5,
-	0x1b, 0x93, 0xa0, 0x06, 0x23, 0xc5, 0xfe, 0x19, 0xe0, 0x32, 0x3c, 0xaa, 0x24, 0xe4, 0x5c, 0xe8,
-	0x60, 0xc2, 0x5b, 0xb0, 0x6c, 0x60, 0x72, 0x35, 0x5f, 0x85, 0xda, 0x18, 0x01, 0xb9, 0x94, 0x4a,
-	0x95, 0x93, 0x57, 0x22, 0x6a, 0xdc, 0x16, 0x2c, 0x3e, 0xe0, 0xe9, 0xc3, 0xf0, 0x24, 0x52, 0x2d,
-	0xfd, 0x70, 0x06, 0x96, 0x34, 0x24, 0x1b, 0xba, 0x05, 0x4b, 0x41, 0x9f, 0x87, 0x69, 0x90, 0x4e,
-	0xbb, 0xd6, 0x89, 0x28, 0x0f, 0xa3, 0x37, 0xe7, 0x0f, 0x03, 0x3f, 0x91, 0xfe, 0x82, 0x28, 0xb0,
-	0x2d, 0x58, 0x45, 0x53, 0xa3, 0xac, 0x87, 0xde, 0x62, 0x71, 0x30, 0x2b, 0xad, 0x43, 0x65, 0x80,
-	0xb8, 0xd4, 0xf6, 0xfa, 0x13, 0xe1, 0xd5, 0x94, 0x55, 0xe1, 0xaa, 0x89, 0x96, 0x70, 0xca, 0x35,
-	0x61, 0x8e, 0x34, 0x50, 0x08, 0x0a, 0xcd, 0x0a, 0x55, 0x95, 0x0f, 0x0a, 0x19, 0x81, 0xa5, 0xf9,
-	0x42, 0x60, 0x09, 0x55, 0xd9, 0x34, 0xec, 0xf1, 0x7e, 0x37, 0x8d, 0xba, 0xa4, 0x72, 0x69, 0x77,
-	0xe6, 0xbd, 0x3c, 0x4c, 0x21, 0x30, 0x9e, 0xa4, 0x21, 0x4f, 0x49, 0x2b, 0xcd, 0x7b, 0xaa, 0x88,
-	0xd2, 0x45, 0x24, 0xc2, 0x80, 0xd4, 0x3d, 0x59, 0x42, 0xb7, 0x74, 0x12, 0x07, 0x49, 0xbb, 0x49,
-	0x28, 0xfd, 0x66, 0x9f, 0x86, 0xb5, 0x63, 0x9e, 0xa4, 0xdd, 0x53, 0xee, 0xf7, 0x79, 0x4c, 0xbb,
-	0x2f, 0xe2, 0x55, 0xc2, 0xda, 0x97, 0x57, 0x62, 0xdf, 0x67, 0x3c, 0x4e, 0x82, 0x28, 0x24, 0x3b,
-	0x5f, 0xf7, 0x54, 0xd1, 0xfd, 0x16, 0x79, 0xcf, 0x3a, 0x92, 0xf6, 0x3e, 0x99, 0x7e, 0xf6, 0x12,
-	0xd4, 0xc5, 0x1c, 0x93, 0x53, 0x5f, 0x3a, 0xf4, 0xf3, 0x04, 0x1c, 0x9d, 0xfa, 0xa8, 0x2f, 0xac,
-	0x65, 0x13, 0xa1, 0xc9, 0x06, 0x61, 0xfb, 0x62, 0xd5, 0x5e, 0x83, 0x45, 0x15, 0xa3, 0x4b, 0xba,
-	0x43, 0x7e, 0x92, 0xaa, 0x03, 0x77, 0x38, 0x19, 0x61, 0x77, 0xc9, 0x01, 0x3f, 0x49, 0xdd, 0x47,
-	0xb0, 0x2c, 0x65, 0xf8, 0xf1, 0x98, 0xab, 0xae, 0x3f, 0x5b, 0x66, 0x0b, 0x1b, 0x5b, 0x2b, 0xb6,
-	0xd0, 0x53, 0xd4, 0x20, 0x67, 0x20, 0x5d, 0x0f, 0x98, 0xa9, 0x13, 0x64, 0x83, 0xd2, 0x20, 0xa9,
-	0x63, 0xbd, 0x9c, 0x8e, 0x85, 0xe1, 0xfa, 0x24, 0x93, 0x5e, 0x0f, 0x35, 0x81, 0xd0, 0x8f, 0xaa,
-	0xe8, 0x7e, 0xdf, 0x81, 0x15, 0x6a, 0x4d, 0x59, 0x73, 0x7d, 0x16, 0x7c, 0xf1, 0x61, 0x36, 0x7b,
-	0x66, 0xa8, 0x63, 0x15, 0x6a, 0xa6, 0x26, 0x16, 0x85, 0x1f, 0xfd, 0x74, 0x5b, 0x2d, 0x9c, 0x6e,
-	0x7f, 0xe8, 0xc0, 0xb2, 0x50, 0x86, 0xa9, 0x9f, 0x4e, 0x12, 0x39, 0xfd, 0x9f, 0x83, 0x05, 0x61,
-	0xd5, 0xa4, 0x38, 0xc9, 0x81, 0xae, 0x6a, 0xc9, 0x27, 0x54, 0x10, 0xef, 0x5f, 0xf1, 0x6c, 0x62,
-	0xf6, 0x79, 0x68, 0x9a, 0x81, 0x56, 0x1a, 0x73, 0x63, 0xeb, 0xaa, 0x9a, 0x65, 0x81, 0x73, 0xf6,
-	0xaf, 0x78, 0xd6, 0x07, 0xec, 0x5d, 0x72, 0x4d, 0xc2, 0x2e, 0x35, 0x2b, 0x43, 0x55, 0x57, 0x4b,
-	0x14, 0xb8, 0xfe, 0xdc, 0x20, 0xbf, 0x37, 0x0f, 0xb3, 0xc2, 0x17, 0x75, 0x1f, 0xc0, 0x82, 0x35,
-	0x52, 0xeb, 0xd4, 0xde, 0x14, 0xa7, 0xf6, 0x42, 0x90, 0xa7, 0x52, 0x0c, 0xf2, 0xb8, 0xdf, 0x9e,
-	0x01, 0x86, 0xdc, 0x96, 0xdb, 0x4e, 0x74, 0x86, 0xa3, 0xbe, 0x75, 0xb4, 0x69, 0x7a, 0x26, 0xc4,
-	0x6e, 0x03, 0x33, 0x8a, 0x2a, 0x0e, 0x26, 0xec, 0x46, 0x49, 0x0d, 0x2a, 0x38, 0x69, 0x76, 0xa5,
-	0x81, 0x94, 0x87, 0x38, 0xb1, 0x6f, 0xa5, 0x75, 0x68, 0x1a, 0xc6, 0x93, 0xe4, 0x14, 0x9d, 0x75,
-	0x75, 0xf8, 0x51, 0xe5, 0x3c, 0x83, 0xcc, 0x5e, 0xca, 0x20, 0x73, 0x79, 0x06, 0x31, 0xdd, 0xef,
-	0x79, 0xcb, 0xfd, 0x46, 0xb7, 0x6f, 0x84, 0xce, 0x62, 0x3a, 0xec, 0x75, 0x47, 0xd8, 0xbb, 0x3c,
-	0xeb, 0x58, 0x20, 0xdb, 0x84, 0x96, 0x74, 0x14, 0x32, 0x1f, 0x1f, 0x68, 0x8d, 0x0b, 0x38, 0x6a,
-	0x5e, 0xfc, 0x98, 0x34, 0x00, 0x9d, 0x77, 0x6a, 0x5e, 0x06, 0xb8, 0x1f, 0x3b, 0xd0, 0xc2, 0x5d,
-	0xb0, 0x38, 0xf5, 0x1d, 0x20, 0x41, 0x79, 0x41, 0x46, 0xb5, 0x68, 0x7f, 0x72, 0x3e, 0x7d, 0x1b,
-	0xea, 0xd4, 0x60, 0x34, 0xe6, 0xa1, 0x64, 0xd3, 0xb6, 0xcd, 0xa6, 0x99, 0x8e, 0xda, 0xbf, 0xe2,
-	0x65, 0xc4, 0x06, 0x93, 0xfe, 0x83, 0x03, 0x0d, 0x39, 0xcc, 0x1f, 0xfb, 0x54, 0xdf, 0x81, 0x79,
-	0xe4, 0x57, 0xe3, 0xe8, 0xac, 0xcb, 0x68, 0x6b, 0x46, 0x7e, 0x3a, 0x89, 0xd1, 0xb8, 0x5a, 0x27,
-	0xfa, 0x3c, 0x8c, 0x96, 0x92, 0xd4, 0x71, 0xd2, 0x4d, 0x83, 0x61, 0x57, 0xd5, 0xca, 0x5b, 0x8f,
-	0xb2, 0x2a, 0xd4, 0x4a, 0x49, 0xea, 0x0f, 0xb8, 0x34, 0x82, 0xa2, 0xe0, 0xb6, 0x61, 0x5d, 0x4e,
-	0x28, 0xe7, 0x77, 0xba, 0x7f, 0xdd, 0x84, 0x8d, 0x42, 0x95, 0xbe, 0x36, 0x94, 0x47, 0xd5, 0x61,
-	0x30, 0x3a, 0x8e, 0xb4, 0xd3, 0xee, 0x98, 0xa7, 0x58, 0xab, 0x8a, 0x0d, 0x60, 0x4d, 0x59, 0x7b,
-	0x5c, 0xd3, 0xcc, 0xb6, 0x57, 0xc8, 0x4d, 0x79, 0xd3, 0xe6, 0x81, 0x7c, 0x87, 0x0a, 0x37, 0xe5,
-	0xba, 0xbc, 0x3d, 0x76, 0x0a, 0x6d, 0xed, 0x56, 0x48, 0x03, 0x60, 0xb8, 0x1e, 0xd8, 0xd7, 0x1b,
-	0x97, 0xf4, 0x65, 0xb9, 0xa9, 0xde, 0x85, 0xad, 0xb1, 0x29, 0x5c, 0x57, 0x75, 0xa4, 0xe1, 0x8b,
-	0xfd, 0x55, 0x5f, 0x68, 0x6e, 0xe4, 0x80, 0xdb, 0x9d, 0x5e, 0xd2, 0x30, 0xfb, 0x06, 0xac, 0x9f,
-	0xfb, 0x41, 0xaa, 0x86, 0x65, 0xb8, 0x4a, 0x35, 0xea, 0x72, 0xeb, 0x92, 0x2e, 0x3f, 0x10, 0x1f,
-	0x5b, 0x66, 0xef, 0x82, 0x16, 0x3b, 0x7f, 0xe7, 0xc0, 0xa2, 0xdd, 0x0e, 0xb2, 0xa9, 0x54, 0x07,
-	0x4a, 0x2d, 0x2a, 0xd7, 0x30, 0x07, 0x17, 0xcf, 0xbd, 0x95, 0xb2, 0x73, 0xaf, 0x79, 0xda, 0x9c,
-	0xb9, 0x2c, 0x24, 0x54, 0x7d, 0xb1, 0x90, 0x50, 0xad, 0x2c, 0x24, 0xd4, 0xf9, 0x2f, 0x07, 0x58,
-	0x91, 0x97, 0xd8, 0x03, 0x71, 0xf0, 0x0e, 0xf9, 0x50, 0xea, 0xa4, 0xff, 0xff, 0x62, 0xfc, 0xa8,
-	0xd6, 0x4e, 0x7d, 0x8d, 0x82, 0x61, 0x2a, 0x1d, 0xd3, 0x81, 0x5a, 0xf0, 0xca, 0xaa, 0x72, 0x41,
-	0xaa, 0xea, 0xe5, 0x41, 0xaa, 0xda, 0xe5, 0x41, 0xaa, 0xd9, 0x7c, 0x90, 0xaa, 0xf3, 0x1b, 0x0e,
-	0xac, 0x94, 0x6c, 0xfa, 0x4f, 0x6f, 0xe2, 0xb8, 0x4d, 0x96, 0x2e, 0xa8, 0xc8, 0x6d, 0x32, 0xc1,
-	0xce, 0xaf, 0xc0, 0x82, 0xc5, 0xe8, 0x3f, 0xbd, 0xfe, 0xf3, 0x3e, 0xa0, 0xe0, 0x33, 0x0b, 0xeb,
-	0xfc, 0x7b, 0x05, 0x58, 0x51, 0xd8, 0xfe, 0x4f, 0xc7, 0x50, 0x5c, 0xa7, 0x99, 0x92, 0x75, 0xfa,
-	0x99, 0xda, 0x81, 0x37, 0x60, 0x59, 0xe6, 0x18, 0x18, 0xe1, 0x16, 0xc1, 0x31, 0xc5, 0x0a, 0xf4,
-	0x82, 0xed, 0x08, 0xe1, 0xbc, 0x75, 0x37, 0x6d, 0x18, 0xc3, 0x5c, 0xa0, 0xd0, 0x5d, 0x87, 0x55,
-	0x91, 0xb3, 0x70, 0x4f, 0x34, 0xa5, 0xec, 0xca, 0x1f, 0x39, 0xb0, 0x96, 0xab, 0xc8, 0x6e, 0x52,
-	0x85, 0xe9, 0xb0, 0xed, 0x89, 0x0d, 0xe2, 0xf8, 0xa5, 0x1c, 0x19, 0xe3, 0x17, 0xdc, 0x56, 0xac,
-	0xc0, 0xf5, 0x99, 0x84, 0x45, 0x7a, 0xb1, 0xea, 0x65, 0x55, 0xee, 0x86, 0xc8, 0xac, 0x08, 0xf9,
-	0x30, 0x37, 0xf0, 0x13, 0x91, 0x0b, 0x61, 0x56, 0x64, 0xd7, 0x34, 0xf6, 0x90, 0x55, 0x11, 0x7d,
-	0x44, 0xcb, 0x4c, 0xd9, 0xe3, 0x2d, 0xad, 0x73, 0x7f, 0xe0, 0x00, 0xfb, 0xd2, 0x84, 0xc7, 0x53,
-	0xba, 0x51, 0xd5, 0x71, 0xa0, 0x8d, 0x7c, 0x94, 0x63, 0x76, 0x3c, 0x39, 0xfe, 0x22, 0x9f, 0xaa,
-	0x7b, 0xf7, 0x4a, 0x76, 0xef, 0xfe, 0x32, 0x00, 0x1e, 0xce, 0xf4, 0x35, 0x2d, 0xf9, 0x66, 0xe1,
-	0x64, 0x24, 0x1a, 0x2c, 0xbd, 0x1a, 0xaf, 0x5e, 0x7e, 0x35, 0x5e, 0xbb, 0xec, 0x6a, 0xfc, 0x5d,
-	0x58, 0xb1, 0xc6, 0xad, 0xb7, 0x55, 0x5d, 0x18, 0x3b, 0xcf, 0xb9, 0x30, 0xfe, 0x0f, 0x07, 0x66,
-	0xf6, 0xa3, 0xb1, 0x19, 0x03, 0x75, 0xec, 0x18, 0xa8, 0xb4, 0x25, 0x5d, 0x6d, 0x2a, 0xa4, 0x8a,
-	0xb1, 0x40, 0xb6, 0x09, 0x8b, 0xfe, 0x28, 0xc5, 0x43, 0xf9, 0x49, 0x14, 0x9f, 0xfb, 0x71, 0x5f,
-	0xec, 0xf5, 0xbd, 0x4a, 0xdb, 0xf1, 0x72, 0x35, 0x6c, 0x15, 0x66, 0xb4, 0xd2, 0x25, 0x02, 0x2c,
-	0xa2, 0xe3, 0x46, 0xf7, 0x27, 0x53, 0x19, 0x4f, 0x90, 0x25, 0x64, 0x25, 0xfb, 0x7b, 0xe1, 0x48,
-	0x0b, 0xd1, 0x29, 0xab, 0x42, 0xbb, 0x86, 0xcb, 0x47, 0x64, 0x32, 0x10, 0xa4, 0xca, 0xee, 0xbf,
-	0x3a, 0x50, 0xa3, 0x15, 0x40, 0x61, 0x17, 0x1c, 0xae, 0x83, 0x9d, 0x34, 0xf3, 0x05, 0x2f, 0x0f,
-	0x33, 0xd7, 0xca, 0x4f, 0xa9, 0xe8, 0x61, 0x9b, 0x39, 0x2a, 0x37, 0xa0, 0x2e, 0x4a, 0x3a, 0x17,
-	0x83, 0x48, 0x32, 0x90, 0x5d, 0x87, 0xea, 0x69, 0x34, 0x56, 0xde, 0x09, 0xa8, 0x58, 0x7f, 0x34,
-	0xf6, 0x08, 0xcf, 0xc6, 0x83, 0xed, 0x89, 0xc1, 0x0b, 0x9b, 0x93, 0x87, 0xd1, 0xea, 0xea, 0x66,
-	0xcd, 0xc5, 0xc8, 0xa1, 0xee, 0x26, 0x2c, 0x3d, 0x8a, 0xfa, 0xdc, 0x88, 0x38, 0x5d, 0xc8, 0xcd,
-	0xee, 0xaf, 0x3a, 0x30, 0xaf, 0x88, 0xd9, 0x2d, 0xa8, 0xa2, 0x2b, 0x91, 0x3b, 0x28, 0xe8, 0x3b,
-	0x3e, 0xa4, 0xf3, 0x88, 0x02, 0x75, 0x2f, 0xc5, 0x23, 0x32, 0xb7, 0x52, 0x45, 0x23, 0x32, 0xaf,
-	0x49, 0x0f, 0x37, 0xe7, 0x6c, 0xe4, 0x50, 0xf7, 0xcf, 0x1d, 0x58, 0xb0, 0xfa, 0xc0, 0xc3, 0xe3,
-	0xd0, 0x4f, 0x52, 0x79, 0x6f, 0x22, 0xb7, 0xc7, 0x84, 0xcc, 0x18, 0x64, 0xc5, 0x8e, 0x41, 0xea,
-	0xe8, 0xd8, 0x8c, 0x19, 0x1d, 0xbb, 0x0b, 0xf5, 0x2c, 0x8b, 0xa8, 0x6a, 0xe9, 0x54, 0xec, 0x51,
-	0xdd, 0x5e, 0x66, 0x44, 0xd8, 0x4e, 0x2f, 0x1a, 0x46, 0xb1, 0x0c, 0xd8, 0x8b, 0x82, 0xfb, 0x2e,
-	0x34, 0x0c, 0x7a, 0x1c, 0x46, 0xc8, 0xd3, 0xf3, 0x28, 0x7e, 0xaa, 0x42, 0xa1, 0xb2, 0xa8, 0x2f,
-	0xe2, 0x2b, 0xd9, 0x45, 0xbc, 0xfb, 0xb7, 0x0e, 0x2c, 0x20, 0x0f, 0x06, 0xe1, 0xe0, 0x30, 0x1a,
-	0x06, 0xbd, 0x29, 0xed, 0xbd, 0x62, 0x37, 0xa9, 0x19, 0x14, 0x2f, 0xda, 0x30, 0xf2, 0xb6, 0x3a,
-	0x3b, 0x4a, 0x41, 0xd4, 0x65, 0x94, 0x54, 0xe4, 0xf3, 0x63, 0x3f, 0x91, 0xcc, 0x2f, 0x8d, 0x9c,
-	0x05, 0xa2, 0x3c, 0x21, 0x10, 0xfb, 0x29, 0xef, 0x8e, 0x82, 0xe1, 0x30, 0x10, 0xb4, 0xc2, 0x05,
-	0x2a, 0xab, 0xc2, 0x3e, 0xfb, 0x41, 0xe2, 0x1f, 0x67, 0x41, 0x68, 0x5d, 0x76, 0xff, 0xb2, 0x02,
-	0x0d, 0xa9, 0x9e, 0xf7, 0xfa, 0x03, 0x2e, 0x6f, 0x4c, 0xc8, 0xc9, 0xd4, 0xaa, 0xc4, 0x40, 0x54,
-	0xbd, 0xe5, 0x96, 0x1a, 0x48, 0x7e, 0xcb, 0x67, 0x8a, 0x5b, 0x7e, 0x0d, 0xea, 0xc8, 0x7a, 0x6f,
-	0x92, 0xff, 0x2b, 0x6e, 0x5b, 0x32, 0x40, 0xd5, 0x6e, 0x51, 0x6d, 0x2d, 0xab, 0x25, 0xe0, 0xb9,
-	0xf7, 0x2b, 0x6f, 0x43, 0x53, 0x36, 0x43, 0x7b, 0x42, 0x9a, 0x23, 0x63, 0x7e, 0x6b, 0xbf, 0x3c,
-	0x8b, 0x52, 0x7d, 0xb9, 0xa5, 0xbe, 0x9c, 0xbf, 0xec, 0x4b, 0x45, 0x49, 0x77, 0xe1, 0x62, 0x6d,
-	0x1e, 0xc4, 0xfe, 0xf8, 0x54, 0x99, 0xbc, 0xbe, 0x4e, 0xe2, 0x21, 0x98, 0x6d, 0x42, 0x0d, 0x3f,
-	0x53, 0x9a, 0xbc, 0x5c, 0x20, 0x05, 0x09, 0xbb, 0x05, 0x35, 0xde, 0x1f, 0x70, 0x75, 0xc2, 0x63,
-	0xf6, 0x59, 0x1b, 0xf7, 0xc8, 0x13, 0x04, 0xa8, 0x1e, 0x10, 0xcd, 0xa9, 0x07, 0xdb, 0x0a, 0xcc,
-	0x62, 0xf1, 0x61, 0xdf, 0x5d, 0x05, 0xf6, 0x48, 0x70, 0xb4, 0x19, 0xbf, 0xfe, 0xf6, 0x0c, 0x34,
-	0x0c, 0x18, 0x25, 0x7d, 0x80, 0x03, 0xee, 0xf6, 0x03, 0x7f, 0xc4, 0x53, 0x1e, 0x4b, 0x2e, 0xce,
-	0xa1, 0x48, 0xe7, 0x9f, 0x0d, 0xba, 0xd1, 0x24, 0xed, 0xf6, 0xf9, 0x20, 0xe6, 0xc2, 0x30, 0xa3,
-	0xa1, 0xb0, 0x50, 0xa4, 0x1b, 0xf9, 0x1f, 0x9a, 0x74, 0x82, 0x1f, 0x72, 0xa8, 0x8a, 0x46, 0x8b,
-	0x35, 0xaa, 0x66, 0xd1, 0x68, 0xb1, 0x22, 0x79, 0x1d, 0x55, 0x2b, 0xd1, 0x51, 0x6f, 0xc1, 0xba,
-	0xd0, 0x46, 0x52, 0x6e, 0xbb, 0x39, 0x36, 0xb9, 0xa0, 0x96, 0x6d, 0x42, 0x0b, 0xc7, 0xac, 0x18,
-	0x3c, 0x09, 0xbe, 0x25, 0xe2, 0x43, 0x8e, 0x57, 0xc0, 0x91, 0x96, 0x02, 0x35, 0x26, 0xad, 0xb8,
-	0x9d, 0x2b, 0xe0, 0x44, 0xeb, 0x7f, 0x68, 0xd3, 0xd6, 0x25, 0x6d, 0x0e, 0x77, 0x17, 0xa0, 0x71,
-	0x94, 0x46, 0x63, 0xb5, 0x29, 0x8b, 0xd0, 0x14, 0x45, 0x99, 0x0b, 0xf1, 0x12, 0x5c, 0x25, 0x2e,
-	0x7a, 0x12, 0x8d, 0xa3, 0x61, 0x34, 0x98, 0x1e, 0x4d, 0x8e, 0x93, 0x5e, 0x1c, 0x8c, 0xf1, 0x34,
-	0xe4, 0xfe, 0xbd, 0x03, 0x2b, 0x56, 0xad, 0x0c, 0x19, 0x7d, 0x5a, 0xb0, 0xb4, 0xbe, 0xc4, 0x16,
-	0x8c, 0xb7, 0x6c, 0xa8, 0x4a, 0x41, 0x28, 0x42, 0x79, 0xef, 0xcb, 0x7b, 0xed, 0x6d, 0x58, 0x52,
-	0x23, 0x53, 0x1f, 0x0a, 0x2e, 0x6c, 0x17, 0xb9, 0x50, 0x7e, 0xbf, 0x28, 0x3f, 0x50, 0x4d, 0xfc,
-	0xbc, 0xbc, 0xe5, 0xec, 0xd3, 0x1c, 0x55, 0xec, 0x40, 0xdf, 0x4c, 0x99, 0x27, 0x08, 0x35, 0x82,
-	0x9e, 0x06, 0x13, 0xf7, 0xb7, 0x1d, 0x80, 0x6c, 0x74, 0x74, 0x37, 0xa6, 0xd5, 0xbd, 0x48, 0xae,
-	0x36, 0x54, 0xfb, 0xab, 0xd0, 0xd4, 0x77, 0x2a, 0x99, 0x05, 0x69, 0x28, 0x0c, 0x9d, 0xbc, 0x9b,
-	0xb0, 0x34, 0x18, 0x46, 0xc7, 0x64, 0x7e, 0x29, 0xb9, 0x26, 0x91, 0x19, 0x21, 0x8b, 0x02, 0xbe,
-	0x2f, 0xd1, 0xcc, 0xdc, 0x54, 0x0d, 0x73, 0xe3, 0x7e, 0xa7, 0xa2, 0x23, 0xf1, 0xd9, 0x9c, 0x2f,
-	0x94, 0x32, 0xb6, 0x55, 0x50, 0x8e, 0x17, 0x04, 0xbe, 0x29, 0x4a, 0x76, 0x78, 0xe9, 0x21, 0xfe,
-	0x5d, 0x58, 0x8c, 0x85, 0xf6, 0x51, 0xaa, 0xa9, 0xfa, 0x1c, 0xd5, 0xb4, 0x10, 0x5b, 0x36, 0xe9,
-	0x93, 0xd0, 0xf2, 0xfb, 0x67, 0x3c, 0x4e, 0x03, 0x3a, 0x46, 0x91, 0x43, 0x20, 0x14, 0xea, 0x92,
-	0x81, 0x93, 0x9d, 0xbe, 0x09, 0x4b, 0x32, 0x0b, 0x47, 0x53, 0xca, 0xec, 0xd0, 0x0c, 0x46, 0x42,
-	0xf7, 0x4f, 0x55, 0xd0, 0xdf, 0xde, 0xc3, 0x8b, 0x57, 0xc4, 0x9c, 0x5d, 0x25, 0x37, 0xbb, 0x4f,
-	0xc8, 0x00, 0x7c, 0x5f, 0x9d, 0xd5, 0x66, 0x8c, 0x1b, 0xf1, 0xbe, 0xbc, 0x30, 0xb1, 0x97, 0xb4,
-	0xfa, 0x22, 0x4b, 0xea, 0x7e, 0xec, 0xc0, 0xdc, 0x7e, 0x34, 0xde, 0x97, 0xb9, 0x01, 0x24, 0x08,
-	0x3a, 0x8f, 0x4d, 0x15, 0x9f, 0x93, 0x35, 0x50, 0x6a, 0x87, 0x17, 0xf2, 0x76, 0xf8, 0x17, 0xe0,
-	0x25, 0x8a, 0x14, 0xc4, 0xd1, 0x38, 0x8a, 0x51, 0x18, 0xfd, 0xa1, 0x30, 0xba, 0x51, 0x98, 0x9e,
-	0x2a, 0x35, 0xf6, 0x3c, 0x12, 0x3a, 0x92, 0xe1, 0x51, 0x42, 0x38, 0xca, 0xd2, 0x6f, 0x10, 0xda,
-	0xad, 0x58, 0xe1, 0x7e, 0x16, 0xea, 0xe4, 0xf8, 0xd2, 0xb4, 0xde, 0x80, 0xfa, 0x69, 0x34, 0xee,
-	0x9e, 0x06, 0x61, 0xaa, 0x84, 0x7b, 0x31, 0xf3, 0x48, 0xf7, 0x69, 0x41, 0x34, 0x81, 0xfb, 0x07,
-	0x35, 0x98, 0x7b, 0x18, 0x9e, 0x45, 0x41, 0x8f, 0xee, 0x07, 0x46, 0x7c, 0x14, 0xa9, 0xac, 0x3e,
-	0xfc, 0x8d, 0x4b, 0x41, 0xd9, 0x2f, 0xe3, 0x54, 0x06, 0xf8, 0x55, 0x11, 0xcd, 0x7d, 0x9c, 0x65,
-	0xde, 0x0a, 0xd1, 0x31, 0x10, 0x74, 0xfa, 0x63, 0x33, 0x49, 0x59, 0x96, 0xb2, 0xb4, 0xc8, 0x9a,
-	0x91, 0x16, 0x49, 0xb7, 0x49, 0x22, 0x8f, 0x41, 0x5e, 0x74, 0xab, 0x22, 0x1d, 0x52, 0x62, 0x2e,
-	0x22, 0x3c, 0xe4, 0x38, 0xcc, 0xc9, 0x43, 0x8a, 0x09, 0xa2, 0x73, 0x21, 0x3e, 0x10, 0x34, 0x42,
-	0xf9, 0x9a, 0x10, 0x3a, 0x62, 0xf9, 0x3c, 0xe7, 0xba, 0xe0, 0xf9, 0x1c, 0x8c, 0x1a, 0xba, 0xcf,
-	0xb5, 0x22, 0x15, 0x73, 0x00, 0x91, 0x59, 0x9c, 0xc7, 0x8d, 0xa3, 0x8d, 0x48, 0x50, 0x52, 0x47,
-	0x1b, 0x64, 0x14, 0x7f, 0x38, 0x3c, 0xf6, 0x7b, 0x4f, 0x29, 0x8d, 0x9d, 0xf2, 0x91, 0xea, 0x9e,
-	0x0d, 0x52, 0x26, 0x42, 0xb6, 0x9b, 0x74, 0x1f, 0x59, 0xf5, 0x4c, 0x88, 0x6d, 0x41, 0x83, 0x8e,
-	0x73, 0x72, 0x3f, 0x17, 0x69, 0x3f, 0x5b, 0xe6, 0x79, 0x8f, 0x76, 0xd4, 0x24, 0x32, 0xef, 0x2c,
-	0x96, 0xec, 0x3b, 0x0b, 0xa1, 0x34, 0xe5, 0x55, 0x4f, 0x8b, 0x7a, 0xcb, 0x00, 0xb4, 0xa6, 0x72,
-	0xc1, 0x04, 0xc1, 0x32, 0x11, 0x58, 0x18, 0xbb, 0x0e, 0xf3, 0x78, 0x08, 0x19, 0xfb, 0x41, 0xbf,
-	0xcd, 0xf4, 0x59, 0x48, 0x63, 0xd8, 0x86, 0xfa, 0x4d, 0x57, 0x32, 0x2b, 0xb4, 0x2a, 0x16, 0x86,
-	0x6b, 0xa3, 0xcb, 0x24, 0x44, 0xab, 0x62, 0x47, 0x2d, 0xd0, 0x4d, 0x81, 0x6d, 0xf7, 0xfb, 0x92,
-	0x37, 0xf5, 0xd1, 0x37, 0xe3, 0x2a, 0xc7, 0xe2, 0xaa, 0x92, 0xdd, 0xad, 0x94, 0xef, 0xee, 0x73,
-	0xd7, 0xc0, 0xdd, 0x83, 0xc6, 0xa1, 0x91, 0xca, 0x4d, 0x4c, 0xae, 0x92, 0xb8, 0xa5, 0x60, 0x18,
-	0x88, 0x31, 0x9c, 0x8a, 0x39, 0x1c, 0xf7, 0xcf, 0x1c, 0x60, 0x07, 0x41, 0x92, 0xea, 0xe1, 0x8b,
-	0xbe, 0x5d, 0x68, 0xea, 0x00, 0x45, 0x96, 0x9b, 0x65, 0x61, 0x48, 0x43, 0x43, 0xe9, 0x46, 0x27,
-	0x27, 0x09, 0x57, 0x99, 0x14, 0x16, 0x86, 0x1c, 0x8a, 0x3e, 0x0e, 0xfa, 0x0b, 0x81, 0xe8, 0x21,
-	0x91, 0x19, 0x15, 0x05, 0x1c, 0xf5, 0x6c, 0xcc, 0xcf, 0x78, 0x9c, 0x68, 0xd1, 0xd2, 0x65, 0x9d,
-	0x42, 0x96, 0x5f, 0xe5, 0x4d, 0x98, 0xd7, 0xed, 0xda, 0x2a, 0x44, 0x51, 0xea, 0x7a, 0x54, 0x55,
-	0xe4, 0xc3, 0x5b, 0x83, 0x16, 0x6a, 0xb3, 0x58, 0xc1, 0x6e, 0x03, 0x3b, 0x09, 0xe2, 0x3c, 0xf9,
-	0x0c, 0x91, 0x97, 0xd4, 0xb8, 0x1f, 0xc0, 0x8a, 0xec, 0xd2, 0x74, 0x6e, 0xec, 0x4d, 0x74, 0x2e,
-	0x63, 0xe4, 0x4a, 0x91, 0x91, 0xdd, 0xff, 0x76, 0x60, 0x4e, 0xee, 0x34, 0x6d, 0x4b, 0x3e, 0xa7,
-	0xbf, 0xee, 0x59, 0x18, 0x6b, 0x5b, 0xd9, 0xdc, 0xc4, 0xf5, 0x52, 0x75, 0x15, 0x14, 0xd4, 0x4c,
-	0x99, 0x82, 0x62, 0x50, 0x1d, 0xfb, 0xe9, 0x29, 0x9d, 0x4c, 0xeb, 0x1e, 0xfd, 0x66, 0x2d, 0x11,
-	0x2d, 0x11, 0x8a, 0x90, 0x22, 0x25, 0x65, 0x8f, 0x1a, 0x84, 0xbd, 0x2d, 0x3e, 0x6a, 0xb8, 0x06,
-	0x75, 0x1a, 0x40, 0x37, 0x0b, 0x86, 0x64, 0x00, 0x72, 0xae, 0x28, 0x90, 0x84, 0xc9, 0x54, 0xcd,
-	0x0c, 0xc1, 0xf3, 0x09, 0xa5, 0xba, 0x88, 0x56, 0xf5, 0x1d, 0x95, 0x4c, 0xd9, 0xcb, 0xe0, 0x8c,
-	0x23, 0xe4, 0x00, 0xf2, 0x1c, 0x21, 0x49, 0x3d, 0x5d, 0xef, 0x76, 0xa0, 0xbd, 0xcb, 0x87, 0x3c,
-	0xe5, 0xdb, 0xc3, 0x61, 0xbe, 0xfd, 0x97, 0xe0, 0x6a, 0x49, 0x9d, 0xf4, 0x67, 0xbf, 0x04, 0x6b,
-	0xdb, 0x22, 0xbd, 0xe9, 0xa7, 0x95, 0x39, 0xe0, 0xb6, 0x61, 0x3d, 0xdf, 0xa4, 0xec, 0xec, 0x3e,
-	0x2c, 0xef, 0xf2, 0xe3, 0xc9, 0xe0, 0x80, 0x9f, 0x65, 0x1d, 0x31, 0xa8, 0x26, 0xa7, 0xd1, 0xb9,
-	0x14, 0x4c, 0xfa, 0xcd, 0x5e, 0x06, 0x18, 0x22, 0x4d, 0x37, 0x19, 0xf3, 0x9e, 0x4a, 0xc9, 0x26,
-	0xe4, 0x68, 0xcc, 0x7b, 0xee, 0x5b, 0xc0, 0xcc, 0x76, 0xe4, 0x7a, 0xa1, 0x3d, 0x9a, 0x1c, 0x77,
-	0x93, 0x69, 0x92, 0xf2, 0x91, 0xca, 0x35, 0x37, 0x21, 0xf7, 0x26, 0x34, 0x0f, 0xfd, 0xa9, 0xc7,
-	0xbf, 0x29, 0x5f, 0x81, 0x6c, 0xc0, 0xdc, 0xd8, 0x9f, 0xa2, 0x9a, 0xd2, 0xf1, 0x1b, 0xaa, 0x76,
-	0xff, 0xb3, 0x02, 0xb3, 0x82, 0x12, 0x5b, 0xed, 0xf3, 0x24, 0x0d, 0x42, 0x71, 0x63, 0x2b, 0x5b,
-	0x35, 0xa0, 0x02, 0x2b, 0x57, 0x4a, 0x58, 0x59, 0x9e, 0x9a, 0x54, 0x7a, 0xab, 0xe4, 0x57, 0x0b,
-	0x43, 0xe6, 0xca, 0xf2, 0x64, 0x44, 0x00, 0x21, 0x03, 0x72, 0x01, 0xbd, 0xcc, 0xea, 0x89, 0xf1,
-	0x29, 0x29, 0x95, 0x9c, 0x6b, 0x42, 0xa5, 0xb6, 0x75, 0x4e, 0x30, 0x78, 0xc1, 0xb6, 0x16, 0x6c,
-	0xe8, 0xfc, 0x0b, 0xd8, 0x50, 0x71, 0x94, 0x7a, 0x9e, 0x0d, 0x85, 0x17, 0xb0, 0xa1, 0x2e, 0x83,
-	0xd6, 0x7d, 0xce, 0x3d, 0x8e, 0xde, 0x99, 0xe2, 0xdd, 0xef, 0x3a, 0xd0, 0x92, 0x5c, 0xa4, 0xeb,
-	0xd8, 0xab, 0x96, 0x17, 0x5a, 0x9a, 0x84, 0xfa, 0x1a, 0x2c, 0x90, 0x6f, 0xa8, 0x23, 0x97, 0x32,
-	0xcc, 0x6a, 0x81, 0x38, 0x0f, 0x75, 0xbd, 0x34, 0x0a, 0x86, 0x72, 0x53, 0x4c, 0x48, 0x05, 0x3f,
-	0x63, 0x5f, 0xa6, 0xb2, 0x38, 0x9e, 0x2e, 0xbb, 0x7f, 0xe5, 0xc0, 0xb2, 0x31, 0x60, 0xc9, 0x85,
-	0xef, 0x82, 0x92, 0x06, 0x11, 0xe0, 0x14, 0x92, 0xbb, 0x61, 0x8b, 0x4d, 0xf6, 0x99, 0x45, 0x4c,
-	0x9b, 0xe9, 0x4f, 0x69, 0x80, 0xc9, 0x64, 0x24, 0x95, 0xa8, 0x09, 0x21, 0x23, 0x9d, 0x73, 0xfe,
-	0x54, 0x93, 0x08, 0x35, 0x6e, 0x61, 0x94, 0x26, 0x81, 0x3e, 0xad, 0x26, 0x12, 0xf6, 0xcc, 0x06,
-	0xdd, 0x7f, 0x74, 0x60, 0x45, 0x1c, 0x4e, 0xe4, 0xd1, 0x4f, 0xbf, 0x10, 0x98, 0x15, 0xa7, 0x31,
-	0x21, 0x91, 0xfb, 0x57, 0x3c, 0x59, 0x66, 0x9f, 0x79, 0xc1, 0x03, 0x95, 0x4e, 0x8f, 0xb9, 0x60,
-	0x2f, 0x66, 0xca, 0xf6, 0xe2, 0x39, 0x2b, 0x5d, 0x16, 0xd0, 0xab, 0x95, 0x06, 0xf4, 0xee, 0xcd,
-	0x41, 0x2d, 0xe9, 0x45, 0x63, 0xee, 0xae, 0xc3, 0xaa, 0x3d, 0x39, 0xa9, 0x82, 0xbe, 0xe7, 0x40,
-	0xfb, 0xbe, 0x08, 0x6f, 0x07, 0xe1, 0x60, 0x3f, 0x48, 0xd2, 0x28, 0xd6, 0xcf, 0x9e, 0xae, 0x03,
-	0x24, 0xa9, 0x1f, 0xa7, 0x22, 0x7d, 0x51, 0x86, 0xdb, 0x32, 0x04, 0xc7, 0xc8, 0xc3, 0xbe, 0xa8,
-	0x15, 0x7b, 0xa3, 0xcb, 0x05, 0x1f, 0x42, 0x1e, 0x9f, 0x2c, 0x4b, 0xfc, 0xba, 0xc8, 0x37, 0x43,
-	0x5f, 0x81, 0x9f, 0x91, 0x5e, 0x17, 0xe7, 0x92, 0x1c, 0xea, 0xfe, 0x85, 0x03, 0x4b, 0xd9, 0x20,
-	0xf7, 0x10, 0xb4, 0xb5, 0x83, 0x34, 0xbf, 0x99, 0x76, 0x50, 0x81, 0xc0, 0x00, 0xed, 0xb1, 0x1c,
-	0x9b, 0x81, 0x90, 0xc4, 0xca, 0x52, 0x34, 0x51, 0x0e, 0x8e, 0x09, 0x89, 0x4c, 0x0f, 0xf4, 0x04,
-	0xa4, 0x57, 0x23, 0x4b, 0x94, 0x7d, 0x3a, 0x4a, 0xe9, 0xab, 0x59, 0x71, 0x30, 0x93, 0x45, 0x65,
-	0x4a, 0xe7, 0x08, 0xc5, 0x9f, 0xee, 0xef, 0x38, 0x70, 0xb5, 0x64, 0x71, 0xa5, 0x64, 0xec, 0xc2,
-	0xf2, 0x89, 0xae, 0x54, 0x0b, 0x20, 0xc4, 0x63, 0x5d, 0xdd, 0xc7, 0xd8, 0x93, 0xf6, 0x8a, 0x1f,
-	0x68, 0xdf, 0x47, 0x2c, 0xa9, 0x95, 0x42, 0x55, 0xac, 0xd8, 0xfa, 0xdd, 0x19, 0x58, 0x14, 0xf7,
-	0x74, 0xe2, 0x01, 0x32, 0x8f, 0xd9, 0x7b, 0x30, 0x27, 0x1f, 0x90, 0xb3, 0x35, 0xd9, 0xad, 0xfd,
-	0x64, 0xbd, 0xb3, 0x9e, 0x87, 0x25, 0xef, 0xac, 0xfc, 0xfa, 0xc7, 0xff, 0xf2, 0x7b, 0x95, 0x05,
-	0xd6, 0xb8, 0x73, 0xf6, 0xe6, 0x9d, 0x01, 0x0f, 0x13, 0x6c, 0xe3, 0x97, 0x00, 0xb2, 0xa7, 0xd5,
-	0xac, 0xad, 0x7d, 0xb6, 0xdc, 0x9b, 0xf1, 0xce, 0xd5, 0x92, 0x1a, 0xd9, 0xee, 0x55, 0x6a, 0x77,
-	0xc5, 0x5d, 0xc4, 0x76, 0x83, 0x30, 0x48, 0xc5, 0x3b, 0xeb, 0x77, 0x9c, 0x4d, 0xd6, 0x87, 0xa6,
-	0xf9, 0x72, 0x9a, 0xa9, 0xd0, 0x4d, 0xc9, 0xbb, 0xed, 0xce, 0x4b, 0xa5, 0x75, 0x2a, 0x6e, 0x45,
-	0x7d, 0xac, 0xb9, 0x2d, 0xec, 0x63, 0x42, 0x14, 0x59, 0x2f, 0x43, 0x58, 0xb4, 0x1f, 0x48, 0xb3,
-	0x6b, 0x86, 0x58, 0x17, 0x9e, 0x67, 0x77, 0x5e, 0xbe, 0xa0, 0x56, 0xf6, 0xf5, 0x32, 0xf5, 0xb5,
-	0xe1, 0x32, 0xec, 0xab, 0x47, 0x34, 0xea, 0x79, 0xf6, 0x3b, 0xce, 0xe6, 0xd6, 0xc7, 0xd7, 0xa1,
-	0xae, 0x83, 0xad, 0xec, 0x1b, 0xb0, 0x60, 0x5d, 0xa4, 0x32, 0x35, 0x8d, 0xb2, 0x7b, 0xd7, 0xce,
-	0xb5, 0xf2, 0x4a, 0xd9, 0xf1, 0x75, 0xea, 0xb8, 0xcd, 0xd6, 0xb1, 0x63, 0x79, 0x13, 0x79, 0x87,
-	0xae, 0x8f, 0x45, 0x6e, 0xeb, 0x53, 0x31, 0xcf, 0xec, 0xf2, 0xd3, 0x9a, 0x67, 0xe1, 0xb2, 0xd4,
-	0x9a, 0x67, 0xf1, 0xc6, 0xd4, 0xbd, 0x46, 0xdd, 0xad, 0xb3, 0x55, 0xb3, 0x3b, 0x1d, 0x04, 0xe5,
-	0x94, 0x8d, 0x6c, 0xbe, 0x9f, 0x66, 0x2f, 0x6b, 0xc6, 0x2a, 0x7b, 0x57, 0xad, 0x59, 0xa4, 0xf8,
-	0xb8, 0xda, 0x6d, 0x53, 0x57, 0x8c, 0xd1, 0xf6, 0x99, 0xcf, 0xa7, 0xd9, 0xd7, 0xa0, 0xae, 0x1f,
-	0x0b, 0xb2, 0x0d, 0xe3, 0x85, 0xa6, 0xf9, 0x82, 0xb1, 0xd3, 0x2e, 0x56, 0x94, 0x31, 0x86, 0xd9,
-	0x32, 0x32, 0xc6, 0x01, 0xac, 0xc9, 0x33, 0xc0, 0x31, 0xff, 0x51, 0x66, 0x52, 0xf2, 0xea, 0xfb,
-	0xae, 0xc3, 0xde, 0x85, 0x79, 0xf5, 0x06, 0x93, 0xad, 0x97, 0xbf, 0x25, 0xed, 0x6c, 0x14, 0x70,
-	0xa9, 0x3d, 0xbe, 0x02, 0x90, 0xbd, 0x2d, 0xd4, 0x72, 0x56, 0x78, 0xd5, 0xa8, 0x17, 0xb1, 0xf8,
-	0x10, 0xd1, 0x5d, 0xa7, 0xa9, 0xb6, 0x18, 0xc9, 0x59, 0xc8, 0xcf, 0x55, 0x1a, 0xfd, 0x2e, 0x34,
-	0x8c, 0xe7, 0x85, 0x4c, 0xb5, 0x50, 0x7c, 0x9a, 0xd8, 0xe9, 0x94, 0x55, 0xc9, 0x01, 0x7e, 0x01,
-	0x16, 0xac, 0x77, 0x82, 0x9a, 0x91, 0xcb, 0x5e, 0x21, 0x6a, 0x46, 0x2e, 0x7f, 0x5a, 0xf8, 0x55,
-	0x68, 0x18, 0xaf, 0xfa, 0x98, 0x91, 0x20, 0x98, 0x7b, 0xcf, 0xa7, 0x47, 0x54, 0xf6, 0x08, 0x70,
-	0x95, 0xe6, 0xbb, 0xe8, 0xd6, 0x71, 0xbe, 0x94, 0x4b, 0x8e, 0x7b, 0xfa, 0x0d, 0x58, 0xb4, 0xdf,
-	0xf9, 0x69, 0x21, 0x28, 0x7d, 0x31, 0xa8, 0x85, 0xe0, 0x82, 0xc7, 0x81, 0x92, 0x7f, 0x36, 0x57,
-	0x74, 0x27, 0x77, 0x3e, 0x92, 0xb7, 0x86, 0xcf, 0xd8, 0x97, 0x50, 0xd2, 0x65, 0x72, 0x3f, 0xcb,
-	0x5e, 0x37, 0xda, 0x4f, 0x00, 0x34, 0x73, 0x16, 0xde, 0x01, 0xb8, 0xcb, 0xd4, 0x78, 0x83, 0x65,
-	0x33, 0x10, 0xea, 0x9b, 0x92, 0xfc, 0x0d, 0xf5, 0x6d, 0xbe, 0x03, 0x30, 0xd4, 0xb7, 0xf5, 0x16,
-	0x20, 0xaf, 0xbe, 0xd3, 0x00, 0xdb, 0x08, 0x61, 0x29, 0x97, 0x21, 0xa3, 0x79, 0xbb, 0x3c, 0xa5,
-	0xb0, 0x73, 0xfd, 0xf9, 0x89, 0x35, 0xb6, 0x56, 0x50, 0xda, 0xe0, 0x8e, 0xca, 0x00, 0xfd, 0x65,
-	0x68, 0x9a, 0xef, 0xb3, 0xb4, 0x42, 0x2f, 0x79, 0x55, 0xa6, 0x15, 0x7a, 0xd9, 0x83, 0x2e, 0xb5,
-	0xb9, 0xac, 0x69, 0x76, 0x83, 0x9b, 0x6b, 0x3f, 0x50, 0xc9, 0x34, 0x5c, 0xd9, 0xbb, 0x9c, 0x4c,
-	0xc3, 0x95, 0xbe, 0x6a, 0x51, 0x9b, 0xcb, 0x56, 0xac, 0xb9, 0x88, 0x90, 0x30, 0xfb, 0x2a, 0x2c,
-	0x19, 0xe9, 0x67, 0x47, 0xd3, 0xb0, 0xa7, 0x19, 0xb5, 0x98, 0xba, 0xdc, 0x29, 0x73, 0x14, 0xdd,
-	0x0d, 0x6a, 0x7f, 0xd9, 0xb5, 0x26, 0x81, 0x4c, 0xba, 0x03, 0x0d, 0x33, 0xb5, 0xed, 0x39, 0xed,
-	0x6e, 0x18, 0x55, 0x66, 0x9e, 0xee, 0x5d, 0x87, 0xfd, 0xa1, 0x03, 0x4d, 0x2b, 0x51, 0xcc, 0xba,
-	0xf8, 0xc8, 0xb5, 0xd3, 0x36, 0xeb, 0xcc, 0x86, 0x5c, 0x8f, 0x06, 0x79, 0xb0, 0xf9, 0x05, 0x6b,
-	0x11, 0x3e, 0xb2, 0x0e, 0x1c, 0xb7, 0xf3, 0x4f, 0xf9, 0x9f, 0xe5, 0x09, 0xcc, 0xf4, 0xee, 0x67,
-	0x77, 0x1d, 0xf6, 0x27, 0x0e, 0x2c, 0xda, 0xc7, 0x64, 0xbd, 0x55, 0xa5, 0x07, 0x72, 0xbd, 0x55,
-	0x17, 0x9c, 0xad, 0x7f, 0x06, 0xa3, 0x64, 0xef, 0x88, 0x3f, 0xd4, 0x50, 0x31, 0x1b, 0x66, 0xe8,
-	0xe6, 0xfc, 0xb6, 0x9a, 0xff, 0x26, 0x71, 0xcb, 0xb9, 0xeb, 0xb0, 0xaf, 0x8b, 0x7f, 0x15, 0x90,
-	0xdf, 0x12, 0x77, 0xbc, 0xe8, 0xf7, 0xee, 0x6b, 0x34, 0x97, 0xeb, 0xee, 0x55, 0x6b, 0x2e, 0x79,
-	0xe3, 0xb4, 0x2d, 0x46, 0x27, 0xff, 0x2c, 0x22, 0x53, 0xdb, 0x85, 0x3f, 0x90, 0xb8, 0x78, 0x90,
-	0x23, 0x31, 0x48, 0x49, 0x6e, 0xb1, 0xf0, 0x0b, 0x36, 0xe3, 0x6e, 0xd2, 0x58, 0x5f, 0x73, 0x5f,
-	0xb9, 0x70, 0xac, 0x77, 0xe8, 0x90, 0x8b, 0x23, 0x3e, 0x04, 0xc8, 0xe2, 0xab, 0x2c, 0x17, 0xdf,
-	0xd3, 0x96, 0xab, 0x18, 0x82, 0xb5, 0xe5, 0x44, 0x85, 0x01, 0xb1, 0xc5, 0xaf, 0x09, 0x75, 0xf2,
-	0x50, 0x45, 0x06, 0xaf, 0x1a, 0x2a, 0xc3, 0x0e, 0x84, 0x76, 0x3a, 0x65, 0x55, 0x65, 0xca, 0x44,
-	0x87, 0x19, 0xdf, 0x87, 0x85, 0x83, 0x28, 0x7a, 0x3a, 0x19, 0xeb, 0xdb, 0x0a, 0x3b, 0xfe, 0xb4,
-	0xef, 0x27, 0xa7, 0x9d, 0xdc, 0x2c, 0xdc, 0x1b, 0xd4, 0x54, 0x87, 0xb5, 0x8d, 0xa6, 0xee, 0x7c,
-	0x94, 0xc5, 0x6f, 0x9f, 0x31, 0x1f, 0x96, 0xb5, 0x53, 0xa1, 0x07, 0xde, 0xb1, 0x9b, 0x31, 0x23,
-	0x8f, 0x85, 0x2e, 0x2c, 0x37, 0x4f, 0x8d, 0xf6, 0x4e, 0xa2, 0xda, 0xbc, 0xeb, 0xb0, 0x43, 0x68,
-	0xee, 0xf2, 0x5e, 0xd4, 0xe7, 0x32, 0x88, 0xb3, 0x92, 0x0d, 0x5c, 0x47, 0x7f, 0x3a, 0x0b, 0x16,
-	0x68, 0xeb, 0xed, 0xb1, 0x3f, 0x8d, 0xf9, 0x37, 0xef, 0x7c, 0x24, 0xc3, 0x43, 0xcf, 0x94, 0xde,
-	0x56, 0xf1, 0x33, 0x4b, 0x6f, 0xe7, 0x02, 0x6e, 0x96, 0xde, 0x2e, 0x04, 0xdc, 0xac, 0xa5, 0x56,
-	0xf1, 0x3b, 0x36, 0x84, 0xe5, 0x42, 0x8c, 0x8e, 0xbd, 0xa2, 0x2c, 0xef, 0x05, 0x91, 0xbd, 0xce,
-	0x8d, 0x8b, 0x09, 0xec, 0xde, 0x36, 0xed, 0xde, 0x8e, 0x60, 0x61, 0x97, 0x8b, 0xc5, 0x12, 0x29,
-	0x11, 0xb9, 0xb7, 0x8a, 0x66, 0xfa, 0x44, 0x5e, 0x71, 0x53, 0x9d, 0x6d, 0x98, 0x29, 0x1f, 0x81,
-	0x7d, 0x0d, 0x1a, 0x0f, 0x78, 0xaa, 0x72, 0x20, 0xb4, 0x83, 0x97, 0x4b, 0x8a, 0xe8, 0x94, 0xa4,
-	0x50, 0xd8, 0x3c, 0x43, 0xad, 0xdd, 0xe1, 0xfd, 0x01, 0x17, 0xca, 0xa9, 0x1b, 0xf4, 0x9f, 0xb1,
-	0x5f, 0xa4, 0xc6, 0x75, 0x4a, 0xd5, 0xba, 0x71, 0x75, 0x6e, 0x36, 0xbe, 0x94, 0xc3, 0xcb, 0x5a,
-	0x0e, 0xa3, 0x3e, 0x37, 0x5c, 0x94, 0x10, 0x1a, 0x46, 0xbe, 0x9f, 0x16, 0xa0, 0x62, 0xee, 0xa2,
-	0x16, 0xa0, 0x92, 0xf4, 0x40, 0xf7, 0x16, 0xf5, 0xe3, 0xb2, 0x1b, 0x59, 0x3f, 0x22, 0x25, 0x30,
-	0xeb, 0xe9, 0xce, 0x47, 0xfe, 0x28, 0x7d, 0xc6, 0x3e, 0xa0, 0x77, 0x8b, 0x66, 0x9e, 0x47, 0xe6,
-	0xb1, 0xe6, 0x53, 0x42, 0xf4, 0x62, 0x19, 0x55, 0xb6, 0x17, 0x2b, 0xba, 0x22, 0x4f, 0xe6, 0x33,
-	0x00, 0x47, 0x69, 0x34, 0xde, 0xf5, 0xf9, 0x28, 0x0a, 0x33, 0x5d, 0x9b, 0xe5, 0x32, 0x64, 0xfa,
-	0xcb, 0x48, 0x68, 0x60, 0x1f, 0x18, 0x2e, 0xbe, 0x95, 0x26, 0xa3, 0x98, 0xeb, 0xc2, 0x74, 0x07,
-	0xbd, 0x20, 0x25, 0x29, 0x0f, 0x77, 0x1d, 0xb6, 0x0d, 0x90, 0x05, 0x69, 0xb5, 0xc3, 0x5e, 0x88,
-	0xff, 0x6a, 0xb5, 0x57, 0x12, 0xd1, 0x3d, 0x84, 0x7a, 0x16, 0xf5, 0xdb, 0xc8, 0x72, 0x36, 0xad,
-	0x18, 0xa1, 0xb6, 0xdc, 0x85, 0x58, 0x9c, 0xdb, 0xa2, 0xa5, 0x02, 0x36, 0x8f, 0x4b, 0x45, 0x01,
-	0xb6, 0x00, 0x56, 0xc4, 0x00, 0xb5, 0x1b, 0x42, 0xb7, 0xf3, 0x6a, 0x26, 0x25, 0xf1, 0x30, 0x2d,
-	0xcd, 0xa5, 0xe1, 0x24, 0xeb, 0xe8, 0x8e, 0xdc, 0x2a, 0x32, 0x03, 0x50, 0x35, 0x8f, 0x60, 0xb9,
-	0x10, 0x0b, 0xd1, 0x22, 0x7d, 0x51, 0x08, 0x4a, 0x8b, 0xf4, 0x85, 0x61, 0x14, 0x77, 0x8d, 0xba,
-	0x5c, 0x72, 0x01, 0xbb, 0x4c, 0xce, 0x83, 0xb4, 0x77, 0xfa, 0x8e, 0xb3, 0x79, 0x3c, 0x4b, 0x7f,
-	0xc0, 0xf7, 0xa9, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x63, 0x24, 0x7e, 0xd9, 0xb2, 0x4f, 0x00,
-	0x00,
+	// 9272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4d, 0x6c, 0x1c, 0x49,
+	0x96, 0x9e, 0xb2, 0xaa, 0x28, 0x56, 0xbd, 0xaa, 0x62, 0x91, 0x41, 0x91, 0x2c, 0xa5, 0x7e, 0x5a,
+	0x9d, 0xd3, 0xdb, 0xad, 0xd5, 0xf4, 0x4a, 0xdd, 0x9a, 0x99, 0x46, 0x6f, 0x6b, 0x67, 0x7a, 0x28,
+	0x8a, 0x12, 0xd5, 0xcd, 0x96, 0x38, 0x49, 0xf5, 0xf4, 0xee, 0xcc, 0x18, 0xb9, 0xc9, 0xaa, 0x20,
+	0x99, 0xa3, 0xaa, 0xcc, 0x9a, 0xcc, 0x2c, 0x52, 0x35, 0xed, 0x06, 0x6c, 0x0f, 0xb0, 0x30, 0x0c,
+	0xaf, 0x17, 0xb3, 0x5e, 0xc0, 0xb0, 0x2f, 0xb6, 0xc7, 0x06, 0xbc, 0xbe, 0x1a, 0xf0, 0x5e, 0x6c,
+	0xf8, 0xbe, 0x0b, 0x1b, 0x3e, 0xac, 0x61, 0x60, 0x60, 0xc0, 0xf6, 0xc1, 0x97, 0xf5, 0x0f, 0x6c,
+	0xd8, 0xf0, 0xd1, 0x86, 0xf1, 0xe2, 0x2f, 0x23, 0x22, 0xb3, 0x44, 0x76, 0xcf, 0x8f, 0x2f, 0x7b,
+	0x62, 0xc5, 0xf7, 0x5e, 0xc6, 0xef, 0x8b, 0x17, 0x2f, 0x5e, 0xbc, 0x08, 0x42, 0x2b, 0x9d, 0x0c,
+	0x6e, 0x4f, 0xd2, 0x24, 0x4f, 0xc8, 0xc2, 0x28, 0x4e, 0x27, 0x03, 0xf7, 0xea, 0x51, 0x92, 0x1c,
+	0x8d, 0xe8, 0x9d, 0x70, 0x12, 0xdd, 0x09, 0xe3, 0x38, 0xc9, 0xc3, 0x3c, 0x4a, 0xe2, 0x8c, 0x33,
+	0x79, 0xbf, 0x0d, 0x4b, 0x8f, 0x68, 0xbc, 0x4f, 0xe9, 0xd0, 0xa7, 0x3f, 0x98, 0xd2, 0x2c, 0x27,
+	0x5f, 0x86, 0x95, 0x90, 0xfe, 0x90, 0xd2, 0x61, 0x30, 0x09, 0xb3, 0x6c, 0x72, 0x9c, 0x86, 0x19,
+	0xed, 0x3b, 0x37, 0x9c, 0x9b, 0x1d, 0x7f, 0x99, 0x13, 0xf6, 0x14, 0x4e, 0x5e, 0x85, 0x4e, 0x86,
+	0xac, 0x34, 0xce, 0xd3, 0x64, 0x32, 0xeb, 0xd7, 0x18, 0x5f, 0x1b, 0xb1, 0x6d, 0x0e, 0x79, 0x23,
+	0xe8, 0xa9, 0x12, 0xb2, 0x49, 0x12, 0x67, 0x94, 0xbc, 0x05, 0x97, 0x06, 0xd1, 0xe4, 0x98, 0xa6,
+	0x01, 0xfb, 0x78, 0x1c, 0xd3, 0x71, 0x12, 0x47, 0x83, 0xbe, 0x73, 0xa3, 0x7e, 0xb3, 0xe5, 0x13,
+	0x4e, 0xc3, 0x2f, 0x3e, 0x12, 0x14, 0xf2, 0x06, 0xf4, 0x68, 0xcc, 0x71, 0x3a, 0x64, 0x5f, 0x89,
+	0xa2, 0x96, 0x0a, 0x18, 0x3f, 0xf0, 0xfe, 0x43, 0x0d, 0x56, 0x1e, 0xc7, 0x51, 0xfe, 0x49, 0x38,
+	0x1a, 0xd1, 0x5c, 0xb6, 0xe9, 0x0d, 0xe8, 0x9d, 0x32, 0x80, 0xb5, 0xe9, 0x34, 0x49, 0x87, 0xa2,
+	0x45, 0x4b, 0x1c, 0xde, 0x13, 0xe8, 0xdc, 0x9a, 0xd5, 0xe6, 0xd6, 0xac, 0xb2, 0xbb, 0xea, 0x73,
+	0xba, 0xeb, 0x0d, 0xe8, 0xa5, 0x74, 0x90, 0x9c, 0xd0, 0x74, 0x16, 0x9c, 0x46, 0xf1, 0x30, 0x39,
+	0xed, 0x37, 0x6e, 0x38, 0x37, 0x17, 0xfc, 0x25, 0x09, 0x7f, 0xc2, 0x50, 0xf2, 0x2b, 0xb0, 0x94,
+	0xe5, 0x61, 0x4e, 0x47, 0x34, 0xcb, 0x82, 0x28, 0x8e, 0xf2, 0xfe, 0xc2, 0x0d, 0xe7, 0x66, 0xd3,
+	0xef, 0x2a, 0x14, 0x1b, 0x49, 0x6e, 0xc3, 0x2a, 0x8d, 0x07, 0xe9, 0x6c, 0x92, 0x8b, 0x5e, 0x09,
+	0x0e, 0xa3, 0x11, 0xed, 0x5f, 0xbc, 0xe1, 0xdc, 0x6c, 0xf9, 0x2b, 0x8a, 0x84, 0x15, 0x7e, 0x18,
+	0x8d, 0x28, 0xb9, 0x0f, 0xbd, 0xc1, 0x71, 0x18, 0xc7, 0x74, 0x14, 0x1c, 0x84, 0x83, 0xe7, 0xd3,
+	0x49, 0xd6, 0x5f, 0xbc, 0xe1, 0xdc, 0x6c, 0xdf, 0xbd, 0x7c, 0x9b, 0x09, 0xcb, 0xed, 0xad, 0xe3,
+	0x30, 0xbe, 0xcf, 0x28, 0xfb, 0x71, 0x38, 0xc9, 0x8e, 0x93, 0xdc, 0x5f, 0x12, 0x5f, 0x70, 0x38,
+	0xf3, 0xee, 0x01, 0xd1, 0x3b, 0x58, 0x0c, 0xe9, 0xaf, 0xc0, 0x52, 0x38, 0x1c, 0x47, 0x71, 0x30,
+	0x0e, 0x07, 0x61, 0x9a, 0x24, 0xb1, 0xe8, 0xe0, 0x2e, 0x43, 0x3f, 0x12, 0xa0, 0xf7, 0x37, 0x1c,
+	0x58, 0xfd, 0x38, 0x1e, 0x25, 0x83, 0xe7, 0x5f, 0x70, 0x80, 0x2a, 0x7a, 0xb0, 0x76, 0xce, 0x1e,
+	0xac, 0x57, 0xf4, 0xa0, 0xb7, 0x0e, 0x97, 0xcc, 0xfa, 0xf0, 0xf6, 0x78, 0x14, 0xd6, 0xb0, 0x2f,
+	0x8e, 0xa8, 0x2c, 0x59, 0xd6, 0xf4, 0x57, 0x61, 0x79, 0x30, 0x4d, 0x53, 0x1a, 0x97, 0xaa, 0xda,
+	0x13, 0xb8, 0xaa, 0xeb, 0xab, 0xd0, 0x89, 0xe9, 0x69, 0xc1, 0x26, 0x26, 0x47, 0x4c, 0x4f, 0x25,
+	0x8b, 0xd7, 0x87, 0x75, 0xbb, 0x18, 0x51, 0x81, 0x3f, 0xae, 0x41, 0xfb, 0x59, 0x1a, 0xc6, 0x59,
+	0x38, 0xc0, 0xf9, 0x4a, 0xfa, 0xb0, 0x98, 0xbf, 0x08, 0x8e, 0xc3, 0xec, 0x98, 0x15, 0xd7, 0xf2,
+	0x65, 0x92, 0xac, 0xc3, 0xc5, 0x70, 0x9c, 0x4c, 0xe3, 0x9c, 0x15, 0x50, 0xf7, 0x45, 0x8a, 0xbc,
+	0x09, 0x2b, 0xf1, 0x74, 0x1c, 0x0c, 0x92, 0xf8, 0x30, 0x4a, 0xc7, 0x7c, 0xd6, 0xb3, 0x4e, 0x58,
+	0xf0, 0xcb, 0x04, 0x72, 0x1d, 0xe0, 0x00, 0xfb, 0x81, 0x17, 0xd1, 0x60, 0x45, 0x68, 0x08, 0xf1,
+	0xa0, 0x23, 0x52, 0x34, 0x3a, 0x3a, 0xe6, 0xf2, 0xb8, 0xe0, 0x1b, 0x18, 0xe6, 0x91, 0x47, 0x63,
+	0x1a, 0x64, 0x79, 0x38, 0x9e, 0x30, 0x29, 0xac, 0xfb, 0x1a, 0xc2, 0xe8, 0x49, 0x1e, 0x8e, 0x82,
+	0x43, 0x4a, 0xb9, 0xe4, 0xd5, 0x7d, 0x0d, 0x21, 0xaf, 0xc3, 0xd2, 0x90, 0x66, 0x79, 0x10, 0x0e,
+	0x87, 0x29, 0xcd, 0x32, 0x9a, 0xf5, 0x9b, 0x6c, 0xde, 0x59, 0x28, 0xb9, 0x04, 0x0b, 0xa3, 0xf0,
+	0x80, 0x8e, 0xfa, 0x2d, 0x56, 0x4d, 0x9e, 0xc0, 0x1e, 0x0a, 0x07, 0x03, 0xd6, 0x11, 0xc0, 0x7b,
+	0x48, 0x24, 0xbd, 0xef, 0xc1, 0xfa, 0x23, 0x9a, 0x6b, 0xbd, 0x99, 0xc9, 0xd1, 0xf4, 0xa0, 0x93,
+	0xe5, 0x61, 0x9a, 0xcb, 0x56, 0x39, 0xbc, 0x55, 0x3a, 0x86, 0xb5, 0xa6, 0xf1, 0x50, 0x72, 0x70,
+	0x69, 0xd3, 0x10, 0x6f, 0x17, 0x88, 0x96, 0xf5, 0x03, 0x9a, 0x87, 0xd1, 0x28, 0x23, 0xef, 0x40,
+	0x27, 0xd7, 0x0a, 0x64, 0xba, 0xad, 0x7d, 0x97, 0x88, 0x79, 0xa6, 0x7d, 0xe0, 0x1b, 0x7c, 0xde,
+	0x23, 0x68, 0x3e, 0xa4, 0x74, 0x37, 0x1a, 0x47, 0x39, 0x59, 0x87, 0x85, 0xc3, 0xe8, 0x05, 0xe5,
+	0x02, 0x56, 0xdf, 0xb9, 0xe0, 0xf3, 0x24, 0x71, 0x61, 0x71, 0x42, 0xd3, 0x01, 0x95, 0x43, 0xbe,
+	0x73, 0xc1, 0x97, 0xc0, 0xfd, 0x45, 0x58, 0x18, 0xe1, 0xc7, 0xde, 0x1f, 0xd6, 0xa0, 0xbd, 0x4f,
+	0x63, 0x25, 0xb8, 0x04, 0x1a, 0xd8, 0x8d, 0x42, 0x58, 0xd9, 0x6f, 0xf2, 0x0a, 0xb4, 0xf1, 0x6f,
+	0x90, 0xe5, 0x69, 0x14, 0x1f, 0xb1, 0xcc, 0x5a, 0x3e, 0x20, 0xb4, 0xcf, 0x10, 0xb2, 0x0c, 0xf5,
+	0x70, 0xcc, 0xa7, 0x4e, 0xdd, 0xc7, 0x9f, 0x28, 0xd4, 0x93, 0x70, 0x36, 0x46, 0xf9, 0x57, 0x92,
+	0xd2, 0xf1, 0xdb, 0x02, 0xdb, 0x41, 0x51, 0xb9, 0x0d, 0xab, 0x3a, 0x8b, 0xcc, 0x7d, 0x81, 0x6b,
+	0x25, 0x8d, 0x53, 0x14, 0xf2, 0x06, 0xf4, 0x24, 0x7f, 0xca, 0x2b, 0x2b, 0x34, 0xd8, 0x92, 0x80,
+	0x65, 0x13, 0x6e, 0xc2, 0xf2, 0x61, 0x14, 0x87, 0xa3, 0x60, 0x30, 0xca, 0x4f, 0x82, 0x21, 0x1d,
+	0xe5, 0x21, 0x93, 0xa2, 0x05, 0x7f, 0x89, 0xe1, 0x5b, 0xa3, 0xfc, 0xe4, 0x01, 0xa2, 0xe4, 0x4d,
+	0x68, 0x1d, 0x52, 0x1a, 0xb0, 0x9e, 0xe8, 0x37, 0x99, 0x8a, 0xeb, 0x89, 0xae, 0x97, 0xbd, 0xeb,
+	0x37, 0x0f, 0xc5, 0x2f, 0xef, 0x0f, 0x1c, 0xe8, 0xf0, 0xae, 0x12, 0xda, 0xec, 0x35, 0xe8, 0xca,
+	0x1a, 0xd1, 0x34, 0x4d, 0x52, 0x31, 0xe5, 0x4c, 0x90, 0xdc, 0x82, 0x65, 0x09, 0x4c, 0x52, 0x1a,
+	0x8d, 0xc3, 0x23, 0x2a, 0xe6, 0x78, 0x09, 0x27, 0x77, 0x8b, 0x1c, 0xd3, 0x64, 0x9a, 0xf3, 0x25,
+	0xa2, 0x7d, 0xb7, 0x23, 0x2a, 0xe5, 0x23, 0xe6, 0x9b, 0x2c, 0xde, 0xef, 0x3a, 0x40, 0xb0, 0x5a,
+	0xcf, 0x12, 0x4e, 0x16, 0xbd, 0x60, 0x8f, 0x80, 0x73, 0xee, 0x11, 0xa8, 0xcd, 0x1b, 0x81, 0xd7,
+	0xe0, 0x22, 0x2b, 0x12, 0xf5, 0x43, 0xbd, 0x54, 0x2d, 0x41, 0xf3, 0x7e, 0xe2, 0x40, 0x67, 0x8b,
+	0x2f, 0x06, 0x7b, 0x49, 0x14, 0xe7, 0xe4, 0x2d, 0x20, 0x87, 0xd3, 0x78, 0x18, 0xc5, 0x47, 0x41,
+	0xfe, 0x22, 0x1a, 0x06, 0x07, 0x33, 0xcc, 0x82, 0xd5, 0x67, 0xe7, 0x82, 0x5f, 0x41, 0x23, 0x6f,
+	0xc2, 0xb2, 0x81, 0x66, 0x79, 0xca, 0x6b, 0xb5, 0x73, 0xc1, 0x2f, 0x51, 0x70, 0x76, 0x26, 0xd3,
+	0x7c, 0x32, 0xcd, 0x83, 0x28, 0x1e, 0xd2, 0x17, 0xac, 0xcf, 0xba, 0xbe, 0x81, 0xdd, 0x5f, 0x82,
+	0x8e, 0xfe, 0x9d, 0xf7, 0x0d, 0x58, 0xde, 0xc5, 0x69, 0x19, 0x47, 0xf1, 0xd1, 0x26, 0xd7, 0x18,
+	0xa8, 0x21, 0x27, 0xd3, 0x83, 0xe7, 0x74, 0x26, 0xc6, 0x51, 0xa4, 0x70, 0x4a, 0x1c, 0x27, 0x59,
+	0x2e, 0xfa, 0x85, 0xfd, 0xf6, 0x7e, 0xbf, 0x06, 0x3d, 0xec, 0xf4, 0x8f, 0xc2, 0x78, 0x26, 0x7b,
+	0x7c, 0x17, 0x3a, 0x98, 0xd5, 0xb3, 0x64, 0x93, 0xeb, 0x59, 0x3e, 0x97, 0x6f, 0x8a, 0x4e, 0xb2,
+	0xb8, 0x6f, 0xeb, 0xac, 0x68, 0x04, 0xcd, 0x7c, 0xe3, 0x6b, 0x9c, 0x74, 0x79, 0x98, 0x1e, 0xd1,
+	0x9c, 0x69, 0x60, 0xa1, 0x91, 0x81, 0x43, 0x5b, 0x49, 0x7c, 0x48, 0x6e, 0x40, 0x27, 0x0b, 0xf3,
+	0x60, 0x42, 0x53, 0xd6, 0x6b, 0x6c, 0xe2, 0xd4, 0x7d, 0xc8, 0xc2, 0x7c, 0x8f, 0xa6, 0xf7, 0x67,
+	0x39, 0x25, 0xbf, 0x06, 0x2d, 0xec, 0x04, 0x1c, 0x84, 0xac, 0x7f, 0xf1, 0x46, 0x5d, 0x13, 0xef,
+	0xa7, 0xd3, 0x9c, 0x0d, 0x8e, 0x5f, 0x70, 0xb8, 0xef, 0xc3, 0x4a, 0xa9, 0x52, 0x38, 0xb5, 0x8b,
+	0x1e, 0xc1, 0x9f, 0xa8, 0x56, 0x4f, 0xc2, 0xd1, 0x94, 0x8a, 0x75, 0x84, 0x27, 0xde, 0xab, 0xbd,
+	0xeb, 0x78, 0xaf, 0xc3, 0x72, 0xd1, 0x4a, 0x31, 0x47, 0x08, 0x34, 0xb0, 0xc3, 0x45, 0x06, 0xec,
+	0xb7, 0xf7, 0x7d, 0x68, 0xca, 0xf2, 0x99, 0xb2, 0xb7, 0x84, 0xc2, 0xd7, 0x10, 0xe2, 0x42, 0xd3,
+	0x14, 0x01, 0xbf, 0xf9, 0x79, 0x06, 0xde, 0xfb, 0x5f, 0x0e, 0x34, 0x3e, 0xce, 0x5f, 0x24, 0xe4,
+	0x9b, 0xd0, 0xc8, 0x67, 0x13, 0x6e, 0xa3, 0x2e, 0xdd, 0x7d, 0x4d, 0xf4, 0xc3, 0x13, 0x7a, 0x2a,
+	0x86, 0x5f, 0x1f, 0x17, 0x9a, 0x65, 0xcf, 0x66, 0x13, 0xea, 0x77, 0xc4, 0x62, 0x12, 0xe0, 0x97,
+	0x6c, 0xe5, 0xe0, 0x69, 0x51, 0x13, 0x99, 0xc4, 0x46, 0xf0, 0xd5, 0x34, 0xc8, 0x42, 0xa9, 0x06,
+	0x35, 0x84, 0x5c, 0x85, 0xd6, 0xe4, 0x79, 0x90, 0x0d, 0xd2, 0x68, 0x92, 0x8b, 0x45, 0xb3, 0x00,
+	0xc8, 0x97, 0xa1, 0x29, 0x07, 0x81, 0x0d, 0x62, 0xc5, 0x28, 0x29, 0x06, 0xd4, 0x39, 0xe6, 0x52,
+	0xcd, 0xd7, 0x4f, 0x13, 0xf4, 0xf6, 0x80, 0xec, 0x46, 0x59, 0xfe, 0x71, 0x9c, 0x4d, 0x34, 0xc5,
+	0x78, 0x15, 0x5a, 0x68, 0x7b, 0x21, 0x6b, 0x26, 0xd6, 0xb0, 0x02, 0x60, 0xd4, 0xf0, 0x85, 0xa0,
+	0xd6, 0x04, 0x55, 0x02, 0xde, 0xbb, 0xb0, 0x6a, 0xe4, 0x28, 0x86, 0xf7, 0x55, 0x58, 0x98, 0xe6,
+	0x2f, 0x12, 0xb9, 0x70, 0xb5, 0x45, 0xc5, 0xb1, 0xc7, 0x7d, 0x4e, 0xf1, 0xfe, 0xb2, 0x03, 0x64,
+	0x97, 0x86, 0x19, 0x7d, 0xca, 0xc6, 0x45, 0x56, 0x66, 0x09, 0x6a, 0x91, 0xb4, 0x89, 0x6a, 0xd1,
+	0xd0, 0xe8, 0x85, 0xda, 0x59, 0xbd, 0x70, 0x1b, 0x08, 0x7d, 0x31, 0x89, 0x52, 0xd6, 0xdc, 0x20,
+	0xa3, 0x83, 0x24, 0x1e, 0x72, 0xab, 0xa5, 0xe1, 0x57, 0x50, 0xbc, 0xaf, 0xc1, 0xaa, 0x51, 0x05,
+	0x51, 0x7b, 0x5c, 0xb3, 0x15, 0x33, 0xab, 0x4b, 0xc3, 0xd7, 0x10, 0x6f, 0x1f, 0x2e, 0xf9, 0x74,
+	0xf4, 0xf3, 0xad, 0xbb, 0xb7, 0x01, 0x6b, 0x56, 0xa6, 0xc2, 0x96, 0x8b, 0x60, 0x63, 0x17, 0x4d,
+	0x14, 0x7d, 0xd5, 0x17, 0x05, 0xea, 0xb3, 0xc0, 0xb1, 0x66, 0x81, 0x32, 0x73, 0x6a, 0xba, 0x99,
+	0x73, 0x15, 0x5a, 0x68, 0xe7, 0x9e, 0xa6, 0x91, 0x58, 0x45, 0x9a, 0x7e, 0x01, 0x78, 0x2e, 0xf4,
+	0xcb, 0x45, 0x89, 0x6a, 0xfc, 0xb4, 0x0e, 0x8b, 0x9b, 0xdc, 0x24, 0xc2, 0xd9, 0x1b, 0x87, 0x63,
+	0x2a, 0x67, 0x2f, 0xfe, 0x26, 0x6f, 0xc1, 0x2a, 0x7d, 0x91, 0xd3, 0x78, 0x88, 0x9b, 0x99, 0xe9,
+	0xc1, 0x28, 0x1a, 0x04, 0xa8, 0x21, 0x78, 0xe9, 0x55, 0x24, 0xb2, 0x03, 0xc6, 0x44, 0xea, 0xd7,
+	0xbf, 0xf0, 0x14, 0x7c, 0x07, 0xd6, 0xc7, 0x61, 0x96, 0xd3, 0x14, 0xf3, 0x0d, 0x0e, 0xa3, 0xf8,
+	0x88, 0xa6, 0x93, 0x34, 0x8a, 0xf9, 0xac, 0xea, 0xf8, 0x73, 0xa8, 0xe4, 0x26, 0xf4, 0x86, 0x34,
+	0x8d, 0x4e, 0xb8, 0x54, 0x4c, 0xc2, 0xfc, 0x58, 0xd8, 0x19, 0x36, 0x8c, 0x9c, 0x07, 0x51, 0x9a,
+	0x1f, 0x0f, 0xc3, 0x99, 0xb4, 0xe5, 0x2e, 0x32, 0xb5, 0x62, 0xc3, 0x38, 0x13, 0xb3, 0x59, 0x3c,
+	0xa0, 0xca, 0xe6, 0x5b, 0x64, 0x7c, 0x26, 0xc8, 0x25, 0x35, 0xa7, 0x29, 0xda, 0x23, 0x58, 0x2b,
+	0x6e, 0x79, 0x36, 0x19, 0x6b, 0x05, 0x05, 0xf9, 0xa3, 0xb8, 0xc4, 0xdf, 0xe2, 0xfc, 0x65, 0x0a,
+	0x9a, 0xef, 0x62, 0xea, 0xd3, 0x61, 0x70, 0x10, 0x8e, 0xc2, 0x78, 0x40, 0x99, 0x61, 0x5b, 0xf7,
+	0xcb, 0x04, 0xef, 0x9f, 0xd4, 0xe0, 0xd2, 0xe3, 0xf1, 0x24, 0x49, 0x73, 0x31, 0xc2, 0x9a, 0xd9,
+	0xf7, 0xe7, 0x03, 0x5d, 0x3d, 0xd0, 0xde, 0x26, 0xac, 0x59, 0x7d, 0x26, 0xd4, 0xc7, 0xcd, 0x62,
+	0x2b, 0xe1, 0xb0, 0x59, 0xbf, 0x24, 0x5a, 0x2a, 0x19, 0x25, 0xd9, 0x5b, 0xe3, 0xda, 0x53, 0xe0,
+	0xb2, 0xfd, 0xde, 0x7d, 0xb8, 0x64, 0xc2, 0x22, 0xe3, 0x5b, 0xd0, 0x14, 0x5f, 0x4a, 0xc5, 0x6a,
+	0xe7, 0xac, 0xe8, 0xde, 0x4f, 0x6a, 0xd0, 0x7b, 0x38, 0x8d, 0x87, 0x7b, 0xd9, 0x81, 0x1a, 0xcd,
+	0xbe, 0x59, 0xb1, 0x62, 0x8f, 0x43, 0xf6, 0x70, 0x03, 0x3e, 0x4c, 0x83, 0x3c, 0x09, 0xd4, 0x6e,
+	0x50, 0xb7, 0x52, 0xac, 0x9c, 0x2a, 0xac, 0x14, 0xeb, 0x7b, 0x72, 0xa3, 0xca, 0x4e, 0xd1, 0x21,
+	0xe2, 0x59, 0x86, 0x4a, 0x83, 0x49, 0xa7, 0x81, 0x99, 0x4b, 0xd3, 0x82, 0xb5, 0x34, 0xfd, 0xec,
+	0x96, 0xc9, 0x21, 0x2c, 0x17, 0x2d, 0x13, 0x9d, 0x7c, 0x03, 0xda, 0x87, 0x53, 0x2e, 0xc1, 0xd9,
+	0x81, 0xdc, 0xf0, 0xe8, 0x10, 0x4e, 0x80, 0x01, 0xdb, 0x76, 0x07, 0x86, 0x99, 0xc1, 0xd7, 0xc6,
+	0x2a, 0x92, 0xf7, 0x23, 0x07, 0x96, 0xee, 0x4f, 0xc7, 0x93, 0x87, 0x94, 0x16, 0x8e, 0xb2, 0x62,
+	0x6d, 0x70, 0xce, 0x5a, 0xd7, 0xac, 0xce, 0xac, 0x31, 0x81, 0x7c, 0x69, 0x67, 0x0a, 0x9b, 0x47,
+	0xc7, 0xbc, 0x15, 0xe8, 0xa9, 0x4a, 0x08, 0xa5, 0xfe, 0x2f, 0x1d, 0x6e, 0x9b, 0x6d, 0x25, 0x51,
+	0xb1, 0xad, 0x25, 0xd0, 0xc0, 0xc1, 0x94, 0x93, 0x1e, 0x7f, 0xcf, 0x75, 0x13, 0xfc, 0xd2, 0xcd,
+	0x51, 0x5c, 0xf3, 0x32, 0xdc, 0x3f, 0x87, 0xa3, 0x11, 0x53, 0xad, 0x4d, 0x5f, 0xa5, 0xbd, 0xf7,
+	0x61, 0x45, 0x6b, 0xcd, 0x7c, 0x53, 0x73, 0x5e, 0x73, 0xbc, 0x7f, 0xe4, 0xc0, 0x4a, 0x49, 0x1f,
+	0x91, 0x77, 0xbf, 0x80, 0x8d, 0xd8, 0x50, 0xb6, 0xe1, 0x60, 0xa0, 0x0a, 0xd2, 0xbc, 0x0a, 0xdf,
+	0x80, 0xb6, 0xc6, 0x4e, 0x36, 0x60, 0xf5, 0x93, 0xc7, 0xcf, 0x9e, 0x6c, 0xef, 0xef, 0x07, 0x7b,
+	0x1f, 0xdf, 0xff, 0x70, 0xfb, 0xb7, 0x82, 0x9d, 0xcd, 0xfd, 0x9d, 0xe5, 0x0b, 0x64, 0x1d, 0xc8,
+	0x93, 0xed, 0xfd, 0x67, 0xdb, 0x0f, 0x0c, 0xdc, 0xf1, 0x6e, 0x03, 0xd1, 0x2b, 0x20, 0xda, 0xaa,
+	0xd9, 0xa2, 0x8e, 0x61, 0x8b, 0x7a, 0xaf, 0x03, 0xd9, 0x8f, 0x8e, 0xe2, 0x8f, 0x68, 0x96, 0x85,
+	0x47, 0x4a, 0x0a, 0x97, 0xa1, 0x3e, 0xce, 0x8e, 0x84, 0x90, 0xe3, 0x4f, 0xef, 0x2b, 0xb0, 0x6a,
+	0xf0, 0x89, 0x8c, 0xaf, 0x42, 0x2b, 0x8b, 0x8e, 0xe2, 0x30, 0x9f, 0xa6, 0x72, 0x35, 0x28, 0x00,
+	0xef, 0x21, 0x5c, 0xfa, 0x36, 0x4d, 0xa3, 0xc3, 0xd9, 0x59, 0xd9, 0x9b, 0xf9, 0xd4, 0xec, 0x7c,
+	0xb6, 0x61, 0xcd, 0xca, 0x47, 0x14, 0xcf, 0xa7, 0xb0, 0x18, 0xc4, 0xa6, 0xcf, 0x13, 0xda, 0xce,
+	0xac, 0xa6, 0xef, 0xcc, 0xbc, 0x8f, 0x81, 0x6c, 0x25, 0x71, 0x4c, 0x07, 0xf9, 0x1e, 0xa5, 0x69,
+	0x31, 0xe3, 0x0a, 0xb1, 0x6e, 0xdf, 0xdd, 0x10, 0xa3, 0x68, 0x6f, 0xf7, 0x84, 0xbc, 0x13, 0x68,
+	0x4c, 0x68, 0x3a, 0x66, 0x19, 0x37, 0x7d, 0xf6, 0x1b, 0xb5, 0xb5, 0x91, 0xad, 0x98, 0x43, 0x6f,
+	0xc3, 0xda, 0x83, 0x28, 0x1b, 0x94, 0x0b, 0xec, 0xc3, 0xe2, 0x64, 0x7a, 0x10, 0x14, 0xda, 0x48,
+	0x26, 0xd1, 0x71, 0x67, 0x7f, 0x22, 0x32, 0xfb, 0x1d, 0x07, 0x1a, 0x3b, 0xcf, 0x76, 0xb7, 0x50,
+	0xcc, 0xa3, 0x78, 0x90, 0x8c, 0x71, 0xe7, 0xcd, 0x1b, 0xad, 0xd2, 0x73, 0x27, 0xe3, 0x55, 0x68,
+	0xb1, 0x0d, 0x3b, 0x7a, 0xd5, 0x84, 0x17, 0xb9, 0x00, 0xd0, 0x24, 0xd0, 0x4c, 0x60, 0xb1, 0xb6,
+	0x35, 0x98, 0x9e, 0x28, 0x13, 0xbc, 0xff, 0xdb, 0x80, 0x45, 0xb1, 0x5d, 0x67, 0xe5, 0x0d, 0xf2,
+	0xe8, 0x84, 0x8a, 0x9a, 0x88, 0x14, 0x9a, 0x3a, 0x29, 0x1d, 0x27, 0x39, 0x0d, 0x8c, 0x61, 0x30,
+	0x41, 0xe4, 0x92, 0x6e, 0x63, 0xae, 0xee, 0xea, 0x9c, 0xcb, 0x00, 0xb1, 0xb3, 0x10, 0x08, 0xa2,
+	0x21, 0xab, 0x53, 0xc3, 0x97, 0x49, 0xec, 0x89, 0x41, 0x38, 0x09, 0x07, 0x51, 0x3e, 0x13, 0xda,
+	0x43, 0xa5, 0x31, 0xef, 0x51, 0x32, 0x08, 0x47, 0xca, 0xc4, 0x11, 0xdb, 0x1e, 0x03, 0x44, 0xcf,
+	0xa0, 0xa8, 0x92, 0x64, 0xe3, 0xde, 0x43, 0x0b, 0x45, 0xbb, 0x7f, 0x90, 0x8c, 0xc7, 0x51, 0x8e,
+	0x0e, 0x45, 0x66, 0x8c, 0xd5, 0x7d, 0x0d, 0xe1, 0x9b, 0x2c, 0x96, 0x3a, 0xe5, 0xbd, 0xd7, 0x92,
+	0x9b, 0x2c, 0x0d, 0xc4, 0x5c, 0xd0, 0x7b, 0x84, 0x1a, 0xef, 0xf9, 0xa9, 0xb0, 0xb9, 0x34, 0x04,
+	0xc7, 0x61, 0x1a, 0x67, 0x34, 0xcf, 0x47, 0x9a, 0x69, 0xd6, 0xe6, 0xa6, 0x59, 0x89, 0x80, 0x8b,
+	0x0d, 0xf7, 0x71, 0x66, 0x61, 0x9e, 0x64, 0xc7, 0x51, 0x16, 0x64, 0x34, 0xce, 0xfb, 0x1d, 0xc6,
+	0x5f, 0x45, 0x22, 0xef, 0xc2, 0x86, 0x05, 0xa7, 0x74, 0x40, 0xa3, 0x13, 0x3a, 0xec, 0x77, 0xd9,
+	0x57, 0xf3, 0xc8, 0xb8, 0xcc, 0xa0, 0x6b, 0x77, 0x3a, 0x19, 0x86, 0xb8, 0xeb, 0x5e, 0x62, 0xe3,
+	0xa0, 0x43, 0xe4, 0x6d, 0xe8, 0x4e, 0x28, 0xf7, 0x97, 0x1c, 0xe7, 0xa3, 0x41, 0xd6, 0xef, 0x19,
+	0xfb, 0x3b, 0x94, 0x5c, 0xdf, 0xe4, 0x40, 0xa1, 0x1c, 0x64, 0xcc, 0xdf, 0x16, 0xce, 0xfa, 0xcb,
+	0x4c, 0xdc, 0x0a, 0x80, 0xcd, 0x11, 0x66, 0x80, 0xd1, 0xfe, 0x0a, 0x93, 0x2d, 0x99, 0xf4, 0xfe,
+	0xae, 0xc3, 0x8d, 0x23, 0x21, 0x84, 0x4a, 0x19, 0xbf, 0x02, 0x6d, 0x2e, 0x7e, 0x41, 0x12, 0x8f,
+	0x66, 0x42, 0x22, 0x81, 0x43, 0x4f, 0xe3, 0xd1, 0x8c, 0x7c, 0x09, 0xba, 0x51, 0xac, 0xb3, 0xf0,
+	0x39, 0xdc, 0x89, 0x62, 0x8d, 0xe9, 0x15, 0x68, 0x0b, 0x03, 0x95, 0xb1, 0xf0, 0x9d, 0x10, 0x70,
+	0x88, 0x31, 0xa0, 0x9f, 0x8c, 0xd7, 0x84, 0x73, 0x34, 0x18, 0x47, 0x5b, 0x60, 0xc8, 0x22, 0xcd,
+	0xb4, 0xa2, 0x82, 0x85, 0x99, 0x26, 0x64, 0x3b, 0xeb, 0xb7, 0x0d, 0x33, 0x4d, 0xb0, 0xfa, 0x8a,
+	0xee, 0xfd, 0x51, 0x03, 0x56, 0x05, 0xba, 0x35, 0x4a, 0x32, 0xba, 0x3f, 0x1d, 0x8f, 0xc3, 0xb4,
+	0x62, 0xd2, 0x38, 0x67, 0x4c, 0x9a, 0x9a, 0x39, 0x69, 0x50, 0x94, 0x8f, 0xc3, 0x28, 0xe6, 0x4e,
+	0x3e, 0x3e, 0xe3, 0x34, 0x04, 0xcd, 0xdc, 0xc1, 0x28, 0xc9, 0xb8, 0xe3, 0x4b, 0xf7, 0xda, 0xdb,
+	0x70, 0x79, 0x92, 0x2f, 0x54, 0x4d, 0x72, 0x7d, 0x92, 0x5e, 0xb4, 0x26, 0xa9, 0x07, 0x1d, 0xcc,
+	0x94, 0x9a, 0x1b, 0x22, 0x03, 0xc3, 0xfa, 0xd8, 0x53, 0x82, 0xcf, 0xbf, 0x5e, 0xd5, 0x84, 0xc0,
+	0x43, 0x01, 0xd4, 0x69, 0x1a, 0x77, 0x4b, 0x4c, 0x88, 0x32, 0x89, 0x3c, 0x04, 0xe0, 0x65, 0xb1,
+	0x45, 0x1c, 0xd8, 0x22, 0xfe, 0xba, 0x39, 0x22, 0x7a, 0xdf, 0xdf, 0xc6, 0xc4, 0x34, 0xa5, 0x6c,
+	0x19, 0xd7, 0xbe, 0xf4, 0xfe, 0x9a, 0x03, 0x6d, 0x8d, 0x46, 0xd6, 0x60, 0x65, 0xeb, 0xe9, 0xd3,
+	0xbd, 0x6d, 0x7f, 0xf3, 0xd9, 0xe3, 0x6f, 0x6f, 0x07, 0x5b, 0xbb, 0x4f, 0xf7, 0xb7, 0x97, 0x2f,
+	0x20, 0xbc, 0xfb, 0x74, 0x6b, 0x73, 0x37, 0x78, 0xf8, 0xd4, 0xdf, 0x92, 0xb0, 0x83, 0x0b, 0xb9,
+	0xbf, 0xfd, 0xd1, 0xd3, 0x67, 0xdb, 0x06, 0x5e, 0x23, 0xcb, 0xd0, 0xb9, 0xef, 0x6f, 0x6f, 0x6e,
+	0xed, 0x08, 0xa4, 0x4e, 0x2e, 0xc1, 0xf2, 0xc3, 0x8f, 0x9f, 0x3c, 0x78, 0xfc, 0xe4, 0x51, 0xb0,
+	0xb5, 0xf9, 0x64, 0x6b, 0x7b, 0x77, 0xfb, 0xc1, 0x72, 0x83, 0x74, 0xa1, 0xb5, 0x79, 0x7f, 0xf3,
+	0xc9, 0x83, 0xa7, 0x4f, 0xb6, 0x1f, 0x2c, 0x2f, 0x78, 0xff, 0xde, 0x81, 0x35, 0x56, 0xeb, 0xa1,
+	0x3d, 0x41, 0x6e, 0x40, 0x7b, 0x90, 0x24, 0x13, 0x9a, 0x86, 0x9a, 0xca, 0xd6, 0x21, 0x14, 0x7e,
+	0xae, 0x20, 0x0f, 0x93, 0x74, 0x40, 0xc5, 0xfc, 0x00, 0x06, 0x3d, 0x44, 0x04, 0x85, 0x5f, 0x0c,
+	0x2f, 0xe7, 0xe0, 0xd3, 0xa3, 0xcd, 0x31, 0xce, 0xb2, 0x0e, 0x17, 0x0f, 0x52, 0x1a, 0x0e, 0x8e,
+	0xc5, 0xcc, 0x10, 0x29, 0x3c, 0xe1, 0x92, 0x1e, 0xd5, 0x01, 0xf6, 0xfe, 0x88, 0x0e, 0xc5, 0xe9,
+	0x63, 0x4f, 0xe0, 0x5b, 0x02, 0x46, 0xcd, 0x10, 0x1e, 0x84, 0xf1, 0x30, 0x89, 0xe9, 0x90, 0x09,
+	0x4d, 0xd3, 0x2f, 0x00, 0x6f, 0x0f, 0xd6, 0xed, 0xf6, 0x89, 0xf9, 0xf5, 0x8e, 0x36, 0xbf, 0xf8,
+	0x36, 0xc8, 0x9d, 0x3f, 0x9a, 0xda, 0x5c, 0xfb, 0xcf, 0x0e, 0x34, 0x70, 0xb1, 0x9d, 0xbf, 0x30,
+	0xeb, 0xf6, 0x53, 0xbd, 0xe4, 0xcb, 0x63, 0x9e, 0x47, 0xae, 0x7e, 0xf9, 0x12, 0xa5, 0x21, 0x05,
+	0x3d, 0xa5, 0x83, 0x93, 0xfe, 0x82, 0x4e, 0x47, 0x84, 0x99, 0xad, 0x61, 0xce, 0xbf, 0x16, 0x13,
+	0x44, 0xa6, 0x25, 0x8d, 0x7d, 0xb9, 0x58, 0xd0, 0xd8, 0x77, 0x7d, 0x58, 0x8c, 0xe2, 0x83, 0x64,
+	0x1a, 0x0f, 0xd9, 0x84, 0x68, 0xfa, 0x32, 0xc9, 0xbc, 0x87, 0x6c, 0xa2, 0x46, 0x63, 0x29, 0xfe,
+	0x05, 0xe0, 0x11, 0xf4, 0x64, 0x67, 0xcc, 0xb8, 0x50, 0xfb, 0xca, 0x77, 0x60, 0x45, 0xc3, 0x0a,
+	0x57, 0xdd, 0x04, 0x01, 0xcb, 0x55, 0x87, 0x4c, 0x3e, 0xa7, 0x78, 0xcb, 0x78, 0xcc, 0x9f, 0x3f,
+	0x8e, 0x0f, 0x13, 0x99, 0xd3, 0x4f, 0xeb, 0xd0, 0x53, 0x90, 0xda, 0xf6, 0xf6, 0xa2, 0x21, 0x8d,
+	0xf3, 0x28, 0x9f, 0x05, 0x86, 0xc3, 0xdc, 0x86, 0xd1, 0x9a, 0x0b, 0x47, 0x51, 0x28, 0xfd, 0xa5,
+	0x3c, 0x41, 0xee, 0xc2, 0x25, 0x5c, 0x6a, 0xe4, 0xea, 0xa1, 0x86, 0x98, 0x6f, 0x65, 0x2a, 0x69,
+	0xa8, 0x0c, 0x10, 0x17, 0xda, 0x5e, 0x7d, 0xc2, 0xad, 0x9a, 0x2a, 0x12, 0xf6, 0x1a, 0xcf, 0x09,
+	0x9b, 0xbc, 0xc0, 0x97, 0x23, 0x05, 0x94, 0xce, 0x29, 0xf9, 0xd6, 0xbf, 0x74, 0x4e, 0xa9, 0x9d,
+	0x75, 0x36, 0x4b, 0x67, 0x9d, 0xa8, 0xca, 0xb8, 0xaf, 0x27, 0x4f, 0x02, 0xa6, 0x72, 0xd9, 0xe8,
+	0x34, 0x7d, 0x1b, 0xc6, 0xb1, 0xcd, 0x69, 0x96, 0xc7, 0x94, 0x9f, 0x39, 0x36, 0x7d, 0x99, 0xc4,
+	0xd9, 0xc5, 0x58, 0xf8, 0x02, 0xd2, 0xf2, 0x45, 0x0a, 0xcd, 0xd2, 0x69, 0x1a, 0x65, 0xfd, 0x0e,
+	0x43, 0xd9, 0x6f, 0xf2, 0x55, 0x58, 0x3b, 0xa0, 0x19, 0x1e, 0x38, 0x86, 0x43, 0x9a, 0xb2, 0xd1,
+	0xe7, 0x47, 0xa8, 0x7c, 0xb5, 0xaf, 0x26, 0x62, 0xd9, 0x27, 0x34, 0xcd, 0xd0, 0xc1, 0xb9, 0xc4,
+	0x25, 0x5d, 0x24, 0xbd, 0x1f, 0x32, 0xeb, 0x59, 0x79, 0x8d, 0x3f, 0x66, 0x4b, 0x3f, 0xb9, 0x02,
+	0x2d, 0xde, 0xc6, 0xec, 0x38, 0x14, 0x06, 0x7d, 0x93, 0x01, 0xfb, 0xc7, 0x21, 0xea, 0x0b, 0xa3,
+	0xdb, 0xf8, 0x56, 0xb8, 0xcd, 0xb0, 0x1d, 0xe9, 0x16, 0x5b, 0x92, 0xc7, 0xc6, 0x59, 0x30, 0xa2,
+	0x87, 0xb9, 0xdc, 0xa2, 0xc6, 0xd3, 0x31, 0x16, 0x97, 0xed, 0xd2, 0xc3, 0xdc, 0x7b, 0x02, 0x2b,
+	0x62, 0x0e, 0x3f, 0x9d, 0x50, 0x59, 0xf4, 0xaf, 0x57, 0xad, 0x85, 0xed, 0xbb, 0xab, 0xe6, 0xa4,
+	0xe7, 0x1b, 0x45, 0x93, 0xd3, 0xf3, 0x81, 0xe8, 0x3a, 0x41, 0x64, 0x28, 0x16, 0x24, 0x79, 0xea,
+	0x23, 0x9a, 0x63, 0x60, 0xd8, 0x3f, 0xd9, 0x74, 0x30, 0x90, 0x5e, 0xfd, 0xa6, 0x2f, 0x93, 0xde,
+	0x7f, 0x77, 0x60, 0x95, 0xe5, 0x26, 0x57, 0x73, 0xb5, 0x4b, 0x3c, 0x7f, 0x35, 0x3b, 0x03, 0x2d,
+	0x85, 0xf3, 0x41, 0xd7, 0xc4, 0x3c, 0xf1, 0xf9, 0xb7, 0xd6, 0x8d, 0xd2, 0xd6, 0xfa, 0x16, 0xac,
+	0x1c, 0x4c, 0xc7, 0x93, 0x20, 0x3c, 0xcc, 0x91, 0x09, 0x87, 0x43, 0x0a, 0x7d, 0x0f, 0x09, 0x9b,
+	0x88, 0xdf, 0x67, 0x30, 0xb9, 0x0c, 0x4d, 0xc6, 0x8b, 0xa6, 0x2f, 0x57, 0xc6, 0x8b, 0x07, 0xdc,
+	0x5b, 0xe0, 0xfd, 0xd4, 0x81, 0x15, 0xae, 0x53, 0xf3, 0x30, 0x9f, 0x66, 0xa2, 0x17, 0x7f, 0x03,
+	0xba, 0x7c, 0x71, 0x14, 0xb3, 0x52, 0xb4, 0xf7, 0x92, 0x52, 0x20, 0x0c, 0xe5, 0xcc, 0x3b, 0x17,
+	0x7c, 0x93, 0x99, 0xbc, 0x0f, 0x1d, 0xfd, 0x6c, 0xa2, 0x5f, 0x33, 0x23, 0x49, 0x4a, 0x02, 0xb8,
+	0x73, 0xc1, 0x37, 0x3e, 0x20, 0xf7, 0x98, 0x85, 0x13, 0x07, 0x2c, 0xdb, 0x7e, 0xdd, 0xfc, 0xbc,
+	0x34, 0xe6, 0x3b, 0x17, 0x7c, 0x8d, 0xfd, 0x7e, 0x13, 0x2e, 0x72, 0x93, 0xd6, 0x7b, 0x04, 0x5d,
+	0xa3, 0xa6, 0x86, 0xbb, 0xa0, 0x23, 0xdc, 0x05, 0xf6, 0x89, 0x52, 0xad, 0xe2, 0x44, 0xe9, 0xbf,
+	0xd6, 0xe1, 0x92, 0x28, 0x77, 0x73, 0x30, 0xa0, 0x93, 0x5c, 0x5b, 0x8f, 0xe3, 0x64, 0x48, 0x75,
+	0x9d, 0xd8, 0xf1, 0x75, 0xc8, 0x32, 0xd6, 0xf8, 0x21, 0xb0, 0x65, 0xac, 0xe9, 0x9a, 0x0f, 0xcd,
+	0x3d, 0xbe, 0xbb, 0xb3, 0x61, 0xe9, 0xbc, 0x42, 0x08, 0x4f, 0xde, 0xf9, 0x32, 0xa5, 0x43, 0xb8,
+	0xd6, 0x4c, 0xa6, 0xd9, 0x31, 0x23, 0xf3, 0x55, 0x4a, 0xa5, 0xb1, 0x1e, 0xc3, 0x69, 0x96, 0x8b,
+	0x83, 0xef, 0x8b, 0x8c, 0xaa, 0x21, 0xa8, 0x6d, 0xf1, 0xe4, 0x87, 0xf9, 0xcf, 0x82, 0x28, 0x0e,
+	0x0e, 0x47, 0xca, 0x9e, 0x6b, 0xf8, 0x55, 0x24, 0x66, 0x66, 0x8a, 0x39, 0x91, 0xd2, 0x8c, 0xa6,
+	0x27, 0xdc, 0xac, 0x6b, 0xf8, 0x36, 0x8c, 0xf5, 0x42, 0xc7, 0x1e, 0xee, 0x19, 0x98, 0xba, 0x6c,
+	0xf8, 0x2a, 0x5d, 0xb1, 0xa3, 0x6a, 0x18, 0x3b, 0x2a, 0x63, 0x8b, 0xd1, 0xb6, 0xb7, 0x18, 0xb7,
+	0x81, 0x60, 0xd5, 0x42, 0x36, 0x28, 0x74, 0xc8, 0xb7, 0x25, 0x6c, 0x03, 0xd5, 0xf5, 0x2b, 0x28,
+	0xba, 0xe9, 0x7d, 0x38, 0x0a, 0x8f, 0x32, 0xa6, 0x47, 0xbb, 0xbe, 0x09, 0x7a, 0x09, 0xac, 0x59,
+	0xa3, 0x2d, 0x96, 0x41, 0xb6, 0x59, 0x46, 0xa4, 0xd8, 0x2c, 0x63, 0xaa, 0x6a, 0x10, 0x6b, 0xd5,
+	0x83, 0x78, 0x09, 0x16, 0x78, 0xdc, 0x00, 0x37, 0x41, 0x78, 0xc2, 0xfb, 0xb7, 0x75, 0x20, 0xa8,
+	0x14, 0x2d, 0xad, 0x63, 0x49, 0x57, 0xad, 0x2c, 0x5d, 0xb7, 0x81, 0x68, 0x49, 0x79, 0x9a, 0xcf,
+	0xf3, 0xae, 0xa0, 0xe0, 0x3a, 0x2c, 0xac, 0x43, 0x25, 0x36, 0xcc, 0xd7, 0xc0, 0xd5, 0x4b, 0x25,
+	0x4d, 0x49, 0x55, 0x16, 0x72, 0xa9, 0xaa, 0xfb, 0x2a, 0x6d, 0xeb, 0xb1, 0x8b, 0x67, 0xea, 0xb1,
+	0xc5, 0x92, 0x1e, 0xd3, 0x76, 0x89, 0x4d, 0x63, 0x97, 0x88, 0x83, 0x25, 0xc5, 0x24, 0x18, 0x63,
+	0xe9, 0x62, 0x4b, 0x6e, 0x80, 0x18, 0x6b, 0x21, 0xec, 0xd9, 0x42, 0x4e, 0x80, 0x8d, 0x6a, 0x09,
+	0x37, 0x5d, 0xce, 0x6d, 0xfb, 0x34, 0xd4, 0x83, 0x0e, 0xfa, 0x80, 0x65, 0xfb, 0x99, 0x18, 0x35,
+	0x7d, 0x03, 0x33, 0x1d, 0x9a, 0xdd, 0xb3, 0x1c, 0x9a, 0xde, 0xff, 0x70, 0x60, 0xf9, 0x7e, 0x98,
+	0x0f, 0x8e, 0xb5, 0xd1, 0x3d, 0x87, 0xd2, 0x98, 0x37, 0x4c, 0xb5, 0x73, 0x0e, 0x53, 0xdd, 0x1a,
+	0x26, 0xad, 0x8f, 0x1b, 0x67, 0xf4, 0xf1, 0xc2, 0x79, 0xfb, 0xf8, 0x62, 0x75, 0x1f, 0xe3, 0xe6,
+	0x65, 0xc3, 0x6e, 0xb2, 0x14, 0xe8, 0xaf, 0x94, 0xac, 0x7b, 0xe9, 0xaa, 0x2b, 0x7d, 0xa1, 0x18,
+	0x6d, 0x19, 0xab, 0x9d, 0x29, 0x63, 0xf5, 0x92, 0x8c, 0x19, 0xe3, 0xde, 0xb0, 0xc7, 0xdd, 0x18,
+	0xd3, 0x85, 0x33, 0xc7, 0xf4, 0x7b, 0xd0, 0x2f, 0xb7, 0x4f, 0x28, 0x88, 0x6f, 0xc2, 0x72, 0xc9,
+	0xc6, 0xe5, 0x0d, 0xad, 0x5c, 0x3a, 0xfd, 0x12, 0xb7, 0xf7, 0x07, 0x35, 0xb8, 0xb4, 0x3f, 0x19,
+	0x45, 0x03, 0xdb, 0x04, 0xf9, 0xe2, 0x96, 0x12, 0x0e, 0x72, 0xc6, 0xb2, 0x44, 0xed, 0x8d, 0x8b,
+	0x03, 0x97, 0x23, 0x13, 0x44, 0x4f, 0x9a, 0x00, 0x92, 0x69, 0x1e, 0x14, 0xc1, 0x5d, 0x16, 0xaa,
+	0x8e, 0x10, 0x1a, 0xda, 0x11, 0x82, 0x35, 0x46, 0x0b, 0x67, 0x8e, 0xd1, 0xc5, 0x97, 0x8f, 0xd1,
+	0xa2, 0x35, 0x46, 0xde, 0x77, 0x60, 0xcd, 0xea, 0x15, 0xd1, 0xe3, 0x9b, 0xb0, 0x82, 0xa1, 0x94,
+	0xe7, 0xee, 0x9a, 0x32, 0xb7, 0xf7, 0x63, 0x07, 0x56, 0x7d, 0x1a, 0x0e, 0x67, 0x0f, 0x93, 0x14,
+	0x8f, 0x8b, 0x1e, 0x8a, 0xb9, 0x7e, 0x13, 0x7a, 0x6a, 0x8e, 0x19, 0x8e, 0x77, 0x1b, 0xc6, 0xae,
+	0xab, 0x9c, 0xa9, 0x16, 0x7a, 0xfe, 0xc5, 0xde, 0xfb, 0xbd, 0x1a, 0x2c, 0xa3, 0x80, 0x19, 0x56,
+	0xd9, 0x7b, 0xc0, 0x6c, 0xcb, 0x73, 0x1a, 0x65, 0x06, 0xef, 0xcf, 0x6e, 0x93, 0xbd, 0x0b, 0x2d,
+	0x96, 0x61, 0x32, 0xa1, 0xb1, 0x30, 0xc9, 0xfa, 0x66, 0x07, 0x17, 0x66, 0xfd, 0xce, 0x05, 0xbf,
+	0x60, 0x26, 0xef, 0x41, 0x4b, 0xe9, 0x50, 0x26, 0x35, 0xc5, 0xa6, 0xbe, 0xa2, 0xdb, 0xf1, 0x5b,
+	0xc5, 0xae, 0x19, 0x73, 0x7f, 0xd5, 0x81, 0xf5, 0x87, 0x51, 0x1c, 0x8e, 0xa2, 0x1f, 0x52, 0xc1,
+	0x5a, 0x44, 0xff, 0x95, 0xba, 0xd5, 0x99, 0x6b, 0x43, 0xe1, 0x89, 0x84, 0x3c, 0x00, 0x94, 0x41,
+	0xe9, 0x05, 0x84, 0x8b, 0x00, 0x8f, 0x24, 0x4c, 0xc3, 0xd3, 0x20, 0x7f, 0x21, 0xc6, 0xc7, 0xc0,
+	0xbc, 0xaf, 0xc3, 0x46, 0xa9, 0x26, 0x42, 0x1c, 0x3d, 0x33, 0xe8, 0x4c, 0x08, 0x8c, 0x81, 0x79,
+	0xff, 0xda, 0x81, 0xb6, 0x18, 0xac, 0x2f, 0x7c, 0x1c, 0xe0, 0x6a, 0x47, 0x8c, 0x7c, 0xb9, 0x57,
+	0x69, 0xec, 0x8e, 0x31, 0x9e, 0xb9, 0xe0, 0xae, 0xdc, 0x38, 0x0a, 0xb0, 0x61, 0x34, 0xfa, 0xf8,
+	0xc6, 0x21, 0xc8, 0xa3, 0x51, 0x20, 0xa9, 0x62, 0xfa, 0x56, 0x91, 0xd0, 0x7e, 0xc9, 0xf2, 0xf0,
+	0x88, 0x4f, 0xe0, 0xae, 0xcf, 0x13, 0x78, 0xe6, 0x21, 0x1a, 0x64, 0x39, 0xac, 0xbc, 0x1f, 0x2d,
+	0xc1, 0x46, 0x89, 0xa4, 0x82, 0xfd, 0x85, 0x8f, 0x7b, 0x14, 0x8d, 0x0f, 0x12, 0xe5, 0xed, 0x73,
+	0x74, 0xf7, 0xb7, 0x41, 0x22, 0x47, 0xb0, 0x26, 0x47, 0x14, 0x25, 0xab, 0xd0, 0xb1, 0xfc, 0x44,
+	0xfb, 0x6d, 0x73, 0x26, 0xd8, 0x05, 0x4a, 0x5c, 0x57, 0xdc, 0xd5, 0xf9, 0x91, 0x63, 0xe8, 0x4b,
+	0x82, 0xdc, 0x39, 0x6a, 0x3e, 0x0b, 0x2c, 0xeb, 0xcd, 0x33, 0xca, 0x32, 0xfc, 0x5b, 0xfe, 0xdc,
+	0xdc, 0xc8, 0x0c, 0xae, 0x4b, 0x1a, 0xdb, 0x1a, 0x96, 0xcb, 0x6b, 0x9c, 0xab, 0x6d, 0xcc, 0x73,
+	0x67, 0x16, 0x7a, 0x46, 0xc6, 0xe4, 0xfb, 0xb0, 0x7e, 0x1a, 0x46, 0xb9, 0xac, 0x96, 0xe6, 0x63,
+	0xe1, 0x8b, 0xe0, 0xdd, 0x33, 0x8a, 0xfc, 0x84, 0x7f, 0x6c, 0xec, 0x97, 0xe7, 0xe4, 0xe8, 0xfe,
+	0x89, 0x03, 0x4b, 0x66, 0x3e, 0x28, 0xa6, 0xc2, 0x78, 0x90, 0xa6, 0x8f, 0x54, 0xaf, 0x16, 0x5c,
+	0x76, 0x98, 0xd7, 0xaa, 0x1c, 0xe6, 0xba, 0x9b, 0xba, 0x7e, 0xd6, 0x59, 0x52, 0xe3, 0x7c, 0x67,
+	0x49, 0x0b, 0x55, 0x67, 0x49, 0xee, 0xff, 0x76, 0x80, 0x94, 0x65, 0x89, 0x3c, 0xe2, 0x1e, 0xfb,
+	0x98, 0x8e, 0x84, 0x66, 0xfe, 0xb5, 0xf3, 0xc9, 0xa3, 0xec, 0x3b, 0xf9, 0x35, 0x0b, 0x42, 0xd0,
+	0x54, 0xaf, 0xee, 0x79, 0xe9, 0xfa, 0x55, 0x24, 0xeb, 0x74, 0xab, 0x71, 0xf6, 0xe9, 0xd6, 0xc2,
+	0xd9, 0xa7, 0x5b, 0x17, 0xed, 0xd3, 0x2d, 0xf7, 0x4f, 0x6a, 0xb0, 0x5a, 0x31, 0xe8, 0x3f, 0xbf,
+	0x86, 0xe3, 0x30, 0x19, 0xba, 0x40, 0x18, 0x2a, 0x06, 0x58, 0x72, 0xf1, 0x70, 0xfd, 0x67, 0x60,
+	0x68, 0xb1, 0x1e, 0xa4, 0x49, 0x38, 0x1c, 0x84, 0x59, 0x6e, 0x2a, 0xc1, 0x12, 0xce, 0xd6, 0x79,
+	0x4a, 0x03, 0xe6, 0x21, 0xd1, 0xee, 0x30, 0x74, 0x7d, 0x1b, 0xc6, 0x10, 0x25, 0x74, 0x74, 0x21,
+	0x9c, 0xd2, 0x98, 0x1e, 0x25, 0x79, 0xa4, 0x85, 0x64, 0x76, 0xfd, 0x39, 0x54, 0x34, 0x99, 0x06,
+	0x93, 0xc3, 0x89, 0x88, 0x69, 0x60, 0xbf, 0xdd, 0xbf, 0x08, 0x5d, 0x63, 0xba, 0xfe, 0xfc, 0x7a,
+	0xd1, 0xee, 0x9f, 0x5a, 0xb9, 0x7f, 0xdc, 0xff, 0x52, 0x03, 0x52, 0x56, 0x19, 0xbf, 0xd4, 0x3a,
+	0x94, 0x47, 0xbb, 0x5e, 0x35, 0xda, 0xbf, 0xc8, 0xd5, 0xec, 0x4d, 0x58, 0x11, 0x97, 0x83, 0xb4,
+	0xd3, 0x26, 0x2e, 0xf7, 0x65, 0x02, 0x3a, 0x01, 0xcd, 0x03, 0xd2, 0xa6, 0x71, 0x73, 0x43, 0x5b,
+	0xd2, 0xad, 0x73, 0x52, 0xbc, 0x4b, 0xc4, 0x6f, 0x11, 0xdd, 0xe7, 0x59, 0xc9, 0xd5, 0xf1, 0x3f,
+	0x3a, 0xb0, 0x66, 0x11, 0x8a, 0x7b, 0x06, 0x7c, 0x01, 0x34, 0x57, 0x45, 0x13, 0xac, 0x8e, 0x04,
+	0xac, 0xcd, 0x89, 0x04, 0xc4, 0xfe, 0x99, 0xc6, 0x65, 0x7e, 0xde, 0xeb, 0x55, 0x24, 0xb2, 0x09,
+	0xcb, 0x22, 0xa6, 0x45, 0x42, 0x72, 0x39, 0x5a, 0x33, 0x83, 0xd3, 0x64, 0xf5, 0x4b, 0xec, 0xde,
+	0x6f, 0xc2, 0x92, 0xc9, 0xf3, 0x92, 0x48, 0xb5, 0xcf, 0xd5, 0x1c, 0x0c, 0xaa, 0xdd, 0x92, 0x17,
+	0xd0, 0x8c, 0x5e, 0x3d, 0x84, 0x75, 0x9b, 0x50, 0x84, 0xd0, 0x98, 0xfd, 0x29, 0x93, 0xb8, 0xe3,
+	0x36, 0x2c, 0x01, 0xb3, 0xf4, 0x4a, 0x9a, 0xf7, 0x47, 0x0e, 0x90, 0x6f, 0x4d, 0x69, 0x3a, 0x63,
+	0x97, 0x21, 0xd4, 0x19, 0xdd, 0x86, 0x7d, 0x02, 0x85, 0xa1, 0x2b, 0x1f, 0xd2, 0x99, 0xbc, 0x32,
+	0x53, 0x2b, 0xae, 0xcc, 0x5c, 0x03, 0x40, 0x8d, 0xa1, 0x6e, 0x58, 0xb0, 0x4d, 0x4f, 0x3c, 0x1d,
+	0xf3, 0x0c, 0x2b, 0x6f, 0xb5, 0x34, 0xce, 0xbe, 0xd5, 0xb2, 0x70, 0xd6, 0xad, 0x96, 0x7b, 0xb0,
+	0x6a, 0xd4, 0x5b, 0xc9, 0x9c, 0xbc, 0xeb, 0xe1, 0xbc, 0xe4, 0xae, 0xc7, 0x7f, 0x73, 0xa0, 0xbe,
+	0x93, 0x4c, 0xf4, 0xf3, 0x69, 0xc7, 0x3c, 0x9f, 0x16, 0xcb, 0x75, 0xa0, 0x56, 0x63, 0xa1, 0xc5,
+	0x0d, 0x90, 0xdc, 0x82, 0xa5, 0x70, 0x9c, 0xe3, 0x81, 0xc9, 0x61, 0x92, 0x9e, 0x86, 0x29, 0xd7,
+	0xe3, 0xf5, 0xfb, 0xb5, 0xbe, 0xe3, 0x5b, 0x14, 0x72, 0x09, 0xea, 0x6a, 0x5d, 0x63, 0x0c, 0x98,
+	0x44, 0xdb, 0x98, 0xc5, 0xb6, 0xcc, 0x84, 0xba, 0x16, 0x29, 0x94, 0x73, 0xf3, 0x7b, 0xee, 0xd9,
+	0xe0, 0xf3, 0xba, 0x8a, 0x84, 0xa6, 0x03, 0x76, 0x1f, 0x63, 0x13, 0x87, 0x74, 0x32, 0xed, 0xfd,
+	0x99, 0x03, 0x0b, 0xac, 0x07, 0x50, 0x13, 0xf1, 0xe9, 0xa7, 0x0e, 0xa2, 0x59, 0xcb, 0xbb, 0xbe,
+	0x0d, 0x13, 0xcf, 0xb8, 0xce, 0x56, 0x53, 0xd5, 0xd6, 0x50, 0x72, 0x03, 0x5a, 0x3c, 0xa5, 0x76,
+	0xda, 0x8c, 0xa5, 0x00, 0xc9, 0x75, 0xbc, 0x84, 0x32, 0x91, 0x33, 0x0e, 0x64, 0x1c, 0x46, 0x32,
+	0xf1, 0x19, 0x5e, 0xd4, 0x07, 0xf3, 0xd3, 0xbd, 0x37, 0x36, 0x8c, 0x86, 0x8d, 0xca, 0x56, 0xef,
+	0x0c, 0x0b, 0xf5, 0x6e, 0x41, 0xef, 0x49, 0x32, 0xa4, 0xda, 0x69, 0xe0, 0x5c, 0x69, 0xf6, 0xfe,
+	0x92, 0x03, 0x4d, 0xc9, 0x4c, 0x6e, 0x42, 0x03, 0xad, 0x35, 0x6b, 0x47, 0xaa, 0xe2, 0xaf, 0x90,
+	0xcf, 0x67, 0x1c, 0xb8, 0x30, 0xb0, 0xb3, 0xa2, 0xc2, 0x72, 0x97, 0x27, 0x45, 0x0a, 0x2b, 0xaa,
+	0x6b, 0xd9, 0x73, 0x16, 0xea, 0xfd, 0x63, 0x07, 0xba, 0x46, 0x19, 0xb8, 0xbf, 0x1b, 0xe1, 0xca,
+	0xce, 0xf7, 0x8c, 0x62, 0x78, 0x74, 0x48, 0x3f, 0x1f, 0xae, 0x99, 0xe7, 0xc3, 0xea, 0xe4, 0xb2,
+	0xae, 0x9f, 0x5c, 0xbe, 0x05, 0xad, 0xe2, 0xd2, 0x61, 0xc3, 0x50, 0xf8, 0x58, 0xa2, 0x8c, 0x2c,
+	0x6b, 0x19, 0x77, 0x10, 0x07, 0xc9, 0x28, 0x49, 0x45, 0x30, 0x05, 0x4f, 0x78, 0xf7, 0xa0, 0xad,
+	0xf1, 0x63, 0x35, 0x62, 0x9a, 0x9f, 0x26, 0xe9, 0x73, 0xa9, 0x04, 0x45, 0x52, 0xb9, 0x57, 0x6a,
+	0x85, 0x7b, 0xc5, 0xfb, 0x63, 0x07, 0xba, 0x28, 0x83, 0x51, 0x7c, 0xb4, 0x97, 0x8c, 0xa2, 0xc1,
+	0x8c, 0x8d, 0xbd, 0x14, 0x37, 0xa1, 0x19, 0xa4, 0x2c, 0x9a, 0xb0, 0xe1, 0x7c, 0xe7, 0x13, 0x51,
+	0xa5, 0x71, 0xa6, 0x32, 0x13, 0x27, 0xcc, 0x84, 0xf0, 0x8b, 0x15, 0xd8, 0x00, 0x71, 0x3e, 0x21,
+	0x90, 0x86, 0x39, 0x0d, 0xc6, 0xd1, 0x68, 0x14, 0x71, 0x5e, 0x6e, 0x65, 0x56, 0x91, 0xb0, 0xcc,
+	0x61, 0x94, 0x85, 0x07, 0x45, 0x80, 0x80, 0x4a, 0x7b, 0xff, 0xac, 0x06, 0x6d, 0xa1, 0x9e, 0xb7,
+	0x87, 0x47, 0x54, 0x1c, 0x90, 0x60, 0xb2, 0x50, 0x25, 0x1a, 0x22, 0xe9, 0x86, 0xe5, 0xaf, 0x21,
+	0xf6, 0x90, 0xd7, 0xcb, 0x43, 0x8e, 0xc7, 0xc2, 0xc9, 0x90, 0xbe, 0xcd, 0xb6, 0x18, 0xe2, 0x2a,
+	0x8e, 0x02, 0x24, 0xf5, 0x2e, 0xa3, 0x2e, 0x14, 0x54, 0x06, 0xbc, 0x34, 0xf6, 0xe5, 0x5d, 0xe8,
+	0x88, 0x6c, 0xd8, 0x98, 0xf4, 0x17, 0x0d, 0xe1, 0x37, 0xc6, 0xcb, 0x37, 0x38, 0xe5, 0x97, 0x77,
+	0xe5, 0x97, 0xcd, 0xb3, 0xbe, 0x94, 0x9c, 0x2c, 0x4e, 0x91, 0xf7, 0xcd, 0xa3, 0x34, 0x9c, 0x1c,
+	0xcb, 0x25, 0x6f, 0x08, 0x1d, 0x1d, 0x26, 0xb7, 0x60, 0x01, 0x3f, 0xb3, 0x9d, 0x8f, 0xe6, 0x84,
+	0xe4, 0x2c, 0xe4, 0x26, 0x2c, 0xd0, 0xe1, 0x11, 0x95, 0x9b, 0x68, 0x62, 0x3a, 0x75, 0x70, 0x8c,
+	0x7c, 0xce, 0x80, 0xea, 0x01, 0x51, 0x4b, 0x3d, 0x98, 0xab, 0x00, 0x9e, 0x66, 0xc7, 0x8f, 0x87,
+	0xde, 0x25, 0x8c, 0x61, 0x65, 0x12, 0xad, 0xb1, 0x7b, 0x3f, 0xaa, 0x43, 0x5b, 0x83, 0x71, 0xa6,
+	0x1f, 0x61, 0x85, 0x83, 0x61, 0x14, 0x8e, 0x69, 0x4e, 0x53, 0x21, 0xc5, 0x16, 0x8a, 0x7c, 0xe1,
+	0xc9, 0x11, 0x73, 0x41, 0x0e, 0xe9, 0x51, 0x4a, 0xf9, 0xc2, 0xec, 0xf8, 0x16, 0x8a, 0x7c, 0x78,
+	0xea, 0xa3, 0xf1, 0x71, 0x79, 0xb0, 0x50, 0x19, 0x29, 0xc0, 0xfb, 0xa8, 0x51, 0x44, 0x0a, 0xf0,
+	0x1e, 0xb1, 0x75, 0xd4, 0x42, 0x85, 0x8e, 0x7a, 0x07, 0xd6, 0xb9, 0x36, 0x12, 0xf3, 0x36, 0xb0,
+	0xc4, 0x64, 0x0e, 0x15, 0x37, 0x26, 0x58, 0x67, 0x29, 0xe0, 0x59, 0xf4, 0x43, 0x7e, 0x28, 0xe2,
+	0xf8, 0x25, 0x1c, 0x79, 0x99, 0x07, 0x54, 0xe7, 0xe5, 0x91, 0x53, 0x25, 0x9c, 0xf1, 0x86, 0x2f,
+	0x0c, 0x4c, 0x9c, 0x97, 0x94, 0x70, 0xaf, 0x0b, 0xed, 0xfd, 0x3c, 0x99, 0xc8, 0x41, 0x59, 0x82,
+	0x0e, 0x4f, 0x8a, 0x38, 0xd5, 0x2b, 0x70, 0x99, 0x49, 0xd1, 0xb3, 0x64, 0x92, 0x8c, 0x92, 0xa3,
+	0xd9, 0xfe, 0xf4, 0x80, 0x5f, 0x5b, 0xc3, 0x08, 0x82, 0x7f, 0xe5, 0xc0, 0xaa, 0x41, 0x15, 0xbe,
+	0xc9, 0xaf, 0x72, 0x91, 0x56, 0x01, 0x86, 0x5c, 0xf0, 0x56, 0x34, 0x55, 0xc9, 0x19, 0xf9, 0x41,
+	0x07, 0xff, 0x9d, 0x91, 0xcd, 0xe2, 0x0c, 0x51, 0x7e, 0xc8, 0xa5, 0xb0, 0x5f, 0x96, 0x42, 0xf1,
+	0xbd, 0x7c, 0x75, 0x40, 0x66, 0xf1, 0x75, 0x11, 0x81, 0x36, 0x64, 0x6d, 0x94, 0xee, 0x19, 0x15,
+	0x35, 0xa4, 0x6f, 0x6f, 0x64, 0x0d, 0x06, 0x0a, 0xcc, 0xbc, 0xbf, 0xee, 0x00, 0x14, 0xb5, 0x63,
+	0x71, 0x4b, 0x4a, 0xdd, 0xf3, 0x57, 0x27, 0x0a, 0x00, 0x63, 0x21, 0x54, 0xbc, 0x4b, 0xb1, 0x82,
+	0xb4, 0x25, 0x86, 0x46, 0xde, 0x1b, 0xd0, 0x3b, 0x1a, 0x25, 0x07, 0x6c, 0xf9, 0x65, 0x81, 0xcf,
+	0x99, 0x70, 0x21, 0x2e, 0x71, 0xf8, 0xa1, 0x40, 0x8b, 0xe5, 0xa6, 0xa1, 0x2d, 0x37, 0xde, 0xef,
+	0xd6, 0x60, 0xa5, 0xd4, 0xe6, 0xb9, 0xb3, 0x8c, 0xdc, 0x2d, 0x29, 0xc7, 0x39, 0x6e, 0x6f, 0xe6,
+	0x8e, 0xdd, 0x3b, 0xd3, 0x4f, 0x72, 0x0f, 0x96, 0x52, 0xae, 0x7d, 0xa4, 0x6a, 0x6a, 0xbc, 0x44,
+	0x35, 0x75, 0x53, 0x3d, 0x89, 0xe1, 0x61, 0xe1, 0xf0, 0x84, 0xa6, 0x79, 0xc4, 0xf6, 0x78, 0xcc,
+	0x20, 0x10, 0x37, 0x71, 0x34, 0x9c, 0xad, 0xd3, 0x6f, 0x40, 0x4f, 0x44, 0x48, 0x2b, 0x4e, 0x71,
+	0xb1, 0xbb, 0x80, 0x91, 0xd1, 0xfb, 0x07, 0x32, 0x20, 0xc3, 0x1c, 0xc3, 0xf9, 0x3d, 0xa2, 0xb7,
+	0xae, 0x66, 0xb5, 0xee, 0x4b, 0x22, 0xaa, 0x41, 0x5d, 0xdf, 0xaa, 0x6b, 0xd1, 0x8a, 0x43, 0x11,
+	0xcc, 0x62, 0x76, 0x69, 0xe3, 0x3c, 0x5d, 0xea, 0xfd, 0xa9, 0x03, 0x8b, 0x3b, 0xc9, 0x64, 0x47,
+	0xc4, 0x6d, 0xb2, 0x89, 0xa0, 0x9c, 0xbf, 0x32, 0xf9, 0x92, 0x88, 0xce, 0xca, 0x75, 0xb8, 0x6b,
+	0xaf, 0xc3, 0xdf, 0x84, 0x2b, 0x08, 0x4c, 0xd2, 0x64, 0x92, 0xa4, 0x38, 0x19, 0xc3, 0x11, 0x5f,
+	0x74, 0x93, 0x38, 0x3f, 0x96, 0x6a, 0xec, 0x65, 0x2c, 0x6c, 0x83, 0x85, 0x5b, 0x09, 0x6e, 0x28,
+	0x0b, 0xbb, 0x81, 0x6b, 0xb7, 0x32, 0xc1, 0xfb, 0x75, 0x68, 0x31, 0xc3, 0x97, 0x35, 0xeb, 0x4d,
+	0x68, 0x1d, 0x27, 0x93, 0xe0, 0x38, 0x2a, 0x5f, 0x50, 0x12, 0x2d, 0xf7, 0x0b, 0x06, 0xef, 0x6f,
+	0x2d, 0xc0, 0xe2, 0xe3, 0xf8, 0x24, 0x89, 0x06, 0x2c, 0xe8, 0x62, 0x4c, 0xc7, 0x89, 0xbc, 0xa3,
+	0x81, 0xbf, 0xb1, 0x2b, 0x58, 0x64, 0xf2, 0x44, 0xfa, 0xe0, 0x65, 0x12, 0x97, 0xfb, 0xb4, 0xb8,
+	0x34, 0xcf, 0xa7, 0x8e, 0x86, 0xa0, 0xd1, 0x9f, 0xea, 0xef, 0x0b, 0x88, 0x54, 0x71, 0x11, 0x68,
+	0x41, 0xbb, 0x08, 0x84, 0xe5, 0x88, 0x18, 0x53, 0x19, 0xf7, 0x22, 0x92, 0x6c, 0x93, 0x92, 0x52,
+	0xee, 0x44, 0x63, 0x86, 0xc3, 0xa2, 0xd8, 0xa4, 0xe8, 0x20, 0x3b, 0x2f, 0x60, 0x1f, 0x70, 0x1e,
+	0xae, 0x7c, 0x75, 0x88, 0x9d, 0x3d, 0x58, 0x4f, 0x14, 0xf0, 0xb7, 0x27, 0x6c, 0x18, 0x35, 0xf4,
+	0x90, 0x2a, 0x45, 0xca, 0xdb, 0x00, 0xfc, 0x51, 0x00, 0x1b, 0xd7, 0xb6, 0x36, 0x3c, 0x78, 0x5c,
+	0xa4, 0x98, 0xa0, 0x84, 0xa3, 0x11, 0x3e, 0xd1, 0xc2, 0x0e, 0x9f, 0xd8, 0x19, 0x75, 0xcb, 0x37,
+	0x41, 0xac, 0xb5, 0x36, 0x9a, 0x2c, 0xc6, 0xa1, 0xe1, 0xeb, 0x10, 0xb9, 0x0b, 0x6d, 0xb6, 0x9d,
+	0x13, 0xe3, 0xb9, 0xc4, 0xc6, 0x73, 0x59, 0xdf, 0xef, 0xb1, 0x11, 0xd5, 0x99, 0xf4, 0x43, 0xe4,
+	0x9e, 0x79, 0x88, 0xcc, 0x95, 0xa6, 0x88, 0x9f, 0x59, 0x66, 0xa5, 0x15, 0x00, 0xbb, 0xbe, 0xc4,
+	0x3b, 0x8c, 0x33, 0xac, 0x30, 0x06, 0x03, 0x23, 0xd7, 0xa1, 0x89, 0x9b, 0x90, 0x49, 0x18, 0x0d,
+	0xfb, 0x44, 0xed, 0x85, 0x14, 0x86, 0x79, 0xc8, 0xdf, 0xec, 0x80, 0x7b, 0x95, 0xdf, 0x27, 0xd3,
+	0x31, 0xec, 0x1b, 0x95, 0x66, 0x93, 0xe8, 0x12, 0x1f, 0x51, 0x03, 0xf4, 0x72, 0x20, 0x9b, 0xc3,
+	0xa1, 0x90, 0x4d, 0x3d, 0xb0, 0x23, 0xd5, 0xdf, 0x4c, 0x10, 0xa9, 0xaa, 0xd1, 0xad, 0x55, 0x8f,
+	0xee, 0x4b, 0xfb, 0xc0, 0xdb, 0x86, 0xf6, 0x9e, 0xf6, 0x0a, 0x03, 0x13, 0x72, 0xf9, 0xfe, 0x82,
+	0x98, 0x18, 0x1a, 0xa2, 0x55, 0xa7, 0xa6, 0x57, 0xc7, 0xfb, 0x87, 0x0e, 0xbf, 0xe4, 0xad, 0xaa,
+	0xaf, 0xde, 0x2a, 0x51, 0x0e, 0x8a, 0x22, 0x6e, 0xde, 0xc0, 0x90, 0x87, 0x55, 0x25, 0x48, 0x0e,
+	0x0f, 0x33, 0x2a, 0xc3, 0x87, 0x0c, 0x0c, 0x25, 0x14, 0x6d, 0x1c, 0xb4, 0x17, 0x22, 0x5e, 0x42,
+	0x26, 0xe2, 0x88, 0x4a, 0x38, 0xea, 0xd9, 0x94, 0x62, 0x58, 0xa1, 0x9a, 0x5a, 0x2a, 0xad, 0xc2,
+	0xfb, 0xed, 0x5e, 0xbe, 0x85, 0x07, 0x5d, 0x22, 0x5f, 0x53, 0x85, 0x48, 0x4e, 0x45, 0x47, 0x55,
+	0xc5, 0x6c, 0x78, 0xa3, 0xd2, 0x5c, 0x6d, 0x96, 0x09, 0x18, 0x07, 0x73, 0x18, 0xa5, 0x36, 0xbb,
+	0xb8, 0x1c, 0x5e, 0xa6, 0x78, 0x9f, 0xc0, 0xaa, 0x28, 0x52, 0x37, 0x6e, 0xcc, 0x41, 0x74, 0xce,
+	0x12, 0xe4, 0x5a, 0x59, 0x90, 0xbd, 0xff, 0xe3, 0xc0, 0xa2, 0x18, 0x69, 0x36, 0x2c, 0xf6, 0x73,
+	0x1c, 0x2d, 0xdf, 0xc0, 0x48, 0xdf, 0xb8, 0xbf, 0xc8, 0xa4, 0x9e, 0x03, 0x65, 0x05, 0x55, 0xaf,
+	0x52, 0x50, 0x78, 0x97, 0x09, 0x6f, 0xbc, 0x36, 0x78, 0xd0, 0x28, 0xfe, 0x26, 0xcb, 0xdc, 0x5b,
+	0xc2, 0x15, 0x21, 0xfe, 0xac, 0x7c, 0x8f, 0x84, 0xaf, 0xb7, 0x25, 0x1c, 0xfb, 0x80, 0x55, 0x20,
+	0x28, 0x9c, 0x21, 0x05, 0x80, 0x92, 0xcb, 0x13, 0x6c, 0x86, 0x89, 0x6b, 0x34, 0x05, 0x22, 0x6f,
+	0xbd, 0x8a, 0x2e, 0xb0, 0x6f, 0xbd, 0x16, 0x70, 0x21, 0x11, 0xa2, 0x02, 0xb6, 0x44, 0x08, 0x56,
+	0x5f, 0xd1, 0xf1, 0x02, 0xfb, 0x03, 0x3a, 0xa2, 0x39, 0xdd, 0x1c, 0x8d, 0xec, 0xfc, 0xaf, 0xc0,
+	0xe5, 0x0a, 0x9a, 0xb0, 0x67, 0xbf, 0x05, 0x6b, 0x9b, 0x3c, 0xf4, 0xfc, 0xe7, 0x15, 0xd5, 0x89,
+	0x07, 0x9e, 0x76, 0x96, 0xa2, 0xb0, 0x87, 0xb0, 0xf2, 0x80, 0x1e, 0x4c, 0x8f, 0x76, 0xe9, 0x49,
+	0x51, 0x10, 0x81, 0x46, 0x76, 0x9c, 0x9c, 0x8a, 0x89, 0xc9, 0x7e, 0xa3, 0xef, 0x6f, 0x84, 0x3c,
+	0x41, 0x36, 0xa1, 0x03, 0x79, 0x5d, 0x8e, 0x21, 0xfb, 0x13, 0x3a, 0xf0, 0xde, 0x01, 0xa2, 0xe7,
+	0x53, 0x5c, 0x60, 0xcd, 0xa6, 0x07, 0x41, 0x36, 0xcb, 0x72, 0x3a, 0x96, 0xe1, 0x08, 0x3a, 0xe4,
+	0xbd, 0x01, 0x9d, 0xbd, 0x10, 0x5f, 0x1c, 0x11, 0x0f, 0xb8, 0xa0, 0xff, 0x26, 0x9c, 0xa1, 0x9a,
+	0x52, 0xfe, 0x1b, 0x46, 0xf6, 0xfe, 0x67, 0x0d, 0x2e, 0x72, 0x4e, 0xcc, 0x75, 0x48, 0xb3, 0x3c,
+	0x8a, 0x8b, 0x47, 0x11, 0x5a, 0xbe, 0x0e, 0x95, 0x44, 0xb9, 0x56, 0x21, 0xca, 0x62, 0xd7, 0x24,
+	0xaf, 0x1e, 0x09, 0x79, 0x35, 0x30, 0x14, 0xae, 0x22, 0x86, 0x99, 0x3b, 0x10, 0x0a, 0xc0, 0x72,
+	0xe8, 0x15, 0xab, 0x1e, 0xaf, 0x9f, 0x9c, 0xa5, 0x42, 0x72, 0x75, 0xa8, 0x72, 0x6d, 0x5d, 0xe4,
+	0x02, 0x6e, 0xe3, 0xe5, 0x35, 0xb4, 0x79, 0x8e, 0x35, 0x94, 0x6f, 0xa5, 0x5e, 0xb6, 0x86, 0xc2,
+	0x39, 0xd6, 0x50, 0x8c, 0xdc, 0x67, 0x57, 0x74, 0xd1, 0x3a, 0x93, 0xb2, 0xfb, 0xb7, 0x1d, 0x58,
+	0x16, 0x52, 0xa4, 0x68, 0xe4, 0x55, 0xc3, 0x0a, 0xad, 0xbc, 0x20, 0xf4, 0x1a, 0x74, 0x99, 0x6d,
+	0xa8, 0x3c, 0x97, 0xc2, 0xcd, 0x6a, 0x80, 0x2c, 0x6a, 0x54, 0x9c, 0xe0, 0x8d, 0xa3, 0x91, 0x18,
+	0x14, 0x1d, 0x92, 0xce, 0xcf, 0x54, 0x46, 0x87, 0x39, 0xbe, 0x4a, 0x7b, 0xff, 0xdc, 0x81, 0x15,
+	0xad, 0xc2, 0x42, 0x0a, 0xef, 0x41, 0x47, 0x05, 0x4c, 0x52, 0x6a, 0x87, 0x72, 0xd9, 0x6d, 0xf1,
+	0x0d, 0x66, 0x36, 0x98, 0xe1, 0x8c, 0x55, 0x30, 0x9b, 0x8e, 0x85, 0x12, 0xd5, 0x21, 0x14, 0xa4,
+	0x53, 0x4a, 0x9f, 0x2b, 0x16, 0xae, 0xc6, 0x0d, 0x0c, 0x1b, 0x3f, 0x46, 0x9b, 0x56, 0x31, 0xf1,
+	0xf5, 0xcc, 0x04, 0xbd, 0x7f, 0xe7, 0xc0, 0x2a, 0xdf, 0x9c, 0x88, 0xad, 0x9f, 0xba, 0xbd, 0x79,
+	0x91, 0xef, 0xc6, 0xf8, 0x8c, 0xdc, 0xb9, 0xe0, 0x8b, 0x34, 0xf9, 0xda, 0x39, 0x37, 0x54, 0x2a,
+	0xe6, 0x78, 0xce, 0x58, 0xd4, 0xab, 0xc6, 0xe2, 0x25, 0x3d, 0x5d, 0xe5, 0xd0, 0x5b, 0xa8, 0x74,
+	0xe8, 0xe1, 0x3b, 0x5e, 0xd9, 0x20, 0x99, 0x50, 0x3c, 0x55, 0x32, 0x1b, 0x27, 0x54, 0xd0, 0x4f,
+	0x1c, 0xe8, 0x3f, 0xe4, 0xee, 0x6d, 0x3c, 0x8f, 0x8a, 0xb2, 0x3c, 0x49, 0xd5, 0x8b, 0x45, 0xd7,
+	0x01, 0xf8, 0x1b, 0x66, 0x98, 0xad, 0x74, 0xb7, 0x15, 0x08, 0xd6, 0x91, 0xc6, 0x43, 0x4e, 0xe5,
+	0x63, 0xa3, 0xd2, 0x25, 0x1b, 0x42, 0x6c, 0x9f, 0x74, 0x0c, 0x3d, 0x30, 0xd2, 0x56, 0xa0, 0x27,
+	0x4c, 0xaf, 0xf3, 0x7d, 0x89, 0x85, 0x7a, 0xff, 0xd4, 0x81, 0x5e, 0x51, 0xc9, 0x6d, 0x04, 0x4d,
+	0xed, 0x20, 0x96, 0x5f, 0x05, 0x28, 0x47, 0x60, 0x84, 0xeb, 0xb1, 0xa8, 0x9b, 0x86, 0xb0, 0x19,
+	0x2b, 0x52, 0xc9, 0x54, 0xc5, 0x47, 0x6b, 0x10, 0x0f, 0xa6, 0x41, 0x4b, 0x40, 0x58, 0x35, 0x22,
+	0xc5, 0x4e, 0xa4, 0xc6, 0x39, 0xfb, 0x8a, 0x07, 0x46, 0xcb, 0xa4, 0x5c, 0x4a, 0x79, 0x14, 0x34,
+	0xfe, 0xf4, 0x7e, 0xcf, 0x81, 0xcb, 0x15, 0x9d, 0x2b, 0x66, 0xc6, 0x03, 0x58, 0x39, 0x54, 0x44,
+	0xd9, 0x01, 0x7c, 0x7a, 0xac, 0xcb, 0xf3, 0x18, 0xb3, 0xd1, 0x7e, 0xf9, 0x03, 0x65, 0xfb, 0xf0,
+	0x2e, 0x35, 0xe2, 0xd2, 0xcb, 0x04, 0x6f, 0x0b, 0x7a, 0x9b, 0xc3, 0xe1, 0xb3, 0xe4, 0xb4, 0xb8,
+	0x9d, 0x6c, 0x3e, 0x6b, 0xd5, 0x51, 0xcf, 0x5a, 0xcd, 0x7d, 0xce, 0x08, 0x15, 0x53, 0x91, 0x89,
+	0x5a, 0xca, 0x88, 0x4f, 0xc7, 0xc9, 0x09, 0xfd, 0x19, 0xf3, 0x5e, 0x83, 0x55, 0x23, 0x1f, 0x91,
+	0xfd, 0xfb, 0xfc, 0xc6, 0x12, 0x03, 0xd5, 0xe1, 0xd9, 0x2d, 0x58, 0x8e, 0xe2, 0xc1, 0x68, 0x3a,
+	0xa4, 0x41, 0x46, 0xb3, 0x4c, 0x3c, 0x90, 0x87, 0xab, 0x66, 0x09, 0xc7, 0x07, 0x0e, 0x3a, 0xec,
+	0xeb, 0x7d, 0x8e, 0xc8, 0x3b, 0xae, 0xf2, 0x01, 0x4b, 0xe1, 0xfd, 0xd7, 0x20, 0x79, 0xa7, 0x48,
+	0x5a, 0xc6, 0x92, 0xb3, 0x56, 0xdc, 0x29, 0xb2, 0x48, 0x98, 0x27, 0x4a, 0xad, 0xe4, 0x14, 0xee,
+	0x65, 0x0d, 0x42, 0xdb, 0x33, 0x3b, 0xa5, 0x74, 0x12, 0x94, 0x2e, 0x6c, 0x34, 0xfc, 0x0a, 0x8a,
+	0x76, 0xe3, 0x7a, 0x41, 0xbf, 0x71, 0xed, 0xfd, 0xbe, 0x03, 0x0b, 0xac, 0x39, 0x73, 0xbb, 0xd8,
+	0x70, 0x4e, 0xd5, 0x6c, 0xe7, 0x94, 0x5c, 0x7f, 0x65, 0xb7, 0x15, 0x77, 0x70, 0x14, 0x46, 0xee,
+	0x40, 0x53, 0xfe, 0x16, 0x87, 0x19, 0x52, 0xb9, 0xe9, 0x1d, 0xe9, 0x2b, 0x26, 0xef, 0x3d, 0xbe,
+	0xe1, 0x90, 0x83, 0x54, 0x9c, 0x14, 0xe6, 0x0c, 0xb1, 0x4e, 0x0a, 0xf9, 0x00, 0x0b, 0x9a, 0x77,
+	0x19, 0x36, 0x18, 0xb0, 0x35, 0x8a, 0x68, 0x9c, 0x63, 0x24, 0xa3, 0xb2, 0xd7, 0xfe, 0xb0, 0x06,
+	0xfd, 0x32, 0x4d, 0xe4, 0x2e, 0x2e, 0x8b, 0x89, 0xfe, 0x2d, 0x6e, 0x38, 0x73, 0x8d, 0x50, 0x49,
+	0xb3, 0xbf, 0x91, 0x61, 0xff, 0x42, 0x4d, 0x54, 0xd2, 0x64, 0x34, 0x87, 0xc4, 0xa3, 0x98, 0x8e,
+	0xa2, 0xa3, 0xe8, 0x60, 0x44, 0xc5, 0x8a, 0x33, 0x87, 0x8a, 0x97, 0xb2, 0xf4, 0x4e, 0x0d, 0xc2,
+	0xc1, 0x0f, 0xa6, 0x51, 0x4a, 0xe5, 0xe5, 0xf6, 0x6a, 0xa2, 0x2c, 0x4d, 0x11, 0xe8, 0x8b, 0xe3,
+	0x70, 0x9a, 0xe5, 0xe2, 0x84, 0xa4, 0xe1, 0xcf, 0xa1, 0x7a, 0xdf, 0x02, 0x77, 0xfb, 0x05, 0xae,
+	0xa3, 0x5b, 0xfa, 0x6b, 0xab, 0x45, 0x44, 0xb5, 0x6d, 0x27, 0xcc, 0xb1, 0x5f, 0x35, 0x36, 0xef,
+	0x10, 0xba, 0x46, 0x66, 0x5f, 0x28, 0x17, 0xa5, 0x6f, 0x79, 0x0f, 0xc9, 0x58, 0x4a, 0x0d, 0xf2,
+	0x4e, 0xa0, 0xf7, 0xd1, 0x74, 0x94, 0x47, 0xc5, 0xdb, 0xb1, 0xe4, 0x6b, 0xd0, 0x2e, 0xb2, 0x90,
+	0xe2, 0x53, 0x59, 0x94, 0xce, 0x87, 0x1a, 0x71, 0x8c, 0x39, 0x05, 0xe5, 0x12, 0xcb, 0x04, 0xef,
+	0x03, 0x58, 0x32, 0xda, 0x97, 0xe1, 0x81, 0x8b, 0xc6, 0x60, 0x1f, 0x8b, 0x98, 0x3d, 0x6b, 0x70,
+	0xa2, 0x03, 0x92, 0x94, 0xdf, 0xbe, 0x25, 0x8f, 0x60, 0x15, 0x9d, 0x99, 0x23, 0x1a, 0x58, 0xf9,
+	0x3a, 0x5a, 0x70, 0x84, 0x59, 0x09, 0xbf, 0xea, 0x0b, 0x5c, 0x31, 0xaa, 0x5b, 0x56, 0xac, 0x18,
+	0x56, 0x1f, 0x56, 0xb5, 0xd8, 0x85, 0x3e, 0x7f, 0x5c, 0x43, 0x63, 0x93, 0x7a, 0xf6, 0xc7, 0x0e,
+	0xf4, 0x7d, 0x8a, 0xeb, 0x14, 0xd5, 0xa9, 0x5c, 0x7e, 0xee, 0x95, 0x3a, 0x66, 0x7e, 0x03, 0x54,
+	0x4c, 0x71, 0xa1, 0xf9, 0xe6, 0x8d, 0xca, 0xce, 0x85, 0x8a, 0x5a, 0x62, 0x30, 0xaf, 0xa8, 0x2f,
+	0x7b, 0x10, 0x8d, 0x55, 0xc9, 0xaa, 0xec, 0x07, 0x00, 0x1f, 0xd2, 0xd9, 0x6e, 0x32, 0x08, 0xf3,
+	0x24, 0xc5, 0x25, 0x9f, 0xbd, 0xf6, 0x14, 0x8e, 0x23, 0xe1, 0xd6, 0xe8, 0xfa, 0x1a, 0x82, 0x0a,
+	0x11, 0x53, 0xfa, 0x02, 0x59, 0x00, 0xde, 0x01, 0x74, 0x3f, 0xa4, 0xb3, 0x07, 0xc2, 0xfe, 0x4f,
+	0x52, 0x76, 0xdd, 0x3d, 0x3c, 0x65, 0x0f, 0x48, 0xe9, 0x6f, 0x0f, 0x9a, 0x20, 0xf9, 0x32, 0x2c,
+	0x62, 0x62, 0x94, 0x0c, 0xc4, 0x38, 0xc8, 0x43, 0x8c, 0xa2, 0x62, 0xbe, 0xe4, 0xf0, 0x6e, 0xc2,
+	0xc5, 0x0f, 0x29, 0xdb, 0x44, 0x9d, 0x51, 0x57, 0xef, 0x1e, 0x2c, 0x3c, 0x7b, 0xf1, 0x74, 0x9a,
+	0x17, 0x9e, 0x4a, 0x47, 0xf7, 0x54, 0x1a, 0xef, 0x05, 0x72, 0xc9, 0x2e, 0x00, 0xef, 0xdf, 0xd4,
+	0x60, 0x09, 0x9f, 0x6e, 0xd1, 0x1a, 0xf3, 0x16, 0x34, 0x31, 0x77, 0xdc, 0xde, 0x58, 0xc7, 0xee,
+	0x46, 0xa3, 0x7d, 0xc5, 0xc5, 0xfc, 0x17, 0x5c, 0x02, 0xf3, 0x53, 0x1a, 0x3e, 0x17, 0xa5, 0x18,
+	0x18, 0xf2, 0x0c, 0x93, 0xe9, 0x81, 0xe2, 0x11, 0xe1, 0xcf, 0x3a, 0x86, 0x26, 0xde, 0x69, 0x94,
+	0xc7, 0x34, 0xcb, 0xf4, 0xf7, 0x0d, 0x3b, 0xbe, 0x85, 0xe2, 0x2a, 0xc1, 0x6f, 0xd1, 0x89, 0x88,
+	0x14, 0xb5, 0x4a, 0x60, 0x37, 0xf8, 0x82, 0xc6, 0x5c, 0xb4, 0xd1, 0x11, 0xdb, 0xb1, 0xf1, 0x20,
+	0x3a, 0x99, 0x44, 0x05, 0x13, 0xc5, 0xc5, 0xc5, 0x3c, 0x7e, 0x27, 0x40, 0x87, 0xc8, 0x37, 0xd4,
+	0xe3, 0xd0, 0xd8, 0x48, 0xe6, 0x08, 0x31, 0x8f, 0x52, 0x79, 0x70, 0xd5, 0x87, 0x74, 0xb6, 0x17,
+	0xe6, 0xc7, 0xbe, 0xcd, 0x8c, 0xc6, 0x5c, 0xd7, 0x60, 0xe1, 0xe1, 0x01, 0xe9, 0x24, 0xc9, 0x64,
+	0xf0, 0x80, 0x4c, 0xe2, 0x00, 0x0d, 0x92, 0x28, 0xe6, 0x0f, 0x0d, 0x08, 0x59, 0x53, 0x80, 0x1e,
+	0xd4, 0xc4, 0xd7, 0x5d, 0x99, 0xe4, 0x97, 0xe9, 0xc3, 0x58, 0x5c, 0xa6, 0xef, 0xfa, 0x22, 0x85,
+	0x62, 0xc0, 0xdb, 0xc5, 0xcd, 0x7c, 0x9e, 0xf0, 0x86, 0xb0, 0x88, 0xe3, 0x8c, 0x02, 0xe5, 0x41,
+	0x87, 0xc7, 0x9b, 0x1b, 0xc2, 0x6a, 0x60, 0xb8, 0x5d, 0xc1, 0xe0, 0x75, 0x36, 0xbe, 0xf2, 0xe8,
+	0x4c, 0x4e, 0x5e, 0x53, 0x5e, 0x7c, 0x8d, 0xd1, 0x7b, 0x1d, 0x9a, 0xbc, 0x94, 0x6c, 0xc2, 0x1c,
+	0x79, 0xe1, 0x69, 0x90, 0x45, 0x47, 0x5c, 0x2d, 0x76, 0x7c, 0x95, 0xf6, 0x1e, 0x41, 0xfb, 0x31,
+	0x76, 0xf7, 0x3e, 0x1f, 0xd0, 0x3e, 0x2c, 0x8a, 0x21, 0x16, 0x9c, 0x32, 0xc9, 0x76, 0x15, 0xd1,
+	0x91, 0x29, 0xbe, 0x1a, 0xe2, 0x7d, 0x08, 0x3d, 0x2d, 0x23, 0x56, 0xee, 0xbb, 0xd0, 0xe5, 0x43,
+	0xc9, 0x59, 0xec, 0x77, 0x90, 0x75, 0x76, 0x93, 0xd1, 0x7b, 0x0a, 0x6b, 0x1f, 0xd2, 0x59, 0xc5,
+	0x8b, 0x47, 0xe7, 0x9b, 0xdf, 0xe2, 0xe1, 0xa2, 0x5a, 0xf1, 0x2e, 0xd2, 0x3b, 0xb0, 0x6e, 0x67,
+	0x38, 0xef, 0x69, 0xa4, 0x8e, 0xfe, 0xa4, 0xd1, 0x75, 0xb8, 0xca, 0x97, 0xe6, 0x4f, 0xd8, 0x7d,
+	0xa0, 0x78, 0x34, 0x33, 0xde, 0x2e, 0xf7, 0xbe, 0x0e, 0xd7, 0xe6, 0xd0, 0x8b, 0xec, 0x85, 0x48,
+	0x0e, 0x0f, 0x64, 0xf6, 0x0a, 0xf0, 0xee, 0xc1, 0xda, 0x03, 0x7c, 0xc1, 0x8e, 0xee, 0xa5, 0xd1,
+	0x09, 0xd3, 0x32, 0xc5, 0x25, 0x03, 0x6c, 0x12, 0xba, 0xdf, 0x83, 0xc2, 0x4a, 0x34, 0x30, 0x6f,
+	0x02, 0xcb, 0xfb, 0xc7, 0x61, 0x4a, 0x87, 0x5c, 0x3d, 0xb1, 0xfe, 0xf9, 0xfc, 0x2a, 0xe3, 0x16,
+	0x2c, 0xd3, 0xc9, 0x31, 0x1d, 0xd3, 0x34, 0x1c, 0x99, 0xd7, 0x10, 0x4b, 0xb8, 0xf7, 0x15, 0x58,
+	0xd1, 0x4a, 0x2c, 0x9e, 0xdb, 0xcc, 0x18, 0xa8, 0x55, 0x54, 0x43, 0xbc, 0x5b, 0xb0, 0xbc, 0x87,
+	0x0f, 0xb3, 0x64, 0xc7, 0xcf, 0x5e, 0x68, 0x3b, 0x0c, 0x71, 0xf9, 0x42, 0x3a, 0xe3, 0x59, 0xca,
+	0x5b, 0x85, 0x15, 0x8d, 0x57, 0x2c, 0x18, 0xef, 0x00, 0xd9, 0xce, 0xf2, 0x68, 0x1c, 0xe6, 0x54,
+	0x7b, 0x81, 0x8d, 0xbd, 0x93, 0x11, 0x1f, 0x06, 0xfc, 0x06, 0x92, 0x78, 0xf8, 0x54, 0x87, 0xf0,
+	0x79, 0x50, 0xe3, 0x3b, 0xad, 0xbe, 0xc2, 0x58, 0x7f, 0x7e, 0x2a, 0x34, 0xb4, 0x86, 0xdc, 0xfd,
+	0x71, 0x1d, 0x96, 0xf8, 0x20, 0xf2, 0xc7, 0xe1, 0x69, 0x4a, 0x3e, 0x82, 0x45, 0xf1, 0x6f, 0x0c,
+	0x88, 0x9c, 0x7a, 0xe6, 0x3f, 0x4e, 0x70, 0xd7, 0x6d, 0x58, 0xd4, 0x7d, 0xf5, 0xaf, 0xfc, 0xe9,
+	0x7f, 0xfa, 0x9b, 0xb5, 0x2e, 0x69, 0xdf, 0x39, 0x79, 0xfb, 0xce, 0x11, 0x8d, 0x33, 0xcc, 0xe3,
+	0x7b, 0x00, 0xc5, 0x2b, 0xfa, 0xa4, 0xaf, 0xa6, 0x83, 0xf5, 0x9f, 0x0b, 0xdc, 0xcb, 0x15, 0x14,
+	0x91, 0xef, 0x65, 0x96, 0xef, 0xaa, 0xb7, 0x84, 0xf9, 0x46, 0x71, 0x94, 0x73, 0x99, 0x7a, 0xcf,
+	0xb9, 0x45, 0x86, 0xd0, 0xd1, 0x5f, 0xb5, 0x27, 0xf2, 0x9c, 0xbc, 0xe2, 0xe9, 0x7d, 0xf7, 0x4a,
+	0x25, 0x4d, 0x06, 0x09, 0xb0, 0x32, 0xd6, 0xbc, 0x65, 0x2c, 0x63, 0xca, 0x38, 0x8a, 0x52, 0x46,
+	0xb0, 0x64, 0x3e, 0x5e, 0x4f, 0xae, 0x6a, 0x16, 0x45, 0xe9, 0xe9, 0x7c, 0xf7, 0xda, 0x1c, 0xaa,
+	0x28, 0xeb, 0x1a, 0x2b, 0x6b, 0xc3, 0x23, 0x58, 0x16, 0x7f, 0x83, 0x4f, 0x3e, 0x9d, 0xff, 0x9e,
+	0x73, 0xeb, 0xee, 0x9f, 0xfd, 0x2a, 0xb4, 0x54, 0x64, 0x0b, 0xf9, 0xbe, 0x54, 0xe9, 0x32, 0xde,
+	0xf4, 0x8a, 0xb1, 0x16, 0x98, 0xb1, 0xa2, 0xee, 0xd5, 0x6a, 0xa2, 0x28, 0xf8, 0x3a, 0x2b, 0xb8,
+	0x4f, 0xd6, 0xb1, 0x60, 0x11, 0xf6, 0x79, 0x87, 0x05, 0x12, 0xf3, 0x47, 0x1e, 0x9e, 0x6b, 0x86,
+	0x26, 0x2f, 0xec, 0xaa, 0x6d, 0x39, 0x19, 0xa5, 0x5d, 0x9b, 0x43, 0x15, 0xc5, 0x5d, 0x65, 0xc5,
+	0xad, 0x93, 0x4b, 0x7a, 0x71, 0x2a, 0xe2, 0x84, 0xb2, 0x67, 0x39, 0xf4, 0xb7, 0xea, 0xc9, 0x35,
+	0x25, 0x58, 0x55, 0x6f, 0xd8, 0x2b, 0x11, 0x29, 0x3f, 0x42, 0xef, 0xf5, 0x59, 0x51, 0x84, 0xb0,
+	0xe1, 0xd3, 0x9f, 0x99, 0x27, 0xdf, 0x85, 0x96, 0x7a, 0x67, 0x8f, 0x6c, 0x68, 0x2f, 0x59, 0xeb,
+	0xef, 0x08, 0xba, 0xfd, 0x32, 0xa1, 0x4a, 0x30, 0xf4, 0x9c, 0x51, 0x30, 0x76, 0x61, 0x4d, 0x1c,
+	0xb8, 0x1c, 0xd0, 0xcf, 0xd3, 0x92, 0x8a, 0xd7, 0xf1, 0xdf, 0x72, 0xc8, 0x3d, 0x68, 0xca, 0xc7,
+	0xa7, 0xc9, 0x7a, 0xf5, 0x9b, 0xdb, 0xee, 0x46, 0x09, 0x17, 0x33, 0xfd, 0x5d, 0x58, 0x14, 0x2f,
+	0x26, 0xaa, 0x69, 0x6b, 0x3e, 0xe3, 0xe8, 0xae, 0xdb, 0xb0, 0x72, 0xf2, 0xb4, 0xb5, 0x77, 0x91,
+	0xc9, 0x65, 0x15, 0x5c, 0x65, 0xbf, 0xbe, 0xec, 0xba, 0x55, 0x24, 0x2d, 0x97, 0xe2, 0x45, 0xe0,
+	0x22, 0x97, 0xd2, 0xd3, 0xc3, 0xae, 0x5b, 0x45, 0x12, 0xb9, 0x7c, 0x00, 0x5d, 0xe3, 0x65, 0x61,
+	0x25, 0xed, 0x55, 0x8f, 0x18, 0xbb, 0x57, 0xab, 0x89, 0x22, 0xaf, 0x7d, 0x58, 0xb6, 0x5f, 0x08,
+	0x26, 0xd7, 0x65, 0xd9, 0xd5, 0xaf, 0x14, 0xbb, 0xaf, 0xcc, 0xa5, 0x17, 0x15, 0x34, 0x5e, 0x52,
+	0x55, 0x15, 0xac, 0x7a, 0x93, 0xd6, 0xbd, 0x5a, 0x4d, 0x14, 0x79, 0x3d, 0x82, 0x8e, 0xfe, 0x76,
+	0x2a, 0xd1, 0xbb, 0xd7, 0x7a, 0x67, 0xd5, 0xbd, 0x52, 0x49, 0x53, 0x0e, 0xec, 0xa6, 0x7c, 0x1b,
+	0x54, 0x09, 0x8e, 0xf5, 0x0c, 0xaa, 0xbb, 0x51, 0xc2, 0xc5, 0xc7, 0xbf, 0x05, 0x50, 0xbc, 0xce,
+	0xa8, 0x14, 0x74, 0xe9, 0xc5, 0x48, 0xf7, 0x72, 0x05, 0x45, 0xcc, 0x91, 0x75, 0x36, 0x47, 0x96,
+	0x09, 0x53, 0xd0, 0x31, 0x3d, 0x95, 0xf7, 0x48, 0x1f, 0x40, 0x5b, 0xb3, 0x42, 0x94, 0x4c, 0x94,
+	0x4d, 0x1d, 0xd7, 0xad, 0x22, 0x15, 0x5d, 0x6e, 0xbc, 0xb4, 0xa8, 0xba, 0xbc, 0xea, 0x1d, 0x47,
+	0xf7, 0x6a, 0x35, 0x51, 0xe4, 0xf5, 0x1d, 0x68, 0x6b, 0xef, 0x22, 0x12, 0xed, 0xbe, 0xa8, 0xf5,
+	0x22, 0xa2, 0xeb, 0x56, 0x91, 0x44, 0x7b, 0x2f, 0xb1, 0xf6, 0x2e, 0x79, 0x2d, 0x6c, 0x2f, 0x7b,
+	0x8d, 0x07, 0x95, 0xc1, 0xf7, 0x61, 0xc9, 0x7c, 0x29, 0x51, 0x69, 0xcf, 0xca, 0x37, 0x17, 0xdd,
+	0x6b, 0x73, 0xa8, 0xa6, 0xe2, 0xb9, 0xb5, 0xaa, 0x0a, 0xb9, 0xf3, 0xa9, 0x88, 0xed, 0xfd, 0x8c,
+	0x7c, 0x0b, 0x5a, 0xea, 0x79, 0x24, 0xb2, 0xa1, 0xc9, 0x86, 0xfe, 0x88, 0x92, 0xdb, 0x2f, 0x13,
+	0x44, 0xe6, 0x2b, 0x2c, 0xf3, 0x36, 0x29, 0x5a, 0xc0, 0xd7, 0x7d, 0xf6, 0x4c, 0x92, 0xb6, 0xee,
+	0xeb, 0x2f, 0x29, 0xb9, 0xeb, 0x36, 0x5c, 0xbd, 0xee, 0xe7, 0x11, 0xe6, 0x11, 0x43, 0xcf, 0xba,
+	0x64, 0xa3, 0x94, 0x62, 0xf5, 0xdd, 0x4a, 0xf7, 0xfa, 0xcb, 0xef, 0xe6, 0x98, 0xcb, 0x89, 0x5c,
+	0x46, 0xee, 0xc8, 0x0b, 0xc1, 0x7f, 0x81, 0x4f, 0x26, 0x55, 0x98, 0x3e, 0x99, 0xec, 0x92, 0xae,
+	0x54, 0xd2, 0xcc, 0xc1, 0x25, 0x1d, 0xbd, 0x18, 0x1c, 0x5c, 0xf3, 0x89, 0xaf, 0x62, 0x69, 0xac,
+	0x7a, 0xd9, 0xcc, 0xbd, 0x36, 0x87, 0x6a, 0x0e, 0x2e, 0x59, 0x35, 0xda, 0xc2, 0x03, 0xb7, 0xc8,
+	0x77, 0xa0, 0xa7, 0xdd, 0xc3, 0xdb, 0x9f, 0xc5, 0x03, 0x25, 0xa8, 0xe5, 0x47, 0x08, 0xdc, 0x2a,
+	0x6f, 0x93, 0xb7, 0xc1, 0xf2, 0x5f, 0xf1, 0x8c, 0x46, 0xa0, 0x90, 0x6e, 0x41, 0x5b, 0xcb, 0xe3,
+	0x65, 0xf9, 0x6e, 0x68, 0x24, 0xfd, 0xda, 0xf6, 0x5b, 0x0e, 0xd9, 0x83, 0x9e, 0x75, 0x61, 0x58,
+	0x8d, 0x6d, 0xf5, 0x95, 0x66, 0xf7, 0xfa, 0x3c, 0x72, 0xa1, 0xab, 0x4b, 0xef, 0x4a, 0x5c, 0x9f,
+	0xf7, 0x96, 0x82, 0xa5, 0xab, 0xe7, 0xbe, 0x5e, 0xf0, 0x01, 0x74, 0x8d, 0x4b, 0xf6, 0x4a, 0x71,
+	0x54, 0x3d, 0x48, 0xe0, 0x5e, 0xad, 0x26, 0x8a, 0xbc, 0xf6, 0xa0, 0x67, 0xbc, 0xa1, 0x92, 0xa4,
+	0xb6, 0x6d, 0x64, 0xbe, 0xad, 0xe2, 0x5e, 0xa9, 0xa6, 0xb2, 0xb2, 0x6e, 0x3a, 0x6f, 0x39, 0xe4,
+	0xef, 0xe0, 0x3f, 0x19, 0xd1, 0xaf, 0x1d, 0x1a, 0x31, 0x9e, 0x56, 0xe5, 0xfa, 0x3a, 0x4d, 0x1f,
+	0x0d, 0xcf, 0x67, 0x23, 0xbd, 0x7b, 0xeb, 0x03, 0x43, 0x92, 0x3e, 0x35, 0xce, 0x56, 0x6f, 0xdb,
+	0xff, 0x70, 0xe4, 0x33, 0x9b, 0x41, 0x7f, 0x1e, 0xe8, 0xb3, 0xb7, 0x1c, 0xf2, 0xf7, 0x1d, 0x58,
+	0x32, 0x23, 0x02, 0x54, 0x73, 0x2b, 0x63, 0x0f, 0xdc, 0x6b, 0x73, 0xa8, 0x42, 0xde, 0x7f, 0x01,
+	0xb5, 0x24, 0xef, 0xf1, 0x7f, 0xfb, 0x23, 0xc3, 0x53, 0x88, 0x66, 0x19, 0xd9, 0x73, 0x43, 0xff,
+	0x9f, 0x37, 0xac, 0xf3, 0x7f, 0x1b, 0x7a, 0xda, 0xb7, 0x6c, 0x8a, 0x9d, 0xf7, 0x7b, 0xef, 0x35,
+	0xd6, 0x96, 0xeb, 0xde, 0x65, 0xa3, 0x2d, 0xb6, 0x69, 0xb8, 0x09, 0x6d, 0xed, 0x5f, 0xda, 0x14,
+	0x6b, 0x5f, 0xe9, 0xdf, 0xdc, 0xcc, 0xaf, 0xe4, 0x18, 0x7a, 0x1a, 0xbb, 0xa1, 0x07, 0xce, 0x99,
+	0x8d, 0x77, 0x8b, 0xd5, 0xf5, 0x35, 0xef, 0x95, 0xb9, 0x75, 0xbd, 0xc3, 0xce, 0xf3, 0xb1, 0xc6,
+	0x7b, 0x00, 0x45, 0x28, 0x19, 0xb1, 0x42, 0x99, 0xd4, 0xf2, 0x5f, 0x8e, 0x36, 0x33, 0x95, 0x8d,
+	0x8c, 0x78, 0xc2, 0x1c, 0xbf, 0xcb, 0x75, 0xb2, 0xe0, 0xcf, 0x0c, 0xd3, 0xd2, 0x8c, 0xf9, 0x72,
+	0xdd, 0x2a, 0x52, 0x95, 0x46, 0x96, 0xf9, 0x93, 0x8f, 0xa1, 0xbb, 0x9b, 0x24, 0xcf, 0xa7, 0x13,
+	0x59, 0x63, 0x62, 0x86, 0xda, 0x60, 0x64, 0x9a, 0x6b, 0xb5, 0xc2, 0xbb, 0xc1, 0xb2, 0x72, 0x49,
+	0x5f, 0xcb, 0xea, 0xce, 0xa7, 0x45, 0xa8, 0xda, 0x67, 0x24, 0x84, 0x15, 0x65, 0xd2, 0xab, 0x8a,
+	0xbb, 0x66, 0x36, 0x7a, 0x90, 0x55, 0xa9, 0x08, 0x63, 0x93, 0x25, 0x6b, 0x7b, 0x27, 0x93, 0x79,
+	0x32, 0xf5, 0xd9, 0x79, 0x40, 0x07, 0xc9, 0x90, 0x8a, 0x78, 0x95, 0xd5, 0xa2, 0xe2, 0x2a, 0xd0,
+	0xc5, 0xed, 0x1a, 0xa0, 0xb9, 0xf8, 0x4d, 0xc2, 0x59, 0x4a, 0x7f, 0x70, 0xe7, 0x53, 0x11, 0x09,
+	0xf3, 0x99, 0x5c, 0xfc, 0x44, 0xcb, 0xcd, 0xc5, 0xcf, 0x8a, 0x2d, 0x72, 0xaf, 0x54, 0xd2, 0xaa,
+	0xba, 0x5a, 0x86, 0x2a, 0x91, 0x11, 0xac, 0x94, 0xc2, 0x91, 0x88, 0x54, 0xbf, 0xf3, 0x82, 0x98,
+	0xdc, 0x1b, 0xf3, 0x19, 0xcc, 0xd2, 0x6e, 0x99, 0xa5, 0xed, 0x43, 0x97, 0x3b, 0x6f, 0x0e, 0x28,
+	0xbf, 0xfd, 0x61, 0x3d, 0x99, 0xa9, 0xdf, 0x14, 0x71, 0x57, 0x2b, 0x68, 0xa6, 0x75, 0xc3, 0xae,
+	0x5e, 0x90, 0xef, 0x42, 0xfb, 0x11, 0xcd, 0xe5, 0x75, 0x0f, 0x65, 0x25, 0x5b, 0xf7, 0x3f, 0xdc,
+	0x8a, 0xdb, 0x22, 0xa6, 0xcc, 0xb0, 0xdc, 0xee, 0xe0, 0xfd, 0x11, 0xae, 0x9c, 0x82, 0x68, 0xf8,
+	0x19, 0xf9, 0x4d, 0x96, 0xb9, 0xba, 0x3d, 0xb6, 0xae, 0xdd, 0x12, 0xd0, 0x33, 0xef, 0x59, 0x78,
+	0x55, 0xce, 0x71, 0x32, 0xa4, 0x9a, 0x9d, 0x17, 0x43, 0x5b, 0xbb, 0xda, 0xa8, 0x26, 0x50, 0xf9,
+	0x9a, 0xa6, 0xeb, 0x56, 0x91, 0x44, 0x3f, 0xdf, 0x64, 0xe5, 0x78, 0xe4, 0x46, 0x51, 0x0e, 0x9b,
+	0xf5, 0x9a, 0x45, 0x79, 0xe7, 0xd3, 0x70, 0x9c, 0x7f, 0x46, 0x3e, 0x61, 0xcf, 0x67, 0xea, 0x57,
+	0x5a, 0x0a, 0xb3, 0xdf, 0xbe, 0xfd, 0xe2, 0x92, 0x32, 0xc9, 0xdc, 0x0a, 0xf0, 0xa2, 0x98, 0x39,
+	0xf8, 0x35, 0x00, 0xbc, 0x94, 0xf1, 0x20, 0xa4, 0xe3, 0x24, 0x2e, 0x74, 0x6d, 0x71, 0x6d, 0xc3,
+	0x5d, 0x35, 0x30, 0xb1, 0xec, 0x7e, 0xa2, 0x6d, 0xb0, 0xf5, 0x21, 0x26, 0x52, 0xb8, 0xe6, 0xde,
+	0xec, 0x70, 0xdd, 0x2a, 0x0e, 0x65, 0xc2, 0x6c, 0x02, 0x14, 0xf1, 0x68, 0x6a, 0xd7, 0x53, 0x0a,
+	0x75, 0x73, 0x2f, 0x57, 0x50, 0x94, 0x49, 0xd0, 0x2a, 0x02, 0x9c, 0x36, 0x8a, 0xeb, 0xa9, 0x46,
+	0x38, 0x94, 0xdb, 0x2f, 0x13, 0xc4, 0xa8, 0x2c, 0xb3, 0xae, 0x02, 0xd2, 0xc4, 0xae, 0x62, 0xb1,
+	0x44, 0x11, 0xac, 0xf2, 0x0a, 0x2a, 0x5b, 0x8e, 0x5d, 0x44, 0x90, 0x2d, 0xa9, 0x08, 0xfd, 0x71,
+	0xaf, 0x54, 0xd2, 0xaa, 0x1c, 0x67, 0x28, 0xad, 0xfc, 0x12, 0x04, 0xaa, 0xe6, 0x31, 0xac, 0x94,
+	0xc2, 0x3e, 0xd4, 0x94, 0x9e, 0x17, 0x6d, 0xe3, 0xde, 0x98, 0xcf, 0x20, 0x8a, 0x5c, 0x63, 0x45,
+	0xf6, 0x3c, 0xc0, 0x22, 0xb3, 0xd3, 0x28, 0x1f, 0x1c, 0x63, 0x71, 0xf7, 0xa0, 0x29, 0xe3, 0x31,
+	0xd4, 0xf4, 0xb0, 0xa2, 0x3c, 0xdc, 0x8d, 0x12, 0x5e, 0xb8, 0x16, 0xb4, 0x80, 0x0b, 0x25, 0x91,
+	0xe5, 0x60, 0x0e, 0xd7, 0xad, 0x22, 0xa9, 0x97, 0x95, 0xa0, 0x38, 0xfa, 0x27, 0xfa, 0xd6, 0xc8,
+	0x08, 0xd9, 0x70, 0x2f, 0x57, 0x50, 0x0a, 0x2b, 0xd5, 0x3e, 0xe5, 0x57, 0x56, 0xea, 0x9c, 0xd0,
+	0x00, 0xf7, 0x95, 0xb9, 0x74, 0x95, 0xe9, 0x6a, 0xc5, 0x81, 0x38, 0x79, 0x55, 0x7c, 0x37, 0xff,
+	0xb0, 0xdc, 0x9d, 0xff, 0xbf, 0x4c, 0xc9, 0x13, 0x58, 0xb6, 0x0f, 0x50, 0xc9, 0x7c, 0x76, 0x55,
+	0xc9, 0x79, 0x87, 0xae, 0xe4, 0xdb, 0xea, 0x80, 0xd3, 0x3a, 0x89, 0x7e, 0x45, 0xf5, 0x78, 0xf5,
+	0x89, 0xac, 0x7b, 0xd5, 0x64, 0x30, 0xf3, 0xbd, 0xfb, 0xf7, 0x1a, 0xd0, 0xf1, 0xd9, 0x6b, 0x24,
+	0xb8, 0xf3, 0xa7, 0x78, 0x0c, 0xd8, 0xc5, 0x5f, 0xc2, 0x95, 0x13, 0x9e, 0x2a, 0x3b, 0x44, 0x1c,
+	0x23, 0xb9, 0x3d, 0x23, 0x9d, 0x4d, 0xc8, 0x6f, 0xe0, 0x1b, 0xb0, 0xe3, 0xc9, 0x34, 0xa7, 0xfa,
+	0xd9, 0x8e, 0xfd, 0xd9, 0x7a, 0xc5, 0x39, 0x0c, 0x7e, 0x7d, 0x00, 0x6b, 0x95, 0x67, 0x1a, 0xe4,
+	0x4b, 0x46, 0xff, 0x57, 0x9f, 0x88, 0xb8, 0xaf, 0xbd, 0x9c, 0x49, 0xed, 0x43, 0x0c, 0x37, 0xc8,
+	0xd5, 0xe2, 0x90, 0xa2, 0xc2, 0x13, 0x72, 0x6d, 0x0e, 0x55, 0xe4, 0xb5, 0x05, 0x5d, 0xe3, 0x10,
+	0x85, 0x54, 0x1e, 0x79, 0xa8, 0x5e, 0xaf, 0x3e, 0x70, 0xf9, 0xaa, 0xcc, 0xe4, 0x09, 0x7d, 0x81,
+	0x47, 0x85, 0xa4, 0x5b, 0x64, 0x82, 0x9d, 0x55, 0x99, 0x27, 0xf9, 0x2a, 0xb4, 0xf8, 0x57, 0xf8,
+	0x45, 0xf9, 0x10, 0x79, 0xce, 0x57, 0xef, 0x03, 0xec, 0x0f, 0xc2, 0x51, 0x98, 0xe2, 0xb1, 0x7f,
+	0xe1, 0x80, 0xb5, 0xce, 0x72, 0xdc, 0x7e, 0x99, 0x20, 0x44, 0xe4, 0x5f, 0x38, 0x70, 0xf1, 0xff,
+	0x93, 0x70, 0x3c, 0x80, 0x1e, 0x6f, 0xb1, 0xaa, 0xd5, 0x17, 0x69, 0xc0, 0xef, 0xd4, 0xa0, 0x25,
+	0x4e, 0x65, 0xa3, 0xfc, 0x97, 0xda, 0xf7, 0x8f, 0x80, 0xc8, 0x13, 0x26, 0xcd, 0x07, 0x2a, 0x9b,
+	0x60, 0x1f, 0x54, 0xb9, 0xfd, 0x32, 0xa1, 0xd0, 0xc0, 0xda, 0xe9, 0x92, 0xd2, 0x24, 0xe5, 0x93,
+	0x2a, 0xd7, 0xad, 0x22, 0xf1, 0x5c, 0x0e, 0x2e, 0xb2, 0xff, 0xc4, 0xfd, 0x95, 0xff, 0x37, 0x00,
+	0xfe, 0xf6, 0xef, 0x34, 0xbb, 0x7b, 0x00, 0x00,
 }
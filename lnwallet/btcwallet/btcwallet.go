@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +27,10 @@ import (
 
 const (
 	defaultAccount = uint32(waddrmgr.DefaultAccountNum)
+
+	// walletFileName is the name of the database file the wallet loader
+	// stores the wallet in within the network directory.
+	walletFileName = "wallet.db"
 )
 
 var (
@@ -99,7 +106,10 @@ func New(cfg Config) (*BtcWallet, error) {
 			return nil, err
 		}
 
-		if !walletExists {
+		if !walletExists && cfg.WatchOnly {
+			return nil, fmt.Errorf("watch-only wallet must be " +
+				"imported before it can be opened")
+		} else if !walletExists {
 			// Wallet has never been created, perform initial
 			// set up.
 			wallet, err = loader.CreateNewWallet(
@@ -131,6 +141,38 @@ func New(cfg Config) (*BtcWallet, error) {
 	}, nil
 }
 
+// WalletExists returns whether a wallet has been created within the network
+// directory of the passed data directory.
+func WalletExists(dataDir string, netParams *chaincfg.Params) (bool, error) {
+	netDir := NetworkDir(dataDir, netParams)
+	loader := base.NewLoader(netParams, netDir, 0)
+
+	return loader.WalletExists()
+}
+
+// ImportWatchOnlyWallet writes the passed watch-only wallet database, as
+// exported by a remote signer, to the network directory within the passed data
+// directory. An existing wallet is never overwritten.
+func ImportWatchOnlyWallet(dataDir string, netParams *chaincfg.Params,
+	walletDB []byte) error {
+
+	walletExists, err := WalletExists(dataDir, netParams)
+	if err != nil {
+		return err
+	}
+	if walletExists {
+		return fmt.Errorf("wallet already exists")
+	}
+
+	netDir := NetworkDir(dataDir, netParams)
+	if err := os.MkdirAll(netDir, 0700); err != nil {
+		return err
+	}
+
+	dbPath := filepath.Join(netDir, walletFileName)
+	return ioutil.WriteFile(dbPath, walletDB, 0600)
+}
+
 // BackEnd returns the underlying ChainService's name as a string.
 //
 // This is a part of the WalletController interface.
@@ -166,6 +208,17 @@ func (b *BtcWallet) Start() error {
 	// current main chain.
 	b.wallet.SynchronizeRPC(b.chain)
 
+	// A watch-only wallet can't be unlocked, and doesn't need the
+	// KeyScope below, as all keys for contracts are derived by the remote
+	// signer.
+	if b.cfg.WatchOnly {
+		if !b.wallet.Manager.WatchOnly() {
+			return fmt.Errorf("wallet isn't watch-only")
+		}
+
+		return nil
+	}
+
 	if err := b.wallet.Unlock(b.cfg.PrivatePass, nil); err != nil {
 		return err
 	}
@@ -342,9 +395,10 @@ func (b *BtcWallet) ListUnspentWitness(minConfs int32) ([]*lnwallet.Utxo, error)
 			}
 
 			utxo := &lnwallet.Utxo{
-				AddressType: addressType,
-				Value:       amt,
-				PkScript:    pkScript,
+				AddressType:   addressType,
+				Value:         amt,
+				Confirmations: output.Confirmations,
+				PkScript:      pkScript,
 				OutPoint: wire.OutPoint{
 					Hash:  *txid,
 					Index: output.Vout,
@@ -546,20 +600,24 @@ func unminedTransactionsToDetail(
 }
 
 // ListTransactionDetails returns a list of all transactions which are
-// relevant to the wallet.
+// relevant to the wallet and confirmed between startHeight and endHeight,
+// inclusive. If endHeight is -1, unconfirmed transactions are returned as
+// well.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
+func (b *BtcWallet) ListTransactionDetails(startHeight, endHeight int32) (
+	[]*lnwallet.TransactionDetail, error) {
+
 	// Grab the best block the wallet knows of, we'll use this to calculate
 	// # of confirmations shortly below.
 	bestBlock := b.wallet.Manager.SyncedTo()
 	currentHeight := bestBlock.Height
 
-	// We'll attempt to find all unconfirmed transactions (height of -1),
-	// as well as all transactions that are known to have confirmed at this
-	// height.
-	start := base.NewBlockIdentifierFromHeight(0)
-	stop := base.NewBlockIdentifierFromHeight(-1)
+	// An end height of -1 makes the base wallet return all transactions
+	// confirmed from the start height onwards, as well as all unconfirmed
+	// transactions.
+	start := base.NewBlockIdentifierFromHeight(startHeight)
+	stop := base.NewBlockIdentifierFromHeight(endHeight)
 	txns, err := b.wallet.GetTransactions(start, stop, nil)
 	if err != nil {
 		return nil, err
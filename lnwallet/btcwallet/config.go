@@ -96,6 +96,12 @@ type Config struct {
 	// encrypted at all, in which case it should be attempted to be loaded
 	// normally when creating the BtcWallet.
 	Wallet *wallet.Wallet
+
+	// WatchOnly indicates that the wallet holds no private keys, as they
+	// are kept by a remote signer. Such a wallet must have been imported
+	// with ImportWatchOnlyWallet, is never unlocked and can't sign for its
+	// outputs itself.
+	WatchOnly bool
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
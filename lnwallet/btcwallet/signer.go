@@ -44,6 +44,13 @@ func (b *BtcWallet) FetchInputInfo(prevOut *wire.OutPoint) (*wire.TxOut, error)
 		return nil, lnwallet.ErrNotMine
 	}
 
+	numOutputs := uint32(len(txDetail.TxRecord.MsgTx.TxOut))
+	if prevOut.Index >= numOutputs {
+		return nil, errors.Errorf("invalid output index %v for "+
+			"transaction with %v outputs", prevOut.Index,
+			numOutputs)
+	}
+
 	output = txDetail.TxRecord.MsgTx.TxOut[prevOut.Index]
 
 	b.cacheMtx.Lock()
@@ -75,6 +82,34 @@ func (b *BtcWallet) fetchOutputAddr(script []byte) (waddrmgr.ManagedAddress, err
 	return nil, errors.Errorf("address not found")
 }
 
+// KeyPath returns the key scope and BIP0044 derivation path of the wallet key
+// the passed output script pays to. A watch-only wallet uses it to let its
+// remote signer derive the key needed to spend the output.
+func (b *BtcWallet) KeyPath(pkScript []byte) (waddrmgr.KeyScope,
+	waddrmgr.DerivationPath, error) {
+
+	walletAddr, err := b.fetchOutputAddr(pkScript)
+	if err != nil {
+		return waddrmgr.KeyScope{}, waddrmgr.DerivationPath{}, err
+	}
+
+	pubKeyAddr, ok := walletAddr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return waddrmgr.KeyScope{}, waddrmgr.DerivationPath{},
+			errors.Errorf("address %v isn't a public key address",
+				walletAddr.Address())
+	}
+
+	scope, path, ok := pubKeyAddr.DerivationInfo()
+	if !ok {
+		return waddrmgr.KeyScope{}, waddrmgr.DerivationPath{},
+			errors.Errorf("address %v wasn't derived from the "+
+				"wallet's seed", walletAddr.Address())
+	}
+
+	return scope, path, nil
+}
+
 // fetchPrivKey attempts to retrieve the raw private key corresponding to the
 // passed public key if populated, or the key descriptor path (if non-empty).
 func (b *BtcWallet) fetchPrivKey(keyDesc *keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
@@ -1860,6 +1860,13 @@ type HtlcRetribution struct {
 	// update the SignDesc above accordingly to sweep properly.
 	SecondLevelWitnessScript []byte
 
+	// SecondLevelTx is the unsigned second level HTLC transaction that the
+	// remote party would broadcast in order to move this HTLC to the
+	// second level. As the transaction's witness does not commit to its
+	// txid, this allows the output paying to SecondLevelWitnessScript to
+	// be swept before the transaction is ever broadcast.
+	SecondLevelTx *wire.MsgTx
+
 	// IsIncoming is a boolean flag that indicates whether or not this
 	// HTLC was accepted from the counterparty. A false value indicates that
 	// this HTLC was offered by us. This flag is used determine the exact
@@ -1925,6 +1932,15 @@ type BreachRetribution struct {
 	// HtlcRetributions is a slice of HTLC retributions for each output
 	// active HTLC output within the breached commitment transaction.
 	HtlcRetributions []HtlcRetribution
+
+	// KeyRing contains the derived public keys used to construct the
+	// breaching commitment transaction. This allows downstream clients to
+	// have access to the public keys used in the scripts.
+	KeyRing *CommitmentKeyRing
+
+	// RemoteDelay specifies the CSV delay applied to to-local scripts on
+	// the breaching commitment transaction.
+	RemoteDelay uint32
 }
 
 // NewBreachRetribution creates a new fully populated BreachRetribution for the
@@ -2068,9 +2084,17 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			return nil, err
 		}
 
+		htlcOutPoint := wire.OutPoint{
+			Hash:  commitHash,
+			Index: uint32(htlc.OutputIndex),
+		}
+		feePerKw := SatPerKWeight(revokedSnapshot.FeePerKw)
+
 		// If this is an incoming HTLC, then this means that they were
 		// the sender of the HTLC (relative to us). So we'll
-		// re-generate the sender HTLC script.
+		// re-generate the sender HTLC script, along with the timeout
+		// transaction they'd use to go to the second level.
+		var secondLevelTx *wire.MsgTx
 		if htlc.Incoming {
 			htlcWitnessScript, err = senderHTLCScript(
 				keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
@@ -2080,10 +2104,22 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 				return nil, err
 			}
 
+			secondLevelTx, err = createHtlcTimeoutTx(
+				htlcOutPoint,
+				htlc.Amt.ToSatoshis()-htlcTimeoutFee(feePerKw),
+				htlc.RefundTimeout, remoteDelay,
+				keyRing.RevocationKey, keyRing.DelayKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 		} else {
 			// Otherwise, is this was an outgoing HTLC that we
 			// sent, then from the PoV of the remote commitment
-			// state, they're the receiver of this HTLC.
+			// state, they're the receiver of this HTLC, and would
+			// go to the second level using the success
+			// transaction.
 			htlcWitnessScript, err = receiverHTLCScript(
 				htlc.RefundTimeout, keyRing.LocalHtlcKey,
 				keyRing.RemoteHtlcKey, keyRing.RevocationKey,
@@ -2092,6 +2128,16 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			if err != nil {
 				return nil, err
 			}
+
+			secondLevelTx, err = createHtlcSuccessTx(
+				htlcOutPoint,
+				htlc.Amt.ToSatoshis()-htlcSuccessFee(feePerKw),
+				remoteDelay, keyRing.RevocationKey,
+				keyRing.DelayKey,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		htlcPkScript, err := WitnessScriptHash(htlcWitnessScript)
@@ -2110,11 +2156,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 				},
 				HashType: txscript.SigHashAll,
 			},
-			OutPoint: wire.OutPoint{
-				Hash:  commitHash,
-				Index: uint32(htlc.OutputIndex),
-			},
+			OutPoint:                 htlcOutPoint,
 			SecondLevelWitnessScript: secondLevelWitnessScript,
+			SecondLevelTx:            secondLevelTx,
 			IsIncoming:               htlc.Incoming,
 		})
 	}
@@ -2133,6 +2177,8 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		RemoteOutpoint:       remoteOutpoint,
 		RemoteOutputSignDesc: remoteSignDesc,
 		HtlcRetributions:     htlcRetributions,
+		KeyRing:              keyRing,
+		RemoteDelay:          remoteDelay,
 	}, nil
 }
 
@@ -5725,6 +5771,60 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 	}, nil
 }
 
+// CoopCloseRBFSequence is the sequence number of the funding input of
+// replaceable cooperative close transactions, which signals replaceability as
+// defined by BIP 125.
+const CoopCloseRBFSequence = wire.MaxTxInSequenceNum - 2
+
+// coopCloseOptions houses the options that modify the cooperative close
+// transaction created for a channel.
+type coopCloseOptions struct {
+	// replaceable indicates whether the close transaction signals
+	// replaceability.
+	replaceable bool
+}
+
+// CoopCloseOption is a functional option that modifies the cooperative close
+// transaction created for a channel.
+type CoopCloseOption func(*coopCloseOptions)
+
+// ReplaceableCoopClose is a CoopCloseOption that makes the close transaction
+// signal replaceability, which allows its fee to be re-negotiated later on.
+// Both parties must use the option, as it alters the signed transaction.
+func ReplaceableCoopClose() CoopCloseOption {
+	return func(o *coopCloseOptions) {
+		o.replaceable = true
+	}
+}
+
+// coopCloseTxIn returns the input of a cooperative close transaction spending
+// the funding output, modified by the passed options.
+func (lc *LightningChannel) coopCloseTxIn(opts []CoopCloseOption) wire.TxIn {
+	var closeOpts coopCloseOptions
+	for _, opt := range opts {
+		opt(&closeOpts)
+	}
+
+	txIn := lc.fundingTxIn()
+	if closeOpts.replaceable {
+		txIn.Sequence = CoopCloseRBFSequence
+	}
+
+	return txIn
+}
+
+// IsReplaceableCoopClose returns whether the passed cooperative close
+// transaction signals replaceability.
+func IsReplaceableCoopClose(closeTx *wire.MsgTx) bool {
+	for _, txIn := range closeTx.TxIn {
+		if txIn.Sequence < wire.MaxTxInSequenceNum-1 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -5736,8 +5836,9 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer Signer,
 // TODO(roasbeef): caller should initiate signal to reject all incoming HTLCs,
 // settle any in flight.
 func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
-	localDeliveryScript []byte,
-	remoteDeliveryScript []byte) ([]byte, *chainhash.Hash, btcutil.Amount, error) {
+	localDeliveryScript []byte, remoteDeliveryScript []byte,
+	opts ...CoopCloseOption) ([]byte, *chainhash.Hash, btcutil.Amount,
+	error) {
 
 	lc.Lock()
 	defer lc.Unlock()
@@ -5764,7 +5865,7 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
 		theirBalance = theirBalance - proposedFee + commitFee
 	}
 
-	closeTx := CreateCooperativeCloseTx(lc.fundingTxIn(),
+	closeTx := CreateCooperativeCloseTx(lc.coopCloseTxIn(opts),
 		lc.localChanCfg.DustLimit, lc.remoteChanCfg.DustLimit,
 		ourBalance, theirBalance, localDeliveryScript,
 		remoteDeliveryScript, lc.channelState.IsInitiator)
@@ -5804,7 +5905,8 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
 // signatures including the proper sighash byte.
 func (lc *LightningChannel) CompleteCooperativeClose(localSig, remoteSig []byte,
 	localDeliveryScript, remoteDeliveryScript []byte,
-	proposedFee btcutil.Amount) (*wire.MsgTx, btcutil.Amount, error) {
+	proposedFee btcutil.Amount,
+	opts ...CoopCloseOption) (*wire.MsgTx, btcutil.Amount, error) {
 
 	lc.Lock()
 	defer lc.Unlock()
@@ -5834,7 +5936,7 @@ func (lc *LightningChannel) CompleteCooperativeClose(localSig, remoteSig []byte,
 	// Create the transaction used to return the current settled balance
 	// on this active channel back to both parties. In this current model,
 	// the initiator pays full fees for the cooperative close transaction.
-	closeTx := CreateCooperativeCloseTx(lc.fundingTxIn(),
+	closeTx := CreateCooperativeCloseTx(lc.coopCloseTxIn(opts),
 		lc.localChanCfg.DustLimit, lc.remoteChanCfg.DustLimit,
 		ourBalance, theirBalance, localDeliveryScript,
 		remoteDeliveryScript, lc.channelState.IsInitiator)
@@ -6202,6 +6304,18 @@ func (lc *LightningChannel) MarkCommitmentBroadcasted() error {
 	return lc.channelState.MarkCommitmentBroadcasted()
 }
 
+// MarkCoopBroadcasted marks the channel as having its cooperative close
+// transaction broadcast, and stores the passed close info so the close
+// transaction can be rebroadcast and fee bumped until it confirms.
+func (lc *LightningChannel) MarkCoopBroadcasted(
+	info *channeldb.CoopCloseInfo) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.channelState.MarkCoopBroadcasted(info)
+}
+
 // ActiveHtlcs returns a slice of HTLC's which are currently active on *both*
 // commitment transactions.
 func (lc *LightningChannel) ActiveHtlcs() []channeldb.HTLC {
@@ -529,6 +529,73 @@ func TestCooperativeChannelClosure(t *testing.T) {
 	}
 }
 
+// TestCooperativeCloseReplaceable tests that the cooperative close
+// transaction signals replaceability if both parties create it with the
+// ReplaceableCoopClose option, and that a party that doesn't use the option
+// rejects the other party's signature.
+func TestCooperativeCloseReplaceable(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	aliceDeliveryScript := bobsPrivKey[:]
+	bobDeliveryScript := testHdSeed[:]
+
+	feeRate := SatPerKWeight(
+		aliceChannel.channelState.LocalCommitment.FeePerKw,
+	)
+	fee := aliceChannel.CalcFee(feeRate)
+
+	aliceSig, _, _, err := aliceChannel.CreateCloseProposal(
+		fee, aliceDeliveryScript, bobDeliveryScript,
+		ReplaceableCoopClose(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice coop close proposal: %v", err)
+	}
+	aliceCloseSig := append(aliceSig, byte(txscript.SigHashAll))
+
+	bobSig, _, _, err := bobChannel.CreateCloseProposal(
+		fee, bobDeliveryScript, aliceDeliveryScript,
+		ReplaceableCoopClose(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create bob coop close proposal: %v", err)
+	}
+	bobCloseSig := append(bobSig, byte(txscript.SigHashAll))
+
+	// Without the option, Alice would create a different transaction, so
+	// Bob's signature shouldn't be valid for it.
+	_, _, err = aliceChannel.CompleteCooperativeClose(
+		aliceCloseSig, bobCloseSig, aliceDeliveryScript,
+		bobDeliveryScript, fee,
+	)
+	if err == nil {
+		t.Fatalf("expected signature for replaceable close tx to " +
+			"be rejected")
+	}
+
+	closeTx, _, err := aliceChannel.CompleteCooperativeClose(
+		aliceCloseSig, bobCloseSig, aliceDeliveryScript,
+		bobDeliveryScript, fee, ReplaceableCoopClose(),
+	)
+	if err != nil {
+		t.Fatalf("unable to complete cooperative close: %v", err)
+	}
+
+	if !IsReplaceableCoopClose(closeTx) {
+		t.Fatalf("close tx doesn't signal replaceability")
+	}
+	if closeTx.TxIn[0].Sequence != CoopCloseRBFSequence {
+		t.Fatalf("expected sequence %v, got %v", CoopCloseRBFSequence,
+			closeTx.TxIn[0].Sequence)
+	}
+}
+
 // TestForceClose checks that the resulting ForceCloseSummary is correct when a
 // peer is ForceClosing the channel. Will check outputs both above and below
 // the dust limit. Additionally, we'll ensure that the node which executed the
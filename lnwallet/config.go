@@ -54,4 +54,8 @@ type Config struct {
 	// NetParams is the set of parameters that tells the wallet which chain
 	// it will be operating on.
 	NetParams chaincfg.Params
+
+	// WatchOnly indicates that the WalletController holds no private
+	// keys, so all inputs must be signed by the Signer.
+	WatchOnly bool
 }
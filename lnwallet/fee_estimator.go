@@ -2,10 +2,19 @@ package lnwallet
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 )
 
 const (
@@ -413,3 +422,625 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 // A compile-time assertion to ensure that BitcoindFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
+
+const (
+	// DefaultWebAPIPollInterval is the default interval at which the
+	// WebAPIFeeEstimator refreshes its fee estimates.
+	DefaultWebAPIPollInterval = 5 * time.Minute
+
+	// webAPITimeout is the time after which a request to a fee estimation
+	// web API is given up.
+	webAPITimeout = 10 * time.Second
+)
+
+// webAPIResponse is the JSON document served by a fee estimation web API. It
+// maps confirmation targets in blocks to fee rates in sat/kvB, e.g.:
+//
+//   {"fee_by_block_target": {"2": 20000, "6": 12000, "144": 1000}}
+type webAPIResponse struct {
+	FeeByBlockTarget map[uint32]uint32 `json:"fee_by_block_target"`
+}
+
+// WebAPIFeeEstimator is an implementation of the FeeEstimator interface that
+// polls an HTTP endpoint for fee estimates. This allows nodes without a full
+// node backend, such as neutrino light clients, to use live fee estimates.
+// The last valid response is cached, so a temporarily unavailable endpoint
+// doesn't affect the estimates. Until the first valid response has been
+// received, a static fallback fee rate is returned.
+type WebAPIFeeEstimator struct {
+	url string
+
+	pollInterval time.Duration
+
+	// fallbackFeePerKW is the fee rate in sat/kw that is returned if no
+	// estimates could be fetched from the endpoint yet.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	// Any estimate below it is raised to this fee rate, so our
+	// transactions are relayed by the network.
+	minFeePerKW SatPerKWeight
+
+	client *http.Client
+
+	// feeByBlockTarget holds the fee rates of the last valid response,
+	// indexed by confirmation target.
+	feeByBlockTarget map[uint32]SatPerKWeight
+	feesMtx          sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWebAPIFeeEstimator creates a new WebAPIFeeEstimator which fetches fee
+// estimates from the given URL every pollInterval. The fallback fee rate is
+// used until the first estimates have been fetched successfully.
+func NewWebAPIFeeEstimator(url string, pollInterval time.Duration,
+	fallBackFeeRate SatPerKWeight) *WebAPIFeeEstimator {
+
+	return &WebAPIFeeEstimator{
+		url:              url,
+		pollInterval:     pollInterval,
+		fallbackFeePerKW: fallBackFeeRate,
+		minFeePerKW:      FeePerKwFloor,
+		client:           &http.Client{Timeout: webAPITimeout},
+		quit:             make(chan struct{}),
+	}
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) Start() error {
+	// We'll fetch the initial estimates right away, but won't fail if the
+	// endpoint is unavailable, as we'll keep retrying in the background.
+	if err := w.updateFeeEstimates(); err != nil {
+		walletLog.Warnf("Unable to fetch fee estimates from %v, using "+
+			"fallback fee rate of %v sat/kw: %v", w.url,
+			int64(w.fallbackFeePerKW), err)
+	}
+
+	w.wg.Add(1)
+	go w.feeUpdateManager()
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) Stop() error {
+	close(w.quit)
+	w.wg.Wait()
+
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw. The fee rate
+// of the highest confirmation target that doesn't exceed numBlocks is used.
+// If numBlocks is below every known target, the fee rate of the lowest target
+// is returned instead.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	w.feesMtx.RLock()
+	defer w.feesMtx.RUnlock()
+
+	if len(w.feeByBlockTarget) == 0 {
+		return w.fallbackFeePerKW, nil
+	}
+
+	satPerKw := feeForTarget(w.feeByBlockTarget, numBlocks)
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// feeForTarget returns the fee rate of the highest confirmation target in the
+// non-empty feeByBlockTarget map that doesn't exceed numBlocks. If numBlocks
+// is below every target, the fee rate of the lowest target is returned.
+func feeForTarget(feeByBlockTarget map[uint32]SatPerKWeight,
+	numBlocks uint32) SatPerKWeight {
+
+	var closest, lowest uint32
+	for target := range feeByBlockTarget {
+		if target <= numBlocks && target > closest {
+			closest = target
+		}
+		if lowest == 0 || target < lowest {
+			lowest = target
+		}
+	}
+	if closest == 0 {
+		closest = lowest
+	}
+
+	return feeByBlockTarget[closest]
+}
+
+// feeUpdateManager periodically refreshes the fee estimates.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *WebAPIFeeEstimator) feeUpdateManager() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.updateFeeEstimates(); err != nil {
+				walletLog.Warnf("Unable to update fee estimates "+
+					"from %v: %v", w.url, err)
+			}
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// updateFeeEstimates fetches the current fee estimates from the endpoint. The
+// cached estimates are only replaced if the response is valid.
+func (w *WebAPIFeeEstimator) updateFeeEstimates() error {
+	resp, err := w.client.Get(w.url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status: %v", resp.Status)
+	}
+
+	var feeResp webAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&feeResp); err != nil {
+		return fmt.Errorf("unable to decode response: %v", err)
+	}
+
+	feeByBlockTarget := make(map[uint32]SatPerKWeight)
+	for target, feeRate := range feeResp.FeeByBlockTarget {
+		if target == 0 || feeRate == 0 {
+			continue
+		}
+
+		// The fee rates are expressed in sat/kvB, so we'll convert
+		// them to sat/kw, and enforce our fee floor.
+		satPerKw := SatPerKVByte(feeRate).FeePerKWeight()
+		if satPerKw < w.minFeePerKW {
+			satPerKw = w.minFeePerKW
+		}
+		feeByBlockTarget[target] = satPerKw
+	}
+	if len(feeByBlockTarget) == 0 {
+		return fmt.Errorf("response contains no fee estimates")
+	}
+
+	w.feesMtx.Lock()
+	w.feeByBlockTarget = feeByBlockTarget
+	w.feesMtx.Unlock()
+
+	walletLog.Debugf("Updated fee estimates from %v: %v", w.url,
+		newLogClosure(func() string {
+			return spew.Sdump(feeByBlockTarget)
+		}))
+
+	return nil
+}
+
+// A compile-time assertion to ensure that WebAPIFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*WebAPIFeeEstimator)(nil)
+
+const (
+	// DefaultNeutrinoFeeWindow is the default number of recent blocks the
+	// NeutrinoFeeEstimator derives its fee estimates from.
+	DefaultNeutrinoFeeWindow = 6
+
+	// DefaultNeutrinoFeePollInterval is the default interval at which the
+	// NeutrinoFeeEstimator checks the chain for new blocks.
+	DefaultNeutrinoFeePollInterval = time.Minute
+
+	// minNeutrinoFeeSamples is the minimum number of transactions with a
+	// known fee the NeutrinoFeeEstimator needs in its window before it
+	// serves estimates from them rather than the fallback fee rate. As the
+	// highest conf target uses the 90th percentile, a smaller sample would
+	// let a handful of transactions determine the estimate.
+	minNeutrinoFeeSamples = 100
+)
+
+// confTargetPercentiles maps confirmation targets to the percentile of the
+// fee rates seen in recent blocks that is used as estimate for them. The
+// lower the target, the higher the fee rate we'll pay to make it into one of
+// the next blocks.
+var confTargetPercentiles = map[uint32]float64{
+	1:   0.9,
+	2:   0.75,
+	3:   0.6,
+	6:   0.5,
+	12:  0.3,
+	25:  0.2,
+	144: 0.1,
+}
+
+// FeeBlockSource is the source of recent blocks the NeutrinoFeeEstimator
+// computes its fee estimates from.
+type FeeBlockSource interface {
+	// BestHeight returns the height of the current chain tip.
+	BestHeight() (uint32, error)
+
+	// BlockHash returns the hash of the block at the given height in the
+	// main chain.
+	BlockHash(height uint32) (*chainhash.Hash, error)
+
+	// FetchBlock returns the full block with the given hash.
+	FetchBlock(hash *chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// feeWindowBlock holds the data the NeutrinoFeeEstimator keeps for each block
+// within its window.
+type feeWindowBlock struct {
+	height uint32
+	hash   chainhash.Hash
+
+	// outPoints are the outputs created in this block. They're removed
+	// from the estimator's output index once the block leaves the window.
+	outPoints []wire.OutPoint
+
+	// feeRates are the fee rates of the block's transactions whose fees
+	// could be determined.
+	feeRates []SatPerKWeight
+}
+
+// NeutrinoFeeEstimator is an implementation of the FeeEstimator interface for
+// light clients. It fetches the last blocks of the chain and derives fee
+// estimates for different confirmation targets from percentiles of the fee
+// rates paid by the transactions in them.
+//
+// As full blocks don't carry the values of the outputs their transactions
+// spend, the fee of a transaction can only be computed if all of its inputs
+// spend outputs created within the window. Other transactions are ignored,
+// which biases the sample: it's made up of transactions spending very recent
+// outputs, such as CPFP children bumping an unconfirmed parent and chains of
+// payments, which tend to pay higher fee rates than the rest of the block.
+// The sample usually also covers only a small share of the window's
+// transactions. To keep a handful of such transactions from determining the
+// estimates, the fallback fee rate of the static estimator is used until at
+// least minNeutrinoFeeSamples fee rates are known.
+type NeutrinoFeeEstimator struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	source FeeBlockSource
+
+	// windowSize is the number of recent blocks the estimates are derived
+	// from.
+	windowSize uint32
+
+	pollInterval time.Duration
+
+	// fallbackFeePerKW is the fee rate in sat/kw that is returned as long
+	// as too few fee rates are known to derive estimates from.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	minFeePerKW SatPerKWeight
+
+	// window holds the blocks in the window, ordered by height. It's only
+	// accessed by the feeUpdateManager goroutine.
+	window []*feeWindowBlock
+
+	// outputValues indexes the values of all outputs created within the
+	// window. It's only accessed by the feeUpdateManager goroutine.
+	outputValues map[wire.OutPoint]btcutil.Amount
+
+	// feeByBlockTarget holds the current estimates, indexed by
+	// confirmation target.
+	feeByBlockTarget map[uint32]SatPerKWeight
+	feesMtx          sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewNeutrinoFeeEstimator creates a new NeutrinoFeeEstimator that derives its
+// estimates from the last windowSize blocks of the given source, checking for
+// new blocks every pollInterval. The fallback fee rate is used until enough
+// fee rates are known.
+func NewNeutrinoFeeEstimator(source FeeBlockSource, windowSize uint32,
+	pollInterval time.Duration,
+	fallBackFeeRate SatPerKWeight) *NeutrinoFeeEstimator {
+
+	return &NeutrinoFeeEstimator{
+		source:           source,
+		windowSize:       windowSize,
+		pollInterval:     pollInterval,
+		fallbackFeePerKW: fallBackFeeRate,
+		minFeePerKW:      FeePerKwFloor,
+		outputValues:     make(map[wire.OutPoint]btcutil.Amount),
+		quit:             make(chan struct{}),
+	}
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (n *NeutrinoFeeEstimator) Start() error {
+	if !atomic.CompareAndSwapInt32(&n.started, 0, 1) {
+		return nil
+	}
+
+	// Fetching the blocks of the window from the network may take a while,
+	// so the initial update is done in the background as well.
+	n.wg.Add(1)
+	go n.feeUpdateManager()
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (n *NeutrinoFeeEstimator) Stop() error {
+	if !atomic.CompareAndSwapInt32(&n.stopped, 0, 1) {
+		return nil
+	}
+
+	close(n.quit)
+	n.wg.Wait()
+
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw. The fee rate
+// of the highest confirmation target that doesn't exceed numBlocks is used.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (n *NeutrinoFeeEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	n.feesMtx.RLock()
+	defer n.feesMtx.RUnlock()
+
+	if len(n.feeByBlockTarget) == 0 {
+		return n.fallbackFeePerKW, nil
+	}
+
+	satPerKw := feeForTarget(n.feeByBlockTarget, numBlocks)
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// feeUpdateManager keeps the window in sync with the chain and refreshes the
+// fee estimates whenever it changes.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *NeutrinoFeeEstimator) feeUpdateManager() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := n.updateWindow(); err != nil {
+			walletLog.Warnf("Unable to update fee estimates from "+
+				"recent blocks: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// updateWindow moves the window to the current chain tip, fetching any blocks
+// that are missing and dropping those that were reorged out or fell out of
+// the window. If the window changed, the fee estimates are recomputed.
+func (n *NeutrinoFeeEstimator) updateWindow() error {
+	bestHeight, err := n.source.BestHeight()
+	if err != nil {
+		return err
+	}
+
+	var changed bool
+
+	// First, we'll drop all blocks from the end of the window that are no
+	// longer part of the main chain.
+	for len(n.window) > 0 {
+		tip := n.window[len(n.window)-1]
+		if tip.height <= bestHeight {
+			hash, err := n.source.BlockHash(tip.height)
+			if err != nil {
+				return err
+			}
+			if *hash == tip.hash {
+				break
+			}
+		}
+
+		n.dropBlock(tip)
+		n.window = n.window[:len(n.window)-1]
+		changed = true
+	}
+
+	// Then we'll fetch the blocks between our tip and the chain tip, but
+	// no more than fit into the window.
+	var startHeight uint32
+	if bestHeight >= n.windowSize {
+		startHeight = bestHeight - n.windowSize + 1
+	}
+	if len(n.window) > 0 {
+		nextHeight := n.window[len(n.window)-1].height + 1
+		if nextHeight > startHeight {
+			startHeight = nextHeight
+		}
+	}
+	for height := startHeight; height <= bestHeight; height++ {
+		select {
+		case <-n.quit:
+			return nil
+		default:
+		}
+
+		if err := n.addBlock(height); err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	// Finally, we'll drop the blocks that fell out of the window.
+	for len(n.window) > 0 && n.window[0].height+n.windowSize <= bestHeight {
+		n.dropBlock(n.window[0])
+		n.window = n.window[1:]
+		changed = true
+	}
+
+	if changed {
+		n.updateFeeEstimates()
+	}
+
+	return nil
+}
+
+// addBlock fetches the block at the given height and appends it to the
+// window.
+func (n *NeutrinoFeeEstimator) addBlock(height uint32) error {
+	hash, err := n.source.BlockHash(height)
+	if err != nil {
+		return err
+	}
+	block, err := n.source.FetchBlock(hash)
+	if err != nil {
+		return fmt.Errorf("unable to fetch block %v: %v", hash, err)
+	}
+
+	windowBlock := &feeWindowBlock{
+		height: height,
+		hash:   *hash,
+	}
+
+	// We'll index the outputs of all transactions before computing any
+	// fees, so transactions spending outputs created later within the
+	// same block can be accounted for as well.
+	for _, tx := range block.Transactions {
+		txHash := tx.TxHash()
+		for i, txOut := range tx.TxOut {
+			op := wire.OutPoint{Hash: txHash, Index: uint32(i)}
+			n.outputValues[op] = btcutil.Amount(txOut.Value)
+			windowBlock.outPoints = append(windowBlock.outPoints, op)
+		}
+	}
+
+	for _, tx := range block.Transactions {
+		feeRate, ok := n.txFeeRate(tx)
+		if ok {
+			windowBlock.feeRates = append(
+				windowBlock.feeRates, feeRate,
+			)
+		}
+	}
+
+	n.window = append(n.window, windowBlock)
+
+	walletLog.Debugf("Added block %v (height=%v) with %v known fee rates "+
+		"to fee estimation window", hash, height,
+		len(windowBlock.feeRates))
+
+	return nil
+}
+
+// dropBlock removes the outputs created in the given block from the output
+// index.
+func (n *NeutrinoFeeEstimator) dropBlock(block *feeWindowBlock) {
+	for _, op := range block.outPoints {
+		delete(n.outputValues, op)
+	}
+}
+
+// txFeeRate returns the fee rate paid by the given transaction. The boolean
+// is false if the fee can't be determined, as one of the spent outputs isn't
+// known, or if the transaction is a coinbase.
+func (n *NeutrinoFeeEstimator) txFeeRate(tx *wire.MsgTx) (SatPerKWeight, bool) {
+	if blockchain.IsCoinBaseTx(tx) {
+		return 0, false
+	}
+
+	var inputTotal, outputTotal btcutil.Amount
+	for _, txIn := range tx.TxIn {
+		value, ok := n.outputValues[txIn.PreviousOutPoint]
+		if !ok {
+			return 0, false
+		}
+		inputTotal += value
+	}
+	for _, txOut := range tx.TxOut {
+		outputTotal += btcutil.Amount(txOut.Value)
+	}
+
+	fee := inputTotal - outputTotal
+	if fee < 0 {
+		return 0, false
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+
+	return SatPerKWeight(fee * 1000 / btcutil.Amount(weight)), true
+}
+
+// updateFeeEstimates recomputes the fee estimates of all confirmation targets
+// from the fee rates within the window. If too few fee rates are known, the
+// estimates are cleared, so the fallback fee rate is used.
+func (n *NeutrinoFeeEstimator) updateFeeEstimates() {
+	var feeRates []SatPerKWeight
+	for _, block := range n.window {
+		feeRates = append(feeRates, block.feeRates...)
+	}
+
+	var feeByBlockTarget map[uint32]SatPerKWeight
+	if len(feeRates) < minNeutrinoFeeSamples {
+		walletLog.Infof("Only %v fee rates known within the last %v "+
+			"blocks, using fallback fee rate of %v sat/kw",
+			len(feeRates), len(n.window),
+			int64(n.fallbackFeePerKW))
+	} else {
+		sort.Slice(feeRates, func(i, j int) bool {
+			return feeRates[i] < feeRates[j]
+		})
+
+		feeByBlockTarget = make(map[uint32]SatPerKWeight)
+		for target, percentile := range confTargetPercentiles {
+			idx := int(percentile * float64(len(feeRates)-1))
+			satPerKw := feeRates[idx]
+			if satPerKw < n.minFeePerKW {
+				satPerKw = n.minFeePerKW
+			}
+			feeByBlockTarget[target] = satPerKw
+		}
+	}
+
+	n.feesMtx.Lock()
+	n.feeByBlockTarget = feeByBlockTarget
+	n.feesMtx.Unlock()
+
+	walletLog.Debugf("Updated fee estimates from %v fee rates within the "+
+		"last %v blocks: %v", len(feeRates), len(n.window),
+		newLogClosure(func() string {
+			return spew.Sdump(feeByBlockTarget)
+		}))
+}
+
+// A compile-time assertion to ensure that NeutrinoFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*NeutrinoFeeEstimator)(nil)
@@ -1,8 +1,15 @@
 package lnwallet_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
@@ -91,3 +98,343 @@ func TestStaticFeeEstimator(t *testing.T) {
 		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
 	}
 }
+
+// feeAPIStub is a local HTTP server that mimics a fee estimation web API. Its
+// response can be changed while it's running.
+type feeAPIStub struct {
+	sync.Mutex
+	status int
+	body   string
+}
+
+func (f *feeAPIStub) set(status int, body string) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.status = status
+	f.body = body
+}
+
+func (f *feeAPIStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	w.WriteHeader(f.status)
+	w.Write([]byte(f.body))
+}
+
+// TestWebAPIFeeEstimator checks that the WebAPIFeeEstimator serves the fee
+// rates of the closest confirmation target, enforces the fee floor, keeps
+// its cached estimates while the endpoint is unavailable, and falls back to
+// the static fee rate until it has received a valid response.
+func TestWebAPIFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fallbackFeeRate = lnwallet.SatPerKWeight(12500)
+		pollInterval    = 10 * time.Millisecond
+	)
+
+	stub := &feeAPIStub{status: http.StatusInternalServerError}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	estimator := lnwallet.NewWebAPIFeeEstimator(
+		server.URL, pollInterval, fallbackFeeRate,
+	)
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	assertFeeRate := func(numBlocks uint32,
+		expected lnwallet.SatPerKWeight) {
+
+		t.Helper()
+
+		// As the estimates are refreshed in the background, we'll
+		// allow a few poll intervals for them to be updated.
+		var feeRate lnwallet.SatPerKWeight
+		for i := 0; i < 100; i++ {
+			var err error
+			feeRate, err = estimator.EstimateFeePerKW(numBlocks)
+			if err != nil {
+				t.Fatalf("unable to estimate fee: %v", err)
+			}
+			if feeRate == expected {
+				return
+			}
+			time.Sleep(pollInterval)
+		}
+		t.Fatalf("expected fee rate %v for conf target %v, got %v",
+			expected, numBlocks, feeRate)
+	}
+
+	// As the endpoint is unavailable, the fallback fee rate should be
+	// used.
+	assertFeeRate(6, fallbackFeeRate)
+
+	// Once the endpoint serves estimates, they should be used.
+	stub.set(http.StatusOK, `{"fee_by_block_target": `+
+		`{"2": 40000, "6": 20000, "144": 400}}`)
+
+	testCases := []struct {
+		numBlocks uint32
+		feeRate   lnwallet.SatPerKWeight
+	}{
+		// A target below the lowest known one gets the fee rate of
+		// the lowest target.
+		{numBlocks: 1, feeRate: 10000},
+		{numBlocks: 2, feeRate: 10000},
+
+		// Otherwise, the closest target below is used.
+		{numBlocks: 5, feeRate: 10000},
+		{numBlocks: 6, feeRate: 5000},
+		{numBlocks: 100, feeRate: 5000},
+
+		// 400 sat/kvB is below the fee floor, so it should be
+		// raised.
+		{numBlocks: 144, feeRate: lnwallet.FeePerKwFloor},
+		{numBlocks: 1000, feeRate: lnwallet.FeePerKwFloor},
+	}
+	for _, test := range testCases {
+		assertFeeRate(test.numBlocks, test.feeRate)
+	}
+
+	// If the endpoint becomes unavailable, or serves an invalid response,
+	// the cached estimates should still be used.
+	stub.set(http.StatusServiceUnavailable, "")
+	time.Sleep(pollInterval * 5)
+	assertFeeRate(6, 5000)
+
+	stub.set(http.StatusOK, `{"fee_by_block_target": {}}`)
+	time.Sleep(pollInterval * 5)
+	assertFeeRate(6, 5000)
+
+	// Finally, new estimates should replace the cached ones.
+	stub.set(http.StatusOK, `{"fee_by_block_target": {"6": 8000}}`)
+	assertFeeRate(6, 2000)
+}
+
+// feeBlockSourceStub is an in-memory chain that implements the
+// lnwallet.FeeBlockSource interface. Its blocks can be changed while it's
+// being used.
+type feeBlockSourceStub struct {
+	sync.Mutex
+	blocks []*wire.MsgBlock
+}
+
+func (f *feeBlockSourceStub) BestHeight() (uint32, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	return uint32(len(f.blocks) - 1), nil
+}
+
+func (f *feeBlockSourceStub) BlockHash(height uint32) (*chainhash.Hash, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if height >= uint32(len(f.blocks)) {
+		return nil, fmt.Errorf("no block at height %v", height)
+	}
+	hash := f.blocks[height].BlockHash()
+	return &hash, nil
+}
+
+func (f *feeBlockSourceStub) FetchBlock(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	f.Lock()
+	defer f.Unlock()
+
+	for _, block := range f.blocks {
+		if block.BlockHash() == *hash {
+			return block, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown block %v", hash)
+}
+
+// setBlocks replaces the chain by blocks containing the given transactions,
+// preceded by a coinbase transaction with the given number of outputs. The
+// nonce makes the block hashes unique among competing chains.
+func (f *feeBlockSourceStub) setBlocks(nonce uint32, numOutputs int,
+	blockTxs ...[]*wire.MsgTx) {
+
+	f.Lock()
+	defer f.Unlock()
+
+	f.blocks = nil
+	var prevBlock chainhash.Hash
+	for height, txs := range blockTxs {
+		coinbase := &wire.MsgTx{
+			Version: 1,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{
+					Index: wire.MaxPrevOutIndex,
+				},
+				SignatureScript: []byte{byte(height), 0},
+			}},
+		}
+		for i := 0; i < numOutputs; i++ {
+			coinbase.AddTxOut(&wire.TxOut{Value: 100000})
+		}
+
+		block := &wire.MsgBlock{
+			Header: wire.BlockHeader{
+				PrevBlock: prevBlock,
+				Nonce:     nonce,
+			},
+			Transactions: append([]*wire.MsgTx{coinbase}, txs...),
+		}
+		f.blocks = append(f.blocks, block)
+		prevBlock = block.BlockHash()
+	}
+}
+
+// coinbaseSpends returns one transaction for each of the given fee rates,
+// spending the coinbase outputs of the given block and paying exactly that
+// fee rate.
+func (f *feeBlockSourceStub) coinbaseSpends(block *wire.MsgBlock,
+	feeRates []lnwallet.SatPerKWeight) []*wire.MsgTx {
+
+	coinbase := block.Transactions[0]
+	coinbaseHash := coinbase.TxHash()
+
+	txs := make([]*wire.MsgTx, 0, len(feeRates))
+	for i, feeRate := range feeRates {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  coinbaseHash,
+				Index: uint32(i),
+			},
+		})
+		tx.AddTxOut(&wire.TxOut{})
+
+		weight := int64(
+			tx.SerializeSizeStripped()*3 + tx.SerializeSize(),
+		)
+		fee := feeRate.FeeForWeight(weight)
+		tx.TxOut[0].Value = coinbase.TxOut[i].Value - int64(fee)
+
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// TestNeutrinoFeeEstimator checks that the NeutrinoFeeEstimator derives its
+// estimates from the fee rates paid within its window of recent blocks,
+// follows reorgs, enforces the fee floor, and falls back to the static fee
+// rate if fewer than the minimum number of fee rates are known.
+func TestNeutrinoFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fallbackFeeRate = lnwallet.SatPerKWeight(12500)
+		pollInterval    = 10 * time.Millisecond
+
+		// numTxs is the minimum number of known fee rates the
+		// estimator requires before deriving estimates from them.
+		numTxs = 100
+	)
+
+	source := &feeBlockSourceStub{}
+	source.setBlocks(0, numTxs, nil)
+
+	estimator := lnwallet.NewNeutrinoFeeEstimator(
+		source, 2, pollInterval, fallbackFeeRate,
+	)
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	assertFeeRate := func(numBlocks uint32,
+		expected lnwallet.SatPerKWeight) {
+
+		t.Helper()
+
+		// As the window is updated in the background, we'll allow a
+		// few poll intervals for the estimates to be updated.
+		var feeRate lnwallet.SatPerKWeight
+		for i := 0; i < 100; i++ {
+			var err error
+			feeRate, err = estimator.EstimateFeePerKW(numBlocks)
+			if err != nil {
+				t.Fatalf("unable to estimate fee: %v", err)
+			}
+			if feeRate == expected {
+				return
+			}
+			time.Sleep(pollInterval)
+		}
+		t.Fatalf("expected fee rate %v for conf target %v, got %v",
+			expected, numBlocks, feeRate)
+	}
+
+	// The genesis block only contains a coinbase transaction, so the
+	// fallback fee rate should be used.
+	assertFeeRate(6, fallbackFeeRate)
+
+	// We'll now mine a block spending all outputs of the genesis block,
+	// with fee rates ranging from 1000 to 100000 sat/kw.
+	feeRates := make([]lnwallet.SatPerKWeight, numTxs)
+	for i := range feeRates {
+		feeRates[i] = lnwallet.SatPerKWeight((i + 1) * 1000)
+	}
+
+	source.setBlocks(
+		0, numTxs, nil,
+		source.coinbaseSpends(source.blocks[0], feeRates),
+	)
+
+	testCases := []struct {
+		numBlocks uint32
+		feeRate   lnwallet.SatPerKWeight
+	}{
+		// The lower the conf target, the higher the percentile of
+		// the fee rates that is used.
+		{numBlocks: 1, feeRate: 90000},
+		{numBlocks: 2, feeRate: 75000},
+		{numBlocks: 5, feeRate: 60000},
+		{numBlocks: 6, feeRate: 50000},
+		{numBlocks: 100, feeRate: 20000},
+		{numBlocks: 144, feeRate: 10000},
+		{numBlocks: 1000, feeRate: 10000},
+	}
+	for _, test := range testCases {
+		assertFeeRate(test.numBlocks, test.feeRate)
+	}
+
+	// If the block is reorged out in favor of one that is a single fee
+	// rate short of the minimum sample, the fallback fee rate should be
+	// used.
+	source.setBlocks(
+		2, numTxs, nil,
+		source.coinbaseSpends(source.blocks[0], feeRates[1:]),
+	)
+	assertFeeRate(6, fallbackFeeRate)
+
+	// Once reorged again in favor of a block with lower fee rates,
+	// the estimates should follow, while still enforcing the fee floor.
+	for i := range feeRates {
+		feeRates[i] = lnwallet.SatPerKWeight((i + 1) * 20)
+	}
+	source.setBlocks(
+		1, numTxs, nil,
+		source.coinbaseSpends(source.blocks[0], feeRates),
+	)
+	assertFeeRate(1, 1800)
+	assertFeeRate(6, 1000)
+	assertFeeRate(144, lnwallet.FeePerKwFloor)
+
+	// Once the block with the fee rates has left the window of two
+	// blocks, the fallback fee rate should be used again.
+	source.setBlocks(
+		1, numTxs, nil,
+		source.coinbaseSpends(source.blocks[0], feeRates), nil, nil,
+	)
+	assertFeeRate(6, fallbackFeeRate)
+}
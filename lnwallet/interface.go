@@ -52,6 +52,7 @@ var (
 type Utxo struct {
 	AddressType   AddressType
 	Value         btcutil.Amount
+	Confirmations int64
 	PkScript      []byte
 	RedeemScript  []byte
 	WitnessScript []byte
@@ -94,6 +95,10 @@ type TransactionDetail struct {
 
 	// DestAddresses are the destinations for a transaction
 	DestAddresses []btcutil.Address
+
+	// Account is the name of the watch-only account the transaction
+	// belongs to. It's empty for transactions of the wallet itself.
+	Account string
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -167,8 +172,11 @@ type WalletController interface {
 	ListUnspentWitness(confirms int32) ([]*Utxo, error)
 
 	// ListTransactionDetails returns a list of all transactions which are
-	// relevant to the wallet.
-	ListTransactionDetails() ([]*TransactionDetail, error)
+	// relevant to the wallet and confirmed between startHeight and
+	// endHeight, inclusive. If endHeight is -1, unconfirmed transactions
+	// are returned as well.
+	ListTransactionDetails(startHeight, endHeight int32) (
+		[]*TransactionDetail, error)
 
 	// LockOutpoint marks an outpoint as locked meaning it will no longer
 	// be deemed as eligible for coin selection. Locking outputs are
@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -287,10 +288,10 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	}
 
 	// In this scenario, we'll test a dual funder reservation, with each
-	// side putting in 10 BTC.
+	// side putting in 5 BTC.
 
-	// Alice initiates a channel funded with 5 BTC for each side, so 10 BTC
-	// total. She also generates 2 BTC in change.
+	// Alice initiates a channel funded with 5 BTC. Bob will contribute
+	// another 5 BTC, so the channel will have a capacity of 10 BTC total.
 	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -300,7 +301,7 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		NodeID:          bobPub,
 		NodeAddr:        bobAddr,
 		FundingAmount:   fundingAmount,
-		Capacity:        fundingAmount * 2,
+		Capacity:        fundingAmount,
 		CommitFeePerKw:  feePerKw,
 		FundingFeePerKw: feePerKw,
 		PushMSat:        0,
@@ -329,11 +330,32 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		t.Fatalf("outputs for funding tx not properly selected, have %v "+
 			"outputs should have 2", len(aliceContribution.Inputs))
 	}
+	if len(aliceContribution.PrevOutputs) != 2 {
+		t.Fatalf("expected 2 previous outputs, have %v",
+			len(aliceContribution.PrevOutputs))
+	}
+
+	// The heights of the selected outputs must be valid hints for Bob to
+	// look them up with, so they can't be above the current height.
+	_, bestHeight, err := alice.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best height: %v", err)
+	}
+	if len(aliceContribution.PrevOutputHeights) != 2 {
+		t.Fatalf("expected 2 previous output heights, have %v",
+			len(aliceContribution.PrevOutputHeights))
+	}
+	for _, height := range aliceContribution.PrevOutputHeights {
+		if height == 0 || height > uint32(bestHeight) {
+			t.Fatalf("invalid previous output height %v at "+
+				"height %v", height, bestHeight)
+		}
+	}
 	assertContributionInitPopulated(t, aliceContribution)
 
-	// Bob does the same, generating his own contribution. He then also
-	// receives' Alice's contribution, and consumes that so we can continue
-	// the funding process.
+	// Bob receives Alice's request, and decides to contribute 5 BTC
+	// himself. He then also consumes Alice's contribution, so we can
+	// continue the funding process.
 	bobReq := &lnwallet.InitFundingReserveMsg{
 		ChainHash:       chainHash,
 		NodeID:          alicePub,
@@ -359,16 +381,22 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	}
 	bobChanReservation.SetNumConfsRequired(numReqConfs)
 
-	assertContributionInitPopulated(t, bobChanReservation.OurContribution())
+	bobContribution := bobChanReservation.OurContribution()
+	assertContributionInitPopulated(t, bobContribution)
+	if len(bobContribution.Inputs) == 0 {
+		t.Fatalf("bob didn't select any inputs")
+	}
+	if bobContribution.FundingAmount != fundingAmount {
+		t.Fatalf("expected bob to contribute %v, instead contributes "+
+			"%v", fundingAmount, bobContribution.FundingAmount)
+	}
 
-	err = bobChanReservation.ProcessContribution(aliceContribution)
+	err = bobChanReservation.ProcessSingleContribution(aliceContribution)
 	if err != nil {
 		t.Fatalf("bob unable to process alice's contribution: %v", err)
 	}
 	assertContributionInitPopulated(t, bobChanReservation.TheirContribution())
 
-	bobContribution := bobChanReservation.OurContribution()
-
 	// Bob then sends over his contribution, which will be consumed by
 	// Alice. After this phase, Alice should have all the necessary
 	// material required to craft the funding transaction and commitment
@@ -388,29 +416,42 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		t.Fatalf("alice's commit signatures not populated")
 	}
 
-	// Additionally, Bob's signatures should also be fully populated.
+	// Alice now sends over her own inputs and change outputs, which allows
+	// Bob to build the very same funding transaction and sign his inputs.
+	err = bobChanReservation.ProcessDualFundingInputs(
+		aliceContribution.Inputs, aliceContribution.PrevOutputs,
+		aliceContribution.PrevOutputHeights,
+		aliceContribution.ChangeOutputs,
+	)
+	if err != nil {
+		t.Fatalf("bob unable to process alice's inputs: %v", err)
+	}
+
+	// Along with the inputs, Alice sends the funding outpoint and her
+	// signature for Bob's commitment transaction.
+	fundingPoint := aliceChanReservation.FundingOutpoint()
+	_, err = bobChanReservation.CompleteReservationSingle(
+		fundingPoint, aliceCommitSig,
+	)
+	if err != nil {
+		t.Fatalf("bob unable to complete reservation: %v", err)
+	}
+
+	// Bob's signatures should now also be fully populated.
 	bobFundingSigs, bobCommitSig := bobChanReservation.OurSignatures()
-	if bobFundingSigs == nil {
-		t.Fatalf("bob's funding signatures not populated")
+	if len(bobFundingSigs) != len(bobContribution.Inputs) {
+		t.Fatalf("expected %v funding signatures from bob, have %v",
+			len(bobContribution.Inputs), len(bobFundingSigs))
 	}
 	if bobCommitSig == nil {
 		t.Fatalf("bob's commit signatures not populated")
 	}
 
-	// To conclude, we'll consume first Alice's signatures with Bob, and
-	// then the other way around.
+	// To conclude, Alice consumes Bob's signatures, after which she's
+	// able to broadcast the funding transaction.
 	_, err = aliceChanReservation.CompleteReservation(
 		bobFundingSigs, bobCommitSig,
 	)
-	if err != nil {
-		for _, in := range aliceChanReservation.FinalFundingTx().TxIn {
-			fmt.Println(in.PreviousOutPoint.String())
-		}
-		t.Fatalf("unable to consume alice's sigs: %v", err)
-	}
-	_, err = bobChanReservation.CompleteReservation(
-		aliceFundingSigs, aliceCommitSig,
-	)
 	if err != nil {
 		t.Fatalf("unable to consume bob's sigs: %v", err)
 	}
@@ -434,6 +475,13 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	if aliceChannels[0].ChanType != channeldb.DualFunder {
 		t.Fatalf("channel not detected as dual funder")
 	}
+	if aliceChannels[0].Capacity != fundingAmount*2 {
+		t.Fatalf("expected capacity of %v, got %v", fundingAmount*2,
+			aliceChannels[0].Capacity)
+	}
+	if !aliceChannels[0].IsInitiator {
+		t.Fatalf("alice should be the initiator of the channel")
+	}
 	bobChannels, err := bob.Cfg.Database.FetchOpenChannels(alicePub)
 	if err != nil {
 		t.Fatalf("unable to retrieve channel from DB: %v", err)
@@ -444,6 +492,11 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	if bobChannels[0].ChanType != channeldb.DualFunder {
 		t.Fatalf("channel not detected as dual funder")
 	}
+	bobBalance := bobChannels[0].LocalCommitment.LocalBalance.ToSatoshis()
+	if bobBalance != fundingAmount {
+		t.Fatalf("expected bob's balance to be %v, got %v",
+			fundingAmount, bobBalance)
+	}
 
 	// Let Alice publish the funding transaction.
 	if err := alice.PublishTransaction(fundingTx); err != nil {
@@ -607,6 +660,361 @@ func testFundingCancellationNotEnoughFunds(miner *rpctest.Harness,
 	}
 }
 
+func testFundingWithSelectedInputs(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+
+	// We'll fund a channel using a single output of the wallet, which is
+	// picked by us rather than by coin selection.
+	coins, err := alice.ListUnspentWitness(1)
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(coins) == 0 {
+		t.Fatalf("wallet has no unspent outputs")
+	}
+	coin := coins[0]
+
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:       chainHash,
+		NodeID:          bobPub,
+		NodeAddr:        bobAddr,
+		FundingAmount:   coin.Value / 2,
+		Capacity:        coin.Value / 2,
+		CommitFeePerKw:  feePerKw,
+		FundingFeePerKw: feePerKw,
+		PushMSat:        0,
+		Flags:           lnwire.FFAnnounceChannel,
+		MinConfs:        1,
+		FundingInputs:   []wire.OutPoint{coin.OutPoint},
+	}
+	chanReservation, err := alice.InitChannelReservation(req)
+	if err != nil {
+		t.Fatalf("unable to initialize funding reservation: %v", err)
+	}
+
+	// The contribution should spend exactly the output we selected.
+	contribution := chanReservation.OurContribution()
+	if len(contribution.Inputs) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(contribution.Inputs))
+	}
+	if contribution.Inputs[0].PreviousOutPoint != coin.OutPoint {
+		t.Fatalf("expected input %v, got %v", coin.OutPoint,
+			contribution.Inputs[0].PreviousOutPoint)
+	}
+
+	// As the output is now locked by the reservation, it can neither be
+	// leased nor used to fund another channel.
+	var lockID [32]byte
+	lockID[0] = 1
+	_, err = alice.LeaseOutput(lockID, coin.OutPoint, time.Minute)
+	if err == nil {
+		t.Fatalf("able to lease output locked by a reservation")
+	}
+	if _, err := alice.InitChannelReservation(req); err == nil {
+		t.Fatalf("able to fund two channels with the same output")
+	}
+
+	// Releasing a lease we never held must not unlock the output either.
+	if err := alice.ReleaseOutput(lockID, coin.OutPoint); err == nil {
+		t.Fatalf("able to release output that isn't leased")
+	}
+	if _, err := alice.InitChannelReservation(req); err == nil {
+		t.Fatalf("able to fund two channels with the same output")
+	}
+
+	// Once the reservation is canceled, we'll lease the output, which
+	// once again must prevent it from funding a channel.
+	if err := chanReservation.Cancel(); err != nil {
+		t.Fatalf("unable to cancel reservation: %v", err)
+	}
+	_, err = alice.LeaseOutput(lockID, coin.OutPoint, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if _, err := alice.InitChannelReservation(req); err == nil {
+		t.Fatalf("able to fund channel with leased output")
+	}
+
+	// After releasing the lease, the output can be used again.
+	if err := alice.ReleaseOutput(lockID, coin.OutPoint); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	chanReservation, err = alice.InitChannelReservation(req)
+	if err != nil {
+		t.Fatalf("unable to initialize funding reservation: %v", err)
+	}
+	if err := chanReservation.Cancel(); err != nil {
+		t.Fatalf("unable to cancel reservation: %v", err)
+	}
+}
+
+func testSendOutputsWithInputs(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+
+	coins, err := alice.ListUnspentWitness(1)
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(coins) < 2 {
+		t.Fatalf("expected at least 2 unspent outputs, got %v",
+			len(coins))
+	}
+	inputs := []wire.OutPoint{coins[0].OutPoint, coins[1].OutPoint}
+	totalIn := coins[0].Value + coins[1].Value
+
+	// We'll send the value of a single input to an address of the miner,
+	// which requires both inputs once the fee is accounted for.
+	minerAddr, err := miner.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to get miner address: %v", err)
+	}
+	minerScript, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to generate script: %v", err)
+	}
+	output := &wire.TxOut{
+		Value:    int64(coins[0].Value),
+		PkScript: minerScript,
+	}
+
+	// Spending an input that isn't ours should fail.
+	badInputs := []wire.OutPoint{coins[0].OutPoint, {Index: 1}}
+	_, err = alice.SendOutputsWithInputs(
+		badInputs, []*wire.TxOut{output}, feePerKw,
+	)
+	if err == nil {
+		t.Fatalf("able to send using unknown input")
+	}
+
+	tx, err := alice.SendOutputsWithInputs(
+		inputs, []*wire.TxOut{output}, feePerKw,
+	)
+	if err != nil {
+		t.Fatalf("unable to send outputs: %v", err)
+	}
+	txid := tx.TxHash()
+	if err := waitForMempoolTx(miner, &txid); err != nil {
+		t.Fatalf("tx not relayed to miner: %v", err)
+	}
+
+	// The transaction must spend exactly the inputs we passed, and return
+	// the remainder minus the fee as change.
+	if len(tx.TxIn) != len(inputs) {
+		t.Fatalf("expected %v inputs, got %v", len(inputs),
+			len(tx.TxIn))
+	}
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txIn := range tx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, op := range inputs {
+		if _, ok := spent[op]; !ok {
+			t.Fatalf("input %v not spent by tx", op)
+		}
+	}
+	if len(tx.TxOut) != 2 {
+		t.Fatalf("expected 2 outputs, got %v", len(tx.TxOut))
+	}
+	var totalOut btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+	if totalOut >= totalIn || totalOut <= totalIn-coins[1].Value {
+		t.Fatalf("unexpected fee: spent %v, sent %v", totalIn,
+			totalOut)
+	}
+
+	// Both inputs are now spent, so they can't be used a second time.
+	_, err = alice.SendOutputsWithInputs(
+		inputs, []*wire.TxOut{output}, feePerKw,
+	)
+	if err == nil {
+		t.Fatalf("able to spend inputs twice")
+	}
+
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(miner, alice); err != nil {
+		t.Fatalf("unable to sync alice: %v", err)
+	}
+}
+
+// sweepToMiner sweeps all confirmed outputs of the wallet to the passed script
+// of the miner, and mines the sweep transaction.
+func sweepToMiner(miner *rpctest.Harness, w *lnwallet.LightningWallet,
+	minerScript []byte, feePerKw lnwallet.SatPerKWeight,
+	t *testing.T) *wire.MsgTx {
+
+	tx, err := w.SweepAll(minerScript, feePerKw)
+	if err != nil {
+		t.Fatalf("unable to sweep wallet: %v", err)
+	}
+	txid := tx.TxHash()
+	if err := waitForMempoolTx(miner, &txid); err != nil {
+		t.Fatalf("tx not relayed to miner: %v", err)
+	}
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(miner, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	return tx
+}
+
+func testSweepAll(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+
+	minerAddr, err := miner.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to get miner address: %v", err)
+	}
+	minerScript, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to generate script: %v", err)
+	}
+
+	coins, err := alice.ListUnspentWitness(1)
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(coins) < 3 {
+		t.Fatalf("expected at least 3 unspent outputs, got %v",
+			len(coins))
+	}
+
+	// We'll lease one output and lock another one, both of which must be
+	// excluded from the sweep.
+	var lockID [32]byte
+	lockID[0] = 2
+	leased, locked := coins[0], coins[1]
+	_, err = alice.LeaseOutput(lockID, leased.OutPoint, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	alice.LockOutpoint(locked.OutPoint)
+
+	var totalIn btcutil.Amount
+	for _, coin := range coins[2:] {
+		totalIn += coin.Value
+	}
+
+	tx := sweepToMiner(miner, alice, minerScript, feePerKw, t)
+
+	// All other outputs must be spent to a single output paying to the
+	// miner, which has the fee deducted from it.
+	if len(tx.TxIn) != len(coins)-2 {
+		t.Fatalf("expected %v inputs, got %v", len(coins)-2,
+			len(tx.TxIn))
+	}
+	for _, txIn := range tx.TxIn {
+		op := txIn.PreviousOutPoint
+		if op == leased.OutPoint || op == locked.OutPoint {
+			t.Fatalf("sweep spent excluded output %v", op)
+		}
+	}
+	if len(tx.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %v", len(tx.TxOut))
+	}
+	if !bytes.Equal(tx.TxOut[0].PkScript, minerScript) {
+		t.Fatalf("sweep doesn't pay to the miner")
+	}
+	fee := totalIn - btcutil.Amount(tx.TxOut[0].Value)
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	minFee := feePerKw.FeeForWeight(weight)
+	if fee < minFee || fee > 2*minFee {
+		t.Fatalf("expected fee between %v and %v, got %v", minFee,
+			2*minFee, fee)
+	}
+
+	// Only the excluded outputs remain in the wallet, which become
+	// available once they're released.
+	if err := alice.ReleaseOutput(lockID, leased.OutPoint); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	alice.UnlockOutpoint(locked.OutPoint)
+
+	balance, err := alice.ConfirmedBalance(1)
+	if err != nil {
+		t.Fatalf("unable to query balance: %v", err)
+	}
+	if balance != leased.Value+locked.Value {
+		t.Fatalf("expected balance of %v, got %v",
+			leased.Value+locked.Value, balance)
+	}
+
+	// Sweeping again must now empty the wallet.
+
+	tx = sweepToMiner(miner, alice, minerScript, feePerKw, t)
+	if len(tx.TxIn) != 2 || len(tx.TxOut) != 1 {
+		t.Fatalf("expected 2 inputs and 1 output, got %v and %v",
+			len(tx.TxIn), len(tx.TxOut))
+	}
+
+	balance, err = alice.ConfirmedBalance(0)
+	if err != nil {
+		t.Fatalf("unable to query balance: %v", err)
+	}
+	if balance != 0 {
+		t.Fatalf("expected empty wallet, got balance of %v", balance)
+	}
+	if _, err := alice.SweepAll(minerScript, feePerKw); err == nil {
+		t.Fatalf("able to sweep empty wallet")
+	}
+
+	// A wallet holding an output that's worth less than the fee of
+	// sweeping it, plus the dust limit, can't be swept.
+	dustAddr, err := alice.NewAddress(lnwallet.WitnessPubKey, false)
+	if err != nil {
+		t.Fatalf("unable to get address: %v", err)
+	}
+	dustScript, err := txscript.PayToAddrScript(dustAddr)
+	if err != nil {
+		t.Fatalf("unable to generate script: %v", err)
+	}
+	_, err = miner.SendOutputs([]*wire.TxOut{{
+		Value:    1500,
+		PkScript: dustScript,
+	}}, 2500)
+	if err != nil {
+		t.Fatalf("unable to send dust output: %v", err)
+	}
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(miner, alice); err != nil {
+		t.Fatalf("unable to sync alice: %v", err)
+	}
+
+	_, err = alice.SweepAll(minerScript, feePerKw)
+	if _, ok := err.(*lnwallet.ErrInsufficientFunds); !ok {
+		t.Fatalf("expected ErrInsufficientFunds when sweeping dust, "+
+			"got: %v", err)
+	}
+
+	// Finally, we'll refill the wallet for the remaining tests.
+	if err := loadTestCredits(miner, alice, 20, 4); err != nil {
+		t.Fatalf("unable to refill wallet: %v", err)
+	}
+}
+
 func testCancelNonExistentReservation(miner *rpctest.Harness,
 	alice, _ *lnwallet.LightningWallet, t *testing.T) {
 
@@ -983,7 +1391,7 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("Couldn't sync Alice's wallet: %v", err)
 	}
-	txDetails, err := alice.ListTransactionDetails()
+	txDetails, err := alice.ListTransactionDetails(0, -1)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1089,7 +1497,7 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	// We should be able to find the transaction above in the set of
 	// returned transactions, and it should have a confirmation of -1,
 	// indicating that it's not yet mined.
-	txDetails, err = alice.ListTransactionDetails()
+	txDetails, err = alice.ListTransactionDetails(0, -1)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1123,7 +1531,7 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("Couldn't sync Alice's wallet: %v", err)
 	}
-	txDetails, err = alice.ListTransactionDetails()
+	txDetails, err = alice.ListTransactionDetails(0, -1)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1993,6 +2401,18 @@ var walletTests = []walletTestCase{
 		name: "reservation insufficient funds",
 		test: testFundingCancellationNotEnoughFunds,
 	},
+	{
+		name: "funding with selected inputs",
+		test: testFundingWithSelectedInputs,
+	},
+	{
+		name: "send outputs with selected inputs",
+		test: testSendOutputsWithInputs,
+	},
+	{
+		name: "sweep all",
+		test: testSweepAll,
+	},
 	{
 		name: "transaction subscriptions",
 		test: testTransactionSubscriptions,
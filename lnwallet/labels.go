@@ -0,0 +1,117 @@
+package lnwallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+const (
+	// SweepTxLabel is the label of the transactions published by the
+	// sweeper, which may batch inputs of several channels.
+	SweepTxLabel = "sweep:batch"
+
+	// NurseryTxLabel is the label of the second-level HTLC timeout
+	// transactions published by the utxo nursery.
+	NurseryTxLabel = "sweep:nursery"
+)
+
+// ErrUnknownTransaction is returned when a transaction that isn't relevant to
+// the wallet is attempted to be labeled.
+var ErrUnknownTransaction = fmt.Errorf("transaction not known to the wallet")
+
+// FundingTxLabel returns the label of a transaction funding the channels with
+// the passed channel points.
+func FundingTxLabel(chanPoints ...wire.OutPoint) string {
+	return chanPointsLabel("open", chanPoints)
+}
+
+// CoopCloseTxLabel returns the label of a cooperative close transaction of
+// the channel with the passed channel point.
+func CoopCloseTxLabel(chanPoint wire.OutPoint) string {
+	return chanPointsLabel("close", []wire.OutPoint{chanPoint})
+}
+
+// SpliceTxLabel returns the label of a splice transaction spending the
+// funding output of the channel with the passed channel point.
+func SpliceTxLabel(chanPoint wire.OutPoint) string {
+	return chanPointsLabel("splice", []wire.OutPoint{chanPoint})
+}
+
+// ForceCloseTxLabel returns the label of a commitment transaction broadcast to
+// force close the channel with the passed channel point.
+func ForceCloseTxLabel(chanPoint wire.OutPoint) string {
+	return chanPointsLabel("force-close", []wire.OutPoint{chanPoint})
+}
+
+// HtlcSuccessTxLabel returns the label of a second-level HTLC success
+// transaction of the channel with the passed channel point.
+func HtlcSuccessTxLabel(chanPoint wire.OutPoint) string {
+	return chanPointsLabel("htlc-success", []wire.OutPoint{chanPoint})
+}
+
+// JusticeTxLabel returns the label of a justice transaction sweeping the
+// outputs of a breached channel with the passed channel point.
+func JusticeTxLabel(chanPoint wire.OutPoint) string {
+	return chanPointsLabel("justice", []wire.OutPoint{chanPoint})
+}
+
+// chanPointsLabel returns a label of the form <kind>:<chanpoint>[,...].
+func chanPointsLabel(kind string, chanPoints []wire.OutPoint) string {
+	points := make([]string, 0, len(chanPoints))
+	for _, chanPoint := range chanPoints {
+		points = append(points, chanPoint.String())
+	}
+
+	return kind + ":" + strings.Join(points, ",")
+}
+
+// PublishLabeledTransaction broadcasts the transaction and, once it has been
+// accepted, stores the passed label for it. Transactions that fail to be
+// published are never labeled, and an existing label, for instance one set by
+// the user, is never replaced. Failing to store the label isn't reported to
+// the caller, as the transaction has already been broadcast at that point.
+func (l *LightningWallet) PublishLabeledTransaction(tx *wire.MsgTx,
+	label string) error {
+
+	if err := l.PublishTransaction(tx); err != nil {
+		return err
+	}
+
+	txid := tx.TxHash()
+	err := l.Cfg.Database.PutTxLabel(txid, label, false)
+	if err != nil && err != channeldb.ErrTxLabelExists {
+		walletLog.Errorf("Unable to label transaction %v as %q: %v",
+			txid, label, err)
+	}
+
+	return nil
+}
+
+// LabelTransaction sets the label of a transaction relevant to the wallet. If
+// the transaction already has a label, it's only replaced if overwrite is
+// true.
+func (l *LightningWallet) LabelTransaction(txid chainhash.Hash, label string,
+	overwrite bool) error {
+
+	txns, err := l.ListTransactionDetails(0, -1)
+	if err != nil {
+		return err
+	}
+
+	var known bool
+	for _, tx := range txns {
+		if tx.Hash == txid {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return ErrUnknownTransaction
+	}
+
+	return l.Cfg.Database.PutTxLabel(txid, label, overwrite)
+}
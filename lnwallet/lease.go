@@ -0,0 +1,163 @@
+package lnwallet
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+const (
+	// DefaultLeaseDuration is the duration of an output lease if the
+	// caller doesn't specify one.
+	DefaultLeaseDuration = 10 * time.Minute
+
+	// leaseExpiryInterval is the interval at which we'll check for output
+	// leases that have expired.
+	leaseExpiryInterval = time.Minute
+)
+
+// LeaseOutput locks an output of the wallet under the passed lock ID for the
+// given duration, excluding it from coin selection until it's either released
+// or the lease expires. Leases are persisted, so they survive restarts. If the
+// output is already leased under the same lock ID, the lease is extended.
+// The expiration of the lease is returned.
+func (l *LightningWallet) LeaseOutput(id [32]byte, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	if duration <= 0 {
+		duration = DefaultLeaseDuration
+	}
+
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	// As a leased output is locked, it won't be reported as unspent by
+	// the wallet. So unless we're extending an active lease of the same
+	// ID, we'll make sure the output is available before leasing it.
+	leases, err := l.Cfg.Database.FetchOutputLeases()
+	if err != nil {
+		return time.Time{}, err
+	}
+	now := time.Now()
+	var extending bool
+	for _, lease := range leases {
+		if lease.OutPoint == op && lease.LockID == id &&
+			!lease.Expired(now) {
+
+			extending = true
+			break
+		}
+	}
+	if !extending {
+		_, err := l.fetchUnspentInputs([]wire.OutPoint{op}, 0)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	lease := &channeldb.OutputLease{
+		OutPoint:   op,
+		LockID:     id,
+		Expiration: time.Unix(now.Add(duration).Unix(), 0),
+	}
+	if err := l.Cfg.Database.LeaseOutput(lease); err != nil {
+		return time.Time{}, err
+	}
+	l.leasedOutPoints[op] = struct{}{}
+	l.LockOutpoint(op)
+
+	walletLog.Infof("Leased output %v until %v", op, lease.Expiration)
+
+	return lease.Expiration, nil
+}
+
+// ReleaseOutput releases the lease of an output, making it eligible for coin
+// selection again. The output must have been leased under the passed lock ID.
+func (l *LightningWallet) ReleaseOutput(id [32]byte, op wire.OutPoint) error {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	if err := l.Cfg.Database.ReleaseOutput(id, op); err != nil {
+		return err
+	}
+	l.unlockLeasedOutPoint(op)
+
+	walletLog.Infof("Released output %v", op)
+
+	return nil
+}
+
+// syncLeases locks all outputs with an active lease within the wallet, and
+// releases all leases that have expired. It's called on startup to restore the
+// leases, and periodically after to expire them.
+func (l *LightningWallet) syncLeases() error {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	leases, err := l.Cfg.Database.FetchOutputLeases()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, lease := range leases {
+		if !lease.Expired(now) {
+			l.leasedOutPoints[lease.OutPoint] = struct{}{}
+			l.LockOutpoint(lease.OutPoint)
+			continue
+		}
+
+		err := l.Cfg.Database.ReleaseOutput(
+			lease.LockID, lease.OutPoint,
+		)
+		if err != nil {
+			return err
+		}
+		l.unlockLeasedOutPoint(lease.OutPoint)
+
+		walletLog.Infof("Lease of output %v expired", lease.OutPoint)
+	}
+
+	return nil
+}
+
+// unlockLeasedOutPoint removes the passed outpoint from the set of leased
+// outpoints. It's only unlocked within the underlying wallet if we locked it
+// for the lease, and no funding flow holds a lock on it in the meantime.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) unlockLeasedOutPoint(op wire.OutPoint) {
+	if _, ok := l.leasedOutPoints[op]; !ok {
+		return
+	}
+	delete(l.leasedOutPoints, op)
+
+	if _, ok := l.lockedOutPoints[op]; ok {
+		return
+	}
+	l.UnlockOutpoint(op)
+}
+
+// leaseExpirer periodically releases output leases that have expired.
+//
+// NOTE: This MUST be run as a goroutine.
+func (l *LightningWallet) leaseExpirer() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(leaseExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := l.syncLeases(); err != nil {
+				walletLog.Errorf("Unable to expire output "+
+					"leases: %v", err)
+			}
+
+		case <-l.quit:
+			return
+		}
+	}
+}
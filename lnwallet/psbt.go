@@ -0,0 +1,371 @@
+package lnwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// psbtMagic is the magic byte sequence that every serialized partially
+// signed bitcoin transaction (BIP 174) starts with.
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+const (
+	// psbtGlobalUnsignedTx is the key type of the global unsigned
+	// transaction within a PSBT.
+	psbtGlobalUnsignedTx = 0x00
+
+	// psbtInWitnessUtxo is the key type of the output spent by a witness
+	// input within a PSBT.
+	psbtInWitnessUtxo = 0x01
+
+	// psbtInRedeemScript is the key type of the redeem script of a P2SH
+	// input within a PSBT.
+	psbtInRedeemScript = 0x04
+
+	// psbtInBip32Derivation is the key type of the BIP32 derivation path
+	// of a key required to sign an input within a PSBT.
+	psbtInBip32Derivation = 0x06
+
+	// psbtInFinalScriptSig is the key type of the finalized scriptSig of
+	// an input within a PSBT.
+	psbtInFinalScriptSig = 0x07
+
+	// psbtInFinalScriptWitness is the key type of the finalized witness of
+	// an input within a PSBT.
+	psbtInFinalScriptWitness = 0x08
+
+	// psbtOutRedeemScript is the key type of the redeem script of a P2SH
+	// output within a PSBT.
+	psbtOutRedeemScript = 0x00
+
+	// psbtOutBip32Derivation is the key type of the BIP32 derivation path
+	// of a key an output pays to within a PSBT.
+	psbtOutBip32Derivation = 0x02
+
+	// maxPsbtValueSize is the maximum size of a single key or value
+	// within a PSBT that we'll read.
+	maxPsbtValueSize = wire.MaxBlockPayload
+)
+
+var (
+	// ErrNotPsbt is returned when the passed bytes don't start with the
+	// PSBT magic bytes.
+	ErrNotPsbt = errors.New("not a serialized psbt")
+
+	// ErrPsbtNotFinalized is returned when a PSBT is missing the finalized
+	// scriptSig or witness of one of its inputs.
+	ErrPsbtNotFinalized = errors.New("psbt is not finalized")
+)
+
+// IsPsbt returns true if the passed bytes look like a serialized partially
+// signed bitcoin transaction.
+func IsPsbt(b []byte) bool {
+	return bytes.HasPrefix(b, psbtMagic)
+}
+
+// psbtKeyValue is a single key-value pair within one of the maps of a PSBT.
+type psbtKeyValue struct {
+	key   []byte
+	value []byte
+}
+
+// readPsbtMap reads a single PSBT map, which consists of a number of
+// key-value pairs terminated by a zero length key.
+func readPsbtMap(r io.Reader) ([]psbtKeyValue, error) {
+	var pairs []psbtKeyValue
+	for {
+		key, err := wire.ReadVarBytes(
+			r, 0, maxPsbtValueSize, "psbt key",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// A zero length key acts as the separator between maps.
+		if len(key) == 0 {
+			return pairs, nil
+		}
+
+		value, err := wire.ReadVarBytes(
+			r, 0, maxPsbtValueSize, "psbt value",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, psbtKeyValue{key: key, value: value})
+	}
+}
+
+// ExtractPsbtTx parses a finalized partially signed bitcoin transaction as
+// defined in BIP 174, and returns the final, fully signed transaction. An
+// error is returned if any of the inputs of the PSBT haven't been finalized.
+func ExtractPsbtTx(psbt []byte) (*wire.MsgTx, error) {
+	if !IsPsbt(psbt) {
+		return nil, ErrNotPsbt
+	}
+	r := bytes.NewReader(psbt[len(psbtMagic):])
+
+	// The global map must contain the unsigned transaction, which
+	// determines the number of input and output maps that follow.
+	globals, err := readPsbtMap(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read psbt globals: %v", err)
+	}
+	var tx *wire.MsgTx
+	for _, kv := range globals {
+		if kv.key[0] != psbtGlobalUnsignedTx {
+			continue
+		}
+		if len(kv.key) != 1 || tx != nil {
+			return nil, errors.New("invalid psbt unsigned tx")
+		}
+
+		tx = wire.NewMsgTx(2)
+		err := tx.DeserializeNoWitness(bytes.NewReader(kv.value))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse psbt unsigned "+
+				"tx: %v", err)
+		}
+	}
+	if tx == nil {
+		return nil, errors.New("psbt is missing the unsigned tx")
+	}
+
+	// Each input map should carry the finalized scriptSig and/or witness
+	// for its input, which we'll attach to the unsigned transaction.
+	for i, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 {
+			return nil, errors.New("psbt unsigned tx has a non-empty " +
+				"scriptSig")
+		}
+
+		inputs, err := readPsbtMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read psbt input %v: "+
+				"%v", i, err)
+		}
+
+		var finalized bool
+		for _, kv := range inputs {
+			switch kv.key[0] {
+			case psbtInFinalScriptSig:
+				txIn.SignatureScript = kv.value
+				finalized = true
+
+			case psbtInFinalScriptWitness:
+				witness, err := readPsbtWitness(kv.value)
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse "+
+						"witness of psbt input %v: %v",
+						i, err)
+				}
+				txIn.Witness = witness
+				finalized = true
+			}
+		}
+		if !finalized {
+			return nil, fmt.Errorf("%v: input %v has no final "+
+				"scriptSig or witness", ErrPsbtNotFinalized, i)
+		}
+	}
+
+	// Finally, we'll make sure the output maps are present, even though
+	// none of their contents are needed to extract the transaction.
+	for i := range tx.TxOut {
+		if _, err := readPsbtMap(r); err != nil {
+			return nil, fmt.Errorf("unable to read psbt output %v: "+
+				"%v", i, err)
+		}
+	}
+
+	return tx, nil
+}
+
+// readPsbtWitness parses a witness serialized in the format used for the
+// final script witness field of a PSBT input.
+func readPsbtWitness(b []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(b)
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if numItems > uint64(len(b)) {
+		return nil, fmt.Errorf("invalid number of witness items: %v",
+			numItems)
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, maxPsbtValueSize, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
+
+// PsbtBip32Derivation describes how the key with the given public key is
+// derived from a master key, allowing a signer to locate it.
+type PsbtBip32Derivation struct {
+	// PubKey is the compressed public key being derived.
+	PubKey []byte
+
+	// MasterFingerprint is the fingerprint of the master key, being the
+	// first four bytes of the hash160 of its public key, interpreted as a
+	// big-endian integer.
+	MasterFingerprint uint32
+
+	// Path is the BIP32 derivation path of the key from the master key.
+	Path []uint32
+}
+
+// PsbtInput carries the information a signer needs to sign an input of an
+// unsigned PSBT.
+type PsbtInput struct {
+	// WitnessUtxo is the output spent by the input.
+	WitnessUtxo *wire.TxOut
+
+	// RedeemScript is the redeem script of the input if it spends a P2SH
+	// output.
+	RedeemScript []byte
+
+	// Bip32Derivation is the derivation of the key required to sign the
+	// input, if known.
+	Bip32Derivation *PsbtBip32Derivation
+}
+
+// PsbtOutput carries optional information about an output of an unsigned
+// PSBT, such as the derivation of its key if it's a change output.
+type PsbtOutput struct {
+	// RedeemScript is the redeem script of the output if it's a P2SH
+	// output.
+	RedeemScript []byte
+
+	// Bip32Derivation is the derivation of the key the output pays to, if
+	// known.
+	Bip32Derivation *PsbtBip32Derivation
+}
+
+// CreatePsbt serializes an unsigned PSBT as defined in BIP 174 for the passed
+// transaction. The inputs and outputs must match those of the transaction.
+func CreatePsbt(tx *wire.MsgTx, inputs []PsbtInput,
+	outputs []PsbtOutput) ([]byte, error) {
+
+	if len(inputs) != len(tx.TxIn) || len(outputs) != len(tx.TxOut) {
+		return nil, errors.New("psbt inputs and outputs don't match tx")
+	}
+
+	var b bytes.Buffer
+	b.Write(psbtMagic)
+
+	// The unsigned transaction must not contain any scriptSigs or
+	// witnesses.
+	unsignedTx := tx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.SignatureScript = nil
+		txIn.Witness = nil
+	}
+	var txBuf bytes.Buffer
+	if err := unsignedTx.SerializeNoWitness(&txBuf); err != nil {
+		return nil, err
+	}
+	err := writePsbtPair(&b, []byte{psbtGlobalUnsignedTx}, txBuf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	b.WriteByte(0x00)
+
+	for _, input := range inputs {
+		if input.WitnessUtxo != nil {
+			var utxoBuf bytes.Buffer
+			err := wire.WriteTxOut(&utxoBuf, 0, 0, input.WitnessUtxo)
+			if err != nil {
+				return nil, err
+			}
+			err = writePsbtPair(
+				&b, []byte{psbtInWitnessUtxo}, utxoBuf.Bytes(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(input.RedeemScript) != 0 {
+			err := writePsbtPair(
+				&b, []byte{psbtInRedeemScript},
+				input.RedeemScript,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if input.Bip32Derivation != nil {
+			err := writePsbtDerivation(
+				&b, psbtInBip32Derivation,
+				input.Bip32Derivation,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b.WriteByte(0x00)
+	}
+
+	for _, output := range outputs {
+		if len(output.RedeemScript) != 0 {
+			err := writePsbtPair(
+				&b, []byte{psbtOutRedeemScript},
+				output.RedeemScript,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if output.Bip32Derivation != nil {
+			err := writePsbtDerivation(
+				&b, psbtOutBip32Derivation,
+				output.Bip32Derivation,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b.WriteByte(0x00)
+	}
+
+	return b.Bytes(), nil
+}
+
+// writePsbtPair writes a single key-value pair of a PSBT map.
+func writePsbtPair(w io.Writer, key, value []byte) error {
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// writePsbtDerivation writes a BIP32 derivation path of the given key type,
+// which is keyed by the public key and holds the master key fingerprint
+// followed by the little-endian path indexes.
+func writePsbtDerivation(w io.Writer, keyType byte,
+	derivation *PsbtBip32Derivation) error {
+
+	key := append([]byte{keyType}, derivation.PubKey...)
+
+	value := make([]byte, 4+4*len(derivation.Path))
+	binary.BigEndian.PutUint32(value[:4], derivation.MasterFingerprint)
+	for i, index := range derivation.Path {
+		binary.LittleEndian.PutUint32(value[4+4*i:], index)
+	}
+
+	return writePsbtPair(w, key, value)
+}
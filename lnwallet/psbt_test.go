@@ -0,0 +1,126 @@
+package lnwallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// writePsbtKeyValue writes a single key-value pair of a PSBT map.
+func writePsbtKeyValue(t *testing.T, w *bytes.Buffer, key, value []byte) {
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		t.Fatalf("unable to write key: %v", err)
+	}
+	if err := wire.WriteVarBytes(w, 0, value); err != nil {
+		t.Fatalf("unable to write value: %v", err)
+	}
+}
+
+// serializePsbt creates a PSBT for the passed signed transaction. If finalize
+// is false, then the final witnesses of the inputs are left out.
+func serializePsbt(t *testing.T, signedTx *wire.MsgTx, finalize bool) []byte {
+	var b bytes.Buffer
+	b.Write(psbtMagic)
+
+	unsignedTx := signedTx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.Witness = nil
+	}
+	var txBuf bytes.Buffer
+	if err := unsignedTx.SerializeNoWitness(&txBuf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	writePsbtKeyValue(
+		t, &b, []byte{psbtGlobalUnsignedTx}, txBuf.Bytes(),
+	)
+	b.WriteByte(0x00)
+
+	for _, txIn := range signedTx.TxIn {
+		// We'll add an unrelated partial signature to each input, which
+		// should be ignored.
+		writePsbtKeyValue(t, &b, []byte{0x02, 0x01}, []byte{0x01})
+
+		if finalize {
+			var witBuf bytes.Buffer
+			wire.WriteVarInt(&witBuf, 0, uint64(len(txIn.Witness)))
+			for _, item := range txIn.Witness {
+				wire.WriteVarBytes(&witBuf, 0, item)
+			}
+			writePsbtKeyValue(
+				t, &b, []byte{psbtInFinalScriptWitness},
+				witBuf.Bytes(),
+			)
+		}
+		b.WriteByte(0x00)
+	}
+
+	for range signedTx.TxOut {
+		b.WriteByte(0x00)
+	}
+
+	return b.Bytes()
+}
+
+// TestExtractPsbtTx tests that the final transaction can be extracted from a
+// finalized PSBT, and that PSBTs which haven't been finalized are rejected.
+func TestExtractPsbtTx(t *testing.T) {
+	t.Parallel()
+
+	signedTx := wire.NewMsgTx(2)
+	signedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: 1,
+		},
+		Witness: wire.TxWitness{
+			bytes.Repeat([]byte{0x02}, 72),
+			bytes.Repeat([]byte{0x03}, 33),
+		},
+	})
+	signedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{2},
+			Index: 0,
+		},
+		Witness: wire.TxWitness{
+			nil,
+			bytes.Repeat([]byte{0x04}, 72),
+			bytes.Repeat([]byte{0x05}, 71),
+			bytes.Repeat([]byte{0x06}, 71),
+		},
+	})
+	signedTx.AddTxOut(&wire.TxOut{
+		Value:    100000,
+		PkScript: bytes.Repeat([]byte{0x07}, 34),
+	})
+
+	tx, err := ExtractPsbtTx(serializePsbt(t, signedTx, true))
+	if err != nil {
+		t.Fatalf("unable to extract psbt tx: %v", err)
+	}
+	if tx.WitnessHash() != signedTx.WitnessHash() {
+		t.Fatalf("extracted tx doesn't match: expected %v, got %v",
+			signedTx.WitnessHash(), tx.WitnessHash())
+	}
+
+	// A PSBT which hasn't been finalized must be rejected, as it can't be
+	// broadcast.
+	_, err = ExtractPsbtTx(serializePsbt(t, signedTx, false))
+	if err == nil {
+		t.Fatalf("expected non-finalized psbt to be rejected")
+	}
+
+	// Finally, a raw transaction isn't a PSBT.
+	var txBuf bytes.Buffer
+	if err := signedTx.Serialize(&txBuf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	if IsPsbt(txBuf.Bytes()) {
+		t.Fatalf("raw tx detected as psbt")
+	}
+	if _, err := ExtractPsbtTx(txBuf.Bytes()); err != ErrNotPsbt {
+		t.Fatalf("expected ErrNotPsbt, got %v", err)
+	}
+}
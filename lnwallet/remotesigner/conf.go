@@ -0,0 +1,19 @@
+package remotesigner
+
+import "time"
+
+// Conf specifies the remote signer options that can be configured from the
+// command line or configuration file.
+type Conf struct {
+	Active bool `long:"active" description:"If true, this node runs a watch-only wallet exported by a remote signer, which holds the seed of this node and does all signing and key derivation"`
+
+	Serve bool `long:"serve" description:"If true, the RemoteSigner RPC service is exposed so that a watch-only node can use this node as its remote signer"`
+
+	RPCHost string `long:"rpchost" description:"The host:port of the remote signer's RPC server"`
+
+	MacaroonPath string `long:"macaroonpath" description:"Path to the remote signer macaroon"`
+
+	TLSCertPath string `long:"tlscertpath" description:"Path to the TLS certificate of the remote signer's RPC server"`
+
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single request to the remote signer"`
+}
@@ -0,0 +1,176 @@
+package remotesigner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// MarshalKeyDescriptor converts a key descriptor into its RPC representation.
+func MarshalKeyDescriptor(keyDesc keychain.KeyDescriptor) *lnrpc.KeyDescriptor {
+	rpcDesc := &lnrpc.KeyDescriptor{
+		KeyLoc: &lnrpc.KeyLocator{
+			KeyFamily: uint32(keyDesc.Family),
+			KeyIndex:  keyDesc.Index,
+		},
+	}
+	if keyDesc.PubKey != nil {
+		rpcDesc.RawKeyBytes = keyDesc.PubKey.SerializeCompressed()
+	}
+
+	return rpcDesc
+}
+
+// UnmarshalKeyDescriptor parses the RPC representation of a key descriptor.
+// Either the public key or a non-empty key locator must be set.
+func UnmarshalKeyDescriptor(
+	rpcDesc *lnrpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	var keyDesc keychain.KeyDescriptor
+	if rpcDesc == nil {
+		return keyDesc, errors.New("missing key descriptor")
+	}
+
+	if rpcDesc.KeyLoc != nil {
+		keyDesc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
+			Index:  rpcDesc.KeyLoc.KeyIndex,
+		}
+	}
+
+	if len(rpcDesc.RawKeyBytes) != 0 {
+		pubKey, err := btcec.ParsePubKey(
+			rpcDesc.RawKeyBytes, btcec.S256(),
+		)
+		if err != nil {
+			return keyDesc, fmt.Errorf("invalid public key: %v",
+				err)
+		}
+		keyDesc.PubKey = pubKey
+	}
+
+	if keyDesc.PubKey == nil && keyDesc.KeyLocator.IsEmpty() {
+		return keyDesc, errors.New("key descriptor must have either " +
+			"a public key or a non-empty key locator")
+	}
+
+	return keyDesc, nil
+}
+
+// MarshalSignDescriptor converts a sign descriptor into its RPC
+// representation. The sighash midstate isn't included, as it's recomputed from
+// the transaction by the signer. An empty key descriptor, as used for wallet
+// inputs, is omitted.
+func MarshalSignDescriptor(
+	signDesc *lnwallet.SignDescriptor) *lnrpc.SignDescriptor {
+
+	rpcDesc := &lnrpc.SignDescriptor{
+		SingleTweak:   signDesc.SingleTweak,
+		WitnessScript: signDesc.WitnessScript,
+		Sighash:       uint32(signDesc.HashType),
+		InputIndex:    int32(signDesc.InputIndex),
+	}
+	if signDesc.KeyDesc.PubKey != nil ||
+		!signDesc.KeyDesc.KeyLocator.IsEmpty() {
+
+		rpcDesc.KeyDesc = MarshalKeyDescriptor(signDesc.KeyDesc)
+	}
+	if signDesc.DoubleTweak != nil {
+		rpcDesc.DoubleTweak = signDesc.DoubleTweak.Serialize()
+	}
+	if signDesc.Output != nil {
+		rpcDesc.Output = &lnrpc.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		}
+	}
+
+	return rpcDesc
+}
+
+// UnmarshalSignDescriptor parses the RPC representation of a sign descriptor
+// for an input of the passed transaction. A missing key descriptor is left
+// empty, which is only valid when computing the input script of a wallet
+// output.
+func UnmarshalSignDescriptor(rpcDesc *lnrpc.SignDescriptor,
+	tx *wire.MsgTx) (*lnwallet.SignDescriptor, error) {
+
+	if rpcDesc.Output == nil {
+		return nil, errors.New("sign descriptor is missing the output")
+	}
+	if rpcDesc.InputIndex < 0 || int(rpcDesc.InputIndex) >= len(tx.TxIn) {
+		return nil, fmt.Errorf("invalid input index %v",
+			rpcDesc.InputIndex)
+	}
+
+	var keyDesc keychain.KeyDescriptor
+	if rpcDesc.KeyDesc != nil {
+		var err error
+		keyDesc, err = UnmarshalKeyDescriptor(rpcDesc.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signDesc := &lnwallet.SignDescriptor{
+		KeyDesc:       keyDesc,
+		SingleTweak:   rpcDesc.SingleTweak,
+		WitnessScript: rpcDesc.WitnessScript,
+		Output: &wire.TxOut{
+			Value:    rpcDesc.Output.Value,
+			PkScript: rpcDesc.Output.PkScript,
+		},
+		HashType:   txscript.SigHashType(rpcDesc.Sighash),
+		SigHashes:  txscript.NewTxSigHashes(tx),
+		InputIndex: int(rpcDesc.InputIndex),
+	}
+	if len(rpcDesc.DoubleTweak) != 0 {
+		signDesc.DoubleTweak, _ = btcec.PrivKeyFromBytes(
+			btcec.S256(), rpcDesc.DoubleTweak,
+		)
+	}
+
+	return signDesc, nil
+}
+
+// MarshalWalletKeyPath converts the BIP0044 path of a wallet key into its RPC
+// representation.
+func MarshalWalletKeyPath(scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) *lnrpc.WalletKeyPath {
+
+	return &lnrpc.WalletKeyPath{
+		Purpose:  scope.Purpose,
+		CoinType: scope.Coin,
+		Account:  path.Account,
+		Branch:   path.Branch,
+		Index:    path.Index,
+	}
+}
+
+// serializeTx serializes the passed transaction, including its witnesses.
+func serializeTx(tx *wire.MsgTx) ([]byte, error) {
+	var b bytes.Buffer
+	if err := tx.Serialize(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeTx parses a serialized transaction.
+func deserializeTx(rawTx []byte) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(2)
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, fmt.Errorf("unable to parse tx: %v", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,329 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// DefaultTimeout is the default timeout of a single request to the remote
+// signer.
+const DefaultTimeout = 30 * time.Second
+
+// RemoteSigner is an implementation of the lnwallet.Signer,
+// lnwallet.MessageSigner and keychain.SecretKeyRing interfaces which forwards
+// all operations to a remote signer over gRPC. The remote signer holds the
+// seed, so the keys guarding channel funds never enter this process. The
+// node key, revocation roots and watchtower session keys are the exception,
+// as they're still used in raw form and fetched through DerivePrivKey.
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  lnrpc.RemoteSignerClient
+	timeout time.Duration
+}
+
+// A compile time check to ensure that RemoteSigner implements the Signer,
+// MessageSigner and SecretKeyRing interfaces.
+var _ lnwallet.Signer = (*RemoteSigner)(nil)
+var _ lnwallet.MessageSigner = (*RemoteSigner)(nil)
+var _ keychain.SecretKeyRing = (*RemoteSigner)(nil)
+
+// New connects to the remote signer described by the passed config. The
+// connection is authenticated using the signer's TLS certificate and macaroon.
+func New(cfg *Conf) (*RemoteSigner, error) {
+	if cfg.RPCHost == "" {
+		return nil, errors.New("remote signer rpc host must be set")
+	}
+
+	tlsCreds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote signer tls "+
+			"cert: %v", err)
+	}
+
+	macBytes, err := ioutil.ReadFile(cfg.MacaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote signer "+
+			"macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode remote signer "+
+			"macaroon: %v", err)
+	}
+
+	conn, err := grpc.Dial(
+		cfg.RPCHost, grpc.WithTransportCredentials(tlsCreds),
+		grpc.WithPerRPCCredentials(
+			macaroons.NewMacaroonCredential(mac),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer: "+
+			"%v", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	return newRemoteSigner(conn, timeout), nil
+}
+
+// newRemoteSigner creates a new RemoteSigner using an established connection
+// to the remote signer.
+func newRemoteSigner(conn *grpc.ClientConn,
+	timeout time.Duration) *RemoteSigner {
+
+	return &RemoteSigner{
+		conn:    conn,
+		client:  lnrpc.NewRemoteSignerClient(conn),
+		timeout: timeout,
+	}
+}
+
+// Stop closes the connection to the remote signer.
+func (r *RemoteSigner) Stop() error {
+	return r.conn.Close()
+}
+
+// SignOutputRaw generates a signature for the passed transaction according to
+// the data within the passed SignDescriptor.
+//
+// NOTE: This is part of the lnwallet.Signer interface.
+func (r *RemoteSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	rawTx, err := serializeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.SignOutputRaw(ctx, &lnrpc.SignReq{
+		RawTxBytes: rawTx,
+		SignDescs: []*lnrpc.SignDescriptor{
+			MarshalSignDescriptor(signDesc),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RawSigs) != 1 {
+		return nil, fmt.Errorf("expected 1 signature from remote "+
+			"signer, got %v", len(resp.RawSigs))
+	}
+
+	return resp.RawSigs[0], nil
+}
+
+// ComputeInputScript generates a complete InputScript for the passed
+// transaction with the signature as defined within the passed SignDescriptor.
+//
+// NOTE: This is part of the lnwallet.Signer interface.
+func (r *RemoteSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return r.computeInputScript(tx, MarshalSignDescriptor(signDesc))
+}
+
+// ComputeWalletInputScript generates a complete InputScript for an output of
+// the watch-only wallet, which pays to the wallet key at the passed path. The
+// remote signer derives the key from its own copy of the wallet.
+func (r *RemoteSigner) ComputeWalletInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor, scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) (*lnwallet.InputScript, error) {
+
+	rpcDesc := MarshalSignDescriptor(signDesc)
+	rpcDesc.WalletKeyPath = MarshalWalletKeyPath(scope, path)
+
+	return r.computeInputScript(tx, rpcDesc)
+}
+
+// computeInputScript requests the input script for the passed sign descriptor
+// from the remote signer.
+func (r *RemoteSigner) computeInputScript(tx *wire.MsgTx,
+	rpcDesc *lnrpc.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	rawTx, err := serializeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.ComputeInputScript(ctx, &lnrpc.SignReq{
+		RawTxBytes: rawTx,
+		SignDescs:  []*lnrpc.SignDescriptor{rpcDesc},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.InputScripts) != 1 {
+		return nil, fmt.Errorf("expected 1 input script from remote "+
+			"signer, got %v", len(resp.InputScripts))
+	}
+
+	return &lnwallet.InputScript{
+		Witness:   resp.InputScripts[0].Witness,
+		ScriptSig: resp.InputScripts[0].SigScript,
+	}, nil
+}
+
+// ExportWatchOnlyWallet fetches a copy of the remote signer's wallet with all
+// private key material removed. The copy is encrypted with the default public
+// passphrase.
+func (r *RemoteSigner) ExportWatchOnlyWallet() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.ExportWatchOnlyWallet(
+		ctx, &lnrpc.ExportWatchOnlyWalletRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.WalletDb, nil
+}
+
+// SignMessage signs the double SHA-256 digest of the passed message with the
+// private key that corresponds to the passed public key.
+//
+// NOTE: This is part of the lnwallet.MessageSigner interface.
+func (r *RemoteSigner) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.SignMessage(ctx, &lnrpc.KeySignMessageRequest{
+		RawKeyBytes: pubKey.SerializeCompressed(),
+		Msg:         msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.ParseDERSignature(resp.Signature, btcec.S256())
+}
+
+// DeriveNextKey derives the next external key within the passed key family.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RemoteSigner) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.DeriveNextKey(ctx, &lnrpc.KeyReq{
+		KeyFamily: uint32(keyFam),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return unmarshalDerivedKey(resp)
+}
+
+// DeriveKey derives the key specified by the passed key locator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RemoteSigner) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.DeriveKey(ctx, &lnrpc.KeyLocator{
+		KeyFamily: uint32(keyLoc.Family),
+		KeyIndex:  keyLoc.Index,
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return unmarshalDerivedKey(resp)
+}
+
+// unmarshalDerivedKey parses a key descriptor returned by the remote signer,
+// which must include the public key.
+func unmarshalDerivedKey(
+	rpcDesc *lnrpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	keyDesc, err := UnmarshalKeyDescriptor(rpcDesc)
+	if err != nil {
+		return keyDesc, err
+	}
+	if keyDesc.PubKey == nil {
+		return keyDesc, errors.New("remote signer didn't return the " +
+			"derived public key")
+	}
+
+	return keyDesc, nil
+}
+
+// DerivePrivKey fetches the private key that corresponds to the passed key
+// descriptor from the remote signer. The remote signer only returns the
+// private keys of the node key, revocation root and watchtower session key
+// families.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RemoteSigner) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.DerivePrivKey(ctx, MarshalKeyDescriptor(keyDesc))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RawPrivKey) != btcec.PrivKeyBytesLen {
+		return nil, fmt.Errorf("invalid private key length %v",
+			len(resp.RawPrivKey))
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), resp.RawPrivKey)
+	return privKey, nil
+}
+
+// ScalarMult performs an ECDH operation between the key described by the
+// passed key descriptor and the passed public key on the remote signer,
+// returning the SHA-256 of the compressed shared point.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RemoteSigner) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	resp, err := r.client.ScalarMult(ctx, &lnrpc.SharedKeyRequest{
+		KeyDesc:         MarshalKeyDescriptor(keyDesc),
+		EphemeralPubkey: pubKey.SerializeCompressed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.SharedKey, nil
+}
@@ -0,0 +1,252 @@
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"google.golang.org/grpc"
+)
+
+// mockWallet implements the signer, message signer and secret key ring of the
+// signing node using a single private key.
+type mockWallet struct {
+	privKey *btcec.PrivateKey
+	keyLoc  keychain.KeyLocator
+}
+
+func (m *mockWallet) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	if signDesc.KeyDesc.KeyLocator != m.keyLoc {
+		return nil, fmt.Errorf("unknown key locator %v",
+			signDesc.KeyDesc.KeyLocator)
+	}
+
+	sig, err := txscript.RawTxInWitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.WitnessScript,
+		signDesc.HashType, m.privKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:len(sig)-1], nil
+}
+
+func (m *mockWallet) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	witness, err := txscript.WitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.Output.PkScript,
+		signDesc.HashType, m.privKey, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwallet.InputScript{Witness: witness}, nil
+}
+
+func (m *mockWallet) SignMessage(pubKey *btcec.PublicKey,
+	msg []byte) (*btcec.Signature, error) {
+
+	if !pubKey.IsEqual(m.privKey.PubKey()) {
+		return nil, fmt.Errorf("unknown public key")
+	}
+
+	return m.privKey.Sign(chainhash.DoubleHashB(msg))
+}
+
+func (m *mockWallet) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return m.DeriveKey(keychain.KeyLocator{Family: keyFam})
+}
+
+func (m *mockWallet) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	if keyLoc.Family != m.keyLoc.Family {
+		return keychain.KeyDescriptor{}, fmt.Errorf("unknown key "+
+			"family %v", keyLoc.Family)
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: m.keyLoc,
+		PubKey:     m.privKey.PubKey(),
+	}, nil
+}
+
+func (m *mockWallet) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	return m.privKey, nil
+}
+
+func (m *mockWallet) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pub *btcec.PublicKey) ([]byte, error) {
+
+	x, y := btcec.S256().ScalarMult(pub.X, pub.Y, m.privKey.D.Bytes())
+	s := &btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}
+	h := sha256.Sum256(s.SerializeCompressed())
+
+	return h[:], nil
+}
+
+// startRemoteSigner serves the RemoteSigner service backed by the passed
+// wallet, and returns a RemoteSigner connected to it.
+func startRemoteSigner(t *testing.T, w *mockWallet) (*RemoteSigner, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	lnrpc.RegisterRemoteSignerServer(
+		grpcServer, NewServer(w, w, w, nil, nil),
+	)
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to dial remote signer: %v", err)
+	}
+	signer := newRemoteSigner(conn, 5*time.Second)
+
+	return signer, func() {
+		signer.Stop()
+		grpcServer.Stop()
+	}
+}
+
+// TestRemoteSigner tests that signing and key derivation are forwarded to the
+// remote signer, and that their results are returned intact.
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	w := &mockWallet{
+		privKey: privKey,
+		keyLoc: keychain.KeyLocator{
+			Family: keychain.KeyFamilyMultiSig,
+			Index:  7,
+		},
+	}
+
+	signer, cleanUp := startRemoteSigner(t, w)
+	defer cleanUp()
+
+	keyDesc, err := signer.DeriveNextKey(keychain.KeyFamilyMultiSig)
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	if keyDesc.KeyLocator != w.keyLoc ||
+		!keyDesc.PubKey.IsEqual(privKey.PubKey()) {
+
+		t.Fatalf("unexpected key descriptor: %v", keyDesc)
+	}
+
+	// The private keys guarding channel funds must never be handed out,
+	// while those the watch-only node needs in raw form are.
+	if _, err := signer.DerivePrivKey(keyDesc); err == nil {
+		t.Fatalf("expected multisig private key to be refused")
+	}
+	derivedPriv, err := signer.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to derive private key: %v", err)
+	}
+	if !bytes.Equal(derivedPriv.Serialize(), privKey.Serialize()) {
+		t.Fatalf("derived private key doesn't match")
+	}
+
+	// Sign a p2wsh spend with the remote signer, and verify the
+	// signature against the sighash of the input.
+	witnessScript := []byte{txscript.OP_TRUE}
+	pkScript, err := lnwallet.WitnessScriptHash(witnessScript)
+	if err != nil {
+		t.Fatalf("unable to create pkscript: %v", err)
+	}
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: pkScript})
+
+	signDesc := &lnwallet.SignDescriptor{
+		KeyDesc:       keyDesc,
+		WitnessScript: witnessScript,
+		Output:        &wire.TxOut{Value: 2000, PkScript: pkScript},
+		HashType:      txscript.SigHashAll,
+		SigHashes:     txscript.NewTxSigHashes(tx),
+	}
+	rawSig, err := signer.SignOutputRaw(tx, signDesc)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	sig, err := btcec.ParseDERSignature(rawSig, btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, signDesc.SigHashes, txscript.SigHashAll, tx, 0,
+		2000,
+	)
+	if err != nil {
+		t.Fatalf("unable to compute sighash: %v", err)
+	}
+	if !sig.Verify(sigHash, privKey.PubKey()) {
+		t.Fatalf("invalid signature from remote signer")
+	}
+
+	msg := []byte("remote")
+	msgSig, err := signer.SignMessage(privKey.PubKey(), msg)
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+	if !msgSig.Verify(chainhash.DoubleHashB(msg), privKey.PubKey()) {
+		t.Fatalf("invalid message signature from remote signer")
+	}
+
+	// The shared secret computed by the remote signer must match the one
+	// computed with the private key locally.
+	ephemeral, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	sharedKey, err := signer.ScalarMult(keyDesc, ephemeral.PubKey())
+	if err != nil {
+		t.Fatalf("unable to perform ecdh: %v", err)
+	}
+	expectedKey, _ := w.ScalarMult(keyDesc, ephemeral.PubKey())
+	if !bytes.Equal(sharedKey, expectedKey) {
+		t.Fatalf("shared key mismatch")
+	}
+
+	// Errors of the signing node must be returned to the caller.
+	_, err = signer.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+	})
+	if err == nil {
+		t.Fatalf("expected derivation of unknown family to fail")
+	}
+}
@@ -0,0 +1,266 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"golang.org/x/net/context"
+)
+
+// privKeyFamilies is the set of key families whose private keys are handed out
+// by DerivePrivKey. A watch-only node still requires these keys in raw form:
+// the node key, which is used by brontide and to sign gossip messages, the
+// revocation roots from which the per-commitment secrets of each channel are
+// derived, and the session keys used by brontide to connect to watchtowers.
+// Any other key, such as the multisig keys and base points guarding channel
+// funds, can only be used through the signing RPCs.
+var privKeyFamilies = map[keychain.KeyFamily]struct{}{
+	keychain.KeyFamilyRevocationRoot: {},
+	keychain.KeyFamilyNodeKey:        {},
+	keychain.KeyFamilyTowerSession:   {},
+}
+
+// Server implements the RemoteSigner gRPC service on behalf of a watch-only
+// node, backed by the signer, message signer and secret key ring of the
+// wallet that holds the seed.
+type Server struct {
+	signer    lnwallet.Signer
+	msgSigner lnwallet.MessageSigner
+	keyRing   keychain.SecretKeyRing
+
+	// wallet is the wallet that holds the seed. It's used to export the
+	// watch-only wallet and to derive the keys of its addresses. If nil,
+	// neither is supported.
+	wallet *base.Wallet
+
+	// pubPass is the public passphrase of the wallet.
+	pubPass []byte
+}
+
+// A compile time check to ensure that Server fully implements the
+// RemoteSignerServer gRPC service.
+var _ lnrpc.RemoteSignerServer = (*Server)(nil)
+
+// NewServer creates a new RemoteSigner service backed by the passed signer,
+// message signer and key ring. The wallet, unlocked with the passed public
+// passphrase, is optional and only needed to serve watch-only nodes that keep
+// their on-chain funds in the signer's wallet.
+func NewServer(signer lnwallet.Signer, msgSigner lnwallet.MessageSigner,
+	keyRing keychain.SecretKeyRing, wallet *base.Wallet,
+	pubPass []byte) *Server {
+
+	return &Server{
+		signer:    signer,
+		msgSigner: msgSigner,
+		keyRing:   keyRing,
+		wallet:    wallet,
+		pubPass:   pubPass,
+	}
+}
+
+// SignOutputRaw generates a signature for each of the passed sign
+// descriptors.
+func (s *Server) SignOutputRaw(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.SignResp, error) {
+
+	tx, err := deserializeTx(in.RawTxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.SignResp{}
+	for _, rpcDesc := range in.SignDescs {
+		signDesc, err := UnmarshalSignDescriptor(rpcDesc, tx)
+		if err != nil {
+			return nil, err
+		}
+		if signDesc.KeyDesc.PubKey == nil &&
+			signDesc.KeyDesc.KeyLocator.IsEmpty() {
+
+			return nil, errors.New("missing key descriptor")
+		}
+
+		sig, err := s.signer.SignOutputRaw(tx, signDesc)
+		if err != nil {
+			return nil, err
+		}
+		resp.RawSigs = append(resp.RawSigs, sig)
+	}
+
+	return resp, nil
+}
+
+// ComputeInputScript generates a complete input script for each of the passed
+// sign descriptors.
+func (s *Server) ComputeInputScript(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.InputScriptResp, error) {
+
+	tx, err := deserializeTx(in.RawTxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.InputScriptResp{}
+	for _, rpcDesc := range in.SignDescs {
+		signDesc, err := UnmarshalSignDescriptor(rpcDesc, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Outputs of a watch-only node's wallet may pay to addresses
+		// that our wallet hasn't derived yet, so we'll derive them
+		// before looking up the key to sign with.
+		if rpcDesc.WalletKeyPath != nil {
+			err := s.deriveWalletKey(rpcDesc.WalletKeyPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		inputScript, err := s.signer.ComputeInputScript(tx, signDesc)
+		if err != nil {
+			return nil, err
+		}
+		if inputScript == nil {
+			return nil, errors.New("unable to find key for input")
+		}
+		resp.InputScripts = append(resp.InputScripts,
+			&lnrpc.InputScript{
+				Witness:   inputScript.Witness,
+				SigScript: inputScript.ScriptSig,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// ExportWatchOnlyWallet returns a copy of the signer's wallet database with
+// all private key material removed.
+func (s *Server) ExportWatchOnlyWallet(ctx context.Context,
+	in *lnrpc.ExportWatchOnlyWalletRequest) (
+	*lnrpc.ExportWatchOnlyWalletResponse, error) {
+
+	if s.wallet == nil {
+		return nil, errors.New("signer doesn't support exporting a " +
+			"watch-only wallet")
+	}
+
+	walletDB, err := exportWatchOnlyWallet(
+		s.wallet.Database(), s.pubPass, s.wallet.ChainParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ExportWatchOnlyWalletResponse{
+		WalletDb: walletDB,
+	}, nil
+}
+
+// SignMessage signs the passed message with the private key that corresponds
+// to the passed public key.
+func (s *Server) SignMessage(ctx context.Context,
+	in *lnrpc.KeySignMessageRequest) (*lnrpc.KeySignMessageResponse,
+	error) {
+
+	pubKey, err := btcec.ParsePubKey(in.RawKeyBytes, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+
+	sig, err := s.msgSigner.SignMessage(pubKey, in.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.KeySignMessageResponse{
+		Signature: sig.Serialize(),
+	}, nil
+}
+
+// DerivePrivKey returns the private key described by the passed key
+// descriptor. Only keys of the families in privKeyFamilies are returned.
+func (s *Server) DerivePrivKey(ctx context.Context,
+	in *lnrpc.KeyDescriptor) (*lnrpc.DerivePrivKeyResponse, error) {
+
+	keyDesc, err := UnmarshalKeyDescriptor(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := privKeyFamilies[keyDesc.Family]; !ok {
+		return nil, fmt.Errorf("private keys of key family %d can't "+
+			"be exported", keyDesc.Family)
+	}
+
+	privKey, err := s.keyRing.DerivePrivKey(keyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DerivePrivKeyResponse{
+		RawPrivKey: privKey.Serialize(),
+	}, nil
+}
+
+// DeriveNextKey derives the next unused key within the passed key family.
+func (s *Server) DeriveNextKey(ctx context.Context,
+	in *lnrpc.KeyReq) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := s.keyRing.DeriveNextKey(
+		keychain.KeyFamily(in.KeyFamily),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalKeyDescriptor(keyDesc), nil
+}
+
+// DeriveKey derives the public key at the passed key locator.
+func (s *Server) DeriveKey(ctx context.Context,
+	in *lnrpc.KeyLocator) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := s.keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamily(in.KeyFamily),
+		Index:  in.KeyIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalKeyDescriptor(keyDesc), nil
+}
+
+// ScalarMult performs an ECDH operation between the key described by the
+// passed key descriptor and the passed public key.
+func (s *Server) ScalarMult(ctx context.Context,
+	in *lnrpc.SharedKeyRequest) (*lnrpc.SharedKeyResponse, error) {
+
+	keyDesc, err := UnmarshalKeyDescriptor(in.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.EphemeralPubkey) == 0 {
+		return nil, errors.New("missing ephemeral public key")
+	}
+	pubKey, err := btcec.ParsePubKey(in.EphemeralPubkey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ephemeral public key: %v", err)
+	}
+
+	sharedKey, err := s.keyRing.ScalarMult(keyDesc, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SharedKeyResponse{
+		SharedKey: sharedKey,
+	}, nil
+}
@@ -0,0 +1,198 @@
+package remotesigner
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+
+	// This is required to register bdb as a valid walletdb driver.
+	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
+)
+
+// maxWalletKeyLookahead is the maximum number of addresses beyond those it
+// has already derived that the signer derives in order to sign for an output
+// of a watch-only node. This prevents a watch-only node from making the signer
+// derive an arbitrary number of keys.
+const maxWalletKeyLookahead = 10000
+
+var (
+	// waddrmgrNamespaceKey is the namespace of the address manager within
+	// the wallet database.
+	waddrmgrNamespaceKey = []byte("waddrmgr")
+
+	// walletNamespaceKeys are the top-level buckets of the wallet
+	// database, which are copied to the watch-only wallet.
+	walletNamespaceKeys = [][]byte{waddrmgrNamespaceKey, []byte("wtxmgr")}
+)
+
+// exportWatchOnlyWallet returns a copy of the passed wallet database with all
+// private key material removed. The public data of the copy is encrypted with
+// the default public passphrase, rather than the passed one of the wallet.
+func exportWatchOnlyWallet(db walletdb.DB, pubPass []byte,
+	netParams *chaincfg.Params) ([]byte, error) {
+
+	tempDir, err := ioutil.TempDir("", "watchonly")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	// We'll start with a full copy of the wallet, which we then convert to
+	// a watch-only wallet.
+	copyPath := filepath.Join(tempDir, "copy.db")
+	copyFile, err := os.Create(copyPath)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Copy(copyFile)
+	copyFile.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	copyDB, err := walletdb.Open("bdb", copyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer copyDB.Close()
+
+	err = walletdb.Update(copyDB, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		mgr, err := waddrmgr.Open(ns, pubPass, netParams)
+		if err != nil {
+			return err
+		}
+		defer mgr.Close()
+
+		if err := mgr.ConvertToWatchingOnly(ns); err != nil {
+			return err
+		}
+
+		return mgr.ChangePassphrase(
+			ns, pubPass, lnwallet.DefaultPublicPassphrase, false,
+			&waddrmgr.DefaultScryptOptions,
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert wallet to "+
+			"watch-only: %v", err)
+	}
+
+	// The pages of the removed private keys are only freed, not
+	// overwritten, so we'll copy the remaining data into a fresh database
+	// that doesn't contain them.
+	exportPath := filepath.Join(tempDir, "wallet.db")
+	exportDB, err := walletdb.Create("bdb", exportPath)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.View(copyDB, func(srcTx walletdb.ReadTx) error {
+		return walletdb.Update(exportDB, func(
+			dstTx walletdb.ReadWriteTx) error {
+
+			for _, key := range walletNamespaceKeys {
+				src := srcTx.ReadBucket(key)
+				if src == nil {
+					continue
+				}
+
+				dst, err := dstTx.CreateTopLevelBucket(key)
+				if err != nil {
+					return err
+				}
+				if err := copyBucket(dst, src); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	})
+	exportDB.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(exportPath)
+}
+
+// copyBucket recursively copies all key/value pairs and nested buckets of the
+// source bucket into the destination bucket.
+func copyBucket(dst walletdb.ReadWriteBucket, src walletdb.ReadBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+
+		nested, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return copyBucket(nested, src.NestedReadBucket(k))
+	})
+}
+
+// deriveWalletKey makes sure the signer's wallet has derived all addresses up
+// to the key at the passed path, so that it's able to sign for outputs paying
+// to addresses the watch-only node handed out.
+func (s *Server) deriveWalletKey(path *lnrpc.WalletKeyPath) error {
+	if s.wallet == nil {
+		return fmt.Errorf("signer doesn't support wallet key paths")
+	}
+
+	scope := waddrmgr.KeyScope{
+		Purpose: path.Purpose,
+		Coin:    path.CoinType,
+	}
+	scopedMgr, err := s.wallet.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return err
+	}
+
+	return walletdb.Update(s.wallet.Database(), func(
+		tx walletdb.ReadWriteTx) error {
+
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		props, err := scopedMgr.AccountProperties(ns, path.Account)
+		if err != nil {
+			return err
+		}
+
+		switch path.Branch {
+		case waddrmgr.ExternalBranch:
+			if path.Index >= props.ExternalKeyCount+
+				maxWalletKeyLookahead {
+
+				return fmt.Errorf("external key %v too far "+
+					"ahead of derived keys", path.Index)
+			}
+
+			return scopedMgr.ExtendExternalAddresses(
+				ns, path.Account, path.Index,
+			)
+
+		case waddrmgr.InternalBranch:
+			if path.Index >= props.InternalKeyCount+
+				maxWalletKeyLookahead {
+
+				return fmt.Errorf("internal key %v too far "+
+					"ahead of derived keys", path.Index)
+			}
+
+			return scopedMgr.ExtendInternalAddresses(
+				ns, path.Account, path.Index,
+			)
+
+		default:
+			return fmt.Errorf("invalid branch %v", path.Branch)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package remotesigner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"golang.org/x/net/context"
+)
+
+var (
+	testPubPass  = []byte("signer-public")
+	testPrivPass = []byte("signer-private")
+	testSeed     = []byte("remote-signer-watch-only-seed!!!")
+)
+
+// openTestWallet creates or opens the wallet within the passed database file.
+func openTestWallet(t *testing.T, path string, pubPass []byte,
+	create bool) (*base.Wallet, func()) {
+
+	var (
+		db  walletdb.DB
+		err error
+	)
+	if create {
+		db, err = walletdb.Create("bdb", path)
+		if err != nil {
+			t.Fatalf("unable to create db: %v", err)
+		}
+		err = base.Create(
+			db, pubPass, testPrivPass, testSeed,
+			&chaincfg.RegressionNetParams, time.Now(),
+		)
+		if err != nil {
+			t.Fatalf("unable to create wallet: %v", err)
+		}
+	} else {
+		db, err = walletdb.Open("bdb", path)
+		if err != nil {
+			t.Fatalf("unable to open db: %v", err)
+		}
+	}
+
+	w, err := base.Open(
+		db, pubPass, nil, &chaincfg.RegressionNetParams, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+
+	return w, func() {
+		db.Close()
+	}
+}
+
+// nextExternalAddress derives the next external address of the default
+// account.
+func nextExternalAddress(t *testing.T, w *base.Wallet) string {
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(
+		waddrmgr.KeyScopeBIP0084,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch scope: %v", err)
+	}
+
+	var addrs []waddrmgr.ManagedAddress
+	err = walletdb.Update(w.Database(), func(
+		tx walletdb.ReadWriteTx) error {
+
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		addrs, err = scopedMgr.NextExternalAddresses(ns, 0, 1)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+
+	return addrs[0].Address().EncodeAddress()
+}
+
+// TestExportWatchOnlyWallet tests that the exported wallet contains no private
+// key material, opens with the default public passphrase and derives the same
+// addresses as the signer's wallet.
+func TestExportWatchOnlyWallet(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "remotesigner")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	signerWallet, cleanUp := openTestWallet(
+		t, filepath.Join(tempDir, "signer.db"), testPubPass, true,
+	)
+	defer cleanUp()
+
+	server := NewServer(nil, nil, nil, signerWallet, testPubPass)
+	resp, err := server.ExportWatchOnlyWallet(
+		context.Background(), &lnrpc.ExportWatchOnlyWalletRequest{},
+	)
+	if err != nil {
+		t.Fatalf("unable to export wallet: %v", err)
+	}
+
+	watchOnlyPath := filepath.Join(tempDir, "watchonly.db")
+	err = ioutil.WriteFile(watchOnlyPath, resp.WalletDb, 0600)
+	if err != nil {
+		t.Fatalf("unable to write wallet: %v", err)
+	}
+	watchOnlyWallet, cleanUp := openTestWallet(
+		t, watchOnlyPath, lnwallet.DefaultPublicPassphrase, false,
+	)
+	defer cleanUp()
+
+	if !watchOnlyWallet.Manager.WatchOnly() {
+		t.Fatalf("exported wallet isn't watch-only")
+	}
+	if err := watchOnlyWallet.Unlock(testPrivPass, nil); err == nil {
+		t.Fatalf("expected unlocking watch-only wallet to fail")
+	}
+
+	signerAddr := nextExternalAddress(t, signerWallet)
+	watchOnlyAddr := nextExternalAddress(t, watchOnlyWallet)
+	if signerAddr != watchOnlyAddr {
+		t.Fatalf("address mismatch: signer %v, watch-only %v",
+			signerAddr, watchOnlyAddr)
+	}
+
+	// The signer must derive the keys of addresses the watch-only wallet
+	// handed out, but not arbitrarily far ahead.
+	path := &lnrpc.WalletKeyPath{
+		Purpose:  waddrmgr.KeyScopeBIP0084.Purpose,
+		CoinType: waddrmgr.KeyScopeBIP0084.Coin,
+		Branch:   waddrmgr.ExternalBranch,
+		Index:    5,
+	}
+	if err := server.deriveWalletKey(path); err != nil {
+		t.Fatalf("unable to derive wallet key: %v", err)
+	}
+	scopedMgr, err := signerWallet.Manager.FetchScopedKeyManager(
+		waddrmgr.KeyScopeBIP0084,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch scope: %v", err)
+	}
+	var props *waddrmgr.AccountProperties
+	err = walletdb.View(signerWallet.Database(), func(
+		tx walletdb.ReadTx) error {
+
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		props, err = scopedMgr.AccountProperties(ns, 0)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch account: %v", err)
+	}
+	if props.ExternalKeyCount != 6 {
+		t.Fatalf("expected 6 external keys, got %v",
+			props.ExternalKeyCount)
+	}
+
+	path.Index = props.ExternalKeyCount + maxWalletKeyLookahead
+	if err := server.deriveWalletKey(path); err == nil {
+		t.Fatalf("expected derivation too far ahead to fail")
+	}
+}
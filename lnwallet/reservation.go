@@ -26,6 +26,16 @@ type ChannelContribution struct {
 	// Inputs to the funding transaction.
 	Inputs []*wire.TxIn
 
+	// PrevOutputs are the outputs spent by the Inputs to the funding
+	// transaction, in the same order. They allow the counterparty to
+	// verify the inputs, and the signatures for them.
+	PrevOutputs []*wire.TxOut
+
+	// PrevOutputHeights are the heights of the blocks the PrevOutputs were
+	// confirmed in, in the same order. The counterparty uses them as hints
+	// to look up the outputs.
+	PrevOutputHeights []uint32
+
 	// ChangeOutputs are the Outputs to be used in the case that the total
 	// value of the funding inputs is greater than the total potential
 	// channel capacity.
@@ -96,6 +106,16 @@ type ChannelReservation struct {
 	// fundingTx is the funding transaction for this pending channel.
 	fundingTx *wire.MsgTx
 
+	// externalFunding denotes that the funding transaction will be crafted
+	// and signed outside of the wallet.
+	externalFunding bool
+
+	// fundingOutput is the 2-of-2 multi-sig output that an externally
+	// crafted funding transaction must pay to. It's only set for
+	// externally funded reservations, once the remote party's
+	// contribution has been processed.
+	fundingOutput *wire.TxOut
+
 	// In order of sorted inputs. Sorting is done in accordance
 	// to BIP-69: https://github.com/bitcoin/bips/blob/master/bip-0069.mediawiki.
 	ourFundingInputScripts   []*InputScript
@@ -166,25 +186,15 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 				int64(2*DefaultDustLimit()),
 			)
 		}
-	} else {
-		// TODO(roasbeef): need to rework fee structure in general and
-		// also when we "unlock" dual funder within the daemon
-
-		if capacity == fundingAmt {
-			// If we're initiating a single funder workflow, then
-			// we pay all the initial fees within the commitment
-			// transaction. We also deduct our balance by the
-			// amount pushed as part of the initial state.
-			ourBalance = capacityMSat - feeMSat - pushMSat
-			theirBalance = pushMSat
-		} else {
-			// Otherwise, this is a dual funder workflow where both
-			// slides split the amount funded and the commitment
-			// fee.
-			ourBalance = fundingMSat - (feeMSat / 2)
-			theirBalance = capacityMSat - fundingMSat - (feeMSat / 2) + pushMSat
-		}
-
+	} else if capacity == fundingAmt {
+		// If we're initiating the funding workflow, then we pay all
+		// the initial fees within the commitment transaction. We also
+		// deduct our balance by the amount pushed as part of the
+		// initial state. Should the responder decide to contribute
+		// funds as well, their balance is increased once their
+		// contribution has been processed.
+		ourBalance = capacityMSat - feeMSat - pushMSat
+		theirBalance = pushMSat
 		initiator = true
 
 		// If we, the initiator don't have enough funds to actually pay
@@ -195,6 +205,21 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 				int64(2*DefaultDustLimit()),
 			)
 		}
+	} else {
+		// Otherwise, we're the responder to a dual funder workflow,
+		// and contribute funds to the channel ourselves. The initiator
+		// still pays all the fees within the commitment transaction,
+		// as the channel state machine expects it to.
+		ourBalance = fundingMSat + pushMSat
+		theirBalance = capacityMSat - fundingMSat - feeMSat - pushMSat
+		initiator = false
+
+		if int64(theirBalance) < 0 {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee), int64(theirBalance.ToSatoshis()),
+				int64(2*DefaultDustLimit()),
+			)
+		}
 	}
 
 	// If we're the initiator and our starting balance within the channel
@@ -210,25 +235,20 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 		)
 	}
 
-	// Next we'll set the channel type based on what we can ascertain about
-	// the balances/push amount within the channel.
-	var chanType channeldb.ChannelType
-
-	// If either of the balances are zero at this point, or we have a
-	// non-zero push amt (there's no pushing for dual funder), then this is
-	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || pushMSat != 0 {
-		chanType = channeldb.SingleFunder
-	} else {
-		// Otherwise, this is a dual funder channel, and no side is
-		// technically the "initiator"
-		initiator = false
+	// Next we'll set the channel type based on whether the responder
+	// contributes funds to the channel. If we're the initiator, the
+	// channel type is only upgraded once the responder's contribution is
+	// known.
+	chanType := channeldb.ChannelType(channeldb.SingleFunder)
+	ourFunding := ourBalance.ToSatoshis()
+	if fundingAmt != 0 && capacity != fundingAmt {
 		chanType = channeldb.DualFunder
+		ourFunding = fundingAmt
 	}
 
 	return &ChannelReservation{
 		ourContribution: &ChannelContribution{
-			FundingAmount: ourBalance.ToSatoshis(),
+			FundingAmount: ourFunding,
 			ChannelConfig: &channeldb.ChannelConfig{},
 		},
 		theirContribution: &ChannelContribution{
@@ -372,6 +392,10 @@ func (r *ChannelReservation) OurContribution() *ChannelContribution {
 // transaction belonging to the wallet are available. Additionally, the wallet
 // will generate a signature to the counterparty's version of the commitment
 // transaction.
+//
+// NOTE: If the reservation is funded externally, only the funding output is
+// generated, and the workflow can't progress until the funding transaction is
+// handed over via ProcessExternalFundingTx.
 func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContribution) error {
 	errChan := make(chan error, 1)
 
@@ -384,6 +408,52 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ExternalFundingOutput returns the output that the funding transaction of an
+// externally funded reservation must pay to. If the reservation isn't funded
+// externally, or the counterparty's contribution hasn't been processed yet,
+// nil is returned.
+func (r *ChannelReservation) ExternalFundingOutput() *wire.TxOut {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.fundingOutput
+}
+
+// ProcessExternalFundingTx hands the fully signed funding transaction of an
+// externally funded reservation to the wallet. The transaction is verified to
+// pay the full channel capacity to the funding output. Once processed, the
+// funding outpoint and our signature for the counterparty's version of the
+// commitment transaction become available, just as if ProcessContribution was
+// called for a reservation funded by the wallet.
+func (r *ChannelReservation) ProcessExternalFundingTx(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addExternalFundingTxMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// ProcessBatchFundingTx hands a funding transaction created by the wallet via
+// CreateBatchFundingTx to an externally funded reservation. In contrast to
+// ProcessExternalFundingTx, only the funding output is verified, as the
+// inputs of the transaction are known to belong to the wallet.
+func (r *ChannelReservation) ProcessBatchFundingTx(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addExternalFundingTxMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		fromWallet:       true,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
 // ProcessSingleContribution verifies, and records the initiator's contribution
 // to this pending single funder channel. Internally, no further action is
 // taken other than recording the initiator's contribution to the single funder
@@ -400,6 +470,32 @@ func (r *ChannelReservation) ProcessSingleContribution(theirContribution *Channe
 	return <-errChan
 }
 
+// ProcessDualFundingInputs records the inputs and change outputs that the
+// initiator of a dual funder channel adds to the funding transaction. As the
+// responder, we're then able to build the complete funding transaction, and
+// sign our own inputs to it. The signatures are available via
+// .OurSignatures() once this method returns, but they should only be handed
+// out after CompleteReservationSingle succeeded.
+//
+// NOTE: This method MUST only be called after ProcessSingleContribution.
+func (r *ChannelReservation) ProcessDualFundingInputs(inputs []*wire.TxIn,
+	prevOutputs []*wire.TxOut, prevOutputHeights []uint32,
+	changeOutputs []*wire.TxOut) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addDualFundingInputsMsg{
+		pendingFundingID:  r.reservationID,
+		inputs:            inputs,
+		prevOutputs:       prevOutputs,
+		prevOutputHeights: prevOutputHeights,
+		changeOutputs:     changeOutputs,
+		err:               errChan,
+	}
+
+	return <-errChan
+}
+
 // TheirContribution returns the counterparty's pending contribution to the
 // payment channel. See 'ChannelContribution' for further details regarding the
 // contents of a contribution. This attribute will ONLY be available after a
@@ -478,6 +478,15 @@ func (twe *TxWeightEstimator) AddP2SHOutput() *TxWeightEstimator {
 	return twe
 }
 
+// AddTxOutput updates the weight estimate to account for an additional output
+// paying to an arbitrary script.
+func (twe *TxWeightEstimator) AddTxOutput(txOut *wire.TxOut) *TxWeightEstimator {
+	twe.outputSize += txOut.SerializeSize()
+	twe.outputCount++
+
+	return twe
+}
+
 // Weight gets the estimated weight of the transaction.
 func (twe *TxWeightEstimator) Weight() int {
 	txSizeStripped := BaseTxSize +
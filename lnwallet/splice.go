@@ -0,0 +1,520 @@
+package lnwallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// spliceInputWeight is the weight of the input of a splice transaction that
+// spends the current funding output of the channel.
+const spliceInputWeight = InputSize*blockchain.WitnessScaleFactor + WitnessSize
+
+// SpliceTxFee returns the fee required at the passed fee rate for a splice
+// transaction that only spends the current funding output of a channel, and
+// creates the new funding output along with the passed outputs.
+func SpliceTxFee(feeRate SatPerKWeight, outputs []*wire.TxOut) btcutil.Amount {
+	var weightEstimate TxWeightEstimator
+	weightEstimate.AddWitnessInput(WitnessSize)
+	weightEstimate.AddP2WSHOutput()
+	for _, output := range outputs {
+		weightEstimate.AddTxOutput(output)
+	}
+
+	return feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+}
+
+// FundSplice selects coins from the wallet in order to splice amt into a
+// channel. Along with the amount, the selected coins pay for the fee of the
+// splice transaction at the passed fee rate, including the fee of the input
+// spending the current funding output. Only native segwit outputs are
+// selected, as the txid of the splice transaction must not be malleable. The
+// selected coins are locked until they are released via ReleaseSpliceInputs.
+func (l *LightningWallet) FundSplice(amt btcutil.Amount,
+	feeRate SatPerKWeight, minConfs int32) (*ChannelContribution, error) {
+
+	contribution := &ChannelContribution{}
+	selectAmt := amt + feeRate.FeeForWeight(spliceInputWeight)
+	err := l.selectCoinsAndChange(
+		feeRate, selectAmt, minConfs, nil, true, contribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return contribution, nil
+}
+
+// ReleaseSpliceInputs unlocks the coins selected by FundSplice, which won't be
+// spent by a splice transaction after all.
+func (l *LightningWallet) ReleaseSpliceInputs(inputs []*wire.TxIn) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range inputs {
+		l.unlockOutPoint(txIn.PreviousOutPoint)
+	}
+}
+
+// VerifySpliceInputs ensures the inputs the remote party adds to a splice
+// transaction exist, only spend native segwit outputs, and are sufficient to
+// pay for its outputs along with the passed change in channel capacity, which
+// is negative when funds are spliced out of the channel.
+func (l *LightningWallet) VerifySpliceInputs(inputs []*wire.TxIn,
+	prevOutputs []*wire.TxOut, prevOutputHeights []uint32,
+	outputs []*wire.TxOut, capacityDelta btcutil.Amount) error {
+
+	return l.verifyContributionInputs(
+		inputs, prevOutputs, prevOutputHeights, outputs,
+		capacityDelta,
+	)
+}
+
+// SignSpliceInputs signs the passed inputs of a splice transaction, which
+// must all spend outputs controlled by the wallet. The witnesses are returned
+// in the order the inputs appear within the splice transaction.
+func (l *LightningWallet) SignSpliceInputs(spliceTx *wire.MsgTx,
+	inputs []*wire.TxIn) ([]wire.TxWitness, error) {
+
+	ourInputs := make(map[wire.OutPoint]struct{}, len(inputs))
+	for _, txIn := range inputs {
+		ourInputs[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	inputScripts, err := l.signInputs(spliceTx, ourInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	witnesses := make([]wire.TxWitness, 0, len(inputScripts))
+	for _, inputScript := range inputScripts {
+		witnesses = append(witnesses, inputScript.Witness)
+	}
+
+	if len(witnesses) != len(inputs) {
+		return nil, fmt.Errorf("splice transaction only spends %v of "+
+			"%v inputs", len(witnesses), len(inputs))
+	}
+
+	return witnesses, nil
+}
+
+// AddSpliceWitnesses attaches the passed witnesses to the inputs of the
+// splice transaction that spend the given previous outputs, in the order
+// those inputs appear within the transaction. Each witness is verified to
+// validly spend its output.
+func AddSpliceWitnesses(spliceTx *wire.MsgTx, inputs []*wire.TxIn,
+	prevOutputs []*wire.TxOut, witnesses []wire.TxWitness) error {
+
+	if len(inputs) != len(prevOutputs) || len(inputs) != len(witnesses) {
+		return fmt.Errorf("%v inputs, %v previous outputs, but %v "+
+			"witnesses", len(inputs), len(prevOutputs),
+			len(witnesses))
+	}
+
+	prevOuts := make(map[wire.OutPoint]*wire.TxOut, len(inputs))
+	for i, txIn := range inputs {
+		prevOuts[txIn.PreviousOutPoint] = prevOutputs[i]
+	}
+
+	hashCache := txscript.NewTxSigHashes(spliceTx)
+	var numWitnesses int
+	for i, txIn := range spliceTx.TxIn {
+		prevOut, ok := prevOuts[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+
+		txIn.Witness = witnesses[numWitnesses]
+		numWitnesses++
+
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, spliceTx, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot create script engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("input %v has an invalid signature: "+
+				"%v", i, err)
+		}
+	}
+
+	if numWitnesses != len(witnesses) {
+		return fmt.Errorf("splice transaction only spends %v of %v "+
+			"inputs", numWitnesses, len(witnesses))
+	}
+
+	return nil
+}
+
+// NewSpliceTx creates the splice transaction for the channel. It spends the
+// current funding output along with the passed inputs, and creates a new
+// funding output of the given capacity along with the passed outputs. The
+// transaction is sorted according to BIP 69, so both parties arrive at the
+// very same transaction.
+func (lc *LightningChannel) NewSpliceTx(capacity btcutil.Amount,
+	inputs []*wire.TxIn, outputs []*wire.TxOut) (*wire.MsgTx, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	spliceTx := wire.NewMsgTx(2)
+
+	fundingTxIn := lc.fundingTxIn()
+	spliceTx.AddTxIn(&fundingTxIn)
+	for _, txIn := range inputs {
+		spliceTx.AddTxIn(wire.NewTxIn(&txIn.PreviousOutPoint, nil, nil))
+	}
+
+	// The new funding output pays to the very same multi-sig script as
+	// the current one.
+	spliceTx.AddTxOut(&wire.TxOut{
+		Value:    int64(capacity),
+		PkScript: lc.signDesc.Output.PkScript,
+	})
+	for _, txOut := range outputs {
+		spliceTx.AddTxOut(txOut)
+	}
+
+	txsort.InPlaceSort(spliceTx)
+
+	if err := blockchain.CheckTransactionSanity(
+		btcutil.NewTx(spliceTx),
+	); err != nil {
+		return nil, err
+	}
+
+	return spliceTx, nil
+}
+
+// NewSplice creates the state of the channel once the passed splice
+// transaction confirms. The difference between the value of the new funding
+// output and the current capacity is added to, or taken from, the balance of
+// the party that initiated the splice, while the commitments remain at their
+// current height. In order to be spliced, the channel must be fully synced,
+// and have no HTLCs.
+//
+// NOTE: The commitments of the returned splice still need to be signed via
+// SignSpliceCommitment and ReceiveSpliceCommitment.
+func (lc *LightningChannel) NewSplice(spliceTx *wire.MsgTx,
+	localInitiated bool,
+	broadcastHeight uint32) (*channeldb.ChannelSplice, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	if len(chanState.LocalCommitment.Htlcs) != 0 ||
+		len(chanState.RemoteCommitment.Htlcs) != 0 {
+
+		return nil, fmt.Errorf("channel has active HTLCs")
+	}
+	if lc.localCommitChain.tip() != lc.localCommitChain.tail() ||
+		lc.remoteCommitChain.tip() != lc.remoteCommitChain.tail() {
+
+		return nil, fmt.Errorf("channel has pending commitments")
+	}
+	if lc.localUpdateLog.Len() != 0 || lc.remoteUpdateLog.Len() != 0 {
+		return nil, fmt.Errorf("channel has pending updates")
+	}
+
+	// Locate the new funding output, which pays to the same script as the
+	// current one.
+	fundingIndex := -1
+	for i, txOut := range spliceTx.TxOut {
+		if bytes.Equal(txOut.PkScript, lc.signDesc.Output.PkScript) {
+			fundingIndex = i
+			break
+		}
+	}
+	if fundingIndex == -1 {
+		return nil, fmt.Errorf("splice transaction has no funding " +
+			"output")
+	}
+	fundingOutpoint := wire.OutPoint{
+		Hash:  spliceTx.TxHash(),
+		Index: uint32(fundingIndex),
+	}
+	capacity := btcutil.Amount(spliceTx.TxOut[fundingIndex].Value)
+
+	// Apply the difference in capacity to the balance of the initiator
+	// of the splice, making sure it still meets its channel reserve.
+	localCommit := chanState.LocalCommitment
+	remoteCommit := chanState.RemoteCommitment
+
+	balance := localCommit.RemoteBalance.ToSatoshis()
+	reserve := lc.remoteChanCfg.ChanReserve
+	if localInitiated {
+		balance = localCommit.LocalBalance.ToSatoshis()
+		reserve = lc.localChanCfg.ChanReserve
+	}
+	delta := capacity - chanState.Capacity
+	if balance+delta < reserve {
+		return nil, fmt.Errorf("splice leaves initiator balance of "+
+			"%v below channel reserve of %v", balance+delta,
+			reserve)
+	}
+
+	if localInitiated {
+		localCommit.LocalBalance = applySpliceDelta(
+			localCommit.LocalBalance, delta,
+		)
+		remoteCommit.LocalBalance = applySpliceDelta(
+			remoteCommit.LocalBalance, delta,
+		)
+	} else {
+		localCommit.RemoteBalance = applySpliceDelta(
+			localCommit.RemoteBalance, delta,
+		)
+		remoteCommit.RemoteBalance = applySpliceDelta(
+			remoteCommit.RemoteBalance, delta,
+		)
+	}
+
+	// With the balances known, we'll re-create both commitments at their
+	// current height, but spending the new funding output.
+	fundingTxIn := *wire.NewTxIn(&fundingOutpoint, nil, nil)
+
+	commitSecret, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localKeys := deriveCommitmentKeys(
+		ComputeCommitmentPoint(commitSecret[:]), true,
+		lc.localChanCfg, lc.remoteChanCfg,
+	)
+	localCommit.CommitTx, err = lc.createSpliceCommitTx(
+		fundingTxIn, localKeys, localCommit.CommitHeight,
+		uint32(lc.localChanCfg.CsvDelay),
+		localCommit.LocalBalance.ToSatoshis(),
+		localCommit.RemoteBalance.ToSatoshis(),
+		lc.localChanCfg.DustLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localCommit.CommitSig = nil
+
+	remoteKeys := deriveCommitmentKeys(
+		chanState.RemoteCurrentRevocation, false, lc.localChanCfg,
+		lc.remoteChanCfg,
+	)
+	remoteCommit.CommitTx, err = lc.createSpliceCommitTx(
+		fundingTxIn, remoteKeys, remoteCommit.CommitHeight,
+		uint32(lc.remoteChanCfg.CsvDelay),
+		remoteCommit.RemoteBalance.ToSatoshis(),
+		remoteCommit.LocalBalance.ToSatoshis(),
+		lc.remoteChanCfg.DustLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	remoteCommit.CommitSig = nil
+
+	return &channeldb.ChannelSplice{
+		SpliceTx:         spliceTx,
+		FundingOutpoint:  fundingOutpoint,
+		Capacity:         capacity,
+		BroadcastHeight:  broadcastHeight,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}, nil
+}
+
+// applySpliceDelta adds the passed change in capacity, which may be negative,
+// to the balance.
+func applySpliceDelta(balance lnwire.MilliSatoshi,
+	delta btcutil.Amount) lnwire.MilliSatoshi {
+
+	if delta < 0 {
+		return balance - lnwire.NewMSatFromSatoshis(-delta)
+	}
+	return balance + lnwire.NewMSatFromSatoshis(delta)
+}
+
+// createSpliceCommitTx creates a commitment transaction without any HTLCs at
+// the given height, spending the passed funding input.
+func (lc *LightningChannel) createSpliceCommitTx(fundingTxIn wire.TxIn,
+	keyRing *CommitmentKeyRing, height uint64, csvDelay uint32,
+	delayBalance, p2wkhBalance, dustLimit btcutil.Amount) (*wire.MsgTx,
+	error) {
+
+	commitTx, err := CreateCommitTx(
+		fundingTxIn, keyRing, csvDelay, delayBalance, p2wkhBalance,
+		dustLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SetStateNumHint(commitTx, height, lc.stateHintObfuscator)
+	if err != nil {
+		return nil, err
+	}
+
+	txsort.InPlaceSort(commitTx)
+
+	return commitTx, nil
+}
+
+// spliceSignDesc returns a sign descriptor for the new funding output of the
+// passed splice.
+func (lc *LightningChannel) spliceSignDesc(
+	splice *channeldb.ChannelSplice) *SignDescriptor {
+
+	signDesc := *lc.signDesc
+	signDesc.Output = &wire.TxOut{
+		Value:    int64(splice.Capacity),
+		PkScript: lc.signDesc.Output.PkScript,
+	}
+	signDesc.InputIndex = 0
+
+	return &signDesc
+}
+
+// SignSpliceCommitment signs the remote party's commitment spending the new
+// funding output of the passed splice.
+func (lc *LightningChannel) SignSpliceCommitment(
+	splice *channeldb.ChannelSplice) (lnwire.Sig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := splice.RemoteCommitment.CommitTx
+	signDesc := lc.spliceSignDesc(splice)
+	signDesc.SigHashes = txscript.NewTxSigHashes(commitTx)
+
+	rawSig, err := lc.Signer.SignOutputRaw(commitTx, signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+	splice.RemoteCommitment.CommitSig = rawSig
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// ReceiveSpliceCommitment verifies the remote party's signature for our
+// commitment spending the new funding output of the passed splice, and stores
+// it within the splice.
+func (lc *LightningChannel) ReceiveSpliceCommitment(
+	splice *channeldb.ChannelSplice, commitSig lnwire.Sig) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := splice.LocalCommitment.CommitTx
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript, txscript.NewTxSigHashes(commitTx),
+		txscript.SigHashAll, commitTx, 0, int64(splice.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	verifyKey := btcec.PublicKey{
+		X:     lc.remoteChanCfg.MultiSigKey.PubKey.X,
+		Y:     lc.remoteChanCfg.MultiSigKey.PubKey.Y,
+		Curve: btcec.S256(),
+	}
+	cSig, err := commitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	if !cSig.Verify(sigHash, &verifyKey) {
+		return fmt.Errorf("invalid commitment signature for splice "+
+			"of ChannelPoint(%v)", lc.channelState.FundingOutpoint)
+	}
+
+	splice.LocalCommitment.CommitSig = commitSig.ToSignatureBytes()
+
+	return nil
+}
+
+// spliceFundingIndex returns the index of the input of the splice
+// transaction that spends the current funding output.
+func (lc *LightningChannel) spliceFundingIndex(spliceTx *wire.MsgTx) (int,
+	error) {
+
+	for i, txIn := range spliceTx.TxIn {
+		if txIn.PreviousOutPoint == lc.channelState.FundingOutpoint {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("splice transaction doesn't spend "+
+		"ChannelPoint(%v)", lc.channelState.FundingOutpoint)
+}
+
+// SignSpliceFunding signs the input of the splice transaction that spends the
+// current funding output.
+func (lc *LightningChannel) SignSpliceFunding(
+	splice *channeldb.ChannelSplice) (lnwire.Sig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	inputIndex, err := lc.spliceFundingIndex(splice.SpliceTx)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	signDesc := *lc.signDesc
+	signDesc.SigHashes = txscript.NewTxSigHashes(splice.SpliceTx)
+	signDesc.InputIndex = inputIndex
+
+	rawSig, err := lc.Signer.SignOutputRaw(splice.SpliceTx, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// CompleteSpliceFunding adds the witness spending the current funding output
+// to the splice transaction, given both our and the remote party's signature.
+// An error is returned if the remote signature isn't valid.
+func (lc *LightningChannel) CompleteSpliceFunding(
+	splice *channeldb.ChannelSplice, localSig, remoteSig lnwire.Sig) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	spliceTx := splice.SpliceTx
+	inputIndex, err := lc.spliceFundingIndex(spliceTx)
+	if err != nil {
+		return err
+	}
+
+	ourKey := lc.localChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	theirKey := lc.remoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	ourSig := append(localSig.ToSignatureBytes(), byte(txscript.SigHashAll))
+	theirSig := append(
+		remoteSig.ToSignatureBytes(), byte(txscript.SigHashAll),
+	)
+	spliceTx.TxIn[inputIndex].Witness = SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey, ourSig, theirKey, theirSig,
+	)
+
+	prevOut := lc.signDesc.Output
+	vm, err := txscript.NewEngine(prevOut.PkScript, spliceTx, inputIndex,
+		txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(spliceTx), prevOut.Value)
+	if err != nil {
+		return err
+	}
+
+	return vm.Execute()
+}
@@ -0,0 +1,142 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestChannelSplice tests that both parties of a channel arrive at the same
+// state when funds are spliced out of the channel, and that the resulting
+// commitments and splice transaction are validly signed.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice will splice one BTC out of the channel, paying a static fee
+	// for the splice transaction.
+	spliceOut := &wire.TxOut{
+		Value:    btcutil.SatoshiPerBitcoin,
+		PkScript: testHdSeed[:],
+	}
+	oldCapacity := aliceChannel.channelState.Capacity
+	fee := SpliceTxFee(SatPerKWeight(6000), []*wire.TxOut{spliceOut})
+	newCapacity := oldCapacity - btcutil.Amount(spliceOut.Value) - fee
+
+	// Splicing out more than Alice's balance should fail.
+	tooMuch := &wire.TxOut{
+		Value:    int64(oldCapacity / 2),
+		PkScript: testHdSeed[:],
+	}
+	badTx, err := aliceChannel.NewSpliceTx(
+		oldCapacity/2, nil, []*wire.TxOut{tooMuch},
+	)
+	if err != nil {
+		t.Fatalf("unable to create splice tx: %v", err)
+	}
+	if _, err := aliceChannel.NewSplice(badTx, true, 100); err == nil {
+		t.Fatalf("splice below channel reserve should fail")
+	}
+
+	spliceTx, err := aliceChannel.NewSpliceTx(
+		newCapacity, nil, []*wire.TxOut{spliceOut},
+	)
+	if err != nil {
+		t.Fatalf("unable to create splice tx: %v", err)
+	}
+
+	aliceSplice, err := aliceChannel.NewSplice(spliceTx, true, 100)
+	if err != nil {
+		t.Fatalf("unable to create alice's splice: %v", err)
+	}
+	bobSplice, err := bobChannel.NewSplice(spliceTx, false, 100)
+	if err != nil {
+		t.Fatalf("unable to create bob's splice: %v", err)
+	}
+
+	if aliceSplice.FundingOutpoint != bobSplice.FundingOutpoint {
+		t.Fatalf("funding outpoints don't match: %v vs %v",
+			aliceSplice.FundingOutpoint, bobSplice.FundingOutpoint)
+	}
+	if aliceSplice.Capacity != newCapacity {
+		t.Fatalf("expected capacity %v, got %v", newCapacity,
+			aliceSplice.Capacity)
+	}
+
+	// Only Alice's balance should be reduced by the spliced out funds and
+	// the fee.
+	delta := lnwire.NewMSatFromSatoshis(oldCapacity - newCapacity)
+	oldCommit := aliceChannel.channelState.LocalCommitment
+	if aliceSplice.LocalCommitment.LocalBalance !=
+		oldCommit.LocalBalance-delta {
+
+		t.Fatalf("expected alice's balance to be %v, got %v",
+			oldCommit.LocalBalance-delta,
+			aliceSplice.LocalCommitment.LocalBalance)
+	}
+	if bobSplice.LocalCommitment.LocalBalance !=
+		oldCommit.RemoteBalance {
+
+		t.Fatalf("expected bob's balance to be %v, got %v",
+			oldCommit.RemoteBalance,
+			bobSplice.LocalCommitment.LocalBalance)
+	}
+
+	// Each party's version of the commitments must match the other's.
+	aliceCommitHash := aliceSplice.LocalCommitment.CommitTx.TxHash()
+	if aliceCommitHash != bobSplice.RemoteCommitment.CommitTx.TxHash() {
+		t.Fatalf("alice's commitment doesn't match")
+	}
+	bobCommitHash := bobSplice.LocalCommitment.CommitTx.TxHash()
+	if bobCommitHash != aliceSplice.RemoteCommitment.CommitTx.TxHash() {
+		t.Fatalf("bob's commitment doesn't match")
+	}
+
+	// Exchange signatures for the new commitments.
+	bobCommitSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	if err != nil {
+		t.Fatalf("unable to sign commitment: %v", err)
+	}
+	err = aliceChannel.ReceiveSpliceCommitment(aliceSplice, bobCommitSig)
+	if err != nil {
+		t.Fatalf("unable to receive commitment sig: %v", err)
+	}
+	aliceCommitSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	if err != nil {
+		t.Fatalf("unable to sign commitment: %v", err)
+	}
+	err = bobChannel.ReceiveSpliceCommitment(bobSplice, aliceCommitSig)
+	if err != nil {
+		t.Fatalf("unable to receive commitment sig: %v", err)
+	}
+
+	// A signature for the wrong commitment must be rejected.
+	err = aliceChannel.ReceiveSpliceCommitment(aliceSplice, aliceCommitSig)
+	if err == nil {
+		t.Fatalf("invalid commitment sig should be rejected")
+	}
+
+	// Finally, both parties sign the input spending the current funding
+	// output, which should complete the splice transaction.
+	aliceFundingSig, err := aliceChannel.SignSpliceFunding(aliceSplice)
+	if err != nil {
+		t.Fatalf("unable to sign splice tx: %v", err)
+	}
+	bobFundingSig, err := bobChannel.SignSpliceFunding(bobSplice)
+	if err != nil {
+		t.Fatalf("unable to sign splice tx: %v", err)
+	}
+	err = aliceChannel.CompleteSpliceFunding(
+		aliceSplice, aliceFundingSig, bobFundingSig,
+	)
+	if err != nil {
+		t.Fatalf("unable to complete splice tx: %v", err)
+	}
+}
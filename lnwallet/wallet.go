@@ -97,6 +97,19 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// FundingInputs is an optional set of wallet outputs that must be used
+	// to fund the channel. If set, all of these outputs are spent by the
+	// funding transaction, rather than performing coin selection.
+	FundingInputs []wire.OutPoint
+
+	// ExternalFunding denotes that the funding transaction will be
+	// crafted and signed outside of the wallet. If set, no coins are
+	// selected for this reservation, and the fully signed funding
+	// transaction must be handed to the reservation through
+	// ProcessExternalFundingTx once the remote party's contribution has
+	// been processed.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -153,6 +166,41 @@ type addSingleContributionMsg struct {
 	err chan error
 }
 
+// addDualFundingInputsMsg carries the inputs and change outputs that the
+// initiator of a dual funder channel adds to the funding transaction. This
+// message is processed by the responder once the initiator's contribution
+// has been recorded, and allows it to build and sign the funding transaction.
+type addDualFundingInputsMsg struct {
+	pendingFundingID uint64
+
+	inputs            []*wire.TxIn
+	prevOutputs       []*wire.TxOut
+	prevOutputHeights []uint32
+	changeOutputs     []*wire.TxOut
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// addExternalFundingTxMsg carries a funding transaction which has been
+// crafted and signed outside of the wallet for a reservation that was
+// initialized with ExternalFunding set. Once the transaction has been
+// verified, both commitment transactions can be constructed, which allows the
+// funding workflow to resume.
+type addExternalFundingTxMsg struct {
+	pendingFundingID uint64
+
+	fundingTx *wire.MsgTx
+
+	// fromWallet indicates that the transaction was created by the
+	// wallet itself as part of a batch, so its inputs don't need to be
+	// verified.
+	fromWallet bool
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addCounterPartySigsMsg represents the final message required to complete,
 // and 'open' a payment channel. This message carries the counterparty's
 // signatures for each of their inputs to the funding transaction, and also a
@@ -274,6 +322,21 @@ type LightningWallet struct {
 	// the currently locked outpoints.
 	lockedOutPoints map[wire.OutPoint]struct{}
 
+	// leasedOutPoints is the set of outpoints locked by an active output
+	// lease. An outpoint may be both leased and locked by a funding flow,
+	// in which case it must remain locked within the underlying wallet
+	// until it's released by both.
+	leasedOutPoints map[wire.OutPoint]struct{}
+
+	// watchOnlyAccounts are the imported watch-only accounts, keyed by
+	// their name.
+	watchOnlyAccounts map[string]*watchOnlyAccount
+	watchOnlyMtx      sync.Mutex
+
+	// watchOnlySyncSignal is used to request an immediate scan for the
+	// transactions of the watch-only accounts.
+	watchOnlySyncSignal chan struct{}
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -287,14 +350,17 @@ type LightningWallet struct {
 func NewLightningWallet(Cfg Config) (*LightningWallet, error) {
 
 	return &LightningWallet{
-		Cfg:              Cfg,
-		SecretKeyRing:    Cfg.SecretKeyRing,
-		WalletController: Cfg.WalletController,
-		msgChan:          make(chan interface{}, msgBufferSize),
-		nextFundingID:    0,
-		fundingLimbo:     make(map[uint64]*ChannelReservation),
-		lockedOutPoints:  make(map[wire.OutPoint]struct{}),
-		quit:             make(chan struct{}),
+		Cfg:                 Cfg,
+		SecretKeyRing:       Cfg.SecretKeyRing,
+		WalletController:    Cfg.WalletController,
+		msgChan:             make(chan interface{}, msgBufferSize),
+		nextFundingID:       0,
+		fundingLimbo:        make(map[uint64]*ChannelReservation),
+		lockedOutPoints:     make(map[wire.OutPoint]struct{}),
+		leasedOutPoints:     make(map[wire.OutPoint]struct{}),
+		watchOnlyAccounts:   make(map[string]*watchOnlyAccount),
+		watchOnlySyncSignal: make(chan struct{}, 1),
+		quit:                make(chan struct{}),
 	}, nil
 }
 
@@ -311,9 +377,21 @@ func (l *LightningWallet) Startup() error {
 		return err
 	}
 
-	l.wg.Add(1)
+	// Lock all outputs that are still leased, as locks within the
+	// underlying wallet don't persist across restarts.
+	if err := l.syncLeases(); err != nil {
+		return err
+	}
+
+	if err := l.loadWatchOnlyAccounts(); err != nil {
+		return err
+	}
+
+	l.wg.Add(3)
 	// TODO(roasbeef): multiple request handlers?
 	go l.requestHandler()
+	go l.leaseExpirer()
+	go l.watchOnlySyncer()
 
 	return nil
 }
@@ -352,9 +430,22 @@ func (l *LightningWallet) ResetReservations() {
 	l.fundingLimbo = make(map[uint64]*ChannelReservation)
 
 	for outpoint := range l.lockedOutPoints {
-		l.UnlockOutpoint(outpoint)
+		l.unlockOutPoint(outpoint)
+	}
+}
+
+// unlockOutPoint releases the lock a funding flow holds on the passed outpoint.
+// The outpoint is only unlocked within the underlying wallet if it isn't also
+// leased.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) unlockOutPoint(op wire.OutPoint) {
+	delete(l.lockedOutPoints, op)
+
+	if _, ok := l.leasedOutPoints[op]; ok {
+		return
 	}
-	l.lockedOutPoints = make(map[wire.OutPoint]struct{})
+	l.UnlockOutpoint(op)
 }
 
 // ActiveReservations returns a slice of all the currently active
@@ -384,6 +475,10 @@ out:
 				l.handleSingleContribution(msg)
 			case *addContributionMsg:
 				l.handleContributionMsg(msg)
+			case *addDualFundingInputsMsg:
+				l.handleDualFundingInputs(msg)
+			case *addExternalFundingTxMsg:
+				l.handleExternalFundingTx(msg)
 			case *addSingleFunderSigsMsg:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
@@ -469,15 +564,23 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	reservation.nodeAddr = req.NodeAddr
 	reservation.partialState.IdentityPub = req.NodeID
+	reservation.externalFunding = req.ExternalFunding
+
+	// If we're on the receiving end of a single funder channel, or the
+	// funding transaction will be provided externally, then we don't need
+	// to perform any coin selection. Otherwise, attempt to obtain enough
+	// coins to meet the required funding amount.
+	if req.FundingAmount != 0 && !req.ExternalFunding {
+		// If we're the responder to a dual funder workflow, the
+		// initiator will only accept native segwit inputs, as it
+		// can't verify that any other input can't be malleated.
+		dualResponder := req.FundingAmount != req.Capacity
 
-	// If we're on the receiving end of a single funder channel then we
-	// don't need to perform any coin selection. Otherwise, attempt to
-	// obtain enough coins to meet the required funding amount.
-	if req.FundingAmount != 0 {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
 			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.FundingInputs, dualResponder,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -611,8 +714,7 @@ func (l *LightningWallet) handleFundingCancelRequest(req *fundingReserveCancelMs
 	// Mark all previously locked outpoints as useable for future funding
 	// requests.
 	for _, unusedInput := range pendingReservation.ourContribution.Inputs {
-		delete(l.lockedOutPoints, unusedInput.PreviousOutPoint)
-		l.UnlockOutpoint(unusedInput.PreviousOutPoint)
+		l.unlockOutPoint(unusedInput.PreviousOutPoint)
 	}
 
 	// TODO(roasbeef): is it even worth it to keep track of unused keys?
@@ -686,35 +788,54 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	pendingReservation.Lock()
 	defer pendingReservation.Unlock()
 
-	// Create a blank, fresh transaction. Soon to be a complete funding
-	// transaction which will allow opening a lightning channel.
-	pendingReservation.fundingTx = wire.NewMsgTx(1)
-	fundingTx := pendingReservation.fundingTx
-
 	// Some temporary variables to cut down on the resolution verbosity.
 	pendingReservation.theirContribution = req.contribution
 	theirContribution := req.contribution
-	ourContribution := pendingReservation.ourContribution
+	chanState := pendingReservation.partialState
 
-	// Add all multi-party inputs and outputs to the transaction.
-	for _, ourInput := range ourContribution.Inputs {
-		fundingTx.AddTxIn(ourInput)
-	}
-	for _, theirInput := range theirContribution.Inputs {
-		fundingTx.AddTxIn(theirInput)
-	}
-	for _, ourChangeOutput := range ourContribution.ChangeOutputs {
-		fundingTx.AddTxOut(ourChangeOutput)
-	}
-	for _, theirChangeOutput := range theirContribution.ChangeOutputs {
-		fundingTx.AddTxOut(theirChangeOutput)
+	// If the remote party contributes inputs to the funding transaction,
+	// then this has turned into a dual funder channel. We'll verify their
+	// inputs, and add their funds to both the capacity of the channel and
+	// their starting balance.
+	if len(theirContribution.Inputs) != 0 {
+		switch {
+		case !chanState.IsInitiator:
+			req.err <- fmt.Errorf("only the initiator can process " +
+				"a dual funder contribution")
+			return
+
+		case pendingReservation.externalFunding:
+			req.err <- fmt.Errorf("externally funded channels " +
+				"can't be dual funded")
+			return
+		}
+
+		err := l.verifyContributionInputs(
+			theirContribution.Inputs, theirContribution.PrevOutputs,
+			theirContribution.PrevOutputHeights,
+			theirContribution.ChangeOutputs,
+			theirContribution.FundingAmount,
+		)
+		if err != nil {
+			req.err <- fmt.Errorf("invalid dual funder "+
+				"contribution: %v", err)
+			return
+		}
+
+		theirFunds := lnwire.NewMSatFromSatoshis(
+			theirContribution.FundingAmount,
+		)
+		chanState.ChanType = channeldb.DualFunder
+		chanState.Capacity += theirContribution.FundingAmount
+		chanState.LocalCommitment.RemoteBalance += theirFunds
+		chanState.RemoteCommitment.RemoteBalance += theirFunds
 	}
 
 	ourKey := pendingReservation.ourContribution.MultiSigKey
 	theirKey := theirContribution.MultiSigKey
 
-	// Finally, add the 2-of-2 multi-sig output which will set up the lightning
-	// channel.
+	// Generate the 2-of-2 multi-sig output which will set up the
+	// lightning channel.
 	channelCapacity := int64(pendingReservation.partialState.Capacity)
 	witnessScript, multiSigOut, err := GenFundingPkScript(
 		ourKey.PubKey.SerializeCompressed(),
@@ -725,16 +846,64 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		return
 	}
 
+	// If the funding transaction is to be provided externally, then we
+	// can't go any further until it has been handed to us. We'll record
+	// the multi-sig output, so the caller is able to craft a transaction
+	// that pays to it.
+	if pendingReservation.externalFunding {
+		pendingReservation.fundingOutput = multiSigOut
+		req.err <- nil
+		return
+	}
+
+	if err := l.buildFundingTx(pendingReservation, multiSigOut); err != nil {
+		req.err <- err
+		return
+	}
+
+	err = l.initFundingCommitments(
+		pendingReservation, witnessScript, multiSigOut,
+	)
+	req.err <- err
+}
+
+// buildFundingTx assembles the funding transaction of a reservation from the
+// inputs and change outputs of both contributions, along with the passed
+// multi-sig output. Once the transaction is sorted, all inputs that belong to
+// the wallet are signed.
+func (l *LightningWallet) buildFundingTx(pendingReservation *ChannelReservation,
+	multiSigOut *wire.TxOut) error {
+
+	ourContribution := pendingReservation.ourContribution
+	theirContribution := pendingReservation.theirContribution
+
+	// Create a blank, fresh transaction. Soon to be a complete funding
+	// transaction which will allow opening a lightning channel.
+	fundingTx := wire.NewMsgTx(1)
+
+	// Add all multi-party inputs and outputs to the transaction.
+	for _, ourInput := range ourContribution.Inputs {
+		fundingTx.AddTxIn(ourInput)
+	}
+	for _, theirInput := range theirContribution.Inputs {
+		fundingTx.AddTxIn(theirInput)
+	}
+	for _, ourChangeOutput := range ourContribution.ChangeOutputs {
+		fundingTx.AddTxOut(ourChangeOutput)
+	}
+	for _, theirChangeOutput := range theirContribution.ChangeOutputs {
+		fundingTx.AddTxOut(theirChangeOutput)
+	}
+
 	// Sort the transaction. Since both side agree to a canonical ordering,
 	// by sorting we no longer need to send the entire transaction. Only
 	// signatures will be exchanged.
 	fundingTx.AddTxOut(multiSigOut)
-	txsort.InPlaceSort(pendingReservation.fundingTx)
+	txsort.InPlaceSort(fundingTx)
 
 	// Next, sign all inputs that are ours, collecting the signatures in
 	// order of the inputs.
-	pendingReservation.ourFundingInputScripts = make([]*InputScript, 0,
-		len(ourContribution.Inputs))
+	ourInputScripts := make([]*InputScript, 0, len(ourContribution.Inputs))
 	signDesc := SignDescriptor{
 		HashType:  txscript.SigHashAll,
 		SigHashes: txscript.NewTxSigHashes(fundingTx),
@@ -744,8 +913,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		if err == ErrNotMine {
 			continue
 		} else if err != nil {
-			req.err <- err
-			return
+			return err
 		}
 
 		signDesc.Output = info
@@ -754,18 +922,237 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		inputScript, err := l.Cfg.Signer.ComputeInputScript(fundingTx,
 			&signDesc)
 		if err != nil {
-			req.err <- err
-			return
+			return err
 		}
 
 		txIn.SignatureScript = inputScript.ScriptSig
 		txIn.Witness = inputScript.Witness
-		pendingReservation.ourFundingInputScripts = append(
-			pendingReservation.ourFundingInputScripts,
-			inputScript,
+		ourInputScripts = append(ourInputScripts, inputScript)
+	}
+
+	pendingReservation.fundingTx = fundingTx
+	pendingReservation.ourFundingInputScripts = ourInputScripts
+
+	return nil
+}
+
+// verifyContributionInputs ensures that the inputs the remote party of a dual
+// funder channel contributes to the funding transaction spend existing native
+// segwit outputs, and that they're sufficient to pay for both the funding
+// amount and the change outputs. Any other input would allow the txid of the
+// funding transaction to be malleated, invalidating the signatures for the
+// commitment transactions. The outputs are looked up starting at the heights
+// the remote party claims they were confirmed at, so an input with a height
+// that's too high won't be found.
+func (l *LightningWallet) verifyContributionInputs(inputs []*wire.TxIn,
+	prevOutputs []*wire.TxOut, prevOutputHeights []uint32,
+	changeOutputs []*wire.TxOut, fundingAmt btcutil.Amount) error {
+
+	if len(inputs) != len(prevOutputs) ||
+		len(inputs) != len(prevOutputHeights) {
+
+		return fmt.Errorf("%v inputs, but %v previous outputs and "+
+			"%v heights", len(inputs), len(prevOutputs),
+			len(prevOutputHeights))
+	}
+
+	var totalIn btcutil.Amount
+	for i, txIn := range inputs {
+		prevOut := prevOutputs[i]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return fmt.Errorf("input %v doesn't spend a native "+
+				"segwit output", txIn.PreviousOutPoint)
+		}
+
+		output, err := l.Cfg.ChainIO.GetUtxo(
+			&txIn.PreviousOutPoint, prevOut.PkScript,
+			prevOutputHeights[i],
+		)
+		if output == nil {
+			return fmt.Errorf("input %v does not exist: %v",
+				txIn.PreviousOutPoint, err)
+		}
+		if output.Value != prevOut.Value ||
+			!bytes.Equal(output.PkScript, prevOut.PkScript) {
+
+			return fmt.Errorf("input %v doesn't match the output "+
+				"it spends", txIn.PreviousOutPoint)
+		}
+
+		totalIn += btcutil.Amount(prevOut.Value)
+	}
+
+	var totalOut btcutil.Amount
+	for _, changeOutput := range changeOutputs {
+		totalOut += btcutil.Amount(changeOutput.Value)
+	}
+
+	if totalIn < fundingAmt+totalOut {
+		return fmt.Errorf("inputs worth %v can't pay for funding "+
+			"amount %v and change of %v", totalIn, fundingAmt,
+			totalOut)
+	}
+
+	return nil
+}
+
+// handleExternalFundingTx processes a funding transaction that was crafted and
+// signed outside of the wallet. After the transaction has been verified to
+// fund the channel, both commitment transactions are created, and we'll sign
+// the remote party's version.
+func (l *LightningWallet) handleExternalFundingTx(req *addExternalFundingTxMsg) {
+	l.limboMtx.RLock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.RUnlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	switch {
+	case !pendingReservation.externalFunding:
+		req.err <- fmt.Errorf("reservation isn't funded externally")
+		return
+
+	case pendingReservation.fundingOutput == nil:
+		req.err <- fmt.Errorf("remote contribution hasn't been " +
+			"processed yet")
+		return
+
+	case pendingReservation.fundingTx != nil:
+		req.err <- fmt.Errorf("funding transaction already provided")
+		return
+	}
+
+	fundingTx := req.fundingTx.Copy()
+	err := checkFundingOutput(fundingTx, pendingReservation.fundingOutput)
+	if err == nil && !req.fromWallet {
+		err = l.verifyExternalFundingInputs(fundingTx)
+	}
+	if err != nil {
+		req.err <- fmt.Errorf("invalid funding transaction: %v", err)
+		return
+	}
+
+	// Re-generate the witness script of the funding output, which is
+	// needed to sign the remote party's commitment transaction.
+	ourKey := pendingReservation.ourContribution.MultiSigKey
+	theirKey := pendingReservation.theirContribution.MultiSigKey
+	witnessScript, multiSigOut, err := GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(pendingReservation.partialState.Capacity),
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	pendingReservation.fundingTx = fundingTx
+	err = l.initFundingCommitments(
+		pendingReservation, witnessScript, multiSigOut,
+	)
+	if err != nil {
+		pendingReservation.fundingTx = nil
+	}
+	req.err <- err
+}
+
+// checkFundingOutput ensures that the passed funding transaction pays the full
+// channel capacity to the funding output.
+func checkFundingOutput(fundingTx *wire.MsgTx,
+	fundingOutput *wire.TxOut) error {
+
+	found, index := FindScriptOutputIndex(fundingTx, fundingOutput.PkScript)
+	if !found {
+		return fmt.Errorf("funding output not found")
+	}
+	if fundingTx.TxOut[index].Value != fundingOutput.Value {
+		return fmt.Errorf("funding output has value %v, expected %v",
+			btcutil.Amount(fundingTx.TxOut[index].Value),
+			btcutil.Amount(fundingOutput.Value))
+	}
+
+	return nil
+}
+
+// verifyExternalFundingInputs ensures that the passed transaction, which was
+// crafted outside of the wallet, is fully signed. As we'll sign the remote
+// party's commitment transaction before the funding transaction confirms, each
+// input must spend a confirmed native segwit output, so the txid of the
+// funding transaction can't be malleated.
+func (l *LightningWallet) verifyExternalFundingInputs(
+	fundingTx *wire.MsgTx) error {
+
+	hashCache := txscript.NewTxSigHashes(fundingTx)
+	for i, txIn := range fundingTx.TxIn {
+		switch {
+		case len(txIn.SignatureScript) != 0:
+			return fmt.Errorf("input %v doesn't spend a native "+
+				"segwit output", i)
+
+		case len(txIn.Witness) == 0:
+			return fmt.Errorf("input %v isn't signed", i)
+		}
+
+		// Derive the pkScript of the output being spent from the
+		// witness, which is needed to look up the output when we're
+		// running as a light client.
+		var pkScript []byte
+		var err error
+		witness := txIn.Witness
+		if len(witness) == 2 && len(witness[1]) == 33 {
+			pkScript, err = txscript.NewScriptBuilder().AddOp(
+				txscript.OP_0,
+			).AddData(btcutil.Hash160(witness[1])).Script()
+		} else {
+			pkScript, err = WitnessScriptHash(
+				witness[len(witness)-1],
+			)
+		}
+		if err != nil {
+			return err
+		}
+
+		output, err := l.Cfg.ChainIO.GetUtxo(
+			&txIn.PreviousOutPoint, pkScript, 0,
+		)
+		if output == nil {
+			return fmt.Errorf("input %v does not exist: %v", i, err)
+		}
+
+		vm, err := txscript.NewEngine(
+			output.PkScript, fundingTx, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			output.Value,
 		)
+		if err != nil {
+			return fmt.Errorf("cannot create script engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("input %v has an invalid signature: "+
+				"%v", i, err)
+		}
 	}
 
+	return nil
+}
+
+// initFundingCommitments creates both commitment transactions that spend from
+// the reservation's funding transaction, and signs the remote party's version.
+func (l *LightningWallet) initFundingCommitments(
+	pendingReservation *ChannelReservation, witnessScript []byte,
+	multiSigOut *wire.TxOut) error {
+
+	fundingTx := pendingReservation.fundingTx
+	theirContribution := pendingReservation.theirContribution
+	ourContribution := pendingReservation.ourContribution
+	ourKey := ourContribution.MultiSigKey
+
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
@@ -808,39 +1195,29 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
 	)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
-	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	//
+	// NOTE: The obfuscator is derived from the payment base points of the
+	// initiator and the responder, in that order, regardless of whether
+	// the responder contributes funds to the channel.
+	stateObfuscator := DeriveStateHintObfuscator(
+		ourContribution.PaymentBasePoint.PubKey,
+		theirContribution.PaymentBasePoint.PubKey,
+	)
+	if !chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
-			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
 		)
-	} else {
-		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		switch bytes.Compare(ourSer, theirSer) {
-		case -1:
-			stateObfuscator = DeriveStateHintObfuscator(
-				ourContribution.PaymentBasePoint.PubKey,
-				theirContribution.PaymentBasePoint.PubKey,
-			)
-		default:
-			stateObfuscator = DeriveStateHintObfuscator(
-				theirContribution.PaymentBasePoint.PubKey,
-				ourContribution.PaymentBasePoint.PubKey,
-			)
-		}
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// Sort both transactions according to the agreed upon canonical
@@ -861,7 +1238,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = SignDescriptor{
+	signDesc := SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -871,12 +1248,11 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	sigTheirCommit, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 	pendingReservation.ourCommitmentSig = sigTheirCommit
 
-	req.err <- nil
+	return nil
 }
 
 // handleSingleContribution is called as the second step to a single funder
@@ -920,6 +1296,78 @@ func (l *LightningWallet) handleSingleContribution(req *addSingleContributionMsg
 	return
 }
 
+// handleDualFundingInputs is called as the third step of a dual funder
+// workflow to which we are the responder. Once the inputs and change outputs
+// of the initiator have been verified, we're able to build the complete
+// funding transaction, and sign our own inputs to it.
+func (l *LightningWallet) handleDualFundingInputs(
+	req *addDualFundingInputsMsg) {
+
+	l.limboMtx.RLock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.RUnlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety.
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	chanState := pendingReservation.partialState
+	theirContribution := pendingReservation.theirContribution
+	switch {
+	case chanState.ChanType != channeldb.DualFunder ||
+		chanState.IsInitiator:
+
+		req.err <- fmt.Errorf("reservation isn't the responder to a " +
+			"dual funder channel")
+		return
+
+	case theirContribution.MultiSigKey.PubKey == nil:
+		req.err <- fmt.Errorf("remote contribution hasn't been " +
+			"processed yet")
+		return
+
+	case pendingReservation.fundingTx != nil:
+		req.err <- fmt.Errorf("funding transaction already created")
+		return
+	}
+
+	// The initiator funds the remainder of the channel capacity, so their
+	// inputs must be sufficient to pay for it.
+	ourContribution := pendingReservation.ourContribution
+	theirFundingAmt := chanState.Capacity - ourContribution.FundingAmount
+	err := l.verifyContributionInputs(
+		req.inputs, req.prevOutputs, req.prevOutputHeights,
+		req.changeOutputs, theirFundingAmt,
+	)
+	if err != nil {
+		req.err <- fmt.Errorf("invalid dual funder contribution: %v",
+			err)
+		return
+	}
+
+	theirContribution.FundingAmount = theirFundingAmt
+	theirContribution.Inputs = req.inputs
+	theirContribution.PrevOutputs = req.prevOutputs
+	theirContribution.PrevOutputHeights = req.prevOutputHeights
+	theirContribution.ChangeOutputs = req.changeOutputs
+
+	_, multiSigOut, err := GenFundingPkScript(
+		ourContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		theirContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		int64(chanState.Capacity),
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	req.err <- l.buildFundingTx(pendingReservation, multiSigOut)
+}
+
 // openChanDetails contains a "finalized" channel which can be considered
 // "open" according to the requested confirmation depth at reservation
 // initialization. Additionally, the struct contains additional details
@@ -952,58 +1400,62 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	defer res.Unlock()
 
 	// Now we can complete the funding transaction by adding their
-	// signatures to their inputs.
+	// signatures to their inputs. We'll map each of their inputs to the
+	// output it spends, which has been verified to exist when their
+	// contribution was processed.
+	theirPrevOutputs := make(map[wire.OutPoint]*wire.TxOut)
+	for i, txIn := range res.theirContribution.Inputs {
+		if i < len(res.theirContribution.PrevOutputs) {
+			prevOut := res.theirContribution.PrevOutputs[i]
+			theirPrevOutputs[txIn.PreviousOutPoint] = prevOut
+		}
+	}
+
 	res.theirFundingInputScripts = msg.theirFundingInputScripts
 	inputScripts := msg.theirFundingInputScripts
 	fundingTx := res.fundingTx
 	sigIndex := 0
 	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
 	for i, txin := range fundingTx.TxIn {
-		if len(inputScripts) != 0 && len(txin.Witness) == 0 {
-			// Attach the input scripts so we can verify it below.
-			txin.Witness = inputScripts[sigIndex].Witness
-			txin.SignatureScript = inputScripts[sigIndex].ScriptSig
-
-			// Fetch the alleged previous output along with the
-			// pkscript referenced by this input.
-			//
-			// TODO(roasbeef): when dual funder pass actual
-			// height-hint
-			pkScript, err := WitnessScriptHash(
-				txin.Witness[len(txin.Witness)-1],
-			)
-			if err != nil {
-			}
-			output, err := l.Cfg.ChainIO.GetUtxo(
-				&txin.PreviousOutPoint,
-				pkScript, 0,
-			)
-			if output == nil {
-				msg.err <- fmt.Errorf("input to funding tx "+
-					"does not exist: %v", err)
-				msg.completeChan <- nil
-				return
-			}
+		output, ok := theirPrevOutputs[txin.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+		if sigIndex >= len(inputScripts) {
+			msg.err <- fmt.Errorf("missing signature for funding "+
+				"input %v", txin.PreviousOutPoint)
+			msg.completeChan <- nil
+			return
+		}
 
-			// Ensure that the witness+sigScript combo is valid.
-			vm, err := txscript.NewEngine(output.PkScript,
-				fundingTx, i, txscript.StandardVerifyFlags, nil,
-				fundingHashCache, output.Value)
-			if err != nil {
-				msg.err <- fmt.Errorf("cannot create script "+
-					"engine: %s", err)
-				msg.completeChan <- nil
-				return
-			}
-			if err = vm.Execute(); err != nil {
-				msg.err <- fmt.Errorf("cannot validate "+
-					"transaction: %s", err)
-				msg.completeChan <- nil
-				return
-			}
+		// Attach the input scripts so we can verify it below.
+		txin.Witness = inputScripts[sigIndex].Witness
+		txin.SignatureScript = inputScripts[sigIndex].ScriptSig
 
-			sigIndex++
+		// Ensure that the witness+sigScript combo is valid.
+		vm, err := txscript.NewEngine(output.PkScript,
+			fundingTx, i, txscript.StandardVerifyFlags, nil,
+			fundingHashCache, output.Value)
+		if err != nil {
+			msg.err <- fmt.Errorf("cannot create script "+
+				"engine: %s", err)
+			msg.completeChan <- nil
+			return
 		}
+		if err = vm.Execute(); err != nil {
+			msg.err <- fmt.Errorf("cannot validate "+
+				"transaction: %s", err)
+			msg.completeChan <- nil
+			return
+		}
+
+		sigIndex++
+	}
+	if sigIndex != len(inputScripts) {
+		msg.err <- fmt.Errorf("received %v funding input signatures, "+
+			"expected %v", len(inputScripts), sigIndex)
+		msg.completeChan <- nil
+		return
 	}
 
 	// At this point, we can also record and verify their signature for our
@@ -1115,6 +1567,46 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	defer pendingReservation.Unlock()
 
 	chanState := pendingReservation.partialState
+
+	// If we contribute funds to the channel ourselves, then we've already
+	// built the funding transaction, and the initiator must have arrived
+	// at the very same one.
+	if chanState.ChanType == channeldb.DualFunder {
+		fundingTx := pendingReservation.fundingTx
+		if fundingTx == nil {
+			req.err <- fmt.Errorf("initiator's funding inputs " +
+				"haven't been processed yet")
+			req.completeChan <- nil
+			return
+		}
+
+		ourKey := pendingReservation.ourContribution.MultiSigKey
+		theirKey := pendingReservation.theirContribution.MultiSigKey
+		_, multiSigOut, err := GenFundingPkScript(
+			ourKey.PubKey.SerializeCompressed(),
+			theirKey.PubKey.SerializeCompressed(),
+			int64(chanState.Capacity),
+		)
+		if err != nil {
+			req.err <- err
+			req.completeChan <- nil
+			return
+		}
+
+		_, index := FindScriptOutputIndex(fundingTx, multiSigOut.PkScript)
+		expectedOutpoint := wire.OutPoint{
+			Hash:  fundingTx.TxHash(),
+			Index: index,
+		}
+		if *req.fundingOutpoint != expectedOutpoint {
+			req.err <- fmt.Errorf("funding outpoint %v doesn't "+
+				"match ours: %v", req.fundingOutpoint,
+				expectedOutpoint)
+			req.completeChan <- nil
+			return
+		}
+	}
+
 	chanState.FundingOutpoint = *req.fundingOutpoint
 	fundingTxIn := wire.NewTxIn(req.fundingOutpoint, nil, nil)
 
@@ -1259,11 +1751,12 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 // outputs which sum to at least 'numCoins' amount of satoshis. If coin
 // selection is successful/possible, then the selected coins are available
 // within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// also be generated. If nativeSegwit is set, only native segwit outputs are
+// selected.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
-	contribution *ChannelContribution) error {
+	amt btcutil.Amount, minConfs int32, inputs []wire.OutPoint,
+	nativeSegwit bool, contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
 	// performing coin selection in order to avoid inadvertent double
@@ -1274,25 +1767,71 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	walletLog.Infof("Performing funding tx coin selection using %v "+
 		"sat/kw as fee rate", int64(feeRate))
 
-	// Find all unlocked unspent witness outputs that satisfy the minimum
-	// number of confirmations required.
-	coins, err := l.ListUnspentWitness(minConfs)
+	// The counterparty looks up the selected coins starting at the height
+	// they were confirmed at, which we derive from the number of
+	// confirmations. We fetch the best height before the coins, so a new
+	// block can only make the derived heights too low, never too high.
+	_, bestHeight, err := l.Cfg.ChainIO.GetBestBlock()
 	if err != nil {
 		return err
 	}
 
-	// Perform coin selection over our available, unlocked unspent outputs
-	// in order to find enough coins to meet the funding amount
-	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
-	if err != nil {
-		return err
+	var (
+		selectedCoins []*Utxo
+		changeAmt     btcutil.Amount
+	)
+	if len(inputs) != 0 {
+		// If the caller specified the outputs to fund the channel
+		// with, we'll spend exactly those, as long as they're
+		// sufficient to pay for the funding amount.
+		coins, err := l.fetchUnspentInputs(inputs, minConfs)
+		if err != nil {
+			return err
+		}
+		for _, coin := range coins {
+			if nativeSegwit && coin.AddressType != WitnessPubKey {
+				return fmt.Errorf("outpoint %v isn't a native "+
+					"segwit output", coin.OutPoint)
+			}
+		}
+
+		changeAmt, err = fundingChange(feeRate, amt, coins)
+		if err != nil {
+			return err
+		}
+		selectedCoins = coins
+	} else {
+		// Find all unlocked unspent witness outputs that satisfy the
+		// minimum number of confirmations required.
+		coins, err := l.ListUnspentWitness(minConfs)
+		if err != nil {
+			return err
+		}
+		if nativeSegwit {
+			nativeCoins := coins[:0]
+			for _, coin := range coins {
+				if coin.AddressType == WitnessPubKey {
+					nativeCoins = append(nativeCoins, coin)
+				}
+			}
+			coins = nativeCoins
+		}
+
+		// Perform coin selection over our available, unlocked unspent
+		// outputs in order to find enough coins to meet the funding
+		// amount requirements.
+		selectedCoins, changeAmt, err = coinSelect(feeRate, amt, coins)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Lock the selected coins. These coins are now "reserved", this
 	// prevents concurrent funding requests from referring to and this
 	// double-spending the same set of coins.
 	contribution.Inputs = make([]*wire.TxIn, len(selectedCoins))
+	contribution.PrevOutputs = make([]*wire.TxOut, len(selectedCoins))
+	contribution.PrevOutputHeights = make([]uint32, len(selectedCoins))
 	for i, coin := range selectedCoins {
 		outpoint := &coin.OutPoint
 		l.lockedOutPoints[*outpoint] = struct{}{}
@@ -1301,6 +1840,13 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 		// Empty sig script, we'll actually sign if this reservation is
 		// queued up to be completed (the other side accepts).
 		contribution.Inputs[i] = wire.NewTxIn(outpoint, nil, nil)
+		contribution.PrevOutputs[i] = &wire.TxOut{
+			Value:    int64(coin.Value),
+			PkScript: coin.PkScript,
+		}
+		contribution.PrevOutputHeights[i] = uint32(
+			bestHeight - int32(coin.Confirmations) + 1,
+		)
 	}
 
 	// Record any change output(s) generated as a result of the coin
@@ -1362,6 +1908,488 @@ func initStateHints(commit1, commit2 *wire.MsgTx,
 	return nil
 }
 
+// addInputWeight updates the weight estimate to account for spending the
+// passed wallet output.
+func addInputWeight(weightEstimate *TxWeightEstimator, utxo *Utxo) error {
+	switch utxo.AddressType {
+	case WitnessPubKey:
+		weightEstimate.AddP2WKHInput()
+	case NestedWitnessPubKey:
+		weightEstimate.AddNestedP2WKHInput()
+	default:
+		return fmt.Errorf("Unsupported address type: %v",
+			utxo.AddressType)
+	}
+
+	return nil
+}
+
+// fundingTxFee returns the fee required at the passed fee rate for a funding
+// transaction that spends the given coins.
+func fundingTxFee(feeRate SatPerKWeight, coins []*Utxo) (btcutil.Amount,
+	error) {
+
+	var weightEstimate TxWeightEstimator
+	for _, utxo := range coins {
+		if err := addInputWeight(&weightEstimate, utxo); err != nil {
+			return 0, err
+		}
+	}
+
+	// Channel funding multisig output is P2WSH.
+	weightEstimate.AddP2WSHOutput()
+
+	// Assume that change output is a P2WKH output.
+	//
+	// TODO: Handle wallets that generate non-witness change
+	// addresses.
+	weightEstimate.AddP2WKHOutput()
+
+	totalWeight := int64(weightEstimate.Weight())
+	return feeRate.FeeForWeight(totalWeight), nil
+}
+
+// fundingChange returns the change left over when funding a channel of the
+// passed amount using all of the given coins. An error is returned if the
+// coins aren't sufficient to pay for both the funding amount and the fees.
+func fundingChange(feeRate SatPerKWeight, amt btcutil.Amount,
+	coins []*Utxo) (btcutil.Amount, error) {
+
+	var totalSat btcutil.Amount
+	for _, coin := range coins {
+		totalSat += coin.Value
+	}
+
+	requiredFee, err := fundingTxFee(feeRate, coins)
+	if err != nil {
+		return 0, err
+	}
+	if totalSat < amt+requiredFee {
+		return 0, &ErrInsufficientFunds{amt + requiredFee, totalSat}
+	}
+
+	return totalSat - amt - requiredFee, nil
+}
+
+// fetchUnspentInputs returns the wallet outputs identified by the passed
+// outpoints. An error is returned if any of them isn't an unspent output of
+// the wallet with at least minConfs confirmations, or if it's currently
+// locked, either by a pending reservation or by a lease.
+//
+// NOTE: The coin select mutex MUST be held when calling this method.
+func (l *LightningWallet) fetchUnspentInputs(inputs []wire.OutPoint,
+	minConfs int32) ([]*Utxo, error) {
+
+	coins, err := l.ListUnspentWitness(minConfs)
+	if err != nil {
+		return nil, err
+	}
+	unspent := make(map[wire.OutPoint]*Utxo, len(coins))
+	for _, coin := range coins {
+		unspent[coin.OutPoint] = coin
+	}
+
+	selected := make([]*Utxo, 0, len(inputs))
+	for _, op := range inputs {
+		coin, ok := unspent[op]
+		if !ok {
+			return nil, fmt.Errorf("outpoint %v isn't an available "+
+				"unspent output of the wallet", op)
+		}
+
+		// Remove the coin from the set, so the same outpoint can't be
+		// used twice.
+		delete(unspent, op)
+		selected = append(selected, coin)
+	}
+
+	return selected, nil
+}
+
+// SendOutputsWithInputs crafts, signs and broadcasts a transaction paying out
+// to the specified outputs, which spends exactly the passed wallet outputs. If
+// the inputs exceed the total output value and the fee at the given fee rate,
+// the remainder is sent to a change address, unless it would be dust. This
+// allows the caller to control which coins are used, in contrast to
+// SendOutputs which performs coin selection.
+func (l *LightningWallet) SendOutputsWithInputs(inputs []wire.OutPoint,
+	outputs []*wire.TxOut, feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	// We hold the coin select mutex to make sure none of the inputs are
+	// selected for a funding transaction in the meantime.
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	coins, err := l.fetchUnspentInputs(inputs, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.sendCoins(coins, outputs, feeRate)
+}
+
+// SendOutputs funds, signs, and broadcasts a Bitcoin transaction paying out to
+// the specified outputs. If the wallet is watch-only, coin selection is done
+// here and the inputs are signed by the configured Signer, as the underlying
+// wallet can't sign for its outputs itself. Otherwise, this is left to the
+// WalletController.
+func (l *LightningWallet) SendOutputs(outputs []*wire.TxOut,
+	feeRate SatPerKWeight) (*chainhash.Hash, error) {
+
+	if !l.Cfg.WatchOnly {
+		return l.WalletController.SendOutputs(outputs, feeRate)
+	}
+
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	unspent, err := l.ListUnspentWitness(1)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalOut btcutil.Amount
+	for _, output := range outputs {
+		totalOut += btcutil.Amount(output.Value)
+	}
+
+	// We'll select coins until they cover both the outputs and the fee of
+	// spending them.
+	amtNeeded := totalOut
+	for {
+		totalIn, coins, err := selectInputs(amtNeeded, unspent)
+		if err != nil {
+			return nil, err
+		}
+
+		fee, err := sendFee(coins, outputs, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		if totalIn < totalOut+fee {
+			amtNeeded = totalOut + fee
+			continue
+		}
+
+		tx, err := l.sendCoins(coins, outputs, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		txid := tx.TxHash()
+
+		return &txid, nil
+	}
+}
+
+// sendFee returns the fee of a transaction spending the passed coins to the
+// passed outputs and a change output at the given fee rate.
+func sendFee(coins []*Utxo, outputs []*wire.TxOut,
+	feeRate SatPerKWeight) (btcutil.Amount, error) {
+
+	var weightEstimate TxWeightEstimator
+	for _, coin := range coins {
+		if err := addInputWeight(&weightEstimate, coin); err != nil {
+			return 0, err
+		}
+	}
+	for _, output := range outputs {
+		weightEstimate.AddTxOutput(output)
+	}
+
+	// We'll account for a change output, even if it turns out to be dust.
+	weightEstimate.AddP2WKHOutput()
+
+	return feeRate.FeeForWeight(int64(weightEstimate.Weight())), nil
+}
+
+// sendCoins crafts, signs and broadcasts a transaction spending exactly the
+// passed coins to the specified outputs. The remainder is sent to a change
+// address, unless it would be dust. The coin select mutex must be held.
+func (l *LightningWallet) sendCoins(coins []*Utxo, outputs []*wire.TxOut,
+	feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	tx := wire.NewMsgTx(2)
+	var totalIn, totalOut btcutil.Amount
+	for _, coin := range coins {
+		totalIn += coin.Value
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+	for _, output := range outputs {
+		totalOut += btcutil.Amount(output.Value)
+		tx.AddTxOut(output)
+	}
+
+	fee, err := sendFee(coins, outputs, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	if totalIn < totalOut+fee {
+		return nil, &ErrInsufficientFunds{totalOut + fee, totalIn}
+	}
+
+	changeAmt := totalIn - totalOut - fee
+	if changeAmt > DefaultDustLimit() {
+		changeAddr, err := l.NewAddress(WitnessPubKey, true)
+		if err != nil {
+			return nil, err
+		}
+		changeScript, err := txscript.PayToAddrScript(changeAddr)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(changeAmt),
+			PkScript: changeScript,
+		})
+	}
+
+	txsort.InPlaceSort(tx)
+
+	// With the transaction complete, we'll sign each of the inputs.
+	if err := l.signTxInputs(tx); err != nil {
+		return nil, err
+	}
+
+	walletLog.Debugf("Publishing tx spending selected inputs: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(tx)
+		}))
+
+	if err := l.PublishTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// SweepAll creates, signs and broadcasts a transaction that spends all
+// confirmed wallet outputs to the passed output script, paying the given fee
+// rate. Outputs that are locked, e.g. because they're leased or reserved for a
+// pending channel, aren't spent. The amount sent is the value of the outputs
+// minus the fee.
+func (l *LightningWallet) SweepAll(pkScript []byte,
+	feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	// We hold the coin select mutex so that no funding transaction can
+	// select any of the outputs while we're sweeping them.
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	// Locked outputs aren't returned as unspent by the wallet, so they're
+	// excluded from the sweep.
+	coins, err := l.ListUnspentWitness(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(coins) == 0 {
+		return nil, fmt.Errorf("no confirmed wallet outputs to sweep")
+	}
+
+	tx := wire.NewMsgTx(2)
+	var weightEstimate TxWeightEstimator
+	var totalIn btcutil.Amount
+	for _, coin := range coins {
+		if err := addInputWeight(&weightEstimate, coin); err != nil {
+			return nil, err
+		}
+		totalIn += coin.Value
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+
+	sweepOutput := &wire.TxOut{PkScript: pkScript}
+	weightEstimate.AddTxOutput(sweepOutput)
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+
+	// The remaining amount must be large enough to not be considered dust.
+	if totalIn-fee <= DefaultDustLimit() {
+		return nil, &ErrInsufficientFunds{
+			fee + DefaultDustLimit() + 1, totalIn,
+		}
+	}
+	sweepOutput.Value = int64(totalIn - fee)
+	tx.AddTxOut(sweepOutput)
+
+	txsort.InPlaceSort(tx)
+
+	if err := l.signTxInputs(tx); err != nil {
+		return nil, err
+	}
+
+	walletLog.Debugf("Publishing tx sweeping all wallet outputs: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(tx)
+		}))
+
+	if err := l.PublishTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// CreateBatchFundingTx crafts and signs a single transaction paying to each of
+// the passed funding outputs, which allows several channels to be funded at
+// once. If inputs is empty, coins with at least minConfs confirmations are
+// selected from the wallet, otherwise exactly the given outputs are spent.
+// Any remaining value is sent to a change address, unless it would be dust.
+//
+// The transaction is NOT broadcast. Its inputs remain locked until either the
+// transaction is published, or they're released via ReleaseBatchInputs.
+func (l *LightningWallet) CreateBatchFundingTx(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32,
+	inputs []wire.OutPoint) (*wire.MsgTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no funding outputs specified")
+	}
+
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	// The fee estimate of the coin selection only accounts for a single
+	// funding output, so we'll add the fee of the remaining ones to the
+	// amount to be selected.
+	var totalOut btcutil.Amount
+	for _, output := range outputs {
+		totalOut += btcutil.Amount(output.Value)
+	}
+	extraWeight := int64(len(outputs)-1) * P2WSHOutputSize *
+		blockchain.WitnessScaleFactor
+	amt := totalOut + feeRate.FeeForWeight(extraWeight)
+
+	var (
+		coins     []*Utxo
+		changeAmt btcutil.Amount
+		err       error
+	)
+	if len(inputs) != 0 {
+		coins, err = l.fetchUnspentInputs(inputs, minConfs)
+		if err != nil {
+			return nil, err
+		}
+		changeAmt, err = fundingChange(feeRate, amt, coins)
+	} else {
+		var unspent []*Utxo
+		unspent, err = l.ListUnspentWitness(minConfs)
+		if err != nil {
+			return nil, err
+		}
+		coins, changeAmt, err = coinSelect(feeRate, amt, unspent)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, coin := range coins {
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+	if changeAmt > DefaultDustLimit() {
+		changeAddr, err := l.NewAddress(WitnessPubKey, true)
+		if err != nil {
+			return nil, err
+		}
+		changeScript, err := txscript.PayToAddrScript(changeAddr)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(changeAmt),
+			PkScript: changeScript,
+		})
+	}
+
+	txsort.InPlaceSort(tx)
+
+	// The transaction is signed right away, as the txid must be final
+	// before we can sign any of the commitment transactions spending
+	// from it.
+	if err := l.signTxInputs(tx); err != nil {
+		return nil, err
+	}
+
+	// Lock the selected coins, such that they won't be picked by any
+	// other funding flow while the channels of the batch are negotiated.
+	for _, coin := range coins {
+		l.lockedOutPoints[coin.OutPoint] = struct{}{}
+		l.LockOutpoint(coin.OutPoint)
+	}
+
+	return tx, nil
+}
+
+// ReleaseBatchInputs unlocks the inputs of a funding transaction created by
+// CreateBatchFundingTx, which won't be broadcast after all.
+func (l *LightningWallet) ReleaseBatchInputs(tx *wire.MsgTx) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range tx.TxIn {
+		l.unlockOutPoint(txIn.PreviousOutPoint)
+	}
+}
+
+// signTxInputs signs each input of the passed transaction, which must all
+// spend outputs controlled by the wallet.
+func (l *LightningWallet) signTxInputs(tx *wire.MsgTx) error {
+	inputScripts, err := l.signInputs(tx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, txIn := range tx.TxIn {
+		txIn.SignatureScript = inputScripts[i].ScriptSig
+		txIn.Witness = inputScripts[i].Witness
+	}
+
+	return nil
+}
+
+// signInputs generates the input scripts for the inputs of the passed
+// transaction that spend one of the given outpoints, or for all of its inputs
+// if ours is nil. The signed inputs must spend outputs controlled by the
+// wallet. The input scripts are returned in the order the inputs appear within
+// the transaction, which itself isn't modified.
+func (l *LightningWallet) signInputs(tx *wire.MsgTx,
+	ours map[wire.OutPoint]struct{}) ([]*InputScript, error) {
+
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(tx),
+	}
+
+	var inputScripts []*InputScript
+	for i, txIn := range tx.TxIn {
+		if ours != nil {
+			if _, ok := ours[txIn.PreviousOutPoint]; !ok {
+				continue
+			}
+		}
+
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			tx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		inputScripts = append(inputScripts, inputScript)
+	}
+
+	return inputScripts, nil
+}
+
 // selectInputs selects a slice of inputs necessary to meet the specified
 // selection amount. If input selection is unable to succeed due to insufficient
 // funds, a non-nil error is returned. Additionally, the total amount of the
@@ -1394,29 +2422,11 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			return nil, 0, err
 		}
 
-		var weightEstimate TxWeightEstimator
-
-		for _, utxo := range selectedUtxos {
-			switch utxo.AddressType {
-			case WitnessPubKey:
-				weightEstimate.AddP2WKHInput()
-			case NestedWitnessPubKey:
-				weightEstimate.AddNestedP2WKHInput()
-			default:
-				return nil, 0, fmt.Errorf("Unsupported address type: %v",
-					utxo.AddressType)
-			}
+		requiredFee, err := fundingTxFee(feeRate, selectedUtxos)
+		if err != nil {
+			return nil, 0, err
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
-
-		// Assume that change output is a P2WKH output.
-		//
-		// TODO: Handle wallets that generate non-witness change
-		// addresses.
-		weightEstimate.AddP2WKHOutput()
-
 		// The difference between the selected amount and the amount
 		// requested will be used to pay fees, and generate a change
 		// output with the remaining.
@@ -1426,8 +2436,6 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 		// amount isn't enough to pay fees, then increase the requested
 		// coin amount by the estimate required fee, performing another
 		// round of coin selection.
-		totalWeight := int64(weightEstimate.Weight())
-		requiredFee := feeRate.FeeForWeight(totalWeight)
 		if overShootAmt < requiredFee {
 			amtNeeded = amt + requiredFee
 			continue
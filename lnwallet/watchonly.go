@@ -0,0 +1,1013 @@
+package lnwallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+const (
+	// watchOnlyExternalBranch is the BIP32 branch of the receive addresses
+	// of a watch-only account.
+	watchOnlyExternalBranch = 0
+
+	// watchOnlyInternalBranch is the BIP32 branch of the change addresses
+	// of a watch-only account.
+	watchOnlyInternalBranch = 1
+
+	// watchOnlyGapLimit is the number of addresses beyond the last used
+	// one on each branch that are watched for incoming payments.
+	watchOnlyGapLimit = 20
+
+	// watchOnlySyncInterval is the interval at which the chain is polled
+	// for new blocks relevant to the watch-only accounts.
+	watchOnlySyncInterval = 30 * time.Second
+
+	// watchOnlyReorgDepth is the number of recent block hashes kept for
+	// each watch-only account in order to detect reorgs.
+	watchOnlyReorgDepth = 20
+
+	// watchOnlyCheckpointInterval is the number of blocks after which the
+	// sync progress of an account is persisted, even if none of the blocks
+	// were relevant to it.
+	watchOnlyCheckpointInterval = 100
+)
+
+var (
+	// ErrWatchOnlyPrivateKey is returned when an extended private key is
+	// passed to import a watch-only account.
+	ErrWatchOnlyPrivateKey = errors.New("watch-only accounts require an " +
+		"extended public key")
+
+	// ErrWatchOnlyWrongNet is returned when the extended key of a watch-
+	// only account doesn't belong to the active network.
+	ErrWatchOnlyWrongNet = errors.New("extended key is not for the " +
+		"active network")
+)
+
+// watchOnlyKeyLocator is the location of a key of a watch-only account.
+type watchOnlyKeyLocator struct {
+	branch uint32
+	index  uint32
+}
+
+// watchOnlyAccount is the in-memory state of a watch-only account that's
+// needed to scan blocks for its transactions.
+type watchOnlyAccount struct {
+	info *channeldb.WatchOnlyAccount
+
+	netParams *chaincfg.Params
+
+	// branchKeys are the extended keys of the external and internal
+	// branches of the account.
+	branchKeys [2]*hdkeychain.ExtendedKey
+
+	// numDerived is the number of keys derived so far on each branch.
+	numDerived [2]uint32
+
+	// scripts maps the output scripts of all derived addresses to the
+	// location of their key.
+	scripts map[string]watchOnlyKeyLocator
+
+	// utxos is the set of unspent outputs of the account.
+	utxos map[wire.OutPoint]*channeldb.WatchOnlyUtxo
+}
+
+// newWatchOnlyAccount creates the in-memory state of a watch-only account
+// from its stored info and unspent outputs.
+func newWatchOnlyAccount(info *channeldb.WatchOnlyAccount,
+	utxos []*channeldb.WatchOnlyUtxo,
+	netParams *chaincfg.Params) (*watchOnlyAccount, error) {
+
+	switch AddressType(info.AddrType) {
+	case WitnessPubKey, NestedWitnessPubKey:
+	default:
+		return nil, fmt.Errorf("unsupported address type %v for "+
+			"watch-only account", info.AddrType)
+	}
+
+	key, err := hdkeychain.NewKeyFromString(info.ExtendedKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate() {
+		return nil, ErrWatchOnlyPrivateKey
+	}
+	if !key.IsForNet(netParams) {
+		return nil, ErrWatchOnlyWrongNet
+	}
+
+	a := &watchOnlyAccount{
+		info:      info,
+		netParams: netParams,
+		scripts:   make(map[string]watchOnlyKeyLocator),
+		utxos:     make(map[wire.OutPoint]*channeldb.WatchOnlyUtxo),
+	}
+	for _, branch := range []uint32{
+		watchOnlyExternalBranch, watchOnlyInternalBranch,
+	} {
+		a.branchKeys[branch], err = key.Child(branch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := a.extendLookahead(info); err != nil {
+		return nil, err
+	}
+
+	for _, utxo := range utxos {
+		if utxo.SpentHeight == 0 {
+			a.utxos[utxo.OutPoint] = utxo
+		}
+	}
+
+	return a, nil
+}
+
+// deriveAddress derives the address of the key at the given location, along
+// with the key itself.
+func (a *watchOnlyAccount) deriveAddress(branch,
+	index uint32) (btcutil.Address, *btcec.PublicKey, error) {
+
+	child, err := a.branchKeys[branch].Child(index)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()), a.netParams,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if AddressType(a.info.AddrType) == WitnessPubKey {
+		return witnessAddr, pubKey, nil
+	}
+
+	// Nested addresses are the P2SH address of the witness program.
+	witnessProgram, err := txscript.PayToAddrScript(witnessAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := btcutil.NewAddressScriptHash(witnessProgram, a.netParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return addr, pubKey, nil
+}
+
+// extendLookahead derives the keys of each branch up to the gap limit beyond
+// the next indexes of the passed account info, so that payments to them are
+// detected.
+func (a *watchOnlyAccount) extendLookahead(
+	info *channeldb.WatchOnlyAccount) error {
+
+	nextIndexes := [2]uint32{info.NextExternalIndex, info.NextInternalIndex}
+	for branch, nextIndex := range nextIndexes {
+		for a.numDerived[branch] < nextIndex+watchOnlyGapLimit {
+			index := a.numDerived[branch]
+			a.numDerived[branch]++
+
+			addr, _, err := a.deriveAddress(uint32(branch), index)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+
+			a.scripts[string(pkScript)] = watchOnlyKeyLocator{
+				branch: uint32(branch),
+				index:  index,
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyInfo returns a copy of the account info that can be modified without
+// affecting the current one.
+func (a *watchOnlyAccount) copyInfo() *channeldb.WatchOnlyAccount {
+	info := *a.info
+	info.DerivationPath = append([]uint32(nil), a.info.DerivationPath...)
+	info.RecentBlockHashes = append(
+		[]chainhash.Hash(nil), a.info.RecentBlockHashes...,
+	)
+
+	return &info
+}
+
+// loadWatchOnlyAccounts restores the watch-only accounts from the database.
+func (l *LightningWallet) loadWatchOnlyAccounts() error {
+	infos, err := l.Cfg.Database.FetchWatchOnlyAccounts()
+	if err != nil {
+		return err
+	}
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	for _, info := range infos {
+		utxos, err := l.Cfg.Database.FetchWatchOnlyUtxos(info.Name)
+		if err != nil {
+			return err
+		}
+		account, err := newWatchOnlyAccount(
+			info, utxos, &l.Cfg.NetParams,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to load watch-only account "+
+				"%v: %v", info.Name, err)
+		}
+		l.watchOnlyAccounts[info.Name] = account
+	}
+
+	return nil
+}
+
+// ImportWatchOnlyAccount imports the BIP32 extended public key of an account
+// as a watch-only account under the given name. The account's addresses are
+// of the given type, and derived from the external (0) and internal (1)
+// branches of the key. The master key fingerprint and derivation path of the
+// key are included within the PSBTs spending from the account. If they're
+// unknown, the key is assumed to be the master key. The chain is scanned for
+// the account's transactions starting from the given birthday height.
+func (l *LightningWallet) ImportWatchOnlyAccount(name, extendedKey string,
+	addrType AddressType, masterFingerprint uint32,
+	derivationPath []uint32,
+	birthdayHeight uint32) (*channeldb.WatchOnlyAccount, error) {
+
+	if name == "" {
+		return nil, errors.New("watch-only account name must be set")
+	}
+
+	key, err := hdkeychain.NewKeyFromString(extendedKey)
+	if err != nil {
+		return nil, err
+	}
+	if masterFingerprint == 0 && len(derivationPath) == 0 {
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		masterFingerprint = binary.BigEndian.Uint32(
+			btcutil.Hash160(pubKey.SerializeCompressed())[:4],
+		)
+	}
+
+	// The genesis block can't pay to the account, so we'll scan from the
+	// first block at the earliest.
+	if birthdayHeight == 0 {
+		birthdayHeight = 1
+	}
+	info := &channeldb.WatchOnlyAccount{
+		Name:              name,
+		ExtendedKey:       extendedKey,
+		AddrType:          uint8(addrType),
+		MasterFingerprint: masterFingerprint,
+		DerivationPath:    derivationPath,
+		BirthdayHeight:    birthdayHeight,
+		SyncedHeight:      birthdayHeight - 1,
+	}
+	account, err := newWatchOnlyAccount(info, nil, &l.Cfg.NetParams)
+	if err != nil {
+		return nil, err
+	}
+
+	l.watchOnlyMtx.Lock()
+	if err := l.Cfg.Database.CreateWatchOnlyAccount(info); err != nil {
+		l.watchOnlyMtx.Unlock()
+		return nil, err
+	}
+	l.watchOnlyAccounts[name] = account
+	l.watchOnlyMtx.Unlock()
+
+	walletLog.Infof("Imported watch-only account %v, scanning from "+
+		"height %v", name, birthdayHeight)
+
+	// Kick off the scan of the new account right away.
+	select {
+	case l.watchOnlySyncSignal <- struct{}{}:
+	default:
+	}
+
+	return account.copyInfo(), nil
+}
+
+// WatchOnlyAccounts returns the info of all watch-only accounts.
+func (l *LightningWallet) WatchOnlyAccounts() []*channeldb.WatchOnlyAccount {
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	infos := make([]*channeldb.WatchOnlyAccount, 0, len(l.watchOnlyAccounts))
+	for _, account := range l.watchOnlyAccounts {
+		infos = append(infos, account.copyInfo())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	return infos
+}
+
+// WatchOnlyAccountBalance returns the sum of the unspent outputs of the
+// watch-only account with the given name. As only confirmed transactions are
+// tracked for watch-only accounts, this is the account's confirmed balance.
+func (l *LightningWallet) WatchOnlyAccountBalance(
+	name string) (btcutil.Amount, error) {
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	account, ok := l.watchOnlyAccounts[name]
+	if !ok {
+		return 0, channeldb.ErrWatchOnlyAccountNotFound
+	}
+
+	var balance btcutil.Amount
+	for _, utxo := range account.utxos {
+		balance += utxo.Value
+	}
+
+	return balance, nil
+}
+
+// NewWatchOnlyAddress returns the next unused receive or change address of
+// the watch-only account with the given name.
+func (l *LightningWallet) NewWatchOnlyAddress(name string,
+	change bool) (btcutil.Address, error) {
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	account, ok := l.watchOnlyAccounts[name]
+	if !ok {
+		return nil, channeldb.ErrWatchOnlyAccountNotFound
+	}
+
+	addr, _, err := l.nextWatchOnlyAddress(account, change)
+	return addr, err
+}
+
+// nextWatchOnlyAddress derives the next address of the given branch of an
+// account, and persists the advanced address index. The location of the
+// address' key is returned along with it.
+//
+// NOTE: The watchOnlyMtx MUST be held when calling this method.
+func (l *LightningWallet) nextWatchOnlyAddress(account *watchOnlyAccount,
+	change bool) (btcutil.Address, watchOnlyKeyLocator, error) {
+
+	info := account.copyInfo()
+	nextIndex := &info.NextExternalIndex
+	branch := uint32(watchOnlyExternalBranch)
+	if change {
+		nextIndex = &info.NextInternalIndex
+		branch = watchOnlyInternalBranch
+	}
+
+	for {
+		index := *nextIndex
+		*nextIndex++
+
+		addr, _, err := account.deriveAddress(branch, index)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, watchOnlyKeyLocator{}, err
+		}
+
+		if err := l.Cfg.Database.UpdateWatchOnlyAccount(info); err != nil {
+			return nil, watchOnlyKeyLocator{}, err
+		}
+		account.info = info
+		if err := account.extendLookahead(info); err != nil {
+			return nil, watchOnlyKeyLocator{}, err
+		}
+
+		return addr, watchOnlyKeyLocator{branch: branch, index: index},
+			nil
+	}
+}
+
+// ListWatchOnlyTransactionDetails returns the transactions of all watch-only
+// accounts that confirmed within the given range of heights. An end height of
+// -1 includes all transactions up to the chain tip.
+func (l *LightningWallet) ListWatchOnlyTransactionDetails(startHeight,
+	endHeight int32) ([]*TransactionDetail, error) {
+
+	_, bestHeight, err := l.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	l.watchOnlyMtx.Lock()
+	names := make([]string, 0, len(l.watchOnlyAccounts))
+	for name := range l.watchOnlyAccounts {
+		names = append(names, name)
+	}
+	l.watchOnlyMtx.Unlock()
+	sort.Strings(names)
+
+	var details []*TransactionDetail
+	for _, name := range names {
+		txns, err := l.Cfg.Database.FetchWatchOnlyTxns(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, txn := range txns {
+			height := int32(txn.BlockHeight)
+			if height < startHeight ||
+				(endHeight != -1 && height > endHeight) {
+
+				continue
+			}
+
+			var destAddresses []btcutil.Address
+			for _, txOut := range txn.Tx.TxOut {
+				_, addrs, _, _ := txscript.ExtractPkScriptAddrs(
+					txOut.PkScript, &l.Cfg.NetParams,
+				)
+				destAddresses = append(destAddresses, addrs...)
+			}
+
+			blockHash := txn.BlockHash
+			details = append(details, &TransactionDetail{
+				Hash:             txn.Tx.TxHash(),
+				Value:            txn.Value,
+				NumConfirmations: bestHeight - height + 1,
+				BlockHash:        &blockHash,
+				BlockHeight:      height,
+				Timestamp:        txn.Timestamp,
+				TotalFees:        int64(txn.TotalFees),
+				DestAddresses:    destAddresses,
+				Account:          name,
+			})
+		}
+	}
+
+	return details, nil
+}
+
+// FundWatchOnlyPsbt creates an unsigned PSBT paying to the given outputs that
+// spends from the watch-only account with the given name. Its inputs are
+// selected from the account's outputs with at least minConfs confirmations,
+// and any change is sent to a new change address of the account. The inputs
+// are annotated with the outputs they spend and the derivation of their keys,
+// so that the PSBT can be signed by the holder of the account's private keys.
+// The index of the change output is returned along with the PSBT, or -1 if
+// there's none.
+//
+// NOTE: The selected outputs aren't locked, so funding several PSBTs without
+// broadcasting them in between may select the same outputs.
+func (l *LightningWallet) FundWatchOnlyPsbt(name string,
+	outputs []*wire.TxOut, feeRate SatPerKWeight,
+	minConfs int32) ([]byte, int32, error) {
+
+	if len(outputs) == 0 {
+		return nil, 0, errors.New("no outputs specified")
+	}
+
+	_, bestHeight, err := l.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	account, ok := l.watchOnlyAccounts[name]
+	if !ok {
+		return nil, 0, channeldb.ErrWatchOnlyAccountNotFound
+	}
+	addrType := AddressType(account.info.AddrType)
+
+	var coins []*Utxo
+	for _, utxo := range account.utxos {
+		confs := int64(bestHeight) - int64(utxo.Height) + 1
+		if confs < int64(minConfs) {
+			continue
+		}
+		coins = append(coins, &Utxo{
+			AddressType:   addrType,
+			Value:         utxo.Value,
+			Confirmations: confs,
+			PkScript:      utxo.PkScript,
+			OutPoint:      utxo.OutPoint,
+		})
+	}
+
+	// We'll spend the largest outputs first to keep the number of inputs
+	// low.
+	sort.Slice(coins, func(i, j int) bool {
+		if coins[i].Value != coins[j].Value {
+			return coins[i].Value > coins[j].Value
+		}
+		return coins[i].OutPoint.String() < coins[j].OutPoint.String()
+	})
+
+	var totalOut btcutil.Amount
+	for _, txOut := range outputs {
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+
+	// Select coins until they cover the outputs as well as the fee of a
+	// transaction that includes a change output.
+	var (
+		amtNeeded = totalOut
+		selected  []*Utxo
+		changeAmt btcutil.Amount
+	)
+	for {
+		totalIn, coinsSelected, err := selectInputs(amtNeeded, coins)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var weightEstimate TxWeightEstimator
+		for range coinsSelected {
+			if addrType == WitnessPubKey {
+				weightEstimate.AddP2WKHInput()
+			} else {
+				weightEstimate.AddNestedP2WKHInput()
+			}
+		}
+		for _, txOut := range outputs {
+			weightEstimate.AddTxOutput(txOut)
+		}
+		if addrType == WitnessPubKey {
+			weightEstimate.AddP2WKHOutput()
+		} else {
+			weightEstimate.AddP2SHOutput()
+		}
+
+		fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+		if totalIn < totalOut+fee {
+			amtNeeded = totalOut + fee
+			continue
+		}
+
+		selected = coinsSelected
+		changeAmt = totalIn - totalOut - fee
+		break
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, coin := range selected {
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+	for _, txOut := range outputs {
+		tx.AddTxOut(txOut)
+	}
+
+	var (
+		changeScript  []byte
+		changeLocator watchOnlyKeyLocator
+	)
+	if changeAmt > DefaultDustLimit() {
+		var changeAddr btcutil.Address
+		changeAddr, changeLocator, err = l.nextWatchOnlyAddress(
+			account, true,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		changeScript, err = txscript.PayToAddrScript(changeAddr)
+		if err != nil {
+			return nil, 0, err
+		}
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(changeAmt),
+			PkScript: changeScript,
+		})
+	}
+
+	txsort.InPlaceSort(tx)
+
+	// With the transaction assembled, we'll annotate its inputs and
+	// change output for the signer.
+	psbtInputs := make([]PsbtInput, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		utxo := account.utxos[txIn.PreviousOutPoint]
+		psbtInputs[i], err = account.psbtInput(utxo)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	changeIndex := int32(-1)
+	psbtOutputs := make([]PsbtOutput, len(tx.TxOut))
+	for i, txOut := range tx.TxOut {
+		if changeScript == nil || changeIndex != -1 ||
+			txOut.Value != int64(changeAmt) ||
+			string(txOut.PkScript) != string(changeScript) {
+
+			continue
+		}
+
+		changeIndex = int32(i)
+		_, pubKey, err := account.deriveAddress(
+			changeLocator.branch, changeLocator.index,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		psbtOutputs[i].RedeemScript, err = account.redeemScript(pubKey)
+		if err != nil {
+			return nil, 0, err
+		}
+		psbtOutputs[i].Bip32Derivation = account.psbtDerivation(
+			pubKey, changeLocator,
+		)
+	}
+
+	psbt, err := CreatePsbt(tx, psbtInputs, psbtOutputs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return psbt, changeIndex, nil
+}
+
+// psbtInput returns the PSBT input spending the passed output of the account.
+func (a *watchOnlyAccount) psbtInput(
+	utxo *channeldb.WatchOnlyUtxo) (PsbtInput, error) {
+
+	_, pubKey, err := a.deriveAddress(utxo.Branch, utxo.Index)
+	if err != nil {
+		return PsbtInput{}, err
+	}
+	redeemScript, err := a.redeemScript(pubKey)
+	if err != nil {
+		return PsbtInput{}, err
+	}
+
+	return PsbtInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    int64(utxo.Value),
+			PkScript: utxo.PkScript,
+		},
+		RedeemScript: redeemScript,
+		Bip32Derivation: a.psbtDerivation(pubKey, watchOnlyKeyLocator{
+			branch: utxo.Branch,
+			index:  utxo.Index,
+		}),
+	}, nil
+}
+
+// redeemScript returns the redeem script of an output paying to the passed
+// key, which is only set for nested addresses.
+func (a *watchOnlyAccount) redeemScript(pubKey *btcec.PublicKey) ([]byte,
+	error) {
+
+	if AddressType(a.info.AddrType) != NestedWitnessPubKey {
+		return nil, nil
+	}
+
+	witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()), a.netParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(witnessAddr)
+}
+
+// psbtDerivation returns the full BIP32 derivation of the key at the given
+// location of the account.
+func (a *watchOnlyAccount) psbtDerivation(pubKey *btcec.PublicKey,
+	loc watchOnlyKeyLocator) *PsbtBip32Derivation {
+
+	path := make([]uint32, 0, len(a.info.DerivationPath)+2)
+	path = append(path, a.info.DerivationPath...)
+	path = append(path, loc.branch, loc.index)
+
+	return &PsbtBip32Derivation{
+		PubKey:            pubKey.SerializeCompressed(),
+		MasterFingerprint: a.info.MasterFingerprint,
+		Path:              path,
+	}
+}
+
+// watchOnlySyncer periodically scans new blocks for transactions of the
+// watch-only accounts.
+//
+// NOTE: This MUST be run as a goroutine.
+func (l *LightningWallet) watchOnlySyncer() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(watchOnlySyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.syncWatchOnlyAccounts(); err != nil {
+			walletLog.Errorf("Unable to sync watch-only accounts: "+
+				"%v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-l.watchOnlySyncSignal:
+		case <-l.quit:
+			return
+		}
+	}
+}
+
+// syncWatchOnlyAccounts brings all watch-only accounts up to date with the
+// current chain tip. Each block is only fetched once, and then scanned for
+// all accounts that haven't processed it yet.
+func (l *LightningWallet) syncWatchOnlyAccounts() error {
+	_, bestHeight, err := l.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	tipHeight := uint32(bestHeight)
+
+	l.watchOnlyMtx.Lock()
+	accounts := make([]*watchOnlyAccount, 0, len(l.watchOnlyAccounts))
+	for _, account := range l.watchOnlyAccounts {
+		accounts = append(accounts, account)
+	}
+	l.watchOnlyMtx.Unlock()
+
+	// Before scanning any new blocks, we'll undo those that have been
+	// disconnected from the chain.
+	startHeight := tipHeight + 1
+	for _, account := range accounts {
+		syncedHeight, err := l.rewindWatchOnlyAccount(account, tipHeight)
+		if err != nil {
+			return err
+		}
+		if syncedHeight+1 < startHeight {
+			startHeight = syncedHeight + 1
+		}
+	}
+
+	for height := startHeight; height <= tipHeight; height++ {
+		select {
+		case <-l.quit:
+			return nil
+		default:
+		}
+
+		hash, err := l.Cfg.ChainIO.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+		block, err := l.Cfg.ChainIO.GetBlock(hash)
+		if err != nil {
+			return err
+		}
+
+		checkpoint := height == tipHeight ||
+			height%watchOnlyCheckpointInterval == 0
+		for _, account := range accounts {
+			err := l.connectWatchOnlyBlock(
+				account, height, block, checkpoint,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to scan block %v: %v",
+					height, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rewindWatchOnlyAccount checks whether the most recent blocks scanned for the
+// account are still part of the chain, and undoes the ones that aren't. The
+// height the account is synced to afterwards is returned.
+func (l *LightningWallet) rewindWatchOnlyAccount(account *watchOnlyAccount,
+	tipHeight uint32) (uint32, error) {
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	info := account.copyInfo()
+	for len(info.RecentBlockHashes) > 0 {
+		lastHash := info.RecentBlockHashes[len(info.RecentBlockHashes)-1]
+		if info.SyncedHeight <= tipHeight {
+			hash, err := l.Cfg.ChainIO.GetBlockHash(
+				int64(info.SyncedHeight),
+			)
+			if err != nil {
+				return 0, err
+			}
+			if *hash == lastHash {
+				break
+			}
+		}
+
+		info.RecentBlockHashes = info.RecentBlockHashes[:len(
+			info.RecentBlockHashes)-1]
+		info.SyncedHeight--
+	}
+	if info.SyncedHeight == account.info.SyncedHeight {
+		return info.SyncedHeight, nil
+	}
+
+	walletLog.Infof("Rewinding watch-only account %v from height %v to "+
+		"%v due to reorg", info.Name, account.info.SyncedHeight,
+		info.SyncedHeight)
+
+	err := l.Cfg.Database.RewindWatchOnlyAccount(info, info.SyncedHeight)
+	if err != nil {
+		return 0, err
+	}
+	utxos, err := l.Cfg.Database.FetchWatchOnlyUtxos(info.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	account.info = info
+	account.utxos = make(map[wire.OutPoint]*channeldb.WatchOnlyUtxo)
+	for _, utxo := range utxos {
+		if utxo.SpentHeight == 0 {
+			account.utxos[utxo.OutPoint] = utxo
+		}
+	}
+
+	return info.SyncedHeight, nil
+}
+
+// connectWatchOnlyBlock scans a block for outputs paying to the account and
+// inputs spending from it, if the account is synced up to the block's parent.
+// The outputs and transactions found are persisted along with the account's
+// sync progress. If the block isn't relevant to the account, the progress is
+// only persisted if checkpoint is true.
+func (l *LightningWallet) connectWatchOnlyBlock(account *watchOnlyAccount,
+	height uint32, block *wire.MsgBlock, checkpoint bool) error {
+
+	l.watchOnlyMtx.Lock()
+	defer l.watchOnlyMtx.Unlock()
+
+	if account.info.SyncedHeight+1 != height {
+		return nil
+	}
+
+	var (
+		info      = account.copyInfo()
+		blockHash = block.BlockHash()
+		updated   = make(map[wire.OutPoint]*channeldb.WatchOnlyUtxo)
+		txns      []*channeldb.WatchOnlyTx
+	)
+
+	// fetchUnspent returns the unspent output of the account with the
+	// given outpoint, taking the outputs created and spent earlier within
+	// the block into account.
+	fetchUnspent := func(op wire.OutPoint) *channeldb.WatchOnlyUtxo {
+		if utxo, ok := updated[op]; ok {
+			if utxo.SpentHeight != 0 {
+				return nil
+			}
+			return utxo
+		}
+		return account.utxos[op]
+	}
+
+	for _, tx := range block.Transactions {
+		var (
+			txHash      = tx.TxHash()
+			debit       btcutil.Amount
+			credit      btcutil.Amount
+			totalOut    btcutil.Amount
+			ownedInputs int
+		)
+
+		if !blockchain.IsCoinBaseTx(tx) {
+			for _, txIn := range tx.TxIn {
+				utxo := fetchUnspent(txIn.PreviousOutPoint)
+				if utxo == nil {
+					continue
+				}
+
+				spent := *utxo
+				spent.SpentHeight = height
+				updated[utxo.OutPoint] = &spent
+
+				debit += utxo.Value
+				ownedInputs++
+			}
+		}
+
+		for i, txOut := range tx.TxOut {
+			totalOut += btcutil.Amount(txOut.Value)
+
+			loc, ok := account.scripts[string(txOut.PkScript)]
+			if !ok {
+				continue
+			}
+
+			op := wire.OutPoint{Hash: txHash, Index: uint32(i)}
+			updated[op] = &channeldb.WatchOnlyUtxo{
+				OutPoint: op,
+				Value:    btcutil.Amount(txOut.Value),
+				PkScript: txOut.PkScript,
+				Branch:   loc.branch,
+				Index:    loc.index,
+				Height:   height,
+			}
+			credit += btcutil.Amount(txOut.Value)
+
+			// An address that has been used can't be handed out
+			// anymore, and we'll need to watch further ahead.
+			switch {
+			case loc.branch == watchOnlyExternalBranch &&
+				loc.index >= info.NextExternalIndex:
+
+				info.NextExternalIndex = loc.index + 1
+
+			case loc.branch == watchOnlyInternalBranch &&
+				loc.index >= info.NextInternalIndex:
+
+				info.NextInternalIndex = loc.index + 1
+			}
+			if err := account.extendLookahead(info); err != nil {
+				return err
+			}
+		}
+
+		if debit == 0 && credit == 0 {
+			continue
+		}
+
+		// The fee is only known if all inputs spend outputs of the
+		// account.
+		var fee btcutil.Amount
+		if ownedInputs == len(tx.TxIn) {
+			fee = debit - totalOut
+		}
+
+		txns = append(txns, &channeldb.WatchOnlyTx{
+			Tx:          tx,
+			BlockHash:   blockHash,
+			BlockHeight: height,
+			Timestamp:   block.Header.Timestamp.Unix(),
+			Value:       credit - debit,
+			TotalFees:   fee,
+		})
+	}
+
+	info.SyncedHeight = height
+	info.RecentBlockHashes = append(info.RecentBlockHashes, blockHash)
+	if len(info.RecentBlockHashes) > watchOnlyReorgDepth {
+		info.RecentBlockHashes = info.RecentBlockHashes[len(
+			info.RecentBlockHashes)-watchOnlyReorgDepth:]
+	}
+
+	// Blocks that aren't relevant to the account don't need to be
+	// persisted, as they'd just be scanned again after a restart.
+	if len(updated) != 0 || checkpoint {
+		utxos := make([]*channeldb.WatchOnlyUtxo, 0, len(updated))
+		for _, utxo := range updated {
+			utxos = append(utxos, utxo)
+		}
+		err := l.Cfg.Database.AddWatchOnlyBlock(info, utxos, txns)
+		if err != nil {
+			return err
+		}
+	}
+
+	account.info = info
+	for op, utxo := range updated {
+		if utxo.SpentHeight != 0 {
+			delete(account.utxos, op)
+			continue
+		}
+		account.utxos[op] = utxo
+	}
+
+	if len(txns) != 0 {
+		walletLog.Infof("Found %v transactions of watch-only account "+
+			"%v in block %v", len(txns), info.Name, height)
+	}
+
+	return nil
+}
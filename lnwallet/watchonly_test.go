@@ -0,0 +1,379 @@
+package lnwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// mockWatchOnlyChain is a BlockChainIO backed by an in-memory chain of blocks.
+type mockWatchOnlyChain struct {
+	blocks []*wire.MsgBlock
+
+	// numCreated is the number of blocks ever created, which is used to
+	// make the hashes of blocks that replace each other unique.
+	numCreated uint32
+}
+
+// addBlock appends a block with the passed transactions to the chain.
+func (m *mockWatchOnlyChain) addBlock(txns ...*wire.MsgTx) {
+	height := uint32(len(m.blocks))
+
+	// Every block gets a coinbase transaction, as it would on a real
+	// chain.
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  []byte{byte(height), byte(len(txns))},
+	})
+	coinbase.AddTxOut(&wire.TxOut{Value: 1, PkScript: []byte{0x51}})
+
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Timestamp: time.Unix(int64(1500000000+height*600), 0),
+			Nonce:     m.numCreated,
+		},
+		Transactions: append([]*wire.MsgTx{coinbase}, txns...),
+	}
+	if height > 0 {
+		block.Header.PrevBlock = m.blocks[height-1].BlockHash()
+	}
+	m.blocks = append(m.blocks, block)
+	m.numCreated++
+}
+
+func (m *mockWatchOnlyChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	tip := m.blocks[len(m.blocks)-1].BlockHash()
+	return &tip, int32(len(m.blocks) - 1), nil
+}
+
+func (m *mockWatchOnlyChain) GetUtxo(op *wire.OutPoint, _ []byte,
+	_ uint32) (*wire.TxOut, error) {
+
+	return nil, nil
+}
+
+func (m *mockWatchOnlyChain) GetBlockHash(height int64) (*chainhash.Hash,
+	error) {
+
+	hash := m.blocks[height].BlockHash()
+	return &hash, nil
+}
+
+func (m *mockWatchOnlyChain) GetBlock(
+	hash *chainhash.Hash) (*wire.MsgBlock, error) {
+
+	for _, block := range m.blocks {
+		if block.BlockHash() == *hash {
+			return block, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// payTo returns a transaction spending the passed outpoint, which pays the
+// given amounts to the passed addresses.
+func payTo(t *testing.T, prevOut wire.OutPoint, addrs []btcutil.Address,
+	amts ...btcutil.Amount) *wire.MsgTx {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: prevOut})
+	for i, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create pkScript: %v", err)
+		}
+		tx.AddTxOut(&wire.TxOut{Value: int64(amts[i]), PkScript: pkScript})
+	}
+
+	return tx
+}
+
+// TestWatchOnlyAccount tests that the transactions of a watch-only account
+// are found while scanning the chain, that reorgs are handled, and that PSBTs
+// spending from the account can be created.
+func TestWatchOnlyAccount(t *testing.T) {
+	t.Parallel()
+
+	netParams := &chaincfg.RegressionNetParams
+	chain := &mockWatchOnlyChain{}
+	chain.addBlock()
+
+	tempDir, err := ioutil.TempDir("", "watchonly")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	cdb, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer cdb.Close()
+
+	wallet, err := NewLightningWallet(Config{
+		Database:  cdb,
+		ChainIO:   chain,
+		NetParams: *netParams,
+	})
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	// We'll derive the account key m/84'/1'/0' of a fresh master key.
+	master, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{0x01}, 32), netParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	path := []uint32{
+		hdkeychain.HardenedKeyStart + 84,
+		hdkeychain.HardenedKeyStart + 1,
+		hdkeychain.HardenedKeyStart + 0,
+	}
+	accountKey := master
+	for _, index := range path {
+		accountKey, err = accountKey.Child(index)
+		if err != nil {
+			t.Fatalf("unable to derive account key: %v", err)
+		}
+	}
+	accountPubKey, err := accountKey.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter account key: %v", err)
+	}
+
+	deriveAddr := func(branch, index uint32) btcutil.Address {
+		t.Helper()
+
+		key, err := accountPubKey.Child(branch)
+		if err != nil {
+			t.Fatalf("unable to derive key: %v", err)
+		}
+		key, err = key.Child(index)
+		if err != nil {
+			t.Fatalf("unable to derive key: %v", err)
+		}
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			t.Fatalf("unable to derive key: %v", err)
+		}
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()), netParams,
+		)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		return addr
+	}
+
+	// Private keys and keys of other networks can't be imported.
+	_, err = wallet.ImportWatchOnlyAccount(
+		"cold", accountKey.String(), WitnessPubKey, 0, nil, 0,
+	)
+	if err != ErrWatchOnlyPrivateKey {
+		t.Fatalf("expected ErrWatchOnlyPrivateKey, got %v", err)
+	}
+	mainnetKey, _ := hdkeychain.NewKeyFromString(accountPubKey.String())
+	mainnetKey.SetNet(&chaincfg.MainNetParams)
+	_, err = wallet.ImportWatchOnlyAccount(
+		"cold", mainnetKey.String(), WitnessPubKey, 0, nil, 0,
+	)
+	if err != ErrWatchOnlyWrongNet {
+		t.Fatalf("expected ErrWatchOnlyWrongNet, got %v", err)
+	}
+
+	const fingerprint = 0x01020304
+	_, err = wallet.ImportWatchOnlyAccount(
+		"cold", accountPubKey.String(), WitnessPubKey, fingerprint,
+		path, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to import account: %v", err)
+	}
+	_, err = wallet.ImportWatchOnlyAccount(
+		"cold", accountPubKey.String(), WitnessPubKey, fingerprint,
+		path, 1,
+	)
+	if err != channeldb.ErrWatchOnlyAccountExists {
+		t.Fatalf("expected ErrWatchOnlyAccountExists, got %v", err)
+	}
+
+	addr, err := wallet.NewWatchOnlyAddress("cold", false)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	if addr.String() != deriveAddr(0, 0).String() {
+		t.Fatalf("expected address %v, got %v", deriveAddr(0, 0), addr)
+	}
+
+	// The first block pays to the address we just handed out. The second
+	// pays to an address beyond it, but within the gap limit. Once that
+	// is seen, an address even further ahead is watched, which receives
+	// the change of a transaction spending the first output in the third
+	// block.
+	fundingTx := payTo(
+		t, wire.OutPoint{Index: 1}, []btcutil.Address{deriveAddr(0, 0)},
+		100000,
+	)
+	chain.addBlock(fundingTx)
+	chain.addBlock(payTo(
+		t, wire.OutPoint{Index: 2}, []btcutil.Address{deriveAddr(0, 15)},
+		20000,
+	))
+	spendTx := payTo(
+		t, wire.OutPoint{Hash: fundingTx.TxHash()},
+		[]btcutil.Address{deriveAddr(0, 30), deriveAddr(1, 0)},
+		30000, 60000,
+	)
+	chain.addBlock(spendTx)
+
+	if err := wallet.syncWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to sync accounts: %v", err)
+	}
+
+	assertBalance := func(expected btcutil.Amount) {
+		t.Helper()
+
+		balance, err := wallet.WatchOnlyAccountBalance("cold")
+		if err != nil {
+			t.Fatalf("unable to fetch balance: %v", err)
+		}
+		if balance != expected {
+			t.Fatalf("expected balance %v, got %v", expected,
+				balance)
+		}
+	}
+	assertBalance(110000)
+
+	accounts := wallet.WatchOnlyAccounts()
+	if len(accounts) != 1 || accounts[0].SyncedHeight != 3 ||
+		accounts[0].NextExternalIndex != 31 ||
+		accounts[0].NextInternalIndex != 1 {
+
+		t.Fatalf("unexpected accounts: %v", accounts)
+	}
+
+	details, err := wallet.ListWatchOnlyTransactionDetails(0, -1)
+	if err != nil {
+		t.Fatalf("unable to list transactions: %v", err)
+	}
+	if len(details) != 3 {
+		t.Fatalf("expected 3 transactions, got %v", len(details))
+	}
+	for _, detail := range details {
+		if detail.Hash != spendTx.TxHash() {
+			continue
+		}
+		if detail.Value != -10000 || detail.TotalFees != 10000 ||
+			detail.NumConfirmations != 1 || detail.Account != "cold" {
+
+			t.Fatalf("unexpected spend details: %v", detail)
+		}
+	}
+
+	// Now we'll replace the last block, which should undo the spend.
+	chain.blocks = chain.blocks[:3]
+	chain.addBlock()
+	chain.addBlock()
+	if err := wallet.syncWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to sync accounts: %v", err)
+	}
+	assertBalance(120000)
+
+	details, err = wallet.ListWatchOnlyTransactionDetails(0, -1)
+	if err != nil {
+		t.Fatalf("unable to list transactions: %v", err)
+	}
+	if len(details) != 2 {
+		t.Fatalf("expected 2 transactions, got %v", len(details))
+	}
+
+	// The account's state should be restored after a restart.
+	restarted, err := NewLightningWallet(wallet.Cfg)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	if err := restarted.loadWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to load accounts: %v", err)
+	}
+	balance, err := restarted.WatchOnlyAccountBalance("cold")
+	if err != nil {
+		t.Fatalf("unable to fetch balance: %v", err)
+	}
+	if balance != 120000 {
+		t.Fatalf("expected balance 120000, got %v", balance)
+	}
+
+	// Finally, we'll fund a PSBT which requires both outputs.
+	pkScript, err := txscript.PayToAddrScript(deriveAddr(0, 40))
+	if err != nil {
+		t.Fatalf("unable to create pkScript: %v", err)
+	}
+	psbt, changeIndex, err := wallet.FundWatchOnlyPsbt(
+		"cold", []*wire.TxOut{{Value: 110000, PkScript: pkScript}},
+		1000, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to fund psbt: %v", err)
+	}
+	if changeIndex == -1 {
+		t.Fatalf("expected change output")
+	}
+
+	r := bytes.NewReader(psbt[len(psbtMagic):])
+	globals, err := readPsbtMap(r)
+	if err != nil {
+		t.Fatalf("unable to read globals: %v", err)
+	}
+	tx := wire.NewMsgTx(2)
+	err = tx.DeserializeNoWitness(bytes.NewReader(globals[0].value))
+	if err != nil {
+		t.Fatalf("unable to parse unsigned tx: %v", err)
+	}
+	if len(tx.TxIn) != 2 || len(tx.TxOut) != 2 {
+		t.Fatalf("expected 2 inputs and outputs, got %v and %v",
+			len(tx.TxIn), len(tx.TxOut))
+	}
+	for i := range tx.TxIn {
+		pairs, err := readPsbtMap(r)
+		if err != nil {
+			t.Fatalf("unable to read input: %v", err)
+		}
+
+		var derivation []byte
+		for _, kv := range pairs {
+			if kv.key[0] == psbtInBip32Derivation {
+				derivation = kv.value
+			}
+		}
+		if len(derivation) != 4+4*5 ||
+			binary.BigEndian.Uint32(derivation) != fingerprint ||
+			binary.LittleEndian.Uint32(derivation[4:]) != path[0] {
+
+			t.Fatalf("unexpected derivation of input %v: %x", i,
+				derivation)
+		}
+	}
+
+	// The change should go to the next internal address.
+	changeScript, err := txscript.PayToAddrScript(deriveAddr(1, 1))
+	if err != nil {
+		t.Fatalf("unable to create pkScript: %v", err)
+	}
+	if !bytes.Equal(tx.TxOut[changeIndex].PkScript, changeScript) {
+		t.Fatalf("change doesn't pay to next internal address")
+	}
+}
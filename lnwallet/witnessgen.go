@@ -69,6 +69,11 @@ const (
 	// broadcast a revoked commitment, but then also immediately attempt to
 	// go to the second level to claim the HTLC.
 	HtlcSecondLevelRevoke WitnessType = 9
+
+	// WitnessKeyHash is a witness type that allows us to spend a regular
+	// p2wkh output that's sent to an output which is under complete
+	// control of the backing wallet.
+	WitnessKeyHash WitnessType = 10
 )
 
 // WitnessGenerator represents a function which is able to generate the final
@@ -120,6 +125,14 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 		case HtlcSecondLevelRevoke:
 			return htlcSpendRevoke(signer, desc, tx)
 
+		case WitnessKeyHash:
+			inputScript, err := signer.ComputeInputScript(tx, desc)
+			if err != nil {
+				return nil, err
+			}
+
+			return inputScript.Witness, nil
+
 		default:
 			return nil, fmt.Errorf("unknown witness type: %v", wt)
 		}
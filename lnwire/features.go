@@ -46,6 +46,45 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// LargeChannelsRequired is a global feature bit that indicates that
+	// the sending node requires the other party to support channels with a
+	// capacity above the 2^24 satoshi limit defined in BOLT-0002.
+	LargeChannelsRequired FeatureBit = 18
+
+	// LargeChannelsOptional is an optional global feature bit that
+	// signals that the sending node is willing to open and accept channels
+	// with a capacity above the 2^24 satoshi limit defined in BOLT-0002.
+	LargeChannelsOptional FeatureBit = 19
+
+	// DualFundingRequired is a feature bit that indicates that the sending
+	// peer requires the other party to support dual-funded channels, in
+	// which both parties contribute inputs to the funding transaction.
+	DualFundingRequired FeatureBit = 28
+
+	// DualFundingOptional is an optional feature bit that signals that
+	// the sending peer is willing to open dual-funded channels, in which
+	// both parties contribute inputs to the funding transaction.
+	DualFundingOptional FeatureBit = 29
+
+	// SplicingRequired is a feature bit that indicates that the sending
+	// peer requires the other party to support splicing, which allows
+	// funds to be added to or removed from a channel without closing it.
+	SplicingRequired FeatureBit = 30
+
+	// SplicingOptional is an optional feature bit that signals that the
+	// sending peer is able to splice funds into and out of its channels.
+	SplicingOptional FeatureBit = 31
+
+	// CoopCloseRBFRequired is a feature bit that indicates that the
+	// sending peer requires cooperative close transactions to signal
+	// replaceability, such that their fee can be re-negotiated.
+	CoopCloseRBFRequired FeatureBit = 32
+
+	// CoopCloseRBFOptional is an optional feature bit that signals that
+	// the sending peer is able to re-negotiate the fee of replaceable
+	// cooperative close transactions.
+	CoopCloseRBFOptional FeatureBit = 33
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -72,13 +111,22 @@ var LocalFeatures = map[FeatureBit]string{
 	InitialRoutingSync:      "initial-routing-sync",
 	GossipQueriesRequired:   "gossip-queries-required",
 	GossipQueriesOptional:   "gossip-queries-optional",
+	DualFundingRequired:     "dual-funding-required",
+	DualFundingOptional:     "dual-funding-optional",
+	SplicingRequired:        "splicing-required",
+	SplicingOptional:        "splicing-optional",
+	CoopCloseRBFRequired:    "coop-close-rbf-required",
+	CoopCloseRBFOptional:    "coop-close-rbf-optional",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
 // name. All known global feature bits must be assigned a name in this mapping.
 // Global features are those which are advertised to the entire network. A full
 // description of these feature bits is provided in the BOLT-09 specification.
-var GlobalFeatures map[FeatureBit]string
+var GlobalFeatures = map[FeatureBit]string{
+	LargeChannelsRequired: "large-channels-required",
+	LargeChannelsOptional: "large-channels-optional",
+}
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
 // RawFeatureVector itself just stores a set of bit flags but can be used to
@@ -0,0 +1,114 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// FundingInput is an input that one of the parties of a dual-funded channel
+// contributes to the funding transaction. Along with the outpoint, the value
+// and script of the output being spent are included, which allows the remote
+// party to verify the input without fetching the transaction that created it.
+type FundingInput struct {
+	// OutPoint is the output being spent by the input.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the output being spent.
+	Value btcutil.Amount
+
+	// PkScript is the script of the output being spent. Only native
+	// segwit outputs are allowed to be spent by a funding transaction, so
+	// the script is at most 34 bytes.
+	PkScript PkScript
+
+	// Height is the height of the block the output being spent was
+	// confirmed in. Light clients need it as a hint to find the output, so
+	// an input with a wrong height may be rejected.
+	Height uint32
+}
+
+// FundingOutput is a change output that one of the parties of a dual-funded
+// channel adds to the funding transaction.
+type FundingOutput struct {
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// PkScript is the script the output pays to.
+	PkScript PkScript
+}
+
+// FundingContribution is sent by both parties of a dual-funded channel, and
+// carries the inputs and change outputs they add to the funding transaction.
+// The responder sends its contribution right before the AcceptChannel
+// message, which allows the initiator to build the complete funding
+// transaction. The initiator then sends its own contribution right before
+// FundingCreated, so the responder is able to build the very same funding
+// transaction and sign its inputs.
+type FundingContribution struct {
+	// PendingChannelID is the pending channel ID of the channel the
+	// contribution belongs to.
+	PendingChannelID [32]byte
+
+	// FundingAmount is the amount the sender adds to the capacity of the
+	// channel.
+	FundingAmount btcutil.Amount
+
+	// Inputs are the inputs the sender adds to the funding transaction.
+	// Their total value must cover the funding amount, the change outputs
+	// and the fees for the sender's part of the transaction.
+	Inputs []FundingInput
+
+	// ChangeOutputs are the change outputs the sender adds to the
+	// funding transaction.
+	ChangeOutputs []FundingOutput
+}
+
+// A compile time check to ensure FundingContribution implements the
+// lnwire.Message interface.
+var _ Message = (*FundingContribution)(nil)
+
+// Encode serializes the target FundingContribution into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		f.PendingChannelID[:],
+		f.FundingAmount,
+		f.Inputs,
+		f.ChangeOutputs,
+	)
+}
+
+// Decode deserializes the serialized FundingContribution stored in the passed
+// io.Reader into the target FundingContribution using the deserialization
+// rules defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		f.PendingChannelID[:],
+		&f.FundingAmount,
+		&f.Inputs,
+		&f.ChangeOutputs,
+	)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a FundingContribution on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) MsgType() MessageType {
+	return MsgFundingContribution
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// FundingContribution message.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingContribution) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
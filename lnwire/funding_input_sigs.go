@@ -0,0 +1,59 @@
+package lnwire
+
+import "io"
+
+// InputWitness is the witness stack of a single input of a funding
+// transaction.
+type InputWitness [][]byte
+
+// FundingInputSigs is sent by the responder of a dual-funded channel right
+// before the FundingSigned message. It carries the witnesses for all inputs
+// the responder contributed to the funding transaction, which allows the
+// initiator to broadcast the fully signed transaction.
+type FundingInputSigs struct {
+	// ChanID is the permanent channel ID of the channel the funding
+	// transaction belongs to.
+	ChanID ChannelID
+
+	// Witnesses are the witnesses of the responder's inputs, in the order
+	// the inputs appear within the sorted funding transaction.
+	Witnesses []InputWitness
+}
+
+// A compile time check to ensure FundingInputSigs implements the
+// lnwire.Message interface.
+var _ Message = (*FundingInputSigs)(nil)
+
+// Encode serializes the target FundingInputSigs into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, f.ChanID, f.Witnesses)
+}
+
+// Decode deserializes the serialized FundingInputSigs stored in the passed
+// io.Reader into the target FundingInputSigs using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &f.ChanID, &f.Witnesses)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a FundingInputSigs on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) MsgType() MessageType {
+	return MsgFundingInputSigs
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// FundingInputSigs message.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingInputSigs) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
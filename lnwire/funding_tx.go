@@ -0,0 +1,67 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// FundingTx is sent by the initiator of a zero-conf channel right before its
+// FundingLocked message. It carries the full funding transaction, which allows
+// the responder to watch the inputs of the transaction for a double spend
+// while the channel is used before the transaction confirms.
+type FundingTx struct {
+	// ChanID is the permanent channel ID of the channel the funding
+	// transaction belongs to.
+	ChanID ChannelID
+
+	// Tx is the fully signed funding transaction of the channel.
+	Tx *wire.MsgTx
+}
+
+// A compile time check to ensure FundingTx implements the lnwire.Message
+// interface.
+var _ Message = (*FundingTx)(nil)
+
+// Encode serializes the target FundingTx into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingTx) Encode(w io.Writer, pver uint32) error {
+	if err := writeElement(w, f.ChanID); err != nil {
+		return err
+	}
+
+	return f.Tx.Serialize(w)
+}
+
+// Decode deserializes the serialized FundingTx stored in the passed io.Reader
+// into the target FundingTx using the deserialization rules defined by the
+// passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingTx) Decode(r io.Reader, pver uint32) error {
+	if err := readElement(r, &f.ChanID); err != nil {
+		return err
+	}
+
+	f.Tx = &wire.MsgTx{}
+	return f.Tx.Deserialize(r)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a FundingTx on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingTx) MsgType() MessageType {
+	return MsgFundingTx
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a FundingTx
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (f *FundingTx) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -395,6 +395,58 @@ func writeElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case []FundingInput:
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(e)))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		for _, input := range e {
+			err := writeElements(
+				w, input.OutPoint, input.Value, input.PkScript,
+				input.Height,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+	case []FundingOutput:
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(e)))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		for _, output := range e {
+			err := writeElements(w, output.Value, output.PkScript)
+			if err != nil {
+				return err
+			}
+		}
+
+	case []InputWitness:
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(e)))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		for _, witness := range e {
+			binary.BigEndian.PutUint16(l[:], uint16(len(witness)))
+			if _, err := w.Write(l[:]); err != nil {
+				return err
+			}
+
+			for _, item := range witness {
+				err := wire.WriteVarBytes(w, 0, item)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 	case DeliveryAddress:
 		var length [2]byte
 		binary.BigEndian.PutUint16(length[:], uint16(len(e)))
@@ -774,6 +826,82 @@ func readElement(r io.Reader, element interface{}) error {
 		if err != nil {
 			return err
 		}
+	case *[]FundingInput:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return err
+		}
+		numInputs := binary.BigEndian.Uint16(l[:])
+
+		var inputs []FundingInput
+		if numInputs > 0 {
+			inputs = make([]FundingInput, numInputs)
+			for i := range inputs {
+				err := readElements(
+					r, &inputs[i].OutPoint, &inputs[i].Value,
+					&inputs[i].PkScript, &inputs[i].Height,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		*e = inputs
+
+	case *[]FundingOutput:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return err
+		}
+		numOutputs := binary.BigEndian.Uint16(l[:])
+
+		var outputs []FundingOutput
+		if numOutputs > 0 {
+			outputs = make([]FundingOutput, numOutputs)
+			for i := range outputs {
+				err := readElements(
+					r, &outputs[i].Value, &outputs[i].PkScript,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		*e = outputs
+
+	case *[]InputWitness:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return err
+		}
+		numWitnesses := binary.BigEndian.Uint16(l[:])
+
+		var witnesses []InputWitness
+		if numWitnesses > 0 {
+			witnesses = make([]InputWitness, numWitnesses)
+		}
+		for i := range witnesses {
+			if _, err := io.ReadFull(r, l[:]); err != nil {
+				return err
+			}
+			numItems := binary.BigEndian.Uint16(l[:])
+
+			witness := make(InputWitness, numItems)
+			for j := range witness {
+				witness[j], err = wire.ReadVarBytes(
+					r, 0, MaxMessagePayload, "witness item",
+				)
+				if err != nil {
+					return err
+				}
+			}
+			witnesses[i] = witness
+		}
+
+		*e = witnesses
+
 	case *DeliveryAddress:
 		var addrLen [2]byte
 		if _, err = io.ReadFull(r, addrLen[:]); err != nil {
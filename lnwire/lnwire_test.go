@@ -190,6 +190,29 @@ func TestEmptyMessageUnknownType(t *testing.T) {
 	}
 }
 
+// TestExperimentalMessageTypes asserts that the messages that aren't part of
+// the specification yet use odd types, such that peers that don't understand
+// them are free to ignore them.
+func TestExperimentalMessageTypes(t *testing.T) {
+	t.Parallel()
+
+	experimentalTypes := []MessageType{
+		MsgFundingContribution,
+		MsgFundingInputSigs,
+		MsgSpliceInit,
+		MsgSpliceAccept,
+		MsgSpliceSigned,
+		MsgSpliceComplete,
+		MsgFundingTx,
+	}
+	for _, msgType := range experimentalTypes {
+		if msgType%2 == 0 {
+			t.Fatalf("experimental message %v uses even type %d",
+				msgType, uint16(msgType))
+		}
+	}
+}
+
 // TestLightningWireProtocol uses the testing/quick package to create a series
 // of fuzz tests to attempt to break a primary scenario which is implemented as
 // property based testing scenario.
@@ -399,6 +422,210 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgFundingContribution: func(v []reflect.Value, r *rand.Rand) {
+			req := FundingContribution{
+				FundingAmount: btcutil.Amount(r.Int63()),
+			}
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v",
+					err)
+				return
+			}
+
+			randScript := func() PkScript {
+				script := make(PkScript, 22+r.Intn(13))
+				if _, err := r.Read(script); err != nil {
+					t.Fatalf("unable to generate script: %v",
+						err)
+				}
+				return script
+			}
+
+			numInputs := r.Intn(10)
+			for i := 0; i < numInputs; i++ {
+				input := FundingInput{
+					Value:    btcutil.Amount(r.Int63()),
+					PkScript: randScript(),
+					Height:   r.Uint32(),
+				}
+				input.OutPoint.Index = uint32(r.Int31n(
+					math.MaxUint16 + 1,
+				))
+				_, err := r.Read(input.OutPoint.Hash[:])
+				if err != nil {
+					t.Fatalf("unable to generate hash: %v",
+						err)
+					return
+				}
+
+				req.Inputs = append(req.Inputs, input)
+			}
+
+			numOutputs := r.Intn(3)
+			for i := 0; i < numOutputs; i++ {
+				req.ChangeOutputs = append(
+					req.ChangeOutputs, FundingOutput{
+						Value:    btcutil.Amount(r.Int63()),
+						PkScript: randScript(),
+					},
+				)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgFundingInputSigs: func(v []reflect.Value, r *rand.Rand) {
+			var req FundingInputSigs
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			numWitnesses := r.Intn(10)
+			for i := 0; i < numWitnesses; i++ {
+				witness := make(InputWitness, 1+r.Intn(4))
+				for j := range witness {
+					witness[j] = make([]byte, 1+r.Intn(100))
+					_, err := r.Read(witness[j])
+					if err != nil {
+						t.Fatalf("unable to generate "+
+							"witness: %v", err)
+						return
+					}
+				}
+
+				req.Witnesses = append(req.Witnesses, witness)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceInit: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceInit{
+				Capacity: btcutil.Amount(r.Int63()),
+			}
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			randScript := func() PkScript {
+				script := make(PkScript, 22+r.Intn(13))
+				if _, err := r.Read(script); err != nil {
+					t.Fatalf("unable to generate script: %v",
+						err)
+				}
+				return script
+			}
+
+			numInputs := r.Intn(10)
+			for i := 0; i < numInputs; i++ {
+				input := FundingInput{
+					Value:    btcutil.Amount(r.Int63()),
+					PkScript: randScript(),
+					Height:   r.Uint32(),
+				}
+				input.OutPoint.Index = uint32(r.Int31n(
+					math.MaxUint16 + 1,
+				))
+				_, err := r.Read(input.OutPoint.Hash[:])
+				if err != nil {
+					t.Fatalf("unable to generate hash: %v",
+						err)
+					return
+				}
+
+				req.Inputs = append(req.Inputs, input)
+			}
+
+			numOutputs := r.Intn(3)
+			for i := 0; i < numOutputs; i++ {
+				req.Outputs = append(req.Outputs, FundingOutput{
+					Value:    btcutil.Amount(r.Int63()),
+					PkScript: randScript(),
+				})
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceSigned: func(v []reflect.Value, r *rand.Rand) {
+			var req SpliceSigned
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			var err error
+			req.CommitSig, err = NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+			req.FundingSig, err = NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+
+			numWitnesses := r.Intn(10)
+			for i := 0; i < numWitnesses; i++ {
+				witness := make(InputWitness, 1+r.Intn(4))
+				for j := range witness {
+					witness[j] = make([]byte, 1+r.Intn(100))
+					_, err := r.Read(witness[j])
+					if err != nil {
+						t.Fatalf("unable to generate "+
+							"witness: %v", err)
+						return
+					}
+				}
+
+				req.Witnesses = append(req.Witnesses, witness)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgFundingTx: func(v []reflect.Value, r *rand.Rand) {
+			var req FundingTx
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			randBytes := func() []byte {
+				b := make([]byte, 1+r.Intn(100))
+				if _, err := r.Read(b); err != nil {
+					t.Fatalf("unable to generate bytes: %v",
+						err)
+				}
+				return b
+			}
+
+			req.Tx = wire.NewMsgTx(2)
+			numInputs := 1 + r.Intn(5)
+			for i := 0; i < numInputs; i++ {
+				txIn := &wire.TxIn{
+					SignatureScript: randBytes(),
+					Sequence:        r.Uint32(),
+				}
+				_, err := r.Read(txIn.PreviousOutPoint.Hash[:])
+				if err != nil {
+					t.Fatalf("unable to generate hash: %v",
+						err)
+					return
+				}
+				txIn.PreviousOutPoint.Index = r.Uint32()
+				req.Tx.AddTxIn(txIn)
+			}
+
+			numOutputs := 1 + r.Intn(5)
+			for i := 0; i < numOutputs; i++ {
+				req.Tx.AddTxOut(&wire.TxOut{
+					Value:    r.Int63(),
+					PkScript: randBytes(),
+				})
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgFundingLocked: func(v []reflect.Value, r *rand.Rand) {
 
 			var c [32]byte
@@ -808,6 +1035,48 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgFundingContribution,
+			scenario: func(m FundingContribution) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgFundingInputSigs,
+			scenario: func(m FundingInputSigs) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAccept,
+			scenario: func(m SpliceAccept) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceSigned,
+			scenario: func(m SpliceSigned) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceComplete,
+			scenario: func(m SpliceComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgFundingTx,
+			scenario: func(m FundingTx) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgFundingLocked,
 			scenario: func(m FundingLocked) bool {
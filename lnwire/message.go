@@ -54,6 +54,17 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+
+	// The messages below aren't part of the specification yet. They use
+	// odd types from the experimental range, such that peers that don't
+	// understand them are free to ignore them.
+	MsgFundingContribution = 32769
+	MsgFundingInputSigs    = 32771
+	MsgSpliceInit          = 32773
+	MsgSpliceAccept        = 32775
+	MsgSpliceSigned        = 32777
+	MsgSpliceComplete      = 32779
+	MsgFundingTx           = 32781
 )
 
 // String return the string representation of message type.
@@ -75,6 +86,20 @@ func (t MessageType) String() string {
 		return "Shutdown"
 	case MsgClosingSigned:
 		return "ClosingSigned"
+	case MsgFundingContribution:
+		return "FundingContribution"
+	case MsgFundingInputSigs:
+		return "FundingInputSigs"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAccept:
+		return "SpliceAccept"
+	case MsgSpliceSigned:
+		return "SpliceSigned"
+	case MsgSpliceComplete:
+		return "SpliceComplete"
+	case MsgFundingTx:
+		return "FundingTx"
 	case MsgUpdateAddHTLC:
 		return "UpdateAddHTLC"
 	case MsgUpdateFailHTLC:
@@ -176,6 +201,20 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &Shutdown{}
 	case MsgClosingSigned:
 		msg = &ClosingSigned{}
+	case MsgFundingContribution:
+		msg = &FundingContribution{}
+	case MsgFundingInputSigs:
+		msg = &FundingInputSigs{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAccept:
+		msg = &SpliceAccept{}
+	case MsgSpliceSigned:
+		msg = &SpliceSigned{}
+	case MsgSpliceComplete:
+		msg = &SpliceComplete{}
+	case MsgFundingTx:
+		msg = &FundingTx{}
 	case MsgUpdateAddHTLC:
 		msg = &UpdateAddHTLC{}
 	case MsgUpdateFailHTLC:
@@ -17,6 +17,12 @@ const (
 	// initiator of a funding flow wishes to announce the channel to the
 	// greater network.
 	FFAnnounceChannel FundingFlag = 1 << iota
+
+	// FFDualFund is a FundingFlag that when set, indicates the initiator
+	// of a funding flow is willing to let the responder contribute funds
+	// to the channel. It must only be set if both peers signaled support
+	// for dual-funded channels.
+	FFDualFund
 )
 
 // OpenChannel is the message Alice sends to Bob if we should like to create a
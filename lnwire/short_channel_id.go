@@ -2,6 +2,20 @@ package lnwire
 
 import (
 	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// AliasStartHeight is the first block height used by alias short
+	// channel IDs. As this height is hundreds of years away, a short
+	// channel ID at or above it can't refer to a confirmed funding
+	// transaction.
+	AliasStartHeight = 16000000
+
+	// aliasHeightRange is the number of block heights available to alias
+	// short channel IDs, as the block height is limited to 3 bytes.
+	aliasHeightRange = (1 << 24) - AliasStartHeight
 )
 
 // ShortChannelID represents the set of data which is needed to retrieve all
@@ -34,6 +48,29 @@ func NewShortChanIDFromInt(chanID uint64) ShortChannelID {
 	}
 }
 
+// NewAliasShortChanID returns the alias short channel ID of the channel with
+// the passed funding outpoint. An alias is used to route over a channel whose
+// funding transaction hasn't confirmed yet. It's derived from the funding
+// outpoint alone, so that both parties of a channel agree on it without
+// having to exchange it.
+func NewAliasShortChanID(op wire.OutPoint) ShortChannelID {
+	txid := op.Hash[:]
+	height := uint32(txid[0])<<16 | uint32(txid[1])<<8 | uint32(txid[2])
+	txIndex := uint32(txid[3])<<16 | uint32(txid[4])<<8 | uint32(txid[5])
+
+	return ShortChannelID{
+		BlockHeight: AliasStartHeight + height%aliasHeightRange,
+		TxIndex:     txIndex,
+		TxPosition:  uint16(op.Index),
+	}
+}
+
+// IsAlias returns true if the short channel ID is an alias, rather than the
+// location of a confirmed funding output.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasStartHeight
+}
+
 // ToUint64 converts the ShortChannelID into a compact format encoded within a
 // uint64 (8 bytes).
 func (c ShortChannelID) ToUint64() uint64 {
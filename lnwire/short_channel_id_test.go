@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -39,3 +40,33 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestAliasShortChanID tests that alias short channel IDs are deterministic,
+// recognized as aliases, and survive the compact encoding.
+func TestAliasShortChanID(t *testing.T) {
+	t.Parallel()
+
+	op := wire.OutPoint{Index: 3}
+	for i := range op.Hash {
+		op.Hash[i] = 0xff
+	}
+
+	alias := NewAliasShortChanID(op)
+	if !alias.IsAlias() {
+		t.Fatalf("expected %v to be an alias", alias)
+	}
+	if alias != NewAliasShortChanID(op) {
+		t.Fatalf("alias of the same outpoint should be identical")
+	}
+	if alias.TxPosition != 3 {
+		t.Fatalf("expected tx position 3, got %v", alias.TxPosition)
+	}
+	if NewShortChanIDFromInt(alias.ToUint64()) != alias {
+		t.Fatalf("alias %v doesn't survive encoding", alias)
+	}
+
+	confirmed := ShortChannelID{BlockHeight: 550000, TxIndex: 1}
+	if confirmed.IsAlias() {
+		t.Fatalf("%v shouldn't be an alias", confirmed)
+	}
+}
@@ -0,0 +1,55 @@
+package lnwire
+
+import "io"
+
+// SpliceAccept is sent by the responder of a splice in reply to a SpliceInit
+// message it agrees with. It carries the responder's signature for the
+// initiator's version of the commitment transaction spending the new funding
+// output.
+type SpliceAccept struct {
+	// ChanID is the channel ID of the channel being spliced.
+	ChanID ChannelID
+
+	// CommitSig is the responder's signature for the initiator's version
+	// of the new commitment transaction.
+	CommitSig Sig
+}
+
+// A compile time check to ensure SpliceAccept implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAccept)(nil)
+
+// Encode serializes the target SpliceAccept into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAccept) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.CommitSig)
+}
+
+// Decode deserializes the serialized SpliceAccept stored in the passed
+// io.Reader into the target SpliceAccept using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAccept) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, &s.CommitSig)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceAccept on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAccept) MsgType() MessageType {
+	return MsgSpliceAccept
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceAccept message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAccept) MaxPayloadLength(uint32) uint32 {
+	// 32 + 64
+	return 96
+}
@@ -0,0 +1,72 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// SpliceInit is sent by the party that wishes to splice funds into or out of
+// an existing channel. It describes the splice transaction, which spends the
+// current funding output along with the inputs below, and creates a new
+// funding output of the given capacity along with the outputs below. The
+// difference between the new and the current capacity is added to, or taken
+// from, the balance of the sender, which also pays the fee of the splice
+// transaction.
+type SpliceInit struct {
+	// ChanID is the channel ID of the channel to be spliced.
+	ChanID ChannelID
+
+	// Capacity is the capacity of the channel once the splice transaction
+	// confirms, which is the value of the new funding output.
+	Capacity btcutil.Amount
+
+	// Inputs are the inputs the sender adds to the splice transaction in
+	// order to splice funds into the channel. Only native segwit outputs
+	// may be spent, to ensure the txid of the splice transaction can't be
+	// malleated.
+	Inputs []FundingInput
+
+	// Outputs are the outputs the sender adds to the splice transaction,
+	// which either carry its change, or the funds spliced out of the
+	// channel.
+	Outputs []FundingOutput
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.Capacity, s.Inputs, s.Outputs)
+}
+
+// Decode deserializes the serialized SpliceInit stored in the passed io.Reader
+// into the target SpliceInit using the deserialization rules defined by the
+// passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, &s.Capacity, &s.Inputs, &s.Outputs)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceInit message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
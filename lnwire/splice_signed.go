@@ -0,0 +1,66 @@
+package lnwire
+
+import "io"
+
+// SpliceSigned is sent by the initiator of a splice once it has received a
+// valid SpliceAccept message. Along with the signature for the responder's
+// version of the new commitment transaction, it carries all the signatures
+// the initiator contributes to the splice transaction. This allows the
+// responder to complete and broadcast the splice transaction after adding its
+// own signature for the current funding output.
+type SpliceSigned struct {
+	// ChanID is the channel ID of the channel being spliced.
+	ChanID ChannelID
+
+	// CommitSig is the initiator's signature for the responder's version
+	// of the new commitment transaction.
+	CommitSig Sig
+
+	// FundingSig is the initiator's signature for the input of the splice
+	// transaction that spends the current funding output.
+	FundingSig Sig
+
+	// Witnesses are the witnesses for the initiator's inputs, in the order
+	// the inputs appear within the sorted splice transaction.
+	Witnesses []InputWitness
+}
+
+// A compile time check to ensure SpliceSigned implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceSigned)(nil)
+
+// Encode serializes the target SpliceSigned into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.CommitSig, s.FundingSig,
+		s.Witnesses)
+}
+
+// Decode deserializes the serialized SpliceSigned stored in the passed
+// io.Reader into the target SpliceSigned using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, &s.CommitSig, &s.FundingSig,
+		&s.Witnesses)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceSigned on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MsgType() MessageType {
+	return MsgSpliceSigned
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceSigned message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -24,6 +25,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/lightningnetwork/lnd/watchtower"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
 )
 
 // Loggers per subsystem.  A single backend logger is created and all subsystem
@@ -65,6 +69,10 @@ var (
 	atplLog = build.NewSubLogger("ATPL", backendLog.Logger)
 	cnctLog = build.NewSubLogger("CNCT", backendLog.Logger)
 	sphxLog = build.NewSubLogger("SPHX", backendLog.Logger)
+	wtwrLog = build.NewSubLogger("WTWR", backendLog.Logger)
+	wtclLog = build.NewSubLogger("WTCL", backendLog.Logger)
+	chbuLog = build.NewSubLogger("CHBU", backendLog.Logger)
+	swprLog = build.NewSubLogger("SWPR", backendLog.Logger)
 )
 
 // Initialize package-global logger variables.
@@ -80,6 +88,10 @@ func init() {
 	autopilot.UseLogger(atplLog)
 	contractcourt.UseLogger(cnctLog)
 	sphinx.UseLogger(sphxLog)
+	watchtower.UseLogger(wtwrLog)
+	wtclient.UseLogger(wtclLog)
+	chanbackup.UseLogger(chbuLog)
+	sweep.UseLogger(swprLog)
 	signal.UseLogger(ltndLog)
 }
 
@@ -103,6 +115,10 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"ATPL": atplLog,
 	"CNCT": cnctLog,
 	"SPHX": sphxLog,
+	"WTWR": wtwrLog,
+	"WTCL": wtclLog,
+	"CHBU": chbuLog,
+	"SWPR": swprLog,
 }
 
 // initLogRotator initializes the logging rotator to write logs to logFile and
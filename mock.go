@@ -249,7 +249,9 @@ func (m *mockWalletController) ListUnspentWitness(confirms int32) ([]*lnwallet.U
 	ret = append(ret, utxo)
 	return ret, nil
 }
-func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
+func (*mockWalletController) ListTransactionDetails(_, _ int32) (
+	[]*lnwallet.TransactionDetail, error) {
+
 	return nil, nil
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
@@ -293,7 +295,12 @@ func (m *mockSecretKeyRing) DerivePrivKey(keyDesc keychain.KeyDescriptor) (*btce
 
 func (m *mockSecretKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
 	pubKey *btcec.PublicKey) ([]byte, error) {
-	return nil, nil
+
+	x, y := btcec.S256().ScalarMult(pubKey.X, pubKey.Y, m.rootKey.D.Bytes())
+	sharedKey := &btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}
+	h := sha256.Sum256(sharedKey.SerializeCompressed())
+
+	return h[:], nil
 }
 
 type mockPreimageCache struct {
@@ -76,6 +76,13 @@ type closeMsg struct {
 	msg lnwire.Message
 }
 
+// spliceMsg is a wrapper struct around any wire messages that deal with the
+// splice of a channel.
+type spliceMsg struct {
+	cid lnwire.ChannelID
+	msg lnwire.Message
+}
+
 // chanSnapshotReq is a message sent by outside subsystems to a peer in order
 // to gain a snapshot of the peer's currently active channels.
 type chanSnapshotReq struct {
@@ -158,6 +165,23 @@ type peer struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// activeSplices is a map that keeps track of the splices of channels
+	// that are being negotiated. Any splice related messages are directed
+	// to one of these state machines. Once the splice transaction has been
+	// broadcast, or the negotiation failed, the state machine is deleted
+	// from the map.
+	spliceMtx     sync.RWMutex
+	activeSplices map[lnwire.ChannelID]*channelSplicer
+
+	// localSpliceReqs is a channel in which any local requests to splice
+	// a particular channel are sent over.
+	localSpliceReqs chan *spliceChanReq
+
+	// spliceMsgs is a channel that any message related to the splice of a
+	// channel is sent over. This includes any lnwire.Error message sent
+	// for a channel that is being spliced.
+	spliceMsgs chan *spliceMsg
+
 	server *server
 
 	// localFeatures is the set of local features that we advertised to the
@@ -180,6 +204,13 @@ type peer struct {
 	// TODO(halseth): remove when link failure is properly handled.
 	failedChannels map[lnwire.ChannelID]struct{}
 
+	// restoredChans is the set of channels with this peer that were
+	// restored from a static channel backup. These channels can't be
+	// operated, instead we'll only use them to ask the remote party to
+	// force close, and to record the commitment point they send us so we
+	// can sweep our funds.
+	restoredChans map[lnwire.ChannelID]*channeldb.OpenChannel
+
 	// writeBuf is a buffer that we'll re-use in order to encode wire
 	// messages to write out directly on the socket. By re-using this
 	// buffer, we avoid needing to allocate more memory each time a new
@@ -223,7 +254,11 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		linkFailures:       make(chan linkFailureReport),
 		chanCloseMsgs:      make(chan *closeMsg),
+		activeSplices:      make(map[lnwire.ChannelID]*channelSplicer),
+		localSpliceReqs:    make(chan *spliceChanReq),
+		spliceMsgs:         make(chan *spliceMsg),
 		failedChannels:     make(map[lnwire.ChannelID]struct{}),
+		restoredChans:      make(map[lnwire.ChannelID]*channeldb.OpenChannel),
 
 		queueQuit: make(chan struct{}),
 		quit:      make(chan struct{}),
@@ -310,7 +345,8 @@ func (p *peer) Start() error {
 	peerLog.Debugf("Loaded %v active channels from database with "+
 		"NodeKey(%x)", len(activeChans), p.PubKey())
 
-	if err := p.loadActiveChannels(activeChans); err != nil {
+	chanSyncMsgs, err := p.loadActiveChannels(activeChans)
+	if err != nil {
 		return fmt.Errorf("unable to load channels: %v", err)
 	}
 
@@ -323,6 +359,13 @@ func (p *peer) Start() error {
 	go p.channelManager()
 	go p.pingHandler()
 
+	// Now that the write handler is active, we'll send out the channel
+	// reestablish messages for any channels restored from a backup, in
+	// order to trigger a force close from the remote party.
+	for _, msg := range chanSyncMsgs {
+		p.queueMsg(msg, nil)
+	}
+
 	return nil
 }
 
@@ -374,15 +417,46 @@ func (p *peer) QuitSignal() <-chan struct{} {
 }
 
 // loadActiveChannels creates indexes within the peer for tracking all active
-// channels returned by the database.
-func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
-	var activePublicChans []wire.OutPoint
+// channels returned by the database. Any channel reestablish messages that
+// need to be sent for channels restored from a static backup are returned.
+func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) (
+	[]lnwire.Message, error) {
+
+	var (
+		activePublicChans []wire.OutPoint
+		chanSyncMsgs      []lnwire.Message
+	)
 	for _, dbChan := range chans {
+		// If this channel was restored from a static channel backup,
+		// then we only have a shell of the channel, so we can't bring
+		// up a link for it. Instead, we'll send a channel reestablish
+		// message claiming that we're at the very first state, which
+		// will cause the remote party to force close the channel.
+		if dbChan.HasChanStatus(channeldb.Restored) {
+			chanID := lnwire.NewChanIDFromOutPoint(
+				&dbChan.FundingOutpoint,
+			)
+
+			peerLog.Infof("NodeKey(%x) found restored "+
+				"ChannelPoint(%v), requesting force close",
+				p.PubKey(), dbChan.FundingOutpoint)
+
+			p.restoredChans[chanID] = dbChan
+			chanSyncMsgs = append(chanSyncMsgs,
+				&lnwire.ChannelReestablish{
+					ChanID:                 chanID,
+					NextLocalCommitHeight:  1,
+					RemoteCommitTailHeight: 0,
+				},
+			)
+			continue
+		}
+
 		lnChan, err := lnwallet.NewLightningChannel(
 			p.server.cc.signer, p.server.witnessBeacon, dbChan,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		chanPoint := &dbChan.FundingOutpoint
@@ -413,56 +487,18 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
 		if err != nil {
 			lnChan.Stop()
-			return err
+			return nil, err
 		}
 
 		// Before we register this new link with the HTLC Switch, we'll
 		// need to fetch its current link-layer forwarding policy from
 		// the database.
-		graph := p.server.chanDB.ChannelGraph()
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
-		if err != nil && err != channeldb.ErrEdgeNotFound {
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
 			lnChan.Stop()
-			return err
+			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *channeldb.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.server.identityPriv.PubKey().SerializeCompressed()) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
-		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *htlcswitch.ForwardingPolicy
-		if selfPolicy != nil {
-			forwardingPolicy = &htlcswitch.ForwardingPolicy{
-				MinHTLC:       selfPolicy.MinHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-			}
-		} else {
-			peerLog.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.server.cc.routingPolicy
-		}
-
-		peerLog.Tracef("Using link policy of: %v",
-			spew.Sdump(forwardingPolicy))
-
 		// Register this new channel link with the HTLC Switch. This is
 		// necessary to properly route multi-hop payments, and forward
 		// new payments triggered by RPC clients.
@@ -471,7 +507,7 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		)
 		if err != nil {
 			lnChan.Stop()
-			return err
+			return nil, err
 		}
 
 		// Create the link and add it to the switch.
@@ -481,8 +517,8 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		)
 		if err != nil {
 			lnChan.Stop()
-			return fmt.Errorf("unable to add link %v to switch: %v",
-				chanPoint, err)
+			return nil, fmt.Errorf("unable to add link %v to "+
+				"switch: %v", chanPoint, err)
 		}
 
 		p.activeChanMtx.Lock()
@@ -515,7 +551,80 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		}
 	}()
 
-	return nil
+	return chanSyncMsgs, nil
+}
+
+// fetchForwardingPolicy returns the link-layer forwarding policy of the
+// channel with the passed funding outpoint, as advertised within the channel
+// graph. If we haven't advertised a policy yet, the default policy is
+// returned.
+func (p *peer) fetchForwardingPolicy(
+	chanPoint *wire.OutPoint) (*htlcswitch.ForwardingPolicy, error) {
+
+	graph := p.server.chanDB.ChannelGraph()
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel edges
+	// based whom the edge connects to. If it doesn't connect to us, then
+	// we know that we were the one that advertised the policy.
+	var selfPolicy *channeldb.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.server.identityPriv.PubKey().SerializeCompressed()) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	// If we don't yet have an advertised routing policy, then we'll use
+	// the current default, otherwise we'll translate the routing policy
+	// into a forwarding policy.
+	var forwardingPolicy *htlcswitch.ForwardingPolicy
+	if selfPolicy != nil {
+		forwardingPolicy = &htlcswitch.ForwardingPolicy{
+			MinHTLC:       selfPolicy.MinHTLC,
+			BaseFee:       selfPolicy.FeeBaseMSat,
+			FeeRate:       selfPolicy.FeeProportionalMillionths,
+			TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+		}
+	} else {
+		peerLog.Warnf("Unable to find our forwarding policy for "+
+			"channel %v, using default values", chanPoint)
+		forwardingPolicy = &p.server.cc.routingPolicy
+	}
+
+	peerLog.Tracef("Using link policy of: %v",
+		spew.Sdump(forwardingPolicy))
+
+	return forwardingPolicy, nil
+}
+
+// handleRestoredChanSync processes a channel reestablish message received for
+// a channel that was restored from a static channel backup. If the remote
+// party sent us their current unrevoked commitment point, we'll store it so
+// our funds can be swept once they force close the channel.
+func (p *peer) handleRestoredChanSync(dbChan *channeldb.OpenChannel,
+	msg *lnwire.ChannelReestablish) {
+
+	chanPoint := dbChan.FundingOutpoint
+
+	if msg.LocalUnrevokedCommitPoint == nil {
+		peerLog.Warnf("NodeKey(%x) didn't send a commitment point for "+
+			"restored ChannelPoint(%v), unable to recover funds "+
+			"if they force close", p.PubKey(), chanPoint)
+		return
+	}
+
+	peerLog.Infof("NodeKey(%x) sent commitment point for restored "+
+		"ChannelPoint(%v)", p.PubKey(), chanPoint)
+
+	if err := dbChan.MarkDataLoss(msg.LocalUnrevokedCommitPoint); err != nil {
+		peerLog.Errorf("Unable to store commitment point for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
 }
 
 // addLink creates and adds a new link from the specified channel.
@@ -573,6 +682,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		TowerClient:         p.server.towerClient,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -995,6 +1105,12 @@ out:
 			p.server.fundingMgr.processFundingCreated(msg, p)
 		case *lnwire.FundingSigned:
 			p.server.fundingMgr.processFundingSigned(msg, p)
+		case *lnwire.FundingContribution:
+			p.server.fundingMgr.processFundingContribution(msg, p)
+		case *lnwire.FundingInputSigs:
+			p.server.fundingMgr.processFundingInputSigs(msg, p)
+		case *lnwire.FundingTx:
+			p.server.fundingMgr.processFundingTx(msg, p)
 		case *lnwire.FundingLocked:
 			p.server.fundingMgr.processFundingLocked(msg, p)
 
@@ -1011,10 +1127,45 @@ out:
 				break out
 			}
 
+		case *lnwire.SpliceInit:
+			select {
+			case p.spliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceAccept:
+			select {
+			case p.spliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceSigned:
+			select {
+			case p.spliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceComplete:
+			select {
+			case p.spliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+
 		case *lnwire.Error:
 			key := p.addr.IdentityKey
 
 			switch {
+			// If the channel is being spliced, the remote party
+			// aborted the splice, so we'll hand the error to the
+			// splice state machine.
+			case p.isSplicing(msg.ChanID):
+				select {
+				case p.spliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+				case <-p.quit:
+					break out
+				}
+
 			// In the case of an all-zero channel ID we want to
 			// forward the error to all channels with this peer.
 			case msg.ChanID == lnwire.ConnectionWideID:
@@ -1068,6 +1219,14 @@ out:
 			isChanUpdate = true
 			targetChan = msg.ChanID
 		case *lnwire.ChannelReestablish:
+			// If this is a channel we restored from a backup, then
+			// there's no link to deliver the message to, so we'll
+			// handle it here.
+			if dbChan, ok := p.restoredChans[msg.ChanID]; ok {
+				p.handleRestoredChanSync(dbChan, msg)
+				break
+			}
+
 			isChanUpdate = true
 			targetChan = msg.ChanID
 
@@ -1143,6 +1302,35 @@ func messageSummary(msg lnwire.Message) string {
 	case *lnwire.FundingSigned:
 		return fmt.Sprintf("chan_id=%v", msg.ChanID)
 
+	case *lnwire.FundingContribution:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v, num_inputs=%v, "+
+			"num_change=%v", msg.PendingChannelID[:],
+			msg.FundingAmount, len(msg.Inputs),
+			len(msg.ChangeOutputs))
+
+	case *lnwire.FundingInputSigs:
+		return fmt.Sprintf("chan_id=%v, num_sigs=%v", msg.ChanID,
+			len(msg.Witnesses))
+
+	case *lnwire.SpliceInit:
+		return fmt.Sprintf("chan_id=%v, capacity=%v, num_inputs=%v, "+
+			"num_outputs=%v", msg.ChanID, msg.Capacity,
+			len(msg.Inputs), len(msg.Outputs))
+
+	case *lnwire.SpliceAccept:
+		return fmt.Sprintf("chan_id=%v", msg.ChanID)
+
+	case *lnwire.SpliceSigned:
+		return fmt.Sprintf("chan_id=%v, num_sigs=%v", msg.ChanID,
+			len(msg.Witnesses))
+
+	case *lnwire.SpliceComplete:
+		return fmt.Sprintf("chan_id=%v", msg.ChanID)
+
+	case *lnwire.FundingTx:
+		return fmt.Sprintf("chan_id=%v, txid=%v", msg.ChanID,
+			msg.Tx.TxHash())
+
 	case *lnwire.FundingLocked:
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
@@ -1656,6 +1844,17 @@ out:
 		case failure := <-p.linkFailures:
 			p.handleLinkFailure(failure)
 
+		// We've just received a local request to splice funds into or
+		// out of an active channel.
+		case req := <-p.localSpliceReqs:
+			p.handleLocalSpliceReq(req)
+
+		// We've received a new splice related message from the remote
+		// peer, which either advances the splice state machine of the
+		// channel, or kicks off a splice initiated by the remote peer.
+		case msg := <-p.spliceMsgs:
+			p.handleSpliceMsg(msg)
+
 		// We've received a new cooperative channel closure related
 		// message from the remote peer, we'll use this message to
 		// advance the chan closer state machine.
@@ -1663,7 +1862,9 @@ out:
 			// We'll now fetch the matching closing state machine
 			// in order to continue, or finalize the channel
 			// closure process.
-			chanCloser, err := p.fetchActiveChanCloser(closeMsg.cid)
+			chanCloser, err := p.fetchActiveChanCloser(
+				closeMsg.cid, closeMsg.msg,
+			)
 			if err != nil {
 				// If the channel is not known to us, we'll
 				// simply ignore this message.
@@ -1739,17 +1940,21 @@ out:
 }
 
 // fetchActiveChanCloser attempts to fetch the active chan closer state machine
-// for the target channel ID. If the channel isn't active an error is returned.
+// for the target channel ID. If the channel isn't active an error is returned,
+// unless the passed message continues or kicks off the re-negotiation of the
+// closing fee of a channel whose close transaction has already been broadcast.
 // Otherwise, either an existing state machine will be returned, or a new one
 // will be created.
-func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, error) {
+func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID,
+	msg lnwire.Message) (*channelCloser, error) {
+
 	// First, we'll ensure that we actually know of the target channel. If
 	// not, we'll ignore this message.
 	p.activeChanMtx.RLock()
 	channel, ok := p.activeChannels[chanID]
 	p.activeChanMtx.RUnlock()
 	if !ok {
-		return nil, ErrChannelNotFound
+		return p.fetchRenegotiationCloser(chanID, msg)
 	}
 
 	// We'll attempt to look up the matching state machine, if we can't
@@ -1765,51 +1970,174 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 				"channel w/ active htlcs")
 		}
 
-		// We'll create a valid closing state machine in order to
-		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
+		var err error
+		chanCloser, err = p.newResponderChanCloser(channel, nil)
 		if err != nil {
-			peerLog.Errorf("unable to gen delivery script: %v", err)
+			return nil, err
+		}
+		p.activeChanCloses[chanID] = chanCloser
+	}
+
+	return chanCloser, nil
+}
+
+// fetchRenegotiationCloser returns the chan closer state machine that handles
+// the re-negotiation of the closing fee of a channel that is no longer active,
+// as its close transaction has already been broadcast. A new state machine is
+// created if the passed message is a shutdown message with which the remote
+// party kicks off a re-negotiation.
+func (p *peer) fetchRenegotiationCloser(chanID lnwire.ChannelID,
+	msg lnwire.Message) (*channelCloser, error) {
+
+	chanCloser, ok := p.activeChanCloses[chanID]
+	if ok && chanCloser.state != closeFinished {
+		return chanCloser, nil
+	}
+
+	// Any other message than a shutdown message is most likely the remote
+	// party echoing its final ClosingSigned message, which we can ignore.
+	if _, ok := msg.(*lnwire.Shutdown); !ok {
+		return nil, ErrChannelNotFound
+	}
+
+	channel, closeInfo, err := p.fetchCoopBroadcastedChannel(chanID)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, fmt.Errorf("close addr unavailable")
+	peerLog.Infof("ChannelPoint(%v): remote party re-negotiating "+
+		"closing fee", channel.ChannelPoint())
+
+	chanCloser, err = p.newResponderChanCloser(channel, closeInfo)
+	if err != nil {
+		channel.Stop()
+		return nil, err
+	}
+	p.activeChanCloses[chanID] = chanCloser
+
+	return chanCloser, nil
+}
+
+// newResponderChanCloser creates a chan closer state machine to respond to a
+// cooperative channel closure initiated by the remote party. If the closing
+// fee is being re-negotiated, prevCloseInfo should describe the close
+// transaction that was previously broadcast.
+func (p *peer) newResponderChanCloser(channel *lnwallet.LightningChannel,
+	prevCloseInfo *channeldb.CoopCloseInfo) (*channelCloser, error) {
+
+	// We'll create a valid closing state machine in order to respond to
+	// the initiated cooperative channel closure.
+	deliveryAddr, err := p.genDeliveryScript()
+	if err != nil {
+		peerLog.Errorf("unable to gen delivery script: %v", err)
+
+		return nil, fmt.Errorf("close addr unavailable")
+	}
+
+	// In order to begin fee negotiations, we'll first compute our target
+	// ideal fee-per-kw. We'll set this to a lax value, as we weren't the
+	// ones that initiated the channel closure.
+	feePerKw, err := p.server.cc.feeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		peerLog.Errorf("unable to query fee estimator: %v", err)
+
+		return nil, fmt.Errorf("unable to estimate fee")
+	}
+
+	// When re-negotiating, the remote party wants to raise the closing
+	// fee, so we won't start below the fee rate of the previous close
+	// transaction.
+	if prevCloseInfo != nil {
+		prevFee := closeTxFee(
+			channel.StateSnapshot().Capacity, prevCloseInfo.CloseTx,
+		)
+		prevFeePerKw := lnwallet.SatPerKWeight(
+			int64(prevFee) * 1000 / lnwallet.CommitWeight,
+		)
+		if prevFeePerKw > feePerKw {
+			feePerKw = prevFeePerKw
 		}
+	}
 
-		// In order to begin fee negotiations, we'll first compute our
-		// target ideal fee-per-kw. We'll set this to a lax value, as
-		// we weren't the ones that initiated the channel closure.
-		feePerKw, err := p.server.cc.feeEstimator.EstimateFeePerKW(6)
-		if err != nil {
-			peerLog.Errorf("unable to query fee estimator: %v", err)
+	_, startingHeight, err := p.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		peerLog.Errorf("unable to obtain best block: %v", err)
+		return nil, fmt.Errorf("cannot obtain best block")
+	}
+
+	return newChannelCloser(
+		chanCloseCfg{
+			channel:           channel,
+			unregisterChannel: p.server.htlcSwitch.RemoveLink,
+			broadcastTx:       p.server.cc.wallet.PublishLabeledTransaction,
+			disableChannel: func(op wire.OutPoint) error {
+				return p.server.announceChanStatus(op, true)
+			},
+			replaceable:   p.supportsCoopCloseRBF(),
+			prevCloseInfo: prevCloseInfo,
+			quit:          p.quit,
+		},
+		deliveryAddr,
+		feePerKw,
+		uint32(startingHeight),
+		nil,
+	), nil
+}
+
+// fetchCoopBroadcastedChannel loads the channel with the given ID from the
+// database if its cooperative close transaction has been broadcast, such that
+// the closing fee can be re-negotiated. The info of the broadcast close
+// transaction is returned along with the channel.
+func (p *peer) fetchCoopBroadcastedChannel(chanID lnwire.ChannelID) (
+	*lnwallet.LightningChannel, *channeldb.CoopCloseInfo, error) {
 
-			return nil, fmt.Errorf("unable to estimate fee")
+	dbChans, err := p.server.chanDB.FetchOpenChannels(p.IdentityKey())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, dbChan := range dbChans {
+		dbChanID := lnwire.NewChanIDFromOutPoint(&dbChan.FundingOutpoint)
+		if dbChanID != chanID {
+			continue
 		}
 
-		_, startingHeight, err := p.server.cc.chainIO.GetBestBlock()
-		if err != nil {
-			peerLog.Errorf("unable to obtain best block: %v", err)
-			return nil, fmt.Errorf("cannot obtain best block")
+		closeInfo, err := dbChan.CoopCloseInfo()
+		switch {
+		case err == channeldb.ErrNoCoopCloseInfo:
+			return nil, nil, ErrChannelNotFound
+
+		case err != nil:
+			return nil, nil, err
 		}
 
-		chanCloser = newChannelCloser(
-			chanCloseCfg{
-				channel:           channel,
-				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel: func(op wire.OutPoint) error {
-					return p.server.announceChanStatus(op,
-						true)
-				},
-				quit: p.quit,
-			},
-			deliveryAddr,
-			feePerKw,
-			uint32(startingHeight),
-			nil,
+		// The previous close transaction can only be replaced if it
+		// signals replaceability.
+		if !lnwallet.IsReplaceableCoopClose(closeInfo.CloseTx) {
+			return nil, nil, fmt.Errorf("close tx of "+
+				"ChannelPoint(%v) isn't replaceable",
+				dbChan.FundingOutpoint)
+		}
+
+		// Once we've bumped the fee using CPFP, a new close
+		// transaction would invalidate our child transaction.
+		if closeInfo.CPFP {
+			return nil, nil, fmt.Errorf("fee of close tx of "+
+				"ChannelPoint(%v) is being bumped using CPFP",
+				dbChan.FundingOutpoint)
+		}
+
+		lnChan, err := lnwallet.NewLightningChannel(
+			p.server.cc.signer, p.server.witnessBeacon, dbChan,
 		)
-		p.activeChanCloses[chanID] = chanCloser
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return lnChan, closeInfo, nil
 	}
 
-	return chanCloser, nil
+	return nil, nil, ErrChannelNotFound
 }
 
 // handleLocalCloseReq kicks-off the workflow to execute a cooperative or
@@ -1820,6 +2148,27 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	p.activeChanMtx.RLock()
 	channel, ok := p.activeChannels[chanID]
 	p.activeChanMtx.RUnlock()
+
+	// If the channel isn't active, its cooperative close transaction may
+	// already have been broadcast, in which case we're asked to
+	// re-negotiate the closing fee.
+	var prevCloseInfo *channeldb.CoopCloseInfo
+	if !ok && req.CloseType == htlcswitch.CloseRegular {
+		var err error
+		channel, prevCloseInfo, err = p.fetchCoopBroadcastedChannel(
+			chanID,
+		)
+		switch {
+		case err == nil:
+			ok = true
+
+		case err != ErrChannelNotFound:
+			peerLog.Errorf("unable to re-negotiate closing fee of "+
+				"ChannelID(%v): %v", chanID, err)
+			req.Err <- err
+			return
+		}
+	}
 	if !ok {
 		err := fmt.Errorf("unable to close channel, ChannelID(%v) is "+
 			"unknown", chanID)
@@ -1857,12 +2206,14 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			chanCloseCfg{
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
+				broadcastTx:       p.server.cc.wallet.PublishLabeledTransaction,
 				disableChannel: func(op wire.OutPoint) error {
 					return p.server.announceChanStatus(op,
 						true)
 				},
-				quit: p.quit,
+				replaceable:   p.supportsCoopCloseRBF(),
+				prevCloseInfo: prevCloseInfo,
+				quit:          p.quit,
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
@@ -1904,6 +2255,300 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	}
 }
 
+// SpliceChannel requests the splice of funds into or out of the channel with
+// the peer described by the passed request.
+func (p *peer) SpliceChannel(req *spliceChanReq) {
+	select {
+	case p.localSpliceReqs <- req:
+	case <-p.quit:
+		req.err <- ErrPeerExiting
+	}
+}
+
+// supportsCoopCloseRBF returns whether both we and the remote peer signaled
+// support for replaceable cooperative close transactions.
+func (p *peer) supportsCoopCloseRBF() bool {
+	if p.localFeatures == nil || p.remoteLocalFeatures == nil ||
+		!p.localFeatures.IsSet(lnwire.CoopCloseRBFOptional) {
+
+		return false
+	}
+
+	features := p.remoteLocalFeatures
+	return features.HasFeature(lnwire.CoopCloseRBFOptional) ||
+		features.HasFeature(lnwire.CoopCloseRBFRequired)
+}
+
+// supportsSplicing returns whether both we and the remote peer signaled
+// support for splicing.
+func (p *peer) supportsSplicing() bool {
+	if !cfg.Splicing {
+		return false
+	}
+
+	features := p.remoteLocalFeatures
+	return features.HasFeature(lnwire.SplicingOptional) ||
+		features.HasFeature(lnwire.SplicingRequired)
+}
+
+// isSplicing returns whether the splice of the channel with the passed ID is
+// being negotiated.
+func (p *peer) isSplicing(chanID lnwire.ChannelID) bool {
+	p.spliceMtx.RLock()
+	defer p.spliceMtx.RUnlock()
+
+	_, ok := p.activeSplices[chanID]
+	return ok
+}
+
+// newChanSplicer creates a splice state machine for the passed channel. The
+// request should only be populated iff we're the initiator of the splice.
+func (p *peer) newChanSplicer(channel *lnwallet.LightningChannel,
+	req *spliceChanReq) (*channelSplicer, error) {
+
+	_, bestHeight, err := p.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	splicer := newChannelSplicer(
+		chanSpliceCfg{
+			channel:           channel,
+			wallet:            p.server.cc.wallet,
+			maxChanSize:       p.server.maxChanSize(p.IdentityKey()),
+			unregisterChannel: p.server.htlcSwitch.RemoveLink,
+			broadcastTx:       p.server.cc.wallet.PublishLabeledTransaction,
+			watchSplice:       p.server.fundingMgr.WatchSplice,
+		},
+		uint32(bestHeight),
+		req,
+	)
+
+	p.spliceMtx.Lock()
+	p.activeSplices[splicer.cid] = splicer
+	p.spliceMtx.Unlock()
+
+	return splicer, nil
+}
+
+// handleLocalSpliceReq kicks off the splice of a channel initiated by a local
+// subsystem.
+func (p *peer) handleLocalSpliceReq(req *spliceChanReq) {
+	chanID := lnwire.NewChanIDFromOutPoint(&req.chanPoint)
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+
+	_, closing := p.activeChanCloses[chanID]
+	switch {
+	case !ok:
+		req.err <- fmt.Errorf("unable to splice channel, "+
+			"ChannelID(%v) is unknown", chanID)
+		return
+
+	case !p.supportsSplicing():
+		req.err <- fmt.Errorf("splicing not supported by peer %x",
+			p.PubKey())
+		return
+
+	case closing:
+		req.err <- fmt.Errorf("unable to splice channel, "+
+			"ChannelID(%v) is being closed", chanID)
+		return
+
+	case p.isSplicing(chanID):
+		req.err <- ErrChanAlreadySplicing
+		return
+	}
+
+	splicer, err := p.newChanSplicer(channel, req)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	spliceInit, err := splicer.InitSplice()
+	if err != nil {
+		p.abortSplice(splicer, err)
+		return
+	}
+
+	p.queueMsg(spliceInit, nil)
+}
+
+// handleSpliceMsg advances the splice state machine of a channel using the
+// passed message received from the remote peer. If the message kicks off a
+// splice, a new state machine is created.
+func (p *peer) handleSpliceMsg(msg *spliceMsg) {
+	p.spliceMtx.RLock()
+	splicer, ok := p.activeSplices[msg.cid]
+	p.spliceMtx.RUnlock()
+
+	// If the remote peer sent an error, it aborted the splice, so we'll
+	// abort it as well.
+	if errMsg, isErr := msg.msg.(*lnwire.Error); isErr {
+		if ok {
+			p.abortSplice(splicer, fmt.Errorf("remote peer aborted "+
+				"splice: %v", string(errMsg.Data)))
+		}
+		return
+	}
+
+	var err error
+	if !ok {
+		splicer, err = p.newResponderChanSplicer(msg.cid, msg.msg)
+		if err != nil {
+			peerLog.Errorf("Unable to respond to splice of "+
+				"ChannelID(%v): %v", msg.cid, err)
+			p.queueMsg(&lnwire.Error{
+				ChanID: msg.cid,
+				Data:   lnwire.ErrorData(err.Error()),
+			}, nil)
+			return
+		}
+	}
+
+	msgs, spliceFin, err := splicer.ProcessSpliceMsg(msg.msg)
+	if err != nil {
+		err := fmt.Errorf("unable to process splice msg: %v", err)
+		peerLog.Error(err)
+
+		p.abortSplice(splicer, err)
+		p.queueMsg(&lnwire.Error{
+			ChanID: msg.cid,
+			Data:   lnwire.ErrorData(err.Error()),
+		}, nil)
+		return
+	}
+
+	for _, msg := range msgs {
+		p.queueMsg(msg, nil)
+	}
+
+	if spliceFin {
+		p.finalizeChanSplice(splicer)
+	}
+}
+
+// newResponderChanSplicer creates a splice state machine to respond to a
+// splice of the channel with the passed ID initiated by the remote party.
+func (p *peer) newResponderChanSplicer(chanID lnwire.ChannelID,
+	msg lnwire.Message) (*channelSplicer, error) {
+
+	if _, ok := msg.(*lnwire.SpliceInit); !ok {
+		return nil, fmt.Errorf("unexpected splice msg %v",
+			msg.MsgType())
+	}
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+
+	_, closing := p.activeChanCloses[chanID]
+	switch {
+	case !ok:
+		return nil, ErrChannelNotFound
+
+	case !p.supportsSplicing():
+		return nil, fmt.Errorf("splicing not supported")
+
+	case closing:
+		return nil, fmt.Errorf("channel is being closed")
+	}
+
+	return p.newChanSplicer(channel, nil)
+}
+
+// abortSplice tears down the splice state machine after its negotiation
+// failed. Unless the splice has already been persisted, the channel resumes
+// its normal operation.
+func (p *peer) abortSplice(splicer *channelSplicer, err error) {
+	peerLog.Errorf("ChannelPoint(%v): splice failed: %v",
+		splicer.chanPoint, err)
+
+	splicer.Abort()
+
+	p.spliceMtx.Lock()
+	delete(p.activeSplices, splicer.cid)
+	p.spliceMtx.Unlock()
+
+	if req := splicer.SpliceRequest(); req != nil {
+		req.err <- err
+	}
+
+	switch {
+	// If we already sent our signatures for the splice transaction, the
+	// remote party is able to broadcast it, so we'll keep waiting for it
+	// to confirm. Should it never confirm, the funding manager force
+	// closes the channel once it has waited for too long.
+	case splicer.Persisted():
+		p.activeChanMtx.Lock()
+		delete(p.activeChannels, splicer.cid)
+		p.activeChanMtx.Unlock()
+
+		splicer.cfg.channel.Stop()
+		p.server.fundingMgr.WatchSplice(splicer.cfg.channel.State())
+		return
+
+	case !splicer.Paused():
+		return
+	}
+
+	// As the link of the channel has already been removed from the
+	// switch, we'll create a new one.
+	chanPoint := &splicer.chanPoint
+	policy, err := p.fetchForwardingPolicy(chanPoint)
+	if err != nil {
+		peerLog.Errorf("Unable to resume ChannelPoint(%v): %v",
+			chanPoint, err)
+		return
+	}
+	chainEvents, err := p.server.chainArb.SubscribeChannelEvents(
+		*chanPoint,
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to resume ChannelPoint(%v): %v",
+			chanPoint, err)
+		return
+	}
+	_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		peerLog.Errorf("Unable to resume ChannelPoint(%v): %v",
+			chanPoint, err)
+		return
+	}
+
+	err = p.addLink(
+		chanPoint, splicer.cfg.channel, policy, chainEvents,
+		currentHeight, false,
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to resume ChannelPoint(%v): %v",
+			chanPoint, err)
+	}
+}
+
+// finalizeChanSplice wraps up a splice once the splice transaction has been
+// broadcast. The channel remains inactive until the splice transaction
+// confirms, after which the funding manager hands it back to us at its new
+// funding output.
+func (p *peer) finalizeChanSplice(splicer *channelSplicer) {
+	p.spliceMtx.Lock()
+	delete(p.activeSplices, splicer.cid)
+	p.spliceMtx.Unlock()
+
+	p.activeChanMtx.Lock()
+	delete(p.activeChannels, splicer.cid)
+	p.activeChanMtx.Unlock()
+
+	splicer.cfg.channel.Stop()
+
+	if req := splicer.SpliceRequest(); req != nil {
+		req.splice <- splicer.Splice()
+	}
+}
+
 // linkFailureReport is sent to the channelManager whenever a link that was
 // added to the switch reports a link failure, and is forced to exit. The report
 // houses the necessary information to cleanup the channel state, send back the
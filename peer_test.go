@@ -589,3 +589,130 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureRenegotiation tests that the responder of a
+// cooperative close re-negotiates the closing fee when the remote party sends
+// a new shutdown message after the close transaction has been broadcast.
+func TestPeerChannelClosureRenegotiation(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	responder, responderChan, initiatorChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	chanID := lnwire.NewChanIDFromOutPoint(responderChan.ChannelPoint())
+
+	// The closing fee can only be re-negotiated if both parties signal
+	// support for replaceable close transactions.
+	features := lnwire.NewRawFeatureVector(lnwire.CoopCloseRBFOptional)
+	responder.localFeatures = features
+	responder.remoteLocalFeatures = lnwire.NewFeatureVector(
+		features, lnwire.LocalFeatures,
+	)
+
+	// negotiateClose sends a shutdown message to Alice, accepts the fee
+	// she proposes, and returns the closing tx she broadcasts.
+	negotiateClose := func() *wire.MsgTx {
+		responder.chanCloseMsgs <- &closeMsg{
+			cid: chanID,
+			msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+		}
+
+		var msg lnwire.Message
+		select {
+		case outMsg := <-responder.outgoingQueue:
+			msg = outMsg.msg
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive shutdown message")
+		}
+
+		shutdownMsg, ok := msg.(*lnwire.Shutdown)
+		if !ok {
+			t.Fatalf("expected Shutdown message, got %T", msg)
+		}
+		respDeliveryScript := shutdownMsg.Address
+
+		select {
+		case outMsg := <-responder.outgoingQueue:
+			msg = outMsg.msg
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive ClosingSigned message")
+		}
+
+		responderClosingSigned, ok := msg.(*lnwire.ClosingSigned)
+		if !ok {
+			t.Fatalf("expected ClosingSigned message, got %T", msg)
+		}
+
+		peerFee := responderClosingSigned.FeeSatoshis
+		initiatorSig, _, _, err := initiatorChan.CreateCloseProposal(
+			peerFee, dummyDeliveryScript, respDeliveryScript,
+			lnwallet.ReplaceableCoopClose(),
+		)
+		if err != nil {
+			t.Fatalf("error creating close proposal: %v", err)
+		}
+
+		parsedSig, err := lnwire.NewSigFromRawSignature(initiatorSig)
+		if err != nil {
+			t.Fatalf("error parsing signature: %v", err)
+		}
+		responder.chanCloseMsgs <- &closeMsg{
+			cid: chanID,
+			msg: lnwire.NewClosingSigned(chanID, peerFee, parsedSig),
+		}
+
+		var closeTx *wire.MsgTx
+		select {
+		case closeTx = <-broadcastTxChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("closing tx not broadcast")
+		}
+		if !lnwallet.IsReplaceableCoopClose(closeTx) {
+			t.Fatalf("closing tx doesn't signal replaceability")
+		}
+
+		// Wait for Alice to send back her final ClosingSigned message.
+		select {
+		case <-responder.outgoingQueue:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive ClosingSigned message")
+		}
+
+		return closeTx
+	}
+
+	// assertCloseInfo asserts that the given close tx has been recorded
+	// along with the expected number of re-negotiations.
+	assertCloseInfo := func(closeTx *wire.MsgTx, numRenegotiations uint32) {
+		closeInfo, err := responderChan.State().CoopCloseInfo()
+		if err != nil {
+			t.Fatalf("unable to fetch coop close info: %v", err)
+		}
+		if closeInfo.CloseTx.TxHash() != closeTx.TxHash() {
+			t.Fatalf("expected close tx %v to be recorded, got %v",
+				closeTx.TxHash(), closeInfo.CloseTx.TxHash())
+		}
+		if closeInfo.NumRenegotiations != numRenegotiations {
+			t.Fatalf("expected %v re-negotiations, got %v",
+				numRenegotiations, closeInfo.NumRenegotiations)
+		}
+	}
+
+	closeTx := negotiateClose()
+	assertCloseInfo(closeTx, 0)
+
+	// Bob now kicks off a re-negotiation of the closing fee. Even though
+	// the channel is no longer active, Alice should go through the
+	// closing procedure once again, and record the new close tx.
+	closeTx = negotiateClose()
+	assertCloseInfo(closeTx, 1)
+}
@@ -6,10 +6,13 @@ import (
 	"net"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tor"
 )
@@ -17,9 +20,10 @@ import (
 // chanController is an implementation of the autopilot.ChannelController
 // interface that's backed by a running lnd instance.
 type chanController struct {
-	server   *server
-	private  bool
-	minConfs int32
+	server      *server
+	private     bool
+	minConfs    int32
+	maxChanSize btcutil.Amount
 }
 
 // OpenChannel opens a channel to a target peer, with a capacity of the
@@ -35,6 +39,14 @@ func (c *chanController) OpenChannel(target *btcec.PublicKey,
 		return err
 	}
 
+	// The agent may propose channels above the soft-limit for channel
+	// size if large channels are enabled, but the target peer might not
+	// support them. In that case we'll open the largest channel the peer
+	// accepts instead.
+	if maxChanSize := c.server.maxChanSize(target); amt > maxChanSize {
+		amt = maxChanSize
+	}
+
 	// TODO(halseth): make configurable?
 	minHtlc := lnwire.NewMSatFromSatoshis(1)
 
@@ -66,13 +78,99 @@ func (c *chanController) OpenChannel(target *btcec.PublicKey,
 func (c *chanController) CloseChannel(chanPoint *wire.OutPoint) error {
 	return nil
 }
+
+// SpliceIn adds the specified amount of funds from the wallet to the target
+// channel. The amount is reduced if the channel would otherwise exceed the
+// maximum channel size. This function un-blocks once the splice transaction
+// has been broadcast, and returns the channel the splice will result in. As
+// the splice transaction is still unconfirmed, the short channel ID of the
+// returned channel isn't known yet.
 func (c *chanController) SpliceIn(chanPoint *wire.OutPoint,
 	amt btcutil.Amount) (*autopilot.Channel, error) {
-	return nil, nil
+
+	dbChan, err := c.server.fetchOpenChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	maxChanSize := c.server.maxChanSize(dbChan.IdentityPub)
+	if c.maxChanSize < maxChanSize {
+		maxChanSize = c.maxChanSize
+	}
+	if dbChan.Capacity+amt > maxChanSize {
+		amt = maxChanSize - dbChan.Capacity
+	}
+	if amt <= 0 {
+		return nil, fmt.Errorf("ChannelPoint(%v) is already at the "+
+			"maximum channel size of %v", chanPoint, maxChanSize)
+	}
+
+	req := &spliceChanReq{
+		chanPoint: *chanPoint,
+		amt:       amt,
+		minConfs:  c.minConfs,
+	}
+
+	return c.spliceChannel(dbChan, req)
 }
+
+// SpliceOut removes the specified amount of funds from the target channel,
+// and sends them to a new address of the wallet. This function un-blocks
+// once the splice transaction has been broadcast, and returns the channel the
+// splice will result in. As the splice transaction is still unconfirmed, the
+// short channel ID of the returned channel isn't known yet.
 func (c *chanController) SpliceOut(chanPoint *wire.OutPoint,
 	amt btcutil.Amount) (*autopilot.Channel, error) {
-	return nil, nil
+
+	dbChan, err := c.server.fetchOpenChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := c.server.cc.wallet.NewAddress(lnwallet.WitnessPubKey, false)
+	if err != nil {
+		return nil, err
+	}
+	deliveryScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &spliceChanReq{
+		chanPoint:      *chanPoint,
+		amt:            amt,
+		deliveryScript: deliveryScript,
+	}
+
+	return c.spliceChannel(dbChan, req)
+}
+
+// spliceChannel sends the passed splice request for the channel to the
+// server, and waits for the splice transaction to be broadcast.
+func (c *chanController) spliceChannel(dbChan *channeldb.OpenChannel,
+	req *spliceChanReq) (*autopilot.Channel, error) {
+
+	var err error
+	req.feePerKw, err = c.server.cc.feeEstimator.EstimateFeePerKW(3)
+	if err != nil {
+		return nil, err
+	}
+
+	spliceChan, errChan := c.server.SpliceChannel(req)
+	select {
+	case splice := <-spliceChan:
+		return &autopilot.Channel{
+			ChanPoint: splice.FundingOutpoint,
+			Capacity:  splice.Capacity,
+			Node:      autopilot.NewNodeID(dbChan.IdentityPub),
+		}, nil
+
+	case err := <-errChan:
+		return nil, err
+
+	case <-c.server.quit:
+		return nil, ErrServerShuttingDown
+	}
 }
 
 // A compile time assertion to ensure chanController meets the
@@ -81,8 +179,11 @@ var _ autopilot.ChannelController = (*chanController)(nil)
 
 // initAutoPilot initializes a new autopilot.Agent instance based on the passed
 // configuration struct. All interfaces needed to drive the pilot will be
-// registered and launched.
-func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error) {
+// registered and launched. If splicing is set, the agent may splice funds
+// into existing channels.
+func initAutoPilot(svr *server, cfg *autoPilotConfig,
+	splicing bool) (*autopilot.Agent, error) {
+
 	atplLog.Infof("Instantiating autopilot with cfg: %v", spew.Sdump(cfg))
 
 	// First, we'll create the preferential attachment heuristic,
@@ -100,10 +201,12 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 		Self:      self,
 		Heuristic: prefAttachment,
 		ChanController: &chanController{
-			server:   svr,
-			private:  cfg.Private,
-			minConfs: cfg.MinConfs,
+			server:      svr,
+			private:     cfg.Private,
+			minConfs:    cfg.MinConfs,
+			maxChanSize: btcutil.Amount(cfg.MaxChannelSize),
 		},
+		Splicing: splicing,
 		WalletBalance: func() (btcutil.Amount, error) {
 			return svr.cc.wallet.ConfirmedBalance(cfg.MinConfs)
 		},
@@ -178,9 +281,10 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 	initialChanState := make([]autopilot.Channel, len(activeChannels))
 	for i, channel := range activeChannels {
 		initialChanState[i] = autopilot.Channel{
-			ChanID:   channel.ShortChanID(),
-			Capacity: channel.Capacity,
-			Node:     autopilot.NewNodeID(channel.IdentityPub),
+			ChanID:    channel.ShortChanID(),
+			ChanPoint: channel.FundingOutpoint,
+			Capacity:  channel.Capacity,
+			Node:      autopilot.NewNodeID(channel.IdentityPub),
 		}
 	}
 
@@ -276,9 +380,10 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 						edgeUpdate.ChanID,
 					)
 					edge := autopilot.Channel{
-						ChanID:   chanID,
-						Capacity: edgeUpdate.Capacity,
-						Node:     chanNode,
+						ChanID:    chanID,
+						ChanPoint: edgeUpdate.ChanPoint,
+						Capacity:  edgeUpdate.Capacity,
+						Node:      chanNode,
 					}
 					pilot.OnChannelOpen(edge)
 				}
@@ -968,10 +968,35 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 				"chan_id=%v", msg.ChannelID)
 		}
 
+		// An alias short channel ID doesn't locate a funding output
+		// on chain, so the channel point and capacity of such an edge
+		// must have been provided by the caller. This is only the
+		// case for our own channels.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if channelID.IsAlias() {
+			if msg.ChannelPoint == (wire.OutPoint{}) ||
+				msg.Capacity == 0 {
+
+				return errors.Errorf("unknown channel point "+
+					"for alias chan_id=%v", msg.ChannelID)
+			}
+
+			if err := r.cfg.Graph.AddChannelEdge(msg); err != nil {
+				return errors.Errorf("unable to add edge: %v",
+					err)
+			}
+
+			invalidateCache = true
+			log.Infof("New alias channel added! Link connects "+
+				"%x and %x with ChannelPoint(%v): chan_id=%v",
+				msg.NodeKey1Bytes, msg.NodeKey2Bytes,
+				msg.ChannelPoint, msg.ChannelID)
+			break
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingPoint, fundingTxOut, err := r.fetchChanPoint(&channelID)
 		if err != nil {
 			r.rejectMtx.Lock()
@@ -1030,6 +1055,31 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 				"got %x", fundingPkScript, chanUtxo.PkScript)
 		}
 
+		// If the channel was known to us by its alias until now, the
+		// alias edge is replaced by this one.
+		aliasID, err := r.cfg.Graph.ChannelID(fundingPoint)
+		switch {
+		case err == channeldb.ErrEdgeNotFound,
+			err == channeldb.ErrGraphNoEdgesFound:
+
+			// This is the first edge we learn of for the channel.
+
+		case err != nil:
+			return errors.Errorf("unable to look up chan_point=%v: "+
+				"%v", fundingPoint, err)
+
+		case lnwire.NewShortChanIDFromInt(aliasID).IsAlias():
+			err := r.cfg.Graph.DeleteChannelEdge(fundingPoint)
+			if err != nil {
+				return errors.Errorf("unable to delete alias "+
+					"edge: %v", err)
+			}
+
+			log.Infof("Replaced alias chan_id=%v of "+
+				"ChannelPoint(%v) with chan_id=%v", aliasID,
+				fundingPoint, msg.ChannelID)
+		}
+
 		// TODO(roasbeef): this is a hack, needs to be removed
 		// after commitment fees are dynamic.
 		msg.Capacity = btcutil.Amount(chanUtxo.Value)
@@ -2016,6 +2016,83 @@ func TestIsKnownEdge(t *testing.T) {
 	}
 }
 
+// TestAddAliasEdge tests that an edge using an alias short channel ID is only
+// added along with its channel point, and that it's replaced by the edge with
+// the confirmed short channel ID of the same channel.
+func TestAddAliasEdge(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+		key1 [33]byte
+		key2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+	copy(key1[:], bitcoinKey1.SerializeCompressed())
+	copy(key2[:], bitcoinKey2.SerializeCompressed())
+
+	fundingTx, chanPoint, chanID, err := createChannelEdge(ctx,
+		key1[:], key2[:], 10000, 500)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+
+	// An alias edge without a channel point should be rejected.
+	alias := lnwire.NewAliasShortChanID(*chanPoint)
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        alias.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: key1,
+		BitcoinKey2Bytes: key2,
+	}
+	if err := ctx.router.AddEdge(edge); err == nil {
+		t.Fatalf("alias edge without channel point should be rejected")
+	}
+
+	edge.ChannelPoint = *chanPoint
+	edge.Capacity = 10000
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add alias edge: %v", err)
+	}
+	if !ctx.router.IsKnownEdge(alias) {
+		t.Fatalf("router should detect alias edge as known")
+	}
+
+	// Once the funding transaction confirms, adding the channel by its
+	// confirmed short channel ID should remove the alias edge.
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	edge = &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: key1,
+		BitcoinKey2Bytes: key2,
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	if !ctx.router.IsKnownEdge(*chanID) {
+		t.Fatalf("router should detect edge as known")
+	}
+	if ctx.router.IsKnownEdge(alias) {
+		t.Fatalf("alias edge should have been replaced")
+	}
+}
+
 // TestIsStaleEdgePolicy tests that the IsStaleEdgePolicy properly detects
 // stale channel edge update announcements.
 func TestIsStaleEdgePolicy(t *testing.T) {
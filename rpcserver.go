@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -21,17 +24,25 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/lightningnetwork/lnd/watchtower"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/tv42/zbase32"
 	"golang.org/x/net/context"
@@ -123,6 +134,10 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		},
+		{
+			Entity: "signer",
+			Action: "generate",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -147,12 +162,81 @@ var (
 		},
 	}
 
+	// signerPermissions is a slice of the entities that allow a user to
+	// access the Signer service, which signs with the keys of the node.
+	signerPermissions = []bakery.Op{
+		{
+			Entity: "signer",
+			Action: "generate",
+		},
+	}
+
+	// walletKitPermissions is a slice of the entities that allow a user to
+	// access the WalletKit service: deriving keys, publishing
+	// transactions and estimating fees. They're distinct from the
+	// on-chain and address permissions, so that the WalletKit macaroon
+	// doesn't grant access to any of the main RPCs.
+	walletKitPermissions = []bakery.Op{
+		{
+			Entity: "walletkit",
+			Action: "read",
+		},
+		{
+			Entity: "walletkit",
+			Action: "write",
+		},
+	}
+
+	// remoteSignerPermissions is a slice of the entities that allow a
+	// watch-only node to use this node as its remote signer. These aren't
+	// part of the admin permissions, as the signer hands out private keys.
+	remoteSignerPermissions = []bakery.Op{
+		{
+			Entity: "remotesigner",
+			Action: "generate",
+		},
+	}
+
 	// permissions maps RPC calls to the permissions they require.
 	permissions = map[string][]bakery.Op{
+		"/lnrpc.RemoteSigner/SignOutputRaw":      remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/ComputeInputScript": remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/SignMessage":        remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/DerivePrivKey":      remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/DeriveNextKey":      remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/DeriveKey":          remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/ScalarMult":         remoteSignerPermissions,
+		"/lnrpc.RemoteSigner/ExportWatchOnlyWallet": {{
+			Entity: "remotesigner",
+			Action: "generate",
+		}},
+		"/lnrpc.Signer/SignOutputRaw":      signerPermissions,
+		"/lnrpc.Signer/ComputeInputScript": signerPermissions,
+		"/lnrpc.Signer/DeriveSharedKey":    signerPermissions,
+		"/lnrpc.WalletKit/DeriveNextKey": {{
+			Entity: "walletkit",
+			Action: "write",
+		}},
+		"/lnrpc.WalletKit/DeriveKey": {{
+			Entity: "walletkit",
+			Action: "read",
+		}},
+		"/lnrpc.WalletKit/PublishTransaction": {{
+			Entity: "walletkit",
+			Action: "write",
+		}},
+		"/lnrpc.WalletKit/EstimateFee": {{
+			Entity: "walletkit",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendCoins": {{
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BumpFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/SendMany": {{
 			Entity: "onchain",
 			Action: "write",
@@ -191,6 +275,62 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ListUnspent": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/LabelTransaction": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ImportAccount": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListAccounts": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/FundPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/FinalizeFunding": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ChannelAcceptor": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -326,6 +466,34 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/AddTower": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/RemoveTower": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListTowers": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/TowerClientStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ExportChannelBackup": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/VerifyChanBackup": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RestoreChannelBackups": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 )
 
@@ -399,16 +567,30 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. If any
+// inputs are passed, the transaction spends exactly those wallet outputs.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight,
+	inputs []wire.OutPoint) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	if len(inputs) == 0 {
+		return r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	}
+
+	tx, err := r.server.cc.wallet.SendOutputsWithInputs(
+		inputs, outputs, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := tx.TxHash()
+	return &txid, nil
 }
 
 // determineFeePerKw will determine the fee in sat/kw that should be paid given
@@ -471,51 +653,625 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v", in.Addr,
-		btcutil.Amount(in.Amount), int64(feePerKw))
+	inputs, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// When sweeping the wallet, the amount is determined by the value of
+	// the wallet's outputs, so it can't be specified along with it.
+	if in.SendAll {
+		if in.Amount != 0 || len(inputs) != 0 {
+			return nil, fmt.Errorf("amount and outpoints can't be " +
+				"set when sending all coins")
+		}
+
+		return r.sweepAllOnChain(in.Addr, feePerKw)
+	}
+
+	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, inputs=%v",
+		in.Addr, btcutil.Amount(in.Amount), int64(feePerKw), inputs)
+
+	paymentMap := map[string]int64{in.Addr: in.Amount}
+	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[sendcoins] spend generated txid: %v", txid.String())
+
+	return &lnrpc.SendCoinsResponse{
+		Txid:   txid.String(),
+		Amount: in.Amount,
+	}, nil
+}
+
+// sweepAllOnChain sends all confirmed, unlocked wallet outputs to the passed
+// address at the given fee rate.
+func (r *rpcServer) sweepAllOnChain(addr string,
+	feePerKw lnwallet.SatPerKWeight) (*lnrpc.SendCoinsResponse, error) {
+
+	sweepAddr, err := btcutil.DecodeAddress(addr, activeNetParams.Params)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(sweepAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[sendcoins] sweeping all coins to addr=%v, sat/kw=%v",
+		addr, int64(feePerKw))
+
+	tx, err := r.server.cc.wallet.SweepAll(pkScript, feePerKw)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := tx.TxHash()
+	amount := tx.TxOut[0].Value
+
+	rpcsLog.Infof("[sendcoins] sweep generated txid=%v, amt=%v", txid,
+		btcutil.Amount(amount))
+
+	return &lnrpc.SendCoinsResponse{
+		Txid:   txid.String(),
+		Amount: amount,
+	}, nil
+}
+
+// SendMany handles a request for a transaction create multiple specified
+// outputs in parallel.
+func (r *rpcServer) SendMany(ctx context.Context,
+	in *lnrpc.SendManyRequest) (*lnrpc.SendManyResponse, error) {
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for this transaction.
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v, inputs=%v",
+		spew.Sdump(in.AddrToAmount), int64(feePerKw), inputs)
+
+	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[sendmany] spend generated txid: %v", txid.String())
+
+	return &lnrpc.SendManyResponse{Txid: txid.String()}, nil
+}
+
+// unmarshallOutPoint converts an outpoint from its RPC representation. The
+// txid may be given either as raw bytes or as a hex-encoded string.
+func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
+	if op == nil {
+		return nil, fmt.Errorf("outpoint must be specified")
+	}
+
+	var hash *chainhash.Hash
+	switch {
+	case len(op.TxidBytes) != 0:
+		var err error
+		hash, err = chainhash.NewHash(op.TxidBytes)
+		if err != nil {
+			return nil, err
+		}
+
+	case op.TxidStr != "":
+		var err error
+		hash, err = chainhash.NewHashFromStr(op.TxidStr)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("outpoint txid must be specified")
+	}
+
+	return wire.NewOutPoint(hash, op.OutputIndex), nil
+}
+
+// unmarshallOutPoints converts a list of outpoints from their RPC
+// representation, rejecting duplicates.
+func unmarshallOutPoints(ops []*lnrpc.OutPoint) ([]wire.OutPoint, error) {
+	outPoints := make([]wire.OutPoint, 0, len(ops))
+	seen := make(map[wire.OutPoint]struct{}, len(ops))
+	for _, rpcOp := range ops {
+		op, err := unmarshallOutPoint(rpcOp)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[*op]; ok {
+			return nil, fmt.Errorf("duplicate outpoint %v", op)
+		}
+		seen[*op] = struct{}{}
+
+		outPoints = append(outPoints, *op)
+	}
+
+	return outPoints, nil
+}
+
+// ListUnspent returns the unspent witness outputs of the wallet that are
+// available for spending, within the requested range of confirmations.
+func (r *rpcServer) ListUnspent(ctx context.Context,
+	in *lnrpc.ListUnspentRequest) (*lnrpc.ListUnspentResponse, error) {
+
+	if in.MinConfs < 0 || in.MaxConfs < 0 {
+		return nil, errors.New("number of confirmations must be " +
+			"non-negative")
+	}
+	if in.MaxConfs != 0 && in.MaxConfs < in.MinConfs {
+		return nil, errors.New("max_confs must be at least min_confs")
+	}
+
+	utxos, err := r.server.cc.wallet.ListUnspentWitness(in.MinConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListUnspentResponse{}
+	for _, utxo := range utxos {
+		if in.MaxConfs != 0 && utxo.Confirmations > int64(in.MaxConfs) {
+			continue
+		}
+
+		var addrType lnrpc.NewAddressRequest_AddressType
+		switch utxo.AddressType {
+		case lnwallet.WitnessPubKey:
+			addrType = lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH
+		case lnwallet.NestedWitnessPubKey:
+			addrType = lnrpc.NewAddressRequest_NESTED_PUBKEY_HASH
+		default:
+			continue
+		}
+
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			utxo.PkScript, activeNetParams.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var addr string
+		if len(addrs) == 1 {
+			addr = addrs[0].String()
+		}
+
+		resp.Utxos = append(resp.Utxos, &lnrpc.Utxo{
+			Type:      addrType,
+			Address:   addr,
+			AmountSat: int64(utxo.Value),
+			PkScript:  hex.EncodeToString(utxo.PkScript),
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   utxo.OutPoint.Hash[:],
+				TxidStr:     utxo.OutPoint.Hash.String(),
+				OutputIndex: utxo.OutPoint.Index,
+			},
+			Confirmations: utxo.Confirmations,
+		})
+	}
+
+	return resp, nil
+}
+
+// LeaseOutput locks a wallet output under the given ID, excluding it from coin
+// selection until it's released or the lease expires.
+func (r *rpcServer) LeaseOutput(ctx context.Context,
+	in *lnrpc.LeaseOutputRequest) (*lnrpc.LeaseOutputResponse, error) {
+
+	var lockID [32]byte
+	if len(in.Id) != len(lockID) {
+		return nil, fmt.Errorf("lease id must be %v bytes", len(lockID))
+	}
+	copy(lockID[:], in.Id)
+
+	op, err := unmarshallOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(in.ExpirationSeconds) * time.Second
+
+	rpcsLog.Debugf("[leaseoutput] outpoint=%v, id=%x, duration=%v", op,
+		lockID[:], duration)
+
+	expiration, err := r.server.cc.wallet.LeaseOutput(
+		lockID, *op, duration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput releases a leased wallet output, making it available for coin
+// selection again.
+func (r *rpcServer) ReleaseOutput(ctx context.Context,
+	in *lnrpc.ReleaseOutputRequest) (*lnrpc.ReleaseOutputResponse, error) {
+
+	var lockID [32]byte
+	if len(in.Id) != len(lockID) {
+		return nil, fmt.Errorf("lease id must be %v bytes", len(lockID))
+	}
+	copy(lockID[:], in.Id)
+
+	op, err := unmarshallOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[releaseoutput] outpoint=%v, id=%x", op, lockID[:])
+
+	err = r.server.cc.wallet.ReleaseOutput(lockID, *op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ReleaseOutputResponse{}, nil
+}
+
+// LabelTransaction sets the label of a transaction relevant to the wallet,
+// replacing an existing label only if requested.
+func (r *rpcServer) LabelTransaction(ctx context.Context,
+	in *lnrpc.LabelTransactionRequest) (*lnrpc.LabelTransactionResponse,
+	error) {
+
+	txid, err := chainhash.NewHashFromStr(in.TxidStr)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[labeltransaction] txid=%v, label=%q, overwrite=%v",
+		txid, in.Label, in.Overwrite)
+
+	err = r.server.cc.wallet.LabelTransaction(*txid, in.Label, in.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.LabelTransactionResponse{}, nil
+}
+
+// parseDerivationPath parses a BIP32 derivation path such as m/84'/0'/0'.
+// Hardened indexes may be marked with either ' or h.
+func parseDerivationPath(path string) ([]uint32, error) {
+	if path == "" || path == "m" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "m/") {
+		return nil, fmt.Errorf("derivation path %v must start with m/",
+			path)
+	}
+
+	elements := strings.Split(strings.TrimPrefix(path, "m/"), "/")
+	indexes := make([]uint32, len(elements))
+	for i, element := range elements {
+		hardened := strings.HasSuffix(element, "'") ||
+			strings.HasSuffix(element, "h")
+		if hardened {
+			element = element[:len(element)-1]
+		}
+
+		index, err := strconv.ParseUint(element, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %q in derivation "+
+				"path: %v", element, err)
+		}
+		indexes[i] = uint32(index)
+		if hardened {
+			indexes[i] += hdkeychain.HardenedKeyStart
+		}
+	}
+
+	return indexes, nil
+}
+
+// formatDerivationPath formats a BIP32 derivation path, marking hardened
+// indexes with '.
+func formatDerivationPath(indexes []uint32) string {
+	path := "m"
+	for _, index := range indexes {
+		if index < hdkeychain.HardenedKeyStart {
+			path += fmt.Sprintf("/%d", index)
+			continue
+		}
+		path += fmt.Sprintf("/%d'", index-hdkeychain.HardenedKeyStart)
+	}
+
+	return path
+}
+
+// marshallAccount converts a watch-only account to its RPC representation.
+func (r *rpcServer) marshallAccount(
+	account *channeldb.WatchOnlyAccount) (*lnrpc.Account, error) {
+
+	balance, err := r.server.cc.wallet.WatchOnlyAccountBalance(account.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrType lnrpc.NewAddressRequest_AddressType
+	switch lnwallet.AddressType(account.AddrType) {
+	case lnwallet.WitnessPubKey:
+		addrType = lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH
+	case lnwallet.NestedWitnessPubKey:
+		addrType = lnrpc.NewAddressRequest_NESTED_PUBKEY_HASH
+	}
+
+	var fingerprint [4]byte
+	binary.BigEndian.PutUint32(fingerprint[:], account.MasterFingerprint)
+	derivationPath := formatDerivationPath(account.DerivationPath)
+
+	return &lnrpc.Account{
+		Name:                 account.Name,
+		ExtendedPublicKey:    account.ExtendedKey,
+		AddressType:          addrType,
+		MasterKeyFingerprint: fingerprint[:],
+		DerivationPath:       derivationPath,
+		BirthdayHeight:       account.BirthdayHeight,
+		SyncedHeight:         account.SyncedHeight,
+		ExternalKeyCount:     account.NextExternalIndex,
+		InternalKeyCount:     account.NextInternalIndex,
+		ConfirmedBalance:     int64(balance),
+	}, nil
+}
+
+// ImportAccount imports the extended public key of an account as a watch-only
+// account.
+func (r *rpcServer) ImportAccount(ctx context.Context,
+	in *lnrpc.ImportAccountRequest) (*lnrpc.ImportAccountResponse, error) {
+
+	var addrType lnwallet.AddressType
+	switch in.AddressType {
+	case lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH:
+		addrType = lnwallet.WitnessPubKey
+	case lnrpc.NewAddressRequest_NESTED_PUBKEY_HASH:
+		addrType = lnwallet.NestedWitnessPubKey
+	default:
+		return nil, fmt.Errorf("unsupported address type %v",
+			in.AddressType)
+	}
+
+	var fingerprint uint32
+	switch len(in.MasterKeyFingerprint) {
+	case 0:
+	case 4:
+		fingerprint = binary.BigEndian.Uint32(in.MasterKeyFingerprint)
+	default:
+		return nil, fmt.Errorf("master key fingerprint must be 4 " +
+			"bytes")
+	}
+
+	derivationPath, err := parseDerivationPath(in.DerivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[importaccount] name=%v, address_type=%v, path=%v, "+
+		"birthday=%v", in.Name, in.AddressType, in.DerivationPath,
+		in.BirthdayHeight)
+
+	account, err := r.server.cc.wallet.ImportWatchOnlyAccount(
+		in.Name, in.ExtendedPublicKey, addrType, fingerprint,
+		derivationPath, in.BirthdayHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcAccount, err := r.marshallAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ImportAccountResponse{Account: rpcAccount}, nil
+}
+
+// ListAccounts returns all watch-only accounts along with their balances.
+func (r *rpcServer) ListAccounts(ctx context.Context,
+	in *lnrpc.ListAccountsRequest) (*lnrpc.ListAccountsResponse, error) {
+
+	resp := &lnrpc.ListAccountsResponse{}
+	for _, account := range r.server.cc.wallet.WatchOnlyAccounts() {
+		rpcAccount, err := r.marshallAccount(account)
+		if err != nil {
+			return nil, err
+		}
+		resp.Accounts = append(resp.Accounts, rpcAccount)
+	}
+
+	return resp, nil
+}
+
+// FundPsbt creates an unsigned PSBT that spends from a watch-only account.
+func (r *rpcServer) FundPsbt(ctx context.Context,
+	in *lnrpc.FundPsbtRequest) (*lnrpc.FundPsbtResponse, error) {
+
+	if in.MinConfs < 0 {
+		return nil, errors.New("number of confirmations must be " +
+			"non-negative")
+	}
+
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := addrPairsToOutputs(in.AddrToAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[fundpsbt] account=%v, outputs=%v, sat/kw=%v",
+		in.Account, spew.Sdump(in.AddrToAmount), int64(feePerKw))
+
+	psbt, changeIndex, err := r.server.cc.wallet.FundWatchOnlyPsbt(
+		in.Account, outputs, feePerKw, in.MinConfs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.FundPsbtResponse{
+		FundedPsbt:        psbt,
+		ChangeOutputIndex: changeIndex,
+	}, nil
+}
 
-	paymentMap := map[string]int64{in.Addr: in.Amount}
-	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+// BumpFee speeds up the confirmation of an unconfirmed transaction by
+// spending one of its wallet controlled outputs in a child transaction that
+// pays a higher fee. The child transaction is handed to the sweeper, which
+// rebroadcasts it, and bumps its fee as the confirmation target approaches,
+// until it confirms.
+func (r *rpcServer) BumpFee(ctx context.Context,
+	in *lnrpc.BumpFeeRequest) (*lnrpc.BumpFeeResponse, error) {
+
+	op, err := unmarshallOutPoint(in.Outpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendcoins] spend generated txid: %v", txid.String())
-
-	return &lnrpc.SendCoinsResponse{Txid: txid.String()}, nil
-}
+	switch {
+	case in.TargetConf == 0 && in.SatPerByte == 0:
+		return nil, fmt.Errorf("either target_conf or sat_per_byte " +
+			"must be set")
 
-// SendMany handles a request for a transaction create multiple specified
-// outputs in parallel.
-func (r *rpcServer) SendMany(ctx context.Context,
-	in *lnrpc.SendManyRequest) (*lnrpc.SendManyResponse, error) {
+	case in.TargetConf != 0 && in.SatPerByte != 0:
+		return nil, fmt.Errorf("only one of target_conf or " +
+			"sat_per_byte may be set")
+	}
 
-	// Based on the passed fee related parameters, we'll determine an
-	// appropriate fee rate for this transaction.
-	feePerKw, err := determineFeePerKw(
-		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	// Ensure that the output is actually controlled by our wallet, as
+	// we'll need to be able to sign for it. The transaction we're looking
+	// up may have outputs that belong to others, so it isn't sufficient
+	// for the wallet to know about the output.
+	wallet := r.server.cc.wallet
+	txOut, err := wallet.FetchInputInfo(op)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find output %v: %v", op, err)
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		txOut.PkScript, activeNetParams.Params,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if len(addrs) != 1 || !wallet.IsOurAddress(addrs[0]) {
+		return nil, fmt.Errorf("output %v is not controlled by the "+
+			"wallet", op)
+	}
 
-	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
-		spew.Sdump(in.AddrToAmount), int64(feePerKw))
+	// The sweeper is only able to generate a witness for p2wkh outputs,
+	// which covers the change outputs of our funding transactions and the
+	// outputs of our sweep transactions.
+	if !txscript.IsPayToWitnessPubKeyHash(txOut.PkScript) {
+		return nil, fmt.Errorf("output %v is not a p2wkh output", op)
+	}
 
-	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw)
+	_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendmany] spend generated txid: %v", txid.String())
+	signDesc := &lnwallet.SignDescriptor{
+		Output:   txOut,
+		HashType: txscript.SigHashAll,
+	}
+	input := sweep.MakeBaseInput(
+		op, lnwallet.WitnessKeyHash, signDesc, uint32(bestHeight),
+	)
 
-	return &lnrpc.SendManyResponse{Txid: txid.String()}, nil
+	// Lock the output, so that the wallet doesn't select it as an input
+	// for any other transaction while the sweeper is spending it.
+	wallet.LockOutpoint(*op)
+
+	var resultChan chan sweep.Result
+	if in.TargetConf != 0 {
+		rpcsLog.Infof("[bumpfee] outpoint=%v, target_conf=%v", op,
+			in.TargetConf)
+
+		deadline := uint32(bestHeight) + in.TargetConf
+		resultChan, err = r.server.sweeper.SweepInput(&input, deadline)
+	} else {
+		var feePerKw lnwallet.SatPerKWeight
+		feePerKw, err = determineFeePerKw(
+			r.server.cc.feeEstimator, 0, int64(in.SatPerByte),
+		)
+		if err != nil {
+			wallet.UnlockOutpoint(*op)
+			return nil, err
+		}
+
+		rpcsLog.Infof("[bumpfee] outpoint=%v, sat/kw=%v", op,
+			int64(feePerKw))
+
+		resultChan, err = r.server.sweeper.SweepInputAtFeeRate(
+			&input, feePerKw,
+		)
+	}
+	if err != nil {
+		wallet.UnlockOutpoint(*op)
+		return nil, err
+	}
+
+	// Wait for the child transaction to confirm in the background, and
+	// release the output once it has been spent.
+	go func() {
+		defer wallet.UnlockOutpoint(*op)
+
+		select {
+		case result := <-resultChan:
+			if result.Err != nil {
+				rpcsLog.Errorf("[bumpfee] unable to spend %v: %v",
+					op, result.Err)
+				return
+			}
+
+			rpcsLog.Infof("[bumpfee] output %v spent by "+
+				"confirmed tx %v", op, result.Tx.TxHash())
+
+		case <-r.quit:
+		}
+	}()
+
+	return &lnrpc.BumpFeeResponse{}, nil
 }
 
 // NewAddress creates a new address under control of the local wallet.
 func (r *rpcServer) NewAddress(ctx context.Context,
 	in *lnrpc.NewAddressRequest) (*lnrpc.NewAddressResponse, error) {
 
+	// Addresses of watch-only accounts are derived by the account itself,
+	// using the account's address type.
+	if in.Account != "" {
+		addr, err := r.server.cc.wallet.NewWatchOnlyAddress(
+			in.Account, false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Infof("[newaddress] account=%v, addr=%v", in.Account,
+			addr.String())
+		return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
+	}
+
 	// Translate the gRPC proto address type to the wallet controller's
 	// available address types.
 	var addrType lnwallet.AddressType
@@ -739,12 +1495,14 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 			"state must be below the local funding amount")
 	}
 
-	// Ensure that the user doesn't exceed the current soft-limit for
-	// channel size. If the funding amount is above the soft-limit, then
-	// we'll reject the request.
-	if localFundingAmt > maxFundingAmount {
+	// Ensure that the user doesn't exceed the largest channel size we
+	// permit. If the funding amount is above it, then we'll reject the
+	// request. Whether the target peer supports channels of this size is
+	// checked by the funding manager.
+	maxChanSize := btcutil.Amount(cfg.MaxChanSize)
+	if localFundingAmt > maxChanSize {
 		return fmt.Errorf("funding amount is too large, the max "+
-			"channel size is: %v", maxFundingAmount)
+			"channel size is: %v", maxChanSize)
 	}
 
 	// Restrict the size of the channel we'll actually open. At a later
@@ -801,6 +1559,15 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	fundingInputs, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return err
+	}
+	if len(fundingInputs) != 0 && in.PsbtFunding {
+		return errors.New("outpoints can't be specified for a " +
+			"channel funded by psbt")
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -814,6 +1581,8 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        in.MinConfs,
+		fundingInputs:   fundingInputs,
+		externalFunding: in.PsbtFunding,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -865,6 +1634,301 @@ out:
 	return nil
 }
 
+// FinalizeFunding hands the signed funding transaction of a pending channel
+// that is funded by an external wallet to the funding manager, allowing the
+// funding flow to resume.
+func (r *rpcServer) FinalizeFunding(ctx context.Context,
+	in *lnrpc.FinalizeFundingRequest) (*lnrpc.FinalizeFundingResponse, error) {
+
+	var pendingChanID [32]byte
+	if len(in.PendingChanId) != len(pendingChanID) {
+		return nil, fmt.Errorf("pending channel ID must be %v bytes",
+			len(pendingChanID))
+	}
+	copy(pendingChanID[:], in.PendingChanId)
+
+	var (
+		fundingTx *wire.MsgTx
+		err       error
+	)
+	switch {
+	case len(in.SignedPsbt) != 0 && len(in.FinalRawTx) != 0:
+		return nil, errors.New("only one of signed_psbt and " +
+			"final_raw_tx can be set")
+
+	case len(in.SignedPsbt) != 0:
+		fundingTx, err = lnwallet.ExtractPsbtTx(in.SignedPsbt)
+		if err != nil {
+			return nil, err
+		}
+
+	case len(in.FinalRawTx) != 0:
+		fundingTx = &wire.MsgTx{}
+		err := fundingTx.Deserialize(bytes.NewReader(in.FinalRawTx))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse funding tx: %v",
+				err)
+		}
+
+	default:
+		return nil, errors.New("either signed_psbt or final_raw_tx " +
+			"must be set")
+	}
+
+	rpcsLog.Debugf("[finalizefunding] funding tx %v for pendingID(%x)",
+		fundingTx.TxHash(), pendingChanID[:])
+
+	err = r.server.fundingMgr.ProcessExternalFundingTx(
+		pendingChanID, fundingTx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.FinalizeFundingResponse{
+		FundingTxid: fundingTx.TxHash().String(),
+	}, nil
+}
+
+// BatchOpenChannel opens several channels with different peers, which are all
+// funded by a single transaction. The call returns once the funding
+// transaction has been broadcast.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	rpcsLog.Tracef("[batchopenchannel] request to open %v channels",
+		len(in.Channels))
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	isSynced, _, err := r.server.cc.wallet.IsSynced()
+	if err != nil {
+		return nil, err
+	}
+	if !isSynced {
+		return nil, errors.New("channels cannot be created before the " +
+			"wallet is fully synced")
+	}
+
+	if len(in.Channels) == 0 {
+		return nil, errors.New("at least one channel must be specified")
+	}
+
+	if in.MinConfs < 0 {
+		return nil, errors.New("minimum number of confirmations must " +
+			"be a non-negative number")
+	}
+
+	reqs := make([]*openChanReq, len(in.Channels))
+	for i, channel := range in.Channels {
+		localFundingAmt := btcutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := btcutil.Amount(channel.PushSat)
+
+		switch {
+		case remoteInitialBalance >= localFundingAmt:
+			return nil, fmt.Errorf("channel %v: amount pushed to "+
+				"remote peer for initial state must be below "+
+				"the local funding amount", i)
+
+		case localFundingAmt > btcutil.Amount(cfg.MaxChanSize):
+			return nil, fmt.Errorf("channel %v: funding amount is "+
+				"too large, the max channel size is: %v", i,
+				btcutil.Amount(cfg.MaxChanSize))
+
+		case localFundingAmt < minChanFundingSize:
+			return nil, fmt.Errorf("channel %v: channel is too "+
+				"small, the minimum channel size is: %v SAT", i,
+				int64(minChanFundingSize))
+		}
+
+		nodePubKey, err := btcec.ParsePubKey(
+			channel.NodePubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("channel %v: %v", i, err)
+		}
+		if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+			return nil, fmt.Errorf("channel %v: cannot open "+
+				"channel to self", i)
+		}
+
+		reqs[i] = &openChanReq{
+			targetPubkey:    nodePubKey,
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localFundingAmt,
+			pushAmt: lnwire.NewMSatFromSatoshis(
+				remoteInitialBalance,
+			),
+			minHtlc:        lnwire.MilliSatoshi(channel.MinHtlcMsat),
+			private:        channel.Private,
+			remoteCsvDelay: uint16(channel.RemoteCsvDelay),
+		}
+	}
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for the funding transaction.
+	feeRate, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[batchopenchannel]: using fee of %v sat/kw for "+
+		"funding tx", int64(feeRate))
+
+	fundingInputs, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	chanPoints, err := r.server.OpenChannelBatch(
+		reqs, feeRate, in.MinConfs, fundingInputs,
+	)
+	if err != nil {
+		rpcsLog.Errorf("unable to open batch of channels: %v", err)
+		return nil, err
+	}
+
+	resp := &lnrpc.BatchOpenChannelResponse{
+		PendingChannels: make([]*lnrpc.PendingUpdate, len(chanPoints)),
+	}
+	for i, chanPoint := range chanPoints {
+		txid := chanPoint.Hash
+		resp.PendingChannels[i] = &lnrpc.PendingUpdate{
+			Txid:        txid[:],
+			OutputIndex: chanPoint.Index,
+		}
+	}
+
+	rpcsLog.Tracef("[batchopenchannel] success, funding txid %v",
+		chanPoints[0].Hash)
+
+	return resp, nil
+}
+
+// SpliceChannel splices funds into or out of an existing channel. The call
+// returns once the splice transaction has been broadcast, after which the
+// channel remains unusable until the splice transaction confirms. A splice
+// that doesn't confirm can be aborted by force closing the channel, which the
+// funding manager does on its own after maxWaitNumBlocksSpliceConf blocks.
+func (r *rpcServer) SpliceChannel(ctx context.Context,
+	in *lnrpc.SpliceChannelRequest) (*lnrpc.SpliceChannelResponse, error) {
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	rpcsLog.Tracef("[splicechannel] request for ChannelPoint(%v), "+
+		"splice_in=%v, splice_out=%v", chanPoint, in.SpliceInAmt,
+		in.SpliceOutAmt)
+
+	switch {
+	case in.SpliceInAmt < 0 || in.SpliceOutAmt < 0:
+		return nil, errors.New("splice amount must be positive")
+
+	case in.SpliceInAmt == 0 && in.SpliceOutAmt == 0:
+		return nil, errors.New("either splice_in_amt or " +
+			"splice_out_amt must be set")
+
+	case in.SpliceInAmt != 0 && in.SpliceOutAmt != 0:
+		return nil, errors.New("cannot splice funds into and out of " +
+			"a channel at once")
+
+	case in.SpliceInAmt != 0 && in.Addr != "":
+		return nil, errors.New("addr can only be set when splicing " +
+			"funds out of a channel")
+
+	case in.MinConfs < 0:
+		return nil, errors.New("minimum number of confirmations must " +
+			"be a non-negative number")
+	}
+
+	req := &spliceChanReq{
+		chanPoint: *chanPoint,
+		amt:       btcutil.Amount(in.SpliceInAmt),
+		minConfs:  in.MinConfs,
+	}
+
+	// If funds are spliced out of the channel, they're sent to the passed
+	// address, or a fresh address of our wallet if none was given.
+	if in.SpliceOutAmt != 0 {
+		req.amt = btcutil.Amount(in.SpliceOutAmt)
+
+		var addr btcutil.Address
+		if in.Addr != "" {
+			addr, err = btcutil.DecodeAddress(
+				in.Addr, activeNetParams.Params,
+			)
+		} else {
+			addr, err = r.server.cc.wallet.NewAddress(
+				lnwallet.WitnessPubKey, false,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		req.deliveryScript, err = txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req.feePerKw, err = determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[splicechannel]: using fee of %v sat/kw for splice tx",
+		int64(req.feePerKw))
+
+	spliceChan, errChan := r.server.SpliceChannel(req)
+
+	select {
+	case splice := <-spliceChan:
+		newChanPoint := splice.FundingOutpoint
+		rpcsLog.Tracef("[splicechannel] success, ChannelPoint(%v) "+
+			"spliced into ChannelPoint(%v)", chanPoint,
+			newChanPoint)
+
+		fundingTxid := &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: newChanPoint.Hash[:],
+		}
+		return &lnrpc.SpliceChannelResponse{
+			NewChannelPoint: &lnrpc.ChannelPoint{
+				FundingTxid: fundingTxid,
+				OutputIndex: newChanPoint.Index,
+			},
+		}, nil
+
+	case err := <-errChan:
+		rpcsLog.Errorf("unable to splice ChannelPoint(%v): %v",
+			chanPoint, err)
+		return nil, err
+
+	case <-r.quit:
+		return nil, fmt.Errorf("server shutting down")
+	}
+}
+
 // OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 // call is meant to be consumed by clients to the REST proxy. As with all other
 // sync calls, all byte slices are instead to be populated as hex encoded
@@ -895,6 +1959,14 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 			"wallet is fully synced")
 	}
 
+	// As the funding address is only known once the remote peer has
+	// accepted the channel, the caller would never learn about it through
+	// this call.
+	if in.PsbtFunding {
+		return nil, errors.New("psbt funding is only supported by the " +
+			"streaming OpenChannel call")
+	}
+
 	// Decode the provided target node's public key, parsing it into a pub
 	// key object. For all sync call, byte slices are expected to be
 	// encoded as hex strings.
@@ -946,6 +2018,11 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target sat/kw for funding tx: %v",
 		int64(feeRate))
 
+	fundingInputs, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -956,6 +2033,7 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        in.MinConfs,
+		fundingInputs:   fundingInputs,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -988,27 +2066,80 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
-// getChanPointFundingTxid returns the given channel point's funding txid in
-// raw bytes.
-func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
-	var txid []byte
+// getChanPointFundingTxid returns the given channel point's funding txid in
+// raw bytes.
+func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
+	var txid []byte
+
+	// A channel point's funding txid can be get/set as a byte slice or a
+	// string. In the case it is a string, decode it.
+	switch chanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid = chanPoint.GetFundingTxidBytes()
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		s := chanPoint.GetFundingTxidStr()
+		h, err := chainhash.NewHashFromStr(s)
+		if err != nil {
+			return nil, err
+		}
+
+		txid = h[:]
+	}
+
+	return txid, nil
+}
+
+// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+// OpenChannel requests are sent to the client and the client responds with
+// whether the channel should be accepted. As long as the stream is open, every
+// inbound channel must be accepted by the client.
+func (r *rpcServer) ChannelAcceptor(
+	stream lnrpc.Lightning_ChannelAcceptorServer) error {
+
+	// The acceptor stops waiting for responses once the stream is closed,
+	// so pending channels are rejected right away.
+	quit := make(chan struct{})
+	defer close(quit)
+
+	acceptor := chanacceptor.NewRPCAcceptor(
+		stream.Send, cfg.AcceptorTimeout, quit,
+	)
+	id := r.server.chanAcceptor.AddAcceptor(acceptor)
+	defer r.server.chanAcceptor.RemoveAcceptor(id)
+
+	rpcsLog.Infof("Channel acceptor %v connected", id)
+
+	// Responses are read in a goroutine, so we can exit once the server
+	// shuts down.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			if err := acceptor.HandleResponse(resp); err != nil {
+				rpcsLog.Warnf("Unable to handle channel "+
+					"acceptor response: %v", err)
+			}
+		}
+	}()
 
-	// A channel point's funding txid can be get/set as a byte slice or a
-	// string. In the case it is a string, decode it.
-	switch chanPoint.GetFundingTxid().(type) {
-	case *lnrpc.ChannelPoint_FundingTxidBytes:
-		txid = chanPoint.GetFundingTxidBytes()
-	case *lnrpc.ChannelPoint_FundingTxidStr:
-		s := chanPoint.GetFundingTxidStr()
-		h, err := chainhash.NewHashFromStr(s)
-		if err != nil {
-			return nil, err
+	select {
+	case err := <-errChan:
+		rpcsLog.Infof("Channel acceptor %v disconnected", id)
+
+		// If the client closed the stream, then we exit normally.
+		if err == io.EOF {
+			return nil
 		}
+		return err
 
-		txid = h[:]
+	case <-r.quit:
+		return nil
 	}
-
-	return txid, nil
 }
 
 // CloseChannel attempts to close an active channel identified by its channel
@@ -1034,6 +2165,34 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	rpcsLog.Tracef("[closechannel] request for ChannelPoint(%v), force=%v",
 		chanPoint, force)
 
+	// If a fee bump was requested, the cooperative close transaction of
+	// the channel has already been broadcast, so we'll hand the request to
+	// the close fee bumper rather than the switch.
+	if in.BumpFee {
+		if force {
+			return fmt.Errorf("cannot bump fee of force close")
+		}
+
+		feeRate, err := determineFeePerKw(
+			r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+		)
+		if err != nil {
+			return err
+		}
+
+		rpcsLog.Debugf("[closechannel] bumping fee of close tx of "+
+			"ChannelPoint(%v) to %v sat/kw", chanPoint,
+			int64(feeRate))
+
+		updateChan, errChan := r.server.closeFeeBumper.BumpFee(
+			*chanPoint, feeRate,
+		)
+
+		return r.sendCloseUpdates(
+			chanPoint, updateStream, updateChan, errChan,
+		)
+	}
+
 	var (
 		updateChan chan *lnrpc.CloseStatusUpdate
 		errChan    chan error
@@ -1151,9 +2310,19 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			in.BumpAfterBlocks,
 		)
 	}
-out:
+
+	return r.sendCloseUpdates(chanPoint, updateStream, updateChan, errChan)
+}
+
+// sendCloseUpdates forwards the status updates of the closure of the given
+// channel to the client, until the closing transaction has confirmed.
+func (r *rpcServer) sendCloseUpdates(chanPoint *wire.OutPoint,
+	updateStream lnrpc.Lightning_CloseChannelServer,
+	updateChan chan *lnrpc.CloseStatusUpdate, errChan chan error) error {
+
 	for {
 		select {
 		case err := <-errChan:
@@ -1168,21 +2337,19 @@ out:
 			}
 
 			// If a final channel closing updates is being sent,
-			// then we can break out of our dispatch loop as we no
-			// longer need to process any further updates.
+			// then we can return as we no longer need to process
+			// any further updates.
 			switch closeUpdate := closingUpdate.Update.(type) {
 			case *lnrpc.CloseStatusUpdate_ChanClose:
 				h, _ := chainhash.NewHash(closeUpdate.ChanClose.ClosingTxid)
 				rpcsLog.Infof("[closechannel] close completed: "+
 					"txid(%v)", h)
-				break out
+				return nil
 			}
 		case <-r.quit:
 			return nil
 		}
 	}
-
-	return nil
 }
 
 // AbandonChannel removes all channel state from the database except for a
@@ -1249,6 +2416,8 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 		return nil, err
 	}
 
+	r.server.chanNotifier.NotifyClosedChannelEvent(*chanPoint)
+
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
@@ -1292,6 +2461,15 @@ func (r *rpcServer) fetchActiveChannel(chanPoint wire.OutPoint) (
 		return nil, err
 	}
 
+	// Channels restored from a static backup are only shells, so they
+	// can't be used to create a state machine. Instead, we'll wait for
+	// the remote party to force close them.
+	if dbChan.HasChanStatus(channeldb.Restored) {
+		return nil, fmt.Errorf("channel %v was restored from a static "+
+			"backup and is waiting to be force closed by the "+
+			"remote party", chanPoint)
+	}
+
 	// If the channel is successfully fetched from the database,
 	// we create a fully populated channel state machine which
 	// uses the db channel as backing storage.
@@ -1440,12 +2618,29 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	// Get unconfirmed balance, from txs with 0 confirmations.
 	unconfirmedBal := totalBal - confirmedBal
 
+	// The balances of the watch-only accounts are reported separately,
+	// as the node can't spend them.
+	var accountBalances []*lnrpc.AccountBalance
+	for _, account := range r.server.cc.wallet.WatchOnlyAccounts() {
+		balance, err := r.server.cc.wallet.WatchOnlyAccountBalance(
+			account.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		accountBalances = append(accountBalances, &lnrpc.AccountBalance{
+			Account:          account.Name,
+			ConfirmedBalance: int64(balance),
+		})
+	}
+
 	rpcsLog.Debugf("[walletbalance] Total balance=%v", totalBal)
 
 	return &lnrpc.WalletBalanceResponse{
 		TotalBalance:       int64(totalBal),
 		ConfirmedBalance:   int64(confirmedBal),
 		UnconfirmedBalance: int64(unconfirmedBal),
+		AccountBalances:    accountBalances,
 	}, nil
 }
 
@@ -1637,6 +2832,17 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				resp.TotalLimboBalance += int64(nurseryInfo.limboBalance)
 			}
 
+			// If the remote party force closed, then our output
+			// isn't time locked, so it'll be swept directly by the
+			// channel arbitrator rather than the nursery. This is
+			// also the case for funds we recover after having lost
+			// our channel state.
+			if pendingClose.CloseType == channeldb.RemoteForceClose {
+				r.arbitratorPopulateForceCloseResp(
+					chanPoint, forceClose, resp,
+				)
+			}
+
 			resp.PendingForceClosingChannels = append(
 				resp.PendingForceClosingChannels,
 				forceClose,
@@ -1666,12 +2872,34 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 
 		// A close tx has been broadcasted, all our balance will be in
 		// limbo until it confirms.
+		pendingClose := &lnrpc.PendingChannelsResponse_WaitingCloseChannel{
+			Channel:      channel,
+			LimboBalance: channel.LocalBalance,
+		}
+
+		// If this is a cooperative close, we'll also report on the
+		// progress of bumping the fee of the close tx.
+		closeInfo, err := waitingClose.CoopCloseInfo()
+		switch {
+		case err == nil:
+			closingTxid := closeInfo.CloseTx.TxHash()
+			bumper := r.server.closeFeeBumper
+			numRenegotiations := closeInfo.NumRenegotiations
+
+			pendingClose.ClosingTxid = closingTxid.String()
+			pendingClose.BroadcastHeight = closeInfo.BroadcastHeight
+			pendingClose.FeeBumpHeight = bumper.bumpHeight(closeInfo)
+			pendingClose.NumFeeRenegotiations = numRenegotiations
+			pendingClose.Cpfp = closeInfo.CPFP
+
+		case err != channeldb.ErrNoCoopCloseInfo:
+			rpcsLog.Errorf("unable to fetch coop close info for "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+			return nil, err
+		}
+
 		resp.WaitingCloseChannels = append(
-			resp.WaitingCloseChannels,
-			&lnrpc.PendingChannelsResponse_WaitingCloseChannel{
-				Channel:      channel,
-				LimboBalance: channel.LocalBalance,
-			},
+			resp.WaitingCloseChannels, pendingClose,
 		)
 
 		resp.TotalLimboBalance += channel.LocalBalance
@@ -1680,6 +2908,28 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	return resp, nil
 }
 
+// arbitratorPopulateForceCloseResp adds the balances of any outputs being
+// swept by the channel arbitrator of the target channel to the passed force
+// close response.
+func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint wire.OutPoint,
+	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel,
+	resp *lnrpc.PendingChannelsResponse) {
+
+	// The arbitrator won't be found once all contracts have been resolved,
+	// in which case there's nothing left to report.
+	arbitrator, err := r.server.chainArb.GetChannelArbitrator(chanPoint)
+	if err != nil {
+		return
+	}
+
+	for _, report := range arbitrator.Report() {
+		forceClose.LimboBalance += int64(report.LimboBalance)
+		forceClose.RecoveredBalance += int64(report.RecoveredBalance)
+
+		resp.TotalLimboBalance += int64(report.LimboBalance)
+	}
+}
+
 // ClosedChannels returns a list of all the channels have been closed.
 // This does not include channels that are still in the process of closing.
 func (r *rpcServer) ClosedChannels(ctx context.Context,
@@ -3047,6 +4297,7 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 				BlockHash:        tx.BlockHash.String(),
 				TimeStamp:        tx.Timestamp,
 				TotalFees:        tx.TotalFees,
+				Label:            r.fetchTxLabel(tx.Hash),
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
@@ -3058,6 +4309,7 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 				Amount:    int64(tx.Value),
 				TimeStamp: tx.Timestamp,
 				TotalFees: tx.TotalFees,
+				Label:     r.fetchTxLabel(tx.Hash),
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
@@ -3069,13 +4321,54 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	}
 }
 
+// fetchTxLabel returns the label of the transaction with the passed txid, or
+// an empty string if it hasn't been labeled.
+func (r *rpcServer) fetchTxLabel(txid chainhash.Hash) string {
+	label, err := r.server.chanDB.FetchTxLabel(txid)
+	if err != nil && err != channeldb.ErrTxLabelNotFound {
+		rpcsLog.Errorf("Unable to fetch label of tx %v: %v", txid, err)
+	}
+
+	return label
+}
+
 // GetTransactions returns a list of describing all the known transactions
-// relevant to the wallet.
+// relevant to the wallet, confirmed within the requested range of heights.
 func (r *rpcServer) GetTransactions(ctx context.Context,
-	_ *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+	req *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+
+	// If no end height is set, we'll list all transactions up to the
+	// chain tip, including unconfirmed ones. An explicit end height of 0
+	// can't be told apart from an unset one, so it's treated the same.
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = -1
+	}
+	if endHeight != -1 && endHeight < req.StartHeight {
+		return nil, fmt.Errorf("end height %v is below start height %v",
+			endHeight, req.StartHeight)
+	}
 
 	// TODO(roasbeef): add pagination support
-	transactions, err := r.server.cc.wallet.ListTransactionDetails()
+	transactions, err := r.server.cc.wallet.ListTransactionDetails(
+		req.StartHeight, endHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transactions of the watch-only accounts are listed after those
+	// of the wallet itself.
+	wallet := r.server.cc.wallet
+	watchOnlyTxns, err := wallet.ListWatchOnlyTransactionDetails(
+		req.StartHeight, endHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	transactions = append(transactions, watchOnlyTxns...)
+
+	labels, err := r.server.chanDB.FetchTxLabels()
 	if err != nil {
 		return nil, err
 	}
@@ -3105,6 +4398,8 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TimeStamp:        tx.Timestamp,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
+			Label:            labels[tx.Hash],
+			Account:          tx.Account,
 		}
 	}
 
@@ -4182,3 +5477,411 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	return resp, nil
 }
+
+// errTowerClientInactive is returned by the watchtower client RPCs when the
+// client has not been enabled through wtclient.active.
+var errTowerClientInactive = errors.New("watchtower client not active")
+
+// AddTower adds a new watchtower reachable at the given address and considers
+// it for new sessions. If the watchtower already exists, then any new
+// addresses included will be considered when dialing it for session
+// negotiations and backups.
+func (r *rpcServer) AddTower(ctx context.Context,
+	req *lnrpc.AddTowerRequest) (*lnrpc.AddTowerResponse, error) {
+
+	if r.server.towerClient == nil {
+		return nil, errTowerClientInactive
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.Pubkey, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	addr, err := lncfg.ParseAddressString(
+		req.Address, strconv.Itoa(watchtower.DefaultPeerPort),
+		cfg.net.ResolveTCPAddr,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %v: %v", req.Address,
+			err)
+	}
+
+	towerAddr := &lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address:     addr,
+	}
+	if err := r.server.towerClient.AddTower(towerAddr); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.AddTowerResponse{}, nil
+}
+
+// RemoveTower removes a watchtower from being considered for future session
+// negotiations and from being used for any subsequent backups until it's added
+// again. If an address is provided, then this RPC only serves as a way of
+// removing the address from the watchtower instead.
+func (r *rpcServer) RemoveTower(ctx context.Context,
+	req *lnrpc.RemoveTowerRequest) (*lnrpc.RemoveTowerResponse, error) {
+
+	if r.server.towerClient == nil {
+		return nil, errTowerClientInactive
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.Pubkey, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	var addr net.Addr
+	if req.Address != "" {
+		addr, err = lncfg.ParseAddressString(
+			req.Address, strconv.Itoa(watchtower.DefaultPeerPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse address %v: %v",
+				req.Address, err)
+		}
+	}
+
+	if err := r.server.towerClient.RemoveTower(pubKey, addr); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.RemoveTowerResponse{}, nil
+}
+
+// ListTowers returns the list of watchtowers registered with the client.
+func (r *rpcServer) ListTowers(ctx context.Context,
+	req *lnrpc.ListTowersRequest) (*lnrpc.ListTowersResponse, error) {
+
+	if r.server.towerClient == nil {
+		return nil, errTowerClientInactive
+	}
+
+	towers, err := r.server.towerClient.RegisteredTowers()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcTowers := make([]*lnrpc.Tower, 0, len(towers))
+	for _, tower := range towers {
+		rpcTowers = append(
+			rpcTowers, marshallTower(tower, req.IncludeSessions),
+		)
+	}
+
+	return &lnrpc.ListTowersResponse{Towers: rpcTowers}, nil
+}
+
+// TowerClientStats returns the in-memory statistics of the watchtower client
+// since startup.
+func (r *rpcServer) TowerClientStats(ctx context.Context,
+	req *lnrpc.TowerClientStatsRequest) (*lnrpc.TowerClientStatsResponse,
+	error) {
+
+	if r.server.towerClient == nil {
+		return nil, errTowerClientInactive
+	}
+
+	stats := r.server.towerClient.Stats()
+	return &lnrpc.TowerClientStatsResponse{
+		NumBackupsReceived:   stats.NumTasksReceived,
+		NumBackupsAccepted:   stats.NumTasksAccepted,
+		NumBackupsIneligible: stats.NumTasksIneligible,
+		NumSessionsAcquired:  stats.NumSessionsAcquired,
+		NumSessionsExhausted: stats.NumSessionsExhausted,
+	}, nil
+}
+
+// marshallTower converts a watchtower registered with the client into its
+// RPC representation, optionally including the sessions negotiated with it.
+func marshallTower(tower *wtclient.RegisteredTower,
+	includeSessions bool) *lnrpc.Tower {
+
+	rpcAddrs := make([]string, 0, len(tower.Addresses))
+	for _, addr := range tower.Addresses {
+		rpcAddrs = append(rpcAddrs, addr.String())
+	}
+
+	var rpcSessions []*lnrpc.TowerSession
+	if includeSessions {
+		rpcSessions = make([]*lnrpc.TowerSession, 0, len(tower.Sessions))
+		for _, session := range tower.Sessions {
+			rpcSessions = append(rpcSessions, marshallTowerSession(session))
+		}
+	}
+
+	return &lnrpc.Tower{
+		Pubkey:      tower.IdentityKey.SerializeCompressed(),
+		Addresses:   rpcAddrs,
+		NumSessions: uint32(len(tower.Sessions)),
+		Sessions:    rpcSessions,
+	}
+}
+
+// marshallTowerSession converts a client session into its RPC representation.
+func marshallTowerSession(session *wtdb.ClientSession) *lnrpc.TowerSession {
+	numPending := uint32(0)
+	if session.HasCommittedUpdate() {
+		numPending = 1
+	}
+
+	satPerKVByte := session.Policy.SweepFeeRate.FeePerKVByte()
+
+	return &lnrpc.TowerSession{
+		NumBackups:        uint32(session.TowerLastApplied),
+		NumPendingBackups: numPending,
+		MaxBackups:        uint32(session.Policy.MaxUpdates),
+		SweepSatPerByte:   uint64(satPerKVByte / 1000),
+		Active:            session.Status == wtdb.CSessionActive,
+	}
+}
+
+// rpcChanPointToOutPoint converts a channel point in its RPC representation to
+// a wire.OutPoint.
+func rpcChanPointToOutPoint(rpcChanPoint *lnrpc.ChannelPoint) (*wire.OutPoint,
+	error) {
+
+	txidHash, err := getChanPointFundingTxid(rpcChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(txid, rpcChanPoint.OutputIndex), nil
+}
+
+// outPointToRPCChanPoint converts a wire.OutPoint to its RPC representation.
+func outPointToRPCChanPoint(chanPoint wire.OutPoint) *lnrpc.ChannelPoint {
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: chanPoint.Hash[:],
+		},
+		OutputIndex: chanPoint.Index,
+	}
+}
+
+// ExportChannelBackup attempts to return an encrypted static channel backup
+// for the target channel identified by its channel point. If no channel point
+// is specified, then a backup of every open channel is returned, along with a
+// multi-channel backup covering all of them.
+func (r *rpcServer) ExportChannelBackup(ctx context.Context,
+	in *lnrpc.ExportChannelBackupRequest) (*lnrpc.ChanBackupSnapshot, error) {
+
+	chanSource := &chanDBSource{db: r.server.chanDB}
+
+	// If a channel point was specified, then we'll only return the backup
+	// for that channel.
+	if in.ChanPoint != nil {
+		chanPoint, err := rpcChanPointToOutPoint(in.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		chanBackup, err := chanbackup.FetchBackupForChan(
+			*chanPoint, chanSource,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var b bytes.Buffer
+		err = chanBackup.PackToWriter(&b, r.server.cc.wallet)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode chan "+
+				"backup: %v", err)
+		}
+
+		return &lnrpc.ChanBackupSnapshot{
+			SingleChanBackups: &lnrpc.ChannelBackups{
+				ChanBackups: []*lnrpc.ChannelBackup{{
+					ChanPoint:  in.ChanPoint,
+					ChanBackup: b.Bytes(),
+				}},
+			},
+		}, nil
+	}
+
+	// Otherwise, we'll assemble a backup for all of our open channels, and
+	// pack them both individually and as a single multi-channel backup.
+	chanBackups, err := chanbackup.FetchStaticChanBackups(chanSource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch all static chan "+
+			"backups: %v", err)
+	}
+
+	packedSingles, err := chanbackup.PackStaticChanBackups(
+		chanBackups, r.server.cc.wallet,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pack chan backups: %v", err)
+	}
+
+	rpcSingles := make([]*lnrpc.ChannelBackup, 0, len(chanBackups))
+	rpcChanPoints := make([]*lnrpc.ChannelPoint, 0, len(chanBackups))
+	for _, chanBackup := range chanBackups {
+		rpcChanPoint := outPointToRPCChanPoint(chanBackup.FundingOutpoint)
+
+		rpcSingles = append(rpcSingles, &lnrpc.ChannelBackup{
+			ChanPoint:  rpcChanPoint,
+			ChanBackup: packedSingles[chanBackup.FundingOutpoint],
+		})
+		rpcChanPoints = append(rpcChanPoints, rpcChanPoint)
+	}
+
+	unpackedMulti := chanbackup.Multi{
+		StaticBackups: chanBackups,
+	}
+	var b bytes.Buffer
+	if err := unpackedMulti.PackToWriter(&b, r.server.cc.wallet); err != nil {
+		return nil, fmt.Errorf("unable to multi-pack backups: %v", err)
+	}
+
+	return &lnrpc.ChanBackupSnapshot{
+		SingleChanBackups: &lnrpc.ChannelBackups{
+			ChanBackups: rpcSingles,
+		},
+		MultiChanBackup: &lnrpc.MultiChanBackup{
+			ChanPoints:      rpcChanPoints,
+			MultiChanBackup: b.Bytes(),
+		},
+	}, nil
+}
+
+// VerifyChanBackup allows a caller to verify the integrity of a channel backup
+// snapshot. This method will accept either a packed Single or a packed Multi.
+// Specifying both will result in an error.
+func (r *rpcServer) VerifyChanBackup(ctx context.Context,
+	in *lnrpc.ChanBackupSnapshot) (*lnrpc.VerifyChanBackupResponse, error) {
+
+	switch {
+	// If neither a Single or Multi has been specified, then we have
+	// nothing to verify.
+	case in.GetSingleChanBackups() == nil && in.GetMultiChanBackup() == nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified")
+
+	// Either a Single or a Multi must be specified, but not both.
+	case in.GetSingleChanBackups() != nil && in.GetMultiChanBackup() != nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified, but not both")
+
+	// If a Single is specified then we'll only accept one of them to
+	// allow the caller to map the valid/invalid state for each individual
+	// Single.
+	case in.GetSingleChanBackups() != nil:
+		chanBackupsProtos := in.GetSingleChanBackups().ChanBackups
+		if len(chanBackupsProtos) != 1 {
+			return nil, errors.New("only one Single is accepted " +
+				"at a time")
+		}
+
+		// First, we'll convert the raw byte slice into a type we can
+		// work with a bit better.
+		chanBackup := chanbackup.PackedSingles(
+			[][]byte{chanBackupsProtos[0].ChanBackup},
+		)
+
+		// With our PackedSingles created, we'll attempt to unpack the
+		// backup. If this fails, then we know the backup is invalid
+		// for some reason.
+		_, err := chanBackup.Unpack(r.server.cc.wallet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid single channel "+
+				"backup: %v", err)
+		}
+
+	case in.GetMultiChanBackup() != nil:
+		// We'll convert the raw byte slice into a PackedMulti that we
+		// can easily work with.
+		packedMultiBackup := in.GetMultiChanBackup().MultiChanBackup
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+
+		// With our PackedMulti created, we'll attempt to unpack the
+		// backup. If this fails, then we know the backup is invalid
+		// for some reason.
+		_, err := packedMulti.Unpack(r.server.cc.wallet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multi channel backup: "+
+				"%v", err)
+		}
+	}
+
+	return &lnrpc.VerifyChanBackupResponse{}, nil
+}
+
+// RestoreChannelBackups accepts a set of singular channel backups, or a single
+// encrypted multi-chan backup and attempts to recover any funds remaining
+// within the channel. If we're able to unpack the backup, then the new channel
+// will be shown under listchannels, as well as pending channels.
+func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
+	in *lnrpc.RestoreChanBackupRequest) (*lnrpc.RestoreBackupResponse, error) {
+
+	// First, we'll make our implementation of the
+	// chanbackup.ChannelRestorer interface which we'll use to properly
+	// restore either a set of chanbackup.Single or chanbackup.Multi
+	// backups.
+	chanRestorer := &chanDBRestorer{
+		db:         r.server.chanDB,
+		secretKeys: r.server.cc.wallet,
+		chainHash:  *activeNetParams.GenesisHash,
+		chainArb:   r.server.chainArb,
+	}
+
+	// We'll accept either a list of Single backups, or a single Multi
+	// backup which contains several single backups.
+	switch {
+	case in.GetChanBackups() != nil:
+		chanBackupsProtos := in.GetChanBackups()
+
+		// Now that we know what type of backup we're working with,
+		// we'll parse them all out into a more suitable format.
+		packedBackups := make([][]byte, 0, len(chanBackupsProtos.ChanBackups))
+		for _, chanBackup := range chanBackupsProtos.ChanBackups {
+			packedBackups = append(
+				packedBackups, chanBackup.ChanBackup,
+			)
+		}
+
+		// With our backups obtained, we'll now restore them which will
+		// write the new backups to disk, and then attempt to connect
+		// out to any peers that we know of which were our prior
+		// channel peers.
+		err := chanbackup.UnpackAndRecoverSingles(
+			chanbackup.PackedSingles(packedBackups),
+			r.server.cc.wallet, chanRestorer, r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack single "+
+				"backups: %v", err)
+		}
+
+	case in.GetMultiChanBackup() != nil:
+		packedMultiBackup := in.GetMultiChanBackup()
+
+		// With our backups obtained, we'll now restore them which will
+		// write the new backups to disk, and then attempt to connect
+		// out to any peers that we know of which were our prior
+		// channel peers.
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+		err := chanbackup.UnpackAndRecoverMulti(
+			packedMulti, r.server.cc.wallet, chanRestorer,
+			r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack chan "+
+				"backup: %v", err)
+		}
+
+	default:
+		return nil, errors.New("either a set of single channel " +
+			"backups or a multi channel backup must be specified")
+	}
+
+	return &lnrpc.RestoreBackupResponse{}, nil
+}
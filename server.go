@@ -18,6 +18,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
@@ -25,6 +26,8 @@ import (
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -36,8 +39,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
 )
 
 const (
@@ -152,10 +157,30 @@ type server struct {
 
 	utxoNursery *utxoNursery
 
+	sweeper *sweep.UtxoSweeper
+
+	closeFeeBumper *closeFeeBumper
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *htlcswitch.OnionProcessor
 
+	// towerClient backs up revoked states of our channels to our
+	// watchtowers. This is nil if the watchtower client is inactive.
+	towerClient wtclient.Client
+
+	// chanNotifier dispatches channel open and close events to any
+	// interested sub-systems.
+	chanNotifier *channelNotifier
+
+	// chanSubSwapper keeps the on-disk multi channel backup up to date
+	// with the set of open channels.
+	chanSubSwapper *chanbackup.SubSwapper
+
+	// chanAcceptor decides whether inbound channels are accepted, based
+	// on the ChannelAcceptor RPC clients that are currently connected.
+	chanAcceptor *chanacceptor.ChainedAcceptor
+
 	connMgr *connmgr.ConnManager
 
 	// globalFeatures feature vector which affects HTLCs and thus are also
@@ -225,7 +250,7 @@ func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
 // newServer creates a new instance of the server which is to listen using the
 // passed listener address.
 func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
-	privKey *btcec.PrivateKey) (*server, error) {
+	privKey *btcec.PrivateKey, towerClient wtclient.Client) (*server, error) {
 
 	var err error
 
@@ -244,6 +269,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 	globalFeatures := lnwire.NewRawFeatureVector()
 
+	// If enabled, we'll signal to our peers and the rest of the network
+	// that we support channels above the BOLT 2 soft-limit.
+	if cfg.LargeChannels {
+		globalFeatures.Set(lnwire.LargeChannelsOptional)
+	}
+
 	var serializedPubKey [33]byte
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
 
@@ -265,6 +296,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 		listenAddrs: listenAddrs,
 
+		towerClient: towerClient,
+
+		chanNotifier: newChannelNotifier(),
+		chanAcceptor: chanacceptor.NewChainedAcceptor(),
+
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
 		sphinx: htlcswitch.NewOnionProcessor(sphinxRouter),
@@ -581,19 +617,68 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	sweeperStore, err := sweep.NewSweeperStore(
+		chanDB, activeNetParams.GenesisHash,
+	)
+	if err != nil {
+		srvrLog.Errorf("unable to create sweeper store: %v", err)
+		return nil, err
+	}
+
+	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
+		FeeEstimator: cc.feeEstimator,
+		GenSweepScript: func() ([]byte, error) {
+			return newSweepPkScript(cc.wallet)
+		},
+		Signer:             cc.wallet.Cfg.Signer,
+		PublishTransaction: cc.wallet.PublishLabeledTransaction,
+		NewBatchTimer: func() <-chan time.Time {
+			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
+		},
+		Notifier:         cc.chainNotifier,
+		ChainIO:          cc.chainIO,
+		Store:            sweeperStore,
+		MaxInputsPerTx:   sweep.DefaultMaxInputsPerTx,
+		MaxSweepAttempts: sweep.DefaultMaxSweepAttempts,
+	})
+
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
 		ChainIO:             cc.chainIO,
 		ConfDepth:           1,
 		FetchClosedChannels: chanDB.FetchClosedChannels,
 		FetchClosedChannel:  chanDB.FetchClosedChannel,
-		Estimator:           cc.feeEstimator,
-		GenSweepScript: func() ([]byte, error) {
-			return newSweepPkScript(cc.wallet)
+		Notifier:            cc.chainNotifier,
+		PublishTransaction:  cc.wallet.PublishLabeledTransaction,
+		Store:               utxnStore,
+		SweepInput:          s.sweeper.SweepInput,
+	})
+
+	s.closeFeeBumper = newCloseFeeBumper(&CloseFeeBumperConfig{
+		ChainIO:                   cc.chainIO,
+		Notifier:                  cc.chainNotifier,
+		FetchWaitingCloseChannels: chanDB.FetchWaitingCloseChannels,
+		Estimator:                 cc.feeEstimator,
+		PublishTransaction:        cc.wallet.PublishLabeledTransaction,
+		RenegotiateClose: func(channel *channeldb.OpenChannel,
+			req *htlcswitch.ChanClose) error {
+
+			peer, err := s.FindPeer(channel.IdentityPub)
+			if err != nil {
+				return err
+			}
+
+			select {
+			case peer.localCloseChanReqs <- req:
+				return nil
+			case <-peer.quit:
+				return fmt.Errorf("peer %x exiting",
+					peer.PubKey())
+			}
 		},
-		Notifier:           cc.chainNotifier,
-		PublishTransaction: cc.wallet.PublishTransaction,
-		Signer:             cc.wallet.Cfg.Signer,
-		Store:              utxnStore,
+		SweepInput:             s.sweeper.SweepInput,
+		SweepInputAtFeeRate:    s.sweeper.SweepInputAtFeeRate,
+		DefaultBumpAfterBlocks: cfg.CoopCloseBumpBlocks,
+		BumpConfTarget:         coopCloseBumpConfTarget,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -601,7 +686,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -616,7 +701,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		NewSweepAddr: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		PublishTx: cc.wallet.PublishTransaction,
+		PublishTx: cc.wallet.PublishLabeledTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
 				err := s.htlcSwitch.ProcessContractResolution(msg)
@@ -653,6 +738,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		Signer:       cc.wallet.Cfg.Signer,
 		FeeEstimator: cc.feeEstimator,
 		ChainIO:      cc.chainIO,
+		Sweeper:      s.sweeper,
 		MarkLinkInactive: func(chanPoint wire.OutPoint) error {
 			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			s.htlcSwitch.RemoveLink(chanID)
@@ -685,6 +771,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
+		NotifyClosedChannel: s.chanNotifier.NotifyClosedChannelEvent,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -695,7 +782,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			return newSweepPkScript(cc.wallet)
 		},
 		Notifier:           cc.chainNotifier,
-		PublishTransaction: cc.wallet.PublishTransaction,
+		PublishTransaction: cc.wallet.PublishLabeledTransaction,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
@@ -721,7 +808,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	s.fundingMgr, err = newFundingManager(fundingConfig{
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
-		PublishTransaction: cc.wallet.PublishTransaction,
+		PublishTransaction: cc.wallet.PublishLabeledTransaction,
 		Notifier:           cc.chainNotifier,
 		FeeEstimator:       cc.feeEstimator,
 		SignMessage: func(pubKey *btcec.PublicKey,
@@ -736,9 +823,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		CurrentNodeAnnouncement: func() (lnwire.NodeAnnouncement, error) {
 			return s.genNodeAnnouncement(true)
 		},
-		SendAnnouncement: func(msg lnwire.Message) chan error {
+		SendAnnouncement: func(msg lnwire.Message,
+			opts ...discovery.OptionalMsgField) chan error {
+
 			return s.authGossiper.ProcessLocalAnnouncement(
-				msg, privKey.PubKey(),
+				msg, privKey.PubKey(), opts...,
 			)
 		},
 		NotifyWhenOnline: s.NotifyWhenOnline,
@@ -816,15 +905,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			}
 
 			// If not we scale according to channel size.
-			delay := uint16(btcutil.Amount(maxRemoteDelay) *
-				chanAmt / maxFundingAmount)
-			if delay < minRemoteDelay {
-				delay = minRemoteDelay
-			}
-			if delay > maxRemoteDelay {
-				delay = maxRemoteDelay
-			}
-			return delay
+			return scaleRemoteDelay(
+				chanAmt, minRemoteDelay, maxRemoteDelay,
+			)
 		},
 		WatchNewChannel: func(channel *channeldb.OpenChannel,
 			peerKey *btcec.PublicKey) error {
@@ -838,12 +921,53 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 			// With that taken care of, we'll send this channel to
 			// the chain arb so it can react to on-chain events.
-			return s.chainArb.WatchNewChannel(channel)
+			if err := s.chainArb.WatchNewChannel(channel); err != nil {
+				return err
+			}
+
+			// Finally, notify any subscribers of the new channel,
+			// so it's included within our channel backups.
+			s.chanNotifier.NotifyOpenChannelEvent(
+				channel.FundingOutpoint,
+			)
+
+			return nil
 		},
+		NotifyClosedChannel: s.chanNotifier.NotifyClosedChannelEvent,
 		ReportShortChanID: func(chanPoint wire.OutPoint) error {
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		ConfirmAliasShortChanID: func(chanPoint wire.OutPoint,
+			persist func() error) error {
+
+			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
+			return s.htlcSwitch.ConfirmAliasShortChanID(cid, persist)
+		},
+		ZeroConfPeer: cfg.isZeroConfPeer,
+		RemoveLink:   s.htlcSwitch.RemoveLink,
+		SpliceContract: func(oldChanPoint wire.OutPoint,
+			channel *channeldb.OpenChannel) error {
+
+			err := s.chainArb.SpliceContract(oldChanPoint, channel)
+			if err != nil {
+				return err
+			}
+
+			// The channel backups are keyed by the funding
+			// outpoint, so we'll have the backup of the spliced
+			// channel replaced.
+			s.chanNotifier.NotifyClosedChannelEvent(oldChanPoint)
+			s.chanNotifier.NotifyOpenChannelEvent(
+				channel.FundingOutpoint,
+			)
+
+			return nil
+		},
+		ForceCloseContract: func(chanPoint wire.OutPoint) error {
+			_, err := s.chainArb.ForceCloseContract(chanPoint)
+			return err
+		},
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -874,6 +998,23 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval: 1 * time.Minute,
 		ReservationTimeout:    10 * time.Minute,
 		MinChanSize:           btcutil.Amount(cfg.MinChanSize),
+		OpenChannelPredicate:  s.chanAcceptor,
+		MaxChanSize:           s.maxChanSize,
+		SupportsDualFunding: func(peerKey *btcec.PublicKey) bool {
+			if !cfg.DualFunding.Active {
+				return false
+			}
+
+			peer, err := s.FindPeer(peerKey)
+			if err != nil {
+				return false
+			}
+
+			features := peer.remoteLocalFeatures
+			return features.HasFeature(lnwire.DualFundingOptional) ||
+				features.HasFeature(lnwire.DualFundingRequired)
+		},
+		DualFundingContribution: cfg.DualFunding.contribution,
 	})
 	if err != nil {
 		return nil, err
@@ -895,6 +1036,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	}
 	s.connMgr = cmgr
 
+	// Finally, we'll create the sub-swapper which will keep our multi
+	// channel backup file in sync with the set of open channels.
+	s.chanSubSwapper = chanbackup.NewSubSwapper(
+		&chanDBSource{db: chanDB}, s.chanNotifier, cc.wallet,
+		chanbackup.NewMultiFile(cfg.BackupFilePath),
+	)
+
 	return s, nil
 }
 
@@ -938,12 +1086,21 @@ func (s *server) Start() error {
 	if err := s.htlcSwitch.Start(); err != nil {
 		return err
 	}
+	if err := s.sweeper.Start(); err != nil {
+		return err
+	}
 	if err := s.utxoNursery.Start(); err != nil {
 		return err
 	}
 	if err := s.chainArb.Start(); err != nil {
 		return err
 	}
+	if err := s.closeFeeBumper.Start(); err != nil {
+		return err
+	}
+	if err := s.chanSubSwapper.Start(); err != nil {
+		return err
+	}
 	if err := s.breachArbiter.Start(); err != nil {
 		return err
 	}
@@ -1023,7 +1180,11 @@ func (s *server) Stop() error {
 	s.utxoNursery.Stop()
 	s.breachArbiter.Stop()
 	s.authGossiper.Stop()
+	s.closeFeeBumper.Stop()
 	s.chainArb.Stop()
+	s.sweeper.Stop()
+	s.chanSubSwapper.Stop()
+	s.chanNotifier.Stop()
 	s.cc.wallet.Shutdown()
 	s.cc.chainView.Stop()
 	s.connMgr.Stop()
@@ -1888,6 +2049,32 @@ func (s *server) sendPeerMessages(
 	return errChans
 }
 
+// maxChanSize returns the largest channel we accept or open with the peer with
+// the given public key. Channels may only exceed the soft-limit for channel
+// size if both we and the peer signal support for large channels.
+func (s *server) maxChanSize(peerKey *btcec.PublicKey) btcutil.Amount {
+	maxChanSize := btcutil.Amount(cfg.MaxChanSize)
+	if maxChanSize <= maxFundingAmount {
+		return maxChanSize
+	}
+
+	// A maximum above the soft-limit implies that we signal support for
+	// large channels, so we only need to check the peer's features.
+	peer, err := s.FindPeer(peerKey)
+	if err != nil {
+		return maxFundingAmount
+	}
+
+	features := peer.remoteGlobalFeatures
+	if !features.HasFeature(lnwire.LargeChannelsOptional) &&
+		!features.HasFeature(lnwire.LargeChannelsRequired) {
+
+		return maxFundingAmount
+	}
+
+	return maxChanSize
+}
+
 // FindPeer will return the peer that corresponds to the passed in public key.
 // This function is used by the funding manager, allowing it to update the
 // daemon's local representation of the remote peer.
@@ -2257,6 +2444,22 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	localFeatures.Set(lnwire.DataLossProtectOptional)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
 
+	// We'll also signal that our cooperative close transactions signal
+	// replaceability, so their fee can be re-negotiated.
+	localFeatures.Set(lnwire.CoopCloseRBFOptional)
+
+	// If enabled, we'll also signal that we're able to open and accept
+	// dual funder channels.
+	if cfg.DualFunding.Active {
+		localFeatures.Set(lnwire.DualFundingOptional)
+	}
+
+	// Likewise, we'll signal that we're able to splice funds into and out
+	// of our channels if enabled.
+	if cfg.Splicing {
+		localFeatures.Set(lnwire.SplicingOptional)
+	}
+
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
 	p, err := newPeer(conn, connReq, s, peerAddr, inbound, localFeatures)
@@ -2573,6 +2776,22 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// fundingInputs is an optional set of wallet outputs that must be
+	// used to fund the channel.
+	fundingInputs []wire.OutPoint
+
+	// externalFunding indicates that the funding transaction will be
+	// provided by an external wallet, rather than funded by our own
+	// wallet.
+	externalFunding bool
+
+	// batchSigned is set if the channel is part of a batch of channels
+	// sharing a single funding transaction, which requires
+	// externalFunding to be set as well. It's closed once the remote peer
+	// has signed our commitment transaction. The funding transaction is
+	// only broadcast once the whole batch is completed.
+	batchSigned chan struct{}
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2754,6 +2973,212 @@ func (s *server) OpenChannel(
 	return req.updates, req.err
 }
 
+// OpenChannelBatch opens a channel for each of the passed requests, all of
+// which are funded by a single transaction crafted by the wallet. The funding
+// flows with the remote peers run in parallel, and the funding transaction is
+// only broadcast once every peer has signed our commitment transaction. If
+// any of the flows fails, all of them are cancelled. On success, the channel
+// points of the new channels are returned in the order of the requests.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) OpenChannelBatch(reqs []*openChanReq,
+	feeRate lnwallet.SatPerKWeight, minConfs int32,
+	inputs []wire.OutPoint) ([]wire.OutPoint, error) {
+
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("no channels specified")
+	}
+
+	// Kick off the funding flows of all channels. The funding transaction
+	// is handed to each flow once all funding outputs are known.
+	updateChans := make([]chan *lnrpc.OpenStatusUpdate, len(reqs))
+	errChans := make([]chan error, len(reqs))
+	for i, req := range reqs {
+		req.fundingFeePerKw = feeRate
+		req.minConfs = minConfs
+		req.externalFunding = true
+		req.batchSigned = make(chan struct{})
+
+		updateChans[i], errChans[i] = s.OpenChannel(req)
+	}
+
+	// We'll wait for every flow to either reach the point where its
+	// funding output is known, or fail. Only the flows that got that far
+	// have a reservation that needs to be cancelled on failure.
+	var (
+		pendingChanIDs [][32]byte
+		outputs        []*wire.TxOut
+		flowErr        error
+	)
+	for i := range reqs {
+		var upd *lnrpc.OpenStatusUpdate
+		select {
+		case upd = <-updateChans[i]:
+		case err := <-errChans[i]:
+			if flowErr == nil {
+				flowErr = err
+			}
+			continue
+		case <-s.quit:
+			return nil, ErrServerShuttingDown
+		}
+
+		psbtFund, ok := upd.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+		if !ok {
+			flowErr = fmt.Errorf("unexpected funding update: %v",
+				upd)
+			continue
+		}
+
+		var pendingChanID [32]byte
+		copy(pendingChanID[:], psbtFund.PsbtFund.PendingChanId)
+		pendingChanIDs = append(pendingChanIDs, pendingChanID)
+
+		addr, err := btcutil.DecodeAddress(
+			psbtFund.PsbtFund.FundingAddress, activeNetParams.Params,
+		)
+		if err != nil {
+			flowErr = err
+			continue
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			flowErr = err
+			continue
+		}
+		outputs = append(outputs, &wire.TxOut{
+			Value:    psbtFund.PsbtFund.FundingAmount,
+			PkScript: pkScript,
+		})
+	}
+	if flowErr != nil {
+		s.fundingMgr.CancelBatch(pendingChanIDs, flowErr)
+		return nil, flowErr
+	}
+
+	fundingTx, err := s.cc.wallet.CreateBatchFundingTx(
+		outputs, feeRate, minConfs, inputs,
+	)
+	if err != nil {
+		s.fundingMgr.CancelBatch(pendingChanIDs, err)
+		return nil, err
+	}
+
+	srvrLog.Infof("Created batch funding tx %v for %v channels",
+		fundingTx.TxHash(), len(reqs))
+
+	// abort cancels all funding flows, and makes the inputs of the
+	// funding transaction available again.
+	abort := func(err error) error {
+		srvrLog.Errorf("Unable to open batch of channels: %v", err)
+
+		s.fundingMgr.CancelBatch(pendingChanIDs, err)
+		s.cc.wallet.ReleaseBatchInputs(fundingTx)
+		return err
+	}
+
+	// With the funding transaction known, each flow can proceed with
+	// exchanging the commitment signatures.
+	for _, pendingChanID := range pendingChanIDs {
+		err := s.fundingMgr.ProcessBatchFundingTx(
+			pendingChanID, fundingTx,
+		)
+		if err != nil {
+			return nil, abort(err)
+		}
+	}
+
+	for i, req := range reqs {
+		select {
+		case <-req.batchSigned:
+		case err := <-errChans[i]:
+			return nil, abort(err)
+		case <-s.quit:
+			return nil, abort(ErrServerShuttingDown)
+		}
+	}
+
+	// Every peer has signed, so we can now broadcast the funding
+	// transaction.
+	err = s.fundingMgr.CompleteBatch(pendingChanIDs, fundingTx)
+	if err != nil {
+		return nil, abort(err)
+	}
+
+	chanPoints := make([]wire.OutPoint, len(outputs))
+	for i, output := range outputs {
+		_, index := lnwallet.FindScriptOutputIndex(
+			fundingTx, output.PkScript,
+		)
+		chanPoints[i] = wire.OutPoint{
+			Hash:  fundingTx.TxHash(),
+			Index: index,
+		}
+	}
+
+	return chanPoints, nil
+}
+
+// fetchOpenChannel locates the open channel with the passed funding outpoint
+// within the database.
+func (s *server) fetchOpenChannel(
+	chanPoint wire.OutPoint) (*channeldb.OpenChannel, error) {
+
+	dbChannels, err := s.chanDB.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dbChannel := range dbChannels {
+		if dbChannel.FundingOutpoint == chanPoint {
+			return dbChannel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find ChannelPoint(%v)", chanPoint)
+}
+
+// SpliceChannel sends a request to splice funds into or out of a channel to
+// the peer the channel is with. The pending splice is sent over the returned
+// channel once the splice transaction has been broadcast.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SpliceChannel(
+	req *spliceChanReq) (chan *channeldb.ChannelSplice, chan error) {
+
+	req.splice = make(chan *channeldb.ChannelSplice, 1)
+	req.err = make(chan error, 1)
+
+	dbChan, err := s.fetchOpenChannel(req.chanPoint)
+	if err != nil {
+		req.err <- err
+		return req.splice, req.err
+	}
+
+	// The splice is negotiated by the peer the channel is with, so it
+	// needs to be online.
+	peer, err := s.FindPeer(dbChan.IdentityPub)
+	if err != nil {
+		req.err <- err
+		return req.splice, req.err
+	}
+
+	// If the fee rate wasn't specified, then we'll use a default
+	// confirmation target.
+	if req.feePerKw == 0 {
+		feeRate, err := s.cc.feeEstimator.EstimateFeePerKW(6)
+		if err != nil {
+			req.err <- err
+			return req.splice, req.err
+		}
+		req.feePerKw = feeRate
+	}
+
+	peer.SpliceChannel(req)
+
+	return req.splice, req.err
+}
+
 // Peers returns a slice of all active peers.
 //
 // NOTE: This function is safe for concurrent access.
@@ -2769,6 +3194,31 @@ func (s *server) Peers() []*peer {
 	return peers
 }
 
+// scaleRemoteDelay scales the CSV delay we require the remote party to use
+// linearly from minDelay blocks for small channels, to maxDelay blocks for
+// channels of size maxFundingAmount. Larger channels also use maxDelay. The
+// scaled delay is clamped before it's narrowed to a uint16, as it exceeds the
+// range of a uint16 for large channels.
+func scaleRemoteDelay(chanAmt btcutil.Amount, minDelay,
+	maxDelay uint16) uint16 {
+
+	// Checking the size of the channel first also ensures the
+	// multiplication below can't overflow.
+	if chanAmt >= maxFundingAmount {
+		return maxDelay
+	}
+
+	delay := btcutil.Amount(maxDelay) * chanAmt / maxFundingAmount
+	switch {
+	case delay < btcutil.Amount(minDelay):
+		return minDelay
+	case delay > btcutil.Amount(maxDelay):
+		return maxDelay
+	default:
+		return uint16(delay)
+	}
+}
+
 // parseHexColor takes a hex string representation of a color in the
 // form "#RRGGBB", parses the hex color values, and returns a color.RGBA
 // struct of the same color.
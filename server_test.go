@@ -1,8 +1,13 @@
+//go:build !rpctest
 // +build !rpctest
 
 package main
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+)
 
 func TestParseHexColor(t *testing.T) {
 	empty := ""
@@ -34,3 +39,56 @@ func TestParseHexColor(t *testing.T) {
 		t.Fatalf("Color %s incorrectly parsed as %v", valid, color)
 	}
 }
+
+// TestScaleRemoteDelay tests that the remote delay is scaled according to the
+// size of the channel, and clamped to the allowed range, also for channels
+// above the 2^24 sat limit.
+func TestScaleRemoteDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		chanAmt btcutil.Amount
+		delay   uint16
+	}{
+		{
+			name:    "small channel",
+			chanAmt: 1000,
+			delay:   minBtcRemoteDelay,
+		},
+		{
+			name:    "half max funding amount",
+			chanAmt: (maxFundingAmount + 1) / 2,
+			delay:   maxBtcRemoteDelay / 2,
+		},
+		{
+			name:    "max funding amount",
+			chanAmt: maxFundingAmount,
+			delay:   maxBtcRemoteDelay,
+		},
+		{
+			// Without clamping before narrowing the delay to a
+			// uint16, the scaled delay of this channel would wrap
+			// around to less than minBtcRemoteDelay blocks.
+			name: "large channel",
+			chanAmt: (1<<16 + 100) * maxFundingAmount /
+				btcutil.Amount(maxBtcRemoteDelay),
+			delay: maxBtcRemoteDelay,
+		},
+		{
+			name:    "all bitcoin",
+			chanAmt: btcutil.MaxSatoshi,
+			delay:   maxBtcRemoteDelay,
+		},
+	}
+
+	for _, test := range tests {
+		delay := scaleRemoteDelay(
+			test.chanAmt, minBtcRemoteDelay, maxBtcRemoteDelay,
+		)
+		if delay != test.delay {
+			t.Fatalf("%s: expected delay %v, got %v", test.name,
+				test.delay, delay)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
+	"golang.org/x/net/context"
+)
+
+// signerRPCServer implements the Signer gRPC service, which allows tools built
+// on top of lnd to sign with the keys of the node.
+type signerRPCServer struct {
+	// rawSigner generates signatures and input scripts exactly as they're
+	// generated for a watch-only node by the RemoteSigner service.
+	rawSigner *remotesigner.Server
+
+	keyRing keychain.SecretKeyRing
+}
+
+// A compile time check to ensure that signerRPCServer fully implements the
+// SignerServer gRPC service.
+var _ lnrpc.SignerServer = (*signerRPCServer)(nil)
+
+// newSignerRPCServer creates a new Signer service backed by the signer and key
+// ring of the passed chain control.
+func newSignerRPCServer(cc *chainControl) *signerRPCServer {
+	return &signerRPCServer{
+		rawSigner: remotesigner.NewServer(
+			cc.signer, cc.msgSigner, cc.wallet, nil, nil,
+		),
+		keyRing: cc.wallet,
+	}
+}
+
+// SignOutputRaw generates a signature for each of the passed sign
+// descriptors.
+func (s *signerRPCServer) SignOutputRaw(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.SignResp, error) {
+
+	return s.rawSigner.SignOutputRaw(ctx, in)
+}
+
+// ComputeInputScript generates a complete input script for each of the passed
+// sign descriptors.
+func (s *signerRPCServer) ComputeInputScript(ctx context.Context,
+	in *lnrpc.SignReq) (*lnrpc.InputScriptResp, error) {
+
+	return s.rawSigner.ComputeInputScript(ctx, in)
+}
+
+// DeriveSharedKey performs an ECDH operation between the node key, or the key
+// described by the passed key descriptor, and the passed ephemeral public key.
+func (s *signerRPCServer) DeriveSharedKey(ctx context.Context,
+	in *lnrpc.SharedKeyRequest) (*lnrpc.SharedKeyResponse, error) {
+
+	ephemeralKey, err := btcec.ParsePubKey(in.EphemeralPubkey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ephemeral public key: %v", err)
+	}
+
+	keyDesc := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyNodeKey,
+			Index:  0,
+		},
+	}
+	if in.KeyDesc != nil {
+		keyDesc, err = remotesigner.UnmarshalKeyDescriptor(in.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sharedKey, err := s.keyRing.ScalarMult(keyDesc, ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SharedKeyResponse{
+		SharedKey: sharedKey,
+	}, nil
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"golang.org/x/net/context"
+)
+
+// newTestRPCChainControl returns a chain control backed by mocks that sign
+// and derive all keys using the passed private key.
+func newTestRPCChainControl(t *testing.T,
+	privKey *btcec.PrivateKey) (*chainControl, *mockWalletController) {
+
+	wc := &mockWalletController{
+		rootKey:               privKey,
+		publishedTransactions: make(chan *wire.MsgTx, 1),
+	}
+	feeEstimator := lnwallet.StaticFeeEstimator{FeePerKW: 12500}
+	wallet, err := lnwallet.NewLightningWallet(lnwallet.Config{
+		SecretKeyRing:    &mockSecretKeyRing{rootKey: privKey},
+		WalletController: wc,
+		Signer:           &mockSigner{key: privKey},
+		FeeEstimator:     feeEstimator,
+		NetParams:        *activeNetParams.Params,
+	})
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	return &chainControl{
+		feeEstimator: feeEstimator,
+		signer:       &mockSigner{key: privKey},
+		wallet:       wallet,
+	}, wc
+}
+
+// TestSignerRPCServer tests that the Signer service signs with the keys of the
+// node, and rejects malformed requests.
+func TestSignerRPCServer(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	cc, _ := newTestRPCChainControl(t, privKey)
+	server := newSignerRPCServer(cc)
+	ctx := context.Background()
+
+	// We'll spend a p2wkh output of the node key, which also serves as
+	// the witness script of the raw signature.
+	pubKeyHash := btcutil.Hash160(privKey.PubKey().SerializeCompressed())
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pubKeyHash).Script()
+	if err != nil {
+		t.Fatalf("unable to create pkscript: %v", err)
+	}
+	witnessScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(pubKeyHash).AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	if err != nil {
+		t.Fatalf("unable to create witness script: %v", err)
+	}
+
+	const amt = 100000
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: amt - 1000, PkScript: pkScript})
+
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	signDesc := &lnrpc.SignDescriptor{
+		KeyDesc: &lnrpc.KeyDescriptor{
+			RawKeyBytes: privKey.PubKey().SerializeCompressed(),
+		},
+		WitnessScript: witnessScript,
+		Output: &lnrpc.TxOut{
+			Value:    amt,
+			PkScript: pkScript,
+		},
+		Sighash: uint32(txscript.SigHashAll),
+	}
+
+	signResp, err := server.SignOutputRaw(ctx, &lnrpc.SignReq{
+		RawTxBytes: rawTx.Bytes(),
+		SignDescs:  []*lnrpc.SignDescriptor{signDesc},
+	})
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	if len(signResp.RawSigs) != 1 {
+		t.Fatalf("expected 1 signature, got %v",
+			len(signResp.RawSigs))
+	}
+	sig, err := btcec.ParseDERSignature(signResp.RawSigs[0], btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, txscript.NewTxSigHashes(tx), txscript.SigHashAll,
+		tx, 0, amt,
+	)
+	if err != nil {
+		t.Fatalf("unable to compute sighash: %v", err)
+	}
+	if !sig.Verify(sigHash, privKey.PubKey()) {
+		t.Fatalf("invalid signature")
+	}
+
+	// The input script must be a valid spend of the p2wkh output.
+	scriptResp, err := server.ComputeInputScript(ctx, &lnrpc.SignReq{
+		RawTxBytes: rawTx.Bytes(),
+		SignDescs:  []*lnrpc.SignDescriptor{signDesc},
+	})
+	if err != nil {
+		t.Fatalf("unable to compute input script: %v", err)
+	}
+	if len(scriptResp.InputScripts) != 1 {
+		t.Fatalf("expected 1 input script, got %v",
+			len(scriptResp.InputScripts))
+	}
+	tx.TxIn[0].Witness = scriptResp.InputScripts[0].Witness
+	vm, err := txscript.NewEngine(
+		pkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx), amt,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("invalid input script: %v", err)
+	}
+
+	// Sign descriptors without a key, without an output or with an input
+	// index outside of the transaction must be rejected.
+	invalidDescs := []*lnrpc.SignDescriptor{
+		{
+			WitnessScript: witnessScript,
+			Output:        signDesc.Output,
+		},
+		{
+			KeyDesc:       signDesc.KeyDesc,
+			WitnessScript: witnessScript,
+		},
+		{
+			KeyDesc:       signDesc.KeyDesc,
+			WitnessScript: witnessScript,
+			Output:        signDesc.Output,
+			InputIndex:    1,
+		},
+	}
+	for i, desc := range invalidDescs {
+		_, err := server.SignOutputRaw(ctx, &lnrpc.SignReq{
+			RawTxBytes: rawTx.Bytes(),
+			SignDescs:  []*lnrpc.SignDescriptor{desc},
+		})
+		if err == nil {
+			t.Fatalf("expected invalid sign descriptor %v to be "+
+				"rejected", i)
+		}
+	}
+	_, err = server.SignOutputRaw(ctx, &lnrpc.SignReq{
+		RawTxBytes: []byte{0x01},
+		SignDescs:  []*lnrpc.SignDescriptor{signDesc},
+	})
+	if err == nil {
+		t.Fatalf("expected invalid transaction to be rejected")
+	}
+
+	// The shared key is derived with the node key by default, or with the
+	// key of the passed key descriptor.
+	ephemeral, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	ephemeralKey := ephemeral.PubKey().SerializeCompressed()
+	keyRing := &mockSecretKeyRing{rootKey: privKey}
+	expectedKey, _ := keyRing.ScalarMult(
+		keychain.KeyDescriptor{}, ephemeral.PubKey(),
+	)
+	sharedReqs := []*lnrpc.SharedKeyRequest{
+		{
+			EphemeralPubkey: ephemeralKey,
+		},
+		{
+			EphemeralPubkey: ephemeralKey,
+			KeyDesc: &lnrpc.KeyDescriptor{
+				KeyLoc: &lnrpc.KeyLocator{
+					KeyFamily: uint32(
+						keychain.KeyFamilyNodeKey,
+					),
+					KeyIndex: 1,
+				},
+			},
+		},
+	}
+	for i, req := range sharedReqs {
+		resp, err := server.DeriveSharedKey(ctx, req)
+		if err != nil {
+			t.Fatalf("unable to derive shared key %v: %v", i, err)
+		}
+		if !bytes.Equal(resp.SharedKey, expectedKey) {
+			t.Fatalf("shared key %v mismatch", i)
+		}
+	}
+
+	_, err = server.DeriveSharedKey(ctx, &lnrpc.SharedKeyRequest{
+		EphemeralPubkey: []byte{0x02},
+	})
+	if err == nil {
+		t.Fatalf("expected invalid ephemeral key to be rejected")
+	}
+	_, err = server.DeriveSharedKey(ctx, &lnrpc.SharedKeyRequest{
+		EphemeralPubkey: ephemeralKey,
+		KeyDesc:         &lnrpc.KeyDescriptor{},
+	})
+	if err == nil {
+		t.Fatalf("expected empty key descriptor to be rejected")
+	}
+}
@@ -0,0 +1,287 @@
+package sweep
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// mockSigner is a signer that produces empty signatures. The sweeper tests
+// don't verify witnesses, so there's no need for real keys.
+type mockSigner struct{}
+
+func (m *mockSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	return []byte{}, nil
+}
+
+func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{}, nil
+}
+
+// mockFeeEstimator returns the fee rate configured for a confirmation target,
+// or the default rate for targets that haven't been configured.
+type mockFeeEstimator struct {
+	feePerKW lnwallet.SatPerKWeight
+
+	blocksToFee map[uint32]lnwallet.SatPerKWeight
+
+	lock sync.Mutex
+}
+
+func newMockFeeEstimator(
+	feePerKW lnwallet.SatPerKWeight) *mockFeeEstimator {
+
+	return &mockFeeEstimator{
+		feePerKW:    feePerKW,
+		blocksToFee: make(map[uint32]lnwallet.SatPerKWeight),
+	}
+}
+
+func (e *mockFeeEstimator) updateFees(numBlocks uint32,
+	feePerKW lnwallet.SatPerKWeight) {
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.blocksToFee[numBlocks] = feePerKW
+}
+
+func (e *mockFeeEstimator) EstimateFeePerKW(numBlocks uint32) (
+	lnwallet.SatPerKWeight, error) {
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if fee, ok := e.blocksToFee[numBlocks]; ok {
+		return fee, nil
+	}
+
+	return e.feePerKW, nil
+}
+
+func (e *mockFeeEstimator) Start() error {
+	return nil
+}
+
+func (e *mockFeeEstimator) Stop() error {
+	return nil
+}
+
+// mockNotifier delivers spend notifications and block epochs on command of
+// the test.
+type mockNotifier struct {
+	spendChan map[wire.OutPoint][]chan *chainntnfs.SpendDetail
+	epochChan chan *chainntnfs.BlockEpoch
+	lock      sync.Mutex
+	t         *testing.T
+}
+
+func newMockNotifier(t *testing.T) *mockNotifier {
+	return &mockNotifier{
+		spendChan: make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
+		epochChan: make(chan *chainntnfs.BlockEpoch),
+		t:         t,
+	}
+}
+
+// notifyEpoch delivers a new block at the given height.
+func (m *mockNotifier) notifyEpoch(height int32) {
+	m.epochChan <- &chainntnfs.BlockEpoch{
+		Height: height,
+	}
+}
+
+// spendOutpoints notifies the spend of all of the inputs of the given tx.
+func (m *mockNotifier) spendOutpoints(tx *wire.MsgTx) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	txHash := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		for _, channel := range m.spendChan[outpoint] {
+			channel <- &chainntnfs.SpendDetail{
+				SpentOutPoint:     &outpoint,
+				SpendingTx:        tx,
+				SpenderTxHash:     &txHash,
+				SpenderInputIndex: uint32(i),
+			}
+		}
+	}
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs uint32,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochChan,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	channel := make(chan *chainntnfs.SpendDetail, 1)
+	m.spendChan[*outpoint] = append(m.spendChan[*outpoint], channel)
+
+	return &chainntnfs.SpendEvent{
+		Spend: channel,
+		Cancel: func() {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			channels := m.spendChan[*outpoint]
+			for i, c := range channels {
+				if c == channel {
+					m.spendChan[*outpoint] = append(
+						channels[:i], channels[i+1:]...,
+					)
+					break
+				}
+			}
+		},
+	}, nil
+}
+
+// mockChainIO reports a fixed best block height.
+type mockChainIO struct {
+	height int32
+}
+
+func (m *mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return nil, m.height, nil
+}
+
+func (m *mockChainIO) GetUtxo(op *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (*wire.TxOut, error) {
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash,
+	error) {
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+// mockSweeperStore is an in-memory implementation of the SweeperStore
+// interface.
+type mockSweeperStore struct {
+	ourTxes      map[chainhash.Hash]struct{}
+	publishedTxs map[chainhash.Hash]*PublishedTx
+	lock         sync.Mutex
+}
+
+func newMockSweeperStore() *mockSweeperStore {
+	return &mockSweeperStore{
+		ourTxes:      make(map[chainhash.Hash]struct{}),
+		publishedTxs: make(map[chainhash.Hash]*PublishedTx),
+	}
+}
+
+func (s *mockSweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.ourTxes[hash]
+	return ok, nil
+}
+
+func (s *mockSweeperStore) NotifyPublishTx(tx *PublishedTx) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hash := tx.Tx.TxHash()
+	s.ourTxes[hash] = struct{}{}
+	s.publishedTxs[hash] = tx
+
+	return nil
+}
+
+func (s *mockSweeperStore) RemovePublishedTx(hash chainhash.Hash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.publishedTxs, hash)
+
+	return nil
+}
+
+func (s *mockSweeperStore) FetchPublishedTxs() ([]*PublishedTx, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	publishedTxs := make([]*PublishedTx, 0, len(s.publishedTxs))
+	for _, tx := range s.publishedTxs {
+		publishedTxs = append(publishedTxs, tx)
+	}
+
+	return publishedTxs, nil
+}
+
+// testInput is a sweep input whose witness is left empty, so that the tests
+// don't depend on actual scripts and keys.
+type testInput struct {
+	BaseInput
+
+	blocksToMaturity uint32
+	lockTime         uint32
+}
+
+func (i *testInput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
+	hashCache *txscript.TxSigHashes, txinIdx int) ([][]byte, error) {
+
+	return [][]byte{{}}, nil
+}
+
+func (i *testInput) BlocksToMaturity() uint32 {
+	return i.blocksToMaturity
+}
+
+func (i *testInput) RequiredLockTime() (uint32, bool) {
+	return i.lockTime, i.lockTime != 0
+}
+
+// Compile-time constraints to ensure the mocks implement the interfaces used
+// by the sweeper.
+var _ lnwallet.Signer = (*mockSigner)(nil)
+var _ lnwallet.FeeEstimator = (*mockFeeEstimator)(nil)
+var _ chainntnfs.ChainNotifier = (*mockNotifier)(nil)
+var _ lnwallet.BlockChainIO = (*mockChainIO)(nil)
+var _ SweeperStore = (*mockSweeperStore)(nil)
+var _ Input = (*testInput)(nil)
@@ -0,0 +1,248 @@
+package sweep
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// sweeperStoreBucket is the top-level bucket of the sweeper store.
+	// Within it, a sub-bucket exists for each chain the sweeper operates
+	// on.
+	sweeperStoreBucket = []byte("sweeper-store")
+
+	// txHashesBucketKey is the key of the sub-bucket that contains the
+	// hashes of all transactions the sweeper has ever attempted to
+	// publish. It allows the sweeper to recognize its own transactions
+	// after a restart.
+	txHashesBucketKey = []byte("tx-hashes")
+
+	// publishedTxBucketKey is the key of the sub-bucket that contains the
+	// sweep transactions that have been published, but haven't yet been
+	// confirmed or replaced. These are rebroadcast after a restart.
+	publishedTxBucketKey = []byte("published-txes")
+
+	// byteOrder is the byte order used to serialize integers within the
+	// store.
+	byteOrder = binary.BigEndian
+
+	// errNoChainBucket is returned when the chain bucket of the store
+	// hasn't been created.
+	errNoChainBucket = errors.New("sweeper store chain bucket not found")
+)
+
+// PublishedTx is a sweep transaction that has been published by the sweeper,
+// along with the fee rate it was created at.
+type PublishedTx struct {
+	// Tx is the fully signed sweep transaction.
+	Tx *wire.MsgTx
+
+	// FeeRate is the fee rate the transaction pays. It is used to
+	// determine the minimum fee rate of any replacement.
+	FeeRate lnwallet.SatPerKWeight
+}
+
+// SweeperStore stores published txes.
+type SweeperStore interface {
+	// IsOurTx determines whether a tx is published by us, based on its
+	// hash.
+	IsOurTx(hash chainhash.Hash) (bool, error)
+
+	// NotifyPublishTx signals that we are about to publish a tx. The tx
+	// will be reported by FetchPublishedTxs until it is removed.
+	NotifyPublishTx(tx *PublishedTx) error
+
+	// RemovePublishedTx removes a tx from the set of published txes, as
+	// it has either been confirmed or replaced. The hash of the tx is
+	// still recognized by IsOurTx.
+	RemovePublishedTx(hash chainhash.Hash) error
+
+	// FetchPublishedTxs returns all txes that have been published, but
+	// not yet removed.
+	FetchPublishedTxs() ([]*PublishedTx, error)
+}
+
+// sweeperStore is a bolt backed implementation of the SweeperStore
+// interface.
+type sweeperStore struct {
+	db        *channeldb.DB
+	chainHash chainhash.Hash
+}
+
+// NewSweeperStore returns a new store instance for the given chain.
+func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
+	SweeperStore, error) {
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			sweeperStoreBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		chainBucket, err := rootBucket.CreateBucketIfNotExists(
+			chainHash[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = chainBucket.CreateBucketIfNotExists(txHashesBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = chainBucket.CreateBucketIfNotExists(
+			publishedTxBucketKey,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sweeperStore{
+		db:        db,
+		chainHash: *chainHash,
+	}, nil
+}
+
+// chainBucket returns the bucket of the store that holds the state for our
+// chain.
+func (s *sweeperStore) chainBucket(tx *bolt.Tx) (*bolt.Bucket, error) {
+	rootBucket := tx.Bucket(sweeperStoreBucket)
+	if rootBucket == nil {
+		return nil, errNoChainBucket
+	}
+
+	chainBucket := rootBucket.Bucket(s.chainHash[:])
+	if chainBucket == nil {
+		return nil, errNoChainBucket
+	}
+
+	return chainBucket, nil
+}
+
+// IsOurTx determines whether a tx is published by us, based on its hash.
+//
+// NOTE: Part of the SweeperStore interface.
+func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
+	var ours bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		chainBucket, err := s.chainBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		txHashesBucket := chainBucket.Bucket(txHashesBucketKey)
+		ours = txHashesBucket.Get(hash[:]) != nil
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ours, nil
+}
+
+// NotifyPublishTx signals that we are about to publish a tx.
+//
+// NOTE: Part of the SweeperStore interface.
+func (s *sweeperStore) NotifyPublishTx(publishedTx *PublishedTx) error {
+	var b bytes.Buffer
+	var scratch [8]byte
+	byteOrder.PutUint64(scratch[:], uint64(publishedTx.FeeRate))
+	if _, err := b.Write(scratch[:]); err != nil {
+		return err
+	}
+	if err := publishedTx.Tx.Serialize(&b); err != nil {
+		return err
+	}
+
+	hash := publishedTx.Tx.TxHash()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		chainBucket, err := s.chainBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		txHashesBucket := chainBucket.Bucket(txHashesBucketKey)
+		if err := txHashesBucket.Put(hash[:], []byte{}); err != nil {
+			return err
+		}
+
+		publishedTxBucket := chainBucket.Bucket(publishedTxBucketKey)
+		return publishedTxBucket.Put(hash[:], b.Bytes())
+	})
+}
+
+// RemovePublishedTx removes a tx from the set of published txes.
+//
+// NOTE: Part of the SweeperStore interface.
+func (s *sweeperStore) RemovePublishedTx(hash chainhash.Hash) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		chainBucket, err := s.chainBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		publishedTxBucket := chainBucket.Bucket(publishedTxBucketKey)
+		return publishedTxBucket.Delete(hash[:])
+	})
+}
+
+// FetchPublishedTxs returns all txes that have been published, but not yet
+// removed.
+//
+// NOTE: Part of the SweeperStore interface.
+func (s *sweeperStore) FetchPublishedTxs() ([]*PublishedTx, error) {
+	var publishedTxs []*PublishedTx
+	err := s.db.View(func(tx *bolt.Tx) error {
+		chainBucket, err := s.chainBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		publishedTxBucket := chainBucket.Bucket(publishedTxBucketKey)
+		return publishedTxBucket.ForEach(func(_, v []byte) error {
+			if len(v) < 8 {
+				return errors.New("invalid published tx entry")
+			}
+
+			feeRate := lnwallet.SatPerKWeight(
+				byteOrder.Uint64(v[:8]),
+			)
+
+			sweepTx := &wire.MsgTx{}
+			err := sweepTx.Deserialize(bytes.NewReader(v[8:]))
+			if err != nil {
+				return err
+			}
+
+			publishedTxs = append(publishedTxs, &PublishedTx{
+				Tx:      sweepTx,
+				FeeRate: feeRate,
+			})
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return publishedTxs, nil
+}
+
+// Compile-time constraint to ensure sweeperStore implements SweeperStore.
+var _ SweeperStore = (*sweeperStore)(nil)
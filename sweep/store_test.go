@@ -0,0 +1,183 @@
+package sweep
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// makeTestDB creates a new instance of the ChannelDB for testing purposes. A
+// callback which cleans up the created temporary directories is also
+// returned and intended to be executed after the test completes.
+func makeTestDB() (*channeldb.DB, func(), error) {
+	// First, create a temporary directory to be used for the duration of
+	// this test.
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Next, create channeldb for the first time.
+	cdb, err := channeldb.Open(tempDirName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanUp := func() {
+		cdb.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	return cdb, cleanUp, nil
+}
+
+// TestStore asserts that the sweeper store persists published txes, and
+// keeps recognizing them as ours after they have been removed.
+func TestStore(t *testing.T) {
+	t.Run("bolt", func(t *testing.T) {
+		cdb, cleanUp, err := makeTestDB()
+		if err != nil {
+			t.Fatalf("unable to open channel db: %v", err)
+		}
+		defer cleanUp()
+
+		testStore(t, func() (SweeperStore, error) {
+			var chain chainhash.Hash
+			return NewSweeperStore(cdb, &chain)
+		})
+	})
+	t.Run("mock", func(t *testing.T) {
+		store := newMockSweeperStore()
+
+		testStore(t, func() (SweeperStore, error) {
+			return store, nil
+		})
+	})
+}
+
+func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
+	store, err := createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Initially we expect the store not to have any published txes.
+	publishedTxs, err := store.FetchPublishedTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(publishedTxs) != 0 {
+		t.Fatalf("expected no published txes, got %v",
+			len(publishedTxs))
+	}
+
+	tx1 := wire.MsgTx{}
+	tx1.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Index: 1,
+		},
+	})
+	tx1.AddTxOut(&wire.TxOut{
+		Value: 1000,
+	})
+
+	tx2 := wire.MsgTx{}
+	tx2.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Index: 2,
+		},
+	})
+	tx2.AddTxOut(&wire.TxOut{
+		Value: 2000,
+	})
+
+	err = store.NotifyPublishTx(&PublishedTx{
+		Tx:      &tx1,
+		FeeRate: lnwallet.SatPerKWeight(1000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.NotifyPublishTx(&PublishedTx{
+		Tx:      &tx2,
+		FeeRate: lnwallet.SatPerKWeight(2000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Recreate the sweeper store, to assert that the txes survive a
+	// restart.
+	store, err = createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publishedTxs, err = store.FetchPublishedTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(publishedTxs) != 2 {
+		t.Fatalf("expected 2 published txes, got %v",
+			len(publishedTxs))
+	}
+	for _, publishedTx := range publishedTxs {
+		switch publishedTx.Tx.TxHash() {
+		case tx1.TxHash():
+			if publishedTx.FeeRate != 1000 {
+				t.Fatalf("unexpected fee rate for tx1: %v",
+					publishedTx.FeeRate)
+			}
+
+		case tx2.TxHash():
+			if publishedTx.FeeRate != 2000 {
+				t.Fatalf("unexpected fee rate for tx2: %v",
+					publishedTx.FeeRate)
+			}
+
+		default:
+			t.Fatalf("unexpected published tx %v",
+				publishedTx.Tx.TxHash())
+		}
+	}
+
+	// Remove the first tx, it should no longer be returned, but still be
+	// recognized as ours.
+	if err := store.RemovePublishedTx(tx1.TxHash()); err != nil {
+		t.Fatal(err)
+	}
+
+	publishedTxs, err = store.FetchPublishedTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(publishedTxs) != 1 ||
+		publishedTxs[0].Tx.TxHash() != tx2.TxHash() {
+
+		t.Fatalf("expected only tx2 to be returned")
+	}
+
+	for _, hash := range []chainhash.Hash{tx1.TxHash(), tx2.TxHash()} {
+		ours, err := store.IsOurTx(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ours {
+			t.Fatalf("expected tx %v to be ours", hash)
+		}
+	}
+
+	// An unknown hash should not be recognized as ours.
+	ours, err := store.IsOurTx(chainhash.Hash{0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ours {
+		t.Fatal("expected unknown tx not to be ours")
+	}
+}
@@ -0,0 +1,1052 @@
+package sweep
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// ErrRemoteSpend is returned in case an output that we try to sweep is
+	// confirmed in a tx of the remote party.
+	ErrRemoteSpend = errors.New("remote party swept utxo")
+
+	// ErrTooManyAttempts is returned in case sweeping an output has failed
+	// for the configured max number of attempts.
+	ErrTooManyAttempts = errors.New("sweep failed after max attempts")
+
+	// ErrSweeperShuttingDown is returned when a sweep request is made
+	// while the sweeper is shutting down.
+	ErrSweeperShuttingDown = errors.New("utxo sweeper shutting down")
+)
+
+const (
+	// DefaultBatchWindowDuration specifies the default duration that the
+	// sweeper waits for more inputs to arrive before sweeping the ones it
+	// has collected, allowing them to be batched into a single
+	// transaction.
+	DefaultBatchWindowDuration = 30 * time.Second
+
+	// DefaultConfTarget is the confirmation target used for inputs that
+	// haven't been given a deadline.
+	DefaultConfTarget = 6
+
+	// DefaultMaxInputsPerTx specifies the default maximum number of
+	// inputs allowed in a single sweep tx. If more need to be swept,
+	// multiple txes are created and published.
+	DefaultMaxInputsPerTx = 100
+
+	// DefaultMaxSweepAttempts specifies the default maximum number of
+	// times an input is included in a publish attempt before giving up
+	// and returning an error to the caller.
+	DefaultMaxSweepAttempts = 10
+
+	// FeeRateBucketSize is the width of the fee rate buckets that inputs
+	// are grouped into. Inputs whose desired fee rates fall within the
+	// same bucket are considered compatible, and are swept within the
+	// same transaction. This corresponds to 10 sat/vbyte.
+	FeeRateBucketSize = lnwallet.SatPerKWeight(2500)
+
+	// relayFeeIncrement is the minimum amount by which the fee rate of a
+	// replacement transaction must exceed the fee rate of the transaction
+	// it replaces, in order to be accepted by the network.
+	relayFeeIncrement = lnwallet.FeePerKwFloor
+)
+
+// Result is the struct that is pushed through the result channel. Callers
+// can use this to be informed of the final sweep result. In case of a remote
+// spend, Err will be ErrRemoteSpend.
+type Result struct {
+	// Err is the final result of the sweep. It is nil when the input is
+	// swept successfully by us. ErrRemoteSpend is returned when another
+	// party took the input.
+	Err error
+
+	// Tx is the transaction that spent the input.
+	Tx *wire.MsgTx
+}
+
+// UtxoSweeperConfig contains dependencies of UtxoSweeper.
+type UtxoSweeperConfig struct {
+	// GenSweepScript generates a P2WKH script belonging to the wallet where
+	// funds can be swept.
+	GenSweepScript func() ([]byte, error)
+
+	// FeeEstimator is used when crafting sweep transactions to estimate
+	// the necessary fee relative to the expected size of the sweep
+	// transaction.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// PublishTransaction facilitates the process of broadcasting a signed
+	// transaction to the appropriate network, labeling it with the passed
+	// label.
+	PublishTransaction func(*wire.MsgTx, string) error
+
+	// NewBatchTimer creates a channel that will be sent on when a certain
+	// time window has passed. During this time window, new inputs can
+	// still be added to the sweep tx that is about to be generated.
+	NewBatchTimer func() <-chan time.Time
+
+	// Notifier is an instance of a chain notifier we'll use to watch for
+	// certain on-chain events.
+	Notifier chainntnfs.ChainNotifier
+
+	// ChainIO is used to determine the current block height.
+	ChainIO lnwallet.BlockChainIO
+
+	// Store stores the published sweeper txes.
+	Store SweeperStore
+
+	// Signer is used by the sweeper to generate valid witnesses for the
+	// inputs of the sweep txes.
+	Signer lnwallet.Signer
+
+	// MaxInputsPerTx specifies the maximum number of inputs allowed in a
+	// single sweep tx. If more need to be swept, multiple txes are created
+	// and published.
+	MaxInputsPerTx int
+
+	// MaxSweepAttempts specifies the maximum number of times an input is
+	// included in a publish attempt before giving up and returning an
+	// error to the caller.
+	MaxSweepAttempts int
+}
+
+// pendingInput is created when an input reaches the main loop for the first
+// time. It tracks all relevant state that is needed for sweeping.
+type pendingInput struct {
+	// listeners is a list of channels over which the final outcome of the
+	// sweep needs to be broadcasted.
+	listeners []chan Result
+
+	// input is the original struct that contains the input and sign
+	// descriptor.
+	input Input
+
+	// deadline is the height by which the input should be confirmed. A
+	// value of zero means that the input isn't time sensitive.
+	deadline uint32
+
+	// feeRate is the fee rate that was explicitly requested for the
+	// input. It serves as a lower bound for the fee rate derived from the
+	// deadline. A value of zero means that no fee rate was requested.
+	feeRate lnwallet.SatPerKWeight
+
+	// ntfnRegCancel is populated with a function that cancels the chain
+	// notifier spend registration.
+	ntfnRegCancel func()
+
+	// publishAttempts records the number of attempts that have already
+	// been made to sweep this input.
+	publishAttempts int
+
+	// sweepTx is the hash of the published sweep tx that currently spends
+	// this input. It is nil if the input hasn't been swept yet, or if the
+	// sweep tx it was part of has been invalidated.
+	sweepTx *chainhash.Hash
+}
+
+// sweepInputMessage structs are used in the internal channel between the
+// SweepInput call and the sweeper main loop.
+type sweepInputMessage struct {
+	input      Input
+	deadline   uint32
+	feeRate    lnwallet.SatPerKWeight
+	resultChan chan Result
+}
+
+// UtxoSweeper is responsible for sweeping outputs back into the wallet.
+// Inputs handed to the sweeper are batched with other inputs of a similar
+// desired fee rate, and the resulting transactions are rebroadcast and, as the
+// deadlines of their inputs draw near, replaced by versions paying a higher
+// fee.
+type UtxoSweeper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *UtxoSweeperConfig
+
+	newInputs chan *sweepInputMessage
+	spendChan chan *chainntnfs.SpendDetail
+
+	// pendingInputs is the total set of inputs the UtxoSweeper has been
+	// requested to sweep.
+	pendingInputs map[wire.OutPoint]*pendingInput
+
+	// publishedTxs is the set of sweep txes that we've published, but
+	// which haven't yet been confirmed or replaced.
+	publishedTxs map[chainhash.Hash]*PublishedTx
+
+	// timer is the channel that signals expiry of the sweep batch timer.
+	timer <-chan time.Time
+
+	currentHeight int32
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a new Sweeper instance.
+func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
+	return &UtxoSweeper{
+		cfg:           cfg,
+		newInputs:     make(chan *sweepInputMessage),
+		spendChan:     make(chan *chainntnfs.SpendDetail),
+		pendingInputs: make(map[wire.OutPoint]*pendingInput),
+		publishedTxs:  make(map[chainhash.Hash]*PublishedTx),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start starts the process of constructing and publishing sweep txes.
+func (s *UtxoSweeper) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("Sweeper starting")
+
+	// Retrieve the sweep txes that we published before the restart, and
+	// rebroadcast them. Once the inputs they spend are offered to us
+	// again, we'll pick up tracking them where we left off.
+	publishedTxs, err := s.cfg.Store.FetchPublishedTxs()
+	if err != nil {
+		return fmt.Errorf("unable to fetch published txes: %v", err)
+	}
+	for _, publishedTx := range publishedTxs {
+		hash := publishedTx.Tx.TxHash()
+
+		log.Debugf("Publishing sweep tx %v from before restart", hash)
+
+		err := s.cfg.PublishTransaction(
+			publishedTx.Tx, lnwallet.SweepTxLabel,
+		)
+		if err == lnwallet.ErrDoubleSpend {
+			// The inputs of the tx have been spent in the meantime,
+			// so there's no point in tracking it any further.
+			log.Infof("Sweep tx %v conflicts with spent inputs, "+
+				"discarding", hash)
+
+			err := s.cfg.Store.RemovePublishedTx(hash)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			log.Warnf("Unable to publish sweep tx %v: %v", hash,
+				err)
+		}
+
+		s.publishedTxs[hash] = publishedTx
+	}
+
+	// Retrieve the current block height, which determines the lock time
+	// and fee rates of the txes we'll create.
+	_, s.currentHeight, err = s.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	// Register for block epochs to retry sweeping every block, and bump
+	// fees as deadlines draw near.
+	blockEpochs, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return fmt.Errorf("register block epoch ntfn: %v", err)
+	}
+
+	// Start sweeper main loop.
+	s.wg.Add(1)
+	go func() {
+		defer blockEpochs.Cancel()
+		defer s.wg.Done()
+
+		s.collector(blockEpochs.Epochs)
+	}()
+
+	return nil
+}
+
+// Stop stops sweeper from listening to block epochs and constructing sweep
+// txes.
+func (s *UtxoSweeper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Sweeper shutting down")
+
+	close(s.quit)
+	s.wg.Wait()
+
+	log.Debugf("Sweeper shut down")
+
+	return nil
+}
+
+// SweepInput sweeps inputs back into the wallet. The inputs will be batched
+// and swept after the batch time window ends. The deadline is the block
+// height by which the input should be confirmed. As the deadline approaches,
+// the fee of the sweep tx is bumped. A deadline of zero indicates that the
+// input isn't time sensitive, in which case the default confirmation target
+// is used.
+//
+// A channel is returned that will receive the sweep result. In case of a
+// remote spend, Err will be ErrRemoteSpend. Offering an input that is already
+// pending will register an additional result channel, and possibly tighten
+// its deadline.
+func (s *UtxoSweeper) SweepInput(input Input,
+	deadline uint32) (chan Result, error) {
+
+	return s.sweepInput(&sweepInputMessage{
+		input:    input,
+		deadline: deadline,
+	})
+}
+
+// SweepInputAtFeeRate sweeps an input back into the wallet, paying at least
+// the given fee rate. Unlike inputs offered through SweepInput, the fee of the
+// sweep tx isn't bumped over time, unless the input is also offered with a
+// deadline. This allows callers to spend an input in a child tx at a fee rate
+// of their choosing, for instance to speed up the confirmation of its parent.
+func (s *UtxoSweeper) SweepInputAtFeeRate(input Input,
+	feeRate lnwallet.SatPerKWeight) (chan Result, error) {
+
+	if feeRate < lnwallet.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %v sat/kw is below the "+
+			"floor of %v sat/kw", int64(feeRate),
+			int64(lnwallet.FeePerKwFloor))
+	}
+
+	return s.sweepInput(&sweepInputMessage{
+		input:   input,
+		feeRate: feeRate,
+	})
+}
+
+// sweepInput delivers a sweep request to the main loop, and returns the
+// channel that will receive the sweep result.
+func (s *UtxoSweeper) sweepInput(msg *sweepInputMessage) (chan Result,
+	error) {
+
+	input := msg.input
+	if input == nil || input.OutPoint() == nil || input.SignDesc() == nil {
+		return nil, errors.New("nil input received")
+	}
+
+	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
+		"time_lock=%v, deadline=%v, fee_rate=%v, amount=%v",
+		input.OutPoint(), input.WitnessType(),
+		input.BlocksToMaturity(), msg.deadline, int64(msg.feeRate),
+		input.SignDesc().Output.Value)
+
+	msg.resultChan = make(chan Result, 1)
+
+	// Deliver input to main event loop.
+	select {
+	case s.newInputs <- msg:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	return msg.resultChan, nil
+}
+
+// collector is the sweeper main loop. It processes new inputs, spend
+// notifications and counts down to publication of the sweep tx.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
+	for {
+		select {
+		// A new input is offered to the sweeper. We check to see if we
+		// are already trying to sweep this input and if not, set up a
+		// listener for spend and schedule a sweep.
+		case input := <-s.newInputs:
+			s.handleNewInput(input)
+
+		// A spend of one of our inputs is detected. Signal sweep
+		// results to the caller(s), and discard any of our sweep txes
+		// that can no longer confirm.
+		case spend := <-s.spendChan:
+			s.handleSpend(spend)
+
+		// The timer expires and we are going to (re)sweep.
+		case <-s.timer:
+			log.Debugf("Sweep timer expired")
+
+			s.timer = nil
+			s.sweepPendingInputs(false)
+
+		// A new block comes in. Rebroadcast our sweep txes, bump the
+		// fees of those whose inputs are getting close to their
+		// deadline, and retry any inputs that weren't swept yet.
+		case epoch, ok := <-blockEpochs:
+			if !ok {
+				return
+			}
+
+			s.currentHeight = epoch.Height
+
+			log.Debugf("New block: height=%v, num_pending_inputs=%v, "+
+				"num_published_txes=%v", epoch.Height,
+				len(s.pendingInputs), len(s.publishedTxs))
+
+			s.sweepPendingInputs(true)
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// handleNewInput adds a newly offered input to the set of pending inputs, or
+// registers an additional listener if the input is already pending.
+func (s *UtxoSweeper) handleNewInput(msg *sweepInputMessage) {
+	outpoint := *msg.input.OutPoint()
+
+	pendInput, pending := s.pendingInputs[outpoint]
+	if pending {
+		log.Debugf("Already pending input %v received", outpoint)
+
+		pendInput.listeners = append(
+			pendInput.listeners, msg.resultChan,
+		)
+
+		// If the new request comes with an earlier deadline, we'll
+		// adopt it, such that the fee rate is adjusted on the next
+		// sweep attempt.
+		if msg.deadline != 0 && (pendInput.deadline == 0 ||
+			msg.deadline < pendInput.deadline) {
+
+			pendInput.deadline = msg.deadline
+		}
+
+		// Likewise, a higher requested fee rate takes precedence.
+		if msg.feeRate > pendInput.feeRate {
+			pendInput.feeRate = msg.feeRate
+		}
+
+		return
+	}
+
+	// Create a new pendingInput and initialize the listeners slice with
+	// the passed in result channel. If this input is offered for sweep
+	// again, the result channel will be appended to this slice.
+	pendInput = &pendingInput{
+		listeners: []chan Result{msg.resultChan},
+		input:     msg.input,
+		deadline:  msg.deadline,
+		feeRate:   msg.feeRate,
+	}
+
+	// If the input is already spent by one of the sweep txes we
+	// published before a restart, we'll resume tracking that tx instead
+	// of creating a new one.
+	for hash, publishedTx := range s.publishedTxs {
+		for _, txIn := range publishedTx.Tx.TxIn {
+			if txIn.PreviousOutPoint != outpoint {
+				continue
+			}
+
+			sweepTx := hash
+			pendInput.sweepTx = &sweepTx
+		}
+	}
+
+	// Start watching for spend of this input, either by us or the remote
+	// party.
+	cancel, err := s.waitForSpend(
+		outpoint, msg.input.SignDesc().Output.PkScript,
+		msg.input.HeightHint(),
+	)
+	if err != nil {
+		err := fmt.Errorf("wait for spend: %v", err)
+		msg.resultChan <- Result{Err: err}
+		return
+	}
+	pendInput.ntfnRegCancel = cancel
+
+	s.pendingInputs[outpoint] = pendInput
+
+	// Start the batch timer, so that this input is swept along with any
+	// other inputs that arrive within the batch window.
+	s.scheduleSweep()
+}
+
+// handleSpend processes the spend of one of our pending inputs. All pending
+// inputs spent by the tx are signaled, and our own sweep txes that conflict
+// with it are discarded.
+func (s *UtxoSweeper) handleSpend(spend *chainntnfs.SpendDetail) {
+	spendHash := *spend.SpenderTxHash
+
+	// Determine whether the spending tx is one of ours. If it is, we'll
+	// report a successful sweep to the caller(s).
+	_, isOurTx := s.publishedTxs[spendHash]
+	if !isOurTx {
+		var err error
+		isOurTx, err = s.cfg.Store.IsOurTx(spendHash)
+		if err != nil {
+			log.Errorf("Unable to determine if tx %v is ours: %v",
+				spendHash, err)
+			return
+		}
+	}
+
+	log.Debugf("Detected spend related to in flight inputs "+
+		"(is_ours=%v): %v", isOurTx, newLogClosure(func() string {
+		return spew.Sdump(spend.SpendingTx)
+	}))
+
+	// Signal sweep results for inputs in this confirmed tx.
+	for _, txIn := range spend.SpendingTx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+
+		// Check if this input is known to us. It could probably be
+		// unknown if we canceled the registration, deleted from
+		// pendingInputs but the ntfn was in-flight already. Or this
+		// could be not one of our inputs.
+		_, ok := s.pendingInputs[outpoint]
+		if !ok {
+			continue
+		}
+
+		// Return either a nil or a remote spend result.
+		var err error
+		if !isOurTx {
+			err = ErrRemoteSpend
+		}
+
+		s.signalAndRemove(&outpoint, Result{
+			Tx:  spend.SpendingTx,
+			Err: err,
+		})
+	}
+
+	// Now that the spending tx is confirmed, any of our published txes
+	// that share an input with it can no longer confirm.
+	s.removeConflictingTxs(spend.SpendingTx)
+}
+
+// removeConflictingTxs discards all published sweep txes that spend any of
+// the inputs of the passed confirmed tx. This includes the tx itself, if it is
+// one of ours.
+func (s *UtxoSweeper) removeConflictingTxs(confirmedTx *wire.MsgTx) {
+	confirmedHash := confirmedTx.TxHash()
+
+	spent := make(map[wire.OutPoint]struct{}, len(confirmedTx.TxIn))
+	for _, txIn := range confirmedTx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	for hash, publishedTx := range s.publishedTxs {
+		var conflicts bool
+		for _, txIn := range publishedTx.Tx.TxIn {
+			if _, ok := spent[txIn.PreviousOutPoint]; ok {
+				conflicts = true
+				break
+			}
+		}
+		if !conflicts {
+			continue
+		}
+
+		if hash != confirmedHash {
+			log.Infof("Sweep tx %v conflicts with confirmed tx %v, "+
+				"discarding", hash, confirmedHash)
+		}
+
+		s.removePublishedTx(hash)
+	}
+}
+
+// removePublishedTx stops tracking the given published sweep tx. Any pending
+// inputs that were part of it are scheduled to be swept again.
+func (s *UtxoSweeper) removePublishedTx(hash chainhash.Hash) {
+	delete(s.publishedTxs, hash)
+
+	if err := s.cfg.Store.RemovePublishedTx(hash); err != nil {
+		log.Errorf("Unable to remove sweep tx %v from store: %v",
+			hash, err)
+	}
+
+	for _, pendInput := range s.pendingInputs {
+		if pendInput.sweepTx == nil || *pendInput.sweepTx != hash {
+			continue
+		}
+
+		pendInput.sweepTx = nil
+		s.scheduleSweep()
+	}
+}
+
+// signalAndRemove notifies the listeners of the final result of the input
+// sweep. It cancels any pending spend notification and removes the input from
+// the list of pending inputs. When this function returns, the sweeper has
+// completely forgotten about the input.
+func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
+	pendInput := s.pendingInputs[*outpoint]
+	listeners := pendInput.listeners
+
+	if result.Err == nil {
+		log.Debugf("Dispatching sweep success for %v to %v listeners",
+			outpoint, len(listeners),
+		)
+	} else {
+		log.Debugf("Dispatching sweep error for %v to %v listeners: %v",
+			outpoint, len(listeners), result.Err,
+		)
+	}
+
+	// Signal all listeners. Channel is buffered. Because we only send once
+	// on every channel, it should never block.
+	for _, resultChan := range listeners {
+		resultChan <- result
+	}
+
+	// Cancel spend notification with chain notifier. This is not necessary
+	// in case of a success, except for that a reorg could still happen.
+	if pendInput.ntfnRegCancel != nil {
+		log.Debugf("Canceling spend ntfn for %v", outpoint)
+
+		pendInput.ntfnRegCancel()
+	}
+
+	// Inputs are no longer pending after result has been sent.
+	delete(s.pendingInputs, *outpoint)
+}
+
+// scheduleSweep starts the sweep timer to create an opportunity for more
+// inputs to be added before the sweep tx is constructed.
+func (s *UtxoSweeper) scheduleSweep() {
+	// If the timer is already running, the inputs will be picked up when
+	// it expires.
+	if s.timer != nil {
+		return
+	}
+
+	log.Debugf("Starting sweep timer")
+
+	s.timer = s.cfg.NewBatchTimer()
+}
+
+// feeRateForInput returns the fee rate that the given input should be swept
+// at, given the current block height. The passed cache is used to avoid
+// querying the fee estimator for the same confirmation target more than once.
+func (s *UtxoSweeper) feeRateForInput(pendInput *pendingInput,
+	cache map[uint32]lnwallet.SatPerKWeight) (lnwallet.SatPerKWeight,
+	error) {
+
+	// Inputs that were offered at a specific fee rate, and without a
+	// deadline, are swept at exactly that rate.
+	if pendInput.feeRate != 0 && pendInput.deadline == 0 {
+		return pendInput.feeRate, nil
+	}
+
+	// Inputs without a deadline are swept using the default confirmation
+	// target. For the other inputs, we'll aim for confirmation within the
+	// number of blocks that remain until their deadline.
+	confTarget := uint32(DefaultConfTarget)
+	if pendInput.deadline != 0 {
+		confTarget = 1
+
+		currentHeight := uint32(s.currentHeight)
+		if pendInput.deadline > currentHeight {
+			confTarget = pendInput.deadline - currentHeight
+		}
+	}
+
+	feeRate, ok := cache[confTarget]
+	if !ok {
+		var err error
+		feeRate, err = s.cfg.FeeEstimator.EstimateFeePerKW(confTarget)
+		if err != nil {
+			return 0, err
+		}
+		if feeRate < lnwallet.FeePerKwFloor {
+			feeRate = lnwallet.FeePerKwFloor
+		}
+
+		cache[confTarget] = feeRate
+	}
+
+	// A fee rate that was requested explicitly serves as a lower bound.
+	if pendInput.feeRate > feeRate {
+		feeRate = pendInput.feeRate
+	}
+
+	return feeRate, nil
+}
+
+// sweepPendingInputs bumps the fees of any published sweep txes whose inputs
+// require a higher fee rate, and sweeps all inputs that aren't part of a
+// published tx yet. If rebroadcast is true, published txes that don't require
+// a fee bump are broadcast again.
+func (s *UtxoSweeper) sweepPendingInputs(rebroadcast bool) {
+	feeRates := make(map[uint32]lnwallet.SatPerKWeight)
+
+	// First, we'll revisit the txes we've already published. We take a
+	// snapshot of their hashes, as the set is modified while we bump
+	// fees.
+	publishedHashes := make([]chainhash.Hash, 0, len(s.publishedTxs))
+	for hash := range s.publishedTxs {
+		publishedHashes = append(publishedHashes, hash)
+	}
+	for _, hash := range publishedHashes {
+		s.bumpOrRebroadcast(hash, feeRates, rebroadcast)
+	}
+
+	// Next, we'll gather all inputs that aren't part of a published tx
+	// and can be spent at this height, and group them into buckets of
+	// compatible fee rates.
+	currentHeight := uint32(s.currentHeight)
+	buckets := make(map[lnwallet.SatPerKWeight][]*pendingInput)
+	bucketRates := make(map[lnwallet.SatPerKWeight]lnwallet.SatPerKWeight)
+	for _, pendInput := range s.pendingInputs {
+		if pendInput.sweepTx != nil {
+			continue
+		}
+
+		lockTime, ok := pendInput.input.RequiredLockTime()
+		if ok && lockTime > currentHeight {
+			log.Debugf("Input %v is locked until height=%v",
+				pendInput.input.OutPoint(), lockTime)
+			continue
+		}
+
+		feeRate, err := s.feeRateForInput(pendInput, feeRates)
+		if err != nil {
+			log.Errorf("Unable to estimate fee rate for %v: %v",
+				pendInput.input.OutPoint(), err)
+			continue
+		}
+
+		bucket := feeRate / FeeRateBucketSize
+		buckets[bucket] = append(buckets[bucket], pendInput)
+		if feeRate > bucketRates[bucket] {
+			bucketRates[bucket] = feeRate
+		}
+	}
+
+	// Finally, we'll sweep each of the buckets, using the highest fee
+	// rate requested by any of its inputs. We sort the inputs to make
+	// the constructed txes deterministic.
+	for bucket, inputs := range buckets {
+		sort.Slice(inputs, func(i, j int) bool {
+			return outPointLess(
+				inputs[i].input.OutPoint(),
+				inputs[j].input.OutPoint(),
+			)
+		})
+
+		for len(inputs) > 0 {
+			numInputs := len(inputs)
+			if numInputs > s.cfg.MaxInputsPerTx {
+				numInputs = s.cfg.MaxInputsPerTx
+			}
+
+			s.sweepInputs(inputs[:numInputs], bucketRates[bucket])
+			inputs = inputs[numInputs:]
+		}
+	}
+}
+
+// bumpOrRebroadcast replaces the given published tx by a version paying a
+// higher fee if any of its inputs requires so. Otherwise, the tx is
+// rebroadcast if requested.
+func (s *UtxoSweeper) bumpOrRebroadcast(hash chainhash.Hash,
+	feeRates map[uint32]lnwallet.SatPerKWeight, rebroadcast bool) {
+
+	publishedTx := s.publishedTxs[hash]
+
+	// Collect the inputs of the tx that we're still sweeping, and
+	// determine the highest fee rate any of them desires.
+	var (
+		inputs     []*pendingInput
+		targetRate lnwallet.SatPerKWeight
+	)
+	for _, txIn := range publishedTx.Tx.TxIn {
+		pendInput, ok := s.pendingInputs[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+		inputs = append(inputs, pendInput)
+
+		feeRate, err := s.feeRateForInput(pendInput, feeRates)
+		if err != nil {
+			log.Errorf("Unable to estimate fee rate for %v: %v",
+				pendInput.input.OutPoint(), err)
+			continue
+		}
+		if feeRate > targetRate {
+			targetRate = feeRate
+		}
+	}
+
+	// If the tx pays less than desired, we'll replace it with one paying
+	// the target fee rate. The replacement must increase the fee rate by
+	// at least the relay fee increment to be accepted.
+	if len(inputs) > 0 && targetRate > publishedTx.FeeRate {
+		minRate := publishedTx.FeeRate + relayFeeIncrement
+		if targetRate < minRate {
+			targetRate = minRate
+		}
+
+		err := s.replaceTx(hash, inputs, targetRate)
+		if err == nil {
+			return
+		}
+
+		log.Warnf("Unable to bump fee of sweep tx %v: %v", hash, err)
+	}
+
+	if !rebroadcast {
+		return
+	}
+
+	log.Debugf("Rebroadcasting sweep tx %v", hash)
+
+	err := s.cfg.PublishTransaction(
+		publishedTx.Tx, lnwallet.SweepTxLabel,
+	)
+	if err == nil {
+		return
+	}
+
+	// If none of the inputs of the tx are pending anymore and it can't be
+	// published, it has either been confirmed or conflicted while we
+	// weren't tracking it, so we'll stop rebroadcasting.
+	if len(inputs) == 0 {
+		log.Infof("Discarding untracked sweep tx %v: %v", hash, err)
+		s.removePublishedTx(hash)
+		return
+	}
+
+	log.Debugf("Unable to rebroadcast sweep tx %v: %v", hash, err)
+}
+
+// replaceTx creates and publishes a tx spending the given inputs at the given
+// fee rate, replacing the published tx identified by oldHash.
+func (s *UtxoSweeper) replaceTx(oldHash chainhash.Hash, inputs []*pendingInput,
+	feeRate lnwallet.SatPerKWeight) error {
+
+	oldTx := s.publishedTxs[oldHash]
+
+	sweepInputs := make([]Input, 0, len(inputs))
+	for _, pendInput := range inputs {
+		sweepInputs = append(sweepInputs, pendInput.input)
+	}
+
+	// We'll keep paying to the same output script, so we don't use up a
+	// new wallet address with every fee bump.
+	pkScript := oldTx.Tx.TxOut[0].PkScript
+	sweepTx, err := createSweepTx(
+		sweepInputs, pkScript, uint32(s.currentHeight), feeRate,
+		s.cfg.Signer,
+	)
+	if err != nil {
+		return err
+	}
+
+	newHash := sweepTx.TxHash()
+
+	log.Infof("Replacing sweep tx %v (%v sat/kw) with %v (%v sat/kw)",
+		oldHash, int64(oldTx.FeeRate), newHash, int64(feeRate))
+
+	if err := s.publishTx(sweepTx, feeRate); err != nil {
+		return err
+	}
+
+	// Now that the replacement has been accepted, we'll point the inputs
+	// to it before discarding the original tx.
+	for _, pendInput := range inputs {
+		pendInput.sweepTx = &newHash
+	}
+	s.removePublishedTx(oldHash)
+
+	return nil
+}
+
+// sweepInputs creates and publishes a new sweep tx spending the given inputs
+// at the given fee rate.
+func (s *UtxoSweeper) sweepInputs(inputs []*pendingInput,
+	feeRate lnwallet.SatPerKWeight) {
+
+	sweepInputs := make([]Input, 0, len(inputs))
+	for _, pendInput := range inputs {
+		sweepInputs = append(sweepInputs, pendInput.input)
+	}
+
+	// Generate an output script if there isn't an unused script
+	// available.
+	pkScript, err := s.cfg.GenSweepScript()
+	if err != nil {
+		log.Errorf("Unable to generate sweep script: %v", err)
+		return
+	}
+
+	sweepTx, err := createSweepTx(
+		sweepInputs, pkScript, uint32(s.currentHeight), feeRate,
+		s.cfg.Signer,
+	)
+	switch {
+	// The inputs aren't worth sweeping at the current fee rate, so we'll
+	// leave them pending until fees drop or more inputs are added.
+	case err == ErrDustOutput:
+		log.Warnf("Sweeping %v inputs at %v sat/kw isn't economical, "+
+			"retrying later", len(inputs), int64(feeRate))
+		return
+
+	case err != nil:
+		log.Errorf("Unable to create sweep tx: %v", err)
+		s.failAttempt(inputs, err)
+		return
+	}
+
+	log.Infof("Publishing sweep tx %v, num_inputs=%v, fee_rate=%v sat/kw",
+		sweepTx.TxHash(), len(inputs), int64(feeRate))
+
+	err = s.publishTx(sweepTx, feeRate)
+	if err != nil {
+		log.Warnf("Unable to publish sweep tx %v: %v",
+			sweepTx.TxHash(), err)
+
+		// A single input that is spent elsewhere or otherwise invalid
+		// would prevent the rest of the batch from being swept. So
+		// we'll retry each of the inputs by itself.
+		if len(inputs) > 1 {
+			for _, pendInput := range inputs {
+				s.sweepInputs(
+					[]*pendingInput{pendInput}, feeRate,
+				)
+			}
+			return
+		}
+
+		s.failAttempt(inputs, err)
+		return
+	}
+
+	hash := sweepTx.TxHash()
+	for _, pendInput := range inputs {
+		pendInput.sweepTx = &hash
+	}
+}
+
+// publishTx records the given sweep tx in the store and broadcasts it. The tx
+// is recorded first, so that we'll always recognize it as ours after a
+// restart.
+func (s *UtxoSweeper) publishTx(sweepTx *wire.MsgTx,
+	feeRate lnwallet.SatPerKWeight) error {
+
+	publishedTx := &PublishedTx{
+		Tx:      sweepTx,
+		FeeRate: feeRate,
+	}
+	if err := s.cfg.Store.NotifyPublishTx(publishedTx); err != nil {
+		return err
+	}
+
+	hash := sweepTx.TxHash()
+	err := s.cfg.PublishTransaction(sweepTx, lnwallet.SweepTxLabel)
+	if err != nil {
+		if err := s.cfg.Store.RemovePublishedTx(hash); err != nil {
+			log.Errorf("Unable to remove sweep tx %v from "+
+				"store: %v", hash, err)
+		}
+
+		return err
+	}
+
+	s.publishedTxs[hash] = publishedTx
+
+	return nil
+}
+
+// failAttempt records a failed sweep attempt for each of the given inputs.
+// Inputs that have reached the maximum number of attempts are removed, and
+// their listeners are notified.
+func (s *UtxoSweeper) failAttempt(inputs []*pendingInput, err error) {
+	for _, pendInput := range inputs {
+		pendInput.publishAttempts++
+		if pendInput.publishAttempts < s.cfg.MaxSweepAttempts {
+			continue
+		}
+
+		log.Warnf("Sweep of %v failed after %v attempts: %v",
+			pendInput.input.OutPoint(), pendInput.publishAttempts,
+			err)
+
+		s.signalAndRemove(pendInput.input.OutPoint(), Result{
+			Err: ErrTooManyAttempts,
+		})
+	}
+}
+
+// waitForSpend registers a spend notification with the chain notifier. It
+// returns a cancel function that can be used to cancel the registration.
+func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
+	script []byte, heightHint uint32) (func(), error) {
+
+	log.Debugf("Wait for spend of %v", outpoint)
+
+	spendEvent, err := s.cfg.Notifier.RegisterSpendNtfn(
+		&outpoint, script, heightHint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register spend ntfn: %v", err)
+	}
+
+	stopChan := make(chan struct{})
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		select {
+		case spend, ok := <-spendEvent.Spend:
+			if !ok {
+				log.Debugf("Spend ntfn for %v canceled",
+					outpoint)
+				return
+			}
+
+			log.Debugf("Delivering spend ntfn for %v",
+				outpoint)
+
+			select {
+			case s.spendChan <- spend:
+				log.Debugf("Delivered spend ntfn for %v",
+					outpoint)
+
+			case <-s.quit:
+			}
+
+		case <-stopChan:
+		case <-s.quit:
+		}
+	}()
+
+	return func() {
+		close(stopChan)
+		spendEvent.Cancel()
+	}, nil
+}
+
+// outPointLess returns true if outpoint a sorts before outpoint b.
+func outPointLess(a, b *wire.OutPoint) bool {
+	if cmp := bytes.Compare(a.Hash[:], b.Hash[:]); cmp != 0 {
+		return cmp < 0
+	}
+
+	return a.Index < b.Index
+}
@@ -0,0 +1,574 @@
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// testHeight is the block height at which the sweeper is started in
+	// the tests.
+	testHeight = 100
+
+	// testInputValue is the value of the inputs swept in the tests.
+	testInputValue = 100000
+
+	// defaultTestFeeRate is the fee rate returned by the mock fee
+	// estimator for confirmation targets that haven't been configured.
+	defaultTestFeeRate = lnwallet.SatPerKWeight(1000)
+
+	// highTestFeeRate is a fee rate that falls in a different bucket than
+	// the default fee rate.
+	highTestFeeRate = lnwallet.SatPerKWeight(10000)
+
+	// defaultTestTimeout is the time we wait for the sweeper to act
+	// before failing a test.
+	defaultTestTimeout = 5 * time.Second
+)
+
+var (
+	testPkScript = []byte{0x00, 0x14, 0x01, 0x02, 0x03}
+
+	testInputCount byte
+)
+
+type sweeperTestContext struct {
+	t *testing.T
+
+	sweeper   *UtxoSweeper
+	cfg       *UtxoSweeperConfig
+	notifier  *mockNotifier
+	estimator *mockFeeEstimator
+	store     *mockSweeperStore
+
+	publishChan chan wire.MsgTx
+	timeoutChan chan chan time.Time
+}
+
+func createSweeperTestContext(t *testing.T) *sweeperTestContext {
+	ctx := &sweeperTestContext{
+		t:           t,
+		notifier:    newMockNotifier(t),
+		estimator:   newMockFeeEstimator(defaultTestFeeRate),
+		store:       newMockSweeperStore(),
+		publishChan: make(chan wire.MsgTx, 2),
+		timeoutChan: make(chan chan time.Time, 1),
+	}
+
+	ctx.cfg = &UtxoSweeperConfig{
+		GenSweepScript: func() ([]byte, error) {
+			return testPkScript, nil
+		},
+		FeeEstimator: ctx.estimator,
+		PublishTransaction: func(tx *wire.MsgTx, _ string) error {
+			ctx.publishChan <- *tx
+			return nil
+		},
+		NewBatchTimer: func() <-chan time.Time {
+			c := make(chan time.Time, 1)
+			ctx.timeoutChan <- c
+			return c
+		},
+		Notifier: ctx.notifier,
+		ChainIO: &mockChainIO{
+			height: testHeight,
+		},
+		Store:            ctx.store,
+		Signer:           &mockSigner{},
+		MaxInputsPerTx:   DefaultMaxInputsPerTx,
+		MaxSweepAttempts: DefaultMaxSweepAttempts,
+	}
+
+	ctx.sweeper = New(ctx.cfg)
+	if err := ctx.sweeper.Start(); err != nil {
+		t.Fatalf("unable to start sweeper: %v", err)
+	}
+
+	return ctx
+}
+
+// restartSweeper stops the sweeper and starts a new instance on top of the
+// same store, simulating a restart of the node.
+func (ctx *sweeperTestContext) restartSweeper() {
+	ctx.sweeper.Stop()
+
+	ctx.sweeper = New(ctx.cfg)
+	if err := ctx.sweeper.Start(); err != nil {
+		ctx.t.Fatalf("unable to restart sweeper: %v", err)
+	}
+}
+
+// tick expires the batch timer that the sweeper started.
+func (ctx *sweeperTestContext) tick() {
+	select {
+	case c := <-ctx.timeoutChan:
+		c <- time.Time{}
+
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatal("tick timeout - no new timer created")
+	}
+}
+
+func (ctx *sweeperTestContext) receiveTx() wire.MsgTx {
+	var tx wire.MsgTx
+	select {
+	case tx = <-ctx.publishChan:
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatal("tx not published")
+	}
+
+	return tx
+}
+
+func (ctx *sweeperTestContext) assertNoTx() {
+	select {
+	case tx := <-ctx.publishChan:
+		ctx.t.Fatalf("unexpected tx published: %v", tx.TxHash())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func (ctx *sweeperTestContext) expectResult(c chan Result,
+	expected error) Result {
+
+	var result Result
+	select {
+	case result = <-c:
+		if result.Err != expected {
+			ctx.t.Fatalf("expected %v result, but got %v",
+				expected, result.Err)
+		}
+
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatalf("no result received")
+	}
+
+	return result
+}
+
+func (ctx *sweeperTestContext) finish() {
+	// We assume that when finish is called, the sweeper has finished all
+	// its goroutines. This implies that the waitgroup is empty.
+	ctx.sweeper.Stop()
+
+	// We should have consumed and asserted all published transactions in
+	// our unit tests.
+	ctx.assertNoTx()
+}
+
+func (ctx *sweeperTestContext) sweepInput(input Input,
+	deadline uint32) chan Result {
+
+	resultChan, err := ctx.sweeper.SweepInput(input, deadline)
+	if err != nil {
+		ctx.t.Fatalf("unable to sweep input: %v", err)
+	}
+
+	return resultChan
+}
+
+// createTestInput returns a new input with a unique outpoint.
+func createTestInput(value int64) *testInput {
+	testInputCount++
+
+	input := &testInput{
+		BaseInput: MakeBaseInput(
+			&wire.OutPoint{
+				Hash: chainhash.Hash{testInputCount},
+			},
+			lnwallet.CommitmentTimeLock,
+			&lnwallet.SignDescriptor{
+				Output: &wire.TxOut{
+					Value: value,
+				},
+			},
+			0,
+		),
+	}
+
+	return input
+}
+
+// assertTxSweepsInputs asserts that the tx spends exactly the given inputs.
+func assertTxSweepsInputs(t *testing.T, sweepTx *wire.MsgTx,
+	inputs ...Input) {
+
+	if len(sweepTx.TxIn) != len(inputs) {
+		t.Fatalf("expected tx to contain %v inputs, but contains %v",
+			len(inputs), len(sweepTx.TxIn))
+	}
+
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txIn := range sweepTx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, input := range inputs {
+		if _, ok := spent[*input.OutPoint()]; !ok {
+			t.Fatalf("expected tx to spend %v", input.OutPoint())
+		}
+	}
+}
+
+// TestSweeperBatching asserts that inputs that are offered within the batch
+// window and desire the same fee rate are swept in a single tx.
+func TestSweeperBatching(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input1 := createTestInput(testInputValue)
+	input2 := createTestInput(testInputValue)
+
+	resultChan1 := ctx.sweepInput(input1, 0)
+	resultChan2 := ctx.sweepInput(input2, 0)
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx, input1, input2)
+
+	if sweepTx.LockTime != testHeight {
+		t.Fatalf("expected lock time %v, got %v", testHeight,
+			sweepTx.LockTime)
+	}
+	for _, txIn := range sweepTx.TxIn {
+		if txIn.Sequence != wire.MaxTxInSequenceNum-2 {
+			t.Fatalf("expected input to signal rbf")
+		}
+	}
+
+	// Confirm the sweep tx, both callers should be notified of success.
+	ctx.notifier.spendOutpoints(&sweepTx)
+
+	result := ctx.expectResult(resultChan1, nil)
+	if result.Tx.TxHash() != sweepTx.TxHash() {
+		t.Fatalf("expected sweep tx in result")
+	}
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperFeeRateBuckets asserts that inputs that desire fee rates far
+// apart are swept in separate txes.
+func TestSweeperFeeRateBuckets(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// The deadline of the second input requires confirmation within two
+	// blocks, which we'll make considerably more expensive.
+	ctx.estimator.updateFees(2, highTestFeeRate)
+
+	input1 := createTestInput(testInputValue)
+	input2 := createTestInput(testInputValue)
+
+	resultChan1 := ctx.sweepInput(input1, 0)
+	resultChan2 := ctx.sweepInput(input2, testHeight+2)
+
+	ctx.tick()
+
+	sweepTxes := make(map[wire.OutPoint]wire.MsgTx)
+	for i := 0; i < 2; i++ {
+		sweepTx := ctx.receiveTx()
+		if len(sweepTx.TxIn) != 1 {
+			t.Fatalf("expected a single input per sweep tx")
+		}
+		sweepTxes[sweepTx.TxIn[0].PreviousOutPoint] = sweepTx
+	}
+
+	sweepTx1, ok := sweepTxes[*input1.OutPoint()]
+	if !ok {
+		t.Fatalf("input 1 not swept")
+	}
+	sweepTx2, ok := sweepTxes[*input2.OutPoint()]
+	if !ok {
+		t.Fatalf("input 2 not swept")
+	}
+
+	// The time sensitive input should pay the higher fee.
+	if sweepTx2.TxOut[0].Value >= sweepTx1.TxOut[0].Value {
+		t.Fatalf("expected time sensitive input to pay higher fee")
+	}
+
+	ctx.notifier.spendOutpoints(&sweepTx1)
+	ctx.notifier.spendOutpoints(&sweepTx2)
+
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperRemoteSpend asserts that the sweeper reports a remote spend of
+// an input, and stops sweeping it.
+func TestSweeperRemoteSpend(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input := createTestInput(testInputValue)
+	resultChan := ctx.sweepInput(input, 0)
+
+	ctx.tick()
+	ctx.receiveTx()
+
+	// A tx of the remote party confirms, spending our input.
+	remoteTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: *input.OutPoint(),
+			},
+		},
+	}
+	ctx.notifier.spendOutpoints(remoteTx)
+
+	result := ctx.expectResult(resultChan, ErrRemoteSpend)
+	if result.Tx.TxHash() != remoteTx.TxHash() {
+		t.Fatalf("expected remote tx in result")
+	}
+
+	// Our own tx is now discarded, so no rebroadcast should happen on the
+	// next block.
+	ctx.notifier.notifyEpoch(testHeight + 1)
+	ctx.assertNoTx()
+
+	publishedTxs, _ := ctx.store.FetchPublishedTxs()
+	if len(publishedTxs) != 0 {
+		t.Fatalf("expected conflicting sweep tx to be removed")
+	}
+
+	ctx.finish()
+}
+
+// TestSweeperFeeBump asserts that a published sweep tx is replaced by a
+// version paying a higher fee as the deadline of its input draws near, and
+// that it is rebroadcast otherwise.
+func TestSweeperFeeBump(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input := createTestInput(testInputValue)
+	resultChan := ctx.sweepInput(input, testHeight+10)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+
+	// Fees rise, and the input now requires a higher fee rate to confirm
+	// before its deadline. This should trigger a replacement on the next
+	// block.
+	ctx.estimator.updateFees(2, highTestFeeRate)
+	ctx.notifier.notifyEpoch(testHeight + 8)
+
+	replacementTx := ctx.receiveTx()
+	assertTxSweepsInputs(t, &replacementTx, input)
+
+	if replacementTx.TxOut[0].Value >= sweepTx.TxOut[0].Value {
+		t.Fatalf("expected replacement tx to pay a higher fee")
+	}
+	publishedTxs, _ := ctx.store.FetchPublishedTxs()
+	if len(publishedTxs) != 1 ||
+		publishedTxs[0].Tx.TxHash() != replacementTx.TxHash() {
+
+		t.Fatalf("expected only the replacement tx to be stored")
+	}
+
+	// On the next block the fee rate desired for the input is lower than
+	// the rate of the replacement, so it should merely be rebroadcast.
+	ctx.notifier.notifyEpoch(testHeight + 9)
+
+	rebroadcastTx := ctx.receiveTx()
+	if rebroadcastTx.TxHash() != replacementTx.TxHash() {
+		t.Fatalf("expected replacement tx to be rebroadcast")
+	}
+
+	ctx.notifier.spendOutpoints(&replacementTx)
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperRestart asserts that the sweeper rebroadcasts its published txes
+// after a restart, and resumes tracking them once their inputs are offered
+// again.
+func TestSweeperRestart(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input := createTestInput(testInputValue)
+	ctx.sweepInput(input, 0)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+
+	ctx.restartSweeper()
+
+	// The published tx should be rebroadcast on startup.
+	rebroadcastTx := ctx.receiveTx()
+	if rebroadcastTx.TxHash() != sweepTx.TxHash() {
+		t.Fatalf("expected sweep tx to be rebroadcast")
+	}
+
+	// Offering the input again shouldn't lead to a new tx, as it is
+	// already spent by the rebroadcast tx.
+	resultChan := ctx.sweepInput(input, 0)
+	ctx.tick()
+	ctx.assertNoTx()
+
+	// Confirmation of the tx should be recognized as our own sweep.
+	ctx.notifier.spendOutpoints(&sweepTx)
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperDuplicateInput asserts that an input that is offered twice is
+// only swept once, and that both callers are notified of the result.
+func TestSweeperDuplicateInput(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input := createTestInput(testInputValue)
+
+	resultChan1 := ctx.sweepInput(input, 0)
+	resultChan2 := ctx.sweepInput(input, 0)
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx, input)
+
+	ctx.notifier.spendOutpoints(&sweepTx)
+
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperLockTime asserts that inputs with an absolute lock time aren't
+// swept before the lock time has been reached, and that csv locked inputs
+// carry their relative lock time in the sequence field.
+func TestSweeperLockTime(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input1 := createTestInput(testInputValue)
+	input1.lockTime = testHeight + 1
+
+	input2 := createTestInput(testInputValue)
+	input2.blocksToMaturity = 144
+
+	resultChan1 := ctx.sweepInput(input1, 0)
+	resultChan2 := ctx.sweepInput(input2, 0)
+
+	// Only the csv locked input can be swept at the current height.
+	ctx.tick()
+
+	sweepTx2 := ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx2, input2)
+	if sweepTx2.TxIn[0].Sequence != input2.blocksToMaturity {
+		t.Fatalf("expected sequence %v, got %v",
+			input2.blocksToMaturity, sweepTx2.TxIn[0].Sequence)
+	}
+
+	// Once the lock time is reached, the first input should be swept as
+	// well. The published tx of the second input is rebroadcast.
+	ctx.notifier.notifyEpoch(testHeight + 1)
+
+	var sweepTx1 wire.MsgTx
+	for i := 0; i < 2; i++ {
+		tx := ctx.receiveTx()
+		if tx.TxHash() == sweepTx2.TxHash() {
+			continue
+		}
+		sweepTx1 = tx
+	}
+	assertTxSweepsInputs(t, &sweepTx1, input1)
+
+	if sweepTx1.LockTime != testHeight+1 {
+		t.Fatalf("expected lock time %v, got %v", testHeight+1,
+			sweepTx1.LockTime)
+	}
+
+	ctx.notifier.spendOutpoints(&sweepTx1)
+	ctx.notifier.spendOutpoints(&sweepTx2)
+
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperExplicitFeeRate asserts that an input offered at an explicit fee
+// rate is swept at that rate, and isn't bumped on subsequent blocks.
+func TestSweeperExplicitFeeRate(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input1 := createTestInput(testInputValue)
+	input2 := createTestInput(testInputValue)
+
+	// Offer the first input at an explicit fee rate, and the second one
+	// without any fee preference. As their fee rates fall in different
+	// buckets, they should be swept in separate txes.
+	resultChan1, err := ctx.sweeper.SweepInputAtFeeRate(
+		input1, highTestFeeRate,
+	)
+	if err != nil {
+		t.Fatalf("unable to sweep input: %v", err)
+	}
+	resultChan2 := ctx.sweepInput(input2, 0)
+
+	ctx.tick()
+
+	sweepTxes := make(map[wire.OutPoint]wire.MsgTx)
+	for i := 0; i < 2; i++ {
+		sweepTx := ctx.receiveTx()
+		sweepTxes[sweepTx.TxIn[0].PreviousOutPoint] = sweepTx
+	}
+	sweepTx1 := sweepTxes[*input1.OutPoint()]
+	sweepTx2 := sweepTxes[*input2.OutPoint()]
+	assertTxSweepsInputs(t, &sweepTx1, input1)
+	assertTxSweepsInputs(t, &sweepTx2, input2)
+
+	weight, err := estimateTxWeight([]Input{input1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedValue := testInputValue -
+		int64(highTestFeeRate.FeeForWeight(weight))
+	if sweepTx1.TxOut[0].Value != expectedValue {
+		t.Fatalf("expected output value %v, got %v", expectedValue,
+			sweepTx1.TxOut[0].Value)
+	}
+
+	// Even though the fee estimate for the default confirmation target
+	// rises above the explicit fee rate, the tx should merely be
+	// rebroadcast.
+	ctx.estimator.updateFees(DefaultConfTarget, 2*highTestFeeRate)
+	ctx.notifier.notifyEpoch(testHeight + 1)
+
+	for i := 0; i < 2; i++ {
+		tx := ctx.receiveTx()
+		if tx.TxIn[0].PreviousOutPoint != *input1.OutPoint() {
+			continue
+		}
+		if tx.TxHash() != sweepTx1.TxHash() {
+			t.Fatalf("expected tx to be rebroadcast")
+		}
+	}
+
+	// Fee rates below the floor are rejected.
+	_, err = ctx.sweeper.SweepInputAtFeeRate(
+		createTestInput(testInputValue), lnwallet.FeePerKwFloor-1,
+	)
+	if err == nil {
+		t.Fatalf("expected fee rate below floor to be rejected")
+	}
+
+	ctx.notifier.spendOutpoints(&sweepTx1)
+	ctx.expectResult(resultChan1, nil)
+
+	ctx.finish()
+
+	// The second input is never swept in this test.
+	select {
+	case <-resultChan2:
+		t.Fatalf("unexpected result for second input")
+	default:
+	}
+}
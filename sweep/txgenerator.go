@@ -0,0 +1,164 @@
+package sweep
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// ErrDustOutput is returned when the value of the sweep output would
+	// be below the dust limit after fees have been deducted.
+	ErrDustOutput = fmt.Errorf("sweep output would be dust")
+)
+
+// getInputWitnessSizeUpperBound returns the maximum length of the witness for
+// the given input if it would be included in a tx.
+func getInputWitnessSizeUpperBound(input Input) (int, error) {
+	switch input.WitnessType() {
+
+	// Outputs on a remote commitment transaction that pay directly to us,
+	// or regular p2wkh outputs of the wallet.
+	case lnwallet.CommitmentNoDelay, lnwallet.WitnessKeyHash:
+		return lnwallet.P2WKHWitnessSize, nil
+
+	// Outputs on a past commitment transaction that pay directly to us,
+	// or second layer HTLC outputs that have matured.
+	case lnwallet.CommitmentTimeLock,
+		lnwallet.HtlcOfferedTimeoutSecondLevel,
+		lnwallet.HtlcAcceptedSuccessSecondLevel:
+
+		return lnwallet.ToLocalTimeoutWitnessSize, nil
+
+	// An HTLC on the commitment transaction of the remote party, that has
+	// had its absolute timelock expire.
+	case lnwallet.HtlcOfferedRemoteTimeout:
+		return lnwallet.AcceptedHtlcTimeoutWitnessSize, nil
+
+	// An HTLC on the commitment transaction of the remote party, that can
+	// be swept with the preimage.
+	case lnwallet.HtlcAcceptedRemoteSuccess:
+		return lnwallet.OfferedHtlcSuccessWitnessSize, nil
+
+	// Outputs on a revoked commitment transaction of the remote party.
+	case lnwallet.CommitmentRevoke, lnwallet.HtlcSecondLevelRevoke:
+		return lnwallet.ToLocalPenaltyWitnessSize, nil
+
+	case lnwallet.HtlcOfferedRevoke:
+		return lnwallet.OfferedHtlcPenaltyWitnessSize, nil
+
+	case lnwallet.HtlcAcceptedRevoke:
+		return lnwallet.AcceptedHtlcPenaltyWitnessSize, nil
+	}
+
+	return 0, fmt.Errorf("unexpected witness type: %v",
+		input.WitnessType())
+}
+
+// estimateTxWeight returns the weight of a sweep transaction that spends the
+// given inputs into a single p2wkh output.
+func estimateTxWeight(inputs []Input) (int64, error) {
+	var weightEstimate lnwallet.TxWeightEstimator
+
+	// Our sweep transaction will pay to a single segwit p2wkh address,
+	// ensure it contributes to our weight estimate.
+	weightEstimate.AddP2WKHOutput()
+
+	// For each output, use its witness type to determine the estimated
+	// weight of its witness.
+	for _, input := range inputs {
+		size, err := getInputWitnessSizeUpperBound(input)
+		if err != nil {
+			return 0, err
+		}
+		weightEstimate.AddWitnessInput(size)
+	}
+
+	return int64(weightEstimate.Weight()), nil
+}
+
+// createSweepTx builds a signed tx spending the inputs to the given output
+// script, paying the given fee rate. The transaction signals opt-in RBF, such
+// that it can later be replaced by a version paying a higher fee.
+func createSweepTx(inputs []Input, outputPkScript []byte,
+	currentBlockHeight uint32, feePerKw lnwallet.SatPerKWeight,
+	signer lnwallet.Signer) (*wire.MsgTx, error) {
+
+	txWeight, err := estimateTxWeight(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum up the total value contained in the inputs.
+	var totalSum btcutil.Amount
+	for _, input := range inputs {
+		totalSum += btcutil.Amount(input.SignDesc().Output.Value)
+	}
+
+	// Using the txn weight estimate, compute the required txn fee, and
+	// sweep as much as possible after subtracting it.
+	txFee := feePerKw.FeeForWeight(txWeight)
+	sweepAmt := totalSum - txFee
+	if sweepAmt < lnwallet.DefaultDustLimit() {
+		return nil, ErrDustOutput
+	}
+
+	log.Infof("Creating sweep transaction for %v inputs (total=%v) "+
+		"using %v sat/kw, tx_fee=%v", len(inputs), totalSum,
+		int64(feePerKw), txFee)
+
+	// Create the sweep transaction that we will be building. We use
+	// version 2 as it is required for CSV. The txn will sweep the amount
+	// after fees to the pkscript given. We set the lock time to the
+	// current height, which satisfies any CLTV locked inputs that have
+	// reached maturity.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.LockTime = currentBlockHeight
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: outputPkScript,
+		Value:    int64(sweepAmt),
+	})
+
+	// Add all inputs to the sweep transaction. CSV locked inputs need
+	// their sequence set to the relative lock time, which also signals
+	// RBF. All other inputs use the highest sequence number that still
+	// signals RBF and enforces the lock time.
+	for _, input := range inputs {
+		sequence := uint32(wire.MaxTxInSequenceNum - 2)
+		if input.BlocksToMaturity() > 0 {
+			sequence = input.BlocksToMaturity()
+		}
+
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         sequence,
+		})
+	}
+
+	// Before signing the transaction, check to ensure that it meets some
+	// basic validity requirements.
+	btx := btcutil.NewTx(sweepTx)
+	if err := blockchain.CheckTransactionSanity(btx); err != nil {
+		return nil, err
+	}
+
+	// With all the inputs in place, use each output's unique witness
+	// function to generate the final witness required for spending.
+	hashCache := txscript.NewTxSigHashes(sweepTx)
+	for idx, input := range inputs {
+		witness, err := input.BuildWitness(
+			signer, sweepTx, hashCache, idx,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepTx.TxIn[idx].Witness = witness
+	}
+
+	return sweepTx, nil
+}
@@ -322,6 +322,7 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		feeEstimator:  estimator,
 		chainIO:       chainIO,
 		chainNotifier: notifier,
+		signer:        aliceSigner,
 		wallet:        wallet,
 	}
 
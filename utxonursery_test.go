@@ -20,6 +20,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -400,6 +401,7 @@ type nurseryTestContext struct {
 	store       *nurseryStoreInterceptor
 	restart     func() bool
 	receiveTx   func() wire.MsgTx
+	sweeper     *mockSweeper
 	t           *testing.T
 }
 
@@ -445,15 +447,13 @@ func createNurseryTestContext(t *testing.T,
 		},
 		Store:   storeIntercepter,
 		ChainIO: &mockChainIO{},
-		GenSweepScript: func() ([]byte, error) {
-			return []byte{}, nil
-		},
-		Estimator: &mockFeeEstimator{},
-		Signer:    &nurseryMockSigner{},
 	}
 
+	sweeper := newMockSweeper(t)
+	cfg.SweepInput = sweeper.sweepInput
+
 	publishChan := make(chan wire.MsgTx, 1)
-	cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+	cfg.PublishTransaction = func(tx *wire.MsgTx, _ string) error {
 		t.Logf("Publishing tx %v", tx.TxHash())
 		publishChan <- *tx
 		return nil
@@ -467,6 +467,7 @@ func createNurseryTestContext(t *testing.T,
 		notifier:    notifier,
 		store:       storeIntercepter,
 		publishChan: publishChan,
+		sweeper:     sweeper,
 		t:           t,
 	}
 
@@ -533,6 +534,14 @@ func (ctx *nurseryTestContext) finish() {
 	default:
 	}
 
+	// Likewise, all inputs offered to the sweeper should have been
+	// asserted.
+	select {
+	case <-ctx.sweeper.sweepChan:
+		ctx.t.Fatalf("unexpected inputs offered to sweeper")
+	default:
+	}
+
 	// Assert that the database is empty. All channels removed and height
 	// index cleared.
 	nurseryChannels, err := ctx.nursery.cfg.Store.ListChannels()
@@ -871,22 +880,18 @@ func testSweepHtlc(t *testing.T, ctx *nurseryTestContext) {
 
 func testSweep(t *testing.T, ctx *nurseryTestContext,
 	afterPublishAssert func()) {
-	// Wait for nursery to publish the sweep tx.
-	sweepTx := ctx.receiveTx()
+	// Wait for nursery to offer the output to the sweeper.
+	ctx.sweeper.expectSweep()
 
 	if ctx.restart() {
-		// Restart will trigger rebroadcast of sweep tx.
-		sweepTx = ctx.receiveTx()
+		// Restart will trigger the nursery to offer the output again.
+		ctx.sweeper.expectSweep()
 	}
 
 	afterPublishAssert()
 
-	// Confirm the sweep tx.
-	sweepTxHash := sweepTx.TxHash()
-	err := ctx.notifier.confirmTx(&sweepTxHash, 129)
-	if err != nil {
-		t.Fatal(err)
-	}
+	// Mimic the sweeper publishing and confirming the sweep tx.
+	ctx.sweeper.sweepAll()
 
 	// Wait for output to be promoted in store to GRAD.
 	select {
@@ -1006,34 +1011,66 @@ func (i *nurseryStoreInterceptor) RemoveChannel(chanPoint *wire.OutPoint) error
 	return i.ns.RemoveChannel(chanPoint)
 }
 
-type mockFeeEstimator struct{}
+type mockSweeper struct {
+	lock sync.Mutex
 
-func (m *mockFeeEstimator) EstimateFeePerKW(
-	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+	resultChans map[wire.OutPoint]chan sweep.Result
+	t           *testing.T
 
-	return lnwallet.SatPerKWeight(10000), nil
+	sweepChan chan sweep.Input
 }
 
-func (m *mockFeeEstimator) Start() error {
-	return nil
-}
-func (m *mockFeeEstimator) Stop() error {
-	return nil
+func newMockSweeper(t *testing.T) *mockSweeper {
+	return &mockSweeper{
+		resultChans: make(map[wire.OutPoint]chan sweep.Result),
+		sweepChan:   make(chan sweep.Input, 10),
+		t:           t,
+	}
 }
 
-type nurseryMockSigner struct {
+func (s *mockSweeper) sweepInput(input sweep.Input,
+	deadline uint32) (chan sweep.Result, error) {
+
+	s.t.Logf("mockSweeper sweepInput called for %v", *input.OutPoint())
+
+	s.sweepChan <- input
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c := make(chan sweep.Result, 1)
+	s.resultChans[*input.OutPoint()] = c
+
+	return c, nil
 }
 
-func (m *nurseryMockSigner) SignOutputRaw(tx *wire.MsgTx,
-	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+func (s *mockSweeper) expectSweep() {
+	s.t.Helper()
 
-	return []byte{}, nil
+	select {
+	case <-s.sweepChan:
+	case <-time.After(defaultTestTimeout):
+		s.t.Fatalf("input not offered to sweeper")
+	}
 }
 
-func (m *nurseryMockSigner) ComputeInputScript(tx *wire.MsgTx,
-	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+func (s *mockSweeper) sweepAll() {
+	s.t.Helper()
+
+	s.lock.Lock()
+	currentChans := s.resultChans
+	s.resultChans = make(map[wire.OutPoint]chan sweep.Result)
+	s.lock.Unlock()
+
+	for o, c := range currentChans {
+		s.t.Logf("mockSweeper signal swept for %v", o)
 
-	return &lnwallet.InputScript{}, nil
+		select {
+		case c <- sweep.Result{Tx: &wire.MsgTx{}}:
+		case <-time.After(defaultTestTimeout):
+			s.t.Fatal("signal result timeout")
+		}
+	}
 }
 
 type nurseryMockNotifier struct {
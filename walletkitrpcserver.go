@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/remotesigner"
+	"golang.org/x/net/context"
+)
+
+// walletKitRPCServer implements the WalletKit gRPC service, which exposes the
+// key derivation and on-chain primitives of the wallet.
+type walletKitRPCServer struct {
+	cc *chainControl
+}
+
+// A compile time check to ensure that walletKitRPCServer fully implements the
+// WalletKitServer gRPC service.
+var _ lnrpc.WalletKitServer = (*walletKitRPCServer)(nil)
+
+// newWalletKitRPCServer creates a new WalletKit service backed by the wallet
+// and fee estimator of the passed chain control.
+func newWalletKitRPCServer(cc *chainControl) *walletKitRPCServer {
+	return &walletKitRPCServer{
+		cc: cc,
+	}
+}
+
+// DeriveNextKey derives the next unused key within the passed key family.
+func (w *walletKitRPCServer) DeriveNextKey(ctx context.Context,
+	in *lnrpc.KeyReq) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := w.cc.wallet.DeriveNextKey(
+		keychain.KeyFamily(in.KeyFamily),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return remotesigner.MarshalKeyDescriptor(keyDesc), nil
+}
+
+// DeriveKey derives the public key at the passed key locator.
+func (w *walletKitRPCServer) DeriveKey(ctx context.Context,
+	in *lnrpc.KeyLocator) (*lnrpc.KeyDescriptor, error) {
+
+	keyDesc, err := w.cc.wallet.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamily(in.KeyFamily),
+		Index:  in.KeyIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return remotesigner.MarshalKeyDescriptor(keyDesc), nil
+}
+
+// PublishTransaction broadcasts the passed fully signed transaction.
+func (w *walletKitRPCServer) PublishTransaction(ctx context.Context,
+	in *lnrpc.PublishTxRequest) (*lnrpc.PublishTxResponse, error) {
+
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(in.RawTx)); err != nil {
+		return nil, fmt.Errorf("unable to parse tx: %v", err)
+	}
+
+	rpcsLog.Debugf("[publishtransaction] txid=%v", tx.TxHash())
+
+	if err := w.cc.wallet.PublishTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.PublishTxResponse{}, nil
+}
+
+// EstimateFee returns the fee rate recommended by the fee estimator for a
+// transaction to confirm within the passed number of blocks.
+func (w *walletKitRPCServer) EstimateFee(ctx context.Context,
+	in *lnrpc.EstimateFeeRequest) (*lnrpc.EstimateFeeResponse, error) {
+
+	if in.ConfTarget < 1 {
+		return nil, errors.New("conf_target must be at least 1")
+	}
+
+	feeRate, err := w.cc.feeEstimator.EstimateFeePerKW(
+		uint32(in.ConfTarget),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.EstimateFeeResponse{
+		SatPerKw: int64(feeRate),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"golang.org/x/net/context"
+)
+
+// TestWalletKitRPCServer tests that the WalletKit service derives keys,
+// publishes transactions and estimates fees using the wallet of the node.
+func TestWalletKitRPCServer(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	cc, wc := newTestRPCChainControl(t, privKey)
+	server := newWalletKitRPCServer(cc)
+	ctx := context.Background()
+
+	nextKey, err := server.DeriveNextKey(ctx, &lnrpc.KeyReq{
+		KeyFamily: uint32(keychain.KeyFamilyMultiSig),
+	})
+	if err != nil {
+		t.Fatalf("unable to derive next key: %v", err)
+	}
+	key, err := server.DeriveKey(ctx, &lnrpc.KeyLocator{
+		KeyFamily: uint32(keychain.KeyFamilyMultiSig),
+		KeyIndex:  3,
+	})
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	expectedKey := privKey.PubKey().SerializeCompressed()
+	for _, keyDesc := range []*lnrpc.KeyDescriptor{nextKey, key} {
+		if !bytes.Equal(keyDesc.RawKeyBytes, expectedKey) {
+			t.Fatalf("unexpected key %x", keyDesc.RawKeyBytes)
+		}
+	}
+
+	// A valid transaction must be handed to the wallet for broadcast,
+	// while an invalid one must be rejected.
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x51}})
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	_, err = server.PublishTransaction(ctx, &lnrpc.PublishTxRequest{
+		RawTx: rawTx.Bytes(),
+	})
+	if err != nil {
+		t.Fatalf("unable to publish tx: %v", err)
+	}
+	select {
+	case published := <-wc.publishedTransactions:
+		if published.TxHash() != tx.TxHash() {
+			t.Fatalf("wrong tx published: %v", published.TxHash())
+		}
+	default:
+		t.Fatalf("tx wasn't published")
+	}
+
+	_, err = server.PublishTransaction(ctx, &lnrpc.PublishTxRequest{
+		RawTx: []byte{0x01},
+	})
+	if err == nil {
+		t.Fatalf("expected invalid tx to be rejected")
+	}
+
+	feeResp, err := server.EstimateFee(ctx, &lnrpc.EstimateFeeRequest{
+		ConfTarget: 6,
+	})
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if feeResp.SatPerKw != 12500 {
+		t.Fatalf("expected fee rate of 12500 sat/kw, got %v",
+			feeResp.SatPerKw)
+	}
+
+	_, err = server.EstimateFee(ctx, &lnrpc.EstimateFeeRequest{})
+	if err == nil {
+		t.Fatalf("expected conf target of 0 to be rejected")
+	}
+}
+
+// TestWalletKitPermissions tests that the WalletKit service is protected by
+// its own entity, so that the WalletKit macaroon grants access to all of its
+// RPCs, but not to any others.
+func TestWalletKitPermissions(t *testing.T) {
+	t.Parallel()
+
+	granted := make(map[string]struct{})
+	for _, op := range walletKitPermissions {
+		if op.Entity != "walletkit" {
+			t.Fatalf("walletkit macaroon grants %v:%v", op.Entity,
+				op.Action)
+		}
+		granted[op.Action] = struct{}{}
+	}
+
+	for method, ops := range permissions {
+		isWalletKit := strings.HasPrefix(method, "/lnrpc.WalletKit/")
+		for _, op := range ops {
+			if isWalletKit != (op.Entity == "walletkit") {
+				t.Fatalf("%v requires %v:%v", method,
+					op.Entity, op.Action)
+			}
+			if _, ok := granted[op.Action]; isWalletKit && !ok {
+				t.Fatalf("walletkit macaroon doesn't grant "+
+					"access to %v", method)
+			}
+		}
+	}
+}
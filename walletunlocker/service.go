@@ -4,12 +4,15 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcwallet/wallet"
 	"github.com/lightningnetwork/lnd/aezeed"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -17,6 +20,25 @@ import (
 	"golang.org/x/net/context"
 )
 
+var (
+	// ErrWalletNotFound is returned when attempting to unlock a wallet
+	// that hasn't been created yet.
+	ErrWalletNotFound = errors.New("wallet not found")
+)
+
+// ChannelsToRecover wraps any set of packed (serialized+encrypted) channel
+// back ups together. These can be passed in when unlocking the wallet, or
+// creating a new wallet for the first time with an existing seed.
+type ChannelsToRecover struct {
+	// PackedMultiChanBackup is an encrypted and serialized multi-channel
+	// backup.
+	PackedMultiChanBackup chanbackup.PackedMulti
+
+	// PackedSingleChanBackups is a series of encrypted and serialized
+	// single-channel backup for one or more channels.
+	PackedSingleChanBackups chanbackup.PackedSingles
+}
+
 // WalletInitMsg is a message sent by the UnlockerService when a user wishes to
 // set up the internal wallet for the first time. The user MUST provide a
 // passphrase, but is also able to provide their own source of entropy. If
@@ -36,6 +58,16 @@ type WalletInitMsg struct {
 	// recovery should be attempted, such as after the wallet's initial
 	// creation.
 	RecoveryWindow uint32
+
+	// StatelessInit is true if the seed was generated by the
+	// UnlockerService itself. In this case the admin macaroon of the new
+	// wallet MUST be sent over the service's MacResponseChan once it has
+	// been created.
+	StatelessInit bool
+
+	// ChanBackups is a set of static channel backups that should be
+	// restored once the wallet has been initialized.
+	ChanBackups ChannelsToRecover
 }
 
 // WalletUnlockMsg is a message sent by the UnlockerService when a user wishes
@@ -59,6 +91,10 @@ type WalletUnlockMsg struct {
 	// later when lnd actually uses it). Because unlocking involves scrypt
 	// which is resource intensive, we want to avoid doing it twice.
 	Wallet *wallet.Wallet
+
+	// StatelessInit is true if the wallet was initialized with
+	// stateless_init, in which case no macaroon files should be written.
+	StatelessInit bool
 }
 
 // UnlockerService implements the WalletUnlocker service used to provide lnd
@@ -74,6 +110,11 @@ type UnlockerService struct {
 	// sent.
 	UnlockMsgs chan *WalletUnlockMsg
 
+	// MacResponseChan is the channel the admin macaroon of a wallet that
+	// was initialized with stateless_init is sent over, so it can be
+	// returned to the caller of InitWallet.
+	MacResponseChan chan []byte
+
 	chainDir      string
 	netParams     *chaincfg.Params
 	macaroonFiles []string
@@ -84,11 +125,12 @@ func New(chainDir string, params *chaincfg.Params,
 	macaroonFiles []string) *UnlockerService {
 
 	return &UnlockerService{
-		InitMsgs:      make(chan *WalletInitMsg, 1),
-		UnlockMsgs:    make(chan *WalletUnlockMsg, 1),
-		chainDir:      chainDir,
-		netParams:     params,
-		macaroonFiles: macaroonFiles,
+		InitMsgs:        make(chan *WalletInitMsg, 1),
+		UnlockMsgs:      make(chan *WalletUnlockMsg, 1),
+		MacResponseChan: make(chan []byte, 1),
+		chainDir:        chainDir,
+		netParams:       params,
+		macaroonFiles:   macaroonFiles,
 	}
 }
 
@@ -179,6 +221,10 @@ func (u *UnlockerService) GenSeed(ctx context.Context,
 // Alternatively, this can be used along with the GenSeed RPC to obtain a
 // seed, then present it to the user. Once it has been verified by the user,
 // the seed can be fed into this RPC in order to commit the new wallet.
+//
+// For unattended setups, the stateless_init flag lets the daemon generate the
+// seed itself. The enciphered seed is then only written to the requested file,
+// and the admin macaroon of the new wallet is returned once it's created.
 func (u *UnlockerService) InitWallet(ctx context.Context,
 	in *lnrpc.InitWalletRequest) (*lnrpc.InitWalletResponse, error) {
 
@@ -211,17 +257,38 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		return nil, fmt.Errorf("wallet already exists")
 	}
 
-	// At this point, we know that the wallet doesn't already exist. So
-	// we'll map the user provided aezeed and passphrase into a decoded
-	// cipher seed instance.
-	var mnemonic aezeed.Mnemonic
-	copy(mnemonic[:], in.CipherSeedMnemonic[:])
+	var cipherSeed *aezeed.CipherSeed
+	if in.StatelessInit {
+		// The seed is generated here, so the caller must not provide
+		// one of its own, and there can't be any channels to recover.
+		if len(in.CipherSeedMnemonic) != 0 {
+			return nil, errors.New("cipher seed mnemonic can't be " +
+				"used with stateless init")
+		}
+		if in.ChannelBackups != nil {
+			return nil, errors.New("channel backups can't be " +
+				"used with stateless init")
+		}
 
-	// If we're unable to map it back into the ciphertext, then either the
-	// mnemonic is wrong, or the passphrase is wrong.
-	cipherSeed, err := mnemonic.ToCipherSeed(in.AezeedPassphrase)
-	if err != nil {
-		return nil, err
+		cipherSeed, err = writeNewSeed(
+			in.EncryptedSeedFile, in.AezeedPassphrase,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// At this point, we know that the wallet doesn't already
+		// exist. So we'll map the user provided aezeed and passphrase
+		// into a decoded cipher seed instance.
+		var mnemonic aezeed.Mnemonic
+		copy(mnemonic[:], in.CipherSeedMnemonic[:])
+
+		// If we're unable to map it back into the ciphertext, then
+		// either the mnemonic is wrong, or the passphrase is wrong.
+		cipherSeed, err = mnemonic.ToCipherSeed(in.AezeedPassphrase)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// With the cipher seed deciphered, and the auth service created, we'll
@@ -231,41 +298,134 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		Passphrase:     password,
 		WalletSeed:     cipherSeed,
 		RecoveryWindow: uint32(recoveryWindow),
+		StatelessInit:  in.StatelessInit,
+	}
+
+	// Before we return the unlock payload, we'll check if we can extract
+	// any channel backups to pass up to the higher level sub-system.
+	chansToRestore := extractChanBackups(in.ChannelBackups)
+	if chansToRestore != nil {
+		initMsg.ChanBackups = *chansToRestore
 	}
 
 	u.InitMsgs <- initMsg
 
-	return &lnrpc.InitWalletResponse{}, nil
+	if !in.StatelessInit {
+		return &lnrpc.InitWalletResponse{}, nil
+	}
+
+	// The daemon hands us the admin macaroon once the wallet and the
+	// macaroon service are set up.
+	select {
+	case adminMac := <-u.MacResponseChan:
+		return &lnrpc.InitWalletResponse{
+			AdminMacaroon: adminMac,
+		}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
-// UnlockWallet sends the password provided by the incoming UnlockWalletRequest
-// over the UnlockMsgs channel in case it successfully decrypts an existing
-// wallet found in the chain's wallet database directory.
-func (u *UnlockerService) UnlockWallet(ctx context.Context,
-	in *lnrpc.UnlockWalletRequest) (*lnrpc.UnlockWalletResponse, error) {
+// extractChanBackups is a helper function that extracts the set of channel
+// backups from the proto into a format that we'll pass to higher level
+// sub-systems.
+func extractChanBackups(
+	chanBackups *lnrpc.ChanBackupSnapshot) *ChannelsToRecover {
+
+	// If there aren't any populated channel backups, then we can exit
+	// early as there's nothing to extract.
+	if chanBackups == nil || (chanBackups.SingleChanBackups == nil &&
+		chanBackups.MultiChanBackup == nil) {
+		return nil
+	}
 
-	password := in.WalletPassword
-	recoveryWindow := uint32(in.RecoveryWindow)
+	// Now that we know there's at least a single back up populated, we'll
+	// extract the multi-chan backup (if it's there).
+	var backups ChannelsToRecover
+	if chanBackups.MultiChanBackup != nil {
+		multiBackup := chanBackups.MultiChanBackup
+		backups.PackedMultiChanBackup = chanbackup.PackedMulti(
+			multiBackup.MultiChanBackup,
+		)
+	}
 
-	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
-	loader := wallet.NewLoader(u.netParams, netDir, recoveryWindow)
+	if chanBackups.SingleChanBackups == nil {
+		return &backups
+	}
 
-	// Check if wallet already exists.
-	walletExists, err := loader.WalletExists()
+	// Finally, we can extract all the single chan backups as well.
+	for _, backup := range chanBackups.SingleChanBackups.ChanBackups {
+		singleChanBackup := backup.ChanBackup
+
+		backups.PackedSingleChanBackups = append(
+			backups.PackedSingleChanBackups, singleChanBackup,
+		)
+	}
+
+	return &backups
+}
+
+// writeNewSeed generates a new cipher seed and writes it to the given file,
+// enciphered with the passed aezeed passphrase. The file must not exist yet,
+// to make sure we never overwrite the backup of another wallet's seed.
+func writeNewSeed(seedFile string, aezeedPass []byte) (*aezeed.CipherSeed,
+	error) {
+
+	if seedFile == "" {
+		return nil, errors.New("encrypted seed file must be set for " +
+			"stateless init")
+	}
+
+	var entropy [aezeed.EntropySize]byte
+	if _, err := rand.Read(entropy[:]); err != nil {
+		return nil, err
+	}
+	cipherSeed, err := aezeed.New(
+		keychain.KeyDerivationVersion, &entropy, time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	encipheredSeed, err := cipherSeed.Encipher(aezeedPass)
 	if err != nil {
 		return nil, err
 	}
 
-	if !walletExists {
-		// Cannot unlock a wallet that does not exist!
-		return nil, fmt.Errorf("wallet not found")
+	f, err := os.OpenFile(seedFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create encrypted seed "+
+			"file: %v", err)
+	}
+	if _, err := f.Write(encipheredSeed[:]); err != nil {
+		f.Close()
+		os.Remove(seedFile)
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(seedFile)
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(seedFile)
+		return nil, err
 	}
 
-	// Try opening the existing wallet with the provided password.
-	unlockedWallet, err := loader.OpenExistingWallet(password, false)
+	return cipherSeed, nil
+}
+
+// UnlockWallet sends the password provided by the incoming UnlockWalletRequest
+// over the UnlockMsgs channel in case it successfully decrypts an existing
+// wallet found in the chain's wallet database directory.
+func (u *UnlockerService) UnlockWallet(ctx context.Context,
+	in *lnrpc.UnlockWalletRequest) (*lnrpc.UnlockWalletResponse, error) {
+
+	password := in.WalletPassword
+	recoveryWindow := uint32(in.RecoveryWindow)
+
+	unlockedWallet, err := u.LoadAndUnlock(password, recoveryWindow)
 	if err != nil {
-		// Could not open wallet, most likely this means that provided
-		// password was incorrect.
 		return nil, err
 	}
 
@@ -275,6 +435,7 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 		Passphrase:     password,
 		RecoveryWindow: recoveryWindow,
 		Wallet:         unlockedWallet,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// At this point we was able to open the existing wallet with the
@@ -285,6 +446,49 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	return &lnrpc.UnlockWalletResponse{}, nil
 }
 
+// LoadAndUnlock opens the existing wallet with the given password. This is
+// used by UnlockWallet, and by lnd itself to unlock the wallet at startup
+// when the password is provided through a file. ErrWalletNotFound is
+// returned if the wallet hasn't been created yet.
+func (u *UnlockerService) LoadAndUnlock(password []byte,
+	recoveryWindow uint32) (*wallet.Wallet, error) {
+
+	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
+	loader := wallet.NewLoader(u.netParams, netDir, recoveryWindow)
+
+	// Check if wallet already exists.
+	walletExists, err := loader.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+
+	if !walletExists {
+		// Cannot unlock a wallet that does not exist!
+		return nil, ErrWalletNotFound
+	}
+
+	// Try opening the existing wallet with the provided password. If this
+	// fails, most likely the provided password was incorrect.
+	return loader.OpenExistingWallet(password, false)
+}
+
+// ReadPasswordFile reads the wallet password from the given file. Trailing
+// newlines are stripped, as most editors add one when saving the file.
+func ReadPasswordFile(passwordFile string) ([]byte, error) {
+	content, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read wallet password "+
+			"file: %v", err)
+	}
+
+	password := []byte(strings.TrimRight(string(content), "\r\n"))
+	if err := validatePassword(password); err != nil {
+		return nil, err
+	}
+
+	return password, nil
+}
+
 // ChangePassword changes the password of the wallet and sends the new password
 // across the UnlockPasswords channel to automatically unlock the wallet if
 // successful.
@@ -302,7 +506,7 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	}
 
 	if !walletExists {
-		return nil, errors.New("wallet not found")
+		return nil, ErrWalletNotFound
 	}
 
 	publicPw := in.CurrentPassword
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -36,6 +37,8 @@ var (
 	testNetParams = &chaincfg.MainNetParams
 
 	testRecoveryWindow uint32 = 150
+
+	testMultiBackup = []byte("encrypted multi backup")
 )
 
 func createTestWallet(t *testing.T, dir string, netParams *chaincfg.Params) {
@@ -214,6 +217,11 @@ func TestInitWallet(t *testing.T) {
 		CipherSeedMnemonic: []string(mnemonic[:]),
 		AezeedPassphrase:   pass,
 		RecoveryWindow:     int32(testRecoveryWindow),
+		ChannelBackups: &lnrpc.ChanBackupSnapshot{
+			MultiChanBackup: &lnrpc.MultiChanBackup{
+				MultiChanBackup: testMultiBackup,
+			},
+		},
 	}
 	_, err = service.InitWallet(ctx, req)
 	if err != nil {
@@ -249,6 +257,18 @@ func TestInitWallet(t *testing.T) {
 				msg.RecoveryWindow)
 		}
 
+		// The channel backup should be passed along, so the channels
+		// can be restored once the wallet is created.
+		packedMulti := msg.ChanBackups.PackedMultiChanBackup
+		if !bytes.Equal(packedMulti, testMultiBackup) {
+			t.Fatalf("expected multi backup %x, got %x",
+				testMultiBackup, packedMulti)
+		}
+		if len(msg.ChanBackups.PackedSingleChanBackups) != 0 {
+			t.Fatalf("expected no single backups, got %v",
+				len(msg.ChanBackups.PackedSingleChanBackups))
+		}
+
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
 	}
@@ -271,6 +291,139 @@ func TestInitWallet(t *testing.T) {
 	}
 }
 
+// TestStatelessInitWallet tests that the wallet can be initialized with a seed
+// generated by the unlocker service, which is only written to a file in its
+// enciphered form, and that the admin macaroon is returned to the caller.
+func TestStatelessInitWallet(t *testing.T) {
+	t.Parallel()
+
+	// testDir is empty, meaning wallet was not created from before.
+	testDir, err := ioutil.TempDir("", "testcreate")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer func() {
+		os.RemoveAll(testDir)
+	}()
+
+	// Create new UnlockerService.
+	service := walletunlocker.New(testDir, testNetParams, nil)
+
+	// A seed mnemonic can't be combined with a stateless init.
+	seedFile := filepath.Join(testDir, "seed.enc")
+	ctx := context.Background()
+	req := &lnrpc.InitWalletRequest{
+		WalletPassword:     testPassword,
+		CipherSeedMnemonic: []string{"some", "seed"},
+		StatelessInit:      true,
+		EncryptedSeedFile:  seedFile,
+	}
+	if _, err := service.InitWallet(ctx, req); err == nil {
+		t.Fatalf("expected InitWallet to fail")
+	}
+
+	// Without the mnemonic, the call should only return once the admin
+	// macaroon is sent over the response channel.
+	req.CipherSeedMnemonic = nil
+	req.AezeedPassphrase = []byte("test")
+
+	type initResult struct {
+		resp *lnrpc.InitWalletResponse
+		err  error
+	}
+	results := make(chan initResult, 1)
+	go func() {
+		resp, err := service.InitWallet(ctx, req)
+		results <- initResult{resp, err}
+	}()
+
+	select {
+	case msg := <-service.InitMsgs:
+		if !bytes.Equal(msg.Passphrase, testPassword) {
+			t.Fatalf("expected to receive password %x, "+
+				"got %x", testPassword, msg.Passphrase)
+		}
+		if !msg.StatelessInit {
+			t.Fatalf("expected stateless init")
+		}
+		if msg.WalletSeed == nil {
+			t.Fatalf("expected a generated seed")
+		}
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("password not received")
+	}
+
+	// The seed should have been written to the file enciphered.
+	encipheredSeed, err := ioutil.ReadFile(seedFile)
+	if err != nil {
+		t.Fatalf("unable to read seed file: %v", err)
+	}
+	if len(encipheredSeed) != aezeed.EncipheredCipherSeedSize {
+		t.Fatalf("expected enciphered seed of %v bytes, got %v",
+			aezeed.EncipheredCipherSeedSize, len(encipheredSeed))
+	}
+
+	adminMac := []byte("admin macaroon")
+	service.MacResponseChan <- adminMac
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			t.Fatalf("InitWallet call failed: %v", result.err)
+		}
+		if !bytes.Equal(result.resp.AdminMacaroon, adminMac) {
+			t.Fatalf("expected admin macaroon %x, got %x",
+				adminMac, result.resp.AdminMacaroon)
+		}
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("InitWallet didn't return")
+	}
+
+	// An existing seed file must never be overwritten.
+	if _, err := service.InitWallet(ctx, req); err == nil {
+		t.Fatalf("expected InitWallet to fail")
+	}
+}
+
+// TestReadPasswordFile tests that the wallet password is read from a file
+// without its trailing newline, and that too short passwords are rejected.
+func TestReadPasswordFile(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testpassword")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	pwFile := filepath.Join(testDir, "password")
+	if _, err := walletunlocker.ReadPasswordFile(pwFile); err == nil {
+		t.Fatalf("expected missing password file to fail")
+	}
+
+	content := []byte(string(testPassword) + "\n")
+	if err := ioutil.WriteFile(pwFile, content, 0600); err != nil {
+		t.Fatalf("unable to write password file: %v", err)
+	}
+	password, err := walletunlocker.ReadPasswordFile(pwFile)
+	if err != nil {
+		t.Fatalf("unable to read password file: %v", err)
+	}
+	if !bytes.Equal(password, testPassword) {
+		t.Fatalf("expected password %x, got %x", testPassword,
+			password)
+	}
+
+	if err := ioutil.WriteFile(pwFile, []byte("short\n"), 0600); err != nil {
+		t.Fatalf("unable to write password file: %v", err)
+	}
+	if _, err := walletunlocker.ReadPasswordFile(pwFile); err == nil {
+		t.Fatalf("expected short password to fail")
+	}
+}
+
 // TestInitWalletInvalidCipherSeed tests that if we attempt to create a wallet
 // with an invalid cipher seed, then we'll receive an error.
 func TestCreateWalletInvalidEntropy(t *testing.T) {
@@ -325,12 +478,13 @@ func TestUnlockWallet(t *testing.T) {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: testPassword,
 		RecoveryWindow: int32(testRecoveryWindow),
+		StatelessInit:  true,
 	}
 
 	// Should fail to unlock non-existing wallet.
 	_, err = service.UnlockWallet(ctx, req)
-	if err == nil {
-		t.Fatalf("expected call to UnlockWallet to fail")
+	if err != walletunlocker.ErrWalletNotFound {
+		t.Fatalf("expected ErrWalletNotFound, got %v", err)
 	}
 
 	// Create a wallet we can try to unlock.
@@ -363,6 +517,9 @@ func TestUnlockWallet(t *testing.T) {
 				"got %d", testRecoveryWindow,
 				unlockMsg.RecoveryWindow)
 		}
+		if !unlockMsg.StatelessInit {
+			t.Fatalf("expected stateless init to be set")
+		}
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
 	}
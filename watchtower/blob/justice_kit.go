@@ -20,7 +20,7 @@ const (
 	MinVersion = 0
 
 	// MaxVersion is the maximumm blob version supported by this package.
-	MaxVersion = 0
+	MaxVersion = 1
 
 	// NonceSize is the length of a chacha20poly1305 nonce, 12 bytes.
 	NonceSize = chacha20poly1305.NonceSize
@@ -41,25 +41,85 @@ const (
 	//    commit to-remote pubkey:        33 bytes, maybe blank
 	//    commit to-remote sig:           64 bytes, maybe blank
 	V0PlaintextSize = 273
+
+	// MaxHTLCScriptSize is the size of the largest HTLC witness script that
+	// can appear on a commitment transaction, which is that of an accepted
+	// HTLC. Offered HTLC scripts are zero-padded to this length.
+	MaxHTLCScriptSize = lnwallet.AcceptedHtlcScriptSize
+
+	// V1HTLCSize is the plaintext size of each HTLC output encoded in a
+	// version 1 blob.
+	//    revocation sig:                 64 bytes
+	//    witness script length:           1 byte
+	//    witness script:                139 bytes, zero-padded
+	//    second-level revocation sig:    64 bytes
+	V1HTLCSize = 64 + 1 + MaxHTLCScriptSize + 64
+
+	// V1BasePlaintextSize is the plaintext size of a version 1 encoded blob
+	// that contains no HTLC outputs.
+	//    version 0 plaintext:           273 bytes
+	//    num htlcs:                       2 bytes
+	V1BasePlaintextSize = V0PlaintextSize + 2
+
+	// V1MaxHTLCs is the maximum number of HTLC outputs that can be included
+	// in a version 1 blob. The limit ensures that a maximally sized blob,
+	// along with its nonce, still fits within a single 65KB wire message.
+	V1MaxHTLCs = 240
 )
 
-// Size returns the size of the encoded-and-encrypted blob in bytes.
+// Size returns the size of the encoded-and-encrypted blob in bytes, for a blob
+// carrying numHTLCs HTLC outputs.
 //      enciphered plaintext:  n bytes
 //      MAC:                  16 bytes
-func Size(ver uint16) int {
-	return PlaintextSize(ver) + CiphertextExpansion
+func Size(ver uint16, numHTLCs int) int {
+	return PlaintextSize(ver, numHTLCs) + CiphertextExpansion
 }
 
-// PlaintextSize returns the size of the encoded-but-unencrypted blob in bytes.
-func PlaintextSize(ver uint16) int {
+// PlaintextSize returns the size of the encoded-but-unencrypted blob in bytes,
+// for a blob carrying numHTLCs HTLC outputs. Version 0 blobs cannot carry any
+// HTLC outputs, and are always of constant size.
+func PlaintextSize(ver uint16, numHTLCs int) int {
 	switch ver {
 	case 0:
 		return V0PlaintextSize
+	case 1:
+		return V1BasePlaintextSize + numHTLCs*V1HTLCSize
 	default:
 		return 0
 	}
 }
 
+// MaxHTLCs returns the maximum number of HTLC outputs that can be carried by a
+// blob of the given version.
+func MaxHTLCs(ver uint16) int {
+	switch ver {
+	case 1:
+		return V1MaxHTLCs
+	default:
+		return 0
+	}
+}
+
+// IsValidSize returns true if size is the length of an encrypted blob of the
+// given version, carrying any permitted number of HTLC outputs.
+func IsValidSize(ver uint16, size int) bool {
+	switch ver {
+	case 0:
+		return size == Size(0, 0)
+
+	case 1:
+		htlcsSize := size - Size(1, 0)
+		if htlcsSize < 0 || htlcsSize%V1HTLCSize != 0 {
+			return false
+		}
+
+		return htlcsSize/V1HTLCSize <= V1MaxHTLCs
+
+	default:
+		return false
+	}
+}
+
 var (
 	// byteOrder specifies a big-endian encoding of all integer values.
 	byteOrder = binary.BigEndian
@@ -89,11 +149,40 @@ var (
 	ErrNoCommitToRemoteOutput = errors.New(
 		"cannot obtain commit to-remote p2wkh output script from blob",
 	)
+
+	// ErrTooManyHTLCs signals that the justice kit contains more HTLC
+	// outputs than can be encoded by the requested blob version.
+	ErrTooManyHTLCs = errors.New("too many htlc outputs for blob version")
+
+	// ErrHTLCScriptTooLarge signals that an HTLC witness script exceeds
+	// the maximum size of an HTLC script on a commitment transaction.
+	ErrHTLCScriptTooLarge = errors.New("htlc witness script too large")
 )
 
 // PubKey is a 33-byte, serialized compressed public key.
 type PubKey [33]byte
 
+// HTLCOutput contains the information required to sweep a single HTLC output
+// on the breached commitment transaction, as well as the output created if the
+// remote party moves the HTLC to the second level.
+type HTLCOutput struct {
+	// RevocationSig is a signature under the JusticeKit's RevocationPubKey
+	// spending the HTLC output on the breached commitment, using
+	// SIGHASH_ALL. The HTLC output is swept by its own justice
+	// transaction, independent of the commitment outputs and other HTLCs.
+	RevocationSig lnwire.Sig
+
+	// WitnessScript is the witness script of the offered or accepted HTLC
+	// output on the breached commitment transaction.
+	WitnessScript []byte
+
+	// SecondLevelSig is a signature under the JusticeKit's
+	// RevocationPubKey spending the output of the remote party's
+	// second-level HTLC transaction, using SIGHASH_ALL. The output shares
+	// the same script as the commitment to-local output.
+	SecondLevelSig lnwire.Sig
+}
+
 // JusticeKit is lé Blob of Justice. The JusticeKit contains information
 // required to construct a justice transaction, that sweeps a remote party's
 // revoked commitment transaction. It supports encryption and decryption using
@@ -140,6 +229,12 @@ type JusticeKit struct {
 	// NOTE: This value is only used if CommitToRemotePubKey contains a valid
 	// compressed public key.
 	CommitToRemoteSig lnwire.Sig
+
+	// HTLCs contains the revocation signatures and scripts for each
+	// non-dust HTLC output on the breached commitment transaction.
+	//
+	// NOTE: This field is only encoded by blob versions 1 and above.
+	HTLCs []HTLCOutput
 }
 
 // CommitToLocalWitnessScript returns the serialized witness script for the
@@ -169,7 +264,9 @@ func (b *JusticeKit) CommitToLocalWitnessScript() ([]byte, error) {
 //   <revocation-sig> 1
 func (b *JusticeKit) CommitToLocalRevokeWitnessStack() [][]byte {
 	witnessStack := make([][]byte, 2)
-	witnessStack[0] = append(b.CommitToLocalSig[:], byte(txscript.SigHashAll))
+	witnessStack[0] = append(
+		b.CommitToLocalSig.ToSignatureBytes(), byte(txscript.SigHashAll),
+	)
 	witnessStack[1] = []byte{1}
 
 	return witnessStack
@@ -196,7 +293,39 @@ func (b *JusticeKit) CommitToRemoteWitnessScript() ([]byte, error) {
 //   <to-remote-sig>
 func (b *JusticeKit) CommitToRemoteWitnessStack() [][]byte {
 	witnessStack := make([][]byte, 1)
-	witnessStack[0] = append(b.CommitToRemoteSig[:], byte(txscript.SigHashAll))
+	witnessStack[0] = append(
+		b.CommitToRemoteSig.ToSignatureBytes(), byte(txscript.SigHashAll),
+	)
+
+	return witnessStack
+}
+
+// HTLCRevokeWitnessStack constructs a witness stack spending the revocation
+// clause of the i-th HTLC output on the breached commitment. The stack is
+// identical for offered and accepted HTLCs.
+//   <revocation-sig> <revocation-pubkey>
+func (b *JusticeKit) HTLCRevokeWitnessStack(i int) [][]byte {
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = append(
+		b.HTLCs[i].RevocationSig.ToSignatureBytes(),
+		byte(txscript.SigHashAll),
+	)
+	witnessStack[1] = b.RevocationPubKey[:]
+
+	return witnessStack
+}
+
+// HTLCSecondLevelRevokeWitnessStack constructs a witness stack spending the
+// revocation clause of the second-level output created from the i-th HTLC
+// output on the breached commitment.
+//   <revocation-sig> 1
+func (b *JusticeKit) HTLCSecondLevelRevokeWitnessStack(i int) [][]byte {
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = append(
+		b.HTLCs[i].SecondLevelSig.ToSignatureBytes(),
+		byte(txscript.SigHashAll),
+	)
+	witnessStack[1] = []byte{1}
 
 	return witnessStack
 }
@@ -298,7 +427,12 @@ func Decrypt(nonce, key, ciphertext []byte, version uint16) (*JusticeKit, error)
 func (b *JusticeKit) encode(w io.Writer, ver uint16) error {
 	switch ver {
 	case 0:
+		if len(b.HTLCs) > 0 {
+			return ErrTooManyHTLCs
+		}
 		return b.encodeV0(w)
+	case 1:
+		return b.encodeV1(w)
 	default:
 		return ErrUnknownBlobVersion
 	}
@@ -310,6 +444,8 @@ func (b *JusticeKit) decode(r io.Reader, ver uint16) error {
 	switch ver {
 	case 0:
 		return b.decodeV0(r)
+	case 1:
+		return b.decodeV1(r)
 	default:
 		return ErrUnknownBlobVersion
 	}
@@ -440,3 +576,148 @@ func (b *JusticeKit) decodeV0(r io.Reader) error {
 
 	return nil
 }
+
+// encodeV1 encodes the JusticeKit using the version 1 encoding scheme to the
+// provided io.Writer. The encoding extends version 0 with the information
+// required to sweep each HTLC output on the breached commitment, and the
+// second-level output should the remote party transition the HTLC. The
+// plaintext size is 275 bytes, plus 268 bytes for each HTLC output.
+//
+// blob version 1 plaintext encoding:
+//    version 0 plaintext:           273 bytes
+//    num htlcs:                       2 bytes
+//    for each htlc:
+//      revocation sig:               64 bytes
+//      witness script length:         1 byte
+//      witness script:              139 bytes, zero-padded
+//      second-level revocation sig:  64 bytes
+func (b *JusticeKit) encodeV1(w io.Writer) error {
+	if len(b.HTLCs) > V1MaxHTLCs {
+		return ErrTooManyHTLCs
+	}
+
+	// Write the 273-byte version 0 encoding.
+	err := b.encodeV0(w)
+	if err != nil {
+		return err
+	}
+
+	// Write 2-byte number of HTLC outputs.
+	err = binary.Write(w, byteOrder, uint16(len(b.HTLCs)))
+	if err != nil {
+		return err
+	}
+
+	for _, htlc := range b.HTLCs {
+		scriptLen := len(htlc.WitnessScript)
+		if scriptLen > MaxHTLCScriptSize {
+			return ErrHTLCScriptTooLarge
+		}
+
+		// Write 64-byte revocation signature for the HTLC output.
+		_, err = w.Write(htlc.RevocationSig[:])
+		if err != nil {
+			return err
+		}
+
+		// Write 1-byte witness script length, followed by the witness
+		// script padded to 139 bytes.
+		var script [MaxHTLCScriptSize]byte
+		copy(script[:], htlc.WitnessScript)
+
+		_, err = w.Write([]byte{uint8(scriptLen)})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(script[:])
+		if err != nil {
+			return err
+		}
+
+		// Write 64-byte revocation signature for the second-level
+		// output.
+		_, err = w.Write(htlc.SecondLevelSig[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeV1 reconstructs a JusticeKit from the io.Reader, using version 1
+// encoding scheme. After parsing the version 0 fields, the number of HTLC
+// outputs is read, followed by a fixed-size record for each.
+//
+// blob version 1 plaintext encoding:
+//    version 0 plaintext:           273 bytes
+//    num htlcs:                       2 bytes
+//    for each htlc:
+//      revocation sig:               64 bytes
+//      witness script length:         1 byte
+//      witness script:              139 bytes, zero-padded
+//      second-level revocation sig:  64 bytes
+func (b *JusticeKit) decodeV1(r io.Reader) error {
+	// Read the 273-byte version 0 encoding.
+	err := b.decodeV0(r)
+	if err != nil {
+		return err
+	}
+
+	// Read 2-byte number of HTLC outputs.
+	var numHTLCs uint16
+	err = binary.Read(r, byteOrder, &numHTLCs)
+	if err != nil {
+		return err
+	}
+	if numHTLCs > V1MaxHTLCs {
+		return ErrTooManyHTLCs
+	}
+
+	// Leave the HTLCs nil if there are none, such that the decoded blob is
+	// identical to one that was encoded without any HTLC outputs.
+	if numHTLCs == 0 {
+		return nil
+	}
+
+	b.HTLCs = make([]HTLCOutput, numHTLCs)
+	for i := range b.HTLCs {
+		htlc := &b.HTLCs[i]
+
+		// Read 64-byte revocation signature for the HTLC output.
+		_, err = io.ReadFull(r, htlc.RevocationSig[:])
+		if err != nil {
+			return err
+		}
+
+		// Read 1-byte witness script length, followed by the padded
+		// 139-byte witness script.
+		var (
+			scriptLen [1]byte
+			script    [MaxHTLCScriptSize]byte
+		)
+		_, err = io.ReadFull(r, scriptLen[:])
+		if err != nil {
+			return err
+		}
+		if int(scriptLen[0]) > MaxHTLCScriptSize {
+			return ErrHTLCScriptTooLarge
+		}
+		_, err = io.ReadFull(r, script[:])
+		if err != nil {
+			return err
+		}
+
+		htlc.WitnessScript = make([]byte, scriptLen[0])
+		copy(htlc.WitnessScript, script[:])
+
+		// Read 64-byte revocation signature for the second-level
+		// output.
+		_, err = io.ReadFull(r, htlc.SecondLevelSig[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -27,6 +27,27 @@ func makeSig(i int) lnwire.Sig {
 	return sig
 }
 
+func makeHTLCs(n int) []blob.HTLCOutput {
+	htlcs := make([]blob.HTLCOutput, n)
+	for i := range htlcs {
+		// Alternate between the sizes of offered and accepted HTLC
+		// scripts to exercise the padding of shorter scripts.
+		scriptLen := blob.MaxHTLCScriptSize
+		if i%2 == 1 {
+			scriptLen -= 6
+		}
+		script := make([]byte, scriptLen)
+		binary.BigEndian.PutUint64(script[:8], uint64(i))
+
+		htlcs[i] = blob.HTLCOutput{
+			RevocationSig:  makeSig(2*i + 3),
+			WitnessScript:  script,
+			SecondLevelSig: makeSig(2*i + 4),
+		}
+	}
+	return htlcs
+}
+
 var descriptorTests = []struct {
 	name                 string
 	encVersion           uint16
@@ -38,6 +59,7 @@ var descriptorTests = []struct {
 	hasCommitToRemote    bool
 	commitToRemotePubKey blob.PubKey
 	commitToRemoteSig    lnwire.Sig
+	htlcs                []blob.HTLCOutput
 	encErr               error
 	decErr               error
 }{
@@ -64,7 +86,7 @@ var descriptorTests = []struct {
 	},
 	{
 		name:             "unknown encrypt version",
-		encVersion:       1,
+		encVersion:       2,
 		decVersion:       0,
 		revPubKey:        makePubKey(0),
 		delayPubKey:      makePubKey(1),
@@ -74,20 +96,74 @@ var descriptorTests = []struct {
 	},
 	{
 		name:             "unknown decrypt version",
-		encVersion:       0,
-		decVersion:       1,
+		encVersion:       1,
+		decVersion:       2,
 		revPubKey:        makePubKey(0),
 		delayPubKey:      makePubKey(1),
 		csvDelay:         144,
 		commitToLocalSig: makeSig(1),
 		decErr:           blob.ErrUnknownBlobVersion,
 	},
+	{
+		name:             "v1 to-local only",
+		encVersion:       1,
+		decVersion:       1,
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+	},
+	{
+		name:                 "v1 to-local, p2wkh, and htlcs",
+		encVersion:           1,
+		decVersion:           1,
+		revPubKey:            makePubKey(0),
+		delayPubKey:          makePubKey(1),
+		csvDelay:             144,
+		commitToLocalSig:     makeSig(1),
+		hasCommitToRemote:    true,
+		commitToRemotePubKey: makePubKey(2),
+		commitToRemoteSig:    makeSig(2),
+		htlcs:                makeHTLCs(5),
+	},
+	{
+		name:             "v1 max htlcs",
+		encVersion:       1,
+		decVersion:       1,
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+		htlcs:            makeHTLCs(blob.V1MaxHTLCs),
+	},
+	{
+		name:             "v1 too many htlcs",
+		encVersion:       1,
+		decVersion:       1,
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+		htlcs:            makeHTLCs(blob.V1MaxHTLCs + 1),
+		encErr:           blob.ErrTooManyHTLCs,
+	},
+	{
+		name:             "v0 with htlcs",
+		encVersion:       0,
+		decVersion:       0,
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+		htlcs:            makeHTLCs(1),
+		encErr:           blob.ErrTooManyHTLCs,
+	},
 }
 
 // TestBlobJusticeKitEncryptDecrypt asserts that encrypting and decrypting a
 // plaintext blob produces the original. The tests include negative assertions
 // when passed invalid combinations, and that all successfully encrypted blobs
-// are of constant size.
+// are of the size expected for their version and number of HTLCs.
 func TestBlobJusticeKitEncryptDecrypt(t *testing.T) {
 	for i, test := range descriptorTests {
 		boj := &blob.JusticeKit{
@@ -97,6 +173,7 @@ func TestBlobJusticeKitEncryptDecrypt(t *testing.T) {
 			CommitToLocalSig:     test.commitToLocalSig,
 			CommitToRemotePubKey: test.commitToRemotePubKey,
 			CommitToRemoteSig:    test.commitToRemoteSig,
+			HTLCs:                test.htlcs,
 		}
 
 		// Generate a random encryption key for the blob. The key is
@@ -128,14 +205,21 @@ func TestBlobJusticeKitEncryptDecrypt(t *testing.T) {
 			continue
 		}
 
-		// Ensure that all encrypted blobs are padded out to the same
-		// size: 282 bytes for version 0.
-		if len(ctxt) != blob.Size(test.encVersion) {
+		// Ensure that all encrypted blobs are padded out to the size
+		// expected for the version and number of HTLCs: 289 bytes for
+		// version 0, and 291 bytes plus 268 bytes per HTLC for version
+		// 1.
+		expSize := blob.Size(test.encVersion, len(test.htlcs))
+		if len(ctxt) != expSize {
 			t.Fatalf("test #%d %s -- expected blob to have "+
 				"size %d, got %d instead", i, test.name,
-				blob.Size(test.encVersion), len(ctxt))
+				expSize, len(ctxt))
 
 		}
+		if !blob.IsValidSize(test.encVersion, len(ctxt)) {
+			t.Fatalf("test #%d %s -- blob of size %d reported "+
+				"as invalid", i, test.name, len(ctxt))
+		}
 
 		// Decrypt the encrypted blob, reconstructing the original
 		// blob plaintext from the decrypted contents. We use the target
@@ -168,3 +252,66 @@ func TestBlobJusticeKitEncryptDecrypt(t *testing.T) {
 		}
 	}
 }
+
+// TestBlobIsValidSize asserts that only the encrypted sizes producible by
+// each blob version are reported as valid.
+func TestBlobIsValidSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		version uint16
+		size    int
+		valid   bool
+	}{
+		{
+			name:    "v0",
+			version: 0,
+			size:    blob.Size(0, 0),
+			valid:   true,
+		},
+		{
+			name:    "v0 too large",
+			version: 0,
+			size:    blob.Size(0, 0) + 1,
+		},
+		{
+			name:    "v1 no htlcs",
+			version: 1,
+			size:    blob.Size(1, 0),
+			valid:   true,
+		},
+		{
+			name:    "v1 max htlcs",
+			version: 1,
+			size:    blob.Size(1, blob.V1MaxHTLCs),
+			valid:   true,
+		},
+		{
+			name:    "v1 too many htlcs",
+			version: 1,
+			size:    blob.Size(1, blob.V1MaxHTLCs+1),
+		},
+		{
+			name:    "v1 partial htlc",
+			version: 1,
+			size:    blob.Size(1, 1) - 1,
+		},
+		{
+			name:    "v1 too small",
+			version: 1,
+			size:    blob.Size(1, 0) - 1,
+		},
+		{
+			name:    "unknown version",
+			version: blob.MaxVersion + 1,
+			size:    blob.Size(0, 0),
+		},
+	}
+
+	for _, test := range tests {
+		valid := blob.IsValidSize(test.version, test.size)
+		if valid != test.valid {
+			t.Fatalf("%s: expected valid=%v for size %d, got %v",
+				test.name, test.valid, test.size, valid)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package watchtower
+
+import (
+	"net"
+	"time"
+)
+
+// Conf specifies the watchtower options that can be configured from the
+// command line or configuration file.
+type Conf struct {
+	Active bool `long:"active" description:"If true, the watchtower will be active."`
+
+	TowerDir string `long:"towerdir" description:"Directory of the watchtower.db"`
+
+	RawListeners []string `long:"listen" description:"Add interfaces/ports to listen for peer connections"`
+
+	ReadTimeout time.Duration `long:"readtimeout" description:"Duration the watchtower server will wait for messages to be received before hanging up on clients"`
+
+	WriteTimeout time.Duration `long:"writetimeout" description:"Duration the watchtower server will wait for messages to be written before hanging up on client connections"`
+
+	// Listeners is the normalized set of addresses derived from
+	// RawListeners on which the watchtower will accept clients.
+	Listeners []net.Addr
+}
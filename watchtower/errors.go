@@ -0,0 +1,9 @@
+package watchtower
+
+import "errors"
+
+var (
+	// ErrNoListeners signals that no listening ports were provided,
+	// rendering the tower unable to receive client requests.
+	ErrNoListeners = errors.New("no listening ports were specified")
+)
@@ -0,0 +1,57 @@
+package lookout
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+// BlockFetcher supports the ability to fetch blocks from the backend or
+// network.
+type BlockFetcher interface {
+	// GetBlock fetches the block given the target block hash.
+	GetBlock(*chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// EpochRegistrar supports the ability to register for events corresponding to
+// newly created blocks.
+type EpochRegistrar interface {
+	// RegisterBlockEpochNtfn registers for a new block epoch subscription.
+	// The implementation must support historical delivery, such that all
+	// blocks after the passed best block are delivered to the caller.
+	RegisterBlockEpochNtfn(
+		*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error)
+}
+
+// SpendRegistrar supports the ability to register for notifications when an
+// outpoint is spent.
+type SpendRegistrar interface {
+	// RegisterSpendNtfn registers for a notification once the outpoint,
+	// whose output pays to the given pkscript, is spent. Spends at or
+	// after the height hint must be delivered, even if they confirmed
+	// before registration.
+	RegisterSpendNtfn(*wire.OutPoint, []byte,
+		uint32) (*chainntnfs.SpendEvent, error)
+}
+
+// DB abstracts the required persistent calls expected by the lookout. DB
+// provides access to all of the state updates sent by clients, and can query
+// for state updates that match a certain breach hint. The last processed block
+// is also persisted, allowing the lookout to resume from where it left off
+// after a restart.
+type DB interface {
+	// GetLookoutTip returns the last block epoch at which the tower
+	// performed a match. If no match has been done, a nil epoch will be
+	// returned.
+	GetLookoutTip() (*chainntnfs.BlockEpoch, error)
+
+	// QueryMatches searches its database for any state updates matching
+	// the provided breach hints. If any matches are found, they will be
+	// returned along with encrypted blobs so that justice can be exacted.
+	QueryMatches([]wtdb.BreachHint) ([]wtdb.Match, error)
+
+	// SetLookoutTip writes the best epoch for which the watchtower has
+	// queried for breach hints.
+	SetLookoutTip(*chainntnfs.BlockEpoch) error
+}
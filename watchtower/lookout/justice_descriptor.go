@@ -0,0 +1,500 @@
+package lookout
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+var (
+	// ErrOutputNotFound signals that the breached output could not be found
+	// on the commitment transaction.
+	ErrOutputNotFound = errors.New("unable to find output on commit tx")
+
+	// ErrUnknownSweepAddrType signals that client provided an output that
+	// was not p2wkh or p2wsh.
+	ErrUnknownSweepAddrType = errors.New("sweep addr is not p2wkh or p2wsh")
+
+	// ErrSweepAmountBelowDust signals that the value swept by the justice
+	// transaction, after accounting for fees, would be below the dust
+	// limit.
+	ErrSweepAmountBelowDust = errors.New("justice output would be dust")
+
+	// ErrNotSecondLevelTx signals that the provided transaction does not
+	// spend any of the HTLC outputs included in the justice kit.
+	ErrNotSecondLevelTx = errors.New("transaction does not spend a " +
+		"breached htlc output")
+)
+
+// JusticeDescriptor contains the information required to sweep a breached
+// channel on behalf of a victim. It supports the ability to create the justice
+// transaction that sweeps the commitments and recover a cut of the channel for
+// the watcher's eternal vigilance.
+type JusticeDescriptor struct {
+	// BreachedCommitTx is the commitment transaction that caused the breach
+	// to be detected.
+	BreachedCommitTx *wire.MsgTx
+
+	// SessionInfo contains the contract with the watchtower client and
+	// the prenegotiated terms they agreed to.
+	SessionInfo *wtdb.SessionInfo
+
+	// JusticeKit contains the decrypted blob and information required to
+	// construct the transaction scripts and witnesses.
+	JusticeKit *blob.JusticeKit
+}
+
+// breachedInput contains the required information to construct and spend
+// breached outputs on a commitment transaction.
+type breachedInput struct {
+	txOut    *wire.TxOut
+	outPoint wire.OutPoint
+	witness  [][]byte
+}
+
+// commitToLocalInput extracts the information required to spend the commit
+// to-local output.
+func (p *JusticeDescriptor) commitToLocalInput() (*breachedInput, error) {
+	// Retrieve the to-local witness script from the justice kit.
+	toLocalScript, err := p.JusticeKit.CommitToLocalWitnessScript()
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the witness script hash, which will be used to locate the
+	// input on the breaching commitment transaction.
+	toLocalWitnessHash, err := lnwallet.WitnessScriptHash(toLocalScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locate the to-local output on the breaching commitment transaction.
+	toLocalIndex, toLocalTxOut, err := findTxOutByPkScript(
+		p.BreachedCommitTx, toLocalWitnessHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the to-local outpoint that will be spent in the justice
+	// transaction.
+	toLocalOutPoint := wire.OutPoint{
+		Hash:  p.BreachedCommitTx.TxHash(),
+		Index: toLocalIndex,
+	}
+
+	// Retrieve to-local witness stack, which primarily includes a signature
+	// under the revocation pubkey.
+	witnessStack := p.JusticeKit.CommitToLocalRevokeWitnessStack()
+
+	return &breachedInput{
+		txOut:    toLocalTxOut,
+		outPoint: toLocalOutPoint,
+		witness:  buildWitness(witnessStack, toLocalScript),
+	}, nil
+}
+
+// commitToRemoteInput extracts the information required to spend the commit
+// to-remote output.
+func (p *JusticeDescriptor) commitToRemoteInput() (*breachedInput, error) {
+	// Retrieve the to-remote witness script from the justice kit.
+	toRemoteScript, err := p.JusticeKit.CommitToRemoteWitnessScript()
+	if err != nil {
+		return nil, err
+	}
+
+	// Since the to-remote witness script should just be a regular p2wkh
+	// output, we'll parse it to retrieve the public key.
+	toRemotePubKey, err := btcec.ParsePubKey(toRemoteScript, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the witness script hash from the to-remote pubkey, which will
+	// be used to locate the input on the breach commitment transaction.
+	toRemoteScriptHash, err := lnwallet.CommitScriptUnencumbered(
+		toRemotePubKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locate the to-remote output on the breaching commitment transaction.
+	toRemoteIndex, toRemoteTxOut, err := findTxOutByPkScript(
+		p.BreachedCommitTx, toRemoteScriptHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the to-remote outpoint which will be spent in the justice
+	// transaction.
+	toRemoteOutPoint := wire.OutPoint{
+		Hash:  p.BreachedCommitTx.TxHash(),
+		Index: toRemoteIndex,
+	}
+
+	// Retrieve the to-remote witness stack, which is just a signature under
+	// the to-remote pubkey.
+	witnessStack := p.JusticeKit.CommitToRemoteWitnessStack()
+
+	return &breachedInput{
+		txOut:    toRemoteTxOut,
+		outPoint: toRemoteOutPoint,
+		witness:  buildWitness(witnessStack, toRemoteScript),
+	}, nil
+}
+
+// htlcInput extracts the information required to spend the i-th HTLC output
+// included in the justice kit.
+func (p *JusticeDescriptor) htlcInput(i int) (*breachedInput, error) {
+	htlcScript := p.JusticeKit.HTLCs[i].WitnessScript
+
+	// Compute the witness script hash, which will be used to locate the
+	// input on the breaching commitment transaction.
+	htlcWitnessHash, err := lnwallet.WitnessScriptHash(htlcScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locate the HTLC output on the breaching commitment transaction.
+	htlcIndex, htlcTxOut, err := findTxOutByPkScript(
+		p.BreachedCommitTx, htlcWitnessHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcOutPoint := wire.OutPoint{
+		Hash:  p.BreachedCommitTx.TxHash(),
+		Index: htlcIndex,
+	}
+
+	// Retrieve the HTLC witness stack, which contains a signature and the
+	// revocation pubkey.
+	witnessStack := p.JusticeKit.HTLCRevokeWitnessStack(i)
+
+	return &breachedInput{
+		txOut:    htlcTxOut,
+		outPoint: htlcOutPoint,
+		witness:  buildWitness(witnessStack, htlcScript),
+	}, nil
+}
+
+// assembleJusticeTxn accepts the breached inputs recovered from state update
+// and attempts to construct the justice transaction that sweeps the victims
+// funds to their wallet. The transaction pays the fee rate negotiated for the
+// session, and is sorted according to BIP69 before the witnesses for each
+// input are attached.
+func (p *JusticeDescriptor) assembleJusticeTxn(txWeight int64,
+	inputs ...*breachedInput) (*wire.MsgTx, error) {
+
+	justiceTxn := wire.NewMsgTx(2)
+
+	// First, add the breached inputs to our justice transaction
+	// and compute the total amount that will be swept.
+	var totalAmt btcutil.Amount
+	for _, input := range inputs {
+		totalAmt += btcutil.Amount(input.txOut.Value)
+		justiceTxn.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: input.outPoint,
+		})
+	}
+
+	// Using the total input amount and the transaction's weight, compute
+	// the amount that will be swept back to the victim after paying the
+	// negotiated fee.
+	txFee := p.SessionInfo.SweepFeeRate.FeeForWeight(txWeight)
+	sweepAmt := totalAmt - txFee
+	if sweepAmt < lnwallet.DefaultDustLimit() {
+		return nil, ErrSweepAmountBelowDust
+	}
+
+	sweepPkScript, err := parseSweepAddress(p.JusticeKit.SweepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	justiceTxn.AddTxOut(&wire.TxOut{
+		PkScript: sweepPkScript,
+		Value:    int64(sweepAmt),
+	})
+
+	// Sort the justice transaction according to BIP69.
+	txsort.InPlaceSort(justiceTxn)
+
+	// Check that the justice transaction meets basic validity requirements
+	// before attempting to attach the witnesses.
+	btx := btcutil.NewTx(justiceTxn)
+	if err := blockchain.CheckTransactionSanity(btx); err != nil {
+		return nil, err
+	}
+
+	// Since the transaction inputs could have been reordered as a result of
+	// the BIP69 sort, create an index mapping each prevout to it's new
+	// index.
+	inputIndex := make(map[wire.OutPoint]int)
+	for i, txIn := range justiceTxn.TxIn {
+		inputIndex[txIn.PreviousOutPoint] = i
+	}
+
+	// Attach each of the provided witnesses to the transaction.
+	for _, input := range inputs {
+		// Lookup the input's new post-sort position.
+		i := inputIndex[input.outPoint]
+		justiceTxn.TxIn[i].Witness = input.witness
+	}
+
+	return justiceTxn, nil
+}
+
+// CreateJusticeTxn computes the justice transaction that sweeps the to-local
+// and to-remote outputs of a breaching commitment transaction. The justice
+// transaction is constructed by assembling the witnesses using data provided
+// by the client in the justice kit, and paying the fee rate agreed upon in the
+// session's contract. HTLC outputs are swept separately using
+// CreateHTLCJusticeTxn, as the breaching party may spend them at any time.
+func (p *JusticeDescriptor) CreateJusticeTxn() (*wire.MsgTx, error) {
+	var (
+		sweepInputs    = make([]*breachedInput, 0, 2)
+		weightEstimate lnwallet.TxWeightEstimator
+	)
+
+	// Add our reward address to the weight estimate.
+	sweepPkScript, err := parseSweepAddress(p.JusticeKit.SweepAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := addScriptWeight(&weightEstimate, sweepPkScript); err != nil {
+		return nil, err
+	}
+
+	// Assemble the breached to-local output from the justice descriptor and
+	// add it to our weight estimate.
+	toLocalInput, err := p.commitToLocalInput()
+	if err != nil {
+		return nil, err
+	}
+	weightEstimate.AddWitnessInput(lnwallet.ToLocalPenaltyWitnessSize)
+	sweepInputs = append(sweepInputs, toLocalInput)
+
+	// If the justice kit specifies that we have to sweep the to-remote
+	// output, we'll also try to assemble the output and add it to weight
+	// estimate if successful.
+	if p.JusticeKit.HasCommitToRemoteOutput() {
+		toRemoteInput, err := p.commitToRemoteInput()
+		if err != nil {
+			return nil, err
+		}
+		weightEstimate.AddWitnessInput(lnwallet.P2WKHWitnessSize)
+		sweepInputs = append(sweepInputs, toRemoteInput)
+	}
+
+	txWeight := int64(weightEstimate.Weight())
+
+	return p.assembleJusticeTxn(txWeight, sweepInputs...)
+}
+
+// CreateHTLCJusticeTxn computes the justice transaction that sweeps the i-th
+// HTLC output included in the justice kit via its revocation clause. Each
+// HTLC is swept in its own transaction, since the breaching party can move an
+// HTLC to the second level before the tower acts, which would invalidate any
+// transaction spending it alongside other outputs.
+func (p *JusticeDescriptor) CreateHTLCJusticeTxn(i int) (*wire.MsgTx, error) {
+	if i < 0 || i >= len(p.JusticeKit.HTLCs) {
+		return nil, ErrOutputNotFound
+	}
+
+	var weightEstimate lnwallet.TxWeightEstimator
+
+	// Add our reward address to the weight estimate.
+	sweepPkScript, err := parseSweepAddress(p.JusticeKit.SweepAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := addScriptWeight(&weightEstimate, sweepPkScript); err != nil {
+		return nil, err
+	}
+
+	htlcInput, err := p.htlcInput(i)
+	if err != nil {
+		return nil, err
+	}
+	weightEstimate.AddWitnessInput(
+		htlcPenaltyWitnessSize(p.JusticeKit.HTLCs[i].WitnessScript),
+	)
+
+	txWeight := int64(weightEstimate.Weight())
+
+	return p.assembleJusticeTxn(txWeight, htlcInput)
+}
+
+// CreateSecondLevelJusticeTxn computes the justice transaction that sweeps the
+// output of a second-level HTLC transaction, which the breaching party used to
+// spend one of the HTLC outputs on their revoked commitment. The second-level
+// output shares the to-local script of the breached commitment, and is swept
+// via its revocation clause using the signature provided by the client for
+// that HTLC.
+func (p *JusticeDescriptor) CreateSecondLevelJusticeTxn(
+	secondLevelTx *wire.MsgTx) (*wire.MsgTx, error) {
+
+	if len(secondLevelTx.TxIn) != 1 || len(secondLevelTx.TxOut) != 1 {
+		return nil, ErrNotSecondLevelTx
+	}
+
+	// Determine which of the HTLCs in the justice kit is spent by the
+	// second-level transaction, by matching the pkscript of the breached
+	// commitment output it spends.
+	commitTxID := p.BreachedCommitTx.TxHash()
+	prevOut := secondLevelTx.TxIn[0].PreviousOutPoint
+	if prevOut.Hash != commitTxID ||
+		prevOut.Index >= uint32(len(p.BreachedCommitTx.TxOut)) {
+
+		return nil, ErrNotSecondLevelTx
+	}
+	spentPkScript := p.BreachedCommitTx.TxOut[prevOut.Index].PkScript
+
+	htlcIndex := -1
+	for i, htlc := range p.JusticeKit.HTLCs {
+		htlcWitnessHash, err := lnwallet.WitnessScriptHash(
+			htlc.WitnessScript,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(htlcWitnessHash, spentPkScript) {
+			htlcIndex = i
+			break
+		}
+	}
+	if htlcIndex < 0 {
+		return nil, ErrNotSecondLevelTx
+	}
+
+	var weightEstimate lnwallet.TxWeightEstimator
+
+	// Add our reward address to the weight estimate.
+	sweepPkScript, err := parseSweepAddress(p.JusticeKit.SweepAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := addScriptWeight(&weightEstimate, sweepPkScript); err != nil {
+		return nil, err
+	}
+
+	// The second-level output pays to the same script as the to-local
+	// output on the breached commitment.
+	secondLevelScript, err := p.JusticeKit.CommitToLocalWitnessScript()
+	if err != nil {
+		return nil, err
+	}
+	secondLevelWitnessHash, err := lnwallet.WitnessScriptHash(
+		secondLevelScript,
+	)
+	if err != nil {
+		return nil, err
+	}
+	secondLevelIndex, secondLevelTxOut, err := findTxOutByPkScript(
+		secondLevelTx, secondLevelWitnessHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := p.JusticeKit.HTLCSecondLevelRevokeWitnessStack(
+		htlcIndex,
+	)
+	secondLevelInput := &breachedInput{
+		txOut: secondLevelTxOut,
+		outPoint: wire.OutPoint{
+			Hash:  secondLevelTx.TxHash(),
+			Index: secondLevelIndex,
+		},
+		witness: buildWitness(witnessStack, secondLevelScript),
+	}
+	weightEstimate.AddWitnessInput(lnwallet.ToLocalPenaltyWitnessSize)
+
+	txWeight := int64(weightEstimate.Weight())
+
+	return p.assembleJusticeTxn(txWeight, secondLevelInput)
+}
+
+// findTxOutByPkScript searches the given transaction for an output whose
+// pkscript matches the query. If one is found, the TxOut is returned along with
+// the index.
+//
+// NOTE: The search stops after the first match is found.
+func findTxOutByPkScript(txn *wire.MsgTx,
+	pkScript []byte) (uint32, *wire.TxOut, error) {
+
+	found, index := lnwallet.FindScriptOutputIndex(txn, pkScript)
+	if !found {
+		return 0, nil, ErrOutputNotFound
+	}
+
+	return index, txn.TxOut[index], nil
+}
+
+// parseSweepAddress extracts the witness program stored in the justice kit's
+// fixed-size sweep address. The length of the program is determined by the
+// data push following the witness version, allowing any trailing padding to be
+// discarded.
+func parseSweepAddress(sweepAddr [42]byte) ([]byte, error) {
+	scriptLen := 2 + int(sweepAddr[1])
+	if scriptLen > len(sweepAddr) {
+		return nil, ErrUnknownSweepAddrType
+	}
+
+	pkScript := sweepAddr[:scriptLen]
+	if !txscript.IsWitnessProgram(pkScript) {
+		return nil, ErrUnknownSweepAddrType
+	}
+
+	return pkScript, nil
+}
+
+// addScriptWeight parses the passed pkscript and adds the computed weight cost
+// were the script to be added to the justice transaction.
+func addScriptWeight(weightEstimate *lnwallet.TxWeightEstimator,
+	script []byte) error {
+
+	switch {
+	case len(script) == lnwallet.P2WPKHSize:
+		weightEstimate.AddP2WKHOutput()
+
+	case len(script) == lnwallet.P2WSHSize:
+		weightEstimate.AddP2WSHOutput()
+
+	default:
+		return ErrUnknownSweepAddrType
+	}
+
+	return nil
+}
+
+// htlcPenaltyWitnessSize returns the size of a witness spending the revocation
+// clause of an HTLC output with the given witness script. This generalizes
+// lnwallet's OfferedHtlcPenaltyWitnessSize and AcceptedHtlcPenaltyWitnessSize,
+// which only differ in the size of their witness scripts.
+func htlcPenaltyWitnessSize(witnessScript []byte) int {
+	return 1 + 1 + 73 + 1 + 33 + 1 + len(witnessScript)
+}
+
+// buildWitness appends the witness script to a given witness stack.
+func buildWitness(witnessStack [][]byte, witnessScript []byte) [][]byte {
+	witness := make([][]byte, len(witnessStack)+1)
+	lastIdx := copy(witness, witnessStack)
+	witness[lastIdx] = witnessScript
+
+	return witness
+}
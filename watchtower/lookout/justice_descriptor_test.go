@@ -0,0 +1,476 @@
+package lookout_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+const (
+	testCSVDelay     = 144
+	testToLocalAmt   = btcutil.Amount(100000)
+	testToRemoteAmt  = btcutil.Amount(200000)
+	testSweepFeeRate = lnwallet.SatPerKWeight(1000)
+)
+
+// justiceTestContext holds the keys and breached commitment transaction used
+// by the justice descriptor tests.
+type justiceTestContext struct {
+	revPriv      *btcec.PrivateKey
+	delayPriv    *btcec.PrivateKey
+	toRemotePriv *btcec.PrivateKey
+
+	toLocalScript   []byte
+	toLocalPkScript []byte
+
+	toRemotePkScript []byte
+
+	sweepPkScript []byte
+
+	breachTxn *wire.MsgTx
+}
+
+func newPrivKey(t *testing.T) *btcec.PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate priv key: %v", err)
+	}
+
+	return priv
+}
+
+func newJusticeTestContext(t *testing.T) *justiceTestContext {
+	ctx := &justiceTestContext{
+		revPriv:      newPrivKey(t),
+		delayPriv:    newPrivKey(t),
+		toRemotePriv: newPrivKey(t),
+	}
+
+	var err error
+	ctx.toLocalScript, err = lnwallet.CommitScriptToSelf(
+		testCSVDelay, ctx.delayPriv.PubKey(), ctx.revPriv.PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create to-local script: %v", err)
+	}
+
+	ctx.toLocalPkScript, err = lnwallet.WitnessScriptHash(ctx.toLocalScript)
+	if err != nil {
+		t.Fatalf("unable to create to-local pkscript: %v", err)
+	}
+
+	ctx.toRemotePkScript, err = lnwallet.CommitScriptUnencumbered(
+		ctx.toRemotePriv.PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create to-remote pkscript: %v", err)
+	}
+
+	ctx.sweepPkScript, err = lnwallet.CommitScriptUnencumbered(
+		newPrivKey(t).PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create sweep pkscript: %v", err)
+	}
+
+	// Construct a breached commitment transaction paying to both the
+	// to-local and to-remote outputs.
+	ctx.breachTxn = wire.NewMsgTx(2)
+	ctx.breachTxn.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+	})
+	ctx.breachTxn.AddTxOut(&wire.TxOut{
+		PkScript: ctx.toLocalPkScript,
+		Value:    int64(testToLocalAmt),
+	})
+	ctx.breachTxn.AddTxOut(&wire.TxOut{
+		PkScript: ctx.toRemotePkScript,
+		Value:    int64(testToRemoteAmt),
+	})
+
+	return ctx
+}
+
+// newJusticeKit constructs a justice kit without any signatures, optionally
+// including the to-remote output.
+func (c *justiceTestContext) newJusticeKit(withToRemote bool) *blob.JusticeKit {
+	kit := &blob.JusticeKit{
+		CSVDelay: testCSVDelay,
+	}
+	copy(kit.SweepAddress[:], c.sweepPkScript)
+	copy(kit.RevocationPubKey[:], c.revPriv.PubKey().SerializeCompressed())
+	copy(kit.LocalDelayPubKey[:], c.delayPriv.PubKey().SerializeCompressed())
+
+	if withToRemote {
+		copy(
+			kit.CommitToRemotePubKey[:],
+			c.toRemotePriv.PubKey().SerializeCompressed(),
+		)
+	}
+
+	return kit
+}
+
+// signInput produces a signature for the input spending the given output of
+// the breached commitment transaction, which is then packed into its wire
+// representation.
+func (c *justiceTestContext) signInput(t *testing.T, justiceTxn *wire.MsgTx,
+	prevIndex uint32, witnessScript []byte,
+	priv *btcec.PrivateKey) lnwire.Sig {
+
+	inputIndex := -1
+	for i, txIn := range justiceTxn.TxIn {
+		if txIn.PreviousOutPoint.Index == prevIndex {
+			inputIndex = i
+		}
+	}
+	if inputIndex < 0 {
+		t.Fatalf("justice txn does not spend output %d", prevIndex)
+	}
+
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+	amt := c.breachTxn.TxOut[prevIndex].Value
+	sig, err := txscript.RawTxInWitnessSignature(
+		justiceTxn, hashCache, inputIndex, amt, witnessScript,
+		txscript.SigHashAll, priv,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign input: %v", err)
+	}
+
+	// Strip the sighash flag before converting to the wire format.
+	wireSig, err := lnwire.NewSigFromRawSignature(sig[:len(sig)-1])
+	if err != nil {
+		t.Fatalf("unable to parse sig: %v", err)
+	}
+
+	return wireSig
+}
+
+// TestJusticeDescriptor asserts that the justice transaction created from a
+// justice kit properly spends the breached outputs, pays the negotiated fee
+// rate, and passes script validation.
+func TestJusticeDescriptor(t *testing.T) {
+	tests := []struct {
+		name         string
+		withToRemote bool
+	}{
+		{
+			name: "to-local only",
+		},
+		{
+			name:         "to-local and to-remote",
+			withToRemote: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testJusticeDescriptor(t, test.withToRemote)
+		})
+	}
+}
+
+func testJusticeDescriptor(t *testing.T, withToRemote bool) {
+	ctx := newJusticeTestContext(t)
+
+	sessionInfo := &wtdb.SessionInfo{
+		Version:      0,
+		SweepFeeRate: testSweepFeeRate,
+	}
+
+	// First, construct the justice transaction without any signatures,
+	// mirroring what a client does to compute the sighashes it must sign.
+	kit := ctx.newJusticeKit(withToRemote)
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: ctx.breachTxn,
+		SessionInfo:      sessionInfo,
+		JusticeKit:       kit,
+	}
+
+	unsignedTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		t.Fatalf("unable to create unsigned justice txn: %v", err)
+	}
+
+	// Sign each of the breached outputs, and store the resulting
+	// signatures in the justice kit.
+	kit.CommitToLocalSig = ctx.signInput(
+		t, unsignedTxn, 0, ctx.toLocalScript, ctx.revPriv,
+	)
+	if withToRemote {
+		kit.CommitToRemoteSig = ctx.signInput(
+			t, unsignedTxn, 1, ctx.toRemotePkScript,
+			ctx.toRemotePriv,
+		)
+	}
+
+	// Now, construct the justice transaction again using the signed
+	// justice kit, which should produce a fully valid transaction.
+	justiceTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		t.Fatalf("unable to create justice txn: %v", err)
+	}
+
+	if unsignedTxn.TxHash() != justiceTxn.TxHash() {
+		t.Fatalf("justice txid changed after signing")
+	}
+
+	expInputs := 1
+	totalAmt := testToLocalAmt
+	if withToRemote {
+		expInputs++
+		totalAmt += testToRemoteAmt
+	}
+
+	if len(justiceTxn.TxIn) != expInputs {
+		t.Fatalf("expected %d inputs, got %d", expInputs,
+			len(justiceTxn.TxIn))
+	}
+	if len(justiceTxn.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %d", len(justiceTxn.TxOut))
+	}
+
+	// The justice transaction should pay exactly the negotiated fee rate,
+	// computed using the estimated weight of the transaction.
+	var weightEstimate lnwallet.TxWeightEstimator
+	weightEstimate.AddP2WKHOutput()
+	weightEstimate.AddWitnessInput(lnwallet.ToLocalPenaltyWitnessSize)
+	if withToRemote {
+		weightEstimate.AddWitnessInput(lnwallet.P2WKHWitnessSize)
+	}
+	txFee := testSweepFeeRate.FeeForWeight(int64(weightEstimate.Weight()))
+
+	sweepAmt := btcutil.Amount(justiceTxn.TxOut[0].Value)
+	if sweepAmt != totalAmt-txFee {
+		t.Fatalf("expected sweep amount %v, got %v", totalAmt-txFee,
+			sweepAmt)
+	}
+
+	// Finally, execute each input's witness to ensure the transaction is
+	// valid under the standard verification flags.
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+	for i, txIn := range justiceTxn.TxIn {
+		prevOut := ctx.breachTxn.TxOut[txIn.PreviousOutPoint.Index]
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, justiceTxn, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Fatalf("input %d failed validation: %v", i, err)
+		}
+	}
+}
+
+// TestJusticeDescriptorMissingOutput asserts that a justice transaction cannot
+// be created if the breached commitment does not contain the to-local output
+// described by the justice kit.
+func TestJusticeDescriptorMissingOutput(t *testing.T) {
+	ctx := newJusticeTestContext(t)
+
+	// Remove the to-local output from the breached commitment.
+	ctx.breachTxn.TxOut = ctx.breachTxn.TxOut[1:]
+
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: ctx.breachTxn,
+		SessionInfo: &wtdb.SessionInfo{
+			SweepFeeRate: testSweepFeeRate,
+		},
+		JusticeKit: ctx.newJusticeKit(true),
+	}
+
+	_, err := desc.CreateJusticeTxn()
+	if err != lookout.ErrOutputNotFound {
+		t.Fatalf("expected ErrOutputNotFound, got: %v", err)
+	}
+}
+
+// revocationOnlyHTLCScript returns a witness script that can only be spent
+// using the same revocation clause present in both offered and accepted HTLC
+// scripts: a signature under the revocation key, followed by the key itself.
+func revocationOnlyHTLCScript(t *testing.T, revKey *btcec.PublicKey) []byte {
+	builder := txscript.NewScriptBuilder()
+	builder.AddOp(txscript.OP_DUP)
+	builder.AddOp(txscript.OP_HASH160)
+	builder.AddData(btcutil.Hash160(revKey.SerializeCompressed()))
+	builder.AddOp(txscript.OP_EQUALVERIFY)
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	script, err := builder.Script()
+	if err != nil {
+		t.Fatalf("unable to create htlc script: %v", err)
+	}
+
+	return script
+}
+
+// assertValidSpend executes the witness of each of the justice transaction's
+// inputs against the outputs of prevTx they spend.
+func assertValidSpend(t *testing.T, justiceTxn, prevTx *wire.MsgTx) {
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+	for i, txIn := range justiceTxn.TxIn {
+		prevOut := prevTx.TxOut[txIn.PreviousOutPoint.Index]
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, justiceTxn, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Fatalf("input %d failed validation: %v", i, err)
+		}
+	}
+}
+
+// TestJusticeDescriptorHTLCs asserts that a version 1 justice kit sweeps each
+// HTLC output of the breached commitment in its own justice transaction, and
+// that the output of a second-level HTLC transaction can be swept using the
+// signature included for that HTLC.
+func TestJusticeDescriptorHTLCs(t *testing.T) {
+	const htlcAmt = btcutil.Amount(50000)
+
+	ctx := newJusticeTestContext(t)
+
+	// Add an HTLC output to the breached commitment, following the
+	// to-local and to-remote outputs.
+	htlcScript := revocationOnlyHTLCScript(t, ctx.revPriv.PubKey())
+	htlcPkScript, err := lnwallet.WitnessScriptHash(htlcScript)
+	if err != nil {
+		t.Fatalf("unable to create htlc pkscript: %v", err)
+	}
+	ctx.breachTxn.AddTxOut(&wire.TxOut{
+		PkScript: htlcPkScript,
+		Value:    int64(htlcAmt),
+	})
+
+	sessionInfo := &wtdb.SessionInfo{
+		Version:      1,
+		SweepFeeRate: testSweepFeeRate,
+	}
+
+	kit := ctx.newJusticeKit(true)
+	kit.HTLCs = []blob.HTLCOutput{{WitnessScript: htlcScript}}
+
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: ctx.breachTxn,
+		SessionInfo:      sessionInfo,
+		JusticeKit:       kit,
+	}
+
+	unsignedTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		t.Fatalf("unable to create unsigned justice txn: %v", err)
+	}
+
+	// Sign each of the commitment outputs. The HTLC output at index 2
+	// should not be spent by the commitment's justice transaction.
+	kit.CommitToLocalSig = ctx.signInput(
+		t, unsignedTxn, 0, ctx.toLocalScript, ctx.revPriv,
+	)
+	kit.CommitToRemoteSig = ctx.signInput(
+		t, unsignedTxn, 1, ctx.toRemotePkScript, ctx.toRemotePriv,
+	)
+
+	justiceTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		t.Fatalf("unable to create justice txn: %v", err)
+	}
+	if len(justiceTxn.TxIn) != 2 {
+		t.Fatalf("expected 2 inputs, got %d", len(justiceTxn.TxIn))
+	}
+	assertValidSpend(t, justiceTxn, ctx.breachTxn)
+
+	// The HTLC output is instead swept by its own justice transaction.
+	unsignedTxn, err = desc.CreateHTLCJusticeTxn(0)
+	if err != nil {
+		t.Fatalf("unable to create unsigned htlc justice txn: %v", err)
+	}
+	kit.HTLCs[0].RevocationSig = ctx.signInput(
+		t, unsignedTxn, 2, htlcScript, ctx.revPriv,
+	)
+
+	htlcJusticeTxn, err := desc.CreateHTLCJusticeTxn(0)
+	if err != nil {
+		t.Fatalf("unable to create htlc justice txn: %v", err)
+	}
+	if len(htlcJusticeTxn.TxIn) != 1 ||
+		htlcJusticeTxn.TxIn[0].PreviousOutPoint.Index != 2 {
+
+		t.Fatalf("expected htlc justice txn to only spend the htlc "+
+			"output, got: %v", htlcJusticeTxn.TxIn)
+	}
+	assertValidSpend(t, htlcJusticeTxn, ctx.breachTxn)
+
+	_, err = desc.CreateHTLCJusticeTxn(1)
+	if err != lookout.ErrOutputNotFound {
+		t.Fatalf("expected ErrOutputNotFound, got: %v", err)
+	}
+
+	// Now, construct a second-level transaction spending the HTLC output,
+	// which pays to the same script as the to-local output.
+	secondLevelTx := wire.NewMsgTx(2)
+	secondLevelTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  ctx.breachTxn.TxHash(),
+			Index: 2,
+		},
+	})
+	secondLevelTx.AddTxOut(&wire.TxOut{
+		PkScript: ctx.toLocalPkScript,
+		Value:    int64(htlcAmt) - 1000,
+	})
+
+	unsignedTxn, err = desc.CreateSecondLevelJusticeTxn(secondLevelTx)
+	if err != nil {
+		t.Fatalf("unable to create unsigned second-level justice "+
+			"txn: %v", err)
+	}
+
+	hashCache := txscript.NewTxSigHashes(unsignedTxn)
+	sig, err := txscript.RawTxInWitnessSignature(
+		unsignedTxn, hashCache, 0, secondLevelTx.TxOut[0].Value,
+		ctx.toLocalScript, txscript.SigHashAll, ctx.revPriv,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign second-level input: %v", err)
+	}
+	kit.HTLCs[0].SecondLevelSig, err = lnwire.NewSigFromRawSignature(
+		sig[:len(sig)-1],
+	)
+	if err != nil {
+		t.Fatalf("unable to parse sig: %v", err)
+	}
+
+	secondLevelJusticeTxn, err := desc.CreateSecondLevelJusticeTxn(
+		secondLevelTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to create second-level justice txn: %v", err)
+	}
+	if unsignedTxn.TxHash() != secondLevelJusticeTxn.TxHash() {
+		t.Fatalf("second-level justice txid changed after signing")
+	}
+	assertValidSpend(t, secondLevelJusticeTxn, secondLevelTx)
+
+	// A transaction that doesn't spend one of the kit's HTLC outputs
+	// should be rejected.
+	secondLevelTx.TxIn[0].PreviousOutPoint.Index = 0
+	_, err = desc.CreateSecondLevelJusticeTxn(secondLevelTx)
+	if err != lookout.ErrNotSecondLevelTx {
+		t.Fatalf("expected ErrNotSecondLevelTx, got: %v", err)
+	}
+}
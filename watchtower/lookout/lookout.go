@@ -0,0 +1,393 @@
+package lookout
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+// Config houses the Lookout's required resources to properly fulfill it's duty,
+// including block fetching, querying accepted state updates, and construction
+// and publication of justice transactions.
+type Config struct {
+	// DB provides persistent access to the watchtower's accepted state
+	// updates such that they can be queried as new blocks arrive from the
+	// network.
+	DB DB
+
+	// EpochRegistrar supports the ability to register for events corresponding
+	// to newly created blocks.
+	EpochRegistrar EpochRegistrar
+
+	// BlockFetcher supports the ability to fetch blocks from the network by
+	// hash.
+	BlockFetcher BlockFetcher
+
+	// SpendRegistrar supports the ability to register for notifications
+	// when the HTLC outputs of a breached commitment are spent.
+	SpendRegistrar SpendRegistrar
+
+	// PublishTx broadcasts a fully signed justice transaction to the
+	// network.
+	PublishTx func(*wire.MsgTx) error
+}
+
+// Lookout will check any incoming blocks against the transactions found in the
+// database, and in case of matches send the information needed to create a
+// penalty transaction to the punisher. The HTLC outputs of a breached
+// commitment are then watched until they are spent, so that their
+// second-level outputs can be swept if the breaching party moves them.
+//
+// NOTE: HTLC outputs are only watched while the lookout is running, and are
+// not watched again after a restart.
+type Lookout struct {
+	started  int32 // atomic
+	shutdown int32 // atomic
+
+	cfg *Config
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New constructs a new Lookout from the given LookoutConfig.
+func New(cfg *Config) *Lookout {
+	return &Lookout{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start safely spins up the Lookout and begins monitoring for breaches.
+func (l *Lookout) Start() error {
+	if !atomic.CompareAndSwapInt32(&l.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting lookout")
+
+	startEpoch, err := l.cfg.DB.GetLookoutTip()
+	if err != nil {
+		return err
+	}
+
+	if startEpoch == nil {
+		log.Infof("Starting lookout from chain tip")
+	} else {
+		log.Infof("Starting lookout from epoch(height=%d hash=%v)",
+			startEpoch.Height, startEpoch.Hash)
+	}
+
+	events, err := l.cfg.EpochRegistrar.RegisterBlockEpochNtfn(startEpoch)
+	if err != nil {
+		log.Errorf("Unable to register for block epochs: %v", err)
+		return err
+	}
+
+	l.wg.Add(1)
+	go l.watchBlocks(events)
+
+	log.Infof("Lookout started successfully")
+
+	return nil
+}
+
+// Stop safely shuts down the Lookout.
+func (l *Lookout) Stop() error {
+	if !atomic.CompareAndSwapInt32(&l.shutdown, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping lookout")
+
+	close(l.quit)
+	l.wg.Wait()
+
+	log.Infof("Lookout stopped successfully")
+
+	return nil
+}
+
+// watchBlocks serially pulls incoming epochs from the epoch source and searches
+// our accepted state updates for any breached transactions. If any are found,
+// we will attempt to decrypt the state updates' encrypted blobs and exact
+// justice for the victim.
+//
+// This method MUST be run as a goroutine.
+func (l *Lookout) watchBlocks(epochs *chainntnfs.BlockEpochEvent) {
+	defer l.wg.Done()
+	defer epochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-epochs.Epochs:
+			if !ok {
+				log.Warnf("Block epoch stream closed, " +
+					"lookout exiting")
+				return
+			}
+
+			log.Debugf("Fetching block for (height=%d, hash=%v)",
+				epoch.Height, epoch.Hash)
+
+			// Fetch the full block from the backend corresponding
+			// to the newly arriving epoch.
+			block, err := l.cfg.BlockFetcher.GetBlock(epoch.Hash)
+			if err != nil {
+				log.Errorf("Unable to fetch block for "+
+					"(height=%d, hash=%v): %v",
+					epoch.Height, epoch.Hash, err)
+				continue
+			}
+
+			// Process the block to see if it contains any breaches
+			// that we are monitoring on behalf of our clients.
+			err = l.processEpoch(epoch, block)
+			if err != nil {
+				log.Errorf("Unable to process block "+
+					"(height=%d, hash=%v): %v",
+					epoch.Height, epoch.Hash, err)
+			}
+
+		case <-l.quit:
+			return
+		}
+	}
+}
+
+// processEpoch accepts an Epoch and queries the database for any matching state
+// updates for the confirmed transactions. If any are found, the lookout
+// responds by attempting to decrypt the encrypted blob and publishing the
+// justice transaction.
+func (l *Lookout) processEpoch(epoch *chainntnfs.BlockEpoch,
+	block *wire.MsgBlock) error {
+
+	numTxnsInBlock := len(block.Transactions)
+
+	log.Debugf("Scanning %d transactions in block (height=%d, hash=%v) "+
+		"for breaches", numTxnsInBlock, epoch.Height, epoch.Hash)
+
+	// Iterate over the transactions contained in the block, deriving a
+	// breach hint for each transaction and constructing an index mapping
+	// the hint back to it's original transaction.
+	hintToTx := make(map[wtdb.BreachHint]*wire.MsgTx, numTxnsInBlock)
+	txHints := make([]wtdb.BreachHint, 0, numTxnsInBlock)
+	for _, tx := range block.Transactions {
+		hash := tx.TxHash()
+		hint := wtdb.NewBreachHintFromHash(&hash)
+
+		txHints = append(txHints, hint)
+		hintToTx[hint] = tx
+	}
+
+	// Query the database to see if any of the breach hints cause a match
+	// with any of our open accepted state updates.
+	matches, err := l.cfg.DB.QueryMatches(txHints)
+	if err != nil {
+		return err
+	}
+
+	// No matches were found, we are done.
+	if len(matches) == 0 {
+		log.Debugf("No breaches found in (height=%d, hash=%v)",
+			epoch.Height, epoch.Hash)
+
+		// Record that this block has been scanned, such that we won't
+		// rescan it on restart.
+		return l.cfg.DB.SetLookoutTip(epoch)
+	}
+
+	breachCountStr := "breach"
+	if len(matches) > 1 {
+		breachCountStr = "breaches"
+	}
+
+	log.Infof("Found %d %s in (height=%d, hash=%v)",
+		len(matches), breachCountStr, epoch.Height, epoch.Hash)
+
+	// For each match, use our hint to recover the commitment transaction
+	// from the block, and attempt to exact justice using the encrypted
+	// blob the client provided.
+	for _, match := range matches {
+		commitTx, ok := hintToTx[match.Hint]
+		if !ok {
+			log.Warnf("Match %x in db, but tx not found in "+
+				"block", match.Hint)
+			continue
+		}
+
+		commitTxID := commitTx.TxHash()
+
+		log.Infof("Dispatching punisher for client %s, breach-txid=%s",
+			match.ID, commitTxID.String())
+
+		err := l.exactJustice(
+			&match, commitTx, &commitTxID, uint32(epoch.Height),
+		)
+		if err != nil {
+			log.Errorf("Unable to exact justice for client %s, "+
+				"breach-txid=%s: %v", match.ID, commitTxID, err)
+			continue
+		}
+	}
+
+	return l.cfg.DB.SetLookoutTip(epoch)
+}
+
+// exactJustice decrypts the encrypted blob contained in the match using the
+// full txid of the breaching commitment, and then builds and broadcasts the
+// justice transaction sweeping the breached commitment outputs to the client's
+// sweep address. Each HTLC output is swept by its own justice transaction, and
+// is watched until spent in case the breaching party moves it to the second
+// level first.
+func (l *Lookout) exactJustice(match *wtdb.Match, commitTx *wire.MsgTx,
+	commitTxID *chainhash.Hash, heightHint uint32) error {
+
+	// The encrypted blob is expected to be prefixed by the nonce chosen by
+	// the client, the remainder being the ciphertext itself.
+	encryptedBlob := match.EncryptedBlob
+	if len(encryptedBlob) < blob.NonceSize {
+		return blob.ErrCiphertextTooSmall
+	}
+	nonce := encryptedBlob[:blob.NonceSize]
+	ciphertext := encryptedBlob[blob.NonceSize:]
+
+	// The decryption key for the state update is derived from the full
+	// txid of the breaching commitment transaction, which was previously
+	// unknown to the tower.
+	key := wtdb.NewBreachKeyFromHash(commitTxID)
+
+	justiceKit, err := blob.Decrypt(
+		nonce, key[:], ciphertext, match.SessionInfo.Version,
+	)
+	if err != nil {
+		// If the decryption fails, this implies either that the
+		// client sent an invalid blob, or that the breach hint caused
+		// a match on the txid, but this isn't actually the right
+		// transaction.
+		return err
+	}
+
+	justiceDesc := &JusticeDescriptor{
+		BreachedCommitTx: commitTx,
+		SessionInfo:      match.SessionInfo,
+		JusticeKit:       justiceKit,
+	}
+
+	justiceTxn, err := justiceDesc.CreateJusticeTxn()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Publishing justice transaction %v for client %s",
+		justiceTxn.TxHash(), match.ID)
+
+	// The HTLC outputs are swept independently of the commitment outputs,
+	// so we'll still attempt to sweep them if the publication fails.
+	publishErr := l.cfg.PublishTx(justiceTxn)
+
+	for i := range justiceKit.HTLCs {
+		err := l.sweepHTLC(justiceDesc, i, heightHint)
+		if err != nil {
+			log.Errorf("Unable to sweep htlc %d for client %s, "+
+				"breach-txid=%s: %v", i, match.ID, commitTxID,
+				err)
+		}
+	}
+
+	return publishErr
+}
+
+// sweepHTLC publishes the justice transaction sweeping the i-th HTLC output of
+// the justice kit, and spawns a goroutine that waits for the HTLC output to be
+// spent. If it is spent by a second-level transaction rather than our justice
+// transaction, the second-level output is swept instead.
+func (l *Lookout) sweepHTLC(desc *JusticeDescriptor, htlcIndex int,
+	heightHint uint32) error {
+
+	justiceTxn, err := desc.CreateHTLCJusticeTxn(htlcIndex)
+	if err != nil {
+		return err
+	}
+
+	htlcOutPoint := justiceTxn.TxIn[0].PreviousOutPoint
+	htlcPkScript := desc.BreachedCommitTx.TxOut[htlcOutPoint.Index].PkScript
+
+	// Register for the spend before publishing, so that we learn of a
+	// second-level transaction even if it has already confirmed.
+	spendEvent, err := l.cfg.SpendRegistrar.RegisterSpendNtfn(
+		&htlcOutPoint, htlcPkScript, heightHint,
+	)
+	if err != nil {
+		return err
+	}
+
+	justiceTxID := justiceTxn.TxHash()
+
+	log.Infof("Publishing justice transaction %v for htlc %v",
+		justiceTxID, htlcOutPoint)
+
+	// The publication is expected to fail if the breaching party has
+	// already moved the HTLC to the second level, which we'll learn about
+	// from the spend notification.
+	if err := l.cfg.PublishTx(justiceTxn); err != nil {
+		log.Warnf("Unable to publish justice transaction %v for "+
+			"htlc %v: %v", justiceTxID, htlcOutPoint, err)
+	}
+
+	l.wg.Add(1)
+	go l.watchHTLC(desc, justiceTxID, spendEvent)
+
+	return nil
+}
+
+// watchHTLC waits for a breached HTLC output to be spent. If the spending
+// transaction is not the justice transaction identified by justiceTxID, it
+// must be the breaching party's second-level transaction, whose output is then
+// swept via its revocation clause.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (l *Lookout) watchHTLC(desc *JusticeDescriptor,
+	justiceTxID chainhash.Hash, spendEvent *chainntnfs.SpendEvent) {
+
+	defer l.wg.Done()
+	defer spendEvent.Cancel()
+
+	var spend *chainntnfs.SpendDetail
+	select {
+	case detail, ok := <-spendEvent.Spend:
+		if !ok {
+			return
+		}
+		spend = detail
+
+	case <-l.quit:
+		return
+	}
+
+	if *spend.SpenderTxHash == justiceTxID {
+		log.Infof("HTLC %v swept by justice transaction %v",
+			spend.SpentOutPoint, justiceTxID)
+		return
+	}
+
+	justiceTxn, err := desc.CreateSecondLevelJusticeTxn(spend.SpendingTx)
+	if err != nil {
+		log.Errorf("Unable to create justice transaction for "+
+			"second-level txn %v: %v", spend.SpenderTxHash, err)
+		return
+	}
+
+	log.Infof("Publishing justice transaction %v for second-level txn %v",
+		justiceTxn.TxHash(), spend.SpenderTxHash)
+
+	if err := l.cfg.PublishTx(justiceTxn); err != nil {
+		log.Errorf("Unable to publish justice transaction %v: %v",
+			justiceTxn.TxHash(), err)
+	}
+}
@@ -0,0 +1,344 @@
+package lookout_test
+
+import (
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+const waitTimeout = 5 * time.Second
+
+type mockDB struct {
+	mu      sync.Mutex
+	tip     *chainntnfs.BlockEpoch
+	matches map[wtdb.BreachHint]wtdb.Match
+}
+
+func (db *mockDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.tip, nil
+}
+
+func (db *mockDB) QueryMatches(hints []wtdb.BreachHint) ([]wtdb.Match, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var matches []wtdb.Match
+	for _, hint := range hints {
+		if match, ok := db.matches[hint]; ok {
+			matches = append(matches, match)
+		}
+	}
+
+	return matches, nil
+}
+
+func (db *mockDB) SetLookoutTip(epoch *chainntnfs.BlockEpoch) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.tip = epoch
+	return nil
+}
+
+type mockEpochRegistrar struct {
+	epochs chan *chainntnfs.BlockEpoch
+}
+
+func (m *mockEpochRegistrar) RegisterBlockEpochNtfn(
+	*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochs,
+		Cancel: func() {},
+	}, nil
+}
+
+type mockBlockFetcher struct {
+	blocks map[chainhash.Hash]*wire.MsgBlock
+}
+
+func (m *mockBlockFetcher) GetBlock(
+	hash *chainhash.Hash) (*wire.MsgBlock, error) {
+
+	return m.blocks[*hash], nil
+}
+
+type mockSpendRegistrar struct {
+	mu     sync.Mutex
+	spends map[wire.OutPoint]chan *chainntnfs.SpendDetail
+}
+
+func (m *mockSpendRegistrar) spendChan(
+	op wire.OutPoint) chan *chainntnfs.SpendDetail {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spendChan, ok := m.spends[op]
+	if !ok {
+		spendChan = make(chan *chainntnfs.SpendDetail, 1)
+		m.spends[op] = spendChan
+	}
+
+	return spendChan
+}
+
+func (m *mockSpendRegistrar) RegisterSpendNtfn(op *wire.OutPoint, _ []byte,
+	_ uint32) (*chainntnfs.SpendEvent, error) {
+
+	return &chainntnfs.SpendEvent{
+		Spend:  m.spendChan(*op),
+		Cancel: func() {},
+	}, nil
+}
+
+// spend notifies the lookout that op was spent by spendingTx.
+func (m *mockSpendRegistrar) spend(op wire.OutPoint, spendingTx *wire.MsgTx) {
+	spenderHash := spendingTx.TxHash()
+	m.spendChan(op) <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &op,
+		SpenderTxHash: &spenderHash,
+		SpendingTx:    spendingTx,
+	}
+}
+
+// addHTLCOutput adds an HTLC output spendable by priv to the breached
+// commitment, returning its witness script and outpoint.
+func addHTLCOutput(t *testing.T, ctx *justiceTestContext,
+	priv *btcec.PrivateKey, amt btcutil.Amount) ([]byte, wire.OutPoint) {
+
+	htlcScript := revocationOnlyHTLCScript(t, priv.PubKey())
+	htlcPkScript, err := lnwallet.WitnessScriptHash(htlcScript)
+	if err != nil {
+		t.Fatalf("unable to create htlc pkscript: %v", err)
+	}
+	ctx.breachTxn.AddTxOut(&wire.TxOut{
+		PkScript: htlcPkScript,
+		Value:    int64(amt),
+	})
+
+	return htlcScript, wire.OutPoint{
+		Index: uint32(len(ctx.breachTxn.TxOut) - 1),
+	}
+}
+
+// waitForPublish returns the next transaction published by the lookout.
+func waitForPublish(t *testing.T, published chan *wire.MsgTx) *wire.MsgTx {
+	select {
+	case tx := <-published:
+		return tx
+	case <-time.After(waitTimeout):
+		t.Fatalf("lookout did not publish a transaction")
+		return nil
+	}
+}
+
+// TestLookoutHTLCSecondLevel asserts that the lookout sweeps each HTLC output
+// of a breached commitment independently of the commitment outputs, and that
+// it sweeps the second-level output of an HTLC the breaching party moved to
+// the second level.
+func TestLookoutHTLCSecondLevel(t *testing.T) {
+	const htlcAmt = btcutil.Amount(50000)
+
+	ctx := newJusticeTestContext(t)
+
+	// The first HTLC is moved to the second level by the breaching party,
+	// while the second is swept by the tower's justice transaction.
+	movedScript, movedOutPoint := addHTLCOutput(
+		t, ctx, ctx.revPriv, htlcAmt,
+	)
+	sweptScript, sweptOutPoint := addHTLCOutput(
+		t, ctx, ctx.toRemotePriv, htlcAmt,
+	)
+
+	commitTxID := ctx.breachTxn.TxHash()
+	movedOutPoint.Hash = commitTxID
+	sweptOutPoint.Hash = commitTxID
+
+	secondLevelTx := wire.NewMsgTx(2)
+	secondLevelTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: movedOutPoint,
+	})
+	secondLevelTx.AddTxOut(&wire.TxOut{
+		PkScript: ctx.toLocalPkScript,
+		Value:    int64(htlcAmt) - 1000,
+	})
+
+	sessionInfo := &wtdb.SessionInfo{
+		Version:      1,
+		SweepFeeRate: testSweepFeeRate,
+	}
+
+	// Sign the justice kit exactly as a client would.
+	kit := ctx.newJusticeKit(true)
+	kit.HTLCs = []blob.HTLCOutput{
+		{WitnessScript: movedScript},
+		{WitnessScript: sweptScript},
+	}
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: ctx.breachTxn,
+		SessionInfo:      sessionInfo,
+		JusticeKit:       kit,
+	}
+
+	unsignedTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		t.Fatalf("unable to create unsigned justice txn: %v", err)
+	}
+	kit.CommitToLocalSig = ctx.signInput(
+		t, unsignedTxn, 0, ctx.toLocalScript, ctx.revPriv,
+	)
+	kit.CommitToRemoteSig = ctx.signInput(
+		t, unsignedTxn, 1, ctx.toRemotePkScript, ctx.toRemotePriv,
+	)
+
+	htlcPrivs := []*btcec.PrivateKey{ctx.revPriv, ctx.toRemotePriv}
+	for i, htlc := range kit.HTLCs {
+		unsignedTxn, err := desc.CreateHTLCJusticeTxn(i)
+		if err != nil {
+			t.Fatalf("unable to create unsigned htlc justice "+
+				"txn: %v", err)
+		}
+		prevIndex := unsignedTxn.TxIn[0].PreviousOutPoint.Index
+		kit.HTLCs[i].RevocationSig = ctx.signInput(
+			t, unsignedTxn, prevIndex, htlc.WitnessScript,
+			htlcPrivs[i],
+		)
+	}
+
+	unsignedTxn, err = desc.CreateSecondLevelJusticeTxn(secondLevelTx)
+	if err != nil {
+		t.Fatalf("unable to create unsigned second-level justice "+
+			"txn: %v", err)
+	}
+	sig, err := txscript.RawTxInWitnessSignature(
+		unsignedTxn, txscript.NewTxSigHashes(unsignedTxn), 0,
+		secondLevelTx.TxOut[0].Value, ctx.toLocalScript,
+		txscript.SigHashAll, ctx.revPriv,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign second-level input: %v", err)
+	}
+	kit.HTLCs[0].SecondLevelSig, err = lnwire.NewSigFromRawSignature(
+		sig[:len(sig)-1],
+	)
+	if err != nil {
+		t.Fatalf("unable to parse sig: %v", err)
+	}
+
+	var nonce [blob.NonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		t.Fatalf("unable to generate nonce: %v", err)
+	}
+	key := wtdb.NewBreachKeyFromHash(&commitTxID)
+	ciphertext, err := kit.Encrypt(nonce[:], key[:], sessionInfo.Version)
+	if err != nil {
+		t.Fatalf("unable to encrypt justice kit: %v", err)
+	}
+
+	hint := wtdb.NewBreachHintFromHash(&commitTxID)
+	db := &mockDB{
+		matches: map[wtdb.BreachHint]wtdb.Match{
+			hint: {
+				ID:            wtdb.SessionID{0x01},
+				Hint:          hint,
+				EncryptedBlob: append(nonce[:], ciphertext...),
+				SessionInfo:   sessionInfo,
+			},
+		},
+	}
+
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{ctx.breachTxn},
+	}
+	blockHash := block.BlockHash()
+
+	epochs := make(chan *chainntnfs.BlockEpoch, 1)
+	published := make(chan *wire.MsgTx, 5)
+	spends := &mockSpendRegistrar{
+		spends: make(map[wire.OutPoint]chan *chainntnfs.SpendDetail),
+	}
+
+	lk := lookout.New(&lookout.Config{
+		DB:             db,
+		EpochRegistrar: &mockEpochRegistrar{epochs: epochs},
+		BlockFetcher: &mockBlockFetcher{
+			blocks: map[chainhash.Hash]*wire.MsgBlock{
+				blockHash: block,
+			},
+		},
+		SpendRegistrar: spends,
+		PublishTx: func(tx *wire.MsgTx) error {
+			published <- tx
+			return nil
+		},
+	})
+	if err := lk.Start(); err != nil {
+		t.Fatalf("unable to start lookout: %v", err)
+	}
+	defer lk.Stop()
+
+	epochs <- &chainntnfs.BlockEpoch{Hash: &blockHash, Height: 100}
+
+	// The commitment outputs should be swept without spending either of
+	// the HTLC outputs.
+	justiceTxn := waitForPublish(t, published)
+	if len(justiceTxn.TxIn) != 2 {
+		t.Fatalf("expected 2 inputs, got %d", len(justiceTxn.TxIn))
+	}
+	assertValidSpend(t, justiceTxn, ctx.breachTxn)
+
+	// Each HTLC output should then be swept by its own justice
+	// transaction.
+	htlcOutPoints := []wire.OutPoint{movedOutPoint, sweptOutPoint}
+	htlcJusticeTxns := make([]*wire.MsgTx, len(htlcOutPoints))
+	for i, op := range htlcOutPoints {
+		htlcJusticeTxns[i] = waitForPublish(t, published)
+		txIns := htlcJusticeTxns[i].TxIn
+		if len(txIns) != 1 || txIns[0].PreviousOutPoint != op {
+			t.Fatalf("expected htlc justice txn spending %v, "+
+				"got: %v", op, txIns)
+		}
+		assertValidSpend(t, htlcJusticeTxns[i], ctx.breachTxn)
+	}
+
+	// Once the breaching party moves the first HTLC to the second level,
+	// the lookout should sweep the second-level output instead.
+	spends.spend(movedOutPoint, secondLevelTx)
+
+	secondLevelJusticeTxn := waitForPublish(t, published)
+	txIns := secondLevelJusticeTxn.TxIn
+	if len(txIns) != 1 ||
+		txIns[0].PreviousOutPoint.Hash != secondLevelTx.TxHash() {
+
+		t.Fatalf("expected justice txn spending second-level txn, "+
+			"got: %v", txIns)
+	}
+	assertValidSpend(t, secondLevelJusticeTxn, secondLevelTx)
+
+	// The second HTLC being spent by our own justice transaction requires
+	// no further action.
+	spends.spend(sweptOutPoint, htlcJusticeTxns[1])
+
+	select {
+	case tx := <-published:
+		t.Fatalf("unexpected transaction published: %v", tx.TxHash())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
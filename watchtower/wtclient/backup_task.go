@@ -0,0 +1,284 @@
+package wtclient
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+var (
+	// ErrNoToLocalOutput signals that the revoked commitment does not
+	// contain a to-local output for the remote party, meaning there is
+	// nothing for a tower to sweep on our behalf.
+	ErrNoToLocalOutput = errors.New("revoked commitment has no to-local " +
+		"output")
+
+	// ErrSweepAddressTooLarge signals that the sweep pkscript provided by
+	// the wallet cannot be encoded within the justice kit.
+	ErrSweepAddressTooLarge = errors.New("sweep pkscript exceeds " +
+		"maximum witness program size")
+)
+
+// justiceKitInput pairs the outpoint of a breached output with the sign
+// descriptor that can be used to produce a signature spending it.
+type justiceKitInput struct {
+	outPoint wire.OutPoint
+	signDesc *lnwallet.SignDescriptor
+}
+
+// newBackupTask constructs a backup task for the revoked state described by
+// breachInfo, which is encrypted with the txid of the breaching commitment.
+// The justice transaction is first assembled without signatures to compute
+// the sighashes for each input, which the signer then uses to produce the
+// signatures included in the justice kit. If the policy's blob version
+// supports HTLC outputs, the kit also carries signatures for the separate
+// transactions sweeping each HTLC output and its second-level counterpart.
+// ErrNoToLocalOutput and lookout.ErrSweepAmountBelowDust are returned if the
+// resulting justice transaction would not be worth sweeping.
+func newBackupTask(chanID *lnwire.ChannelID,
+	breachInfo *lnwallet.BreachRetribution, sweepPkScript []byte,
+	policy *wtdb.SessionPolicy, signer lnwallet.Signer) (*wtdb.BackupTask,
+	error) {
+
+	// The tower can only act if the remote party's to-local output is
+	// present, as it is the only output guarded by the revocation key.
+	if breachInfo.RemoteOutputSignDesc == nil {
+		return nil, ErrNoToLocalOutput
+	}
+
+	kit, inputs, err := newJusticeKit(breachInfo, sweepPkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assemble the justice transaction exactly as the tower would. Since
+	// the kit does not yet contain any signatures, the witnesses will be
+	// invalid, though the txid and sighashes are unaffected.
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: breachInfo.BreachTransaction,
+		SessionInfo: &wtdb.SessionInfo{
+			Version:      policy.BlobVersion,
+			SweepFeeRate: policy.SweepFeeRate,
+		},
+		JusticeKit: kit,
+	}
+	justiceTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		return nil, err
+	}
+
+	// The justice transaction is sorted according to BIP69, so we'll
+	// locate the final position of each input before signing.
+	inputIndex := make(map[wire.OutPoint]int)
+	for i, txIn := range justiceTxn.TxIn {
+		inputIndex[txIn.PreviousOutPoint] = i
+	}
+
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+	sigs := make([]lnwire.Sig, len(inputs))
+	for i, input := range inputs {
+		// Copy the sign descriptor so that the breach retribution
+		// remains unmodified for any other consumers.
+		signDesc := *input.signDesc
+		signDesc.SigHashes = hashCache
+		signDesc.InputIndex = inputIndex[input.outPoint]
+		signDesc.HashType = txscript.SigHashAll
+
+		rawSig, err := signer.SignOutputRaw(justiceTxn, &signDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		sigs[i], err = lnwire.NewSigFromRawSignature(rawSig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The to-local signature always comes first, followed by the
+	// to-remote signature if the output is present.
+	kit.CommitToLocalSig = sigs[0]
+	if kit.HasCommitToRemoteOutput() {
+		kit.CommitToRemoteSig = sigs[1]
+	}
+
+	// With the commitment outputs signed, we'll add each HTLC to the kit
+	// along with the signatures for the justice transactions sweeping it
+	// directly, and sweeping its second-level output should the breaching
+	// party move it to the second level. Include as many HTLCs as the blob
+	// version permits. Any remainder will be left for the breach arbiter
+	// to sweep if we are online.
+	htlcs := breachInfo.HtlcRetributions
+	maxHTLCs := blob.MaxHTLCs(policy.BlobVersion)
+	for i := 0; i < len(htlcs) && len(kit.HTLCs) < maxHTLCs; i++ {
+		htlc := &htlcs[i]
+
+		kit.HTLCs = append(kit.HTLCs, blob.HTLCOutput{
+			WitnessScript: htlc.SignDesc.WitnessScript,
+		})
+		htlcIndex := len(kit.HTLCs) - 1
+
+		revocationSig, err := signHTLCJustice(
+			desc, htlcIndex, htlc, signer,
+		)
+		switch {
+		// If the HTLC output isn't worth sweeping on its own, we'll
+		// leave it out of the kit.
+		case err == lookout.ErrSweepAmountBelowDust:
+			kit.HTLCs = kit.HTLCs[:htlcIndex]
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+		kit.HTLCs[htlcIndex].RevocationSig = revocationSig
+
+		secondLevelSig, err := signSecondLevelJustice(
+			desc, htlc, signer,
+		)
+		switch {
+		// If the second-level output isn't worth sweeping, we'll
+		// leave the signature blank.
+		case err == lookout.ErrSweepAmountBelowDust:
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+		kit.HTLCs[htlcIndex].SecondLevelSig = secondLevelSig
+	}
+
+	// Finally, encrypt the justice kit using a key derived from the txid of
+	// the breaching commitment, which the tower only learns if the
+	// commitment is broadcast. The nonce is prefixed to the ciphertext.
+	breachTxID := breachInfo.BreachTransaction.TxHash()
+	key := wtdb.NewBreachKeyFromHash(&breachTxID)
+
+	var nonce [blob.NonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := kit.Encrypt(nonce[:], key[:], policy.BlobVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedBlob := make([]byte, 0, len(nonce)+len(ciphertext))
+	encryptedBlob = append(encryptedBlob, nonce[:]...)
+	encryptedBlob = append(encryptedBlob, ciphertext...)
+
+	return &wtdb.BackupTask{
+		ChanID:        *chanID,
+		CommitHeight:  breachInfo.RevokedStateNum,
+		Policy:        *policy,
+		Hint:          wtdb.NewBreachHintFromHash(&breachTxID),
+		EncryptedBlob: encryptedBlob,
+	}, nil
+}
+
+// signHTLCJustice signs the justice transaction sweeping the HTLC output at
+// the given index of the justice kit, which is assembled by the justice
+// descriptor exactly as the tower would.
+func signHTLCJustice(desc *lookout.JusticeDescriptor, htlcIndex int,
+	htlc *lnwallet.HtlcRetribution, signer lnwallet.Signer) (lnwire.Sig,
+	error) {
+
+	justiceTxn, err := desc.CreateHTLCJusticeTxn(htlcIndex)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	signDesc := htlc.SignDesc
+	signDesc.SigHashes = txscript.NewTxSigHashes(justiceTxn)
+	signDesc.InputIndex = 0
+	signDesc.HashType = txscript.SigHashAll
+
+	rawSig, err := signer.SignOutputRaw(justiceTxn, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// signSecondLevelJustice signs the justice transaction sweeping the output of
+// the given HTLC's second-level transaction, which is assembled by the justice
+// descriptor exactly as the tower would once the transaction confirms.
+func signSecondLevelJustice(desc *lookout.JusticeDescriptor,
+	htlc *lnwallet.HtlcRetribution, signer lnwallet.Signer) (lnwire.Sig,
+	error) {
+
+	justiceTxn, err := desc.CreateSecondLevelJusticeTxn(htlc.SecondLevelTx)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	// The second-level output is guarded by the same revocation key as
+	// the HTLC output itself, so we only need to swap out the script and
+	// output being spent.
+	signDesc := htlc.SignDesc
+	signDesc.WitnessScript = htlc.SecondLevelWitnessScript
+	signDesc.Output = htlc.SecondLevelTx.TxOut[0]
+	signDesc.SigHashes = txscript.NewTxSigHashes(justiceTxn)
+	signDesc.InputIndex = 0
+	signDesc.HashType = txscript.SigHashAll
+
+	rawSig, err := signer.SignOutputRaw(justiceTxn, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// newJusticeKit populates an unsigned justice kit from the keys and outputs
+// of the breaching commitment. The returned inputs contain the to-local input,
+// followed by the to-remote input if our output on the commitment is not
+// dust. HTLC outputs are added to the kit by the caller as they are signed.
+func newJusticeKit(breachInfo *lnwallet.BreachRetribution,
+	sweepPkScript []byte) (*blob.JusticeKit, []justiceKitInput, error) {
+
+	kit := &blob.JusticeKit{
+		CSVDelay: breachInfo.RemoteDelay,
+	}
+
+	if len(sweepPkScript) > len(kit.SweepAddress) {
+		return nil, nil, ErrSweepAddressTooLarge
+	}
+	copy(kit.SweepAddress[:], sweepPkScript)
+
+	keyRing := breachInfo.KeyRing
+	copy(kit.RevocationPubKey[:], keyRing.RevocationKey.SerializeCompressed())
+	copy(kit.LocalDelayPubKey[:], keyRing.DelayKey.SerializeCompressed())
+
+	inputs := []justiceKitInput{
+		{
+			outPoint: breachInfo.RemoteOutpoint,
+			signDesc: breachInfo.RemoteOutputSignDesc,
+		},
+	}
+
+	// Our own output on the remote party's commitment is only present if
+	// it is above the dust limit.
+	if breachInfo.LocalOutputSignDesc != nil {
+		copy(
+			kit.CommitToRemotePubKey[:],
+			keyRing.NoDelayKey.SerializeCompressed(),
+		)
+
+		inputs = append(inputs, justiceKitInput{
+			outPoint: breachInfo.LocalOutpoint,
+			signDesc: breachInfo.LocalOutputSignDesc,
+		})
+	}
+
+	return kit, inputs, nil
+}
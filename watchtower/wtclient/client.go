@@ -0,0 +1,551 @@
+package wtclient
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+)
+
+const (
+	// DefaultReadTimeout specifies the default duration we will wait
+	// during a read before breaking out of a blocking read.
+	DefaultReadTimeout = 15 * time.Second
+
+	// DefaultWriteTimeout specifies the default duration we will wait
+	// during a write before breaking out of a blocking write.
+	DefaultWriteTimeout = 15 * time.Second
+
+	// DefaultMinBackoff is the minimum amount of time we'll wait before
+	// retrying delivery to a tower after a failure.
+	DefaultMinBackoff = time.Second
+
+	// DefaultMaxBackoff is the maximum amount of time we'll wait before
+	// retrying delivery to a tower after a failure.
+	DefaultMaxBackoff = 5 * time.Minute
+
+	// DefaultMaxUpdates specifies the default maximum number of updates
+	// that will be requested from a tower when negotiating a session.
+	DefaultMaxUpdates = 1024
+
+	// DefaultSweepFeeRate specifies the default fee rate used to construct
+	// justice transactions, equivalent to 10 sat/byte.
+	DefaultSweepFeeRate = lnwallet.SatPerKWeight(2500)
+)
+
+var (
+	// ErrClientExiting signals that the watchtower client is shutting
+	// down.
+	ErrClientExiting = errors.New("watchtower client shutting down")
+)
+
+// Config provides the TowerClient with access to the resources it requires to
+// perform its duty. All nillable fields must be non-nil for the tower to be
+// initialized properly.
+type Config struct {
+	// Signer provides access to the wallet so that the client can sign
+	// justice transactions that spend from a remote party's commitment
+	// transaction.
+	Signer lnwallet.Signer
+
+	// NewAddress generates a new on-chain sweep pkscript, to which the
+	// funds of a channel will be swept by the towers if it is breached.
+	NewAddress func() ([]byte, error)
+
+	// SecretKeyRing is used to derive the session keys used to
+	// communicate with the tower. The client only stores the KeyLocators
+	// internally so that we never store private keys on disk.
+	SecretKeyRing keychain.SecretKeyRing
+
+	// Dial connects to an addr using the specified net and returns the
+	// connection object.
+	Dial func(string, string) (net.Conn, error)
+
+	// AuthDial establishes a brontide connection over an onion or clear
+	// network.
+	AuthDial AuthDialer
+
+	// DB provides access to the client's stable storage medium.
+	DB DB
+
+	// Policy is the session policy the client will propose when creating
+	// new sessions with the tower, and under which all new backups are
+	// constructed.
+	Policy wtdb.SessionPolicy
+
+	// ReadTimeout is the duration we will wait during a read before
+	// breaking out of a blocking read.
+	ReadTimeout time.Duration
+
+	// WriteTimeout is the duration we will wait during a write before
+	// breaking out of a blocking write.
+	WriteTimeout time.Duration
+
+	// MinBackoff defines the initial backoff applied to connections with
+	// watchtowers. Subsequent backoff durations will grow exponentially
+	// up until MaxBackoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff defines the maximum backoff applied to connections with
+	// watchtowers.
+	MaxBackoff time.Duration
+}
+
+// AuthDial connects to the tower at netAddr using the brontide transport,
+// authenticating the connection with localPriv.
+func AuthDial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
+	dialer func(string, string) (net.Conn, error)) (wtserver.Peer, error) {
+
+	return brontide.Dial(localPriv, netAddr, dialer)
+}
+
+// RegisteredTower encompasses information about a registered watchtower with
+// the client.
+type RegisteredTower struct {
+	*wtdb.Tower
+
+	// Sessions is the set of sessions corresponding to the watchtower.
+	Sessions map[wtdb.SessionID]*wtdb.ClientSession
+}
+
+// backupRequest is a request to back up a revoked state, queued by BackupState
+// until the client's dispatcher persists it.
+type backupRequest struct {
+	chanID     lnwire.ChannelID
+	breachInfo *lnwallet.BreachRetribution
+}
+
+// TowerClient is a concrete implementation of the Client interface, offering
+// a non-blocking, reliable subsystem for backing up revoked states to a set
+// of remote watchtowers. BackupState only queues the request, which is then
+// signed and persisted by the client's dispatcher, and delivered to every
+// registered tower by a dedicated worker. The workers resume delivery from
+// their last acknowledged backup upon restart.
+type TowerClient struct {
+	started uint32 // to be used atomically
+	stopped uint32 // to be used atomically
+
+	cfg *Config
+
+	stats ClientStats
+
+	// sweepMtx serializes the registration of sweep pkscripts, ensuring
+	// each channel is only assigned a single address.
+	sweepMtx sync.Mutex
+
+	// pendingBackups holds the requests received by BackupState that have
+	// yet to be persisted. newRequests is signaled whenever a request is
+	// added.
+	pendingMtx     sync.Mutex
+	pendingBackups []*backupRequest
+	newRequests    chan struct{}
+
+	workerMtx sync.Mutex
+	workers   map[wtdb.TowerID]*towerWorker
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// Compile-time constraint to ensure *TowerClient implements the Client
+// interface.
+var _ Client = (*TowerClient)(nil)
+
+// New initializes a new TowerClient from the provided Config. An error is
+// returned if the client's policy is invalid.
+func New(cfg *Config) (*TowerClient, error) {
+	// Copy the config to prevent side-effects from modifying both the
+	// internal and external version of the Config.
+	cfgCopy := new(Config)
+	*cfgCopy = *cfg
+	cfg = cfgCopy
+
+	if cfg.ReadTimeout == 0 {
+		cfg.ReadTimeout = DefaultReadTimeout
+	}
+	if cfg.WriteTimeout == 0 {
+		cfg.WriteTimeout = DefaultWriteTimeout
+	}
+	if cfg.MinBackoff == 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.AuthDial == nil {
+		cfg.AuthDial = AuthDial
+	}
+
+	switch {
+	case cfg.Policy.BlobVersion < blob.MinVersion ||
+		cfg.Policy.BlobVersion > blob.MaxVersion:
+
+		return nil, blob.ErrUnknownBlobVersion
+
+	case cfg.Policy.MaxUpdates == 0:
+		return nil, errors.New("max updates must be positive")
+
+	case cfg.Policy.SweepFeeRate == 0:
+		return nil, errors.New("sweep fee rate must be positive")
+	}
+
+	return &TowerClient{
+		cfg:         cfg,
+		newRequests: make(chan struct{}, 1),
+		workers:     make(map[wtdb.TowerID]*towerWorker),
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+// Start initializes the watchtower client by spawning the backup dispatcher
+// and a worker for each of the towers found in the database, which will
+// deliver any backups that were queued before the client was last shut down.
+func (c *TowerClient) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting watchtower client")
+
+	towers, err := c.cfg.DB.ListTowers()
+	if err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.backupDispatcher()
+
+	c.workerMtx.Lock()
+	for _, tower := range towers {
+		c.addWorker(tower.IdentityKey)
+	}
+	c.workerMtx.Unlock()
+
+	log.Infof("Watchtower client started successfully, delivering "+
+		"backups to %d tower(s) with policy %v", len(towers),
+		c.cfg.Policy)
+
+	return nil
+}
+
+// Stop signals the dispatcher and all tower workers to exit, and blocks until
+// they have done so. Any queued requests are persisted before the dispatcher
+// exits, and backups that have not yet been acknowledged remain in the
+// database.
+func (c *TowerClient) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping watchtower client")
+
+	close(c.quit)
+
+	c.workerMtx.Lock()
+	for id, worker := range c.workers {
+		worker.stop()
+		delete(c.workers, id)
+	}
+	c.workerMtx.Unlock()
+
+	c.wg.Wait()
+
+	log.Infof("Watchtower client stopped, %v", c.stats.Copy())
+
+	return nil
+}
+
+// BackupState queues a request to back up the revoked state described by
+// breachInfo, and returns without waiting for the backup to be constructed.
+// The dispatcher then signs the justice kit and persists it, after which it is
+// delivered to all registered towers, even if the client is restarted. States
+// that are backed up while no towers are registered are kept until one is
+// added.
+func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
+	breachInfo *lnwallet.BreachRetribution) error {
+
+	// The quit channel is checked while holding the pendingMtx, ensuring
+	// that any request accepted here is seen by the dispatcher's final
+	// pass before it exits.
+	c.pendingMtx.Lock()
+	select {
+	case <-c.quit:
+		c.pendingMtx.Unlock()
+		return ErrClientExiting
+	default:
+	}
+
+	c.pendingBackups = append(c.pendingBackups, &backupRequest{
+		chanID:     *chanID,
+		breachInfo: breachInfo,
+	})
+	c.pendingMtx.Unlock()
+
+	c.stats.taskReceived()
+
+	select {
+	case c.newRequests <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// backupDispatcher persists the requests queued by BackupState until the
+// client is stopped, at which point any remaining requests are persisted
+// before exiting.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (c *TowerClient) backupDispatcher() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.newRequests:
+			c.processPendingBackups()
+
+		case <-c.quit:
+			c.processPendingBackups()
+			return
+		}
+	}
+}
+
+// processPendingBackups removes all queued requests and persists a backup
+// for each of them. Failures are logged, as there is no caller to report them
+// to.
+func (c *TowerClient) processPendingBackups() {
+	c.pendingMtx.Lock()
+	requests := c.pendingBackups
+	c.pendingBackups = nil
+	c.pendingMtx.Unlock()
+
+	for _, req := range requests {
+		err := c.backupState(&req.chanID, req.breachInfo)
+		if err != nil {
+			log.Errorf("Unable to back up ChannelID(%v) at "+
+				"height %d: %v", req.chanID,
+				req.breachInfo.RevokedStateNum, err)
+		}
+	}
+}
+
+// backupState constructs a justice kit for the revoked state described by
+// breachInfo, and queues it for delivery to all registered towers. If the
+// revoked state cannot be swept under the client's policy, the request is
+// dropped and nil is returned.
+func (c *TowerClient) backupState(chanID *lnwire.ChannelID,
+	breachInfo *lnwallet.BreachRetribution) error {
+
+	sweepPkScript, err := c.sweepPkScript(*chanID)
+	if err != nil {
+		return err
+	}
+
+	task, err := newBackupTask(
+		chanID, breachInfo, sweepPkScript, &c.cfg.Policy,
+		c.cfg.Signer,
+	)
+	switch {
+	case err == ErrNoToLocalOutput ||
+		err == lookout.ErrSweepAmountBelowDust:
+
+		log.Debugf("Skipping backup of ChannelID(%v) at height %d: "+
+			"%v", chanID, breachInfo.RevokedStateNum, err)
+		c.stats.taskIneligible()
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	if err := c.cfg.DB.QueueBackup(task); err != nil {
+		return err
+	}
+
+	log.Debugf("Queued backup %d of ChannelID(%v) at height %d", task.ID,
+		chanID, breachInfo.RevokedStateNum)
+
+	// Wake up each of the workers so that the new backup is delivered.
+	c.workerMtx.Lock()
+	for _, worker := range c.workers {
+		worker.signal()
+	}
+	c.workerMtx.Unlock()
+
+	return nil
+}
+
+// sweepPkScript returns the pkscript that justice transactions for the given
+// channel should pay to. A new address is generated and registered the first
+// time a channel is backed up, and reused for all subsequent backups.
+func (c *TowerClient) sweepPkScript(chanID lnwire.ChannelID) ([]byte, error) {
+	c.sweepMtx.Lock()
+	defer c.sweepMtx.Unlock()
+
+	pkScript, err := c.cfg.DB.FetchChanSweepPkScript(chanID)
+	switch {
+	case err == nil:
+		return pkScript, nil
+
+	case err != wtdb.ErrChannelNotRegistered:
+		return nil, err
+	}
+
+	pkScript, err = c.cfg.NewAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cfg.DB.RegisterChannel(chanID, pkScript); err != nil {
+		return nil, err
+	}
+
+	return pkScript, nil
+}
+
+// AddTower adds a new watchtower reachable at the given address and considers
+// it for new sessions. If the watchtower already exists, then any new
+// addresses included will be considered when dialing it for session
+// negotiations and backups. A new tower is delivered every backup that is
+// still pending, including those queued while no towers were registered.
+func (c *TowerClient) AddTower(addr *lnwire.NetAddress) error {
+	if _, err := c.cfg.DB.CreateTower(addr); err != nil {
+		return err
+	}
+
+	c.workerMtx.Lock()
+	defer c.workerMtx.Unlock()
+
+	// Workers are only spawned once the client has been started, at which
+	// point all towers in the database are loaded.
+	if atomic.LoadUint32(&c.started) == 0 {
+		return nil
+	}
+
+	select {
+	case <-c.quit:
+		return ErrClientExiting
+	default:
+	}
+
+	towerID := wtdb.NewTowerIDFromPubKey(addr.IdentityKey)
+	if worker, ok := c.workers[towerID]; ok {
+		// The worker reloads the tower's addresses before every
+		// connection attempt, so signaling it is sufficient to have it
+		// retry with the new address.
+		worker.signal()
+		return nil
+	}
+
+	c.addWorker(addr.IdentityKey)
+
+	return nil
+}
+
+// RemoveTower removes a watchtower from being considered for future session
+// negotiations and from being used for any subsequent backups until it's added
+// again. If an address is provided, then this call only serves as a way of
+// removing the address from the watchtower instead.
+func (c *TowerClient) RemoveTower(pubKey *btcec.PublicKey,
+	addr net.Addr) error {
+
+	if err := c.cfg.DB.RemoveTower(pubKey, addr); err != nil {
+		return err
+	}
+
+	if addr != nil {
+		return nil
+	}
+
+	c.workerMtx.Lock()
+	defer c.workerMtx.Unlock()
+
+	towerID := wtdb.NewTowerIDFromPubKey(pubKey)
+	if worker, ok := c.workers[towerID]; ok {
+		worker.stop()
+		delete(c.workers, towerID)
+	}
+
+	return nil
+}
+
+// RegisteredTowers retrieves the list of watchtowers registered with the
+// client, along with the sessions negotiated with each.
+func (c *TowerClient) RegisteredTowers() ([]*RegisteredTower, error) {
+	towers, err := c.cfg.DB.ListTowers()
+	if err != nil {
+		return nil, err
+	}
+
+	registeredTowers := make([]*RegisteredTower, 0, len(towers))
+	for _, tower := range towers {
+		registeredTower, err := c.registeredTower(tower)
+		if err != nil {
+			return nil, err
+		}
+
+		registeredTowers = append(registeredTowers, registeredTower)
+	}
+
+	return registeredTowers, nil
+}
+
+// LookupTower retrieves a registered watchtower through its public key.
+func (c *TowerClient) LookupTower(pubKey *btcec.PublicKey) (*RegisteredTower,
+	error) {
+
+	tower, err := c.cfg.DB.LoadTower(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.registeredTower(tower)
+}
+
+// registeredTower pairs the tower with the sessions negotiated with it.
+func (c *TowerClient) registeredTower(
+	tower *wtdb.Tower) (*RegisteredTower, error) {
+
+	towerID := tower.ID()
+	sessions, err := c.cfg.DB.ListClientSessions(&towerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegisteredTower{
+		Tower:    tower,
+		Sessions: sessions,
+	}, nil
+}
+
+// Stats returns the in-memory statistics of the client since startup.
+func (c *TowerClient) Stats() ClientStats {
+	return c.stats.Copy()
+}
+
+// addWorker spawns a new worker responsible for delivering backups to the
+// tower identified by pubKey.
+//
+// NOTE: This method MUST be called with the workerMtx held.
+func (c *TowerClient) addWorker(pubKey *btcec.PublicKey) {
+	worker := newTowerWorker(pubKey, c.cfg, &c.stats)
+	c.workers[worker.towerID] = worker
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		worker.run()
+	}()
+}
@@ -0,0 +1,748 @@
+package wtclient_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtclient"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+)
+
+const (
+	testCSVDelay    = 144
+	testToLocalAmt  = btcutil.Amount(100000)
+	testToRemoteAmt = btcutil.Amount(200000)
+	waitTimeout     = 5 * time.Second
+)
+
+var (
+	towerAddr = &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9911}
+
+	testPolicy = wtdb.SessionPolicy{
+		BlobVersion:  0,
+		MaxUpdates:   3,
+		SweepFeeRate: 1000,
+	}
+)
+
+func newPrivKey(t *testing.T) *btcec.PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate priv key: %v", err)
+	}
+
+	return priv
+}
+
+// mockConn is one end of an in-memory, message-oriented connection between
+// the client and the tower's server.
+type mockConn struct {
+	remotePub *btcec.PublicKey
+
+	incoming <-chan []byte
+	outgoing chan<- []byte
+
+	quit     chan struct{}
+	quitOnce *sync.Once
+}
+
+// newMockConnPair creates two connected mockConns, the first of which is
+// returned to the client, and the second of which is handed to the server.
+// Closing either end closes the connection.
+func newMockConnPair(clientPub, towerPub *btcec.PublicKey) (*mockConn,
+	*mockConn) {
+
+	var (
+		clientToTower = make(chan []byte, 10)
+		towerToClient = make(chan []byte, 10)
+		quit          = make(chan struct{})
+		quitOnce      = new(sync.Once)
+	)
+
+	clientConn := &mockConn{
+		remotePub: towerPub,
+		incoming:  towerToClient,
+		outgoing:  clientToTower,
+		quit:      quit,
+		quitOnce:  quitOnce,
+	}
+	towerConn := &mockConn{
+		remotePub: clientPub,
+		incoming:  clientToTower,
+		outgoing:  towerToClient,
+		quit:      quit,
+		quitOnce:  quitOnce,
+	}
+
+	return clientConn, towerConn
+}
+
+func (c *mockConn) Write(b []byte) (int, error) {
+	select {
+	case <-c.quit:
+		return 0, io.EOF
+	default:
+	}
+
+	select {
+	case c.outgoing <- b:
+		return len(b), nil
+	case <-c.quit:
+		return 0, io.EOF
+	}
+}
+
+func (c *mockConn) Close() error {
+	c.quitOnce.Do(func() {
+		close(c.quit)
+	})
+	return nil
+}
+
+func (c *mockConn) ReadNextMessage() ([]byte, error) {
+	// Deliver any messages written before the connection was closed.
+	select {
+	case b := <-c.incoming:
+		return b, nil
+	default:
+	}
+
+	select {
+	case b := <-c.incoming:
+		return b, nil
+	case <-c.quit:
+		return nil, io.EOF
+	}
+}
+
+func (c *mockConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func (c *mockConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *mockConn) RemotePub() *btcec.PublicKey {
+	return c.remotePub
+}
+
+func (c *mockConn) RemoteAddr() net.Addr {
+	return towerAddr
+}
+
+// mockNet routes connections dialed by the client directly to the tower's
+// server.
+type mockNet struct {
+	server wtserver.Interface
+}
+
+func (m *mockNet) AuthDial(localPriv *btcec.PrivateKey,
+	netAddr *lnwire.NetAddress,
+	_ func(string, string) (net.Conn, error)) (wtserver.Peer, error) {
+
+	clientConn, towerConn := newMockConnPair(
+		localPriv.PubKey(), netAddr.IdentityKey,
+	)
+	m.server.InboundPeerConnected(towerConn)
+
+	return clientConn, nil
+}
+
+// mockKeyRing is a keychain.SecretKeyRing that derives fresh random keys for
+// each key locator.
+type mockKeyRing struct {
+	mu        sync.Mutex
+	nextIndex uint32
+	keys      map[keychain.KeyLocator]*btcec.PrivateKey
+}
+
+func newMockKeyRing() *mockKeyRing {
+	return &mockKeyRing{
+		keys: make(map[keychain.KeyLocator]*btcec.PrivateKey),
+	}
+}
+
+func (m *mockKeyRing) privKey(loc keychain.KeyLocator) (*btcec.PrivateKey,
+	error) {
+
+	if priv, ok := m.keys[loc]; ok {
+		return priv, nil
+	}
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	m.keys[loc] = priv
+
+	return priv, nil
+}
+
+func (m *mockKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loc := keychain.KeyLocator{
+		Family: keyFam,
+		Index:  m.nextIndex,
+	}
+	m.nextIndex++
+
+	priv, err := m.privKey(loc)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     priv.PubKey(),
+	}, nil
+}
+
+func (m *mockKeyRing) DeriveKey(
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	priv, err := m.privKey(loc)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     priv.PubKey(),
+	}, nil
+}
+
+func (m *mockKeyRing) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.privKey(keyDesc.KeyLocator)
+}
+
+func (m *mockKeyRing) ScalarMult(keychain.KeyDescriptor,
+	*btcec.PublicKey) ([]byte, error) {
+
+	return nil, errors.New("not implemented")
+}
+
+// mockSigner signs for any key it has been given, without applying tweaks.
+type mockSigner struct {
+	mu   sync.Mutex
+	keys map[string]*btcec.PrivateKey
+}
+
+func newMockSigner() *mockSigner {
+	return &mockSigner{
+		keys: make(map[string]*btcec.PrivateKey),
+	}
+}
+
+func (s *mockSigner) addKey(priv *btcec.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[string(priv.PubKey().SerializeCompressed())] = priv
+}
+
+func (s *mockSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	s.mu.Lock()
+	priv, ok := s.keys[string(signDesc.KeyDesc.PubKey.SerializeCompressed())]
+	s.mu.Unlock()
+	if !ok {
+		return nil, errors.New("unknown key")
+	}
+
+	sig, err := txscript.RawTxInWitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.WitnessScript,
+		signDesc.HashType, priv,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:len(sig)-1], nil
+}
+
+func (s *mockSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return nil, errors.New("not implemented")
+}
+
+// testHarness couples a watchtower server with a client backing up to it.
+type testHarness struct {
+	t *testing.T
+
+	towerPriv *btcec.PrivateKey
+	towerDB   *wtdb.TowerDB
+	server    *wtserver.Server
+
+	clientDB *wtdb.ClientDB
+	signer   *mockSigner
+	client   *wtclient.TowerClient
+
+	sweepPkScript []byte
+
+	cleanUps []func()
+}
+
+func newHarness(t *testing.T) *testHarness {
+	h := &testHarness{
+		t:         t,
+		towerPriv: newPrivKey(t),
+		signer:    newMockSigner(),
+	}
+
+	towerDir, err := ioutil.TempDir("", "wtclient-tower")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { os.RemoveAll(towerDir) })
+
+	h.towerDB, err = wtdb.OpenTowerDB(towerDir)
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to open tower db: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { h.towerDB.Close() })
+
+	h.server, err = wtserver.New(&wtserver.Config{
+		DB:           h.towerDB,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+	})
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to create server: %v", err)
+	}
+	if err := h.server.Start(); err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to start server: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { h.server.Stop() })
+
+	clientDir, err := ioutil.TempDir("", "wtclient-client")
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { os.RemoveAll(clientDir) })
+
+	h.clientDB, err = wtdb.OpenClientDB(clientDir)
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to open client db: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { h.clientDB.Close() })
+
+	h.sweepPkScript, err = lnwallet.CommitScriptUnencumbered(
+		newPrivKey(t).PubKey(),
+	)
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to create sweep pkscript: %v", err)
+	}
+
+	network := &mockNet{server: h.server}
+	h.client, err = wtclient.New(&wtclient.Config{
+		Signer: h.signer,
+		NewAddress: func() ([]byte, error) {
+			return h.sweepPkScript, nil
+		},
+		SecretKeyRing: newMockKeyRing(),
+		AuthDial:      network.AuthDial,
+		DB:            h.clientDB,
+		Policy:        testPolicy,
+		ReadTimeout:   time.Second,
+		WriteTimeout:  time.Second,
+		MinBackoff:    10 * time.Millisecond,
+		MaxBackoff:    100 * time.Millisecond,
+	})
+	if err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to create client: %v", err)
+	}
+	if err := h.client.Start(); err != nil {
+		h.cleanUp()
+		t.Fatalf("unable to start client: %v", err)
+	}
+	h.cleanUps = append(h.cleanUps, func() { h.client.Stop() })
+
+	return h
+}
+
+// cleanUp tears down the harness's components in the reverse order of their
+// creation.
+func (h *testHarness) cleanUp() {
+	for i := len(h.cleanUps) - 1; i >= 0; i-- {
+		h.cleanUps[i]()
+	}
+}
+
+// addTower registers the harness's tower with the client.
+func (h *testHarness) addTower() {
+	err := h.client.AddTower(&lnwire.NetAddress{
+		IdentityKey: h.towerPriv.PubKey(),
+		Address:     towerAddr,
+	})
+	if err != nil {
+		h.t.Fatalf("unable to add tower: %v", err)
+	}
+}
+
+// newBreachRetribution constructs a breached commitment paying to a to-local
+// output for the remote party and, optionally, a to-remote output for us. The
+// signer is given the private keys needed to sign for the outputs.
+func (h *testHarness) newBreachRetribution(height uint64,
+	withToRemote bool) *lnwallet.BreachRetribution {
+
+	revPriv := newPrivKey(h.t)
+	delayPriv := newPrivKey(h.t)
+	toRemotePriv := newPrivKey(h.t)
+
+	h.signer.addKey(revPriv)
+	h.signer.addKey(toRemotePriv)
+
+	toLocalScript, err := lnwallet.CommitScriptToSelf(
+		testCSVDelay, delayPriv.PubKey(), revPriv.PubKey(),
+	)
+	if err != nil {
+		h.t.Fatalf("unable to create to-local script: %v", err)
+	}
+	toLocalPkScript, err := lnwallet.WitnessScriptHash(toLocalScript)
+	if err != nil {
+		h.t.Fatalf("unable to create to-local pkscript: %v", err)
+	}
+	toRemotePkScript, err := lnwallet.CommitScriptUnencumbered(
+		toRemotePriv.PubKey(),
+	)
+	if err != nil {
+		h.t.Fatalf("unable to create to-remote pkscript: %v", err)
+	}
+
+	// Each commitment spends a different funding outpoint to ensure the
+	// breach hints are unique.
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: uint32(height)},
+	})
+	commitTx.AddTxOut(&wire.TxOut{
+		PkScript: toLocalPkScript,
+		Value:    int64(testToLocalAmt),
+	})
+	if withToRemote {
+		commitTx.AddTxOut(&wire.TxOut{
+			PkScript: toRemotePkScript,
+			Value:    int64(testToRemoteAmt),
+		})
+	}
+	commitHash := commitTx.TxHash()
+
+	breachInfo := &lnwallet.BreachRetribution{
+		BreachTransaction: commitTx,
+		RevokedStateNum:   height,
+		KeyRing: &lnwallet.CommitmentKeyRing{
+			RevocationKey: revPriv.PubKey(),
+			DelayKey:      delayPriv.PubKey(),
+			NoDelayKey:    toRemotePriv.PubKey(),
+		},
+		RemoteDelay: testCSVDelay,
+		RemoteOutpoint: wire.OutPoint{
+			Hash:  commitHash,
+			Index: 0,
+		},
+		RemoteOutputSignDesc: &lnwallet.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: revPriv.PubKey(),
+			},
+			WitnessScript: toLocalScript,
+			Output:        commitTx.TxOut[0],
+			HashType:      txscript.SigHashAll,
+		},
+	}
+
+	if withToRemote {
+		breachInfo.LocalOutpoint = wire.OutPoint{
+			Hash:  commitHash,
+			Index: 1,
+		}
+		breachInfo.LocalOutputSignDesc = &lnwallet.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: toRemotePriv.PubKey(),
+			},
+			WitnessScript: toRemotePkScript,
+			Output:        commitTx.TxOut[1],
+			HashType:      txscript.SigHashAll,
+		}
+	}
+
+	return breachInfo
+}
+
+// waitForMatches blocks until the tower has accepted state updates for each
+// of the breached commitments, returning the matches found.
+func (h *testHarness) waitForMatches(
+	breaches []*lnwallet.BreachRetribution) []wtdb.Match {
+
+	hints := make([]wtdb.BreachHint, 0, len(breaches))
+	for _, breachInfo := range breaches {
+		txid := breachInfo.BreachTransaction.TxHash()
+		hints = append(hints, wtdb.NewBreachHintFromHash(&txid))
+	}
+
+	deadline := time.After(waitTimeout)
+	for {
+		matches, err := h.towerDB.QueryMatches(hints)
+		if err != nil {
+			h.t.Fatalf("unable to query matches: %v", err)
+		}
+		if len(matches) == len(breaches) {
+			return matches
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			h.t.Fatalf("tower only received %d of %d backups",
+				len(matches), len(breaches))
+		}
+	}
+}
+
+// assertJusticeTxn decrypts the match using the breached commitment's txid,
+// and asserts that the resulting justice transaction is fully valid.
+func (h *testHarness) assertJusticeTxn(match *wtdb.Match,
+	breachInfo *lnwallet.BreachRetribution) {
+
+	commitTx := breachInfo.BreachTransaction
+	commitTxID := commitTx.TxHash()
+	key := wtdb.NewBreachKeyFromHash(&commitTxID)
+
+	kit, err := blob.Decrypt(
+		match.EncryptedBlob[:blob.NonceSize], key[:],
+		match.EncryptedBlob[blob.NonceSize:], match.SessionInfo.Version,
+	)
+	if err != nil {
+		h.t.Fatalf("unable to decrypt blob: %v", err)
+	}
+
+	desc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: commitTx,
+		SessionInfo:      match.SessionInfo,
+		JusticeKit:       kit,
+	}
+	justiceTxn, err := desc.CreateJusticeTxn()
+	if err != nil {
+		h.t.Fatalf("unable to create justice txn: %v", err)
+	}
+
+	if len(justiceTxn.TxIn) != len(commitTx.TxOut) {
+		h.t.Fatalf("expected justice txn to spend %d outputs, "+
+			"spends %d", len(commitTx.TxOut), len(justiceTxn.TxIn))
+	}
+
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+	for i, txIn := range justiceTxn.TxIn {
+		prevOut := commitTx.TxOut[txIn.PreviousOutPoint.Index]
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, justiceTxn, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			h.t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			h.t.Fatalf("input %d failed validation: %v", i, err)
+		}
+	}
+}
+
+// TestClientBackupStates asserts that revoked states backed up by the client
+// are delivered to the tower across multiple sessions, and that the tower can
+// use them to construct valid justice transactions.
+func TestClientBackupStates(t *testing.T) {
+	h := newHarness(t)
+	defer h.cleanUp()
+
+	h.addTower()
+
+	// Back up more states than a single session allows, forcing the
+	// client to negotiate a second session with the tower.
+	const numStates = 5
+	chanID := lnwire.ChannelID{0x01}
+	breaches := make([]*lnwallet.BreachRetribution, 0, numStates)
+	for i := uint64(0); i < numStates; i++ {
+		breachInfo := h.newBreachRetribution(i, i%2 == 0)
+		breaches = append(breaches, breachInfo)
+
+		if err := h.client.BackupState(&chanID, breachInfo); err != nil {
+			t.Fatalf("unable to back up state %d: %v", i, err)
+		}
+	}
+
+	matches := h.waitForMatches(breaches)
+
+	breachByHint := make(map[wtdb.BreachHint]*lnwallet.BreachRetribution)
+	for _, breachInfo := range breaches {
+		txid := breachInfo.BreachTransaction.TxHash()
+		breachByHint[wtdb.NewBreachHintFromHash(&txid)] = breachInfo
+	}
+	for i := range matches {
+		h.assertJusticeTxn(&matches[i], breachByHint[matches[i].Hint])
+	}
+
+	// Once the tower has acknowledged every backup, the client should
+	// have removed them from its database.
+	deadline := time.After(waitTimeout)
+	for {
+		pending, err := h.clientDB.FetchBackups(0, numStates)
+		if err != nil {
+			t.Fatalf("unable to fetch backups: %v", err)
+		}
+		if len(pending) == 0 {
+			break
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("client still has %d pending backups",
+				len(pending))
+		}
+	}
+
+	stats := h.client.Stats()
+	if stats.NumTasksReceived != numStates ||
+		stats.NumTasksAccepted != numStates {
+
+		t.Fatalf("unexpected client stats: %v", stats)
+	}
+	if stats.NumSessionsAcquired != 2 {
+		t.Fatalf("expected 2 sessions, got: %d",
+			stats.NumSessionsAcquired)
+	}
+
+	towers, err := h.client.RegisteredTowers()
+	if err != nil {
+		t.Fatalf("unable to list towers: %v", err)
+	}
+	if len(towers) != 1 || len(towers[0].Sessions) != 2 {
+		t.Fatalf("expected one tower with two sessions, got: %v",
+			towers)
+	}
+}
+
+// TestClientBackupNoTowers asserts that states backed up before any towers are
+// registered are persisted, and delivered once a tower is added.
+func TestClientBackupNoTowers(t *testing.T) {
+	h := newHarness(t)
+	defer h.cleanUp()
+
+	chanID := lnwire.ChannelID{0x01}
+	early := h.newBreachRetribution(0, true)
+	if err := h.client.BackupState(&chanID, early); err != nil {
+		t.Fatalf("unable to back up state: %v", err)
+	}
+
+	// The state is persisted in the background, so wait for it to appear
+	// in the client's database.
+	deadline := time.After(waitTimeout)
+	for {
+		pending, err := h.clientDB.FetchBackups(0, 1)
+		if err != nil {
+			t.Fatalf("unable to fetch backups: %v", err)
+		}
+		if len(pending) == 1 {
+			break
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("state was not persisted")
+		}
+	}
+
+	if stats := h.client.Stats(); stats.NumTasksIneligible != 0 {
+		t.Fatalf("expected no ineligible tasks, got: %v", stats)
+	}
+
+	h.addTower()
+
+	late := h.newBreachRetribution(1, true)
+	if err := h.client.BackupState(&chanID, late); err != nil {
+		t.Fatalf("unable to back up state: %v", err)
+	}
+
+	// Both states should reach the tower, including the one backed up
+	// before the tower was added.
+	breaches := []*lnwallet.BreachRetribution{early, late}
+	matches := h.waitForMatches(breaches)
+
+	breachByHint := make(map[wtdb.BreachHint]*lnwallet.BreachRetribution)
+	for _, breachInfo := range breaches {
+		txid := breachInfo.BreachTransaction.TxHash()
+		breachByHint[wtdb.NewBreachHintFromHash(&txid)] = breachInfo
+	}
+	for i := range matches {
+		h.assertJusticeTxn(&matches[i], breachByHint[matches[i].Hint])
+	}
+}
+
+// TestClientBackupStop asserts that states queued right before the client is
+// stopped are persisted before Stop returns.
+func TestClientBackupStop(t *testing.T) {
+	h := newHarness(t)
+	defer h.cleanUp()
+
+	chanID := lnwire.ChannelID{0x01}
+	breachInfo := h.newBreachRetribution(0, true)
+	if err := h.client.BackupState(&chanID, breachInfo); err != nil {
+		t.Fatalf("unable to back up state: %v", err)
+	}
+	if err := h.client.Stop(); err != nil {
+		t.Fatalf("unable to stop client: %v", err)
+	}
+
+	pending, err := h.clientDB.FetchBackups(0, 1)
+	if err != nil {
+		t.Fatalf("unable to fetch backups: %v", err)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending backup, got: %d", len(pending))
+	}
+
+	err = h.client.BackupState(&chanID, breachInfo)
+	if err != wtclient.ErrClientExiting {
+		t.Fatalf("expected ErrClientExiting, got: %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+package wtclient
+
+import "github.com/lightningnetwork/lnd/lnwire"
+
+// Conf specifies the watchtower client options that can be configured from
+// the command line or configuration file.
+type Conf struct {
+	Active bool `long:"active" description:"If true, revoked states will be backed up to the configured watchtowers."`
+
+	Towers []string `long:"tower" description:"Add a watchtower to back up revoked states to, of the form pubkey@host:port"`
+
+	SweepFeeRate uint64 `long:"sweepfeerate" description:"Fee rate, in sat/byte, used by towers when sweeping breached channels on the client's behalf"`
+
+	MaxUpdates uint16 `long:"maxupdates" description:"The maximum number of updates to request per session negotiated with a watchtower"`
+
+	// TowerAddrs is the parsed set of towers derived from Towers which
+	// will be registered with the client upon startup.
+	TowerAddrs []*lnwire.NetAddress
+}
@@ -0,0 +1,120 @@
+package wtclient
+
+import (
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+)
+
+// Client is the primary interface used by the daemon to control a client's
+// lifecycle and backup revoked states.
+type Client interface {
+	// AddTower adds a new watchtower reachable at the given address and
+	// considers it for new sessions. If the watchtower already exists,
+	// then any new addresses included will be considered when dialing it
+	// for session negotiations and backups.
+	AddTower(*lnwire.NetAddress) error
+
+	// RemoveTower removes a watchtower from being considered for future
+	// session negotiations and from being used for any subsequent backups
+	// until it's added again. If an address is provided, then this call
+	// only serves as a way of removing the address from the watchtower
+	// instead.
+	RemoveTower(*btcec.PublicKey, net.Addr) error
+
+	// RegisteredTowers retrieves the list of watchtowers registered with
+	// the client.
+	RegisteredTowers() ([]*RegisteredTower, error)
+
+	// LookupTower retrieves a registered watchtower through its public
+	// key.
+	LookupTower(*btcec.PublicKey) (*RegisteredTower, error)
+
+	// Stats returns the in-memory statistics of the client since startup.
+	Stats() ClientStats
+
+	// BackupState initiates a request to back up a particular revoked
+	// state. The request is processed asynchronously, such that the
+	// caller is not blocked on constructing and persisting the backup.
+	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution) error
+
+	// Start initializes the watchtower client, allowing it process
+	// requests to backup revoked channel states.
+	Start() error
+
+	// Stop shuts down the watchtower client. Any backups that have not
+	// been acknowledged by every tower remain queued in the database, and
+	// will be delivered once the client is restarted.
+	Stop() error
+}
+
+// DB abstracts the required database operations required by the watchtower
+// client.
+type DB interface {
+	// CreateTower initializes a database entry with the given lightning
+	// address. If the tower exists, the address should be appended to the
+	// list of all addresses used to that tower previously. New towers
+	// should be delivered all backups that are still pending.
+	CreateTower(*lnwire.NetAddress) (*wtdb.Tower, error)
+
+	// RemoveTower modifies a tower's record within the database. If an
+	// address is provided, then _only_ the address record should be
+	// removed from the tower's persisted state. Otherwise, the tower and
+	// its sessions are removed entirely.
+	RemoveTower(*btcec.PublicKey, net.Addr) error
+
+	// LoadTower retrieves a tower by its public key.
+	LoadTower(*btcec.PublicKey) (*wtdb.Tower, error)
+
+	// ListTowers retrieves the list of towers available within the
+	// database.
+	ListTowers() ([]*wtdb.Tower, error)
+
+	// CreateClientSession saves a newly negotiated client session to the
+	// client's database.
+	CreateClientSession(*wtdb.ClientSession) error
+
+	// ListClientSessions returns all sessions known to the client. If a
+	// tower id is provided, only sessions negotiated with that tower are
+	// returned.
+	ListClientSessions(*wtdb.TowerID) (map[wtdb.SessionID]*wtdb.ClientSession, error)
+
+	// MarkSessionInactive prevents the session from being used for any
+	// further backups.
+	MarkSessionInactive(*wtdb.SessionID) error
+
+	// QueueBackup persists a backup task, such that it will be delivered
+	// to every registered tower. If no towers are registered, the task
+	// should be retained until one is added.
+	QueueBackup(*wtdb.BackupTask) error
+
+	// FetchBackups returns up to limit backup tasks queued after the
+	// given backup id.
+	FetchBackups(afterID uint64, limit int) ([]*wtdb.BackupTask, error)
+
+	// CommitUpdate assigns the backup to the next sequence number of the
+	// session, returning the sequence number.
+	CommitUpdate(*wtdb.SessionID, uint64) (uint16, error)
+
+	// AckUpdate records that the tower has accepted the session's update
+	// with the given sequence number.
+	AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) error
+
+	// RegisterChannel registers the sweep pkscript used for justice
+	// transactions of the given channel.
+	RegisterChannel(lnwire.ChannelID, []byte) error
+
+	// FetchChanSweepPkScript returns the sweep pkscript registered for
+	// the given channel.
+	FetchChanSweepPkScript(lnwire.ChannelID) ([]byte, error)
+}
+
+// AuthDialer connects to a remote node using an authenticated transport, such
+// as brontide. The dialer argument is used to specify a resolver, which allows
+// this method to be used over Tor or clear net connections.
+type AuthDialer func(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
+	dialer func(string, string) (net.Conn, error)) (wtserver.Peer, error)
@@ -0,0 +1,80 @@
+package wtclient
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ClientStats is a collection of in-memory statistics of the actions the
+// client has performed since its creation.
+type ClientStats struct {
+	// NumTasksReceived is the total number of backups requested by the
+	// client's channels.
+	NumTasksReceived uint64
+
+	// NumTasksAccepted is the total number of backups acknowledged by
+	// any of our towers.
+	NumTasksAccepted uint64
+
+	// NumTasksIneligible is the total number of backups that could not
+	// be made because the justice transaction would not satisfy the
+	// session policy.
+	NumTasksIneligible uint64
+
+	// NumSessionsAcquired is the total number of new sessions made to
+	// watchtowers.
+	NumSessionsAcquired uint64
+
+	// NumSessionsExhausted is the total number of watchtower sessions that
+	// have been exhausted.
+	NumSessionsExhausted uint64
+}
+
+// taskReceived increments the number of backup requests the client has
+// received from active channels.
+func (s *ClientStats) taskReceived() {
+	atomic.AddUint64(&s.NumTasksReceived, 1)
+}
+
+// taskAccepted increments the number of tasks that have been assigned to
+// sessions and acknowledged by a tower.
+func (s *ClientStats) taskAccepted() {
+	atomic.AddUint64(&s.NumTasksAccepted, 1)
+}
+
+// taskIneligible increments the number of tasks that were unable to satisfy
+// the active session policy.
+func (s *ClientStats) taskIneligible() {
+	atomic.AddUint64(&s.NumTasksIneligible, 1)
+}
+
+// sessionAcquired increments the number of sessions that have been
+// successfully negotiated by the client.
+func (s *ClientStats) sessionAcquired() {
+	atomic.AddUint64(&s.NumSessionsAcquired, 1)
+}
+
+// sessionExhausted increments the number of session that have become full as
+// a result of accepting backup tasks.
+func (s *ClientStats) sessionExhausted() {
+	atomic.AddUint64(&s.NumSessionsExhausted, 1)
+}
+
+// Copy returns a snapshot of the current counters, safe for concurrent use.
+func (s *ClientStats) Copy() ClientStats {
+	return ClientStats{
+		NumTasksReceived:     atomic.LoadUint64(&s.NumTasksReceived),
+		NumTasksAccepted:     atomic.LoadUint64(&s.NumTasksAccepted),
+		NumTasksIneligible:   atomic.LoadUint64(&s.NumTasksIneligible),
+		NumSessionsAcquired:  atomic.LoadUint64(&s.NumSessionsAcquired),
+		NumSessionsExhausted: atomic.LoadUint64(&s.NumSessionsExhausted),
+	}
+}
+
+// String returns a human readable summary of the client's metrics.
+func (s ClientStats) String() string {
+	return fmt.Sprintf("tasks(received=%d accepted=%d ineligible=%d) "+
+		"sessions(acquired=%d exhausted=%d)", s.NumTasksReceived,
+		s.NumTasksAccepted, s.NumTasksIneligible,
+		s.NumSessionsAcquired, s.NumSessionsExhausted)
+}
@@ -0,0 +1,472 @@
+package wtclient
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
+)
+
+// backupBatchSize is the maximum number of backups that will be sent to a
+// tower over a single connection.
+const backupBatchSize = 50
+
+var (
+	// errNoPendingBackups signals that the tower has acknowledged every
+	// backup queued by the client.
+	errNoPendingBackups = errors.New("no pending backups")
+
+	// errNoTowerAddrs signals that the tower has no addresses that can be
+	// dialed.
+	errNoTowerAddrs = errors.New("tower has no addresses")
+)
+
+// towerWorker delivers queued backups to a single tower. Backups are sent in
+// the order they were queued, and the worker only advances past a backup once
+// it has been acknowledged by the tower. Each backup is committed to a
+// session's sequence number before being sent, allowing an interrupted update
+// to be resent under the same sequence number after a restart.
+type towerWorker struct {
+	towerPub *btcec.PublicKey
+	towerID  wtdb.TowerID
+
+	cfg   *Config
+	stats *ClientStats
+
+	// newBackups is signaled whenever a new backup has been queued, or the
+	// tower's addresses have been modified.
+	newBackups chan struct{}
+
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+// newTowerWorker creates a worker for the tower identified by towerPub.
+func newTowerWorker(towerPub *btcec.PublicKey, cfg *Config,
+	stats *ClientStats) *towerWorker {
+
+	return &towerWorker{
+		towerPub:   towerPub,
+		towerID:    wtdb.NewTowerIDFromPubKey(towerPub),
+		cfg:        cfg,
+		stats:      stats,
+		newBackups: make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+	}
+}
+
+// signal wakes up the worker if it is waiting for new backups.
+func (w *towerWorker) signal() {
+	select {
+	case w.newBackups <- struct{}{}:
+	default:
+	}
+}
+
+// stop signals the worker to exit. Any in-flight exchange with the tower is
+// bounded by the configured read and write timeouts.
+func (w *towerWorker) stop() {
+	w.quitOnce.Do(func() {
+		close(w.quit)
+	})
+}
+
+// run is the worker's main event loop, which repeatedly delivers pending
+// backups to the tower. If delivery fails, the worker backs off exponentially
+// before trying again.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (w *towerWorker) run() {
+	var backoff time.Duration
+	for {
+		err := w.deliverBackups()
+		switch {
+		case err == errNoPendingBackups:
+			backoff = 0
+
+			select {
+			case <-w.newBackups:
+			case <-w.quit:
+				return
+			}
+
+		case err != nil:
+			backoff = w.nextBackoff(backoff)
+
+			log.Errorf("Unable to deliver backups to tower %x, "+
+				"retrying in %v: %v", w.towerID[:], backoff, err)
+
+			select {
+			case <-time.After(backoff):
+			case <-w.newBackups:
+			case <-w.quit:
+				return
+			}
+
+		default:
+			backoff = 0
+
+			select {
+			case <-w.quit:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// nextBackoff doubles the previous backoff, clamping the result between the
+// configured minimum and maximum backoffs.
+func (w *towerWorker) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	switch {
+	case backoff < w.cfg.MinBackoff:
+		return w.cfg.MinBackoff
+
+	case backoff > w.cfg.MaxBackoff:
+		return w.cfg.MaxBackoff
+
+	default:
+		return backoff
+	}
+}
+
+// deliverBackups sends the next batch of pending backups to the tower. The
+// batch is sent under a single session, which is negotiated if no existing
+// session can accept the first pending backup. errNoPendingBackups is returned
+// if the tower has acknowledged all queued backups.
+func (w *towerWorker) deliverBackups() error {
+	tower, err := w.cfg.DB.LoadTower(w.towerPub)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := w.cfg.DB.FetchBackups(tower.LastBackupID, backupBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return errNoPendingBackups
+	}
+
+	session, err := w.sessionForTask(tower, tasks[0])
+	if err != nil {
+		return err
+	}
+
+	// Determine how many updates the session can still accept. If the
+	// first task was already committed to the session, it has already
+	// been accounted for in the session's sequence number.
+	remaining := int(session.Policy.MaxUpdates - session.SeqNum)
+	if session.CommittedBackupID == tasks[0].ID {
+		remaining++
+	}
+
+	// Send as many of the tasks as the session can accept, stopping early
+	// if a task was constructed under a different policy.
+	batch := tasks[:1]
+	for _, task := range tasks[1:] {
+		if len(batch) >= remaining ||
+			!task.CompatibleWith(&session.Policy) {
+
+			break
+		}
+		batch = append(batch, task)
+	}
+
+	return w.sendUpdates(tower, session, batch)
+}
+
+// sessionForTask returns a session under which the given task can be sent. A
+// session that already has the task committed is preferred, such that an
+// interrupted update is resent under its original sequence number. Otherwise,
+// any active session with a compatible policy and remaining updates is used,
+// before falling back to negotiating a new session with the tower.
+func (w *towerWorker) sessionForTask(tower *wtdb.Tower,
+	task *wtdb.BackupTask) (*wtdb.ClientSession, error) {
+
+	sessions, err := w.cfg.DB.ListClientSessions(&w.towerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidate *wtdb.ClientSession
+	for _, session := range sessions {
+		switch {
+		case session.Status != wtdb.CSessionActive:
+			continue
+
+		case session.CommittedBackupID == task.ID:
+			return session, nil
+
+		case session.IsExhausted() || session.HasCommittedUpdate() ||
+			!task.CompatibleWith(&session.Policy):
+
+			continue
+		}
+
+		candidate = session
+	}
+
+	if candidate != nil {
+		return candidate, nil
+	}
+
+	return w.negotiateSession(tower, &task.Policy)
+}
+
+// negotiateSession derives a fresh session key and proposes a new session to
+// the tower using the given policy. If the tower accepts, the session is
+// persisted and returned.
+func (w *towerWorker) negotiateSession(tower *wtdb.Tower,
+	policy *wtdb.SessionPolicy) (*wtdb.ClientSession, error) {
+
+	keyDesc, err := w.cfg.SecretKeyRing.DeriveNextKey(
+		keychain.KeyFamilyTowerSession,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionPriv, err := w.cfg.SecretKeyRing.DerivePrivKey(keyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := w.dial(tower, sessionPriv)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	err = w.sendMessage(conn, &wtwire.CreateSession{
+		BlobVersion:  policy.BlobVersion,
+		MaxUpdates:   policy.MaxUpdates,
+		SweepFeeRate: policy.SweepFeeRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := w.readMessage(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, ok := msg.(*wtwire.CreateSessionReply)
+	if !ok {
+		return nil, fmt.Errorf("tower responded to CreateSession "+
+			"with %T", msg)
+	}
+
+	if reply.Code != wtwire.CodeOK {
+		return nil, fmt.Errorf("tower rejected session with "+
+			"policy %v: %v", policy, reply.Code)
+	}
+
+	session := &wtdb.ClientSession{
+		ID:       wtdb.NewSessionIDFromPubKey(sessionPriv.PubKey()),
+		TowerID:  w.towerID,
+		KeyIndex: keyDesc.Index,
+		Policy:   *policy,
+		Status:   wtdb.CSessionActive,
+	}
+	if err := w.cfg.DB.CreateClientSession(session); err != nil {
+		return nil, err
+	}
+
+	w.stats.sessionAcquired()
+
+	log.Infof("Negotiated session %s with tower %x using policy %v",
+		session.ID, w.towerID[:], policy)
+
+	return session, nil
+}
+
+// sendUpdates delivers the batch of backups to the tower under the given
+// session. Each backup is committed to the session before it is sent, and
+// acknowledged once the tower has accepted it. If the tower rejects an update
+// in a way that cannot be resolved by retrying, the session is marked inactive
+// so that the remaining backups are sent under a new session.
+func (w *towerWorker) sendUpdates(tower *wtdb.Tower,
+	session *wtdb.ClientSession, batch []*wtdb.BackupTask) error {
+
+	sessionPriv, err := w.cfg.SecretKeyRing.DerivePrivKey(
+		keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyTowerSession,
+				Index:  session.KeyIndex,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	conn, err := w.dial(tower, sessionPriv)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for i, task := range batch {
+		seqNum, err := w.cfg.DB.CommitUpdate(&session.ID, task.ID)
+		if err != nil {
+			return err
+		}
+
+		update := &wtwire.StateUpdate{
+			SeqNum:        seqNum,
+			LastApplied:   session.TowerLastApplied,
+			Hint:          task.Hint,
+			EncryptedBlob: task.EncryptedBlob,
+		}
+		if i == len(batch)-1 {
+			update.IsComplete = 1
+		}
+
+		if err := w.sendMessage(conn, update); err != nil {
+			return err
+		}
+
+		msg, err := w.readMessage(conn)
+		if err != nil {
+			return err
+		}
+
+		reply, ok := msg.(*wtwire.StateUpdateReply)
+		if !ok {
+			return fmt.Errorf("tower responded to StateUpdate "+
+				"with %T", msg)
+		}
+
+		switch {
+
+		// The tower accepted the update, or has already applied it in
+		// a prior connection that was interrupted before we could
+		// record the acknowledgment.
+		case reply.Code == wtwire.CodeOK,
+			reply.Code == wtwire.StateUpdateCodeClientBehind &&
+				reply.LastApplied >= seqNum:
+
+			err := w.cfg.DB.AckUpdate(
+				&session.ID, seqNum, reply.LastApplied,
+			)
+			if err != nil {
+				return err
+			}
+
+			session.SeqNum = seqNum
+			session.TowerLastApplied = reply.LastApplied
+
+			w.stats.taskAccepted()
+			if seqNum == session.Policy.MaxUpdates {
+				w.stats.sessionExhausted()
+			}
+
+			log.Debugf("Backup %d of ChannelID(%v) at height %d "+
+				"accepted by tower %x as seqnum %d of session "+
+				"%s", task.ID, task.ChanID, task.CommitHeight,
+				w.towerID[:], seqNum, session.ID)
+
+			// The tower hangs up after any non-OK reply, so the
+			// remaining tasks will be sent over a new connection.
+			if reply.Code != wtwire.CodeOK {
+				return nil
+			}
+
+		// The tower will not accept any further updates under this
+		// session, mark it inactive so that a new session is
+		// negotiated for the remaining backups.
+		case reply.Code == wtwire.CodePermanentFailure,
+			reply.Code == wtwire.StateUpdateCodeClientBehind,
+			reply.Code == wtwire.StateUpdateCodeMaxUpdatesExceeded,
+			reply.Code == wtwire.StateUpdateCodeSeqNumOutOfOrder,
+			reply.Code == wtwire.StateUpdateCodeInvalidBlob:
+
+			err := w.cfg.DB.MarkSessionInactive(&session.ID)
+			if err != nil {
+				return err
+			}
+
+			w.stats.sessionExhausted()
+
+			return fmt.Errorf("tower rejected seqnum %d of "+
+				"session %s: %v", seqNum, session.ID,
+				reply.Code)
+
+		default:
+			return fmt.Errorf("unable to send seqnum %d of "+
+				"session %s: %v", seqNum, session.ID,
+				reply.Code)
+		}
+	}
+
+	return nil
+}
+
+// dial attempts to connect to each of the tower's addresses using the given
+// session key, returning the first successful connection.
+func (w *towerWorker) dial(tower *wtdb.Tower,
+	sessionPriv *btcec.PrivateKey) (wtserver.Peer, error) {
+
+	err := errNoTowerAddrs
+	for _, addr := range tower.LNAddrs() {
+		var conn wtserver.Peer
+		conn, err = w.cfg.AuthDial(sessionPriv, addr, w.cfg.Dial)
+		if err != nil {
+			log.Debugf("Unable to connect to tower %x at %v: %v",
+				w.towerID[:], addr.Address, err)
+			continue
+		}
+
+		return conn, nil
+	}
+
+	return nil, err
+}
+
+// readMessage receives and parses the next message from the tower. An error
+// is returned if a message is not received before the client's read timeout,
+// the read off the wire failed, or the message could not be deserialized.
+func (w *towerWorker) readMessage(peer wtserver.Peer) (wtwire.Message, error) {
+	err := peer.SetReadDeadline(time.Now().Add(w.cfg.ReadTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("unable to set read deadline: %v", err)
+	}
+
+	rawMsg, err := peer.ReadNextMessage()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read message: %v", err)
+	}
+
+	msg, err := wtwire.ReadMessage(bytes.NewReader(rawMsg), 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse message: %v", err)
+	}
+
+	return msg, nil
+}
+
+// sendMessage sends a watchtower wire message to the tower.
+func (w *towerWorker) sendMessage(peer wtserver.Peer, msg wtwire.Message) error {
+	var b bytes.Buffer
+	if _, err := wtwire.WriteMessage(&b, msg, 0); err != nil {
+		return fmt.Errorf("unable to encode msg: %v", err)
+	}
+
+	err := peer.SetWriteDeadline(time.Now().Add(w.cfg.WriteTimeout))
+	if err != nil {
+		return fmt.Errorf("unable to set write deadline: %v", err)
+	}
+
+	_, err = peer.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,42 @@
+package wtdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// BreachHintSize is the length of the txid prefix used to identify remote
+// commitment broadcasts.
+const BreachHintSize = 16
+
+// BreachHint is the first 16-bytes of the txid belonging to a revoked
+// commitment transaction. Towers index the encrypted blobs they receive under
+// this value, and use it to efficiently detect whether a transaction included
+// in a block corresponds to a breach for which they hold a justice kit.
+type BreachHint [BreachHintSize]byte
+
+// NewBreachHintFromHash creates a breach hint from a transaction ID.
+func NewBreachHintFromHash(hash *chainhash.Hash) BreachHint {
+	var hint BreachHint
+	copy(hint[:], hash[:BreachHintSize])
+	return hint
+}
+
+// String returns a hex encoding of the breach hint.
+func (h BreachHint) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// BreachKey is the symmetric key used to encrypt and decrypt the justice kit
+// for a particular revoked commitment. It is derived from the full txid of the
+// revoked commitment, which guarantees that a tower can only learn the
+// contents of a blob after the breach has been broadcast.
+type BreachKey [32]byte
+
+// NewBreachKeyFromHash derives the blob encryption key for the revoked
+// commitment with the given txid, computed as SHA256(txid).
+func NewBreachKeyFromHash(hash *chainhash.Hash) BreachKey {
+	return BreachKey(sha256.Sum256(hash[:]))
+}
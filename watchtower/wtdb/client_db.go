@@ -0,0 +1,812 @@
+package wtdb
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// clientDBName is the filename of client database.
+	clientDBName = "wtclient.db"
+)
+
+var (
+	// cTowerBkt is a top-level bucket storing the towers registered with
+	// the client.
+	//  tower id -> tower
+	cTowerBkt = []byte("client-tower-bucket")
+
+	// cSessionBkt is a top-level bucket storing all sessions negotiated by
+	// the client.
+	//  session id -> client session
+	cSessionBkt = []byte("client-session-bucket")
+
+	// cBackupBkt is a top-level bucket storing the encrypted backups that
+	// have yet to be acknowledged by every registered tower.
+	//  backup id -> backup task
+	cBackupBkt = []byte("client-backup-bucket")
+
+	// cMetadataBkt is a top-level bucket storing bookkeeping values used
+	// by the client.
+	//  cBackupSeqKey -> last assigned backup id
+	cMetadataBkt = []byte("client-metadata-bucket")
+
+	// cBackupSeqKey is the key under which the last assigned backup id is
+	// stored, allowing backup ids to increase monotonically even after the
+	// backups themselves have been removed.
+	cBackupSeqKey = []byte("backup-seq")
+
+	// cChanSweepBkt is a top-level bucket storing the sweep pkscript
+	// chosen for each channel backed up by the client.
+	//  channel id -> sweep pkscript
+	cChanSweepBkt = []byte("client-channel-sweep-bucket")
+
+	// ErrTowerNotFound signals that the target tower was not found in the
+	// database.
+	ErrTowerNotFound = errors.New("tower not found")
+
+	// ErrClientSessionNotFound signals that the requested client session
+	// was not found in the database.
+	ErrClientSessionNotFound = errors.New("client session not found")
+
+	// ErrClientSessionAlreadyExists signals an attempt to reinsert a
+	// client session that has already been created.
+	ErrClientSessionAlreadyExists = errors.New(
+		"client session already exists",
+	)
+
+	// ErrClientSessionExhausted signals that an update could not be
+	// committed because the session is inactive or has no remaining
+	// updates.
+	ErrClientSessionExhausted = errors.New("client session exhausted")
+
+	// ErrUpdateAlreadyCommitted signals that the session already has an
+	// unacknowledged update for a different backup.
+	ErrUpdateAlreadyCommitted = errors.New("session already has a " +
+		"committed update")
+
+	// ErrCommittedUpdateNotFound signals that the tower acked an update
+	// that the client has not committed.
+	ErrCommittedUpdateNotFound = errors.New("committed update not found")
+
+	// ErrBackupNotFound signals that the requested backup task is not in
+	// the database.
+	ErrBackupNotFound = errors.New("backup not found")
+
+	// ErrChannelNotRegistered signals that no sweep pkscript has been
+	// registered for the channel.
+	ErrChannelNotRegistered = errors.New("channel not registered")
+)
+
+// ClientDB is single database providing a persistent storage engine for the
+// wtclient.
+type ClientDB struct {
+	db     *bolt.DB
+	dbPath string
+}
+
+// OpenClientDB opens the client database given the path to the database's
+// directory. If no such database exists, this method will initialize a fresh
+// one containing the top-level buckets used by the client.
+func OpenClientDB(dbPath string) (*ClientDB, error) {
+	if err := os.MkdirAll(dbPath, 0700); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dbPath, clientDBName)
+	bdb, err := bolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDB := &ClientDB{
+		db:     bdb,
+		dbPath: dbPath,
+	}
+
+	// Ensure all top-level buckets exist before the database is handed
+	// to its callers.
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		buckets := [][]byte{
+			cTowerBkt,
+			cSessionBkt,
+			cBackupBkt,
+			cMetadataBkt,
+			cChanSweepBkt,
+		}
+		for _, bucket := range buckets {
+			_, err := tx.CreateBucketIfNotExists(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		bdb.Close()
+		return nil, err
+	}
+
+	return clientDB, nil
+}
+
+// Close closes the underlying database.
+func (c *ClientDB) Close() error {
+	return c.db.Close()
+}
+
+// CreateTower initializes a database entry with the given lightning address.
+// If the tower exists, the address is appended to the list of all addresses
+// used to that tower previously. New towers receive every backup that has yet
+// to be acknowledged by all other towers, including those queued while no
+// towers were registered.
+func (c *ClientDB) CreateTower(lnAddr *lnwire.NetAddress) (*Tower, error) {
+	var tower *Tower
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		towers := tx.Bucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		towerID := NewTowerIDFromPubKey(lnAddr.IdentityKey)
+
+		var err error
+		tower, err = getTower(towers, towerID[:])
+		switch {
+		case err == ErrTowerNotFound:
+			lastBackupID, err := firstPendingBackup(tx)
+			if err != nil {
+				return err
+			}
+
+			tower = &Tower{
+				IdentityKey:  lnAddr.IdentityKey,
+				LastBackupID: lastBackupID,
+			}
+
+		case err != nil:
+			return err
+		}
+
+		tower.AddAddress(lnAddr.Address)
+
+		return putTower(towers, tower)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tower, nil
+}
+
+// RemoveTower modifies a tower's record within the database. If an address is
+// provided, then _only_ the address record should be removed from the tower's
+// persisted state. Otherwise, the tower, along with its sessions, is removed
+// entirely.
+func (c *ClientDB) RemoveTower(pubKey *btcec.PublicKey, addr net.Addr) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		towers := tx.Bucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		towerID := NewTowerIDFromPubKey(pubKey)
+		tower, err := getTower(towers, towerID[:])
+		if err != nil {
+			return err
+		}
+
+		if addr != nil {
+			tower.RemoveAddress(addr)
+			return putTower(towers, tower)
+		}
+
+		if err := towers.Delete(towerID[:]); err != nil {
+			return err
+		}
+
+		// Collect the sessions negotiated with the tower before
+		// deleting them, as the bucket cannot be modified while it is
+		// being iterated.
+		var sessionIDs [][]byte
+		err = sessions.ForEach(func(k, v []byte) error {
+			var session ClientSession
+			err := session.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if session.TowerID == towerID {
+				sessionID := make([]byte, len(k))
+				copy(sessionID, k)
+				sessionIDs = append(sessionIDs, sessionID)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, sessionID := range sessionIDs {
+			if err := sessions.Delete(sessionID); err != nil {
+				return err
+			}
+		}
+
+		// With the tower removed, any backups it was holding up may no
+		// longer be needed.
+		return gcBackups(tx)
+	})
+}
+
+// LoadTower retrieves a tower by its public key.
+func (c *ClientDB) LoadTower(pubKey *btcec.PublicKey) (*Tower, error) {
+	var tower *Tower
+	err := c.db.View(func(tx *bolt.Tx) error {
+		towers := tx.Bucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		towerID := NewTowerIDFromPubKey(pubKey)
+
+		var err error
+		tower, err = getTower(towers, towerID[:])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tower, nil
+}
+
+// ListTowers retrieves the list of towers registered with the client.
+func (c *ClientDB) ListTowers() ([]*Tower, error) {
+	var towers []*Tower
+	err := c.db.View(func(tx *bolt.Tx) error {
+		towerBkt := tx.Bucket(cTowerBkt)
+		if towerBkt == nil {
+			return ErrUninitializedDB
+		}
+
+		return towerBkt.ForEach(func(_, v []byte) error {
+			var tower Tower
+			if err := tower.Decode(bytes.NewReader(v)); err != nil {
+				return err
+			}
+
+			towers = append(towers, &tower)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return towers, nil
+}
+
+// CreateClientSession records a newly negotiated client session. An error is
+// returned if the session already exists, or its tower is unknown.
+func (c *ClientDB) CreateClientSession(session *ClientSession) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		towers := tx.Bucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		if _, err := getTower(towers, session.TowerID[:]); err != nil {
+			return err
+		}
+
+		_, err := getClientSession(sessions, session.ID[:])
+		switch {
+		case err == ErrClientSessionNotFound:
+			// proceed.
+
+		case err != nil:
+			return err
+
+		default:
+			return ErrClientSessionAlreadyExists
+		}
+
+		return putClientSession(sessions, session)
+	})
+}
+
+// ListClientSessions returns the set of all client sessions known to the db.
+// If a tower id is provided, only sessions negotiated with that tower are
+// returned.
+func (c *ClientDB) ListClientSessions(
+	towerID *TowerID) (map[SessionID]*ClientSession, error) {
+
+	clientSessions := make(map[SessionID]*ClientSession)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		return sessions.ForEach(func(_, v []byte) error {
+			var session ClientSession
+			err := session.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if towerID != nil && session.TowerID != *towerID {
+				return nil
+			}
+
+			clientSessions[session.ID] = &session
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clientSessions, nil
+}
+
+// MarkSessionInactive flags the client session such that it is no longer
+// used for backups. Any update committed to the session is released, allowing
+// it to be sent under another session.
+func (c *ClientDB) MarkSessionInactive(id *SessionID) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		session, err := getClientSession(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		session.Status = CSessionInactive
+		session.CommittedBackupID = 0
+
+		return putClientSession(sessions, session)
+	})
+}
+
+// QueueBackup persists the backup task, assigning it the next backup id. The
+// task will remain in the database until it has been acknowledged by all
+// registered towers. If no towers are registered, the task is retained until
+// one is added.
+func (c *ClientDB) QueueBackup(task *BackupTask) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		backups := tx.Bucket(cBackupBkt)
+		if backups == nil {
+			return ErrUninitializedDB
+		}
+
+		backupID, err := getBackupSeq(tx)
+		if err != nil {
+			return err
+		}
+		backupID++
+
+		if err := putBackupSeq(tx, backupID); err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := task.Encode(&b); err != nil {
+			return err
+		}
+
+		backupKey := makeBackupKey(backupID)
+		if err := backups.Put(backupKey[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		task.ID = backupID
+
+		return nil
+	})
+}
+
+// FetchBackups returns up to limit backup tasks with an id greater than
+// afterID, in the order they were queued.
+func (c *ClientDB) FetchBackups(afterID uint64,
+	limit int) ([]*BackupTask, error) {
+
+	var tasks []*BackupTask
+	err := c.db.View(func(tx *bolt.Tx) error {
+		backups := tx.Bucket(cBackupBkt)
+		if backups == nil {
+			return ErrUninitializedDB
+		}
+
+		startKey := makeBackupKey(afterID + 1)
+		cursor := backups.Cursor()
+		for k, v := cursor.Seek(startKey[:]); k != nil &&
+			len(tasks) < limit; k, v = cursor.Next() {
+
+			task, err := decodeBackupTask(k, v)
+			if err != nil {
+				return err
+			}
+
+			tasks = append(tasks, task)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// FetchBackup retrieves the backup task with the given id.
+func (c *ClientDB) FetchBackup(backupID uint64) (*BackupTask, error) {
+	var task *BackupTask
+	err := c.db.View(func(tx *bolt.Tx) error {
+		backups := tx.Bucket(cBackupBkt)
+		if backups == nil {
+			return ErrUninitializedDB
+		}
+
+		backupKey := makeBackupKey(backupID)
+		taskBytes := backups.Get(backupKey[:])
+		if taskBytes == nil {
+			return ErrBackupNotFound
+		}
+
+		var err error
+		task, err = decodeBackupTask(backupKey[:], taskBytes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// CommitUpdate assigns the backup to the next sequence number of the session,
+// returning the sequence number. The commitment is persisted so that, after a
+// restart, the client will resend the same backup under the same sequence
+// number. Committing the same backup twice returns the previously assigned
+// sequence number.
+func (c *ClientDB) CommitUpdate(id *SessionID,
+	backupID uint64) (uint16, error) {
+
+	var seqNum uint16
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		session, err := getClientSession(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case session.Status != CSessionActive:
+			return ErrClientSessionExhausted
+
+		case session.CommittedBackupID == backupID:
+			seqNum = session.SeqNum
+			return nil
+
+		case session.HasCommittedUpdate():
+			return ErrUpdateAlreadyCommitted
+
+		case session.SeqNum >= session.Policy.MaxUpdates:
+			return ErrClientSessionExhausted
+		}
+
+		session.SeqNum++
+		session.CommittedBackupID = backupID
+		seqNum = session.SeqNum
+
+		return putClientSession(sessions, session)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return seqNum, nil
+}
+
+// AckUpdate records that the tower has accepted the session's committed update
+// with the given sequence number. The tower's backup cursor is advanced past
+// the acknowledged backup, and any backups that have been acknowledged by all
+// towers are removed.
+func (c *ClientDB) AckUpdate(id *SessionID, seqNum,
+	lastApplied uint16) error {
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		towers := tx.Bucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.Bucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		session, err := getClientSession(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		if !session.HasCommittedUpdate() || session.SeqNum != seqNum {
+			return ErrCommittedUpdateNotFound
+		}
+
+		tower, err := getTower(towers, session.TowerID[:])
+		if err != nil {
+			return err
+		}
+
+		if session.CommittedBackupID > tower.LastBackupID {
+			tower.LastBackupID = session.CommittedBackupID
+		}
+		if err := putTower(towers, tower); err != nil {
+			return err
+		}
+
+		session.TowerLastApplied = lastApplied
+		session.CommittedBackupID = 0
+		if err := putClientSession(sessions, session); err != nil {
+			return err
+		}
+
+		return gcBackups(tx)
+	})
+}
+
+// RegisterChannel records the pkscript that justice transactions for the given
+// channel should sweep to.
+func (c *ClientDB) RegisterChannel(chanID lnwire.ChannelID,
+	sweepPkScript []byte) error {
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		chanSweeps := tx.Bucket(cChanSweepBkt)
+		if chanSweeps == nil {
+			return ErrUninitializedDB
+		}
+
+		return chanSweeps.Put(chanID[:], sweepPkScript)
+	})
+}
+
+// FetchChanSweepPkScript returns the sweep pkscript registered for the given
+// channel, or ErrChannelNotRegistered if none exists.
+func (c *ClientDB) FetchChanSweepPkScript(
+	chanID lnwire.ChannelID) ([]byte, error) {
+
+	var sweepPkScript []byte
+	err := c.db.View(func(tx *bolt.Tx) error {
+		chanSweeps := tx.Bucket(cChanSweepBkt)
+		if chanSweeps == nil {
+			return ErrUninitializedDB
+		}
+
+		pkScript := chanSweeps.Get(chanID[:])
+		if pkScript == nil {
+			return ErrChannelNotRegistered
+		}
+
+		sweepPkScript = make([]byte, len(pkScript))
+		copy(sweepPkScript, pkScript)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepPkScript, nil
+}
+
+// gcBackups removes all backups that have been acknowledged by every
+// registered tower. If no towers remain, all backups are retained so that
+// they can be delivered to the next tower added.
+func gcBackups(tx *bolt.Tx) error {
+	towers := tx.Bucket(cTowerBkt)
+	if towers == nil {
+		return ErrUninitializedDB
+	}
+
+	backups := tx.Bucket(cBackupBkt)
+	if backups == nil {
+		return ErrUninitializedDB
+	}
+
+	if k, _ := towers.Cursor().First(); k == nil {
+		return nil
+	}
+
+	// Determine the lowest backup id acknowledged across all towers.
+	minAcked, err := getBackupSeq(tx)
+	if err != nil {
+		return err
+	}
+	err = towers.ForEach(func(_, v []byte) error {
+		var tower Tower
+		if err := tower.Decode(bytes.NewReader(v)); err != nil {
+			return err
+		}
+
+		if tower.LastBackupID < minAcked {
+			minAcked = tower.LastBackupID
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var staleKeys [][]byte
+	cursor := backups.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if byteOrder.Uint64(k) > minAcked {
+			break
+		}
+
+		staleKey := make([]byte, len(k))
+		copy(staleKey, k)
+		staleKeys = append(staleKeys, staleKey)
+	}
+
+	for _, k := range staleKeys {
+		if err := backups.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// firstPendingBackup returns the id preceding the oldest backup that has yet
+// to be removed, or the last assigned backup id if none remain. A new tower
+// starting from this id will be delivered every pending backup.
+func firstPendingBackup(tx *bolt.Tx) (uint64, error) {
+	backups := tx.Bucket(cBackupBkt)
+	if backups == nil {
+		return 0, ErrUninitializedDB
+	}
+
+	if k, _ := backups.Cursor().First(); k != nil {
+		return byteOrder.Uint64(k) - 1, nil
+	}
+
+	return getBackupSeq(tx)
+}
+
+// getBackupSeq returns the last backup id assigned by the client, or zero if
+// no backups have been queued.
+func getBackupSeq(tx *bolt.Tx) (uint64, error) {
+	metadata := tx.Bucket(cMetadataBkt)
+	if metadata == nil {
+		return 0, ErrUninitializedDB
+	}
+
+	seqBytes := metadata.Get(cBackupSeqKey)
+	if len(seqBytes) != 8 {
+		return 0, nil
+	}
+
+	return byteOrder.Uint64(seqBytes), nil
+}
+
+// putBackupSeq stores the last backup id assigned by the client.
+func putBackupSeq(tx *bolt.Tx, backupID uint64) error {
+	metadata := tx.Bucket(cMetadataBkt)
+	if metadata == nil {
+		return ErrUninitializedDB
+	}
+
+	var seqBytes [8]byte
+	byteOrder.PutUint64(seqBytes[:], backupID)
+
+	return metadata.Put(cBackupSeqKey, seqBytes[:])
+}
+
+// getTower retrieves the tower identified by its tower id from the towers
+// bucket.
+func getTower(towers *bolt.Bucket, id []byte) (*Tower, error) {
+	towerBytes := towers.Get(id)
+	if towerBytes == nil {
+		return nil, ErrTowerNotFound
+	}
+
+	var tower Tower
+	if err := tower.Decode(bytes.NewReader(towerBytes)); err != nil {
+		return nil, err
+	}
+
+	return &tower, nil
+}
+
+// putTower stores the tower in the towers bucket under its tower id.
+func putTower(towers *bolt.Bucket, tower *Tower) error {
+	var b bytes.Buffer
+	if err := tower.Encode(&b); err != nil {
+		return err
+	}
+
+	towerID := tower.ID()
+	return towers.Put(towerID[:], b.Bytes())
+}
+
+// getClientSession retrieves the client session identified by its session id
+// from the sessions bucket.
+func getClientSession(sessions *bolt.Bucket,
+	id []byte) (*ClientSession, error) {
+
+	sessionBytes := sessions.Get(id)
+	if sessionBytes == nil {
+		return nil, ErrClientSessionNotFound
+	}
+
+	var session ClientSession
+	err := session.Decode(bytes.NewReader(sessionBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// putClientSession stores the client session in the sessions bucket under its
+// session id.
+func putClientSession(sessions *bolt.Bucket, session *ClientSession) error {
+	var b bytes.Buffer
+	if err := session.Encode(&b); err != nil {
+		return err
+	}
+
+	return sessions.Put(session.ID[:], b.Bytes())
+}
+
+// makeBackupKey serializes a backup id as a big-endian key, such that backups
+// are iterated in the order they were queued.
+func makeBackupKey(backupID uint64) [8]byte {
+	var key [8]byte
+	byteOrder.PutUint64(key[:], backupID)
+	return key
+}
+
+// decodeBackupTask deserializes a backup task stored under the given key.
+func decodeBackupTask(k, v []byte) (*BackupTask, error) {
+	var task BackupTask
+	if err := task.Decode(bytes.NewReader(v)); err != nil {
+		return nil, err
+	}
+	task.ID = byteOrder.Uint64(k)
+
+	return &task, nil
+}
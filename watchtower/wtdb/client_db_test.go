@@ -0,0 +1,455 @@
+package wtdb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+// makeTestClientDB creates a fresh client database in a temporary directory,
+// and returns a closure which tears down the database and its directory.
+func makeTestClientDB(t *testing.T) (*wtdb.ClientDB, func()) {
+	tempDirName, err := ioutil.TempDir("", "clientdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	db, err := wtdb.OpenClientDB(tempDirName)
+	if err != nil {
+		os.RemoveAll(tempDirName)
+		t.Fatalf("unable to open client db: %v", err)
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	return db, cleanUp
+}
+
+func makeTowerAddr(t *testing.T, port int) *lnwire.NetAddress {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate private key: %v", err)
+	}
+
+	return &lnwire.NetAddress{
+		IdentityKey: priv.PubKey(),
+		Address: &net.TCPAddr{
+			IP:   net.IPv4(127, 0, 0, 1),
+			Port: port,
+		},
+	}
+}
+
+var testPolicy = wtdb.SessionPolicy{
+	BlobVersion:  0,
+	MaxUpdates:   2,
+	SweepFeeRate: 1000,
+}
+
+func makeBackupTask(i byte) *wtdb.BackupTask {
+	return &wtdb.BackupTask{
+		ChanID:        lnwire.ChannelID{i},
+		CommitHeight:  uint64(i),
+		Policy:        testPolicy,
+		Hint:          makeHint(i),
+		EncryptedBlob: bytes.Repeat([]byte{i}, 10),
+	}
+}
+
+// TestClientDBTowers asserts that towers can be created, have their addresses
+// modified, and be removed along with their sessions.
+func TestClientDBTowers(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestClientDB(t)
+	defer cleanUp()
+
+	addr1 := makeTowerAddr(t, 9911)
+	if _, err := db.LoadTower(addr1.IdentityKey); err != wtdb.ErrTowerNotFound {
+		t.Fatalf("expected ErrTowerNotFound, got: %v", err)
+	}
+
+	if _, err := db.CreateTower(addr1); err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+
+	// Adding the same tower with a new address should merge the address
+	// into the existing record.
+	addr2 := &lnwire.NetAddress{
+		IdentityKey: addr1.IdentityKey,
+		Address: &net.TCPAddr{
+			IP:   net.IPv4(127, 0, 0, 2),
+			Port: 9911,
+		},
+	}
+	tower, err := db.CreateTower(addr2)
+	if err != nil {
+		t.Fatalf("unable to add tower address: %v", err)
+	}
+	if len(tower.Addresses) != 2 {
+		t.Fatalf("expected 2 addresses, got: %v", tower.Addresses)
+	}
+
+	// Removing an address should leave the tower in place.
+	err = db.RemoveTower(addr1.IdentityKey, addr2.Address)
+	if err != nil {
+		t.Fatalf("unable to remove tower address: %v", err)
+	}
+	tower, err = db.LoadTower(addr1.IdentityKey)
+	if err != nil {
+		t.Fatalf("unable to load tower: %v", err)
+	}
+	if len(tower.Addresses) != 1 ||
+		tower.Addresses[0].String() != addr1.Address.String() {
+
+		t.Fatalf("unexpected tower addresses: %v", tower.Addresses)
+	}
+
+	if _, err := db.CreateTower(makeTowerAddr(t, 9912)); err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+
+	towers, err := db.ListTowers()
+	if err != nil {
+		t.Fatalf("unable to list towers: %v", err)
+	}
+	if len(towers) != 2 {
+		t.Fatalf("expected 2 towers, got: %d", len(towers))
+	}
+
+	// Create a session with the first tower, which should be removed
+	// along with the tower itself.
+	session := &wtdb.ClientSession{
+		ID:      makeSessionID(1),
+		TowerID: tower.ID(),
+		Policy:  testPolicy,
+	}
+	if err := db.CreateClientSession(session); err != nil {
+		t.Fatalf("unable to create session: %v", err)
+	}
+
+	if err := db.RemoveTower(addr1.IdentityKey, nil); err != nil {
+		t.Fatalf("unable to remove tower: %v", err)
+	}
+	if _, err := db.LoadTower(addr1.IdentityKey); err != wtdb.ErrTowerNotFound {
+		t.Fatalf("expected ErrTowerNotFound, got: %v", err)
+	}
+
+	sessions, err := db.ListClientSessions(nil)
+	if err != nil {
+		t.Fatalf("unable to list sessions: %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("expected sessions to be removed, found %d",
+			len(sessions))
+	}
+}
+
+// TestClientDBSessions asserts that sessions can only be created for known
+// towers, and that they are properly filtered by tower.
+func TestClientDBSessions(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestClientDB(t)
+	defer cleanUp()
+
+	addr := makeTowerAddr(t, 9911)
+	session := &wtdb.ClientSession{
+		ID:       makeSessionID(1),
+		TowerID:  wtdb.NewTowerIDFromPubKey(addr.IdentityKey),
+		KeyIndex: 7,
+		Policy:   testPolicy,
+	}
+
+	err := db.CreateClientSession(session)
+	if err != wtdb.ErrTowerNotFound {
+		t.Fatalf("expected ErrTowerNotFound, got: %v", err)
+	}
+
+	if _, err := db.CreateTower(addr); err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+	if err := db.CreateClientSession(session); err != nil {
+		t.Fatalf("unable to create session: %v", err)
+	}
+
+	err = db.CreateClientSession(session)
+	if err != wtdb.ErrClientSessionAlreadyExists {
+		t.Fatalf("expected ErrClientSessionAlreadyExists, got: %v", err)
+	}
+
+	sessions, err := db.ListClientSessions(&session.TowerID)
+	if err != nil {
+		t.Fatalf("unable to list sessions: %v", err)
+	}
+	dbSession, ok := sessions[session.ID]
+	if !ok || len(sessions) != 1 {
+		t.Fatalf("expected only session %s, got: %v", session.ID,
+			sessions)
+	}
+	if *dbSession != *session {
+		t.Fatalf("session mismatch, want: %v, got: %v", session,
+			dbSession)
+	}
+
+	otherTower := wtdb.TowerID{0x01}
+	sessions, err = db.ListClientSessions(&otherTower)
+	if err != nil {
+		t.Fatalf("unable to list sessions: %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("expected no sessions, got: %d", len(sessions))
+	}
+}
+
+// TestClientDBBackups asserts that queued backups are committed to sessions,
+// and are only removed once every registered tower has acknowledged them.
+func TestClientDBBackups(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestClientDB(t)
+	defer cleanUp()
+
+	addr1 := makeTowerAddr(t, 9911)
+	addr2 := makeTowerAddr(t, 9912)
+	tower1, err := db.CreateTower(addr1)
+	if err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+	tower2, err := db.CreateTower(addr2)
+	if err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+
+	tasks := []*wtdb.BackupTask{
+		makeBackupTask(1), makeBackupTask(2), makeBackupTask(3),
+	}
+	for i, task := range tasks {
+		if err := db.QueueBackup(task); err != nil {
+			t.Fatalf("unable to queue backup: %v", err)
+		}
+		if task.ID != uint64(i+1) {
+			t.Fatalf("expected backup id %d, got: %d", i+1,
+				task.ID)
+		}
+	}
+
+	fetched, err := db.FetchBackups(1, 10)
+	if err != nil {
+		t.Fatalf("unable to fetch backups: %v", err)
+	}
+	if len(fetched) != 2 {
+		t.Fatalf("expected 2 backups, got: %d", len(fetched))
+	}
+	for i, task := range fetched {
+		expTask := tasks[i+1]
+		if task.ID != expTask.ID || task.ChanID != expTask.ChanID ||
+			task.Hint != expTask.Hint ||
+			!bytes.Equal(task.EncryptedBlob, expTask.EncryptedBlob) {
+
+			t.Fatalf("backup mismatch, want: %v, got: %v", expTask,
+				task)
+		}
+	}
+
+	session1 := &wtdb.ClientSession{
+		ID:      makeSessionID(1),
+		TowerID: tower1.ID(),
+		Policy:  testPolicy,
+	}
+	session2 := &wtdb.ClientSession{
+		ID:      makeSessionID(2),
+		TowerID: tower2.ID(),
+		Policy:  testPolicy,
+	}
+	for _, session := range []*wtdb.ClientSession{session1, session2} {
+		if err := db.CreateClientSession(session); err != nil {
+			t.Fatalf("unable to create session: %v", err)
+		}
+	}
+
+	// Commit the first backup to the first session. Recommitting the same
+	// backup should return the same sequence number, while committing a
+	// different backup should fail until the first is acknowledged.
+	seqNum, err := db.CommitUpdate(&session1.ID, 1)
+	if err != nil {
+		t.Fatalf("unable to commit update: %v", err)
+	}
+	if seqNum != 1 {
+		t.Fatalf("expected seqnum 1, got: %d", seqNum)
+	}
+	seqNum, err = db.CommitUpdate(&session1.ID, 1)
+	if err != nil || seqNum != 1 {
+		t.Fatalf("expected seqnum 1 on recommit, got: %d, %v",
+			seqNum, err)
+	}
+	if _, err := db.CommitUpdate(&session1.ID, 2); err != wtdb.ErrUpdateAlreadyCommitted {
+		t.Fatalf("expected ErrUpdateAlreadyCommitted, got: %v", err)
+	}
+
+	if err := db.AckUpdate(&session1.ID, 2, 2); err != wtdb.ErrCommittedUpdateNotFound {
+		t.Fatalf("expected ErrCommittedUpdateNotFound, got: %v", err)
+	}
+	if err := db.AckUpdate(&session1.ID, 1, 1); err != nil {
+		t.Fatalf("unable to ack update: %v", err)
+	}
+
+	// Since the second tower has yet to acknowledge the first backup, it
+	// should still be present.
+	if _, err := db.FetchBackup(1); err != nil {
+		t.Fatalf("unable to fetch backup: %v", err)
+	}
+
+	tower1, err = db.LoadTower(addr1.IdentityKey)
+	if err != nil {
+		t.Fatalf("unable to load tower: %v", err)
+	}
+	if tower1.LastBackupID != 1 {
+		t.Fatalf("expected last backup id 1, got: %d",
+			tower1.LastBackupID)
+	}
+
+	// Deliver the second backup to the first tower, exhausting the
+	// session.
+	if _, err := db.CommitUpdate(&session1.ID, 2); err != nil {
+		t.Fatalf("unable to commit update: %v", err)
+	}
+	if err := db.AckUpdate(&session1.ID, 2, 2); err != nil {
+		t.Fatalf("unable to ack update: %v", err)
+	}
+	if _, err := db.CommitUpdate(&session1.ID, 3); err != wtdb.ErrClientSessionExhausted {
+		t.Fatalf("expected ErrClientSessionExhausted, got: %v", err)
+	}
+
+	// Once the second tower acknowledges the first backup, it should be
+	// garbage collected.
+	if _, err := db.CommitUpdate(&session2.ID, 1); err != nil {
+		t.Fatalf("unable to commit update: %v", err)
+	}
+	if err := db.AckUpdate(&session2.ID, 1, 1); err != nil {
+		t.Fatalf("unable to ack update: %v", err)
+	}
+	if _, err := db.FetchBackup(1); err != wtdb.ErrBackupNotFound {
+		t.Fatalf("expected ErrBackupNotFound, got: %v", err)
+	}
+	if _, err := db.FetchBackup(2); err != nil {
+		t.Fatalf("unable to fetch backup: %v", err)
+	}
+
+	// Marking a session inactive should release its committed update.
+	if _, err := db.CommitUpdate(&session2.ID, 2); err != nil {
+		t.Fatalf("unable to commit update: %v", err)
+	}
+	if err := db.MarkSessionInactive(&session2.ID); err != nil {
+		t.Fatalf("unable to mark session inactive: %v", err)
+	}
+	sessions, err := db.ListClientSessions(nil)
+	if err != nil {
+		t.Fatalf("unable to list sessions: %v", err)
+	}
+	dbSession := sessions[session2.ID]
+	if dbSession.HasCommittedUpdate() || !dbSession.IsExhausted() {
+		t.Fatalf("expected inactive session without committed "+
+			"update, got: %v", dbSession)
+	}
+
+	// Removing the second tower should leave the first tower as the only
+	// one holding up the remaining backups, which it has acknowledged up
+	// to the second.
+	if err := db.RemoveTower(addr2.IdentityKey, nil); err != nil {
+		t.Fatalf("unable to remove tower: %v", err)
+	}
+	if _, err := db.FetchBackup(2); err != wtdb.ErrBackupNotFound {
+		t.Fatalf("expected ErrBackupNotFound, got: %v", err)
+	}
+	if _, err := db.FetchBackup(3); err != nil {
+		t.Fatalf("unable to fetch backup: %v", err)
+	}
+
+	// A newly created tower should receive all backups that are still
+	// pending, starting from the third.
+	tower3, err := db.CreateTower(makeTowerAddr(t, 9913))
+	if err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+	if tower3.LastBackupID != 2 {
+		t.Fatalf("expected last backup id 2, got: %d",
+			tower3.LastBackupID)
+	}
+}
+
+// TestClientDBBackupsNoTowers asserts that backups queued while no towers are
+// registered are retained, and are delivered to the next tower added.
+func TestClientDBBackupsNoTowers(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestClientDB(t)
+	defer cleanUp()
+
+	task := makeBackupTask(1)
+	if err := db.QueueBackup(task); err != nil {
+		t.Fatalf("unable to queue backup: %v", err)
+	}
+	if task.ID != 1 {
+		t.Fatalf("expected backup id 1, got: %d", task.ID)
+	}
+
+	// The tower should start from before the pending backup, such that it
+	// is the first one delivered.
+	addr := makeTowerAddr(t, 9911)
+	tower, err := db.CreateTower(addr)
+	if err != nil {
+		t.Fatalf("unable to create tower: %v", err)
+	}
+	if tower.LastBackupID != 0 {
+		t.Fatalf("expected last backup id 0, got: %d",
+			tower.LastBackupID)
+	}
+
+	// Removing the only tower before it acknowledged the backup should
+	// not remove the backup, as a future tower still needs it.
+	if err := db.RemoveTower(addr.IdentityKey, nil); err != nil {
+		t.Fatalf("unable to remove tower: %v", err)
+	}
+	if _, err := db.FetchBackup(1); err != nil {
+		t.Fatalf("unable to fetch backup: %v", err)
+	}
+}
+
+// TestClientDBChannelSweeps asserts that sweep pkscripts can be registered
+// and retrieved for channels.
+func TestClientDBChannelSweeps(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestClientDB(t)
+	defer cleanUp()
+
+	chanID := lnwire.ChannelID{0x01}
+	_, err := db.FetchChanSweepPkScript(chanID)
+	if err != wtdb.ErrChannelNotRegistered {
+		t.Fatalf("expected ErrChannelNotRegistered, got: %v", err)
+	}
+
+	pkScript := []byte{0x00, 0x14, 0x01, 0x02}
+	if err := db.RegisterChannel(chanID, pkScript); err != nil {
+		t.Fatalf("unable to register channel: %v", err)
+	}
+
+	dbPkScript, err := db.FetchChanSweepPkScript(chanID)
+	if err != nil {
+		t.Fatalf("unable to fetch sweep pkscript: %v", err)
+	}
+	if !bytes.Equal(dbPkScript, pkScript) {
+		t.Fatalf("sweep pkscript mismatch, want: %x, got: %x",
+			pkScript, dbPkScript)
+	}
+}
@@ -0,0 +1,225 @@
+package wtdb
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// SessionPolicy describes the parameters that a client requests when
+// negotiating a session with a tower, and which govern the construction of
+// the justice transactions backed up under the session.
+type SessionPolicy struct {
+	// BlobVersion is the version of the justice kit encoding used for all
+	// state updates sent under the session.
+	BlobVersion uint16
+
+	// MaxUpdates is the maximum number of updates the client may send
+	// under the session.
+	MaxUpdates uint16
+
+	// SweepFeeRate is the fee rate at which justice transactions created
+	// from backups under this session will pay.
+	SweepFeeRate lnwallet.SatPerKWeight
+}
+
+// String returns a human readable description of the session policy.
+func (p SessionPolicy) String() string {
+	return fmt.Sprintf("(blob-version=%d max-updates=%d sweep-fee-rate=%d)",
+		p.BlobVersion, p.MaxUpdates, p.SweepFeeRate)
+}
+
+// CSessionStatus enumerates the possible statuses of ClientSessions.
+type CSessionStatus uint8
+
+const (
+	// CSessionActive indicates that the ClientSession is active and can be
+	// used for backups.
+	CSessionActive CSessionStatus = 0
+
+	// CSessionInactive indicates that the ClientSession was rejected by
+	// the tower, and should no longer be used for backups.
+	CSessionInactive CSessionStatus = 1
+)
+
+// ClientSession encapsulates a session negotiated with a tower, and tracks the
+// progress of the updates the client has sent under it.
+type ClientSession struct {
+	// ID is the client's public key used when connecting to the tower,
+	// which the tower uses to identify the session.
+	ID SessionID
+
+	// TowerID is the identifier of the tower with which the session was
+	// negotiated.
+	TowerID TowerID
+
+	// KeyIndex is the index of the key within the tower session key family
+	// from which the session's private key is derived.
+	KeyIndex uint32
+
+	// Policy holds the parameters negotiated with the tower.
+	Policy SessionPolicy
+
+	// Status indicates the current status of the client session.
+	Status CSessionStatus
+
+	// SeqNum is the sequence number of the last update committed by the
+	// client under this session.
+	SeqNum uint16
+
+	// TowerLastApplied is the last sequence number acknowledged by the
+	// tower.
+	TowerLastApplied uint16
+
+	// CommittedBackupID is the id of the backup task assigned to SeqNum
+	// that has yet to be acknowledged by the tower. A value of zero
+	// indicates that there is no unacknowledged update.
+	CommittedBackupID uint64
+}
+
+// HasCommittedUpdate returns true if the session has an update that has been
+// committed but not yet acknowledged by the tower.
+func (s *ClientSession) HasCommittedUpdate() bool {
+	return s.CommittedBackupID != 0
+}
+
+// IsExhausted returns true if the client cannot send any more updates under
+// the session.
+func (s *ClientSession) IsExhausted() bool {
+	return s.Status != CSessionActive ||
+		(s.SeqNum >= s.Policy.MaxUpdates && !s.HasCommittedUpdate())
+}
+
+// Encode writes the ClientSession to the passed io.Writer.
+func (s *ClientSession) Encode(w io.Writer) error {
+	if _, err := w.Write(s.ID[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write(s.TowerID[:]); err != nil {
+		return err
+	}
+
+	return channeldb.WriteElements(w,
+		s.KeyIndex,
+		s.Policy.BlobVersion,
+		s.Policy.MaxUpdates,
+		uint64(s.Policy.SweepFeeRate),
+		uint16(s.Status),
+		s.SeqNum,
+		s.TowerLastApplied,
+		s.CommittedBackupID,
+	)
+}
+
+// Decode reads a ClientSession from the passed io.Reader.
+func (s *ClientSession) Decode(r io.Reader) error {
+	if _, err := io.ReadFull(r, s.ID[:]); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, s.TowerID[:]); err != nil {
+		return err
+	}
+
+	var (
+		sweepFeeRate uint64
+		status       uint16
+	)
+	err := channeldb.ReadElements(r,
+		&s.KeyIndex,
+		&s.Policy.BlobVersion,
+		&s.Policy.MaxUpdates,
+		&sweepFeeRate,
+		&status,
+		&s.SeqNum,
+		&s.TowerLastApplied,
+		&s.CommittedBackupID,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.Policy.SweepFeeRate = lnwallet.SatPerKWeight(sweepFeeRate)
+	s.Status = CSessionStatus(status)
+
+	return nil
+}
+
+// BackupTask is a justice kit that has been encrypted by the client, and is
+// queued for delivery to each of the client's towers.
+type BackupTask struct {
+	// ID is the unique, monotonically increasing identifier assigned to
+	// the task when it is queued.
+	ID uint64
+
+	// ChanID is the channel whose revoked state is backed up by the task.
+	ChanID lnwire.ChannelID
+
+	// CommitHeight is the height of the revoked commitment.
+	CommitHeight uint64
+
+	// Policy is the policy under which the justice kit was constructed.
+	// The task can only be sent under a session with a matching blob
+	// version and sweep fee rate.
+	Policy SessionPolicy
+
+	// Hint is the breach hint of the revoked commitment.
+	Hint BreachHint
+
+	// EncryptedBlob is the nonce-prefixed ciphertext of the justice kit.
+	EncryptedBlob []byte
+}
+
+// CompatibleWith returns true if the backup task can be sent under a session
+// negotiated with the given policy.
+func (t *BackupTask) CompatibleWith(policy *SessionPolicy) bool {
+	return t.Policy.BlobVersion == policy.BlobVersion &&
+		t.Policy.SweepFeeRate == policy.SweepFeeRate
+}
+
+// Encode writes the BackupTask to the passed io.Writer. The task's ID is not
+// included, as it is used as the task's key within the database.
+func (t *BackupTask) Encode(w io.Writer) error {
+	return channeldb.WriteElements(w,
+		[32]byte(t.ChanID),
+		t.CommitHeight,
+		t.Policy.BlobVersion,
+		t.Policy.MaxUpdates,
+		uint64(t.Policy.SweepFeeRate),
+		t.Hint[:],
+		t.EncryptedBlob,
+	)
+}
+
+// Decode reads a BackupTask from the passed io.Reader.
+func (t *BackupTask) Decode(r io.Reader) error {
+	var (
+		chanID       [32]byte
+		sweepFeeRate uint64
+		hint         []byte
+	)
+	err := channeldb.ReadElements(r,
+		&chanID,
+		&t.CommitHeight,
+		&t.Policy.BlobVersion,
+		&t.Policy.MaxUpdates,
+		&sweepFeeRate,
+		&hint,
+		&t.EncryptedBlob,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(hint) != BreachHintSize {
+		return fmt.Errorf("invalid breach hint length: %d", len(hint))
+	}
+
+	t.ChanID = lnwire.ChannelID(chanID)
+	t.Policy.SweepFeeRate = lnwallet.SatPerKWeight(sweepFeeRate)
+	copy(t.Hint[:], hint)
+
+	return nil
+}
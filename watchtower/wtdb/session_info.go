@@ -0,0 +1,211 @@
+package wtdb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// ErrSessionNotFound is returned when querying by session id for a
+	// session that does not exist.
+	ErrSessionNotFound = errors.New("session not found in db")
+
+	// ErrSessionAlreadyExists signals that a session creation failed
+	// because a session with the same session id already exists.
+	ErrSessionAlreadyExists = errors.New("session already exists")
+
+	// ErrSessionConsumed is returned if the client tries to send a sequence
+	// number larger than the session's max number of updates.
+	ErrSessionConsumed = errors.New("all session updates have been " +
+		"consumed")
+
+	// ErrUpdateOutOfOrder is returned when the sequence number is not equal
+	// to the server's LastApplied+1.
+	ErrUpdateOutOfOrder = errors.New("update sequence number is not " +
+		"sequential")
+
+	// ErrLastAppliedReversion is returned when the client echos a
+	// last-applied value that is less than it claimed in a prior update.
+	ErrLastAppliedReversion = errors.New("update last applied must be " +
+		"non-decreasing")
+
+	// ErrSeqNumAlreadyApplied is returned when the client sends a sequence
+	// number for which they already received an ack.
+	ErrSeqNumAlreadyApplied = errors.New("update sequence number has " +
+		"already been applied")
+
+	// byteOrder specifies a big-endian encoding of all integer values
+	// stored in the tower's database.
+	byteOrder = binary.BigEndian
+)
+
+// SessionIDSize is 33-bytes; it is a serialized, compressed public key.
+const SessionIDSize = 33
+
+// SessionID is created from the remote public key of a client, and serves as a
+// unique identifier and authentication for sending state updates.
+type SessionID [SessionIDSize]byte
+
+// NewSessionIDFromPubKey creates a new SessionID from a public key.
+func NewSessionIDFromPubKey(pubKey *btcec.PublicKey) SessionID {
+	var sid SessionID
+	copy(sid[:], pubKey.SerializeCompressed())
+	return sid
+}
+
+// String returns a hex encoding of the session id.
+func (s SessionID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SessionInfo holds the negotiated session parameters for single session id,
+// and handles the acceptance and validation of state updates sent by the
+// client.
+type SessionInfo struct {
+	// ID is the remote public key of the watchtower client.
+	ID SessionID
+
+	// Version specifies the plaintext blob encoding of all state updates.
+	Version uint16
+
+	// MaxUpdates is the total number of updates the client can send for
+	// this session.
+	MaxUpdates uint16
+
+	// LastApplied the sequence number of the last successful state update.
+	LastApplied uint16
+
+	// ClientLastApplied the last last-applied the client has echoed back.
+	ClientLastApplied uint16
+
+	// SweepFeeRate is the agreed upon fee rate used to sign any sweep
+	// transactions.
+	SweepFeeRate lnwallet.SatPerKWeight
+}
+
+// AcceptUpdateSequence validates that a state update's sequence number and
+// last applied are valid given our past history with the client. These checks
+// ensure that clients are properly in sync and following the update protocol
+// properly. If validation is successful, the receiver's LastApplied and
+// ClientLastApplied are updated with the latest values presented by the client.
+// Any errors returned from this method are converted into an appropriate
+// wtwire.StateUpdateCode.
+func (s *SessionInfo) AcceptUpdateSequence(seqNum, lastApplied uint16) error {
+	switch {
+
+	// Client already claims to have an ACK for this seqnum.
+	case seqNum <= lastApplied:
+		return ErrSeqNumAlreadyApplied
+
+	// Client echos a last applied that is lower than previously sent.
+	case lastApplied < s.ClientLastApplied:
+		return ErrLastAppliedReversion
+
+	// Client update exceeds capacity of session.
+	case seqNum > s.MaxUpdates:
+		return ErrSessionConsumed
+
+	// Client update does not match our expected next seqnum.
+	case seqNum != s.LastApplied+1:
+		return ErrUpdateOutOfOrder
+	}
+
+	s.LastApplied = seqNum
+	s.ClientLastApplied = lastApplied
+
+	return nil
+}
+
+// Encode serializes the session info to the given io.Writer.
+func (s *SessionInfo) Encode(w io.Writer) error {
+	if _, err := w.Write(s.ID[:]); err != nil {
+		return err
+	}
+
+	var scratch [8]byte
+	byteOrder.PutUint16(scratch[:2], s.Version)
+	byteOrder.PutUint16(scratch[2:4], s.MaxUpdates)
+	byteOrder.PutUint16(scratch[4:6], s.LastApplied)
+	byteOrder.PutUint16(scratch[6:8], s.ClientLastApplied)
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint64(scratch[:], uint64(s.SweepFeeRate))
+	_, err := w.Write(scratch[:])
+	return err
+}
+
+// Decode deserializes the session info from the given io.Reader.
+func (s *SessionInfo) Decode(r io.Reader) error {
+	if _, err := io.ReadFull(r, s.ID[:]); err != nil {
+		return err
+	}
+
+	var scratch [8]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	s.Version = byteOrder.Uint16(scratch[:2])
+	s.MaxUpdates = byteOrder.Uint16(scratch[2:4])
+	s.LastApplied = byteOrder.Uint16(scratch[4:6])
+	s.ClientLastApplied = byteOrder.Uint16(scratch[6:8])
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	s.SweepFeeRate = lnwallet.SatPerKWeight(byteOrder.Uint64(scratch[:]))
+
+	return nil
+}
+
+// SessionStateUpdate holds a state update sent by a client along with its
+// SessionID.
+type SessionStateUpdate struct {
+	// ID the session id of the client who sent the state update.
+	ID SessionID
+
+	// SeqNum the sequence number of the update within the session.
+	SeqNum uint16
+
+	// LastApplied the highest index that client has acknowledged is
+	// committed
+	LastApplied uint16
+
+	// Hint is the 16-byte prefix of the revoked commitment transaction.
+	Hint BreachHint
+
+	// EncryptedBlob is a ciphertext containing the sweep information for
+	// exacting justice if the commitment transaction matching the breach
+	// hint is broadcast. The blob is prefixed by the nonce used during
+	// encryption.
+	EncryptedBlob []byte
+}
+
+// Match is returned in response to a database query for a set of breach hints.
+// It contains the full encrypted blob, along with the session info it was sent
+// under.
+type Match struct {
+	// ID is the session id of the client who uploaded the state update.
+	ID SessionID
+
+	// SeqNum is the session sequence number occupied by the state update.
+	SeqNum uint16
+
+	// Hint is the breach hint that triggered the match.
+	Hint BreachHint
+
+	// EncryptedBlob is the encrypted payload containing the justice kit
+	// uploaded by the client.
+	EncryptedBlob []byte
+
+	// SessionInfo is the contract negotiated between tower and client,
+	// that provides input parameters such as the fee rate and blob version
+	// that should be used to construct the justice transaction.
+	SessionInfo *SessionInfo
+}
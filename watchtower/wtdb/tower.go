@@ -0,0 +1,110 @@
+package wtdb
+
+import (
+	"io"
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TowerIDSize is 33-bytes; it is a serialized, compressed public key.
+const TowerIDSize = 33
+
+// TowerID uniquely identifies a tower registered with the client, and is the
+// compressed encoding of the tower's identity public key.
+type TowerID [TowerIDSize]byte
+
+// NewTowerIDFromPubKey creates a TowerID from the tower's identity key.
+func NewTowerIDFromPubKey(pubKey *btcec.PublicKey) TowerID {
+	var id TowerID
+	copy(id[:], pubKey.SerializeCompressed())
+	return id
+}
+
+// Tower holds the necessary components required to connect to a remote tower.
+// Communication is handled by brontide, and requires both a public key and an
+// address.
+type Tower struct {
+	// IdentityKey is the public key of the remote node, used to
+	// authenticate the brontide transport.
+	IdentityKey *btcec.PublicKey
+
+	// Addresses is a list of possible addresses to reach the tower.
+	Addresses []net.Addr
+
+	// LastBackupID is the id of the last backup task acknowledged by the
+	// tower. Any backup tasks queued with a larger id have yet to be
+	// delivered to the tower.
+	LastBackupID uint64
+}
+
+// ID returns the unique identifier of the tower.
+func (t *Tower) ID() TowerID {
+	return NewTowerIDFromPubKey(t.IdentityKey)
+}
+
+// AddAddress adds the given address to the tower's in-memory list of addresses.
+// If the address's string is already present, the Tower will be left
+// unmodified.
+//
+// NOTE: This method is NOT safe for concurrent use.
+func (t *Tower) AddAddress(addr net.Addr) {
+	addrStr := addr.String()
+	for _, address := range t.Addresses {
+		if address.String() == addrStr {
+			return
+		}
+	}
+
+	t.Addresses = append(t.Addresses, addr)
+}
+
+// RemoveAddress removes the given address from the tower's in-memory list of
+// addresses. If the address doesn't exist, then this will act as a NOP.
+//
+// NOTE: This method is NOT safe for concurrent use.
+func (t *Tower) RemoveAddress(addr net.Addr) {
+	addrStr := addr.String()
+	for i, address := range t.Addresses {
+		if address.String() != addrStr {
+			continue
+		}
+
+		t.Addresses = append(t.Addresses[:i], t.Addresses[i+1:]...)
+		return
+	}
+}
+
+// LNAddrs generates a list of lnwire.NetAddress from a Tower instance's
+// addresses. This can be used to have a client connect to a tower.
+func (t *Tower) LNAddrs() []*lnwire.NetAddress {
+	addrs := make([]*lnwire.NetAddress, 0, len(t.Addresses))
+	for _, addr := range t.Addresses {
+		addrs = append(addrs, &lnwire.NetAddress{
+			IdentityKey: t.IdentityKey,
+			Address:     addr,
+		})
+	}
+
+	return addrs
+}
+
+// Encode writes the Tower to the passed io.Writer.
+func (t *Tower) Encode(w io.Writer) error {
+	return channeldb.WriteElements(w,
+		t.IdentityKey,
+		t.Addresses,
+		t.LastBackupID,
+	)
+}
+
+// Decode reads a Tower from the passed io.Reader.
+func (t *Tower) Decode(r io.Reader) error {
+	return channeldb.ReadElements(r,
+		&t.IdentityKey,
+		&t.Addresses,
+		&t.LastBackupID,
+	)
+}
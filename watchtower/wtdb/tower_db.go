@@ -0,0 +1,391 @@
+package wtdb
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	// towerDBName is the filename of tower database.
+	towerDBName = "watchtower.db"
+
+	// dbFilePermission requests read+write access to the db file.
+	dbFilePermission = 0600
+)
+
+var (
+	// sessionsBkt is a bucket containing all negotiated client sessions.
+	//  session id -> session
+	sessionsBkt = []byte("sessions-bucket")
+
+	// updatesBkt is a bucket containing all state updates sent by clients,
+	// indexed by the breach hint they were uploaded under. Each breach hint
+	// maps to a sub-bucket, where the session id and sequence number of
+	// the update form the key of the encrypted blob.
+	//  breach hint -> session id || seqnum -> encrypted blob
+	updatesBkt = []byte("updates-bucket")
+
+	// lookoutTipBkt is a bucket containing the last block epoch processed
+	// by the lookout subsystem.
+	//  lookoutTipKey -> block hash || block height
+	lookoutTipBkt = []byte("lookout-tip-bucket")
+
+	// lookoutTipKey is the key under which the lookout's tip is stored.
+	lookoutTipKey = []byte("lookout-tip")
+
+	// ErrUninitializedDB signals that top-level buckets for the database
+	// have not been initialized.
+	ErrUninitializedDB = errors.New("tower db not initialized")
+
+	// ErrCorruptUpdateKey signals that an update key stored in the db
+	// could not be parsed.
+	ErrCorruptUpdateKey = errors.New("corrupt update key")
+)
+
+// TowerDB is single database providing a persistent storage engine for the
+// wtserver and lookout subsystems.
+type TowerDB struct {
+	db     *bolt.DB
+	dbPath string
+}
+
+// OpenTowerDB opens the tower database given the path to the database's
+// directory. If no such database exists, this method will initialize a fresh
+// one containing the top-level buckets used by the tower.
+func OpenTowerDB(dbPath string) (*TowerDB, error) {
+	if err := os.MkdirAll(dbPath, 0700); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dbPath, towerDBName)
+	bdb, err := bolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	towerDB := &TowerDB{
+		db:     bdb,
+		dbPath: dbPath,
+	}
+
+	// Ensure all top-level buckets exist before the database is handed
+	// to its callers.
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		buckets := [][]byte{
+			sessionsBkt,
+			updatesBkt,
+			lookoutTipBkt,
+		}
+		for _, bucket := range buckets {
+			_, err := tx.CreateBucketIfNotExists(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		bdb.Close()
+		return nil, err
+	}
+
+	return towerDB, nil
+}
+
+// Close closes the underlying database.
+func (t *TowerDB) Close() error {
+	return t.db.Close()
+}
+
+// GetSessionInfo retrieves the session for the passed session id. An error is
+// returned if the session could not be found.
+func (t *TowerDB) GetSessionInfo(id *SessionID) (*SessionInfo, error) {
+	var session *SessionInfo
+	err := t.db.View(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		var err error
+		session, err = getSession(sessions, id[:])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// InsertSessionInfo records a negotiated session in the tower database. An
+// error is returned if the session already exists.
+func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
+	return t.db.Update(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		_, err := getSession(sessions, session.ID[:])
+		switch {
+		case err == ErrSessionNotFound:
+			// proceed.
+
+		case err != nil:
+			return err
+
+		default:
+			return ErrSessionAlreadyExists
+		}
+
+		return putSession(sessions, session)
+	})
+}
+
+// InsertStateUpdate stores an update sent by the client after validating that
+// the update is well-formed in the context of other updates sent for the same
+// session. This include verifying that the sequence number is incremented
+// properly and the last applied values echoed by the client are sane. The
+// tower's last applied value for the session is returned.
+func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error) {
+	var lastApplied uint16
+	err := t.db.Update(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		updates := tx.Bucket(updatesBkt)
+		if updates == nil {
+			return ErrUninitializedDB
+		}
+
+		// Fetch the session corresponding to the update's session id.
+		// This will be used to validate that the update's sequence
+		// number and last applied values are sensible.
+		session, err := getSession(sessions, update.ID[:])
+		if err != nil {
+			return err
+		}
+
+		// Assert that the update's sequence number and last applied
+		// values are sensible.
+		err = session.AcceptUpdateSequence(
+			update.SeqNum, update.LastApplied,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Store the update's encrypted blob under a sub-bucket of its
+		// breach hint, such that it can be retrieved once a matching
+		// transaction is seen in a block.
+		hints, err := updates.CreateBucketIfNotExists(update.Hint[:])
+		if err != nil {
+			return err
+		}
+
+		updateKey := makeUpdateKey(&update.ID, update.SeqNum)
+		err = hints.Put(updateKey, update.EncryptedBlob)
+		if err != nil {
+			return err
+		}
+
+		// Finally, persist the session's new last applied value.
+		lastApplied = session.LastApplied
+
+		return putSession(sessions, session)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return lastApplied, nil
+}
+
+// QueryMatches searches against all known state updates for any that match the
+// passed breachHints. More than one Match will be returned for a given hint if
+// they exist in the database.
+func (t *TowerDB) QueryMatches(breachHints []BreachHint) ([]Match, error) {
+	var matches []Match
+	err := t.db.View(func(tx *bolt.Tx) error {
+		sessions := tx.Bucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		updates := tx.Bucket(updatesBkt)
+		if updates == nil {
+			return ErrUninitializedDB
+		}
+
+		// Iterate through the target breach hints, appending any
+		// matching updates to the set of matches.
+		for _, hint := range breachHints {
+			hints := updates.Bucket(hint[:])
+			if hints == nil {
+				continue
+			}
+
+			// Cache the sessions retrieved for this hint, as a
+			// client may have uploaded several blobs under the
+			// same breach hint.
+			sessionCache := make(map[SessionID]*SessionInfo)
+
+			err := hints.ForEach(func(k, v []byte) error {
+				id, seqNum, err := parseUpdateKey(k)
+				if err != nil {
+					return err
+				}
+
+				session, ok := sessionCache[id]
+				if !ok {
+					session, err = getSession(
+						sessions, id[:],
+					)
+					if err != nil {
+						return err
+					}
+					sessionCache[id] = session
+				}
+
+				// The value is only valid for the lifetime of
+				// the transaction, so we'll make a copy of the
+				// encrypted blob before returning it.
+				encryptedBlob := make([]byte, len(v))
+				copy(encryptedBlob, v)
+
+				matches = append(matches, Match{
+					ID:            id,
+					SeqNum:        seqNum,
+					Hint:          hint,
+					EncryptedBlob: encryptedBlob,
+					SessionInfo:   session,
+				})
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// SetLookoutTip stores the provided epoch as the latest lookout tip epoch in
+// the tower database.
+func (t *TowerDB) SetLookoutTip(epoch *chainntnfs.BlockEpoch) error {
+	return t.db.Update(func(tx *bolt.Tx) error {
+		lookoutTip := tx.Bucket(lookoutTipBkt)
+		if lookoutTip == nil {
+			return ErrUninitializedDB
+		}
+
+		var b [chainhash.HashSize + 4]byte
+		copy(b[:chainhash.HashSize], epoch.Hash[:])
+		byteOrder.PutUint32(b[chainhash.HashSize:], uint32(epoch.Height))
+
+		return lookoutTip.Put(lookoutTipKey, b[:])
+	})
+}
+
+// GetLookoutTip retrieves the current lookout tip block epoch from the tower
+// database. If no tip has been recorded, nil is returned.
+func (t *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
+	var epoch *chainntnfs.BlockEpoch
+	err := t.db.View(func(tx *bolt.Tx) error {
+		lookoutTip := tx.Bucket(lookoutTipBkt)
+		if lookoutTip == nil {
+			return ErrUninitializedDB
+		}
+
+		tipBytes := lookoutTip.Get(lookoutTipKey)
+		if len(tipBytes) != chainhash.HashSize+4 {
+			return nil
+		}
+
+		var hash chainhash.Hash
+		copy(hash[:], tipBytes[:chainhash.HashSize])
+		height := byteOrder.Uint32(tipBytes[chainhash.HashSize:])
+
+		epoch = &chainntnfs.BlockEpoch{
+			Hash:   &hash,
+			Height: int32(height),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return epoch, nil
+}
+
+// getSession retrieves the session info from the sessions bucket identified by
+// its session id. An error is returned if the session could not be found or a
+// deserialization error occurred.
+func getSession(sessions *bolt.Bucket, id []byte) (*SessionInfo, error) {
+	sessionBytes := sessions.Get(id)
+	if sessionBytes == nil {
+		return nil, ErrSessionNotFound
+	}
+
+	var session SessionInfo
+	err := session.Decode(bytes.NewReader(sessionBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// putSession stores the session info in the sessions bucket identified by its
+// session id. An error is returned if a serialization error occurred.
+func putSession(sessions *bolt.Bucket, session *SessionInfo) error {
+	var b bytes.Buffer
+	err := session.Encode(&b)
+	if err != nil {
+		return err
+	}
+
+	return sessions.Put(session.ID[:], b.Bytes())
+}
+
+// makeUpdateKey constructs the key under which an encrypted blob is stored
+// within a breach hint's sub-bucket: session id || seqnum.
+func makeUpdateKey(id *SessionID, seqNum uint16) []byte {
+	var key [SessionIDSize + 2]byte
+	copy(key[:SessionIDSize], id[:])
+	byteOrder.PutUint16(key[SessionIDSize:], seqNum)
+	return key[:]
+}
+
+// parseUpdateKey recovers the session id and sequence number from an update
+// key.
+func parseUpdateKey(key []byte) (SessionID, uint16, error) {
+	var id SessionID
+	if len(key) != SessionIDSize+2 {
+		return id, 0, ErrCorruptUpdateKey
+	}
+
+	copy(id[:], key[:SessionIDSize])
+	seqNum := byteOrder.Uint16(key[SessionIDSize:])
+
+	return id, seqNum, nil
+}
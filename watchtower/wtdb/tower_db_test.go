@@ -0,0 +1,258 @@
+package wtdb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+)
+
+// makeTestDB creates a fresh tower database in a temporary directory, and
+// returns a closure which tears down the database and its directory.
+func makeTestDB(t *testing.T) (*wtdb.TowerDB, func()) {
+	tempDirName, err := ioutil.TempDir("", "towerdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	db, err := wtdb.OpenTowerDB(tempDirName)
+	if err != nil {
+		os.RemoveAll(tempDirName)
+		t.Fatalf("unable to open tower db: %v", err)
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	return db, cleanUp
+}
+
+func makeSessionID(i byte) wtdb.SessionID {
+	var id wtdb.SessionID
+	id[0] = i
+	return id
+}
+
+func makeHint(i byte) wtdb.BreachHint {
+	var hint wtdb.BreachHint
+	hint[0] = i
+	return hint
+}
+
+// TestTowerDBSessionInfo asserts that sessions can be inserted and retrieved,
+// and that duplicate sessions are rejected.
+func TestTowerDBSessionInfo(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestDB(t)
+	defer cleanUp()
+
+	id := makeSessionID(1)
+	if _, err := db.GetSessionInfo(&id); err != wtdb.ErrSessionNotFound {
+		t.Fatalf("expected ErrSessionNotFound, got: %v", err)
+	}
+
+	info := &wtdb.SessionInfo{
+		ID:           id,
+		Version:      0,
+		MaxUpdates:   100,
+		SweepFeeRate: 1000,
+	}
+	if err := db.InsertSessionInfo(info); err != nil {
+		t.Fatalf("unable to insert session: %v", err)
+	}
+
+	err := db.InsertSessionInfo(info)
+	if err != wtdb.ErrSessionAlreadyExists {
+		t.Fatalf("expected ErrSessionAlreadyExists, got: %v", err)
+	}
+
+	dbInfo, err := db.GetSessionInfo(&id)
+	if err != nil {
+		t.Fatalf("unable to fetch session: %v", err)
+	}
+	if *dbInfo != *info {
+		t.Fatalf("session mismatch, want: %v, got: %v", info, dbInfo)
+	}
+}
+
+// TestTowerDBStateUpdates asserts that state updates are validated against
+// their session's sequence numbers, and that accepted updates are returned when
+// querying for their breach hints.
+func TestTowerDBStateUpdates(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestDB(t)
+	defer cleanUp()
+
+	id := makeSessionID(1)
+
+	// Updates for an unknown session should be rejected.
+	update := &wtdb.SessionStateUpdate{
+		ID:            id,
+		SeqNum:        1,
+		Hint:          makeHint(1),
+		EncryptedBlob: []byte("blob1"),
+	}
+	if _, err := db.InsertStateUpdate(update); err != wtdb.ErrSessionNotFound {
+		t.Fatalf("expected ErrSessionNotFound, got: %v", err)
+	}
+
+	err := db.InsertSessionInfo(&wtdb.SessionInfo{
+		ID:           id,
+		MaxUpdates:   2,
+		SweepFeeRate: 1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to insert session: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		update         *wtdb.SessionStateUpdate
+		expErr         error
+		expLastApplied uint16
+	}{
+		{
+			name: "out of order",
+			update: &wtdb.SessionStateUpdate{
+				ID:     id,
+				SeqNum: 2,
+				Hint:   makeHint(2),
+			},
+			expErr: wtdb.ErrUpdateOutOfOrder,
+		},
+		{
+			name:           "first update",
+			update:         update,
+			expLastApplied: 1,
+		},
+		{
+			name: "already applied",
+			update: &wtdb.SessionStateUpdate{
+				ID:          id,
+				SeqNum:      1,
+				LastApplied: 1,
+				Hint:        makeHint(1),
+			},
+			expErr: wtdb.ErrSeqNumAlreadyApplied,
+		},
+		{
+			name: "second update",
+			update: &wtdb.SessionStateUpdate{
+				ID:            id,
+				SeqNum:        2,
+				LastApplied:   1,
+				Hint:          makeHint(2),
+				EncryptedBlob: []byte("blob2"),
+			},
+			expLastApplied: 2,
+		},
+		{
+			name: "last applied reversion",
+			update: &wtdb.SessionStateUpdate{
+				ID:     id,
+				SeqNum: 3,
+				Hint:   makeHint(3),
+			},
+			expErr: wtdb.ErrLastAppliedReversion,
+		},
+		{
+			name: "session consumed",
+			update: &wtdb.SessionStateUpdate{
+				ID:          id,
+				SeqNum:      3,
+				LastApplied: 2,
+				Hint:        makeHint(3),
+			},
+			expErr: wtdb.ErrSessionConsumed,
+		},
+	}
+
+	for _, test := range tests {
+		lastApplied, err := db.InsertStateUpdate(test.update)
+		if err != test.expErr {
+			t.Fatalf("case %q: expected error %v, got: %v",
+				test.name, test.expErr, err)
+		}
+		if lastApplied != test.expLastApplied {
+			t.Fatalf("case %q: expected last applied %d, got: %d",
+				test.name, test.expLastApplied, lastApplied)
+		}
+	}
+
+	// Only the two accepted updates should be returned when querying for
+	// all of the hints used above.
+	matches, err := db.QueryMatches([]wtdb.BreachHint{
+		makeHint(1), makeHint(2), makeHint(3),
+	})
+	if err != nil {
+		t.Fatalf("unable to query matches: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got: %d", len(matches))
+	}
+
+	for i, match := range matches {
+		seqNum := uint16(i + 1)
+		switch {
+		case match.ID != id:
+			t.Fatalf("match %d has wrong session id: %v", i,
+				match.ID)
+
+		case match.SeqNum != seqNum:
+			t.Fatalf("match %d has wrong seqnum: %d", i,
+				match.SeqNum)
+
+		case match.Hint != makeHint(byte(seqNum)):
+			t.Fatalf("match %d has wrong hint: %v", i, match.Hint)
+
+		case match.SessionInfo.LastApplied != 2:
+			t.Fatalf("match %d has stale session info", i)
+		}
+	}
+
+	if !bytes.Equal(matches[1].EncryptedBlob, []byte("blob2")) {
+		t.Fatalf("wrong blob returned for match: %x",
+			matches[1].EncryptedBlob)
+	}
+}
+
+// TestTowerDBLookoutTip asserts that the lookout's tip is unset for a fresh
+// database, and that it can be stored and retrieved.
+func TestTowerDBLookoutTip(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp := makeTestDB(t)
+	defer cleanUp()
+
+	tip, err := db.GetLookoutTip()
+	if err != nil {
+		t.Fatalf("unable to fetch lookout tip: %v", err)
+	}
+	if tip != nil {
+		t.Fatalf("expected no lookout tip, got: %v", tip)
+	}
+
+	epoch := &chainntnfs.BlockEpoch{
+		Hash:   &chainhash.Hash{0x01, 0x02},
+		Height: 500000,
+	}
+	if err := db.SetLookoutTip(epoch); err != nil {
+		t.Fatalf("unable to set lookout tip: %v", err)
+	}
+
+	tip, err = db.GetLookoutTip()
+	if err != nil {
+		t.Fatalf("unable to fetch lookout tip: %v", err)
+	}
+	if *tip.Hash != *epoch.Hash || tip.Height != epoch.Height {
+		t.Fatalf("lookout tip mismatch, want: %v, got: %v", epoch, tip)
+	}
+}
@@ -0,0 +1,603 @@
+package wtserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
+)
+
+var (
+	// ErrPeerAlreadyConnected signals that a peer with the same session id
+	// is already active within the server.
+	ErrPeerAlreadyConnected = errors.New("peer already connected")
+
+	// ErrServerExiting signals that a request could not be processed
+	// because the server has been requested to shut down.
+	ErrServerExiting = errors.New("server shutting down")
+)
+
+// Config abstracts the primary components and dependencies of the server.
+type Config struct {
+	// DB provides persistent access to the server's sessions and for
+	// storing state updates.
+	DB DB
+
+	// Listeners specifies which address to which clients may connect.
+	Listeners []net.Listener
+
+	// ReadTimeout specifies how long a client may go without sending a
+	// message.
+	ReadTimeout time.Duration
+
+	// WriteTimeout specifies how long a client may go without reading a
+	// message from the other end, if the connection has stopped buffering
+	// the server's replies.
+	WriteTimeout time.Duration
+}
+
+// Server houses the state required to handle watchtower peers. It's primary job
+// is to accept incoming connections, and dispatch processing of the client
+// message streams.
+type Server struct {
+	started  int32 // atomic
+	shutdown int32 // atomic
+
+	cfg *Config
+
+	clientMtx sync.RWMutex
+	clients   map[wtdb.SessionID]Peer
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// A compile-time constraint to ensure Server implements the wtserver.Interface.
+var _ Interface = (*Server)(nil)
+
+// New creates a new server to handle watchtower clients. The server will accept
+// clients connecting to the listener addresses, and allows them to open
+// sessions and send state updates.
+func New(cfg *Config) (*Server, error) {
+	return &Server{
+		cfg:     cfg,
+		clients: make(map[wtdb.SessionID]Peer),
+		quit:    make(chan struct{}),
+	}, nil
+}
+
+// Start begins listening on the server's listeners.
+func (s *Server) Start() error {
+	// Already running?
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting watchtower server")
+
+	for _, listener := range s.cfg.Listeners {
+		s.wg.Add(1)
+		go s.listen(listener)
+	}
+
+	log.Infof("Watchtower server started successfully")
+
+	return nil
+}
+
+// Stop shutdowns down the server's listeners and any active requests.
+func (s *Server) Stop() error {
+	// Bail if we're already shutting down.
+	if !atomic.CompareAndSwapInt32(&s.shutdown, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping watchtower server")
+
+	// Closing the listeners will cause their accept loops to exit, while
+	// closing the active clients' connections will unblock any pending
+	// reads or writes.
+	for _, listener := range s.cfg.Listeners {
+		listener.Close()
+	}
+
+	close(s.quit)
+
+	s.clientMtx.RLock()
+	for _, peer := range s.clients {
+		peer.Close()
+	}
+	s.clientMtx.RUnlock()
+
+	s.wg.Wait()
+
+	log.Infof("Watchtower server stopped successfully")
+
+	return nil
+}
+
+// listen accepts incoming connections on the given listener, and dispatches
+// each one to the connection handler.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (s *Server) listen(listener net.Listener) {
+	defer s.wg.Done()
+
+	log.Infof("Watchtower listening on %s", listener.Addr())
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-s.quit:
+				return
+			default:
+			}
+
+			log.Errorf("Unable to accept client connection: %v",
+				err)
+
+			// If the listener has been closed, there's nothing
+			// left for us to do.
+			if _, ok := err.(net.Error); !ok {
+				return
+			}
+			continue
+		}
+
+		// The tower's listeners are expected to produce brontide
+		// connections, which allow us to authenticate the client's
+		// static key as its session id.
+		peer, ok := conn.(Peer)
+		if !ok {
+			log.Errorf("Unsupported connection type %T from %s",
+				conn, conn.RemoteAddr())
+			conn.Close()
+			continue
+		}
+
+		s.InboundPeerConnected(peer)
+	}
+}
+
+// InboundPeerConnected is called by the server's listeners when a new client
+// has connected, and dispatches handling of the client's requests.
+func (s *Server) InboundPeerConnected(peer Peer) {
+	s.wg.Add(1)
+	go s.handleClient(peer)
+}
+
+// handleClient processes a series watchtower messages sent by a client. The
+// client may either send a single CreateSession message, or a series of
+// StateUpdate messages.
+//
+// This method uses the server's peer map to ensure at most one peer using the
+// same session id can enter the main event loop. The connection will be
+// dropped by the watchtower if no messages are sent or received by the
+// configured Read/WriteTimeouts.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (s *Server) handleClient(peer Peer) {
+	defer s.wg.Done()
+
+	id := wtdb.NewSessionIDFromPubKey(peer.RemotePub())
+
+	// Register the peer such that it can be disconnected on shutdown, and
+	// to prevent concurrent connections under the same session id.
+	if err := s.connectPeer(&id, peer); err != nil {
+		log.Errorf("Unable to accept connection from %s@%s: %v",
+			id, peer.RemoteAddr(), err)
+		peer.Close()
+		return
+	}
+	defer s.removePeer(&id)
+
+	msg, err := s.readMessage(peer)
+	if err != nil {
+		log.Errorf("Unable to read message from client %s@%s: %v",
+			id, peer.RemoteAddr(), err)
+		return
+	}
+
+	switch msg := msg.(type) {
+	case *wtwire.CreateSession:
+		// Attempt to open a new session for this client.
+		err := s.handleCreateSession(peer, &id, msg)
+		if err != nil {
+			log.Errorf("Unable to handle CreateSession from "+
+				"%s: %v", id, err)
+		}
+
+	case *wtwire.StateUpdate:
+		// Process any state updates sent by the client.
+		err := s.handleStateUpdates(peer, &id, msg)
+		if err != nil {
+			log.Errorf("Unable to handle StateUpdates from "+
+				"%s: %v", id, err)
+		}
+
+	default:
+		log.Errorf("Received unsupported message type: %T "+
+			"from %s", msg, id)
+	}
+}
+
+// handleCreateSession processes a CreateSession message from the peer, and
+// returns a CreateSessionReply in response. This method will only succeed if
+// no existing session info is known about the session id. If an existing
+// session is found, the reply will contain the tower's last applied sequence
+// number for the session, allowing the client to resume sending updates.
+func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
+	req *wtwire.CreateSession) error {
+
+	// Query the db for session info belonging to the client's session id.
+	existingInfo, err := s.cfg.DB.GetSessionInfo(id)
+	switch {
+
+	// We already have a session corresponding to this session id, return
+	// an error signaling that it already exists in our database. We return
+	// the last applied value to the client, such that they can resume any
+	// updates they have not yet delivered.
+	case err == nil:
+		log.Debugf("Already have session for %s", id)
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeAlreadyExists,
+			existingInfo.LastApplied,
+		)
+
+	// Some other database error occurred, return a temporary failure.
+	case err != wtdb.ErrSessionNotFound:
+		log.Errorf("Unable to load session info for %s", id)
+		return s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0,
+		)
+	}
+
+	// Now that we've established that this session does not exist in the
+	// database, assert that the proposed session parameters are sane.
+	switch {
+	case req.BlobVersion < blob.MinVersion ||
+		req.BlobVersion > blob.MaxVersion:
+
+		log.Debugf("Rejecting session %s: unsupported blob "+
+			"version %d", id, req.BlobVersion)
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeRejectBlobVersion, 0,
+		)
+
+	case req.MaxUpdates == 0:
+		log.Debugf("Rejecting session %s: max updates must be "+
+			"positive", id)
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeRejectMaxUpdates, 0,
+		)
+
+	case req.SweepFeeRate == 0:
+		log.Debugf("Rejecting session %s: sweep fee rate must be "+
+			"positive", id)
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeRejectSweepFeeRate, 0,
+		)
+	}
+
+	info := wtdb.SessionInfo{
+		ID:           *id,
+		Version:      req.BlobVersion,
+		MaxUpdates:   req.MaxUpdates,
+		SweepFeeRate: req.SweepFeeRate,
+	}
+
+	// Insert the session info into the watchtower's database. If
+	// successful, the session will now be ready for use.
+	err = s.cfg.DB.InsertSessionInfo(&info)
+	if err != nil {
+		log.Errorf("Unable to create session for %s", id)
+		return s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0,
+		)
+	}
+
+	log.Infof("Accepted session for %s", id)
+
+	return s.replyCreateSession(peer, id, wtwire.CodeOK, 0)
+}
+
+// replyCreateSession sends a response to a CreateSession from a client. If the
+// status code in the reply is OK, the error from the write will be bubbled up.
+// Otherwise, this method returns a connection error to ensure we don't continue
+// communication with the client.
+func (s *Server) replyCreateSession(peer Peer, id *wtdb.SessionID,
+	code wtwire.ErrorCode, lastApplied uint16) error {
+
+	msg := &wtwire.CreateSessionReply{
+		Code:        code,
+		LastApplied: lastApplied,
+	}
+
+	err := s.sendMessage(peer, msg)
+	if err != nil {
+		log.Errorf("Unable to send CreateSessionReply to %s", id)
+	}
+
+	// Return the write error if the request succeeded.
+	if code == wtwire.CodeOK {
+		return err
+	}
+
+	// Otherwise the request failed, return a connection failure to
+	// disconnect the client.
+	return &connFailure{
+		ID:   *id,
+		Code: code,
+	}
+}
+
+// handleStateUpdates processes a stream of StateUpdate requests from the
+// client. The provided update should be the first such update read, subsequent
+// updates will be consumed if the peer does not signal IsComplete on a
+// particular update.
+func (s *Server) handleStateUpdates(peer Peer, id *wtdb.SessionID,
+	update *wtwire.StateUpdate) error {
+
+	// Set the current update to the first update read off the wire.
+	// Additional updates will be read if this value is set to nil after
+	// processing the first.
+	var curUpdate = update
+	for {
+		// If this is not the first update, read the next state update
+		// from the peer.
+		if curUpdate == nil {
+			nextMsg, err := s.readMessage(peer)
+			if err != nil {
+				return err
+			}
+
+			var ok bool
+			curUpdate, ok = nextMsg.(*wtwire.StateUpdate)
+			if !ok {
+				return fmt.Errorf("client sent %T after "+
+					"StateUpdate", nextMsg)
+			}
+		}
+
+		// Try to accept the state update from the client.
+		err := s.handleStateUpdate(peer, id, curUpdate)
+		if err != nil {
+			return err
+		}
+
+		// If the client signals that this is last StateUpdate
+		// message, we can disconnect the client.
+		if curUpdate.IsComplete == 1 {
+			return nil
+		}
+
+		// Reset the current update to read subsequent updates in the
+		// stream.
+		curUpdate = nil
+
+		select {
+		case <-s.quit:
+			return ErrServerExiting
+		default:
+		}
+	}
+}
+
+// handleStateUpdate processes a StateUpdate message request from a client. An
+// attempt will be made to insert the update into the db, where it is validated
+// against the client's session. The possible errors are then mapped back to
+// StateUpdateCodes specified by the watchtower wire protocol, and sent back
+// using a StateUpdateReply message.
+func (s *Server) handleStateUpdate(peer Peer, id *wtdb.SessionID,
+	update *wtwire.StateUpdate) error {
+
+	session, err := s.cfg.DB.GetSessionInfo(id)
+	switch {
+	case err == wtdb.ErrSessionNotFound:
+		return s.replyStateUpdate(
+			peer, id, wtwire.CodePermanentFailure, 0,
+		)
+
+	case err != nil:
+		return s.replyStateUpdate(
+			peer, id, wtwire.CodeTemporaryFailure, 0,
+		)
+	}
+
+	// Ensure the encrypted blob has a size permitted by the session's blob
+	// version, accounting for the nonce prefix. Later versions carry a
+	// variable number of HTLC outputs, so the size is not fixed.
+	blobSize := len(update.EncryptedBlob) - blob.NonceSize
+	if !blob.IsValidSize(session.Version, blobSize) {
+		return s.replyStateUpdate(
+			peer, id, wtwire.StateUpdateCodeInvalidBlob,
+			session.LastApplied,
+		)
+	}
+
+	sessionUpdate := wtdb.SessionStateUpdate{
+		ID:            *id,
+		Hint:          update.Hint,
+		SeqNum:        update.SeqNum,
+		LastApplied:   update.LastApplied,
+		EncryptedBlob: update.EncryptedBlob,
+	}
+
+	lastApplied, err := s.cfg.DB.InsertStateUpdate(&sessionUpdate)
+	switch {
+	case err == nil:
+		log.Debugf("State update %d accepted for %s",
+			update.SeqNum, id)
+
+		return s.replyStateUpdate(
+			peer, id, wtwire.CodeOK, lastApplied,
+		)
+
+	// Return a permanent failure if a client tries to send an update for
+	// which we have no session.
+	case err == wtdb.ErrSessionNotFound:
+		return s.replyStateUpdate(
+			peer, id, wtwire.CodePermanentFailure, 0,
+		)
+
+	case err == wtdb.ErrSeqNumAlreadyApplied ||
+		err == wtdb.ErrLastAppliedReversion:
+
+		return s.replyStateUpdate(
+			peer, id, wtwire.StateUpdateCodeClientBehind,
+			session.LastApplied,
+		)
+
+	case err == wtdb.ErrSessionConsumed:
+		return s.replyStateUpdate(
+			peer, id, wtwire.StateUpdateCodeMaxUpdatesExceeded,
+			session.LastApplied,
+		)
+
+	case err == wtdb.ErrUpdateOutOfOrder:
+		return s.replyStateUpdate(
+			peer, id, wtwire.StateUpdateCodeSeqNumOutOfOrder,
+			session.LastApplied,
+		)
+
+	default:
+		return s.replyStateUpdate(
+			peer, id, wtwire.CodeTemporaryFailure,
+			session.LastApplied,
+		)
+	}
+}
+
+// replyStateUpdate sends a response to a StateUpdate from a client. If the
+// status code in the reply is OK, the error from the write will be bubbled up.
+// Otherwise, this method returns a connection error to ensure we don't continue
+// communication with the client.
+func (s *Server) replyStateUpdate(peer Peer, id *wtdb.SessionID,
+	code wtwire.ErrorCode, lastApplied uint16) error {
+
+	msg := &wtwire.StateUpdateReply{
+		Code:        code,
+		LastApplied: lastApplied,
+	}
+
+	err := s.sendMessage(peer, msg)
+	if err != nil {
+		log.Errorf("Unable to send StateUpdateReply to %s", id)
+	}
+
+	// Return the write error if the request succeeded.
+	if code == wtwire.CodeOK {
+		return err
+	}
+
+	// Otherwise the request failed, return a connection failure to
+	// disconnect the client.
+	return &connFailure{
+		ID:   *id,
+		Code: code,
+	}
+}
+
+// connectPeer records the given peer under its session id, failing if another
+// connection is already active for the same session id or the server is
+// shutting down.
+func (s *Server) connectPeer(id *wtdb.SessionID, peer Peer) error {
+	s.clientMtx.Lock()
+	defer s.clientMtx.Unlock()
+
+	if atomic.LoadInt32(&s.shutdown) == 1 {
+		return ErrServerExiting
+	}
+
+	if _, ok := s.clients[*id]; ok {
+		return ErrPeerAlreadyConnected
+	}
+	s.clients[*id] = peer
+
+	return nil
+}
+
+// removePeer deletes a client from the server's client map, and closes its
+// underlying connection.
+func (s *Server) removePeer(id *wtdb.SessionID) {
+	s.clientMtx.Lock()
+	peer, ok := s.clients[*id]
+	delete(s.clients, *id)
+	s.clientMtx.Unlock()
+
+	if ok {
+		peer.Close()
+	}
+}
+
+// readMessage receives and parses the next message from the given Peer. An
+// error is returned if a message is not received before the server's read
+// timeout, the read off the wire failed, or the message could not be
+// deserialized.
+func (s *Server) readMessage(peer Peer) (wtwire.Message, error) {
+	// Set a read timeout to ensure we drop the client if not sent in a
+	// timely manner.
+	err := peer.SetReadDeadline(time.Now().Add(s.cfg.ReadTimeout))
+	if err != nil {
+		err = fmt.Errorf("unable to set read deadline: %v", err)
+		return nil, err
+	}
+
+	// Pull the next message off the wire, and parse it according to the
+	// watchtower wire specification.
+	rawMsg, err := peer.ReadNextMessage()
+	if err != nil {
+		err = fmt.Errorf("unable to read message: %v", err)
+		return nil, err
+	}
+
+	msgReader := bytes.NewReader(rawMsg)
+	nextMsg, err := wtwire.ReadMessage(msgReader, 0)
+	if err != nil {
+		err = fmt.Errorf("unable to parse message: %v", err)
+		return nil, err
+	}
+
+	return nextMsg, nil
+}
+
+// sendMessage sends a watchtower wire message to the target peer.
+func (s *Server) sendMessage(peer Peer, msg wtwire.Message) error {
+	var b bytes.Buffer
+	_, err := wtwire.WriteMessage(&b, msg, 0)
+	if err != nil {
+		err = fmt.Errorf("Unable to encode msg: %v", err)
+		return err
+	}
+
+	err = peer.SetWriteDeadline(time.Now().Add(s.cfg.WriteTimeout))
+	if err != nil {
+		err = fmt.Errorf("unable to set write deadline: %v", err)
+		return err
+	}
+
+	_, err = peer.Write(b.Bytes())
+	return err
+}
+
+// connFailure is a default error used when a request failed with a non-zero
+// error code.
+type connFailure struct {
+	ID   wtdb.SessionID
+	Code wtwire.ErrorCode
+}
+
+// Error displays the SessionID and Code that caused the connection failure.
+func (f *connFailure) Error() string {
+	return fmt.Sprintf("connection with %s failed with code=%v", f.ID,
+		f.Code,
+	)
+}
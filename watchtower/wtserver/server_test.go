@@ -0,0 +1,379 @@
+package wtserver_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
+)
+
+const timeoutDuration = 500 * time.Millisecond
+
+var addr = &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9911}
+
+// mockPeer implements the wtserver.Peer interface, allowing the test to feed
+// messages to the server and inspect its replies.
+type mockPeer struct {
+	remotePub *btcec.PublicKey
+
+	incoming chan []byte
+	outgoing chan []byte
+
+	quit chan struct{}
+}
+
+func newMockPeer(t *testing.T) *mockPeer {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate private key: %v", err)
+	}
+
+	return newMockPeerFromPub(priv.PubKey())
+}
+
+// newMockPeerFromPub creates a new connection for a client using the given
+// identity key, allowing tests to simulate a client reconnecting.
+func newMockPeerFromPub(pub *btcec.PublicKey) *mockPeer {
+	return &mockPeer{
+		remotePub: pub,
+		incoming:  make(chan []byte, 1),
+		outgoing:  make(chan []byte, 1),
+		quit:      make(chan struct{}),
+	}
+}
+
+func (p *mockPeer) Write(b []byte) (int, error) {
+	select {
+	case p.outgoing <- b:
+		return len(b), nil
+	case <-p.quit:
+		return 0, io.EOF
+	}
+}
+
+func (p *mockPeer) Close() error {
+	select {
+	case <-p.quit:
+	default:
+		close(p.quit)
+	}
+	return nil
+}
+
+func (p *mockPeer) ReadNextMessage() ([]byte, error) {
+	select {
+	case b := <-p.incoming:
+		return b, nil
+	case <-p.quit:
+		return nil, io.EOF
+	}
+}
+
+func (p *mockPeer) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func (p *mockPeer) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (p *mockPeer) RemotePub() *btcec.PublicKey {
+	return p.remotePub
+}
+
+func (p *mockPeer) RemoteAddr() net.Addr {
+	return addr
+}
+
+// sendMsg serializes the message and delivers it to the server.
+func (p *mockPeer) sendMsg(t *testing.T, msg wtwire.Message) {
+	var b bytes.Buffer
+	if _, err := wtwire.WriteMessage(&b, msg, 0); err != nil {
+		t.Fatalf("unable to encode %T message: %v", msg, err)
+	}
+
+	select {
+	case p.incoming <- b.Bytes():
+	case <-time.After(timeoutDuration):
+		t.Fatalf("unable to send %T message", msg)
+	}
+}
+
+// recvReply reads the next reply sent by the server.
+func (p *mockPeer) recvReply(t *testing.T) wtwire.Message {
+	select {
+	case b := <-p.outgoing:
+		msg, err := wtwire.ReadMessage(bytes.NewReader(b), 0)
+		if err != nil {
+			t.Fatalf("unable to decode reply: %v", err)
+		}
+		return msg
+
+	case <-time.After(timeoutDuration):
+		t.Fatalf("no reply received from server")
+		return nil
+	}
+}
+
+// assertClosed asserts that the server hangs up on the peer.
+func (p *mockPeer) assertClosed(t *testing.T) {
+	select {
+	case <-p.quit:
+	case <-time.After(timeoutDuration):
+		t.Fatalf("server did not close connection")
+	}
+}
+
+// initServer creates and starts a new server backed by a fresh tower database.
+// The returned closure stops the server and removes the database.
+func initServer(t *testing.T) (*wtserver.Server, func()) {
+	tempDirName, err := ioutil.TempDir("", "wtserver")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	db, err := wtdb.OpenTowerDB(tempDirName)
+	if err != nil {
+		os.RemoveAll(tempDirName)
+		t.Fatalf("unable to open tower db: %v", err)
+	}
+
+	server, err := wtserver.New(&wtserver.Config{
+		DB:           db,
+		ReadTimeout:  timeoutDuration,
+		WriteTimeout: timeoutDuration,
+	})
+	if err != nil {
+		t.Fatalf("unable to create server: %v", err)
+	}
+
+	if err = server.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+
+	cleanUp := func() {
+		server.Stop()
+		db.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	return server, cleanUp
+}
+
+// createSession opens a session for the peer, asserting the expected reply.
+func createSession(t *testing.T, s *wtserver.Server, peer *mockPeer,
+	req *wtwire.CreateSession, expReply *wtwire.CreateSessionReply) {
+
+	s.InboundPeerConnected(peer)
+	peer.sendMsg(t, req)
+
+	reply := peer.recvReply(t)
+	if !reflect.DeepEqual(reply, expReply) {
+		t.Fatalf("unexpected CreateSessionReply, want: %v, got: %v",
+			expReply, reply)
+	}
+
+	peer.assertClosed(t)
+}
+
+func makeStateUpdate(seqNum, lastApplied uint16,
+	isComplete bool) *wtwire.StateUpdate {
+
+	update := &wtwire.StateUpdate{
+		SeqNum:        seqNum,
+		LastApplied:   lastApplied,
+		EncryptedBlob: make([]byte, blob.NonceSize+blob.Size(0, 0)),
+	}
+	update.Hint[0] = byte(seqNum)
+	if isComplete {
+		update.IsComplete = 1
+	}
+
+	return update
+}
+
+var defaultCreateSession = &wtwire.CreateSession{
+	BlobVersion:  0,
+	MaxUpdates:   3,
+	SweepFeeRate: 1000,
+}
+
+// TestServerCreateSession asserts that the server accepts and rejects proposed
+// sessions based on their parameters.
+func TestServerCreateSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *wtwire.CreateSession
+		expReply *wtwire.CreateSessionReply
+	}{
+		{
+			name: "unsupported blob version",
+			req: &wtwire.CreateSession{
+				BlobVersion:  blob.MaxVersion + 1,
+				MaxUpdates:   3,
+				SweepFeeRate: 1000,
+			},
+			expReply: &wtwire.CreateSessionReply{
+				Code: wtwire.CreateSessionCodeRejectBlobVersion,
+			},
+		},
+		{
+			name: "zero max updates",
+			req: &wtwire.CreateSession{
+				SweepFeeRate: 1000,
+			},
+			expReply: &wtwire.CreateSessionReply{
+				Code: wtwire.CreateSessionCodeRejectMaxUpdates,
+			},
+		},
+		{
+			name: "zero sweep fee rate",
+			req: &wtwire.CreateSession{
+				MaxUpdates: 3,
+			},
+			expReply: &wtwire.CreateSessionReply{
+				Code: wtwire.CreateSessionCodeRejectSweepFeeRate,
+			},
+		},
+		{
+			name: "valid session",
+			req:  defaultCreateSession,
+			expReply: &wtwire.CreateSessionReply{
+				Code: wtwire.CodeOK,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, cleanUp := initServer(t)
+			defer cleanUp()
+
+			peer := newMockPeer(t)
+			createSession(t, server, peer, test.req, test.expReply)
+		})
+	}
+}
+
+// TestServerStateUpdates asserts that the server properly validates a stream of
+// state updates, and that a client reconnecting after a failure can learn the
+// tower's last applied update by proposing its existing session.
+func TestServerStateUpdates(t *testing.T) {
+	server, cleanUp := initServer(t)
+	defer cleanUp()
+
+	// Updates sent before a session is negotiated should be rejected.
+	peer := newMockPeer(t)
+	server.InboundPeerConnected(peer)
+	peer.sendMsg(t, makeStateUpdate(1, 0, true))
+	reply := peer.recvReply(t)
+	expReply := &wtwire.StateUpdateReply{
+		Code: wtwire.CodePermanentFailure,
+	}
+	if !reflect.DeepEqual(reply, expReply) {
+		t.Fatalf("unexpected reply, want: %v, got: %v", expReply, reply)
+	}
+	peer.assertClosed(t)
+
+	// Create a session for the client, reusing the same identity key.
+	peer = newMockPeerFromPub(peer.remotePub)
+	createSession(
+		t, server, peer, defaultCreateSession,
+		&wtwire.CreateSessionReply{Code: wtwire.CodeOK},
+	)
+
+	reconnect := func() {
+		peer = newMockPeerFromPub(peer.remotePub)
+		server.InboundPeerConnected(peer)
+	}
+
+	// Send two valid updates followed by one that skips a sequence
+	// number, which should cause the server to hang up.
+	reconnect()
+	steps := []struct {
+		update   *wtwire.StateUpdate
+		expReply *wtwire.StateUpdateReply
+	}{
+		{
+			update: makeStateUpdate(1, 0, false),
+			expReply: &wtwire.StateUpdateReply{
+				Code:        wtwire.CodeOK,
+				LastApplied: 1,
+			},
+		},
+		{
+			update: makeStateUpdate(2, 1, false),
+			expReply: &wtwire.StateUpdateReply{
+				Code:        wtwire.CodeOK,
+				LastApplied: 2,
+			},
+		},
+		{
+			update: makeStateUpdate(4, 2, false),
+			expReply: &wtwire.StateUpdateReply{
+				Code:        wtwire.StateUpdateCodeSeqNumOutOfOrder,
+				LastApplied: 2,
+			},
+		},
+	}
+	for i, step := range steps {
+		peer.sendMsg(t, step.update)
+		reply := peer.recvReply(t)
+		if !reflect.DeepEqual(reply, step.expReply) {
+			t.Fatalf("step %d: unexpected reply, want: %v, got: %v",
+				i, step.expReply, reply)
+		}
+	}
+	peer.assertClosed(t)
+
+	// Proposing the same session again should inform the client of the
+	// tower's last applied update.
+	peer = newMockPeerFromPub(peer.remotePub)
+	createSession(
+		t, server, peer, defaultCreateSession,
+		&wtwire.CreateSessionReply{
+			Code:        wtwire.CreateSessionCodeAlreadyExists,
+			LastApplied: 2,
+		},
+	)
+
+	// An improperly sized blob should be rejected.
+	reconnect()
+	badUpdate := makeStateUpdate(3, 2, false)
+	badUpdate.EncryptedBlob = badUpdate.EncryptedBlob[1:]
+	peer.sendMsg(t, badUpdate)
+	reply = peer.recvReply(t)
+	expReply = &wtwire.StateUpdateReply{
+		Code:        wtwire.StateUpdateCodeInvalidBlob,
+		LastApplied: 2,
+	}
+	if !reflect.DeepEqual(reply, expReply) {
+		t.Fatalf("unexpected reply, want: %v, got: %v", expReply, reply)
+	}
+	peer.assertClosed(t)
+
+	// Finally, the client resumes from the tower's last applied update
+	// and signals that it has no more updates to send, after which the
+	// server should disconnect.
+	reconnect()
+	peer.sendMsg(t, makeStateUpdate(3, 2, true))
+	reply = peer.recvReply(t)
+	expReply = &wtwire.StateUpdateReply{
+		Code:        wtwire.CodeOK,
+		LastApplied: 3,
+	}
+	if !reflect.DeepEqual(reply, expReply) {
+		t.Fatalf("unexpected reply, want: %v, got: %v", expReply, reply)
+	}
+	peer.assertClosed(t)
+}
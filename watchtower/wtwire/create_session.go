@@ -0,0 +1,72 @@
+package wtwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// CreateSession is sent from a client to tower to negotiate a session,
+// which specifies the total number of updates that can be made, as well as
+// fee rates. An update is consumed by uploading an encrypted blob that
+// contains information required to sweep a revoked commitment transaction.
+type CreateSession struct {
+	// BlobVersion specifies the blob format that must be used by all
+	// updates sent under the session key used to negotiate this session.
+	BlobVersion uint16
+
+	// MaxUpdates is the maximum number of updates the watchtower will honor
+	// for this session.
+	MaxUpdates uint16
+
+	// SweepFeeRate is the fee rate used by the watchtower to construct
+	// justice transactions. The client signs the inputs of the justice
+	// transaction assuming this fee rate, so the tower must use the exact
+	// value in order for the signatures to be valid.
+	SweepFeeRate lnwallet.SatPerKWeight
+}
+
+// A compile time check to ensure CreateSession implements the wtwire.Message
+// interface.
+var _ Message = (*CreateSession)(nil)
+
+// Decode deserializes a serialized CreateSession message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSession) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&m.BlobVersion,
+		&m.MaxUpdates,
+		&m.SweepFeeRate,
+	)
+}
+
+// Encode serializes the target CreateSession into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSession) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		m.BlobVersion,
+		m.MaxUpdates,
+		m.SweepFeeRate,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSession) MsgType() MessageType {
+	return MsgCreateSession
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a CreateSession
+// complete message observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSession) MaxPayloadLength(uint32) uint32 {
+	// 2 + 2 + 8
+	return 12
+}
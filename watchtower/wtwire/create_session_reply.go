@@ -0,0 +1,79 @@
+package wtwire
+
+import "io"
+
+const (
+	// CreateSessionCodeAlreadyExists is returned when a session is already
+	// active for the public key used to connect to the watchtower. The
+	// response includes the tower's last applied sequence number for the
+	// existing session, allowing the client to resume uploading updates.
+	CreateSessionCodeAlreadyExists ErrorCode = 60
+
+	// CreateSessionCodeRejectMaxUpdates the tower rejected the maximum
+	// number of state updates proposed by the client.
+	CreateSessionCodeRejectMaxUpdates ErrorCode = 61
+
+	// CreateSessionCodeRejectSweepFeeRate the tower rejected the sweep fee
+	// rate proposed by the client.
+	CreateSessionCodeRejectSweepFeeRate ErrorCode = 62
+
+	// CreateSessionCodeRejectBlobVersion the tower rejected the blob
+	// version proposed by the client.
+	CreateSessionCodeRejectBlobVersion ErrorCode = 63
+)
+
+// CreateSessionReply is a message sent from watchtower to client in response to a
+// CreateSession message, and signals either an acceptance or rejection of the
+// proposed session parameters.
+type CreateSessionReply struct {
+	// Code will be non-zero if the watchtower rejected the session init.
+	Code ErrorCode
+
+	// LastApplied is the tower's last accepted sequence number for the
+	// session. This is only meaningful if Code is
+	// CreateSessionCodeAlreadyExists.
+	LastApplied uint16
+}
+
+// A compile time check to ensure CreateSessionReply implements the
+// wtwire.Message interface.
+var _ Message = (*CreateSessionReply)(nil)
+
+// Decode deserializes a serialized CreateSessionReply message stored in the
+// passed io.Reader observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSessionReply) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&m.Code,
+		&m.LastApplied,
+	)
+}
+
+// Encode serializes the target CreateSessionReply into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSessionReply) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		m.Code,
+		m.LastApplied,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSessionReply) MsgType() MessageType {
+	return MsgCreateSessionReply
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a
+// CreateSessionReply complete message observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *CreateSessionReply) MaxPayloadLength(uint32) uint32 {
+	// 2 + 2
+	return 4
+}
@@ -0,0 +1,156 @@
+package wtwire
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestEmptyMessageUnknownType asserts that attempting to construct an empty
+// message of an unknown type fails.
+func TestEmptyMessageUnknownType(t *testing.T) {
+	t.Parallel()
+
+	fakeType := MessageType(math.MaxUint16)
+	if _, err := makeEmptyMessage(fakeType); err == nil {
+		t.Fatalf("should not be able to make an empty message of an " +
+			"unknown type")
+	}
+}
+
+// TestWatchtowerWireProtocol uses the testing/quick package to create a series
+// of fuzz tests to attempt to break a primary scenario which is implemented as
+// property based testing scenario.
+func TestWatchtowerWireProtocol(t *testing.T) {
+	t.Parallel()
+
+	// mainScenario is the primary test that will programmatically be
+	// executed for all registered wire messages. The quick-checker within
+	// testing/quick will attempt to find an input to this function, s.t
+	// the function returns false, if so then we've found an input that
+	// violates our model of the system.
+	mainScenario := func(msg Message) bool {
+		// Give a new message, we'll serialize the message into a new
+		// bytes buffer.
+		var b bytes.Buffer
+		if _, err := WriteMessage(&b, msg, 0); err != nil {
+			t.Fatalf("unable to write msg: %v", err)
+			return false
+		}
+
+		// Next, we'll ensure that the serialized payload (subtracting
+		// the 2 bytes for the message type) is _below_ the specified
+		// max payload size for this message.
+		payloadLen := uint32(b.Len()) - 2
+		if payloadLen > msg.MaxPayloadLength(0) {
+			t.Fatalf("msg payload constraint violated: %v > %v",
+				payloadLen, msg.MaxPayloadLength(0))
+			return false
+		}
+
+		// Finally, we'll deserialize the message from the written
+		// buffer, and finally assert that the messages are equal.
+		newMsg, err := ReadMessage(&b, 0)
+		if err != nil {
+			t.Fatalf("unable to read msg: %v", err)
+			return false
+		}
+		if !reflect.DeepEqual(msg, newMsg) {
+			t.Fatalf("messages don't match after re-encoding: %v "+
+				"vs %v", spew.Sdump(msg), spew.Sdump(newMsg))
+			return false
+		}
+
+		return true
+	}
+
+	// customTypeGen is a map of functions that are able to randomly
+	// generate a given type. These functions are needed for types which
+	// are too complex for the testing/quick package to automatically
+	// generate.
+	customTypeGen := map[MessageType]func([]reflect.Value, *rand.Rand){
+		MsgStateUpdate: func(v []reflect.Value, r *rand.Rand) {
+			req := StateUpdate{
+				SeqNum:        uint16(r.Int31()),
+				LastApplied:   uint16(r.Int31()),
+				IsComplete:    uint8(r.Int31()),
+				EncryptedBlob: make([]byte, r.Intn(1024)),
+			}
+
+			if _, err := r.Read(req.Hint[:]); err != nil {
+				t.Fatalf("unable to generate hint: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.EncryptedBlob); err != nil {
+				t.Fatalf("unable to generate blob: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+	}
+
+	// With the above types defined, we'll now generate a slice of
+	// scenarios to feed into quick.Check. The function scans in input
+	// space of the target function under test, so we'll need to create a
+	// series of wrapper functions to force it to iterate over the target
+	// types, but re-use the mainScenario defined above.
+	tests := []struct {
+		msgType  MessageType
+		scenario interface{}
+	}{
+		{
+			msgType: MsgCreateSession,
+			scenario: func(m CreateSession) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgCreateSessionReply,
+			scenario: func(m CreateSessionReply) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStateUpdate,
+			scenario: func(m StateUpdate) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStateUpdateReply,
+			scenario: func(m StateUpdateReply) bool {
+				return mainScenario(&m)
+			},
+		},
+	}
+	for _, test := range tests {
+		var config *quick.Config
+
+		// If the type defined is within the custom type gen map above,
+		// then we'll modify the default config to use this Value
+		// function that knows how to generate the proper types.
+		if valueGen, ok := customTypeGen[test.msgType]; ok {
+			config = &quick.Config{
+				Values: valueGen,
+			}
+		}
+
+		t.Logf("Running fuzz tests for msgType=%v", test.msgType)
+		if err := quick.Check(test.scenario, config); err != nil {
+			t.Fatalf("fuzz checks for msg=%v failed: %v",
+				test.msgType, err)
+		}
+	}
+}
+
+func init() {
+	rand.Seed(time.Now().Unix())
+}